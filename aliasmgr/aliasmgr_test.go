@@ -0,0 +1,227 @@
+package aliasmgr
+
+import (
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ltcsuite/lnd/kvdb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAliasStorePeerAlias tests that putting and retrieving a peer's alias
+// works properly.
+func TestAliasStorePeerAlias(t *testing.T) {
+	t.Parallel()
+
+	// Create the backend database and use this to create the aliasStore.
+	dbDir, err := ioutil.TempDir("", "aliasStore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	dbPath := filepath.Join(dbDir, "testdb")
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, dbPath, true, kvdb.DefaultDBTimeout,
+	)
+	require.NoError(t, err)
+	defer db.Close()
+
+	aliasStore, err := NewManager(db)
+	require.NoError(t, err)
+
+	var chanID1 [32]byte
+	_, err = rand.Read(chanID1[:])
+	require.NoError(t, err)
+
+	// Test that we can put the (chanID, alias) mapping in the database.
+	// Also check that we retrieve exactly what we put in.
+	err = aliasStore.PutPeerAlias(chanID1, StartingAlias)
+	require.NoError(t, err)
+
+	alias, err := aliasStore.GetPeerAlias(chanID1)
+	require.NoError(t, err)
+	require.Equal(t, StartingAlias, alias)
+
+	// The reverse lookup should yield the same channel.
+	chanID, err := aliasStore.FindChanIDByPeerAlias(StartingAlias)
+	require.NoError(t, err)
+	require.Equal(t, lnwire.ChannelID(chanID1), chanID)
+
+	// Restarting the manager should load the mapping from disk.
+	aliasStore, err = NewManager(db)
+	require.NoError(t, err)
+
+	alias, err = aliasStore.GetPeerAlias(chanID1)
+	require.NoError(t, err)
+	require.Equal(t, StartingAlias, alias)
+}
+
+// TestAliasStoreRequest tests that the aliasStore delivers the expected SCID.
+func TestAliasStoreRequest(t *testing.T) {
+	t.Parallel()
+
+	// Create the backend database and use this to create the aliasStore.
+	dbDir, err := ioutil.TempDir("", "aliasStore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	dbPath := filepath.Join(dbDir, "testdb")
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, dbPath, true, kvdb.DefaultDBTimeout,
+	)
+	require.NoError(t, err)
+	defer db.Close()
+
+	aliasStore, err := NewManager(db)
+	require.NoError(t, err)
+
+	// We'll assert that the very first alias we receive is StartingAlias.
+	alias1, err := aliasStore.RequestAlias()
+	require.NoError(t, err)
+	require.Equal(t, StartingAlias, alias1)
+
+	// The next alias should be the result of passing in StartingAlias to
+	// getNextScid.
+	nextAlias, err := getNextScid(alias1)
+	require.NoError(t, err)
+	alias2, err := aliasStore.RequestAlias()
+	require.NoError(t, err)
+	require.Equal(t, nextAlias, alias2)
+}
+
+// TestAliasStoreLocalAlias tests that local aliases can be added, looked up
+// by either side of the mapping, and deleted.
+func TestAliasStoreLocalAlias(t *testing.T) {
+	t.Parallel()
+
+	// Create the backend database and use this to create the aliasStore.
+	dbDir, err := ioutil.TempDir("", "aliasStore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dbDir)
+
+	dbPath := filepath.Join(dbDir, "testdb")
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, dbPath, true, kvdb.DefaultDBTimeout,
+	)
+	require.NoError(t, err)
+	defer db.Close()
+
+	aliasStore, err := NewManager(db)
+	require.NoError(t, err)
+
+	baseScid := lnwire.NewShortChanIDFromInt(123)
+	alias1, err := aliasStore.RequestAlias()
+	require.NoError(t, err)
+	alias2, err := aliasStore.RequestAlias()
+	require.NoError(t, err)
+
+	require.NoError(t, aliasStore.AddLocalAlias(alias1, baseScid))
+	require.NoError(t, aliasStore.AddLocalAlias(alias2, baseScid))
+
+	// Adding the same alias twice should be a noop.
+	require.NoError(t, aliasStore.AddLocalAlias(alias2, baseScid))
+
+	aliases := aliasStore.GetAliases(baseScid)
+	require.ElementsMatch(
+		t, []lnwire.ShortChannelID{alias1, alias2}, aliases,
+	)
+
+	base, err := aliasStore.FindBaseSCID(alias2)
+	require.NoError(t, err)
+	require.Equal(t, baseScid, base)
+
+	// The mappings should survive a restart.
+	aliasStore, err = NewManager(db)
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t, []lnwire.ShortChannelID{alias1, alias2},
+		aliasStore.ListAliases()[baseScid],
+	)
+
+	// Once deleted, neither alias should map to the base SCID anymore.
+	require.NoError(t, aliasStore.DeleteLocalAliases(baseScid))
+	require.Empty(t, aliasStore.GetAliases(baseScid))
+
+	_, err = aliasStore.FindBaseSCID(alias1)
+	require.ErrorIs(t, err, ErrAliasNotFound)
+}
+
+// TestGetNextScid tests that given a current lnwire.ShortChannelID,
+// getNextScid returns the expected alias to use next.
+func TestGetNextScid(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  lnwire.ShortChannelID
+		expected lnwire.ShortChannelID
+	}{
+		{
+			name:    "starting alias",
+			current: StartingAlias,
+			expected: lnwire.ShortChannelID{
+				BlockHeight: uint32(startingBlockHeight),
+				TxIndex:     0,
+				TxPosition:  1,
+			},
+		},
+		{
+			name: "txposition rollover",
+			current: lnwire.ShortChannelID{
+				BlockHeight: 16_100_000,
+				TxIndex:     15,
+				TxPosition:  65535,
+			},
+			expected: lnwire.ShortChannelID{
+				BlockHeight: 16_100_000,
+				TxIndex:     16,
+				TxPosition:  0,
+			},
+		},
+		{
+			name: "txindex max no rollover",
+			current: lnwire.ShortChannelID{
+				BlockHeight: 16_100_000,
+				TxIndex:     16777215,
+				TxPosition:  15,
+			},
+			expected: lnwire.ShortChannelID{
+				BlockHeight: 16_100_000,
+				TxIndex:     16777215,
+				TxPosition:  16,
+			},
+		},
+		{
+			name: "txindex rollover",
+			current: lnwire.ShortChannelID{
+				BlockHeight: 16_100_000,
+				TxIndex:     16777215,
+				TxPosition:  65535,
+			},
+			expected: lnwire.ShortChannelID{
+				BlockHeight: 16_100_001,
+				TxIndex:     0,
+				TxPosition:  0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			nextScid, err := getNextScid(test.current)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, nextScid)
+		})
+	}
+
+	// Once the last block height of the range has been used up, no more
+	// aliases can be handed out.
+	_, err := getNextScid(lnwire.ShortChannelID{
+		BlockHeight: uint32(endBlockHeight - 1),
+		TxIndex:     16777215,
+		TxPosition:  65535,
+	})
+	require.Error(t, err)
+}
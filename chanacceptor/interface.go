@@ -3,6 +3,7 @@ package chanacceptor
 import (
 	"errors"
 
+	"github.com/ltcsuite/lnd/lnwallet"
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/ltcutil"
@@ -26,6 +27,25 @@ type ChannelAcceptRequest struct {
 	// OpenChanMsg is the actual OpenChannel protocol message that the peer
 	// sent to us.
 	OpenChanMsg *lnwire.OpenChannel
+
+	// DualFunded is true if the request was made through the interactive
+	// dual-funding protocol. In that case OpenChanMsg is converted from
+	// the open_channel2 message, and the acceptor may contribute to the
+	// channel's funding by setting FundingAmt in its response.
+	DualFunded bool
+
+	// Features is the set of features the requesting node signaled when
+	// it connected to us.
+	Features *lnwire.FeatureVector
+
+	// CommitType is the commitment type the channel will use, as
+	// negotiated from the requested channel type and the features of both
+	// nodes.
+	CommitType lnwallet.CommitmentType
+
+	// NumPendingChans is the number of channels with the requesting node
+	// that are pending open, excluding the requested one.
+	NumPendingChans int
 }
 
 // ChannelAcceptResponse is a struct containing the response to a request to
@@ -62,6 +82,15 @@ type ChannelAcceptResponse struct {
 	// MinAcceptDepth is the minimum depth that the initiator of the
 	// channel should wait before considering the channel open.
 	MinAcceptDepth uint16
+
+	// ZeroConf indicates that the fundee wishes to send min_depth = 0 and
+	// request a zero-conf channel with the counter-party.
+	ZeroConf bool
+
+	// FundingAmt is the amount that we contribute to the funding output
+	// of a dual-funded channel. This must be zero for channels that are
+	// funded by the initiator alone.
+	FundingAmt ltcutil.Amount
 }
 
 // NewChannelAcceptResponse is a constructor for a channel accept response,
@@ -72,7 +101,8 @@ type ChannelAcceptResponse struct {
 func NewChannelAcceptResponse(accept bool, acceptErr error,
 	upfrontShutdown lnwire.DeliveryAddress, csvDelay, htlcLimit,
 	minDepth uint16, reserve ltcutil.Amount, inFlight,
-	minHtlcIn lnwire.MilliSatoshi) *ChannelAcceptResponse {
+	minHtlcIn lnwire.MilliSatoshi, zeroConf bool,
+	fundingAmt ltcutil.Amount) *ChannelAcceptResponse {
 
 	resp := &ChannelAcceptResponse{
 		UpfrontShutdown: upfrontShutdown,
@@ -82,6 +112,8 @@ func NewChannelAcceptResponse(accept bool, acceptErr error,
 		HtlcLimit:       htlcLimit,
 		MinHtlcIn:       minHtlcIn,
 		MinAcceptDepth:  minDepth,
+		ZeroConf:        zeroConf,
+		FundingAmt:      fundingAmt,
 	}
 
 	// If we want to accept the channel, we return a response with a nil
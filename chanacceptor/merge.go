@@ -18,6 +18,8 @@ const (
 	fieldMinIn           = "min htlc in"
 	fieldInFlightTotal   = "in flight total"
 	fieldUpfrontShutdown = "upfront shutdown"
+	fieldZeroConf        = "zero conf"
+	fieldFundingAmt      = "funding amount"
 )
 
 // fieldMismatchError returns a merge error for a named field when we get two
@@ -148,5 +150,23 @@ func mergeResponse(current, new ChannelAcceptResponse) (ChannelAcceptResponse,
 		return current, err
 	}
 
+	fundingAmt, err := mergeInt64(
+		fieldFundingAmt, int64(current.FundingAmt),
+		int64(new.FundingAmt),
+	)
+	if err != nil {
+		return current, err
+	}
+	current.FundingAmt = ltcutil.Amount(fundingAmt)
+
+	// A zero-conf channel can only be accepted if the min depth that we
+	// merged above is zero, otherwise the two responses conflict.
+	current.ZeroConf = current.ZeroConf || new.ZeroConf
+	if current.ZeroConf && current.MinAcceptDepth != 0 {
+		return current, fieldMismatchError(
+			fieldZeroConf, current.MinAcceptDepth, current.ZeroConf,
+		)
+	}
+
 	return current, nil
 }
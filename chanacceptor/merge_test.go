@@ -114,6 +114,39 @@ func TestMergeResponse(t *testing.T) {
 			},
 			err: fieldMismatchError(fieldMinDep, 1, 2),
 		},
+		{
+			name: "zero conf with depth",
+			current: ChannelAcceptResponse{
+				MinAcceptDepth: 1,
+			},
+			new: ChannelAcceptResponse{
+				ZeroConf: true,
+			},
+			err: fieldMismatchError(fieldZeroConf, 1, true),
+		},
+		{
+			name: "zero conf",
+			current: ChannelAcceptResponse{
+				ZeroConf: true,
+			},
+			new: ChannelAcceptResponse{
+				CSVDelay: 1,
+			},
+			merged: ChannelAcceptResponse{
+				CSVDelay: 1,
+				ZeroConf: true,
+			},
+		},
+		{
+			name: "different funding amount",
+			current: ChannelAcceptResponse{
+				FundingAmt: 1,
+			},
+			new: ChannelAcceptResponse{
+				FundingAmt: 2,
+			},
+			err: fieldMismatchError(fieldFundingAmt, 1, 2),
+		},
 		{
 			name: "merge all values",
 			current: ChannelAcceptResponse{
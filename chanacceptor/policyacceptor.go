@@ -0,0 +1,142 @@
+package chanacceptor
+
+import (
+	"fmt"
+
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/ltcutil"
+)
+
+// PolicyConfig describes the inbound channels a PolicyAcceptor accepts. Each
+// check is skipped if its field is left at its zero value.
+type PolicyConfig struct {
+	// MinChanSize is the smallest channel size that is accepted.
+	MinChanSize ltcutil.Amount
+
+	// MaxChanSize is the largest channel size that is accepted.
+	MaxChanSize ltcutil.Amount
+
+	// AllowedPeers is the set of nodes that are allowed to open channels
+	// to us.
+	AllowedPeers []*btcec.PublicKey
+
+	// RequiredFeatures is the set of features the requesting node must
+	// signal, either as optional or required.
+	RequiredFeatures []lnwire.FeatureBit
+
+	// CommitTypes is the set of commitment types channels may use.
+	CommitTypes []lnwallet.CommitmentType
+
+	// MaxPendingChans is the maximum number of channels that may be
+	// pending open with a single node at the same time.
+	MaxPendingChans int
+}
+
+// PolicyAcceptor is a ChannelAcceptor that checks inbound channels against a
+// static policy, which allows node operators to restrict the channels they
+// accept without running an RPC acceptor.
+type PolicyAcceptor struct {
+	cfg *PolicyConfig
+
+	allowedPeers map[[33]byte]struct{}
+}
+
+// NewPolicyAcceptor creates a new PolicyAcceptor enforcing the given policy.
+func NewPolicyAcceptor(cfg *PolicyConfig) *PolicyAcceptor {
+	allowedPeers := make(map[[33]byte]struct{}, len(cfg.AllowedPeers))
+	for _, peer := range cfg.AllowedPeers {
+		var key [33]byte
+		copy(key[:], peer.SerializeCompressed())
+		allowedPeers[key] = struct{}{}
+	}
+
+	return &PolicyAcceptor{
+		cfg:          cfg,
+		allowedPeers: allowedPeers,
+	}
+}
+
+// Accept checks the requested channel against our policy, and rejects it with
+// the reason of the first violation if it doesn't comply.
+//
+// NOTE: Part of the ChannelAcceptor interface.
+func (p *PolicyAcceptor) Accept(
+	req *ChannelAcceptRequest) *ChannelAcceptResponse {
+
+	if err := p.checkPolicy(req); err != nil {
+		log.Debugf("Channel %x from node %x violates policy: %v",
+			req.OpenChanMsg.PendingChannelID,
+			req.Node.SerializeCompressed(), err)
+
+		return NewChannelAcceptResponse(
+			false, err, nil, 0, 0, 0, 0, 0, 0, false, 0,
+		)
+	}
+
+	return NewChannelAcceptResponse(
+		true, nil, nil, 0, 0, 0, 0, 0, 0, false, 0,
+	)
+}
+
+// checkPolicy returns an error describing the first violation of our policy
+// by the requested channel.
+func (p *PolicyAcceptor) checkPolicy(req *ChannelAcceptRequest) error {
+	if len(p.allowedPeers) != 0 {
+		var key [33]byte
+		copy(key[:], req.Node.SerializeCompressed())
+		if _, ok := p.allowedPeers[key]; !ok {
+			return fmt.Errorf("channels from this node are not " +
+				"accepted")
+		}
+	}
+
+	chanSize := req.OpenChanMsg.FundingAmount
+	if p.cfg.MinChanSize != 0 && chanSize < p.cfg.MinChanSize {
+		return fmt.Errorf("channel size %v is below minimum of %v",
+			chanSize, p.cfg.MinChanSize)
+	}
+	if p.cfg.MaxChanSize != 0 && chanSize > p.cfg.MaxChanSize {
+		return fmt.Errorf("channel size %v exceeds maximum of %v",
+			chanSize, p.cfg.MaxChanSize)
+	}
+
+	for _, bit := range p.cfg.RequiredFeatures {
+		// We don't care whether the feature is signaled as optional
+		// or required, so we check for both bits of the pair.
+		if req.Features == nil || (!req.Features.IsSet(bit) &&
+			!req.Features.IsSet(bit^1)) {
+
+			return fmt.Errorf("required feature bit %d not "+
+				"signaled", bit)
+		}
+	}
+
+	if len(p.cfg.CommitTypes) != 0 {
+		var allowed bool
+		for _, commitType := range p.cfg.CommitTypes {
+			if req.CommitType == commitType {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("commitment type %v not accepted",
+				req.CommitType)
+		}
+	}
+
+	if p.cfg.MaxPendingChans != 0 &&
+		req.NumPendingChans >= p.cfg.MaxPendingChans {
+
+		return fmt.Errorf("number of pending channels exceeds "+
+			"maximum of %d", p.cfg.MaxPendingChans)
+	}
+
+	return nil
+}
+
+// A compile-time constraint to ensure PolicyAcceptor implements the
+// ChannelAcceptor interface.
+var _ ChannelAcceptor = (*PolicyAcceptor)(nil)
@@ -0,0 +1,144 @@
+package chanacceptor
+
+import (
+	"testing"
+
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPolicyAcceptor tests that the PolicyAcceptor rejects channels that
+// violate its policy, and accepts all others.
+func TestPolicyAcceptor(t *testing.T) {
+	t.Parallel()
+
+	allowedKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	var commitType lnwallet.CommitmentType = lnwallet.
+		CommitmentTypeAnchorsZeroFeeHtlcTx
+
+	acceptor := NewPolicyAcceptor(&PolicyConfig{
+		MinChanSize:  100_000,
+		MaxChanSize:  1_000_000,
+		AllowedPeers: []*btcec.PublicKey{allowedKey.PubKey()},
+		RequiredFeatures: []lnwire.FeatureBit{
+			lnwire.StaticRemoteKeyRequired,
+		},
+		CommitTypes:     []lnwallet.CommitmentType{commitType},
+		MaxPendingChans: 2,
+	})
+
+	// newRequest returns a request that complies with the policy.
+	newRequest := func() *ChannelAcceptRequest {
+		return &ChannelAcceptRequest{
+			Node: allowedKey.PubKey(),
+			OpenChanMsg: &lnwire.OpenChannel{
+				FundingAmount: 500_000,
+			},
+			Features: lnwire.NewFeatureVector(
+				lnwire.NewRawFeatureVector(
+					lnwire.StaticRemoteKeyOptional,
+				), lnwire.Features,
+			),
+			CommitType:      commitType,
+			NumPendingChans: 1,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*ChannelAcceptRequest)
+		reject bool
+	}{
+		{
+			name:   "compliant channel",
+			modify: func(*ChannelAcceptRequest) {},
+		},
+		{
+			name: "unknown peer",
+			modify: func(req *ChannelAcceptRequest) {
+				req.Node = otherKey.PubKey()
+			},
+			reject: true,
+		},
+		{
+			name: "channel too small",
+			modify: func(req *ChannelAcceptRequest) {
+				req.OpenChanMsg.FundingAmount = 99_999
+			},
+			reject: true,
+		},
+		{
+			name: "channel too large",
+			modify: func(req *ChannelAcceptRequest) {
+				req.OpenChanMsg.FundingAmount = 1_000_001
+			},
+			reject: true,
+		},
+		{
+			name: "missing feature",
+			modify: func(req *ChannelAcceptRequest) {
+				req.Features = lnwire.EmptyFeatureVector()
+			},
+			reject: true,
+		},
+		{
+			name: "no features",
+			modify: func(req *ChannelAcceptRequest) {
+				req.Features = nil
+			},
+			reject: true,
+		},
+		{
+			name: "wrong commitment type",
+			modify: func(req *ChannelAcceptRequest) {
+				req.CommitType = lnwallet.CommitmentTypeLegacy
+			},
+			reject: true,
+		},
+		{
+			name: "too many pending channels",
+			modify: func(req *ChannelAcceptRequest) {
+				req.NumPendingChans = 2
+			},
+			reject: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			req := newRequest()
+			test.modify(req)
+
+			resp := acceptor.Accept(req)
+			require.Equal(t, test.reject, resp.RejectChannel())
+			if test.reject {
+				require.Error(t, resp.ChanAcceptError)
+			}
+		})
+	}
+}
+
+// TestPolicyAcceptorEmpty tests that a PolicyAcceptor without any checks
+// accepts all channels.
+func TestPolicyAcceptorEmpty(t *testing.T) {
+	t.Parallel()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	acceptor := NewPolicyAcceptor(&PolicyConfig{})
+	resp := acceptor.Accept(&ChannelAcceptRequest{
+		Node:            key.PubKey(),
+		OpenChanMsg:     &lnwire.OpenChannel{},
+		NumPendingChans: 10,
+	})
+	require.False(t, resp.RejectChannel())
+}
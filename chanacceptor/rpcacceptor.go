@@ -43,6 +43,17 @@ var (
 	errMaxHtlcTooHigh = fmt.Errorf("htlc limit exceeds spec limit of: %v",
 		input.MaxHTLCNumber/2)
 
+	// errZeroConfMinDepth is returned when we get a response which
+	// requests a zero-conf channel but also sets a non-zero min depth.
+	errZeroConfMinDepth = errors.New("zero-conf channels require a " +
+		"min depth of zero")
+
+	// errFundingNotDualFunded is returned when we get a response which
+	// contributes funds to a channel that wasn't opened with the
+	// dual-funding protocol.
+	errFundingNotDualFunded = errors.New("funding amount can only be " +
+		"set for dual-funded channels")
+
 	// maxErrorLength is the maximum error length we allow the error we
 	// send to our peer to be.
 	maxErrorLength = 500
@@ -108,6 +119,7 @@ func (r *RPCAcceptor) Accept(req *ChannelAcceptRequest) *ChannelAcceptResponse {
 	// reject the channel.
 	rejectChannel := NewChannelAcceptResponse(
 		false, errChannelRejected, nil, 0, 0, 0, 0, 0, 0,
+		false, 0,
 	)
 
 	// Send the request to the newRequests channel.
@@ -257,12 +269,29 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 			pendingChanID := req.OpenChanMsg.PendingChannelID
 
 			// Map the channel commitment type to its RPC
-			// counterpart.
-			var commitmentType lnrpc.CommitmentType
+			// counterpart. The zero-conf and scid-alias bits are
+			// reported separately, so we strip them before
+			// matching on the commitment type.
+			var (
+				commitmentType lnrpc.CommitmentType
+				wantsZeroConf  bool
+				wantsScidAlias bool
+			)
 			if req.OpenChanMsg.ChannelType != nil {
 				channelFeatures := lnwire.RawFeatureVector(
 					*req.OpenChanMsg.ChannelType,
 				)
+				channelFeatures = *channelFeatures.Clone()
+
+				wantsZeroConf = channelFeatures.IsSet(
+					lnwire.ZeroConfRequired,
+				)
+				wantsScidAlias = channelFeatures.IsSet(
+					lnwire.ScidAliasRequired,
+				)
+				channelFeatures.Unset(lnwire.ZeroConfRequired)
+				channelFeatures.Unset(lnwire.ScidAliasRequired)
+
 				switch {
 				case channelFeatures.OnlyContains(
 					lnwire.ScriptEnforcedLeaseRequired,
@@ -310,6 +339,9 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				MaxAcceptedHtlcs: uint32(req.OpenChanMsg.MaxAcceptedHTLCs),
 				ChannelFlags:     uint32(req.OpenChanMsg.ChannelFlags),
 				CommitmentType:   commitmentType,
+				WantsZeroConf:    wantsZeroConf,
+				WantsScidAlias:   wantsScidAlias,
+				DualFunded:       req.DualFunded,
 			}
 
 			if err := r.send(chanAcceptReq); err != nil {
@@ -334,7 +366,8 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 			// valid, we log our error and proceed to deliver the
 			// rejection.
 			accept, acceptErr, shutdown, err := r.validateAcceptorResponse(
-				requestInfo.request.OpenChanMsg.DustLimit, resp,
+				requestInfo.request.OpenChanMsg.DustLimit,
+				requestInfo.request.DualFunded, resp,
 			)
 			if err != nil {
 				log.Errorf("Invalid acceptor response: %v", err)
@@ -348,6 +381,7 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				ltcutil.Amount(resp.ReserveSat),
 				lnwire.MilliSatoshi(resp.InFlightMaxMsat),
 				lnwire.MilliSatoshi(resp.MinHtlcIn),
+				resp.ZeroConf, ltcutil.Amount(resp.FundingAmt),
 			)
 
 			// Delete the channel from the acceptRequests map.
@@ -369,8 +403,8 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 // acceptor, returning a boolean indicating whether to accept the channel, an
 // error to send to the peer, and any validation errors that occurred.
 func (r *RPCAcceptor) validateAcceptorResponse(dustLimit ltcutil.Amount,
-	req *lnrpc.ChannelAcceptResponse) (bool, error, lnwire.DeliveryAddress,
-	error) {
+	dualFunded bool, req *lnrpc.ChannelAcceptResponse) (bool, error,
+	lnwire.DeliveryAddress, error) {
 
 	channelStr := hex.EncodeToString(req.PendingChanId)
 
@@ -397,6 +431,24 @@ func (r *RPCAcceptor) validateAcceptorResponse(dustLimit ltcutil.Amount,
 		return false, errChannelRejected, nil, errInsufficientReserve
 	}
 
+	// A zero-conf channel is one that can be used before the funding
+	// transaction confirms, so any other min depth is contradictory.
+	if req.ZeroConf && req.MinAcceptDepth != 0 {
+		log.Errorf("Zero-conf channel: %v requested with min accept "+
+			"depth: %v", channelStr, req.MinAcceptDepth)
+
+		return false, errChannelRejected, nil, errZeroConfMinDepth
+	}
+
+	// Only the dual-funding protocol allows us to contribute to the
+	// funding output of an inbound channel.
+	if req.FundingAmt != 0 && !dualFunded {
+		log.Errorf("Funding amount: %v set for channel: %v which "+
+			"isn't dual-funded", req.FundingAmt, channelStr)
+
+		return false, errChannelRejected, nil, errFundingNotDualFunded
+	}
+
 	// Attempt to parse the upfront shutdown address provided.
 	upfront, err := chancloser.ParseUpfrontShutdownAddress(
 		req.UpfrontShutdown, r.params,
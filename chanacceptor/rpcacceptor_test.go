@@ -27,6 +27,7 @@ func TestValidateAcceptorResponse(t *testing.T) {
 	tests := []struct {
 		name        string
 		dustLimit   ltcutil.Amount
+		dualFunded  bool
 		response    *lnrpc.ChannelAcceptResponse
 		accept      bool
 		acceptorErr error
@@ -115,6 +116,36 @@ func TestValidateAcceptorResponse(t *testing.T) {
 			acceptorErr: errChannelRejected,
 			error:       errMaxHtlcTooHigh,
 		},
+		{
+			name: "zero conf with min depth",
+			response: &lnrpc.ChannelAcceptResponse{
+				Accept:         true,
+				ZeroConf:       true,
+				MinAcceptDepth: 1,
+			},
+			accept:      false,
+			acceptorErr: errChannelRejected,
+			error:       errZeroConfMinDepth,
+		},
+		{
+			name: "funding amount for single-funded channel",
+			response: &lnrpc.ChannelAcceptResponse{
+				Accept:     true,
+				FundingAmt: 100_000,
+			},
+			accept:      false,
+			acceptorErr: errChannelRejected,
+			error:       errFundingNotDualFunded,
+		},
+		{
+			name:       "funding amount for dual-funded channel",
+			dualFunded: true,
+			response: &lnrpc.ChannelAcceptResponse{
+				Accept:     true,
+				FundingAmt: 100_000,
+			},
+			accept: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -128,7 +159,7 @@ func TestValidateAcceptorResponse(t *testing.T) {
 			)
 
 			accept, acceptErr, shutdown, err := acceptor.validateAcceptorResponse(
-				test.dustLimit, test.response,
+				test.dustLimit, test.dualFunded, test.response,
 			)
 			require.Equal(t, test.accept, accept)
 			require.Equal(t, test.acceptorErr, acceptErr)
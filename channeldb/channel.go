@@ -198,6 +198,14 @@ const (
 	// A tlv type definition used to serialize and deserialize a KeyLocator
 	// from the database.
 	keyLocType tlv.Type = 1
+
+	// A tlv type used to serialize and deserialize the confirmed
+	// ShortChannelID for a zero-conf channel.
+	realScidType tlv.Type = 2
+
+	// A tlv type used to serialize and deserialize the funding outpoint
+	// of the last splice of a channel.
+	spliceOutpointType tlv.Type = 3
 )
 
 // indexStatus is an enum-like type that describes what state the
@@ -219,7 +227,7 @@ const (
 // fee negotiation, channel closing, the format of HTLCs, etc. Structure-wise,
 // a ChannelType is a bit field, with each bit denoting a modification from the
 // base channel type of single funder.
-type ChannelType uint8
+type ChannelType uint64
 
 const (
 	// NOTE: iota isn't used here for this enum needs to be stable
@@ -262,6 +270,18 @@ const (
 	// period of time, constraining every output that pays to the channel
 	// initiator with an additional CLTV of the lease maturity.
 	LeaseExpirationBit ChannelType = 1 << 6
+
+	// ZeroConfBit indicates that the channel is a zero-conf channel, and
+	// may be used before its funding transaction has confirmed.
+	ZeroConfBit ChannelType = 1 << 7
+
+	// ScidAliasChanBit indicates that the channel has negotiated the
+	// scid-alias channel type.
+	ScidAliasChanBit ChannelType = 1 << 8
+
+	// ScidAliasFeatureBit indicates that the scid-alias feature bit was
+	// negotiated during the lifetime of this channel.
+	ScidAliasFeatureBit ChannelType = 1 << 9
 )
 
 // IsSingleFunder returns true if the channel type if one of the known single
@@ -311,6 +331,22 @@ func (c ChannelType) HasLeaseExpiration() bool {
 	return c&LeaseExpirationBit == LeaseExpirationBit
 }
 
+// HasZeroConf returns true if the channel is a zero-conf channel.
+func (c ChannelType) HasZeroConf() bool {
+	return c&ZeroConfBit == ZeroConfBit
+}
+
+// HasScidAliasChan returns true if the scid-alias channel type was negotiated.
+func (c ChannelType) HasScidAliasChan() bool {
+	return c&ScidAliasChanBit == ScidAliasChanBit
+}
+
+// HasScidAliasFeature returns true if the scid-alias feature bit was
+// negotiated during the lifetime of this channel.
+func (c ChannelType) HasScidAliasFeature() bool {
+	return c&ScidAliasFeatureBit == ScidAliasFeatureBit
+}
+
 // ChannelConstraints represents a set of constraints meant to allow a node to
 // limit their exposure, enact flow control and ensure that all HTLCs are
 // economically relevant. This struct will be mirrored for both sides of the
@@ -478,6 +514,12 @@ type ChannelCommitment struct {
 	// commitment height.
 	Htlcs []HTLC
 
+	// SpliceCommitment is the version of this commitment that spends the
+	// funding output of a pending splice, if there is one. It isn't
+	// serialized along with the commitment, but written to the
+	// ChannelSplice of the channel when the commitment is persisted.
+	SpliceCommitment *ChannelCommitment
+
 	// TODO(roasbeef): pending commit pointer?
 	//  * lets just walk through
 }
@@ -738,6 +780,18 @@ type OpenChannel struct {
 	// have private key isolation from lnd.
 	RevocationKeyLocator keychain.KeyLocator
 
+	// confirmedScid is the confirmed ShortChannelID for a zero-conf
+	// channel. If the channel is unconfirmed, then this will be the
+	// default ShortChannelID. This is only set for zero-conf channels.
+	confirmedScid lnwire.ShortChannelID
+
+	// SpliceOutpoint is the funding outpoint created by the last splice
+	// of the channel that was locked in by both parties. The channel
+	// remains identified by its original FundingOutpoint, but its
+	// commitments spend this outpoint instead. It's zero if the channel
+	// was never spliced.
+	SpliceOutpoint wire.OutPoint
+
 	// TODO(roasbeef): eww
 	Db *ChannelStateDB
 
@@ -754,6 +808,70 @@ func (c *OpenChannel) ShortChanID() lnwire.ShortChannelID {
 	return c.ShortChannelID
 }
 
+// ActiveFundingOutpoint returns the outpoint that the commitments of the
+// channel currently spend. This is the outpoint of the last splice if the
+// channel was spliced, and the original funding outpoint otherwise.
+func (c *OpenChannel) ActiveFundingOutpoint() wire.OutPoint {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.activeFundingOutpoint()
+}
+
+// activeFundingOutpoint is the internal version of ActiveFundingOutpoint. It
+// expects the caller to hold the channel's mutex.
+func (c *OpenChannel) activeFundingOutpoint() wire.OutPoint {
+	if c.SpliceOutpoint != (wire.OutPoint{}) {
+		return c.SpliceOutpoint
+	}
+
+	return c.FundingOutpoint
+}
+
+// ZeroConfRealScid returns the zero-conf channel's confirmed scid. This should
+// only be called if IsZeroConf returns true.
+func (c *OpenChannel) ZeroConfRealScid() lnwire.ShortChannelID {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.confirmedScid
+}
+
+// ZeroConfConfirmed returns whether the zero-conf channel has confirmed. This
+// should only be called if IsZeroConf returns true.
+func (c *OpenChannel) ZeroConfConfirmed() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.confirmedScid != lnwire.ShortChannelID{}
+}
+
+// IsZeroConf returns whether the option_zeroconf channel type was negotiated.
+func (c *OpenChannel) IsZeroConf() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.ChanType.HasZeroConf()
+}
+
+// IsOptionScidAlias returns whether the option_scid_alias channel type was
+// negotiated.
+func (c *OpenChannel) IsOptionScidAlias() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.ChanType.HasScidAliasChan()
+}
+
+// NegotiatedAliasFeature returns whether the option-scid-alias feature bit was
+// negotiated.
+func (c *OpenChannel) NegotiatedAliasFeature() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.ChanType.HasScidAliasFeature()
+}
+
 // ChanStatus returns the current ChannelStatus of this channel.
 func (c *OpenChannel) ChanStatus() ChannelStatus {
 	c.RLock()
@@ -1033,6 +1151,39 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 	return nil
 }
 
+// MarkRealScid marks the zero-conf channel's confirmed ShortChannelID. This
+// should only be done if IsZeroConf returns true.
+func (c *OpenChannel) MarkRealScid(realScid lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(
+			chanBucket, &c.FundingOutpoint,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel.confirmedScid = realScid
+
+		return putOpenChannel(chanBucket, channel)
+	}, func() {}); err != nil {
+		return err
+	}
+
+	c.confirmedScid = realScid
+
+	return nil
+}
+
 // MarkDataLoss marks sets the channel status to LocalDataLoss and stores the
 // passed commitPoint for use to retrieve funds in case the remote force closes
 // the channel.
@@ -1574,6 +1725,17 @@ func (c *OpenChannel) UpdateCommitment(newCommitment *ChannelCommitment,
 				"revocations: %v", err)
 		}
 
+		// If the channel has a pending splice, the splice version of
+		// the commitment is stored along with it.
+		if newCommitment.SpliceCommitment != nil {
+			splice := newCommitment.SpliceCommitment
+			err := putSpliceCommitment(chanBucket, splice, true)
+			if err != nil {
+				return fmt.Errorf("unable to store splice "+
+					"commitment: %v", err)
+			}
+		}
+
 		// Persist unsigned but acked remote updates that need to be
 		// restored after a restart.
 		var b bytes.Buffer
@@ -1747,6 +1909,10 @@ type HTLC struct {
 	// routing.
 	OnionBlob []byte
 
+	// BlindingPoint is the route blinding point that was sent along with
+	// the HTLC, if it is part of a blinded route.
+	BlindingPoint *btcec.PublicKey
+
 	// HtlcIndex is the HTLC counter index of this active, outstanding
 	// HTLC. This differs from the LogIndex, as the HtlcIndex is only
 	// incremented for each offered HTLC, while they LogIndex is
@@ -1771,9 +1937,33 @@ func SerializeHtlcs(b io.Writer, htlcs ...HTLC) error {
 	}
 
 	for _, htlc := range htlcs {
+		// The blinding point, if any, is appended to the onion blob
+		// as a TLV stream so that the on-disk format of regular HTLCs
+		// doesn't change.
+		onionAndExtraData := htlc.OnionBlob[:]
+		if htlc.BlindingPoint != nil {
+			var extraData lnwire.ExtraOpaqueData
+			err := extraData.PackRecords(
+				&lnwire.BlindingPoint{PublicKey: htlc.BlindingPoint},
+			)
+			if err != nil {
+				return err
+			}
+
+			onionAndExtraData = make(
+				[]byte, 0, len(htlc.OnionBlob)+len(extraData),
+			)
+			onionAndExtraData = append(
+				onionAndExtraData, htlc.OnionBlob...,
+			)
+			onionAndExtraData = append(
+				onionAndExtraData, extraData...,
+			)
+		}
+
 		if err := WriteElements(b,
 			htlc.Signature, htlc.RHash, htlc.Amt, htlc.RefundTimeout,
-			htlc.OutputIndex, htlc.Incoming, htlc.OnionBlob[:],
+			htlc.OutputIndex, htlc.Incoming, onionAndExtraData,
 			htlc.HtlcIndex, htlc.LogIndex,
 		); err != nil {
 			return err
@@ -1810,6 +2000,28 @@ func DeserializeHtlcs(r io.Reader) ([]HTLC, error) {
 		); err != nil {
 			return htlcs, err
 		}
+
+		// Any data following the onion packet holds the additional
+		// records that were sent along with the HTLC.
+		if len(htlcs[i].OnionBlob) <= lnwire.OnionPacketSize {
+			continue
+		}
+
+		extraData := lnwire.ExtraOpaqueData(
+			htlcs[i].OnionBlob[lnwire.OnionPacketSize:],
+		)
+		htlcs[i].OnionBlob = htlcs[i].OnionBlob[:lnwire.OnionPacketSize]
+
+		var blindingPoint lnwire.BlindingPoint
+		typeMap, err := extraData.ExtractRecords(&blindingPoint)
+		if err != nil {
+			return htlcs, err
+		}
+
+		val, ok := typeMap[lnwire.BlindingPointRecordType]
+		if ok && val == nil {
+			htlcs[i].BlindingPoint = blindingPoint.PublicKey
+		}
 	}
 
 	return htlcs, nil
@@ -1822,6 +2034,7 @@ func (h *HTLC) Copy() HTLC {
 		Amt:           h.Amt,
 		RefundTimeout: h.RefundTimeout,
 		OutputIndex:   h.OutputIndex,
+		BlindingPoint: h.BlindingPoint,
 	}
 	copy(clone.Signature[:], h.Signature)
 	copy(clone.RHash[:], h.RHash[:])
@@ -2192,7 +2405,19 @@ func (c *OpenChannel) AppendRemoteCommitChain(diff *CommitDiff) error {
 		if err := serializeCommitDiff(&b2, diff); err != nil {
 			return err
 		}
-		return chanBucket.Put(commitDiffKey, b2.Bytes())
+		err = chanBucket.Put(commitDiffKey, b2.Bytes())
+		if err != nil {
+			return err
+		}
+
+		// The splice version of the new commitment, if any, isn't
+		// part of the commit diff, so it's stored separately.
+		spliceCommit := diff.Commitment.SpliceCommitment
+		if spliceCommit == nil {
+			return nil
+		}
+
+		return putSpliceCommitment(chanBucket, spliceCommit, false)
 	}, func() {})
 }
 
@@ -2227,6 +2452,18 @@ func (c *OpenChannel) RemoteCommitChainTip() (*CommitDiff, error) {
 			return err
 		}
 
+		// Attach the splice version of the pending commitment if
+		// there is one.
+		splice, err := fetchChanSplice(chanBucket)
+		switch {
+		case err == nil:
+			dcd.Commitment.SpliceCommitment =
+				splice.RemotePendingCommitment
+
+		case err != ErrNoSplice:
+			return err
+		}
+
 		cd = dcd
 		return nil
 	}, func() {
@@ -2428,6 +2665,28 @@ func (c *OpenChannel) AdvanceCommitChainTail(fwdPkg *FwdPkg,
 			return err
 		}
 
+		// If the channel has a pending splice, the splice version of
+		// the revoked state is logged as well. Once the splice
+		// transaction confirmed, it replaces the original version, as
+		// that one can no longer be broadcast.
+		if chanBucket.Get(spliceKey) != nil {
+			splice, revoked, err := advanceSpliceCommitChainTail(
+				chanBucket,
+			)
+			if err != nil {
+				return err
+			}
+			newCommit.Commitment.SpliceCommitment =
+				&splice.RemoteCommitment
+
+			err = logRevokedSpliceCommitment(
+				chanBucket, logBucket, splice, revoked,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Lastly, we write the forwarding package to disk so that we
 		// can properly recover from failures and reforward HTLCs that
 		// have not received a corresponding settle/fail.
@@ -3347,12 +3606,24 @@ func putChanInfo(chanBucket kvdb.RwBucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// Write the RevocationKeyLocator as the first entry in a tlv stream.
+	// Write the RevocationKeyLocator as the first entry in a tlv stream,
+	// followed by the confirmed scid of a zero-conf channel and the
+	// outpoint of the last splice.
 	keyLocRecord := MakeKeyLocRecord(
 		keyLocType, &channel.RevocationKeyLocator,
 	)
 
-	tlvStream, err := tlv.NewStream(keyLocRecord)
+	tlvStream, err := tlv.NewStream(
+		keyLocRecord,
+		tlv.MakeStaticRecord(
+			realScidType, &channel.confirmedScid, 8,
+			lnwire.EShortChannelID, lnwire.DShortChannelID,
+		),
+		tlv.MakeStaticRecord(
+			spliceOutpointType, &channel.SpliceOutpoint, 36,
+			eOutPoint, dOutPoint,
+		),
+	)
 	if err != nil {
 		return err
 	}
@@ -3551,7 +3822,17 @@ func fetchChanInfo(chanBucket kvdb.RBucket, channel *OpenChannel) error {
 	}
 
 	keyLocRecord := MakeKeyLocRecord(keyLocType, &channel.RevocationKeyLocator)
-	tlvStream, err := tlv.NewStream(keyLocRecord)
+	tlvStream, err := tlv.NewStream(
+		keyLocRecord,
+		tlv.MakeStaticRecord(
+			realScidType, &channel.confirmedScid, 8,
+			lnwire.EShortChannelID, lnwire.DShortChannelID,
+		),
+		tlv.MakeStaticRecord(
+			spliceOutpointType, &channel.SpliceOutpoint, 36,
+			eOutPoint, dOutPoint,
+		),
+	)
 	if err != nil {
 		return err
 	}
@@ -3629,7 +3910,7 @@ func fetchChanCommitments(chanBucket kvdb.RBucket, channel *OpenChannel) error {
 		return err
 	}
 
-	return nil
+	return attachSpliceCommitments(chanBucket, channel)
 }
 
 func fetchChanRevocationState(chanBucket kvdb.RBucket, channel *OpenChannel) error {
@@ -3777,3 +4058,19 @@ func DKeyLocator(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
 func MakeKeyLocRecord(typ tlv.Type, keyLoc *keychain.KeyLocator) tlv.Record {
 	return tlv.MakeStaticRecord(typ, keyLoc, 8, EKeyLocator, DKeyLocator)
 }
+
+// eOutPoint is an encoder for wire.OutPoint.
+func eOutPoint(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*wire.OutPoint); ok {
+		return writeOutpoint(w, v)
+	}
+	return tlv.NewTypeForEncodingErr(val, "wire.OutPoint")
+}
+
+// dOutPoint is a decoder for wire.OutPoint.
+func dOutPoint(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if v, ok := val.(*wire.OutPoint); ok && l == 36 {
+		return readOutpoint(r, v)
+	}
+	return tlv.NewTypeForDecodingErr(val, "wire.OutPoint", l, 36)
+}
@@ -326,6 +326,7 @@ var dbTopLevelBuckets = [][]byte{
 	closeSummaryBucket,
 	outpointBucket,
 	historicalChannelBucket,
+	offerBucket,
 }
 
 // Wipe completely deletes all saved state within all used buckets within the
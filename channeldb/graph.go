@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ltcsuite/lnd/aliasmgr"
 	"github.com/ltcsuite/lnd/batch"
 	"github.com/ltcsuite/lnd/kvdb"
 	"github.com/ltcsuite/lnd/lnwire"
@@ -535,6 +536,20 @@ func (c *ChannelGraph) FetchNodeFeatures(
 	}
 }
 
+// SetCachedNodeFeatures sets the features of a node within the graph cache
+// only, without persisting them. This allows path finding to make use of the
+// features of nodes we learned about from a trusted source, but don't have a
+// signed announcement of. If the graph cache is disabled, this is a no-op.
+func (c *ChannelGraph) SetCachedNodeFeatures(node route.Vertex,
+	features *lnwire.FeatureVector) {
+
+	if c.graphCache == nil {
+		return
+	}
+
+	c.graphCache.AddNodeFeatures(newGraphCacheNode(node, features))
+}
+
 // ForEachNodeCached is similar to ForEachNode, but it utilizes the channel
 // graph cache instead. Note that this doesn't return all the information the
 // regular ForEachNode method does.
@@ -1574,6 +1589,13 @@ func (c *ChannelGraph) DisconnectBlockAtHeight(height uint32) ([]*ChannelEdgeInf
 		for k, v := cursor.Seek(chanIDStart[:]); k != nil &&
 			bytes.Compare(k, chanIDEnd[:]) <= 0; k, v = cursor.Next() {
 
+			// Edges keyed by an alias aren't tied to the block at
+			// the given height, so they're left untouched.
+			scid := lnwire.NewShortChanIDFromInt(byteOrder.Uint64(k))
+			if aliasmgr.IsAlias(scid) {
+				continue
+			}
+
 			edgeInfoReader := bytes.NewReader(v)
 			edgeInfo, err := deserializeChanEdgeInfo(edgeInfoReader)
 			if err != nil {
@@ -4024,10 +4046,66 @@ func (c *ChannelGraph) NumZombies() (uint64, error) {
 func putLightningNode(nodeBucket kvdb.RwBucket, aliasBucket kvdb.RwBucket, // nolint:dupl
 	updateIndex kvdb.RwBucket, node *LightningNode) error {
 
-	var (
-		scratch [16]byte
-		b       bytes.Buffer
-	)
+	var b bytes.Buffer
+	if err := serializeLightningNode(&b, node); err != nil {
+		return err
+	}
+
+	pub, err := node.PubKey()
+	if err != nil {
+		return err
+	}
+	nodePub := pub.SerializeCompressed()
+
+	// If we didn't get a node announcement for this node, there's no alias
+	// and update time to index.
+	if !node.HaveNodeAnnouncement {
+		return nodeBucket.Put(nodePub, b.Bytes())
+	}
+
+	// If the node has the update time set, index it, else index 0.
+	updateUnix := uint64(0)
+	if node.LastUpdate.Unix() > 0 {
+		updateUnix = uint64(node.LastUpdate.Unix())
+	}
+
+	if err := aliasBucket.Put(nodePub, []byte(node.Alias)); err != nil {
+		return err
+	}
+
+	// With the alias bucket updated, we'll now update the index that
+	// tracks the time series of node updates.
+	var indexKey [8 + 33]byte
+	byteOrder.PutUint64(indexKey[:8], updateUnix)
+	copy(indexKey[8:], nodePub)
+
+	// If there was already an old index entry for this node, then we'll
+	// delete the old one before we write the new entry.
+	if nodeBytes := nodeBucket.Get(nodePub); nodeBytes != nil {
+		// Extract out the old update time to we can reconstruct the
+		// prior index key to delete it from the index.
+		oldUpdateTime := nodeBytes[:8]
+
+		var oldIndexKey [8 + 33]byte
+		copy(oldIndexKey[:8], oldUpdateTime)
+		copy(oldIndexKey[8:], nodePub)
+
+		if err := updateIndex.Delete(oldIndexKey[:]); err != nil {
+			return err
+		}
+	}
+
+	if err := updateIndex.Put(indexKey[:], nil); err != nil {
+		return err
+	}
+
+	return nodeBucket.Put(nodePub, b.Bytes())
+}
+
+// serializeLightningNode writes the on-disk representation of the passed node
+// to w.
+func serializeLightningNode(w io.Writer, node *LightningNode) error {
+	var scratch [16]byte
 
 	pub, err := node.PubKey()
 	if err != nil {
@@ -4042,11 +4120,11 @@ func putLightningNode(nodeBucket kvdb.RwBucket, aliasBucket kvdb.RwBucket, // no
 	}
 
 	byteOrder.PutUint64(scratch[:8], updateUnix)
-	if _, err := b.Write(scratch[:8]); err != nil {
+	if _, err := w.Write(scratch[:8]); err != nil {
 		return err
 	}
 
-	if _, err := b.Write(nodePub); err != nil {
+	if _, err := w.Write(nodePub); err != nil {
 		return err
 	}
 
@@ -4055,45 +4133,43 @@ func putLightningNode(nodeBucket kvdb.RwBucket, aliasBucket kvdb.RwBucket, // no
 	if !node.HaveNodeAnnouncement {
 		// Write HaveNodeAnnouncement=0.
 		byteOrder.PutUint16(scratch[:2], 0)
-		if _, err := b.Write(scratch[:2]); err != nil {
-			return err
-		}
+		_, err := w.Write(scratch[:2])
 
-		return nodeBucket.Put(nodePub, b.Bytes())
+		return err
 	}
 
 	// Write HaveNodeAnnouncement=1.
 	byteOrder.PutUint16(scratch[:2], 1)
-	if _, err := b.Write(scratch[:2]); err != nil {
+	if _, err := w.Write(scratch[:2]); err != nil {
 		return err
 	}
 
-	if err := binary.Write(&b, byteOrder, node.Color.R); err != nil {
+	if err := binary.Write(w, byteOrder, node.Color.R); err != nil {
 		return err
 	}
-	if err := binary.Write(&b, byteOrder, node.Color.G); err != nil {
+	if err := binary.Write(w, byteOrder, node.Color.G); err != nil {
 		return err
 	}
-	if err := binary.Write(&b, byteOrder, node.Color.B); err != nil {
+	if err := binary.Write(w, byteOrder, node.Color.B); err != nil {
 		return err
 	}
 
-	if err := wire.WriteVarString(&b, 0, node.Alias); err != nil {
+	if err := wire.WriteVarString(w, 0, node.Alias); err != nil {
 		return err
 	}
 
-	if err := node.Features.Encode(&b); err != nil {
+	if err := node.Features.Encode(w); err != nil {
 		return err
 	}
 
 	numAddresses := uint16(len(node.Addresses))
 	byteOrder.PutUint16(scratch[:2], numAddresses)
-	if _, err := b.Write(scratch[:2]); err != nil {
+	if _, err := w.Write(scratch[:2]); err != nil {
 		return err
 	}
 
 	for _, address := range node.Addresses {
-		if err := serializeAddr(&b, address); err != nil {
+		if err := serializeAddr(w, address); err != nil {
 			return err
 		}
 	}
@@ -4104,7 +4180,7 @@ func putLightningNode(nodeBucket kvdb.RwBucket, aliasBucket kvdb.RwBucket, // no
 			sigLen)
 	}
 
-	err = wire.WriteVarBytes(&b, 0, node.AuthSigBytes)
+	err = wire.WriteVarBytes(w, 0, node.AuthSigBytes)
 	if err != nil {
 		return err
 	}
@@ -4112,42 +4188,8 @@ func putLightningNode(nodeBucket kvdb.RwBucket, aliasBucket kvdb.RwBucket, // no
 	if len(node.ExtraOpaqueData) > MaxAllowedExtraOpaqueBytes {
 		return ErrTooManyExtraOpaqueBytes(len(node.ExtraOpaqueData))
 	}
-	err = wire.WriteVarBytes(&b, 0, node.ExtraOpaqueData)
-	if err != nil {
-		return err
-	}
-
-	if err := aliasBucket.Put(nodePub, []byte(node.Alias)); err != nil {
-		return err
-	}
-
-	// With the alias bucket updated, we'll now update the index that
-	// tracks the time series of node updates.
-	var indexKey [8 + 33]byte
-	byteOrder.PutUint64(indexKey[:8], updateUnix)
-	copy(indexKey[8:], nodePub)
 
-	// If there was already an old index entry for this node, then we'll
-	// delete the old one before we write the new entry.
-	if nodeBytes := nodeBucket.Get(nodePub); nodeBytes != nil {
-		// Extract out the old update time to we can reconstruct the
-		// prior index key to delete it from the index.
-		oldUpdateTime := nodeBytes[:8]
-
-		var oldIndexKey [8 + 33]byte
-		copy(oldIndexKey[:8], oldUpdateTime)
-		copy(oldIndexKey[8:], nodePub)
-
-		if err := updateIndex.Delete(oldIndexKey[:]); err != nil {
-			return err
-		}
-	}
-
-	if err := updateIndex.Put(indexKey[:], nil); err != nil {
-		return err
-	}
-
-	return nodeBucket.Put(nodePub, b.Bytes())
+	return wire.WriteVarBytes(w, 0, node.ExtraOpaqueData)
 }
 
 func fetchLightningNode(nodeBucket kvdb.RBucket,
@@ -4316,21 +4358,32 @@ func deserializeLightningNode(r io.Reader) (LightningNode, error) {
 
 func putChanEdgeInfo(edgeIndex kvdb.RwBucket, edgeInfo *ChannelEdgeInfo, chanID [8]byte) error {
 	var b bytes.Buffer
+	if err := serializeChanEdgeInfo(&b, edgeInfo, chanID); err != nil {
+		return err
+	}
+
+	return edgeIndex.Put(chanID[:], b.Bytes())
+}
 
-	if _, err := b.Write(edgeInfo.NodeKey1Bytes[:]); err != nil {
+// serializeChanEdgeInfo writes the on-disk representation of the passed edge
+// info to w.
+func serializeChanEdgeInfo(w io.Writer, edgeInfo *ChannelEdgeInfo,
+	chanID [8]byte) error {
+
+	if _, err := w.Write(edgeInfo.NodeKey1Bytes[:]); err != nil {
 		return err
 	}
-	if _, err := b.Write(edgeInfo.NodeKey2Bytes[:]); err != nil {
+	if _, err := w.Write(edgeInfo.NodeKey2Bytes[:]); err != nil {
 		return err
 	}
-	if _, err := b.Write(edgeInfo.BitcoinKey1Bytes[:]); err != nil {
+	if _, err := w.Write(edgeInfo.BitcoinKey1Bytes[:]); err != nil {
 		return err
 	}
-	if _, err := b.Write(edgeInfo.BitcoinKey2Bytes[:]); err != nil {
+	if _, err := w.Write(edgeInfo.BitcoinKey2Bytes[:]); err != nil {
 		return err
 	}
 
-	if err := wire.WriteVarBytes(&b, 0, edgeInfo.Features); err != nil {
+	if err := wire.WriteVarBytes(w, 0, edgeInfo.Features); err != nil {
 		return err
 	}
 
@@ -4343,41 +4396,37 @@ func putChanEdgeInfo(edgeIndex kvdb.RwBucket, edgeInfo *ChannelEdgeInfo, chanID
 		bitcoinSig2 = authProof.BitcoinSig2Bytes
 	}
 
-	if err := wire.WriteVarBytes(&b, 0, nodeSig1); err != nil {
+	if err := wire.WriteVarBytes(w, 0, nodeSig1); err != nil {
 		return err
 	}
-	if err := wire.WriteVarBytes(&b, 0, nodeSig2); err != nil {
+	if err := wire.WriteVarBytes(w, 0, nodeSig2); err != nil {
 		return err
 	}
-	if err := wire.WriteVarBytes(&b, 0, bitcoinSig1); err != nil {
+	if err := wire.WriteVarBytes(w, 0, bitcoinSig1); err != nil {
 		return err
 	}
-	if err := wire.WriteVarBytes(&b, 0, bitcoinSig2); err != nil {
+	if err := wire.WriteVarBytes(w, 0, bitcoinSig2); err != nil {
 		return err
 	}
 
-	if err := writeOutpoint(&b, &edgeInfo.ChannelPoint); err != nil {
+	if err := writeOutpoint(w, &edgeInfo.ChannelPoint); err != nil {
 		return err
 	}
-	if err := binary.Write(&b, byteOrder, uint64(edgeInfo.Capacity)); err != nil {
+	if err := binary.Write(w, byteOrder, uint64(edgeInfo.Capacity)); err != nil {
 		return err
 	}
-	if _, err := b.Write(chanID[:]); err != nil {
+	if _, err := w.Write(chanID[:]); err != nil {
 		return err
 	}
-	if _, err := b.Write(edgeInfo.ChainHash[:]); err != nil {
+	if _, err := w.Write(edgeInfo.ChainHash[:]); err != nil {
 		return err
 	}
 
 	if len(edgeInfo.ExtraOpaqueData) > MaxAllowedExtraOpaqueBytes {
 		return ErrTooManyExtraOpaqueBytes(len(edgeInfo.ExtraOpaqueData))
 	}
-	err := wire.WriteVarBytes(&b, 0, edgeInfo.ExtraOpaqueData)
-	if err != nil {
-		return err
-	}
 
-	return edgeIndex.Put(chanID[:], b.Bytes())
+	return wire.WriteVarBytes(w, 0, edgeInfo.ExtraOpaqueData)
 }
 
 func fetchChanEdgeInfo(edgeIndex kvdb.RBucket,
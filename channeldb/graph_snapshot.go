@@ -0,0 +1,439 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/ltcsuite/lnd/kvdb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/route"
+)
+
+const (
+	// GraphSnapshotVersion is the version of the graph snapshot format that
+	// is written by ExportSnapshot.
+	GraphSnapshotVersion uint16 = 1
+
+	// maxSnapshotRecordLen is the maximum length of a single record within
+	// a graph snapshot, as the length is encoded in two bytes. Every record
+	// was received in a gossip message, so it stays well below that.
+	maxSnapshotRecordLen = math.MaxUint16
+)
+
+// graphSnapshotMagic is written at the start of every graph snapshot to
+// identify the format of the file.
+var graphSnapshotMagic = [4]byte{'l', 'n', 'g', 's'}
+
+var (
+	// ErrUnknownSnapshotFormat is returned when a graph snapshot doesn't
+	// start with the expected magic bytes.
+	ErrUnknownSnapshotFormat = errors.New("unknown graph snapshot format")
+
+	// ErrTruncatedSnapshot is returned when a graph snapshot ends before
+	// its end record.
+	ErrTruncatedSnapshot = errors.New("graph snapshot is truncated")
+)
+
+// ErrUnknownSnapshotVersion is returned when a graph snapshot was written with
+// a version of the format we don't know.
+type ErrUnknownSnapshotVersion uint16
+
+// Error returns a human readable string describing the error.
+func (e ErrUnknownSnapshotVersion) Error() string {
+	return fmt.Sprintf("unknown graph snapshot version %d", uint16(e))
+}
+
+// snapshotRecordType denotes the type of a record within a graph snapshot.
+type snapshotRecordType uint8
+
+const (
+	// snapshotEndRecord marks the end of a snapshot. It doesn't carry any
+	// data, but allows a truncated snapshot to be detected.
+	snapshotEndRecord snapshotRecordType = 0
+
+	// snapshotChannelRecord is a ChannelEdgeInfo, including its
+	// authentication proof.
+	snapshotChannelRecord snapshotRecordType = 1
+
+	// snapshotPolicyRecord is a ChannelEdgePolicy of the channel that was
+	// most recently written to the snapshot.
+	snapshotPolicyRecord snapshotRecordType = 2
+
+	// snapshotNodeRecord is a LightningNode that was announced.
+	snapshotNodeRecord snapshotRecordType = 3
+)
+
+// GraphSnapshotStats summarizes the records that were written to a graph
+// snapshot, or added to the graph when importing one.
+type GraphSnapshotStats struct {
+	// NumNodes is the number of node announcements.
+	NumNodes int
+
+	// NumChannels is the number of channels.
+	NumChannels int
+
+	// NumPolicies is the number of channel policies.
+	NumPolicies int
+}
+
+// GraphSnapshotValidator verifies the records of a graph snapshot before they
+// are added to the graph. As the snapshot was possibly created by somebody
+// else, every record needs to carry a valid signature of its announcer.
+type GraphSnapshotValidator interface {
+	// ValidateChannel checks the authentication proof of a channel.
+	ValidateChannel(info *ChannelEdgeInfo) error
+
+	// ValidatePolicy checks that the policy was signed by the node of the
+	// channel it belongs to.
+	ValidatePolicy(info *ChannelEdgeInfo, policy *ChannelEdgePolicy) error
+
+	// ValidateNode checks the signature of a node announcement.
+	ValidateNode(node *LightningNode) error
+}
+
+// ExportSnapshot writes all public channels, their policies and the
+// announcements of the nodes of those channels to w, in a format that can be
+// read by ImportSnapshot. Private channels and everything we didn't receive a
+// signature for are left out, so that the snapshot can be verified by whoever
+// imports it.
+//
+// The snapshot starts with the magic bytes and a version, followed by a list
+// of records. Each record consists of its type, the length of the data and
+// the data itself, which uses the same encoding as the graph buckets.
+func (c *ChannelGraph) ExportSnapshot(w io.Writer) (*GraphSnapshotStats,
+	error) {
+
+	if _, err := w.Write(graphSnapshotMagic[:]); err != nil {
+		return nil, err
+	}
+
+	var scratch [2]byte
+	byteOrder.PutUint16(scratch[:], GraphSnapshotVersion)
+	if _, err := w.Write(scratch[:]); err != nil {
+		return nil, err
+	}
+
+	var (
+		stats    GraphSnapshotStats
+		nodeKeys = make(map[route.Vertex]struct{})
+		b        bytes.Buffer
+	)
+	err := c.ForEachChannel(func(info *ChannelEdgeInfo, p1,
+		p2 *ChannelEdgePolicy) error {
+
+		// Only channels that were announced can be verified.
+		if info.AuthProof == nil {
+			return nil
+		}
+
+		var chanID [8]byte
+		byteOrder.PutUint64(chanID[:], info.ChannelID)
+
+		b.Reset()
+		if err := serializeChanEdgeInfo(&b, info, chanID); err != nil {
+			return err
+		}
+		err := writeSnapshotRecord(w, snapshotChannelRecord, b.Bytes())
+		if err != nil {
+			return err
+		}
+		stats.NumChannels++
+
+		nodeKeys[info.NodeKey1Bytes] = struct{}{}
+		nodeKeys[info.NodeKey2Bytes] = struct{}{}
+
+		for _, policy := range []*ChannelEdgePolicy{p1, p2} {
+			if policy == nil || len(policy.SigBytes) == 0 {
+				continue
+			}
+
+			b.Reset()
+			err := serializeChanEdgePolicy(
+				&b, policy, policy.Node.PubKeyBytes[:],
+			)
+			if err != nil {
+				return err
+			}
+			err = writeSnapshotRecord(
+				w, snapshotPolicyRecord, b.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+			stats.NumPolicies++
+		}
+
+		return nil
+	})
+	if err != nil && err != ErrGraphNoEdgesFound {
+		return nil, err
+	}
+
+	err = c.ForEachNode(func(_ kvdb.RTx, node *LightningNode) error {
+		if _, ok := nodeKeys[node.PubKeyBytes]; !ok {
+			return nil
+		}
+		if !node.HaveNodeAnnouncement || len(node.AuthSigBytes) == 0 {
+			return nil
+		}
+
+		b.Reset()
+		if err := serializeLightningNode(&b, node); err != nil {
+			return err
+		}
+		err := writeSnapshotRecord(w, snapshotNodeRecord, b.Bytes())
+		if err != nil {
+			return err
+		}
+		stats.NumNodes++
+
+		return nil
+	})
+	if err != nil && err != ErrGraphNotFound {
+		return nil, err
+	}
+
+	if err := writeSnapshotRecord(w, snapshotEndRecord, nil); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// ImportSnapshot reads a graph snapshot written by ExportSnapshot and adds the
+// channels, policies and nodes that are new or more recent than what we know
+// to the graph. The whole snapshot is read and every record is checked by the
+// passed validator before anything is written, so that the graph is left
+// untouched if the snapshot is invalid. The edge points of the channels that
+// were added are returned, so that the caller can watch them for closure.
+//
+// NOTE: The funding outputs of the channels aren't checked against the chain.
+func (c *ChannelGraph) ImportSnapshot(r io.Reader,
+	validator GraphSnapshotValidator) (*GraphSnapshotStats, []EdgePoint,
+	error) {
+
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, nil, ErrUnknownSnapshotFormat
+	}
+	if magic != graphSnapshotMagic {
+		return nil, nil, ErrUnknownSnapshotFormat
+	}
+
+	var scratch [2]byte
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return nil, nil, ErrTruncatedSnapshot
+	}
+	version := byteOrder.Uint16(scratch[:])
+	if version != GraphSnapshotVersion {
+		return nil, nil, ErrUnknownSnapshotVersion(version)
+	}
+
+	var (
+		channels []*ChannelEdgeInfo
+		policies []*ChannelEdgePolicy
+		nodes    []*LightningNode
+		lastChan *ChannelEdgeInfo
+	)
+	for {
+		recordType, data, err := readSnapshotRecord(r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if recordType == snapshotEndRecord {
+			break
+		}
+
+		switch recordType {
+		case snapshotChannelRecord:
+			info, err := deserializeChanEdgeInfo(
+				bytes.NewReader(data),
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := validator.ValidateChannel(&info); err != nil {
+				return nil, nil, fmt.Errorf("invalid channel "+
+					"%v: %v", info.ChannelID, err)
+			}
+
+			lastChan = &info
+			channels = append(channels, &info)
+
+		case snapshotPolicyRecord:
+			policy, err := deserializeChanEdgePolicyRaw(
+				bytes.NewReader(data),
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// Policies directly follow the channel they belong
+			// to, which is needed to verify their signature.
+			if lastChan == nil ||
+				lastChan.ChannelID != policy.ChannelID {
+
+				return nil, nil, fmt.Errorf("policy of "+
+					"channel %v doesn't follow its channel",
+					policy.ChannelID)
+			}
+
+			err = validator.ValidatePolicy(lastChan, policy)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid policy "+
+					"of channel %v: %v", policy.ChannelID,
+					err)
+			}
+
+			policies = append(policies, policy)
+
+		case snapshotNodeRecord:
+			node, err := deserializeLightningNode(
+				bytes.NewReader(data),
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := validator.ValidateNode(&node); err != nil {
+				return nil, nil, fmt.Errorf("invalid node "+
+					"%x: %v", node.PubKeyBytes, err)
+			}
+
+			nodes = append(nodes, &node)
+
+		// Records of unknown types are skipped, so that later
+		// versions of the format can add optional records.
+		default:
+		}
+	}
+
+	var (
+		stats      GraphSnapshotStats
+		edgePoints []EdgePoint
+	)
+	for _, info := range channels {
+		_, _, exists, isZombie, err := c.HasChannelEdge(info.ChannelID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// We don't resurrect channels that we already know to be
+		// zombies.
+		if exists || isZombie {
+			continue
+		}
+
+		err = c.AddChannelEdge(info)
+		switch {
+		case err == ErrEdgeAlreadyExist:
+			continue
+
+		case err != nil:
+			return nil, nil, err
+		}
+
+		pkScript, err := genMultiSigP2WSH(
+			info.BitcoinKey1Bytes[:], info.BitcoinKey2Bytes[:],
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		edgePoints = append(edgePoints, EdgePoint{
+			FundingPkScript: pkScript,
+			OutPoint:        info.ChannelPoint,
+		})
+		stats.NumChannels++
+	}
+
+	for _, policy := range policies {
+		upd1Time, upd2Time, exists, _, err := c.HasChannelEdge(
+			policy.ChannelID,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !exists {
+			continue
+		}
+
+		lastUpdate := upd1Time
+		if policy.ChannelFlags&lnwire.ChanUpdateDirection != 0 {
+			lastUpdate = upd2Time
+		}
+		if !policy.LastUpdate.After(lastUpdate) {
+			continue
+		}
+
+		err = c.UpdateEdgePolicy(policy)
+		switch {
+		case err == ErrEdgeNotFound:
+			continue
+
+		case err != nil:
+			return nil, nil, err
+		}
+		stats.NumPolicies++
+	}
+
+	for _, node := range nodes {
+		lastUpdate, exists, err := c.HasLightningNode(node.PubKeyBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// We only add the node if we know one of its channels by now,
+		// which also prevents adding announcements of nodes that only
+		// have channels we consider to be zombies.
+		if !exists || !node.LastUpdate.After(lastUpdate) {
+			continue
+		}
+
+		if err := c.AddLightningNode(node); err != nil {
+			return nil, nil, err
+		}
+		stats.NumNodes++
+	}
+
+	return &stats, edgePoints, nil
+}
+
+// writeSnapshotRecord writes a single record of the given type to a graph
+// snapshot.
+func writeSnapshotRecord(w io.Writer, recordType snapshotRecordType,
+	data []byte) error {
+
+	if len(data) > maxSnapshotRecordLen {
+		return fmt.Errorf("snapshot record of %d bytes exceeds "+
+			"maximum of %d bytes", len(data), maxSnapshotRecordLen)
+	}
+
+	var header [3]byte
+	header[0] = byte(recordType)
+	byteOrder.PutUint16(header[1:], uint16(len(data)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(data)
+	return err
+}
+
+// readSnapshotRecord reads the next record of a graph snapshot.
+func readSnapshotRecord(r io.Reader) (snapshotRecordType, []byte, error) {
+	var header [3]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, ErrTruncatedSnapshot
+	}
+
+	recordType := snapshotRecordType(header[0])
+	data := make([]byte, byteOrder.Uint16(header[1:]))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return 0, nil, ErrTruncatedSnapshot
+	}
+
+	return recordType, data, nil
+}
@@ -0,0 +1,222 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockSnapshotValidator is a GraphSnapshotValidator that accepts all records,
+// unless it is told to fail.
+type mockSnapshotValidator struct {
+	err error
+}
+
+func (m *mockSnapshotValidator) ValidateChannel(*ChannelEdgeInfo) error {
+	return m.err
+}
+
+func (m *mockSnapshotValidator) ValidatePolicy(*ChannelEdgeInfo,
+	*ChannelEdgePolicy) error {
+
+	return m.err
+}
+
+func (m *mockSnapshotValidator) ValidateNode(*LightningNode) error {
+	return m.err
+}
+
+// createSnapshotTestGraph creates a graph with two announced nodes and a
+// channel between them that has both of its policies set.
+func createSnapshotTestGraph(t *testing.T) (*ChannelGraph, *ChannelEdgeInfo,
+	*LightningNode, *LightningNode, func()) {
+
+	graph, cleanUp, err := MakeTestGraph()
+	require.NoError(t, err)
+
+	node1, err := createTestVertex(graph.db)
+	require.NoError(t, err)
+	require.NoError(t, graph.AddLightningNode(node1))
+
+	node2, err := createTestVertex(graph.db)
+	require.NoError(t, err)
+	require.NoError(t, graph.AddLightningNode(node2))
+
+	edgeInfo, edge1, edge2 := createChannelEdge(graph.db, node1, node2)
+	require.NoError(t, graph.AddChannelEdge(edgeInfo))
+	require.NoError(t, graph.UpdateEdgePolicy(edge1))
+	require.NoError(t, graph.UpdateEdgePolicy(edge2))
+
+	return graph, edgeInfo, node1, node2, cleanUp
+}
+
+// TestGraphSnapshotRoundTrip asserts that the channels, policies and nodes of
+// an exported graph snapshot are added to an empty graph on import, and that
+// importing the same snapshot again doesn't change anything.
+func TestGraphSnapshotRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	graph, edgeInfo, node1, node2, cleanUp := createSnapshotTestGraph(t)
+	defer cleanUp()
+
+	// A channel without an authentication proof can't be verified by the
+	// importer, so it shouldn't be exported.
+	privateNode, err := createTestVertex(graph.db)
+	require.NoError(t, err)
+	privateEdge, _ := createEdge(100, 1, 0, 1, node1, privateNode)
+	privateEdge.AuthProof = nil
+	require.NoError(t, graph.AddChannelEdge(&privateEdge))
+
+	var snapshot bytes.Buffer
+	stats, err := graph.ExportSnapshot(&snapshot)
+	require.NoError(t, err)
+	require.Equal(t, &GraphSnapshotStats{
+		NumNodes:    2,
+		NumChannels: 1,
+		NumPolicies: 2,
+	}, stats)
+
+	newGraph, newCleanUp, err := MakeTestGraph()
+	require.NoError(t, err)
+	defer newCleanUp()
+
+	stats, edgePoints, err := newGraph.ImportSnapshot(
+		bytes.NewReader(snapshot.Bytes()), &mockSnapshotValidator{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &GraphSnapshotStats{
+		NumNodes:    2,
+		NumChannels: 1,
+		NumPolicies: 2,
+	}, stats)
+	require.Len(t, edgePoints, 1)
+	require.Equal(t, edgeInfo.ChannelPoint, edgePoints[0].OutPoint)
+
+	dbInfo, dbEdge1, dbEdge2, err := newGraph.FetchChannelEdgesByID(
+		edgeInfo.ChannelID,
+	)
+	require.NoError(t, err)
+	assertEdgeInfoEqual(t, dbInfo, edgeInfo)
+	require.NotNil(t, dbEdge1)
+	require.NotNil(t, dbEdge2)
+
+	_, oldEdge1, oldEdge2, err := graph.FetchChannelEdgesByID(
+		edgeInfo.ChannelID,
+	)
+	require.NoError(t, err)
+	require.Equal(t, oldEdge1.SigBytes, dbEdge1.SigBytes)
+	require.Equal(t, oldEdge1.FeeBaseMSat, dbEdge1.FeeBaseMSat)
+	require.Equal(t, oldEdge1.LastUpdate, dbEdge1.LastUpdate)
+	require.Equal(t, oldEdge2.SigBytes, dbEdge2.SigBytes)
+	require.Equal(t, oldEdge2.FeeBaseMSat, dbEdge2.FeeBaseMSat)
+	require.Equal(t, oldEdge2.LastUpdate, dbEdge2.LastUpdate)
+
+	for _, node := range []*LightningNode{node1, node2} {
+		dbNode, err := newGraph.FetchLightningNode(node.PubKeyBytes)
+		require.NoError(t, err)
+		require.True(t, dbNode.HaveNodeAnnouncement)
+		require.Equal(t, node.AuthSigBytes, dbNode.AuthSigBytes)
+		require.Equal(t, node.Alias, dbNode.Alias)
+		require.Equal(t, node.LastUpdate, dbNode.LastUpdate)
+		require.Equal(t, node.Addresses, dbNode.Addresses)
+	}
+
+	// Importing the snapshot a second time shouldn't add anything, as all
+	// of its records are known by now.
+	stats, edgePoints, err = newGraph.ImportSnapshot(
+		bytes.NewReader(snapshot.Bytes()), &mockSnapshotValidator{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, &GraphSnapshotStats{}, stats)
+	require.Empty(t, edgePoints)
+}
+
+// TestGraphSnapshotInvalid asserts that snapshots that are malformed or fail
+// validation are rejected without modifying the graph.
+func TestGraphSnapshotInvalid(t *testing.T) {
+	t.Parallel()
+
+	graph, _, _, _, cleanUp := createSnapshotTestGraph(t)
+	defer cleanUp()
+
+	var snapshot bytes.Buffer
+	_, err := graph.ExportSnapshot(&snapshot)
+	require.NoError(t, err)
+	raw := snapshot.Bytes()
+
+	badMagic := append([]byte{}, raw...)
+	badMagic[0] ^= 0xff
+
+	badVersion := append([]byte{}, raw...)
+	badVersion[5]++
+
+	errValidation := errors.New("invalid signature")
+
+	testCases := []struct {
+		name      string
+		snapshot  []byte
+		validator *mockSnapshotValidator
+		expErr    error
+	}{
+		{
+			name:      "empty",
+			snapshot:  nil,
+			validator: &mockSnapshotValidator{},
+			expErr:    ErrUnknownSnapshotFormat,
+		},
+		{
+			name:      "bad magic",
+			snapshot:  badMagic,
+			validator: &mockSnapshotValidator{},
+			expErr:    ErrUnknownSnapshotFormat,
+		},
+		{
+			name:      "unknown version",
+			snapshot:  badVersion,
+			validator: &mockSnapshotValidator{},
+			expErr: ErrUnknownSnapshotVersion(
+				GraphSnapshotVersion + 1,
+			),
+		},
+		{
+			name:      "truncated",
+			snapshot:  raw[:len(raw)-1],
+			validator: &mockSnapshotValidator{},
+			expErr:    ErrTruncatedSnapshot,
+		},
+		{
+			name:     "validation failure",
+			snapshot: raw,
+			validator: &mockSnapshotValidator{
+				err: errValidation,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			newGraph, newCleanUp, err := MakeTestGraph()
+			require.NoError(t, err)
+			defer newCleanUp()
+
+			_, _, err = newGraph.ImportSnapshot(
+				bytes.NewReader(testCase.snapshot),
+				testCase.validator,
+			)
+			require.Error(t, err)
+			if testCase.expErr != nil {
+				require.Equal(t, testCase.expErr, err)
+			}
+
+			// Nothing should have been written to the graph.
+			assertNumChans(t, newGraph, 0)
+			assertNumNodes(t, newGraph, 0)
+		})
+	}
+}
@@ -855,7 +855,9 @@ type InvoiceStateUpdateDesc struct {
 // invoice.
 type InvoiceUpdateCallback = func(invoice *Invoice) (*InvoiceUpdateDesc, error)
 
-func validateInvoice(i *Invoice, paymentHash lntypes.Hash) error {
+// ValidateInvoice checks that the passed invoice can be added to the invoice
+// store under the given payment hash.
+func ValidateInvoice(i *Invoice, paymentHash lntypes.Hash) error {
 	// Avoid conflicts with all-zeroes magic value in the database.
 	if paymentHash == unknownPreimage.Hash() {
 		return fmt.Errorf("cannot use hash of all-zeroes preimage")
@@ -908,7 +910,7 @@ func (i *Invoice) IsPending() bool {
 func (d *DB) AddInvoice(newInvoice *Invoice, paymentHash lntypes.Hash) (
 	uint64, error) {
 
-	if err := validateInvoice(newInvoice, paymentHash); err != nil {
+	if err := ValidateInvoice(newInvoice, paymentHash); err != nil {
 		return 0, err
 	}
 
@@ -2417,8 +2419,8 @@ func copySlice(src []byte) []byte {
 	return dest
 }
 
-// copyInvoice makes a deep copy of the supplied invoice.
-func copyInvoice(src *Invoice) *Invoice {
+// CopyInvoice makes a deep copy of the supplied invoice.
+func CopyInvoice(src *Invoice) *Invoice {
 	dest := Invoice{
 		Memo:           copySlice(src.Memo),
 		PaymentRequest: copySlice(src.PaymentRequest),
@@ -2628,7 +2630,7 @@ func (d *DB) updateInvoice(hash *lntypes.Hash, refSetID *SetID, invoices,
 
 	// Create deep copy to prevent any accidental modification in the
 	// callback.
-	invoiceCopy := copyInvoice(&invoice)
+	invoiceCopy := CopyInvoice(&invoice)
 
 	// Call the callback and obtain the update descriptor.
 	update, err := callback(invoiceCopy)
@@ -2641,6 +2643,126 @@ func (d *DB) updateInvoice(hash *lntypes.Hash, refSetID *SetID, invoices,
 		return &invoice, nil
 	}
 
+	indexer := &kvInvoiceIndexer{
+		invoiceNum:  invoiceNum,
+		settleIndex: settleIndex,
+		setIDIndex:  setIDIndex,
+	}
+	htlcsAmpUpdate, err := applyInvoiceUpdate(
+		&invoice, hash, update, d.clock.Now(), indexer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reserialize and update invoice.
+	var buf bytes.Buffer
+	if err := serializeInvoice(&buf, &invoice); err != nil {
+		return nil, err
+	}
+
+	if err := invoices.Put(invoiceNum[:], buf.Bytes()); err != nil {
+		return nil, err
+	}
+
+	// If this is an AMP invoice, then we'll actually store the rest of the
+	// HTLCs in-line with the invoice, using the invoice ID as a prefix,
+	// and the AMP key as a suffix: invoiceNum || setID.
+	invoiceIsAMP := invoice.Terms.Features.HasFeature(
+		lnwire.AMPOptional,
+	)
+	if invoiceIsAMP {
+		err := updateAMPInvoices(invoices, invoiceNum, htlcsAmpUpdate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &invoice, nil
+}
+
+// InvoiceIndexer is used to maintain the indexes of an invoice store while an
+// invoice update is applied, which allows the different invoice stores to
+// share the update logic.
+type InvoiceIndexer interface {
+	// IndexSetID indexes the invoice by the set ID of a newly added AMP
+	// HTLC. False is returned if another invoice is indexed by the same
+	// set ID already.
+	IndexSetID(setID SetID) (bool, error)
+
+	// NextSettleIndex returns the next settle index, and records that it
+	// belongs to the invoice, or to its AMP sub-invoice if a set ID is
+	// passed.
+	NextSettleIndex(setID *SetID) (uint64, error)
+}
+
+// kvInvoiceIndexer maintains the invoice indexes of the kvdb invoice store.
+type kvInvoiceIndexer struct {
+	invoiceNum  []byte
+	settleIndex kvdb.RwBucket
+	setIDIndex  kvdb.RwBucket
+}
+
+// IndexSetID indexes the invoice by the set ID of a newly added AMP HTLC.
+//
+// NOTE: This is part of the InvoiceIndexer interface.
+func (k *kvInvoiceIndexer) IndexSetID(setID SetID) (bool, error) {
+	setIDInvNum := k.setIDIndex.Get(setID[:])
+	if setIDInvNum == nil {
+		return true, k.setIDIndex.Put(setID[:], k.invoiceNum)
+	}
+
+	return bytes.Equal(setIDInvNum, k.invoiceNum), nil
+}
+
+// NextSettleIndex returns the next settle index, and maps it to the invoice
+// number, followed by the set ID for AMP sub-invoices, in the settle index.
+//
+// NOTE: This is part of the InvoiceIndexer interface.
+func (k *kvInvoiceIndexer) NextSettleIndex(setID *SetID) (uint64, error) {
+	nextSettleSeqNo, err := k.settleIndex.NextSequence()
+	if err != nil {
+		return 0, err
+	}
+
+	// Make a new byte array on the stack that can potentially store the 4
+	// byte invoice number along w/ the 32 byte set ID. We capture valueLen
+	// here which is the number of bytes copied so we can only store the 4
+	// bytes if this is a non-AMP invoice.
+	var indexKey [invoiceSetIDKeyLen]byte
+	valueLen := copy(indexKey[:], k.invoiceNum)
+
+	if setID != nil {
+		valueLen += copy(indexKey[valueLen:], setID[:])
+	}
+
+	var seqNoBytes [8]byte
+	byteOrder.PutUint64(seqNoBytes[:], nextSettleSeqNo)
+	err = k.settleIndex.Put(seqNoBytes[:], indexKey[:valueLen])
+	if err != nil {
+		return 0, err
+	}
+
+	return nextSettleSeqNo, nil
+}
+
+// ApplyInvoiceUpdate applies the changes of the passed update descriptor to an
+// invoice with the given payment hash. The passed indexer is used to update
+// the indexes of the invoice store that holds the invoice.
+func ApplyInvoiceUpdate(invoice *Invoice, hash *lntypes.Hash,
+	update *InvoiceUpdateDesc, now time.Time,
+	indexer InvoiceIndexer) error {
+
+	_, err := applyInvoiceUpdate(invoice, hash, update, now, indexer)
+	return err
+}
+
+// applyInvoiceUpdate applies the changes of the passed update descriptor to an
+// invoice. For AMP invoices, the HTLC sets that were modified are returned.
+func applyInvoiceUpdate(invoice *Invoice, hash *lntypes.Hash,
+	update *InvoiceUpdateDesc, now time.Time, indexer InvoiceIndexer) (
+	map[SetID]map[CircuitKey]*InvoiceHTLC, error) {
+
 	var (
 		newState = invoice.State
 		setID    *[32]byte
@@ -2659,9 +2781,7 @@ func (d *DB) updateInvoice(hash *lntypes.Hash, refSetID *SetID, invoices,
 		setID = (*[32]byte)(update.SetID)
 	}
 
-	now := d.clock.Now()
-
-	invoiceIsAMP := invoiceCopy.Terms.Features.HasFeature(
+	invoiceIsAMP := invoice.Terms.Features.HasFeature(
 		lnwire.AMPOptional,
 	)
 
@@ -2684,13 +2804,11 @@ func (d *DB) updateInvoice(hash *lntypes.Hash, refSetID *SetID, invoices,
 		var setID [32]byte
 		if htlcUpdate.AMP != nil {
 			setID = htlcUpdate.AMP.Record.SetID()
-			setIDInvNum := setIDIndex.Get(setID[:])
-			if setIDInvNum == nil {
-				err = setIDIndex.Put(setID[:], invoiceNum)
-				if err != nil {
-					return nil, err
-				}
-			} else if !bytes.Equal(setIDInvNum, invoiceNum) {
+			ok, err := indexer.IndexSetID(setID)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
 				return nil, ErrDuplicateSetID{setID: setID}
 			}
 		}
@@ -2712,7 +2830,7 @@ func (d *DB) updateInvoice(hash *lntypes.Hash, refSetID *SetID, invoices,
 		// below, but only if this is an AMP invoice.
 		if invoiceIsAMP {
 			updateHtlcsAmp(
-				&invoice, htlcsAmpUpdate, htlc, setID, key,
+				invoice, htlcsAmpUpdate, htlc, setID, key,
 			)
 		}
 	}
@@ -2748,7 +2866,7 @@ func (d *DB) updateInvoice(hash *lntypes.Hash, refSetID *SetID, invoices,
 		// disk, but once again, only if this is an AMP invoice.
 		if invoiceIsAMP {
 			cancelHtlcsAmp(
-				&invoice, htlcsAmpUpdate, htlc, key,
+				invoice, htlcsAmpUpdate, htlc, key,
 			)
 		}
 	}
@@ -2766,7 +2884,7 @@ func (d *DB) updateInvoice(hash *lntypes.Hash, refSetID *SetID, invoices,
 	// HTLCs.
 	if update.State != nil {
 		newState, err := updateInvoiceState(
-			&invoice, hash, *update.State,
+			invoice, hash, *update.State,
 		)
 		if err != nil {
 			return nil, err
@@ -2787,7 +2905,7 @@ func (d *DB) updateInvoice(hash *lntypes.Hash, refSetID *SetID, invoices,
 		// setSettleMetaFields.
 		if !invoiceIsAMP && update.State.NewState == ContractSettled {
 			err := setSettleMetaFields(
-				settleIndex, invoiceNum, &invoice, now, nil,
+				indexer, invoice, now, nil,
 			)
 			if err != nil {
 				return nil, err
@@ -2853,7 +2971,7 @@ func (d *DB) updateInvoice(hash *lntypes.Hash, refSetID *SetID, invoices,
 		// meta data state.
 		if htlcSettled && invoiceIsAMP {
 			settleHtlcsAmp(
-				&invoice, settledSetIDs, htlcsAmpUpdate, htlc, key,
+				invoice, settledSetIDs, htlcsAmpUpdate, htlc, key,
 			)
 		}
 
@@ -2899,34 +3017,14 @@ func (d *DB) updateInvoice(hash *lntypes.Hash, refSetID *SetID, invoices,
 	for settledSetID := range settledSetIDs {
 		settledSetID := settledSetID
 		err := setSettleMetaFields(
-			settleIndex, invoiceNum, &invoice, now, &settledSetID,
+			indexer, invoice, now, &settledSetID,
 		)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	// Reserialize and update invoice.
-	var buf bytes.Buffer
-	if err := serializeInvoice(&buf, &invoice); err != nil {
-		return nil, err
-	}
-
-	if err := invoices.Put(invoiceNum[:], buf.Bytes()); err != nil {
-		return nil, err
-	}
-
-	// If this is an AMP invoice, then we'll actually store the rest of the
-	// HTLCs in-line with the invoice, using the invoice ID as a prefix,
-	// and the AMP key as a suffix: invoiceNum || setID.
-	if invoiceIsAMP {
-		err := updateAMPInvoices(invoices, invoiceNum, htlcsAmpUpdate)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return &invoice, nil
+	return htlcsAmpUpdate, nil
 }
 
 // updateInvoiceState validates and processes an invoice state update. The new
@@ -3141,34 +3239,17 @@ func updateHtlc(resolveTime time.Time, htlc *InvoiceHTLC,
 // invoice. If a non-nil setID is passed in, then the value will be append to
 // the invoice number as well, in order to allow us to detect repeated payments
 // to the same AMP invoices "across time".
-func setSettleMetaFields(settleIndex kvdb.RwBucket, invoiceNum []byte,
-	invoice *Invoice, now time.Time, setID *SetID) error {
+func setSettleMetaFields(indexer InvoiceIndexer, invoice *Invoice,
+	now time.Time, setID *SetID) error {
 
 	// Now that we know the invoice hasn't already been settled, we'll
 	// update the settle index so we can place this settle event in the
 	// proper location within our time series.
-	nextSettleSeqNo, err := settleIndex.NextSequence()
+	nextSettleSeqNo, err := indexer.NextSettleIndex(setID)
 	if err != nil {
 		return err
 	}
 
-	// Make a new byte array on the stack that can potentially store the 4
-	// byte invoice number along w/ the 32 byte set ID. We capture valueLen
-	// here which is the number of bytes copied so we can only store the 4
-	// bytes if this is a non-AMP invoice.
-	var indexKey [invoiceSetIDKeyLen]byte
-	valueLen := copy(indexKey[:], invoiceNum)
-
-	if setID != nil {
-		valueLen += copy(indexKey[valueLen:], setID[:])
-	}
-
-	var seqNoBytes [8]byte
-	byteOrder.PutUint64(seqNoBytes[:], nextSettleSeqNo)
-	if err := settleIndex.Put(seqNoBytes[:], indexKey[:valueLen]); err != nil {
-		return err
-	}
-
 	// If the setID is nil, then this means that this is a non-AMP settle,
 	// so we'll update the invoice settle index directly.
 	if setID == nil {
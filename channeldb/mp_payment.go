@@ -63,6 +63,27 @@ func NewHtlcAttemptInfo(attemptID uint64, sessionKey *btcec.PrivateKey,
 	}
 }
 
+// NewHtlcAttemptInfoFromRawKey creates a htlc attempt from the raw bytes of
+// its session key, which is only parsed once it is used.
+func NewHtlcAttemptInfoFromRawKey(attemptID uint64,
+	sessionKey [btcec.PrivKeyBytesLen]byte, route route.Route,
+	attemptTime time.Time, hash *lntypes.Hash) *HTLCAttemptInfo {
+
+	return &HTLCAttemptInfo{
+		AttemptID:   attemptID,
+		sessionKey:  sessionKey,
+		Route:       route,
+		AttemptTime: attemptTime,
+		Hash:        hash,
+	}
+}
+
+// RawSessionKey returns the raw bytes of the ephemeral key used for a htlc
+// attempt.
+func (h *HTLCAttemptInfo) RawSessionKey() [btcec.PrivKeyBytesLen]byte {
+	return h.sessionKey
+}
+
 // SessionKey returns the ephemeral key used for a htlc attempt. This function
 // performs expensive ec-ops to obtain the session key if it is not cached.
 func (h *HTLCAttemptInfo) SessionKey() *btcec.PrivateKey {
@@ -0,0 +1,224 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ltcsuite/lnd/kvdb"
+)
+
+var (
+	// offerBucket is the name of a top level bucket in which we store the
+	// BOLT 12 offers that we created, keyed by their offer ID.
+	//
+	// offer-bucket
+	//      |
+	//      |-- <offer-id>: <creation date><state><encoded offer>
+	//      |
+	//      |-- <offer-id>: <creation date><state><encoded offer>
+	offerBucket = []byte("offer-bucket")
+)
+
+var (
+	// ErrOfferNotFound is returned when an offer with the requested ID
+	// is not found.
+	ErrOfferNotFound = errors.New("unable to locate offer")
+
+	// ErrDuplicateOffer is returned when an offer with the same ID
+	// already exists.
+	ErrDuplicateOffer = errors.New("offer with the same id already exists")
+
+	// ErrNoOffersCreated is returned when we don't have any offers stored
+	// yet.
+	ErrNoOffersCreated = errors.New("there are no existing offers")
+)
+
+// OfferState describes whether we still accept invoice requests for an
+// offer.
+type OfferState uint8
+
+const (
+	// OfferStateActive means that we answer invoice requests for the
+	// offer.
+	OfferStateActive OfferState = 0
+
+	// OfferStateDisabled means that the offer was disabled by the user
+	// and any invoice requests for it are rejected.
+	OfferStateDisabled OfferState = 1
+)
+
+// String returns a human readable identifier for the offer state.
+func (s OfferState) String() string {
+	switch s {
+	case OfferStateActive:
+		return "Active"
+
+	case OfferStateDisabled:
+		return "Disabled"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(s))
+	}
+}
+
+// Offer is a BOLT 12 offer that we created and answer invoice requests for.
+type Offer struct {
+	// ID uniquely identifies the offer. It is the merkle root of the
+	// offer's TLV records.
+	ID [32]byte
+
+	// Encoded is the bech32 encoded offer string that was handed out to
+	// payers.
+	Encoded []byte
+
+	// CreationDate is the time at which the offer was created.
+	CreationDate time.Time
+
+	// State is the current state of the offer.
+	State OfferState
+}
+
+// AddOffer persists a newly created offer. An error is returned if an offer
+// with the same ID already exists.
+func (d *DB) AddOffer(offer *Offer) error {
+	return kvdb.Update(d, func(tx kvdb.RwTx) error {
+		offers, err := tx.CreateTopLevelBucket(offerBucket)
+		if err != nil {
+			return err
+		}
+
+		if offers.Get(offer.ID[:]) != nil {
+			return ErrDuplicateOffer
+		}
+
+		var b bytes.Buffer
+		if err := serializeOffer(&b, offer); err != nil {
+			return err
+		}
+
+		return offers.Put(offer.ID[:], b.Bytes())
+	}, func() {})
+}
+
+// LookupOffer returns the offer with the given ID.
+func (d *DB) LookupOffer(id [32]byte) (*Offer, error) {
+	var offer *Offer
+	err := kvdb.View(d, func(tx kvdb.RTx) error {
+		offers := tx.ReadBucket(offerBucket)
+		if offers == nil {
+			return ErrNoOffersCreated
+		}
+
+		offerBytes := offers.Get(id[:])
+		if offerBytes == nil {
+			return ErrOfferNotFound
+		}
+
+		var err error
+		offer, err = deserializeOffer(id, bytes.NewReader(offerBytes))
+
+		return err
+	}, func() {
+		offer = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// FetchOffers returns all offers that we created.
+func (d *DB) FetchOffers() ([]*Offer, error) {
+	var offers []*Offer
+	err := kvdb.View(d, func(tx kvdb.RTx) error {
+		offerBkt := tx.ReadBucket(offerBucket)
+		if offerBkt == nil {
+			return nil
+		}
+
+		return offerBkt.ForEach(func(k, v []byte) error {
+			var id [32]byte
+			copy(id[:], k)
+
+			offer, err := deserializeOffer(id, bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			offers = append(offers, offer)
+
+			return nil
+		})
+	}, func() {
+		offers = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offers, nil
+}
+
+// DisableOffer marks the offer with the given ID as disabled, so that we no
+// longer answer invoice requests for it.
+func (d *DB) DisableOffer(id [32]byte) error {
+	return kvdb.Update(d, func(tx kvdb.RwTx) error {
+		offers := tx.ReadWriteBucket(offerBucket)
+		if offers == nil {
+			return ErrNoOffersCreated
+		}
+
+		offerBytes := offers.Get(id[:])
+		if offerBytes == nil {
+			return ErrOfferNotFound
+		}
+
+		offer, err := deserializeOffer(id, bytes.NewReader(offerBytes))
+		if err != nil {
+			return err
+		}
+		offer.State = OfferStateDisabled
+
+		var b bytes.Buffer
+		if err := serializeOffer(&b, offer); err != nil {
+			return err
+		}
+
+		return offers.Put(id[:], b.Bytes())
+	}, func() {})
+}
+
+// serializeOffer writes the passed offer, excluding its ID which is used as
+// the key, to the given writer.
+func serializeOffer(w io.Writer, offer *Offer) error {
+	if err := serializeTime(w, offer.CreationDate); err != nil {
+		return err
+	}
+
+	return WriteElements(w, uint8(offer.State), offer.Encoded)
+}
+
+// deserializeOffer reads an offer that was written using serializeOffer.
+func deserializeOffer(id [32]byte, r io.Reader) (*Offer, error) {
+	var (
+		offer = &Offer{ID: id}
+		state uint8
+		err   error
+	)
+
+	offer.CreationDate, err = deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ReadElements(r, &state, &offer.Encoded); err != nil {
+		return nil, err
+	}
+	offer.State = OfferState(state)
+
+	return offer, nil
+}
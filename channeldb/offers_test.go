@@ -0,0 +1,60 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestOffers tests that offers can be added, looked up and disabled.
+func TestOffers(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	// Without any offers, the lookup fails and no offers are returned.
+	_, err = db.LookupOffer([32]byte{1})
+	require.ErrorIs(t, err, ErrOfferNotFound)
+
+	offers, err := db.FetchOffers()
+	require.NoError(t, err)
+	require.Empty(t, offers)
+
+	offer1 := &Offer{
+		ID:           [32]byte{1},
+		Encoded:      []byte("lno1"),
+		CreationDate: time.Unix(100, 0),
+		State:        OfferStateActive,
+	}
+	offer2 := &Offer{
+		ID:           [32]byte{2},
+		Encoded:      []byte("lno2"),
+		CreationDate: time.Unix(200, 0),
+		State:        OfferStateActive,
+	}
+
+	require.NoError(t, db.AddOffer(offer1))
+	require.NoError(t, db.AddOffer(offer2))
+	require.ErrorIs(t, db.AddOffer(offer1), ErrDuplicateOffer)
+
+	offer, err := db.LookupOffer(offer1.ID)
+	require.NoError(t, err)
+	require.Equal(t, offer1, offer)
+
+	offers, err = db.FetchOffers()
+	require.NoError(t, err)
+	require.Equal(t, []*Offer{offer1, offer2}, offers)
+
+	// Disabling an offer only changes its state.
+	require.NoError(t, db.DisableOffer(offer2.ID))
+	offer2.State = OfferStateDisabled
+
+	offer, err = db.LookupOffer(offer2.ID)
+	require.NoError(t, err)
+	require.Equal(t, offer2, offer)
+
+	require.ErrorIs(t, db.DisableOffer([32]byte{3}), ErrOfferNotFound)
+}
@@ -104,6 +104,38 @@ func NewPaymentControl(db *DB) *PaymentControl {
 	}
 }
 
+// FetchPayments returns all sent payments found in the DB.
+func (p *PaymentControl) FetchPayments() ([]*MPPayment, error) {
+	return p.db.FetchPayments()
+}
+
+// QueryPayments is a query to the payments database which is restricted to a
+// subset of payments by the payments query.
+func (p *PaymentControl) QueryPayments(query PaymentsQuery) (PaymentsResponse,
+	error) {
+
+	return p.db.QueryPayments(query)
+}
+
+// DeletePayment deletes a payment from the DB given its payment hash. If
+// failedHtlcsOnly is set, only failed HTLC attempts of the payment will be
+// deleted.
+func (p *PaymentControl) DeletePayment(paymentHash lntypes.Hash,
+	failedHtlcsOnly bool) error {
+
+	return p.db.DeletePayment(paymentHash, failedHtlcsOnly)
+}
+
+// DeletePayments deletes all completed and failed payments from the DB. If
+// failedOnly is set, only failed payments will be considered for deletion. If
+// failedHtlsOnly is set, the payment itself won't be deleted, only failed HTLC
+// attempts.
+func (p *PaymentControl) DeletePayments(failedOnly,
+	failedHtlcsOnly bool) error {
+
+	return p.db.DeletePayments(failedOnly, failedHtlcsOnly)
+}
+
 // InitPayment checks or records the given PaymentCreationInfo with the DB,
 // making sure it does not already exist as an in-flight payment. When this
 // method returns successfully, the payment is guranteeed to be in the InFlight
@@ -304,65 +336,10 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 			return err
 		}
 
-		// We cannot register a new attempt if the payment already has
-		// reached a terminal condition. We check this before
-		// ensureInFlight because it is a more general check.
-		settle, fail := p.TerminalInfo()
-		if settle != nil || fail != nil {
-			return ErrPaymentTerminal
-		}
-
-		// Ensure the payment is in-flight.
-		if err := ensureInFlight(p); err != nil {
+		if err := ValidateAttempt(p, attempt); err != nil {
 			return err
 		}
 
-		// Make sure any existing shards match the new one with regards
-		// to MPP options.
-		mpp := attempt.Route.FinalHop().MPP
-		for _, h := range p.InFlightHTLCs() {
-			hMpp := h.Route.FinalHop().MPP
-
-			switch {
-
-			// We tried to register a non-MPP attempt for a MPP
-			// payment.
-			case mpp == nil && hMpp != nil:
-				return ErrMPPayment
-
-			// We tried to register a MPP shard for a non-MPP
-			// payment.
-			case mpp != nil && hMpp == nil:
-				return ErrNonMPPayment
-
-			// Non-MPP payment, nothing more to validate.
-			case mpp == nil:
-				continue
-			}
-
-			// Check that MPP options match.
-			if mpp.PaymentAddr() != hMpp.PaymentAddr() {
-				return ErrMPPPaymentAddrMismatch
-			}
-
-			if mpp.TotalMsat() != hMpp.TotalMsat() {
-				return ErrMPPTotalAmountMismatch
-			}
-		}
-
-		// If this is a non-MPP attempt, it must match the total amount
-		// exactly.
-		amt := attempt.Route.ReceiverAmt()
-		if mpp == nil && amt != p.Info.Value {
-			return ErrValueMismatch
-		}
-
-		// Ensure we aren't sending more than the total payment amount.
-		sentAmt, _ := p.SentAmt()
-		if sentAmt+amt > p.Info.Value {
-			return ErrValueExceedsAmt
-		}
-
 		htlcsBucket, err := bucket.CreateBucketIfNotExists(
 			paymentHtlcsBucket,
 		)
@@ -389,6 +366,101 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 	return payment, err
 }
 
+// ValidateAttempt checks that the passed HTLC attempt can be registered for
+// the payment, which must be in flight and not terminal yet. The MPP options of
+// the attempt must match the ones of the other in-flight attempts, and the
+// total amount sent mustn't exceed the payment amount.
+func ValidateAttempt(p *MPPayment, attempt *HTLCAttemptInfo) error {
+	// We cannot register a new attempt if the payment already has
+	// reached a terminal condition. We check this before
+	// ensureInFlight because it is a more general check.
+	settle, fail := p.TerminalInfo()
+	if settle != nil || fail != nil {
+		return ErrPaymentTerminal
+	}
+
+	// Ensure the payment is in-flight.
+	if err := ensureInFlight(p); err != nil {
+		return err
+	}
+
+	// Make sure any existing shards match the new one with regards
+	// to MPP options.
+	mpp := attempt.Route.FinalHop().MPP
+	for _, h := range p.InFlightHTLCs() {
+		hMpp := h.Route.FinalHop().MPP
+
+		switch {
+
+		// We tried to register a non-MPP attempt for a MPP
+		// payment.
+		case mpp == nil && hMpp != nil:
+			return ErrMPPayment
+
+		// We tried to register a MPP shard for a non-MPP
+		// payment.
+		case mpp != nil && hMpp == nil:
+			return ErrNonMPPayment
+
+		// Non-MPP payment, nothing more to validate.
+		case mpp == nil:
+			continue
+		}
+
+		// Check that MPP options match.
+		if mpp.PaymentAddr() != hMpp.PaymentAddr() {
+			return ErrMPPPaymentAddrMismatch
+		}
+
+		if mpp.TotalMsat() != hMpp.TotalMsat() {
+			return ErrMPPTotalAmountMismatch
+		}
+	}
+
+	// If this is a non-MPP attempt, it must match the total amount
+	// exactly.
+	amt := attempt.Route.ReceiverAmt()
+	if mpp == nil && amt != p.Info.Value {
+		return ErrValueMismatch
+	}
+
+	// Ensure we aren't sending more than the total payment amount.
+	sentAmt, _ := p.SentAmt()
+	if sentAmt+amt > p.Info.Value {
+		return ErrValueExceedsAmt
+	}
+
+	return nil
+}
+
+// ValidateAttemptUpdate checks that the outcome of the given HTLC attempt of
+// the payment can be recorded, which requires the payment to be in flight and
+// the attempt to be neither settled nor failed yet.
+func ValidateAttemptUpdate(p *MPPayment, attemptID uint64) error {
+	// We can only update keys of in-flight payments. We allow updating
+	// keys even if the payment has reached a terminal condition, since the
+	// HTLC outcomes must still be updated.
+	if err := ensureInFlight(p); err != nil {
+		return err
+	}
+
+	htlc, err := p.GetAttempt(attemptID)
+	if err != nil {
+		return fmt.Errorf("HTLC with ID %v not registered", attemptID)
+	}
+
+	// Make sure the shard is not already failed or settled.
+	switch {
+	case htlc.Failure != nil:
+		return ErrAttemptAlreadyFailed
+
+	case htlc.Settle != nil:
+		return ErrAttemptAlreadySettled
+	}
+
+	return nil
+}
+
 // SettleAttempt marks the given attempt settled with the preimage. If this is
 // a multi shard payment, this might implicitly mean that the full payment
 // succeeded.
@@ -443,10 +515,7 @@ func (p *PaymentControl) updateHtlcKey(paymentHash lntypes.Hash,
 			return err
 		}
 
-		// We can only update keys of in-flight payments. We allow
-		// updating keys even if the payment has reached a terminal
-		// condition, since the HTLC outcomes must still be updated.
-		if err := ensureInFlight(p); err != nil {
+		if err := ValidateAttemptUpdate(p, attemptID); err != nil {
 			return err
 		}
 
@@ -455,20 +524,6 @@ func (p *PaymentControl) updateHtlcKey(paymentHash lntypes.Hash,
 			return fmt.Errorf("htlcs bucket not found")
 		}
 
-		if htlcsBucket.Get(htlcBucketKey(htlcAttemptInfoKey, aid)) == nil {
-			return fmt.Errorf("HTLC with ID %v not registered",
-				attemptID)
-		}
-
-		// Make sure the shard is not already failed or settled.
-		if htlcsBucket.Get(htlcBucketKey(htlcFailInfoKey, aid)) != nil {
-			return ErrAttemptAlreadyFailed
-		}
-
-		if htlcsBucket.Get(htlcBucketKey(htlcSettleInfoKey, aid)) != nil {
-			return ErrAttemptAlreadySettled
-		}
-
 		// Add or update the key for this htlc.
 		err = htlcsBucket.Put(htlcBucketKey(key, aid), value)
 		if err != nil {
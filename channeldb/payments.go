@@ -15,6 +15,7 @@ import (
 	"github.com/ltcsuite/lnd/record"
 	"github.com/ltcsuite/lnd/routing/route"
 	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/wire"
 )
 
@@ -227,6 +228,10 @@ type PaymentCreationInfo struct {
 
 	// PaymentRequest is the full payment request, if any.
 	PaymentRequest []byte
+
+	// Label is an optional label of the payment, which allows the
+	// payments made by subsystems such as the rebalancer to be told apart.
+	Label string
 }
 
 // htlcBucketKey creates a composite key from prefix and id where the result is
@@ -336,6 +341,20 @@ func fetchPayment(bucket kvdb.RBucket) (*MPPayment, error) {
 		failureReason = &reason
 	}
 
+	return &MPPayment{
+		SequenceNum:   sequenceNum,
+		Info:          creationInfo,
+		HTLCs:         htlcs,
+		FailureReason: failureReason,
+		Status:        DecidePaymentStatus(htlcs, failureReason),
+	}, nil
+}
+
+// DecidePaymentStatus determines the status of a payment from the outcomes of
+// its HTLC attempts and its failure reason, if any.
+func DecidePaymentStatus(htlcs []HTLCAttempt,
+	failureReason *FailureReason) PaymentStatus {
+
 	// Go through all HTLCs for this payment, noting whether we have any
 	// settled HTLC, and any still in-flight.
 	var inflight, settled bool
@@ -354,33 +373,22 @@ func fetchPayment(bucket kvdb.RBucket) (*MPPayment, error) {
 		inflight = true
 	}
 
-	// Use the DB state to determine the status of the payment.
-	var paymentStatus PaymentStatus
-
 	switch {
 
 	// If any of the the HTLCs did succeed and there are no HTLCs in
 	// flight, the payment succeeded.
 	case !inflight && settled:
-		paymentStatus = StatusSucceeded
+		return StatusSucceeded
 
 	// If we have no in-flight HTLCs, and the payment failure is set, the
 	// payment is considered failed.
 	case !inflight && failureReason != nil:
-		paymentStatus = StatusFailed
+		return StatusFailed
 
 	// Otherwise it is still in flight.
 	default:
-		paymentStatus = StatusInFlight
+		return StatusInFlight
 	}
-
-	return &MPPayment{
-		SequenceNum:   sequenceNum,
-		Info:          creationInfo,
-		HTLCs:         htlcs,
-		FailureReason: failureReason,
-		Status:        paymentStatus,
-	}, nil
 }
 
 // fetchHtlcAttempts retrives all htlc attempts made for the payment found in
@@ -534,6 +542,25 @@ type PaymentsQuery struct {
 	// fully completed. This means that pending payments, as well as failed
 	// payments will show up if this field is set to true.
 	IncludeIncomplete bool
+
+	// CreationDateStart, if set, filters out all payments that were
+	// created before this unix timestamp in seconds.
+	CreationDateStart int64
+
+	// CreationDateEnd, if set, filters out all payments that were created
+	// after this unix timestamp in seconds.
+	CreationDateEnd int64
+}
+
+// InCreationDateRange returns true if a payment with the given creation time
+// matches the creation date range of the query.
+func (q *PaymentsQuery) InCreationDateRange(creationTime time.Time) bool {
+	created := creationTime.Unix()
+	if q.CreationDateStart != 0 && created < q.CreationDateStart {
+		return false
+	}
+
+	return q.CreationDateEnd == 0 || created <= q.CreationDateEnd
 }
 
 // PaymentsResponse contains the result of a query to the payments database.
@@ -608,6 +635,13 @@ func (d *DB) QueryPayments(query PaymentsQuery) (PaymentsResponse, error) {
 				return false, err
 			}
 
+			// Skip the payments that were created outside of the
+			// requested date range.
+			creationTime := payment.Info.CreationTime
+			if !query.InCreationDateRange(creationTime) {
+				return false, nil
+			}
+
 			// At this point, we've exhausted the offset, so we'll
 			// begin collecting invoices found within the range.
 			resp.Payments = append(resp.Payments, payment)
@@ -1007,6 +1041,21 @@ func serializePaymentCreationInfo(w io.Writer, c *PaymentCreationInfo) error {
 		return err
 	}
 
+	// The label was added after the other fields, so we only write it if
+	// it's set to keep the encoding of unlabeled payments unchanged.
+	if c.Label == "" {
+		return nil
+	}
+
+	byteOrder.PutUint32(scratch[:4], uint32(len(c.Label)))
+	if _, err := w.Write(scratch[:4]); err != nil {
+		return err
+	}
+
+	if _, err := w.Write([]byte(c.Label)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1043,6 +1092,22 @@ func deserializePaymentCreationInfo(r io.Reader) (*PaymentCreationInfo, error) {
 	}
 	c.PaymentRequest = payReq
 
+	// Payments that were created before labels were added, or that don't
+	// have a label, end here.
+	_, err = io.ReadFull(r, scratch[:4])
+	if err == io.EOF {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	label := make([]byte, byteOrder.Uint32(scratch[:4]))
+	if _, err := io.ReadFull(r, label); err != nil {
+		return nil, err
+	}
+	c.Label = string(label)
+
 	return c, nil
 }
 
@@ -1142,6 +1207,28 @@ func serializeHop(w io.Writer, h *route.Hop) error {
 		records = append(records, h.MPP.Record())
 	}
 
+	// Hops within a blinded route carry the data that the recipient
+	// encrypted for them, and the introduction node and final hop are
+	// given additional fields.
+	if len(h.EncryptedData) > 0 {
+		records = append(
+			records, record.NewEncryptedDataRecord(&h.EncryptedData),
+		)
+	}
+
+	if h.BlindingPoint != nil {
+		records = append(
+			records, record.NewBlindingPointRecord(&h.BlindingPoint),
+		)
+	}
+
+	if h.TotalAmtMsat != 0 {
+		totalAmt := uint64(h.TotalAmtMsat)
+		records = append(
+			records, record.NewTotalAmtMsatBlindedRecord(&totalAmt),
+		)
+	}
+
 	// Final sanity check to absolutely rule out custom records that are not
 	// custom and write into the standard range.
 	if err := h.CustomRecords.Validate(); err != nil {
@@ -1256,6 +1343,42 @@ func deserializeHop(r io.Reader) (*route.Hop, error) {
 		h.MPP = mpp
 	}
 
+	// Likewise, extract any fields that are specific to hops within a
+	// blinded route.
+	encryptedDataType := uint64(record.EncryptedDataOnionType)
+	if encryptedData, ok := tlvMap[encryptedDataType]; ok {
+		delete(tlvMap, encryptedDataType)
+		h.EncryptedData = encryptedData
+	}
+
+	blindingPointType := uint64(record.BlindingPointOnionType)
+	if blindingPoint, ok := tlvMap[blindingPointType]; ok {
+		delete(tlvMap, blindingPointType)
+
+		h.BlindingPoint, err = btcec.ParsePubKey(blindingPoint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	totalAmtType := uint64(record.TotalAmtMsatBlindedType)
+	if totalAmtBytes, ok := tlvMap[totalAmtType]; ok {
+		delete(tlvMap, totalAmtType)
+
+		var (
+			totalAmt    uint64
+			totalAmtRec = record.NewTotalAmtMsatBlindedRecord(
+				&totalAmt,
+			)
+			r = bytes.NewReader(totalAmtBytes)
+		)
+		err := totalAmtRec.Decode(r, uint64(len(totalAmtBytes)))
+		if err != nil {
+			return nil, err
+		}
+		h.TotalAmtMsat = lnwire.MilliSatoshi(totalAmt)
+	}
+
 	h.CustomRecords = tlvMap
 
 	return h, nil
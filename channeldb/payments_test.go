@@ -97,6 +97,16 @@ func TestSentPaymentSerialization(t *testing.T) {
 		)
 	}
 
+	// A label is stored after the payment request, so that creation info
+	// without a label keeps its existing serialization.
+	b.Reset()
+	c.Label = "rebalance"
+	require.NoError(t, serializePaymentCreationInfo(&b, c))
+
+	newCreationInfo, err = deserializePaymentCreationInfo(&b)
+	require.NoError(t, err)
+	require.Equal(t, c, newCreationInfo)
+
 	b.Reset()
 	if err := serializeHTLCAttemptInfo(&b, s); err != nil {
 		t.Fatalf("unable to serialize info: %v", err)
@@ -166,6 +176,43 @@ func TestRouteSerialization(t *testing.T) {
 	}
 }
 
+// TestBlindedRouteSerialization tests that the fields of hops within a blinded
+// route survive serialization.
+func TestBlindedRouteSerialization(t *testing.T) {
+	t.Parallel()
+
+	blindedRoute := route.Route{
+		TotalTimeLock: 123,
+		TotalAmount:   1234567,
+		SourcePubKey:  route.NewVertex(pub),
+		Hops: []*route.Hop{
+			testHop1,
+			{
+				PubKeyBytes:   route.NewVertex(pub),
+				ChannelID:     12345,
+				EncryptedData: []byte{1, 2, 3},
+				BlindingPoint: pub,
+				CustomRecords: record.CustomSet{},
+			},
+			{
+				PubKeyBytes:      route.NewVertex(pub),
+				OutgoingTimeLock: 111,
+				AmtToForward:     555,
+				EncryptedData:    []byte{4, 5, 6},
+				TotalAmtMsat:     1000,
+				CustomRecords:    record.CustomSet{},
+			},
+		},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, SerializeRoute(&b, blindedRoute))
+
+	route2, err := DeserializeRoute(bytes.NewReader(b.Bytes()))
+	require.NoError(t, err)
+	require.NoError(t, assertRouteEqual(&blindedRoute, &route2))
+}
+
 // deletePayment removes a payment with paymentHash from the payments database.
 func deletePayment(t *testing.T, db *DB, paymentHash lntypes.Hash, seqNr uint64) {
 	t.Helper()
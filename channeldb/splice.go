@@ -0,0 +1,542 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/ltcsuite/lnd/kvdb"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+var (
+	// spliceKey is an entry in the bucket of a channel that stores the
+	// pending splice of the channel, if there is one.
+	spliceKey = []byte("splice-key")
+
+	// spliceRevocationLogBucket is a sub-bucket of a channel's bucket that
+	// stores the versions of the remote party's revoked commitments which
+	// spend the funding output of a pending splice. Once the splice
+	// confirms, they replace the corresponding entries of the revocation
+	// log, as the original versions can no longer be broadcast.
+	spliceRevocationLogBucket = []byte("splice-revocation-log-key")
+)
+
+var (
+	// ErrNoSplice is returned when a channel doesn't have a pending
+	// splice.
+	ErrNoSplice = errors.New("channel has no pending splice")
+
+	// ErrSpliceExists is returned when a splice is added to a channel
+	// which already has a pending splice.
+	ErrSpliceExists = errors.New("channel already has a pending splice")
+)
+
+// ChannelSplice is a splice of a channel that was signed by both parties, but
+// whose transaction hasn't been locked in by both of them yet. Until then, the
+// channel keeps operating on its current funding output, while every
+// commitment is additionally signed in a version that spends the funding
+// output created by the splice.
+type ChannelSplice struct {
+	// FundingOutpoint is the funding outpoint created by the splice
+	// transaction.
+	FundingOutpoint wire.OutPoint
+
+	// FundingTx is the splice transaction. It only carries witnesses
+	// once both parties exchanged their signatures for it.
+	FundingTx *wire.MsgTx
+
+	// Capacity is the capacity of the channel after the splice.
+	Capacity ltcutil.Amount
+
+	// LocalContribution is the amount that we added to the channel. It's
+	// negative if we removed funds from the channel.
+	LocalContribution ltcutil.Amount
+
+	// RemoteContribution is the amount that the remote party added to
+	// the channel. It's negative if it removed funds from the channel.
+	RemoteContribution ltcutil.Amount
+
+	// ConfirmHeight is the height at which the splice transaction
+	// confirmed, or zero if it's still unconfirmed.
+	ConfirmHeight uint32
+
+	// LocalCommitment is the version of our current commitment that
+	// spends the funding output of the splice.
+	LocalCommitment ChannelCommitment
+
+	// RemoteCommitment is the version of the remote party's current
+	// commitment that spends the funding output of the splice.
+	RemoteCommitment ChannelCommitment
+
+	// RemotePendingCommitment is the version of the remote party's
+	// pending commitment that spends the funding output of the splice, if
+	// we extended a new commitment to it that it didn't revoke its prior
+	// state for yet.
+	RemotePendingCommitment *ChannelCommitment
+}
+
+// IsConfirmed returns true if the splice transaction has confirmed.
+func (s *ChannelSplice) IsConfirmed() bool {
+	return s.ConfirmHeight != 0
+}
+
+// PutSplice adds a splice that was just signed by both parties to the
+// channel. The splice commitments are attached to the current commitments of
+// the channel.
+func (c *OpenChannel) PutSplice(splice *ChannelSplice) error {
+	c.Lock()
+	defer c.Unlock()
+
+	err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if chanBucket.Get(spliceKey) != nil {
+			return ErrSpliceExists
+		}
+
+		return putChanSplice(chanBucket, splice)
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	c.LocalCommitment.SpliceCommitment = &splice.LocalCommitment
+	c.RemoteCommitment.SpliceCommitment = &splice.RemoteCommitment
+
+	return nil
+}
+
+// FetchSplice returns the pending splice of the channel. ErrNoSplice is
+// returned if there is none.
+func (c *OpenChannel) FetchSplice() (*ChannelSplice, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	var splice *ChannelSplice
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		splice, err = fetchChanSplice(chanBucket)
+		return err
+	}, func() {
+		splice = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return splice, nil
+}
+
+// UpdateSpliceTx replaces the splice transaction of the pending splice, which
+// is used to store it once it's fully signed.
+func (c *OpenChannel) UpdateSpliceTx(spliceTx *wire.MsgTx) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.updateSplice(func(_ kvdb.RwBucket,
+		splice *ChannelSplice) error {
+
+		if splice.FundingTx.TxHash() != spliceTx.TxHash() {
+			return ErrNoSplice
+		}
+		splice.FundingTx = spliceTx
+
+		return nil
+	})
+}
+
+// MarkSpliceConfirmed records the height at which the transaction of the
+// pending splice confirmed. As the original funding output is spent from then
+// on, the versions of the remote party's revoked commitments that spend the
+// funding output of the splice replace the ones in the revocation log.
+func (c *OpenChannel) MarkSpliceConfirmed(height uint32) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.updateSplice(func(chanBucket kvdb.RwBucket,
+		splice *ChannelSplice) error {
+
+		if splice.IsConfirmed() {
+			return nil
+		}
+		splice.ConfirmHeight = height
+
+		spliceLog := chanBucket.NestedReadWriteBucket(
+			spliceRevocationLogBucket,
+		)
+		if spliceLog == nil {
+			return nil
+		}
+
+		logBucket, err := chanBucket.CreateBucketIfNotExists(
+			revocationLogBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = spliceLog.ForEach(func(k, v []byte) error {
+			return logBucket.Put(k, v)
+		})
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.DeleteNestedBucket(spliceRevocationLogBucket)
+	})
+}
+
+// CompleteSplice completes the pending splice once it was locked in by both
+// parties. The funding output of the splice becomes the active funding output
+// of the channel, and its commitments are replaced by their splice versions.
+func (c *OpenChannel) CompleteSplice() error {
+	c.Lock()
+	defer c.Unlock()
+
+	var splice *ChannelSplice
+	err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		splice, err = fetchChanSplice(chanBucket)
+		if err != nil {
+			return err
+		}
+
+		if !splice.IsConfirmed() {
+			return errors.New("splice transaction is unconfirmed")
+		}
+
+		// The channel info is written from the channel itself, so
+		// we'll update it temporarily, and only apply the changes in
+		// memory once the transaction succeeded.
+		spliceOutpoint, capacity := c.SpliceOutpoint, c.Capacity
+		c.SpliceOutpoint = splice.FundingOutpoint
+		c.Capacity = splice.Capacity
+		err = putChanInfo(chanBucket, c)
+		c.SpliceOutpoint, c.Capacity = spliceOutpoint, capacity
+		if err != nil {
+			return err
+		}
+
+		err = putChanCommitment(
+			chanBucket, &splice.LocalCommitment, true,
+		)
+		if err != nil {
+			return err
+		}
+		err = putChanCommitment(
+			chanBucket, &splice.RemoteCommitment, false,
+		)
+		if err != nil {
+			return err
+		}
+
+		// If there's a pending commitment for the remote party, its
+		// splice version becomes the new tip of its commitment chain.
+		// The signatures that we sent for it are left untouched, as
+		// they include the ones for the splice version.
+		if splice.RemotePendingCommitment != nil {
+			tipBytes := chanBucket.Get(commitDiffKey)
+			if tipBytes == nil {
+				return ErrNoPendingCommit
+			}
+
+			diff, err := deserializeCommitDiff(
+				bytes.NewReader(tipBytes),
+			)
+			if err != nil {
+				return err
+			}
+			diff.Commitment = *splice.RemotePendingCommitment
+
+			var b bytes.Buffer
+			if err := serializeCommitDiff(&b, diff); err != nil {
+				return err
+			}
+			err = chanBucket.Put(commitDiffKey, b.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+
+		return chanBucket.Delete(spliceKey)
+	}, func() {
+		splice = nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.SpliceOutpoint = splice.FundingOutpoint
+	c.Capacity = splice.Capacity
+	c.LocalCommitment = splice.LocalCommitment
+	c.RemoteCommitment = splice.RemoteCommitment
+
+	return nil
+}
+
+// AbandonSplice removes the pending splice of the channel along with the
+// splice versions of its commitments. This is only safe as long as the remote
+// party can't have received our signature for the splice transaction.
+func (c *OpenChannel) AbandonSplice() error {
+	c.Lock()
+	defer c.Unlock()
+
+	err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if chanBucket.Get(spliceKey) == nil {
+			return ErrNoSplice
+		}
+
+		spliceLog := chanBucket.NestedReadWriteBucket(
+			spliceRevocationLogBucket,
+		)
+		if spliceLog != nil {
+			err := chanBucket.DeleteNestedBucket(
+				spliceRevocationLogBucket,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return chanBucket.Delete(spliceKey)
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	c.LocalCommitment.SpliceCommitment = nil
+	c.RemoteCommitment.SpliceCommitment = nil
+
+	return nil
+}
+
+// updateSplice reads the pending splice of the channel, applies the passed
+// modification to it and writes it back to disk. The caller must hold the
+// channel's mutex.
+func (c *OpenChannel) updateSplice(cb func(kvdb.RwBucket,
+	*ChannelSplice) error) error {
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		splice, err := fetchChanSplice(chanBucket)
+		if err != nil {
+			return err
+		}
+
+		if err := cb(chanBucket, splice); err != nil {
+			return err
+		}
+
+		return putChanSplice(chanBucket, splice)
+	}, func() {})
+}
+
+// putSpliceCommitment writes the splice version of a commitment that was
+// persisted for the local or remote party to the pending splice. The remote
+// version is written as the pending commitment of the remote party.
+func putSpliceCommitment(chanBucket kvdb.RwBucket, commit *ChannelCommitment,
+	local bool) error {
+
+	splice, err := fetchChanSplice(chanBucket)
+	if err != nil {
+		return err
+	}
+
+	if local {
+		splice.LocalCommitment = *commit
+	} else {
+		splice.RemotePendingCommitment = commit
+	}
+
+	return putChanSplice(chanBucket, splice)
+}
+
+// advanceSpliceCommitChainTail makes the pending splice version of the remote
+// party's commitment its current one, as the remote party just revoked its
+// prior state. The splice version of the revoked state is returned.
+func advanceSpliceCommitChainTail(
+	chanBucket kvdb.RwBucket) (*ChannelSplice, *ChannelCommitment, error) {
+
+	splice, err := fetchChanSplice(chanBucket)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// If the pending commitment was created before the splice, there is
+	// no splice version of it, and the splice is left as is.
+	if splice.RemotePendingCommitment == nil {
+		return splice, nil, nil
+	}
+
+	revoked := splice.RemoteCommitment
+	splice.RemoteCommitment = *splice.RemotePendingCommitment
+	splice.RemotePendingCommitment = nil
+
+	if err := putChanSplice(chanBucket, splice); err != nil {
+		return nil, nil, err
+	}
+
+	return splice, &revoked, nil
+}
+
+// logRevokedSpliceCommitment adds the splice version of a revoked commitment
+// of the remote party to the revocation log if the splice transaction
+// confirmed, replacing the original version. Otherwise, it's added to the
+// separate log of the splice. Nothing is logged if there is no splice version
+// of the revoked commitment.
+func logRevokedSpliceCommitment(chanBucket, logBucket kvdb.RwBucket,
+	splice *ChannelSplice, revoked *ChannelCommitment) error {
+
+	if revoked == nil {
+		return nil
+	}
+
+	if splice.IsConfirmed() {
+		return appendChannelLogEntry(logBucket, revoked)
+	}
+
+	spliceLog, err := chanBucket.CreateBucketIfNotExists(
+		spliceRevocationLogBucket,
+	)
+	if err != nil {
+		return err
+	}
+
+	return appendChannelLogEntry(spliceLog, revoked)
+}
+
+// attachSpliceCommitments attaches the splice versions of the current
+// commitments of the channel to them, if the channel has a pending splice.
+func attachSpliceCommitments(chanBucket kvdb.RBucket,
+	channel *OpenChannel) error {
+
+	splice, err := fetchChanSplice(chanBucket)
+	switch {
+	case err == ErrNoSplice:
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	channel.LocalCommitment.SpliceCommitment = &splice.LocalCommitment
+	channel.RemoteCommitment.SpliceCommitment = &splice.RemoteCommitment
+
+	return nil
+}
+
+func putChanSplice(chanBucket kvdb.RwBucket, splice *ChannelSplice) error {
+	var b bytes.Buffer
+	if err := serializeChanSplice(&b, splice); err != nil {
+		return err
+	}
+
+	return chanBucket.Put(spliceKey, b.Bytes())
+}
+
+func fetchChanSplice(chanBucket kvdb.RBucket) (*ChannelSplice, error) {
+	spliceBytes := chanBucket.Get(spliceKey)
+	if spliceBytes == nil {
+		return nil, ErrNoSplice
+	}
+
+	return deserializeChanSplice(bytes.NewReader(spliceBytes))
+}
+
+func serializeChanSplice(w io.Writer, splice *ChannelSplice) error {
+	err := WriteElements(w,
+		splice.FundingOutpoint, splice.FundingTx, splice.Capacity,
+		splice.LocalContribution, splice.RemoteContribution,
+		splice.ConfirmHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := serializeChanCommit(w, &splice.LocalCommitment); err != nil {
+		return err
+	}
+	if err := serializeChanCommit(w, &splice.RemoteCommitment); err != nil {
+		return err
+	}
+
+	hasPending := splice.RemotePendingCommitment != nil
+	if err := WriteElement(w, hasPending); err != nil {
+		return err
+	}
+	if !hasPending {
+		return nil
+	}
+
+	return serializeChanCommit(w, splice.RemotePendingCommitment)
+}
+
+func deserializeChanSplice(r io.Reader) (*ChannelSplice, error) {
+	splice := &ChannelSplice{}
+
+	err := ReadElements(r,
+		&splice.FundingOutpoint, &splice.FundingTx, &splice.Capacity,
+		&splice.LocalContribution, &splice.RemoteContribution,
+		&splice.ConfirmHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	splice.LocalCommitment, err = deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+	splice.RemoteCommitment, err = deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var hasPending bool
+	if err := ReadElement(r, &hasPending); err != nil {
+		return nil, err
+	}
+	if !hasPending {
+		return splice, nil
+	}
+
+	pending, err := deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+	splice.RemotePendingCommitment = &pending
+
+	return splice, nil
+}
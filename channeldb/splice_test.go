@@ -0,0 +1,229 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// spliceCommitment returns a copy of the passed commitment that spends the
+// passed funding outpoint instead.
+func spliceCommitment(commit *ChannelCommitment,
+	fundingOutpoint wire.OutPoint) ChannelCommitment {
+
+	spliceCommit := *commit
+	spliceCommit.CommitTx = commit.CommitTx.Copy()
+	spliceCommit.SpliceCommitment = nil
+	spliceCommit.CommitTx.TxIn[0].PreviousOutPoint = fundingOutpoint
+	spliceCommit.LocalBalance += lnwire.NewMSatFromSatoshis(50_000)
+
+	return spliceCommit
+}
+
+// TestChannelSplice tests that the splice versions of the commitments of a
+// channel are persisted during the state transitions while a splice is
+// pending, and that they replace the original commitments once the splice
+// completes.
+func TestChannelSplice(t *testing.T) {
+	t.Parallel()
+
+	fullDB, cleanUp, err := MakeTestDB()
+	require.NoError(t, err, "unable to make test database")
+	defer cleanUp()
+
+	cdb := fullDB.ChannelStateDB()
+	channel := createTestChannel(t, cdb, openChannelOption())
+
+	_, err = channel.FetchSplice()
+	require.ErrorIs(t, err, ErrNoSplice)
+	require.Equal(
+		t, channel.FundingOutpoint, channel.ActiveFundingOutpoint(),
+	)
+
+	spliceTx := channel.LocalCommitment.CommitTx.Copy()
+	spliceTx.LockTime = 1234
+	splice := &ChannelSplice{
+		FundingOutpoint: wire.OutPoint{
+			Hash: spliceTx.TxHash(),
+		},
+		FundingTx:          spliceTx,
+		Capacity:           channel.Capacity + 50_000,
+		LocalContribution:  60_000,
+		RemoteContribution: -10_000,
+		LocalCommitment: spliceCommitment(
+			&channel.LocalCommitment, wire.OutPoint{Index: 1},
+		),
+		RemoteCommitment: spliceCommitment(
+			&channel.RemoteCommitment, wire.OutPoint{Index: 1},
+		),
+	}
+	require.NoError(t, channel.PutSplice(splice))
+	require.ErrorIs(t, channel.PutSplice(splice), ErrSpliceExists)
+
+	// The splice versions of the commitments are attached to the ones of
+	// the channel when it's fetched from disk.
+	channels, err := cdb.FetchOpenChannels(channel.IdentityPub)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	assertCommitmentEqual(
+		t, &splice.LocalCommitment,
+		channels[0].LocalCommitment.SpliceCommitment,
+	)
+	assertCommitmentEqual(
+		t, &splice.RemoteCommitment,
+		channels[0].RemoteCommitment.SpliceCommitment,
+	)
+
+	diskSplice, err := channel.FetchSplice()
+	require.NoError(t, err)
+	require.Equal(t, splice.FundingOutpoint, diskSplice.FundingOutpoint)
+	require.Equal(t, splice.FundingTx, diskSplice.FundingTx)
+	require.Equal(t, splice.Capacity, diskSplice.Capacity)
+	require.Equal(t, ltcutil.Amount(60_000), diskSplice.LocalContribution)
+	require.Equal(t, ltcutil.Amount(-10_000), diskSplice.RemoteContribution)
+	require.False(t, diskSplice.IsConfirmed())
+
+	// Advance our local commitment, which also updates its splice
+	// version.
+	localCommit := channel.LocalCommitment
+	localCommit.CommitHeight++
+	localSpliceCommit := spliceCommitment(
+		&localCommit, splice.FundingOutpoint,
+	)
+	localCommit.SpliceCommitment = &localSpliceCommit
+	require.NoError(t, channel.UpdateCommitment(&localCommit, nil))
+
+	diskSplice, err = channel.FetchSplice()
+	require.NoError(t, err)
+	assertCommitmentEqual(
+		t, &localSpliceCommit, &diskSplice.LocalCommitment,
+	)
+
+	// Extend a new commitment to the remote party. Its splice version is
+	// attached to the commit diff returned as the tip of the chain.
+	revokedCommit := channel.RemoteCommitment
+	revokedSpliceCommit := *revokedCommit.SpliceCommitment
+
+	remoteCommit := channel.RemoteCommitment
+	remoteCommit.CommitHeight++
+	remoteSpliceCommit := spliceCommitment(
+		&remoteCommit, splice.FundingOutpoint,
+	)
+	remoteCommit.SpliceCommitment = &remoteSpliceCommit
+	commitDiff := &CommitDiff{
+		Commitment: remoteCommit,
+		CommitSig: &lnwire.CommitSig{
+			ChanID:    lnwire.ChannelID(key),
+			CommitSig: wireSig,
+			HtlcSigs:  []lnwire.Sig{},
+			ExtraData: make([]byte, 0),
+		},
+		LogUpdates:        []LogUpdate{},
+		OpenedCircuitKeys: []CircuitKey{},
+		ClosedCircuitKeys: []CircuitKey{},
+	}
+	require.NoError(t, channel.AppendRemoteCommitChain(commitDiff))
+
+	tip, err := channel.RemoteCommitChainTip()
+	require.NoError(t, err)
+	assertCommitmentEqual(
+		t, &remoteSpliceCommit, tip.Commitment.SpliceCommitment,
+	)
+
+	// Once the remote party revokes its prior state, the splice version
+	// of it is logged separately as long as the splice is unconfirmed.
+	fwdPkg := NewFwdPkg(
+		channel.ShortChanID(), revokedCommit.CommitHeight, nil, nil,
+	)
+	require.NoError(t, channel.AdvanceCommitChainTail(fwdPkg, nil))
+	assertCommitmentEqual(
+		t, &remoteSpliceCommit,
+		channel.RemoteCommitment.SpliceCommitment,
+	)
+
+	revokedHeight := revokedCommit.CommitHeight
+	revokedCommit.SpliceCommitment = nil
+	prevCommit, err := channel.FindPreviousState(revokedHeight)
+	require.NoError(t, err)
+	assertCommitmentEqual(t, &revokedCommit, prevCommit)
+
+	// After the splice transaction confirmed, its version of the revoked
+	// state replaces the original one.
+	require.NoError(t, channel.MarkSpliceConfirmed(100))
+	prevCommit, err = channel.FindPreviousState(revokedHeight)
+	require.NoError(t, err)
+	assertCommitmentEqual(t, &revokedSpliceCommit, prevCommit)
+
+	diskSplice, err = channel.FetchSplice()
+	require.NoError(t, err)
+	require.True(t, diskSplice.IsConfirmed())
+
+	// Finally, complete the splice. Its funding output becomes the active
+	// one of the channel, and its commitments replace the original ones.
+	fundingOutpoint := channel.FundingOutpoint
+	require.NoError(t, channel.CompleteSplice())
+
+	_, err = channel.FetchSplice()
+	require.ErrorIs(t, err, ErrNoSplice)
+
+	channels, err = cdb.FetchOpenChannels(channel.IdentityPub)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+
+	diskChannel := channels[0]
+	require.Equal(t, fundingOutpoint, diskChannel.FundingOutpoint)
+	require.Equal(
+		t, splice.FundingOutpoint, diskChannel.ActiveFundingOutpoint(),
+	)
+	require.Equal(t, splice.Capacity, diskChannel.Capacity)
+	assertCommitmentEqual(
+		t, &localSpliceCommit, &diskChannel.LocalCommitment,
+	)
+	assertCommitmentEqual(
+		t, &remoteSpliceCommit, &diskChannel.RemoteCommitment,
+	)
+}
+
+// TestAbandonChannelSplice tests that an abandoned splice is removed from the
+// channel along with the splice versions of its commitments.
+func TestAbandonChannelSplice(t *testing.T) {
+	t.Parallel()
+
+	fullDB, cleanUp, err := MakeTestDB()
+	require.NoError(t, err, "unable to make test database")
+	defer cleanUp()
+
+	cdb := fullDB.ChannelStateDB()
+	channel := createTestChannel(t, cdb, openChannelOption())
+
+	require.ErrorIs(t, channel.AbandonSplice(), ErrNoSplice)
+
+	splice := &ChannelSplice{
+		FundingTx: channel.LocalCommitment.CommitTx.Copy(),
+		Capacity:  channel.Capacity,
+		LocalCommitment: spliceCommitment(
+			&channel.LocalCommitment, wire.OutPoint{Index: 1},
+		),
+		RemoteCommitment: spliceCommitment(
+			&channel.RemoteCommitment, wire.OutPoint{Index: 1},
+		),
+	}
+	require.NoError(t, channel.PutSplice(splice))
+	require.NoError(t, channel.AbandonSplice())
+
+	_, err = channel.FetchSplice()
+	require.ErrorIs(t, err, ErrNoSplice)
+	require.Nil(t, channel.LocalCommitment.SpliceCommitment)
+
+	channels, err := cdb.FetchOpenChannels(channel.IdentityPub)
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	require.Nil(t, channels[0].LocalCommitment.SpliceCommitment)
+	require.Nil(t, channels[0].RemoteCommitment.SpliceCommitment)
+
+	// A new splice can be added once the previous one was abandoned.
+	require.NoError(t, channel.PutSplice(splice))
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/ltcsuite/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var exportGraphCommand = cli.Command{
+	Name:      "exportgraph",
+	Category:  "Graph",
+	Usage:     "Export a snapshot of the channel graph to a file.",
+	ArgsUsage: "output_file",
+	Description: `
+	Write all announced channels, their routing policies and the
+	announcements of their nodes to the given file. The records include
+	the signatures of their announcers, so that the snapshot can be
+	verified and imported by another node with the importgraph command.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "output_file",
+			Usage: "the file to write the graph snapshot to",
+		},
+	},
+	Action: actionDecorator(exportGraph),
+}
+
+func exportGraph(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var outputFile string
+	switch {
+	case ctx.IsSet("output_file"):
+		outputFile = ctx.String("output_file")
+
+	case ctx.Args().Present():
+		outputFile = ctx.Args().First()
+
+	default:
+		return cli.ShowCommandHelp(ctx, "exportgraph")
+	}
+
+	resp, err := client.ExportGraph(ctxc, &lnrpc.ExportGraphRequest{})
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(outputFile, resp.Snapshot, 0644)
+	if err != nil {
+		return fmt.Errorf("unable to write graph snapshot: %v", err)
+	}
+
+	printJSON(struct {
+		NumNodes    uint32 `json:"num_nodes"`
+		NumChannels uint32 `json:"num_channels"`
+		NumPolicies uint32 `json:"num_policies"`
+	}{
+		NumNodes:    resp.NumNodes,
+		NumChannels: resp.NumChannels,
+		NumPolicies: resp.NumPolicies,
+	})
+
+	return nil
+}
+
+var importGraphCommand = cli.Command{
+	Name:      "importgraph",
+	Category:  "Graph",
+	Usage:     "Import a snapshot of the channel graph from a file.",
+	ArgsUsage: "input_file",
+	Description: `
+	Add the channels, routing policies and node announcements of a graph
+	snapshot created with the exportgraph command to the graph, if they're
+	new or more recent than the ones already known. The signatures of all
+	records are verified first, and the snapshot is rejected as a whole if
+	any of them are invalid.
+
+	As the funding outputs of the channels aren't looked up on chain,
+	snapshots should only be imported from trusted sources.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "input_file",
+			Usage: "the file to read the graph snapshot from",
+		},
+	},
+	Action: actionDecorator(importGraph),
+}
+
+func importGraph(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var inputFile string
+	switch {
+	case ctx.IsSet("input_file"):
+		inputFile = ctx.String("input_file")
+
+	case ctx.Args().Present():
+		inputFile = ctx.Args().First()
+
+	default:
+		return cli.ShowCommandHelp(ctx, "importgraph")
+	}
+
+	snapshot, err := ioutil.ReadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("unable to read graph snapshot: %v", err)
+	}
+
+	resp, err := client.ImportGraph(ctxc, &lnrpc.ImportGraphRequest{
+		Snapshot: snapshot,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var getRapidSyncCommand = cli.Command{
+	Name:     "getrapidsync",
+	Category: "Graph",
+	Usage: "Write a rapid sync snapshot of the channel graph to a " +
+		"file.",
+	ArgsUsage: "output_file",
+	Description: `
+	Write a compact snapshot of the announced channels and their routing
+	policies that were updated since the given unix timestamp to the given
+	file. The snapshot doesn't contain the signatures of the individual
+	records, and is signed by this node instead. It can be applied by
+	nodes that trust this node with the applyrapidsync command.
+
+	To keep the graph of a client up to date, the timestamp that is
+	printed should be passed as --since to request the next snapshot.
+
+	Requires the node to be started with --rapidsync.serve.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "output_file",
+			Usage: "the file to write the snapshot to",
+		},
+		cli.Uint64Flag{
+			Name: "since",
+			Usage: "the unix timestamp from which on " +
+				"updates should be included, if not set a " +
+				"snapshot of the whole graph is created",
+		},
+	},
+	Action: actionDecorator(getRapidSync),
+}
+
+func getRapidSync(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var outputFile string
+	switch {
+	case ctx.IsSet("output_file"):
+		outputFile = ctx.String("output_file")
+
+	case ctx.Args().Present():
+		outputFile = ctx.Args().First()
+
+	default:
+		return cli.ShowCommandHelp(ctx, "getrapidsync")
+	}
+
+	resp, err := client.GetRapidSyncSnapshot(
+		ctxc, &lnrpc.RapidSyncSnapshotRequest{
+			Since: ctx.Uint64("since"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(outputFile, resp.Snapshot, 0644)
+	if err != nil {
+		return fmt.Errorf("unable to write snapshot: %v", err)
+	}
+
+	printJSON(struct {
+		Timestamp uint64 `json:"timestamp"`
+		Size      int    `json:"size"`
+	}{
+		Timestamp: resp.Timestamp,
+		Size:      len(resp.Snapshot),
+	})
+
+	return nil
+}
+
+var applyRapidSyncCommand = cli.Command{
+	Name:      "applyrapidsync",
+	Category:  "Graph",
+	Usage:     "Apply a rapid sync snapshot to the channel graph.",
+	ArgsUsage: "input_file",
+	Description: `
+	Add the channels and routing policies of a snapshot created with the
+	getrapidsync command to the graph. The snapshot must be signed by one
+	of the nodes configured with --rapidsync.trusted-source.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "input_file",
+			Usage: "the file to read the snapshot from",
+		},
+	},
+	Action: actionDecorator(applyRapidSync),
+}
+
+func applyRapidSync(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var inputFile string
+	switch {
+	case ctx.IsSet("input_file"):
+		inputFile = ctx.String("input_file")
+
+	case ctx.Args().Present():
+		inputFile = ctx.Args().First()
+
+	default:
+		return cli.ShowCommandHelp(ctx, "applyrapidsync")
+	}
+
+	snapshot, err := ioutil.ReadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("unable to read snapshot: %v", err)
+	}
+
+	resp, err := client.ApplyRapidSyncSnapshot(
+		ctxc, &lnrpc.ApplyRapidSyncSnapshotRequest{
+			Snapshot: snapshot,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
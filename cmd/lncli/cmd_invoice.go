@@ -71,6 +71,12 @@ var addInvoiceCommand = cli.Command{
 			Usage: "creates an AMP invoice. If true, preimage " +
 				"should not be set.",
 		},
+		cli.BoolFlag{
+			Name: "blind",
+			Usage: "[experimental] include blinded paths in the " +
+				"invoice instead of routing hints, hiding the " +
+				"channels through which the payment is received",
+		},
 	},
 	Action: actionDecorator(addInvoice),
 }
@@ -123,8 +129,9 @@ func addInvoice(ctx *cli.Context) error {
 		DescriptionHash: descHash,
 		FallbackAddr:    ctx.String("fallback_addr"),
 		Expiry:          ctx.Int64("expiry"),
-		Private:         ctx.Bool("private"),
+		Private:         ctx.Bool("private") && !ctx.Bool("blind"),
 		IsAmp:           ctx.Bool("amp"),
+		IsBlinded:       ctx.Bool("blind"),
 	}
 
 	resp, err := client.AddInvoice(ctxc, invoice)
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ltcsuite/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var sendOnionCommand = cli.Command{
+	Name:     "sendonion",
+	Category: "Onion Messages",
+	Usage:    "Send an onion message.",
+	Description: `
+	Send an onion message over a path of nodes, a blinded path or a
+	combination of both. If both are set, the message is routed through the
+	path of nodes to the introduction node of the blinded path.
+	`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "path",
+			Usage: "the hex encoded public key of a node to route " +
+				"the message through, can be specified " +
+				"multiple times",
+		},
+		cli.StringFlag{
+			Name: "blinded_dest",
+			Usage: "the hex encoded blinded path of the " +
+				"recipient",
+		},
+		cli.BoolFlag{
+			Name: "reply_path",
+			Usage: "include a blinded path to this node so that " +
+				"the recipient can reply to the message",
+		},
+		cli.StringFlag{
+			Name: "reply_path_id",
+			Usage: "the hex encoded path ID that is returned with " +
+				"any reply received over the reply path",
+		},
+		cli.StringFlag{
+			Name: "data",
+			Usage: "the contents of the message. The required " +
+				"format is: <record_id>=<hex_value>,<record_id>=" +
+				"<hex_value>,.. Record ids start from 64.",
+		},
+	},
+	Action: actionDecorator(sendOnion),
+}
+
+func sendOnion(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SendOnionMessageRequest{
+		IncludeReplyPath: ctx.Bool("reply_path"),
+		Records:          make(map[uint64][]byte),
+	}
+
+	for _, node := range ctx.StringSlice("path") {
+		nodeBytes, err := hex.DecodeString(node)
+		if err != nil {
+			return fmt.Errorf("invalid path: %v", err)
+		}
+
+		req.Path = append(req.Path, nodeBytes)
+	}
+
+	var err error
+	req.BlindedDestination, err = hex.DecodeString(
+		ctx.String("blinded_dest"),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid blinded destination: %v", err)
+	}
+
+	req.ReplyPathId, err = hex.DecodeString(ctx.String("reply_path_id"))
+	if err != nil {
+		return fmt.Errorf("invalid reply path id: %v", err)
+	}
+
+	data := ctx.String("data")
+	if data != "" {
+		records := strings.Split(data, ",")
+		for _, r := range records {
+			kv := strings.Split(r, "=")
+			if len(kv) != 2 {
+				return errors.New("invalid data format: " +
+					"multiple equal signs in record")
+			}
+
+			recordID, err := strconv.ParseUint(kv[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid data format: %v",
+					err)
+			}
+
+			hexValue, err := hex.DecodeString(kv[1])
+			if err != nil {
+				return fmt.Errorf("invalid data format: %v",
+					err)
+			}
+
+			req.Records[recordID] = hexValue
+		}
+	}
+
+	_, err = client.SendOnionMessage(ctxc, req)
+
+	return err
+}
+
+var subscribeOnionCommand = cli.Command{
+	Name:     "subscribeonion",
+	Category: "Onion Messages",
+	Usage:    "Subscribe to onion messages addressed to this node.",
+	Action:   actionDecorator(subscribeOnion),
+}
+
+func subscribeOnion(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeOnionMessages(
+		ctxc,
+		&lnrpc.SubscribeOnionMessagesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(msg)
+	}
+}
@@ -141,6 +141,19 @@ var openChannelCommand = cli.Command{
 				"must be explicitly told about it to be able " +
 				"to route through it",
 		},
+		cli.BoolFlag{
+			Name: "zero_conf",
+			Usage: "(optional) whether a zero-conf channel open " +
+				"should be attempted. The remote peer must " +
+				"explicitly accept the channel and the " +
+				"channel_type must be set",
+		},
+		cli.BoolFlag{
+			Name: "scid_alias",
+			Usage: "(optional) whether the option-scid-alias " +
+				"channel type should be negotiated. Only " +
+				"valid for private channels",
+		},
 		cli.Int64Flag{
 			Name: "min_htlc_msat",
 			Usage: "(optional) the minimum value we will require " +
@@ -316,6 +329,8 @@ func openChannel(ctx *cli.Context) error {
 	}
 
 	req.Private = ctx.Bool("private")
+	req.ZeroConf = ctx.Bool("zero_conf")
+	req.ScidAlias = ctx.Bool("scid_alias")
 
 	// Parse the channel type and map it to its RPC representation.
 	channelType := ctx.String("channel_type")
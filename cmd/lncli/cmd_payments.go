@@ -826,6 +826,99 @@ func payInvoice(ctx *cli.Context) error {
 	return sendPaymentRequest(ctx, req)
 }
 
+var payOfferCommand = cli.Command{
+	Name:     "payoffer",
+	Category: "Payments",
+	Usage:    "Pay a BOLT 12 offer over lightning.",
+	Description: `
+	Request an invoice for the given offer from its issuer over onion
+	messages and pay it over the blinded paths that it contains.
+
+	The amount must be set if the offer does not specify one. Otherwise
+	the amount of the offer is paid for each item requested.
+	`,
+	ArgsUsage: "offer",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "amt_msat",
+			Usage: "(optional) number of millisatoshis to pay, " +
+				"required if the offer has no amount",
+		},
+		cli.Uint64Flag{
+			Name:  "quantity",
+			Usage: "(optional) the number of items to request",
+		},
+		cli.StringFlag{
+			Name:  "payer_note",
+			Usage: "(optional) a note for the issuer of the offer",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit_msat",
+			Usage: "maximum fee allowed in millisatoshis when " +
+				"sending the payment",
+		},
+		cli.DurationFlag{
+			Name: "timeout",
+			Usage: "the maximum amount of time we should spend " +
+				"fetching the invoice, and again paying it",
+			Value: paymentTimeout,
+		},
+		jsonFlag,
+		inflightUpdatesFlag,
+	},
+	Action: actionDecorator(payOffer),
+}
+
+func payOffer(ctx *cli.Context) error {
+	ctxc := getContext()
+	args := ctx.Args()
+
+	if !args.Present() {
+		return fmt.Errorf("offer argument missing")
+	}
+
+	timeout := ctx.Duration("timeout")
+	if timeout <= 0 {
+		return errors.New("timeout must be greater than zero")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := lnrpc.NewLightningClient(conn)
+	routerClient := routerrpc.NewRouterClient(conn)
+
+	// Always print in-flight updates for the table output.
+	printJSON := ctx.Bool(jsonFlag.Name)
+	noInflightUpdates := !ctx.Bool(inflightUpdatesFlag.Name) && printJSON
+
+	req := &routerrpc.PayOfferRequest{
+		Offer:             args.First(),
+		AmtMsat:           ctx.Uint64("amt_msat"),
+		Quantity:          ctx.Uint64("quantity"),
+		PayerNote:         ctx.String("payer_note"),
+		TimeoutSeconds:    int32(timeout.Seconds()),
+		FeeLimitMsat:      ctx.Int64("fee_limit_msat"),
+		NoInflightUpdates: noInflightUpdates,
+	}
+
+	stream, err := routerClient.PayOffer(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	finalState, err := printLivePayment(ctxc, stream, client, printJSON)
+	if err != nil {
+		return err
+	}
+
+	if finalState.Status != lnrpc.Payment_SUCCEEDED {
+		return errors.New(finalState.Status.String())
+	}
+
+	return nil
+}
+
 var sendToRouteCommand = cli.Command{
 	Name:     "sendtoroute",
 	Category: "Payments",
@@ -1156,6 +1249,16 @@ var listPaymentsCommand = cli.Command{
 				"index_offset will be returned, allowing " +
 				"forwards pagination",
 		},
+		cli.Uint64Flag{
+			Name: "creation_date_start",
+			Usage: "timestamp in seconds, if set, only payments " +
+				"created at or after this time are returned",
+		},
+		cli.Uint64Flag{
+			Name: "creation_date_end",
+			Usage: "timestamp in seconds, if set, only payments " +
+				"created at or before this time are returned",
+		},
 	},
 	Action: actionDecorator(listPayments),
 }
@@ -1170,6 +1273,8 @@ func listPayments(ctx *cli.Context) error {
 		IndexOffset:       uint64(ctx.Uint("index_offset")),
 		MaxPayments:       uint64(ctx.Uint("max_payments")),
 		Reversed:          !ctx.Bool("paginate_forwards"),
+		CreationDateStart: ctx.Uint64("creation_date_start"),
+		CreationDateEnd:   ctx.Uint64("creation_date_end"),
 	}
 
 	payments, err := client.ListPayments(ctxc, req)
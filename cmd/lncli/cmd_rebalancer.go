@@ -0,0 +1,170 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ltcsuite/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var startRebalancerCommand = cli.Command{
+	Name:     "startrebalancer",
+	Category: "Rebalancer",
+	Usage:    "Start the built-in rebalancer.",
+	Description: `
+	Start the built-in rebalancer. It periodically moves local balance
+	between the given channels through circular payments, so that the local
+	balance of each channel approaches its target ratio of the channel
+	capacity. Each attempt moves balance out of the channel that exceeds
+	its target the most into the channel that falls short of it the most.
+
+	Targets are given as chan_id:ratio, for example:
+
+	    lncli startrebalancer --target 123456789:0.5 \
+	        --target 987654321:0.3 --max_fee_ppm 500
+
+	Successful rebalances are recorded as payments with the label
+	"rebalance".`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "target",
+			Usage: "a channel to rebalance and the ratio of " +
+				"its capacity that our local balance should " +
+				"be at, as chan_id:ratio; can be specified " +
+				"multiple times",
+		},
+		cli.Float64Flag{
+			Name: "tolerance",
+			Usage: "the deviation from the target ratio below " +
+				"which a channel isn't rebalanced",
+			Value: 0.1,
+		},
+		cli.Uint64Flag{
+			Name: "max_fee_ppm",
+			Usage: "the maximum fee paid for a rebalance, in " +
+				"parts per million of the rebalanced amount",
+			Value: 100,
+		},
+		cli.Int64Flag{
+			Name: "max_amt",
+			Usage: "the largest amount in litoshis that is " +
+				"rebalanced at once",
+			Value: 100_000,
+		},
+		cli.DurationFlag{
+			Name:  "interval",
+			Usage: "the time between two rebalance attempts",
+			Value: 10 * time.Minute,
+		},
+	},
+	Action: actionDecorator(startRebalancer),
+}
+
+func startRebalancer(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	if !ctx.IsSet("target") {
+		_ = cli.ShowCommandHelp(ctx, "startrebalancer")
+		return nil
+	}
+
+	req := &routerrpc.StartRebalancerRequest{
+		Tolerance:       ctx.Float64("tolerance"),
+		MaxFeePpm:       ctx.Uint64("max_fee_ppm"),
+		MaxAmtSat:       ctx.Int64("max_amt"),
+		IntervalSeconds: uint32(ctx.Duration("interval").Seconds()),
+	}
+
+	for _, target := range ctx.StringSlice("target") {
+		parts := strings.Split(target, ":")
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid target %v, expected "+
+				"chan_id:ratio", target)
+		}
+
+		chanID, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid channel ID in target %v: %v",
+				target, err)
+		}
+
+		ratio, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return fmt.Errorf("invalid ratio in target %v: %v",
+				target, err)
+		}
+
+		req.Targets = append(req.Targets, &routerrpc.RebalanceTarget{
+			ChanId:     chanID,
+			LocalRatio: ratio,
+		})
+	}
+
+	client := routerrpc.NewRouterClient(conn)
+	resp, err := client.StartRebalancer(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var stopRebalancerCommand = cli.Command{
+	Name:     "stoprebalancer",
+	Category: "Rebalancer",
+	Usage:    "Stop the built-in rebalancer.",
+	Action:   actionDecorator(stopRebalancer),
+}
+
+func stopRebalancer(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+	resp, err := client.StopRebalancer(
+		ctxc, &routerrpc.StopRebalancerRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var rebalancerStatusCommand = cli.Command{
+	Name:     "rebalancerstatus",
+	Category: "Rebalancer",
+	Usage:    "Display the status of the built-in rebalancer.",
+	Description: `
+	Returns whether the built-in rebalancer is running, the parameters it
+	was last started with and its most recent rebalance attempts.`,
+	Action: actionDecorator(rebalancerStatus),
+}
+
+func rebalancerStatus(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+	resp, err := client.RebalancerStatus(
+		ctxc, &routerrpc.RebalancerStatusRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -698,7 +698,9 @@ var closeChannelCommand = cli.Command{
 	In the case of a cooperative closure, one can manually set the fee to
 	be used for the closing transaction via either the --conf_target or
 	--sat_per_vbyte arguments. This will be the starting value used during
-	fee negotiation. This is optional.
+	fee negotiation. This is optional. If both peers support the simple
+	close protocol, running closechannel again while the closing
+	transaction is pending replaces it with one paying the new fee rate.
 
 	In the case of a cooperative closure, one can manually set the address
 	to deliver funds to upon closure. This is optional, and may only be used
@@ -1179,6 +1181,90 @@ func abandonChannel(ctx *cli.Context) error {
 	return nil
 }
 
+var spliceChannelCommand = cli.Command{
+	Name:     "splicechannel",
+	Category: "Channels",
+	Usage:    "Add funds to or remove funds from an existing channel.",
+	Description: `
+	Negotiate a splice transaction with the channel peer, which spends the
+	current funding output of the channel into a new one. A positive amount
+	adds funds of the wallet to the channel, a negative amount removes funds
+	from the channel and pays them to a new address of the wallet.
+
+	The channel remains usable while the splice transaction confirms.
+
+	To view which funding_txids/output_indexes can be used for this command,
+	see the channel_point values within the listchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+		cli.Int64Flag{
+			Name: "amt",
+			Usage: "the number of satoshis to add to the " +
+				"channel, a negative value removes funds " +
+				"from the channel",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"splice transaction *should* confirm in, will " +
+				"be used for fee estimation",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/vbyte that should be used when crafting " +
+				"the splice transaction",
+		},
+	},
+	Action: actionDecorator(spliceChannel),
+}
+
+func spliceChannel(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "splicechannel")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("amt") {
+		return fmt.Errorf("amt argument missing")
+	}
+
+	req := &lnrpc.SpliceChannelRequest{
+		ChannelPoint: channelPoint,
+		Amount:       ctx.Int64("amt"),
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		SatPerVbyte:  ctx.Uint64("sat_per_vbyte"),
+	}
+
+	resp, err := client.SpliceChannel(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // parseChannelPoint parses a funding txid and output index from the command
 // line. Both named options as well as unnamed parameters are supported.
 func parseChannelPoint(ctx *cli.Context) (*lnrpc.ChannelPoint, error) {
@@ -1358,6 +1444,82 @@ func pendingChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var listInboundReservationsCommand = cli.Command{
+	Name:     "listinboundreservations",
+	Category: "Channels",
+	Usage: "List the channels remote peers are currently negotiating " +
+		"to open with us.",
+	Action: actionDecorator(listInboundReservations),
+}
+
+func listInboundReservations(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListInboundReservationsRequest{}
+	resp, err := client.ListInboundReservations(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var cancelInboundReservationCommand = cli.Command{
+	Name:      "cancelinboundreservation",
+	Category:  "Channels",
+	Usage:     "Cancel a channel a remote peer is negotiating to open.",
+	ArgsUsage: "pending_chan_id",
+	Description: `
+	Cancel the reservation of a channel that a remote peer is currently
+	negotiating to open with us, and fail the funding flow with the peer.
+	The pending channel ID can be found with listinboundreservations.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "pending_chan_id",
+			Usage: "the hex-encoded temporary ID of the " +
+				"channel",
+		},
+	},
+	Action: actionDecorator(cancelInboundReservation),
+}
+
+func cancelInboundReservation(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var pendingChanIDStr string
+	switch {
+	case ctx.IsSet("pending_chan_id"):
+		pendingChanIDStr = ctx.String("pending_chan_id")
+	case ctx.Args().Present():
+		pendingChanIDStr = ctx.Args().First()
+	default:
+		return cli.ShowCommandHelp(ctx, "cancelinboundreservation")
+	}
+
+	pendingChanID, err := hex.DecodeString(pendingChanIDStr)
+	if err != nil {
+		return fmt.Errorf("unable to decode pending chan ID: %v", err)
+	}
+
+	req := &lnrpc.CancelInboundReservationRequest{
+		PendingChanId: pendingChanID,
+	}
+	resp, err := client.CancelInboundReservation(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var listChannelsCommand = cli.Command{
 	Name:     "listchannels",
 	Category: "Channels",
@@ -1549,6 +1711,31 @@ func getNodeMetrics(ctx *cli.Context) error {
 	return nil
 }
 
+var gossipStatsCommand = cli.Command{
+	Name:     "gossipstats",
+	Category: "Graph",
+	Usage:    "Get the gossip traffic exchanged with each peer.",
+	Description: "Prints out the number of gossip messages and bytes " +
+		"exchanged with each connected peer, and the number of " +
+		"announcements dropped because the peer exceeded its rate " +
+		"limit.",
+	Action: actionDecorator(gossipStats),
+}
+
+func gossipStats(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.GossipStats(ctxc, &lnrpc.GossipStatsRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var getChanInfoCommand = cli.Command{
 	Name:     "getchaninfo",
 	Category: "Graph",
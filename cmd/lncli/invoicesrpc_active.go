@@ -19,6 +19,7 @@ func invoicesCommands() []cli.Command {
 		cancelInvoiceCommand,
 		addHoldInvoiceCommand,
 		settleInvoiceCommand,
+		addOfferCommand,
 	}
 }
 
@@ -253,3 +254,74 @@ func addHoldInvoice(ctx *cli.Context) error {
 
 	return nil
 }
+
+var addOfferCommand = cli.Command{
+	Name:     "addoffer",
+	Category: "Invoices",
+	Usage:    "Add a new BOLT 12 offer.",
+	Description: `
+	Add a new offer, a static payment code that can be paid repeatedly.
+	Payers request an invoice for the offer from this node over onion
+	messages.
+
+	Offers without an amount allow the payer to choose the amount they
+	wish to send.`,
+	ArgsUsage: "[amt_msat]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "description",
+			Usage: "a description of the purpose of the payment, " +
+				"required if an amount is set",
+		},
+		cli.Uint64Flag{
+			Name:  "amt_msat",
+			Usage: "the amt of millisatoshis to pay per item",
+		},
+		cli.StringFlag{
+			Name:  "issuer",
+			Usage: "(optional) a description of the issuer",
+		},
+		cli.Uint64Flag{
+			Name: "quantity_max",
+			Usage: "(optional) the maximum number of items that " +
+				"can be requested at once",
+		},
+		cli.Int64Flag{
+			Name: "absolute_expiry",
+			Usage: "(optional) the unix timestamp after which " +
+				"the offer can no longer be paid",
+		},
+	},
+	Action: actionDecorator(addOffer),
+}
+
+func addOffer(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getInvoicesClient(ctx)
+	defer cleanUp()
+
+	var err error
+	amtMsat := ctx.Uint64("amt_msat")
+	if !ctx.IsSet("amt_msat") && ctx.Args().Present() {
+		amtMsat, err = strconv.ParseUint(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt_msat "+
+				"argument: %v", err)
+		}
+	}
+
+	resp, err := client.AddOffer(ctxc, &invoicesrpc.AddOfferRequest{
+		AmountMsat:     amtMsat,
+		Description:    ctx.String("description"),
+		Issuer:         ctx.String("issuer"),
+		QuantityMax:    ctx.Uint64("quantity_max"),
+		AbsoluteExpiry: ctx.Int64("absolute_expiry"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
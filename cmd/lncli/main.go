@@ -342,14 +342,18 @@ func main() {
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
+		spliceChannelCommand,
 		listPeersCommand,
 		walletBalanceCommand,
 		channelBalanceCommand,
 		getInfoCommand,
 		getRecoveryInfoCommand,
 		pendingChannelsCommand,
+		listInboundReservationsCommand,
+		cancelInboundReservationCommand,
 		sendPaymentCommand,
 		payInvoiceCommand,
+		payOfferCommand,
 		sendToRouteCommand,
 		addInvoiceCommand,
 		lookupInvoiceCommand,
@@ -359,6 +363,11 @@ func main() {
 		listPaymentsCommand,
 		describeGraphCommand,
 		getNodeMetricsCommand,
+		exportGraphCommand,
+		importGraphCommand,
+		getRapidSyncCommand,
+		applyRapidSyncCommand,
+		gossipStatsCommand,
 		getChanInfoCommand,
 		getNodeInfoCommand,
 		queryRoutesCommand,
@@ -387,6 +396,8 @@ func main() {
 		deletePaymentsCommand,
 		sendCustomCommand,
 		subscribeCustomCommand,
+		sendOnionCommand,
+		subscribeOnionCommand,
 	}
 
 	// Add any extra commands determined by build flags.
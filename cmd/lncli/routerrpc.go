@@ -13,5 +13,8 @@ func routerCommands() []cli.Command {
 		getCfgCommand,
 		setCfgCommand,
 		updateChanStatusCommand,
+		startRebalancerCommand,
+		stopRebalancerCommand,
+		rebalancerStatusCommand,
 	}
 }
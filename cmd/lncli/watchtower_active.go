@@ -4,6 +4,9 @@
 package main
 
 import (
+	"encoding/hex"
+	"errors"
+
 	"github.com/ltcsuite/lnd/lnrpc/watchtowerrpc"
 	"github.com/urfave/cli"
 )
@@ -16,6 +19,9 @@ func watchtowerCommands() []cli.Command {
 			Category: "Watchtower",
 			Subcommands: []cli.Command{
 				towerInfoCommand,
+				towerRewardsCommand,
+				towerSessionsCommand,
+				towerRevokeCommand,
 			},
 		},
 	}
@@ -54,3 +60,124 @@ func towerInfo(ctx *cli.Context) error {
 
 	return nil
 }
+
+var towerRewardsCommand = cli.Command{
+	Name:   "rewards",
+	Usage:  "Returns the rewards claimed by the active watchtower.",
+	Action: actionDecorator(towerRewards),
+}
+
+func towerRewards(ctx *cli.Context) error {
+	ctxc := getContext()
+	if ctx.NArg() != 0 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "rewards")
+	}
+
+	client, cleanup := getWatchtowerClient(ctx)
+	defer cleanup()
+
+	req := &watchtowerrpc.ListRewardsRequest{}
+	resp, err := client.ListRewards(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var towerSessionsCommand = cli.Command{
+	Name:  "sessions",
+	Usage: "Returns the sessions negotiated with the active watchtower.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "client_key",
+			Usage: "if set, only the sessions of the client with " +
+				"this hex-encoded key are returned",
+		},
+	},
+	Action: actionDecorator(towerSessions),
+}
+
+func towerSessions(ctx *cli.Context) error {
+	ctxc := getContext()
+	if ctx.NArg() != 0 {
+		return cli.ShowCommandHelp(ctx, "sessions")
+	}
+
+	clientKey, err := hex.DecodeString(ctx.String("client_key"))
+	if err != nil {
+		return err
+	}
+
+	client, cleanup := getWatchtowerClient(ctx)
+	defer cleanup()
+
+	req := &watchtowerrpc.ListSessionsRequest{
+		ClientKey: clientKey,
+	}
+	resp, err := client.ListSessions(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var towerRevokeCommand = cli.Command{
+	Name: "revoke",
+	Usage: "Deletes a session or all sessions of a client from the " +
+		"active watchtower.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "session_id",
+			Usage: "the hex-encoded id of the session to revoke",
+		},
+		cli.StringFlag{
+			Name: "client_key",
+			Usage: "the hex-encoded key of the client whose " +
+				"sessions are all revoked",
+		},
+	},
+	Action: actionDecorator(towerRevoke),
+}
+
+func towerRevoke(ctx *cli.Context) error {
+	ctxc := getContext()
+	if ctx.NArg() != 0 || ctx.NumFlags() != 1 {
+		return cli.ShowCommandHelp(ctx, "revoke")
+	}
+
+	sessionID, err := hex.DecodeString(ctx.String("session_id"))
+	if err != nil {
+		return err
+	}
+
+	clientKey, err := hex.DecodeString(ctx.String("client_key"))
+	if err != nil {
+		return err
+	}
+
+	if len(sessionID) == 0 && len(clientKey) == 0 {
+		return errors.New("session_id or client_key must be set")
+	}
+
+	client, cleanup := getWatchtowerClient(ctx)
+	defer cleanup()
+
+	req := &watchtowerrpc.RevokeSessionsRequest{
+		SessionId: sessionID,
+		ClientKey: clientKey,
+	}
+	resp, err := client.RevokeSessions(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
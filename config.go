@@ -37,6 +37,8 @@ import (
 	"github.com/ltcsuite/lnd/routing"
 	"github.com/ltcsuite/lnd/signal"
 	"github.com/ltcsuite/lnd/tor"
+	"github.com/ltcsuite/lnd/watchtower"
+	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
 	"github.com/ltcsuite/ltcd/ltcutil"
 	"github.com/ltcsuite/neutrino"
 )
@@ -289,6 +291,8 @@ type Config struct {
 	UnsafeDisconnect   bool   `long:"unsafe-disconnect" description:"DEPRECATED: Allows the rpcserver to intentionally disconnect from peers with open channels. THIS FLAG WILL BE REMOVED IN 0.10.0"`
 	UnsafeReplay       bool   `long:"unsafe-replay" description:"Causes a link to replay the adds on its commitment txn after starting up, this enables testing of the sphinx replay logic."`
 	MaxPendingChannels int    `long:"maxpendingchannels" description:"The maximum number of incoming pending channels permitted per peer."`
+	MaxPendingInbound  int    `long:"maxpendinginbound" description:"The maximum number of incoming pending channels permitted across all peers. Set to 0 to disable the limit."`
+	PendingFlapPenalty int    `long:"pendingflappenalty" description:"The number of flaps (disconnects) of a peer after which its maxpendingchannels limit is halved. The limit keeps decreasing with further flaps, and peers whose limit drops below 0.5 can't open channels anymore. Set to 0 to disable reputation based limits."`
 	BackupFilePath     string `long:"backupfilepath" description:"The target location of the channel backup file"`
 
 	FeeURL string `long:"feeurl" description:"Optional URL for external fee estimation. If no URL is specified, the method for fee estimation will depend on the chosen backend and network. Must be set for neutrino on mainnet."`
@@ -348,6 +352,10 @@ type Config struct {
 
 	RejectHTLC bool `long:"rejecthtlc" description:"If true, lnd will not forward any HTLCs that are meant as onward payments. This option will still allow lnd to send HTLCs and receive HTLCs but lnd won't be used as a hop."`
 
+	RequireInterceptor bool `long:"requireinterceptor" description:"If true, HTLCs that are forwarded are held even while no HTLC interceptor is connected. HTLCs held by an interceptor that disconnects remain held, and are replayed to the interceptor once it reconnects, rather than being resumed."`
+
+	HeldForwardFailDelta uint32 `long:"heldforwardfaildelta" description:"The number of blocks before the expiry of an incoming HTLC at which a forward held by the HTLC interceptor is failed back automatically. Must be larger than the incoming broadcast delta of 10 blocks."`
+
 	StaggerInitialReconnect bool `long:"stagger-initial-reconnect" description:"If true, will apply a randomized staggering between 0s and 30s when reconnecting to persistent peers on startup. The first 10 reconnections will be attempted instantly, regardless of the flag's value"`
 
 	MaxOutgoingCltvExpiry uint32 `long:"max-cltv-expiry" description:"The maximum number of blocks funds could be locked up for when forwarding payments."`
@@ -380,6 +388,12 @@ type Config struct {
 
 	Gossip *lncfg.Gossip `group:"gossip" namespace:"gossip"`
 
+	RapidSync *lncfg.RapidSync `group:"rapidsync" namespace:"rapidsync"`
+
+	AcceptorPolicy *lncfg.AcceptorPolicy `group:"acceptorpolicy" namespace:"acceptorpolicy"`
+
+	FeePolicy *lncfg.FeePolicy `group:"feepolicy" namespace:"feepolicy"`
+
 	Workers *lncfg.Workers `group:"workers" namespace:"workers"`
 
 	Caches *lncfg.Caches `group:"caches" namespace:"caches"`
@@ -486,6 +500,7 @@ func DefaultConfig() Config {
 		BlockCacheSize:     defaultBlockCacheSize,
 		UnsafeDisconnect:   true,
 		MaxPendingChannels: lncfg.DefaultMaxPendingChannels,
+		MaxPendingInbound:  lncfg.DefaultMaxPendingInbound,
 		NoSeedBackup:       defaultNoSeedBackup,
 		MinBackoff:         defaultMinBackoff,
 		MaxBackoff:         defaultMaxBackoff,
@@ -537,6 +552,12 @@ func DefaultConfig() Config {
 		Prometheus: lncfg.DefaultPrometheus(),
 		Watchtower: &lncfg.Watchtower{
 			TowerDir: defaultTowerDir,
+			Conf: watchtower.Conf{
+				RewardBase: uint32(lnwallet.DustLimitForSize(
+					input.P2WPKHSize,
+				)),
+				RewardRate: wtpolicy.DefaultRewardRate,
+			},
 		},
 		HealthChecks: &lncfg.HealthCheckConfig{
 			ChainCheck: &lncfg.CheckConfig{
@@ -576,7 +597,13 @@ func DefaultConfig() Config {
 		Gossip: &lncfg.Gossip{
 			MaxChannelUpdateBurst: discovery.DefaultMaxChannelUpdateBurst,
 			ChannelUpdateInterval: discovery.DefaultChannelUpdateInterval,
+			PeerMsgRateLimit:      discovery.DefaultPeerMsgRateLimit,
+			PeerMsgBurst:          discovery.DefaultPeerMsgBurst,
 		},
+		RapidSync:            &lncfg.RapidSync{},
+		AcceptorPolicy:       &lncfg.AcceptorPolicy{},
+		FeePolicy:            lncfg.DefaultFeePolicy(),
+		HeldForwardFailDelta: lncfg.DefaultHeldForwardFailDelta,
 		Invoices: &lncfg.Invoices{
 			HoldExpiryDelta: lncfg.DefaultHoldInvoiceExpiryDelta,
 		},
@@ -899,6 +926,13 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 		)
 	}
 
+	// The zero-conf channel type relies on scid aliases to refer to the
+	// channel before its funding transaction confirms.
+	if cfg.ProtocolOptions.ZeroConf() && !cfg.ProtocolOptions.ScidAlias() {
+		return nil, mkErr("'--protocol.zero-conf' requires " +
+			"'--protocol.option-scid-alias'")
+	}
+
 	// Ensure a valid max channel fee allocation was set.
 	if cfg.MaxChannelFeeAllocation <= 0 || cfg.MaxChannelFeeAllocation > 1 {
 		return nil, mkErr("invalid max channel fee allocation: %v, "+
@@ -1431,6 +1465,21 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 			maxRemoteHtlcs)
 	}
 
+	// Held forwards must be failed back before we'd force close the
+	// incoming channel to claim the htlc on chain.
+	if cfg.HeldForwardFailDelta <= lncfg.DefaultIncomingBroadcastDelta {
+		return nil, mkErr("heldforwardfaildelta (%v) must be greater "+
+			"than %v", cfg.HeldForwardFailDelta,
+			lncfg.DefaultIncomingBroadcastDelta)
+	}
+
+	if cfg.MaxPendingInbound < 0 {
+		return nil, mkErr("maxpendinginbound must be non-negative")
+	}
+	if cfg.PendingFlapPenalty < 0 {
+		return nil, mkErr("pendingflappenalty must be non-negative")
+	}
+
 	if err := cfg.Gossip.Parse(); err != nil {
 		return nil, mkErr("error parsing gossip syncer: %v", err)
 	}
@@ -1457,6 +1506,9 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 		cfg.HealthChecks,
 		cfg.RPCMiddleware,
 		cfg.RemoteSigner,
+		cfg.RapidSync,
+		cfg.AcceptorPolicy,
+		cfg.FeePolicy,
 	)
 	if err != nil {
 		return nil, err
@@ -18,6 +18,8 @@ import (
 	"github.com/ltcsuite/lnd/blockcache"
 	"github.com/ltcsuite/lnd/chainreg"
 	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/clock"
+	"github.com/ltcsuite/lnd/invoices"
 	"github.com/ltcsuite/lnd/keychain"
 	"github.com/ltcsuite/lnd/kvdb"
 	"github.com/ltcsuite/lnd/lncfg"
@@ -26,6 +28,7 @@ import (
 	"github.com/ltcsuite/lnd/lnwallet/btcwallet"
 	"github.com/ltcsuite/lnd/lnwallet/rpcwallet"
 	"github.com/ltcsuite/lnd/macaroons"
+	"github.com/ltcsuite/lnd/routing"
 	"github.com/ltcsuite/lnd/rpcperms"
 	"github.com/ltcsuite/lnd/signal"
 	"github.com/ltcsuite/lnd/tor"
@@ -747,6 +750,16 @@ type DatabaseInstances struct {
 	// complete!
 	ChanStateDB *channeldb.DB
 
+	// InvoiceDB is the database that stores our invoices. This is the
+	// ChanStateDB above, unless the invoices are kept in native SQL
+	// tables.
+	InvoiceDB invoices.InvoiceDB
+
+	// PaymentDB is the database that stores our outgoing payments. This is
+	// a payment control on top of the ChanStateDB above, unless the
+	// payments are kept in native SQL tables.
+	PaymentDB routing.PaymentDB
+
 	// HeightHintDB is the database that stores height hints for spends.
 	HeightHintDB kvdb.Backend
 
@@ -899,6 +912,51 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 	// using the same struct (and DB backend) instance.
 	dbs.ChanStateDB = dbs.GraphDB
 
+	// Invoices are kept in the channel state DB, unless the native SQL
+	// store is enabled. In that case we'll move any invoices that are
+	// still in the kvdb invoice bucket over to the SQL tables first.
+	dbs.InvoiceDB = dbs.ChanStateDB
+	if databaseBackends.NativeSQLStore != nil {
+		invoiceStore := invoices.NewSQLStore(
+			databaseBackends.NativeSQLStore,
+			clock.NewDefaultClock(),
+		)
+
+		err := invoices.MigrateInvoicesToSQL(
+			dbs.ChanStateDB, invoiceStore,
+		)
+		if err != nil {
+			cleanUp()
+
+			err := fmt.Errorf("unable to migrate invoices to "+
+				"native SQL store: %v", err)
+			d.logger.Error(err)
+			return nil, nil, err
+		}
+
+		dbs.InvoiceDB = invoiceStore
+	}
+
+	// The same goes for the outgoing payments and their HTLC attempts.
+	dbs.PaymentDB = channeldb.NewPaymentControl(dbs.ChanStateDB)
+	if databaseBackends.NativeSQLStore != nil {
+		paymentStore := routing.NewSQLPaymentStore(
+			databaseBackends.NativeSQLStore,
+		)
+
+		err := routing.MigratePaymentsToSQL(dbs.PaymentDB, paymentStore)
+		if err != nil {
+			cleanUp()
+
+			err := fmt.Errorf("unable to migrate payments to "+
+				"native SQL store: %v", err)
+			d.logger.Error(err)
+			return nil, nil, err
+		}
+
+		dbs.PaymentDB = paymentStore
+	}
+
 	// Wrap the watchtower client DB and make sure we clean up.
 	if cfg.WtClient.Active {
 		dbs.TowerClientDB, err = wtdb.OpenClientDB(
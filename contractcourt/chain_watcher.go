@@ -261,8 +261,9 @@ func (c *chainWatcher) Start() error {
 		chanState.FundingOutpoint)
 
 	// First, we'll register for a notification to be dispatched if the
-	// funding output is spent.
-	fundingOut := &chanState.FundingOutpoint
+	// funding output is spent. If the channel was spliced, this is the
+	// funding output of the last splice.
+	fundingOut := chanState.ActiveFundingOutpoint()
 
 	// As a height hint, we'll try to use the opening height, but if the
 	// channel isn't yet open, then we'll use the height it was broadcast
@@ -272,6 +273,19 @@ func (c *chainWatcher) Start() error {
 		heightHint = chanState.FundingBroadcastHeight
 	}
 
+	// If the transaction of a pending splice already confirmed, then the
+	// funding output of the splice is the one that can be spent by a
+	// closing transaction.
+	splice, err := chanState.FetchSplice()
+	switch {
+	case err == nil && splice.IsConfirmed():
+		fundingOut = splice.FundingOutpoint
+		heightHint = splice.ConfirmHeight
+
+	case err != nil && err != channeldb.ErrNoSplice:
+		return err
+	}
+
 	localKey := chanState.LocalChanCfg.MultiSigKey.PubKey.SerializeCompressed()
 	remoteKey := chanState.RemoteChanCfg.MultiSigKey.PubKey.SerializeCompressed()
 	multiSigScript, err := input.GenMultiSigScript(
@@ -286,7 +300,7 @@ func (c *chainWatcher) Start() error {
 	}
 
 	spendNtfn, err := c.cfg.notifier.RegisterSpendNtfn(
-		fundingOut, pkScript, heightHint,
+		&fundingOut, pkScript, heightHint,
 	)
 	if err != nil {
 		return err
@@ -499,6 +513,28 @@ func newChainSet(chanState *channeldb.OpenChannel) (*chainSet, error) {
 			chanState.FundingOutpoint, err)
 	}
 
+	// Once the transaction of a pending splice confirmed, the original
+	// funding output is spent, so only the splice versions of the
+	// commitments can be broadcast.
+	splice, err := chanState.FetchSplice()
+	switch {
+	case err == nil && splice.IsConfirmed():
+		log.Debugf("ChannelPoint(%v): using commitments of confirmed "+
+			"splice %v", chanState.FundingOutpoint,
+			splice.FundingOutpoint)
+
+		localCommit = &splice.LocalCommitment
+		remoteCommit = &splice.RemoteCommitment
+		pendingCommit := splice.RemotePendingCommitment
+		if remoteChainTip != nil && pendingCommit != nil {
+			remoteChainTip.Commitment = *pendingCommit
+		}
+
+	case err != nil && err != channeldb.ErrNoSplice:
+		return nil, fmt.Errorf("unable to fetch splice of "+
+			"ChannelPoint(%v): %v", chanState.FundingOutpoint, err)
+	}
+
 	// Now that we have all the possible valid commitments, we'll make the
 	// CommitSet the ChannelArbitrator will need in order to carry out its
 	// duty.
@@ -567,6 +603,23 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 			return
 		}
 
+		// If the funding output was spent by the transaction of a
+		// pending splice, then the channel remains open, and we'll
+		// watch the funding output of the splice from now on.
+		spliceNtfn, err := c.handleSpliceSpend(commitSpend)
+		if err != nil {
+			log.Errorf("Unable to handle splice spend: %v", err)
+			return
+		}
+
+		if spliceNtfn != nil {
+			spendNtfn.Cancel()
+
+			c.wg.Add(1)
+			go c.closeObserver(spliceNtfn)
+			return
+		}
+
 		// Otherwise, the remote party might have broadcast a prior
 		// revoked state...!!!
 		commitTxBroadcast := commitSpend.SpendingTx
@@ -620,10 +673,15 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 
 		// Next, we'll check to see if this is a cooperative channel
 		// closure or not. This is characterized by having an input
-		// sequence number that's finalized. This won't happen with
+		// sequence number that's finalized, or one that signals
+		// replaceability in case of a closing transaction negotiated
+		// with the simple close protocol. This won't happen with
 		// regular commitment transactions due to the state hint
 		// encoding scheme.
-		if commitTxBroadcast.TxIn[0].Sequence == wire.MaxTxInSequenceNum {
+		sequence := commitTxBroadcast.TxIn[0].Sequence
+		if sequence == wire.MaxTxInSequenceNum ||
+			sequence == lnwallet.SimpleCloseSequence {
+
 			// TODO(roasbeef): rare but possible, need itest case
 			// for
 			err := c.dispatchCooperativeClose(commitSpend)
@@ -680,6 +738,42 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 	}
 }
 
+// handleSpliceSpend checks whether the passed spend of the funding output is
+// the transaction of the pending splice of the channel. If so, the
+// confirmation of the splice is recorded, and a spend notification for the
+// funding output of the splice is returned. Otherwise, nil is returned.
+func (c *chainWatcher) handleSpliceSpend(
+	commitSpend *chainntnfs.SpendDetail) (*chainntnfs.SpendEvent, error) {
+
+	chanState := c.cfg.chanState
+	splice, err := chanState.FetchSplice()
+	switch {
+	case err == channeldb.ErrNoSplice:
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	if *commitSpend.SpenderTxHash != splice.FundingOutpoint.Hash {
+		return nil, nil
+	}
+
+	spendHeight := uint32(commitSpend.SpendingHeight)
+	log.Infof("ChannelPoint(%v): splice tx %v confirmed at height %v",
+		chanState.FundingOutpoint, splice.FundingOutpoint.Hash,
+		spendHeight)
+
+	if err := chanState.MarkSpliceConfirmed(spendHeight); err != nil {
+		return nil, err
+	}
+
+	fundingOutput := splice.FundingTx.TxOut[splice.FundingOutpoint.Index]
+	return c.cfg.notifier.RegisterSpendNtfn(
+		&splice.FundingOutpoint, fundingOutput.PkScript, spendHeight,
+	)
+}
+
 // handleKnownLocalState checks whether the passed spend is a local state that
 // is known to us (the current state). If so we will act on this state using
 // the passed chainSet. If this is not a known local state, false is returned.
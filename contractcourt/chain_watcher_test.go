@@ -11,6 +11,7 @@ import (
 	"github.com/ltcsuite/lnd/channeldb"
 	"github.com/ltcsuite/lnd/input"
 	"github.com/ltcsuite/lnd/lntest/mock"
+	"github.com/ltcsuite/lnd/lntest/wait"
 	"github.com/ltcsuite/lnd/lnwallet"
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/ltcd/wire"
@@ -84,6 +85,110 @@ func TestChainWatcherRemoteUnilateralClose(t *testing.T) {
 	}
 }
 
+// TestChainWatcherSpliceConfirmation tests that the chain watcher keeps
+// watching a channel once its funding output is spent by the transaction of a
+// pending splice, and that it detects closes of the channel that spend the
+// funding output of the splice.
+func TestChainWatcherSpliceConfirmation(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels(
+		channeldb.SingleFunderTweaklessBit,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll add a splice to Alice's channel, which spends the current
+	// funding output into a new one with the same script.
+	aliceState := aliceChannel.State()
+	fundingOut := aliceChannel.SpliceFundingOutput(aliceState.Capacity)
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: aliceState.FundingOutpoint,
+	})
+	spliceTx.AddTxOut(fundingOut)
+	spliceTxHash := spliceTx.TxHash()
+
+	err = aliceState.PutSplice(&channeldb.ChannelSplice{
+		FundingOutpoint: wire.OutPoint{
+			Hash:  spliceTxHash,
+			Index: 0,
+		},
+		FundingTx:        spliceTx,
+		Capacity:         aliceState.Capacity,
+		LocalCommitment:  aliceState.LocalCommitment,
+		RemoteCommitment: aliceState.RemoteCommitment,
+	})
+	if err != nil {
+		t.Fatalf("unable to add splice: %v", err)
+	}
+
+	aliceNotifier := &mock.ChainNotifier{
+		SpendChan: make(chan *chainntnfs.SpendDetail),
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+		ConfChan:  make(chan *chainntnfs.TxConfirmation),
+	}
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           aliceState,
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	err = aliceChainWatcher.Start()
+	if err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	// Once the splice transaction spends the funding output, the chain
+	// watcher should record the confirmation of the splice.
+	const spliceHeight = 100
+	aliceNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash:  &spliceTxHash,
+		SpendingTx:     spliceTx,
+		SpendingHeight: spliceHeight,
+	}
+
+	err = wait.NoError(func() error {
+		splice, err := aliceState.FetchSplice()
+		if err != nil {
+			return err
+		}
+
+		if splice.ConfirmHeight != spliceHeight {
+			return fmt.Errorf("expected confirm height %v, got %v",
+				spliceHeight, splice.ConfirmHeight)
+		}
+
+		return nil
+	}, time.Second*15)
+	if err != nil {
+		t.Fatalf("splice not confirmed: %v", err)
+	}
+
+	// The chain watcher should now detect a broadcast of Bob's
+	// commitment, which spends the funding output of the splice.
+	bobCommit := bobChannel.State().LocalCommitment.CommitTx
+	bobTxHash := bobCommit.TxHash()
+	aliceNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+
+	select {
+	case <-chanEvents.RemoteUnilateralClosure:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive unilateral close event")
+	}
+}
+
 func addFakeHTLC(t *testing.T, htlcAmount lnwire.MilliSatoshi, id uint64,
 	aliceChannel, bobChannel *lnwallet.LightningChannel) {
 
@@ -443,9 +443,15 @@ func (h *htlcIncomingContestResolver) SupplementState(_ *channeldb.OpenChannel)
 // decodePayload (re)decodes the hop payload of a received htlc.
 func (h *htlcIncomingContestResolver) decodePayload() (*hop.Payload, error) {
 
+	blindingInfo := hop.ReconstructBlindingInfo{
+		BlindingKey:    h.htlc.BlindingPoint,
+		IncomingAmt:    h.htlc.Amt,
+		IncomingExpiry: h.htlc.RefundTimeout,
+	}
+
 	onionReader := bytes.NewReader(h.htlc.OnionBlob)
 	iterator, err := h.OnionProcessor.ReconstructHopIterator(
-		onionReader, h.htlc.RHash[:],
+		onionReader, h.htlc.RHash[:], blindingInfo,
 	)
 	if err != nil {
 		return nil, err
@@ -45,14 +45,26 @@ type ChannelGraphTimeSeries interface {
 	FilterChannelRange(chain chainhash.Hash,
 		startHeight, endHeight uint32) ([]channeldb.BlockChannelRange, error)
 
+	// FetchChanUpdateInfos returns the timestamps and checksums of the
+	// latest channel updates of the channels we know of among the set of
+	// specified short channel ID's. We'll use this to reply to a
+	// QueryChannelRange message that requests them, and to determine which
+	// of the channels we know of have updates we're missing.
+	FetchChanUpdateInfos(chain chainhash.Hash,
+		shortChanIDs []lnwire.ShortChannelID) (
+		map[lnwire.ShortChannelID]*ChanUpdateInfo, error)
+
 	// FetchChanAnns returns a full set of channel announcements as well as
 	// their updates that match the set of specified short channel ID's.
 	// We'll use this to reply to a QueryShortChanIDs message sent by a
 	// remote peer. The response will contain a unique set of
 	// ChannelAnnouncements, the latest ChannelUpdate for each of the
-	// announcements, and a unique set of NodeAnnouncements.
+	// announcements, and a unique set of NodeAnnouncements. If query flags
+	// are given, only the messages they select for the channel at the
+	// same index are included.
 	FetchChanAnns(chain chainhash.Hash,
-		shortChanIDs []lnwire.ShortChannelID) ([]lnwire.Message, error)
+		shortChanIDs []lnwire.ShortChannelID,
+		queryFlags []lnwire.QueryFlag) ([]lnwire.Message, error)
 
 	// FetchChanUpdates returns the latest channel update messages for the
 	// specified short channel ID. If no channel updates are known for the
@@ -61,6 +73,18 @@ type ChannelGraphTimeSeries interface {
 		shortChanID lnwire.ShortChannelID) ([]*lnwire.ChannelUpdate, error)
 }
 
+// ChanUpdateInfo holds the timestamps and checksums of the latest channel
+// updates of both directions of a channel.
+type ChanUpdateInfo struct {
+	// Timestamps are the timestamps of the updates, which are zero for a
+	// direction we don't know an update of.
+	Timestamps lnwire.ChanUpdateTimestamps
+
+	// Checksums are the checksums of the updates, which are zero for a
+	// direction we don't know an update of.
+	Checksums lnwire.ChanUpdateChecksums
+}
+
 // ChanSeries is an implementation of the ChannelGraphTimeSeries
 // interface backed by the channeldb ChannelGraph database. We'll provide this
 // implementation to the AuthenticatedGossiper so it can properly use the
@@ -215,19 +239,96 @@ func (c *ChanSeries) FilterChannelRange(chain chainhash.Hash,
 	return c.graph.FilterChannelRange(startHeight, endHeight)
 }
 
+// FetchChanUpdateInfos returns the timestamps and checksums of the latest
+// channel updates of the channels we know of among the set of specified short
+// channel ID's.
+//
+// NOTE: This is part of the ChannelGraphTimeSeries interface.
+func (c *ChanSeries) FetchChanUpdateInfos(chain chainhash.Hash,
+	shortChanIDs []lnwire.ShortChannelID) (
+	map[lnwire.ShortChannelID]*ChanUpdateInfo, error) {
+
+	chanIDs := make([]uint64, 0, len(shortChanIDs))
+	for _, chanID := range shortChanIDs {
+		chanIDs = append(chanIDs, chanID.ToUint64())
+	}
+
+	channels, err := c.graph.FetchChanInfos(chanIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make(map[lnwire.ShortChannelID]*ChanUpdateInfo, len(channels))
+	for _, channel := range channels {
+		var info ChanUpdateInfo
+		if channel.Policy1 != nil {
+			timestamp, checksum, err := chanUpdateInfo(
+				channel.Info, channel.Policy1,
+			)
+			if err != nil {
+				return nil, err
+			}
+			info.Timestamps.Timestamp1 = timestamp
+			info.Checksums.Checksum1 = checksum
+		}
+		if channel.Policy2 != nil {
+			timestamp, checksum, err := chanUpdateInfo(
+				channel.Info, channel.Policy2,
+			)
+			if err != nil {
+				return nil, err
+			}
+			info.Timestamps.Timestamp2 = timestamp
+			info.Checksums.Checksum2 = checksum
+		}
+
+		chanID := lnwire.NewShortChanIDFromInt(channel.Info.ChannelID)
+		infos[chanID] = &info
+	}
+
+	return infos, nil
+}
+
+// chanUpdateInfo returns the timestamp and checksum of the channel update
+// that announced the given policy.
+func chanUpdateInfo(info *channeldb.ChannelEdgeInfo,
+	policy *channeldb.ChannelEdgePolicy) (uint32, uint32, error) {
+
+	update, err := netann.ChannelUpdateFromEdge(info, policy)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	checksum, err := update.Checksum()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return update.Timestamp, checksum, nil
+}
+
 // FetchChanAnns returns a full set of channel announcements as well as their
 // updates that match the set of specified short channel ID's.  We'll use this
 // to reply to a QueryShortChanIDs message sent by a remote peer. The response
 // will contain a unique set of ChannelAnnouncements, the latest ChannelUpdate
-// for each of the announcements, and a unique set of NodeAnnouncements.
+// for each of the announcements, and a unique set of NodeAnnouncements. If
+// query flags are given, only the messages they select for the channel at the
+// same index are included.
 //
 // NOTE: This is part of the ChannelGraphTimeSeries interface.
 func (c *ChanSeries) FetchChanAnns(chain chainhash.Hash,
-	shortChanIDs []lnwire.ShortChannelID) ([]lnwire.Message, error) {
+	shortChanIDs []lnwire.ShortChannelID,
+	queryFlags []lnwire.QueryFlag) ([]lnwire.Message, error) {
 
 	chanIDs := make([]uint64, 0, len(shortChanIDs))
-	for _, chanID := range shortChanIDs {
+	flags := make(map[uint64]lnwire.QueryFlag, len(shortChanIDs))
+	for i, chanID := range shortChanIDs {
 		chanIDs = append(chanIDs, chanID.ToUint64())
+
+		flags[chanID.ToUint64()] = lnwire.QueryFlagAll
+		if len(queryFlags) == len(shortChanIDs) {
+			flags[chanID.ToUint64()] = queryFlags[i]
+		}
 	}
 
 	channels, err := c.graph.FetchChanInfos(chanIDs)
@@ -257,14 +358,21 @@ func (c *ChanSeries) FetchChanAnns(chain chainhash.Hash,
 			return nil, err
 		}
 
-		chanAnns = append(chanAnns, chanAnn)
+		flag := flags[channel.Info.ChannelID]
+		if flag.Contains(lnwire.QueryFlagChanAnn) {
+			chanAnns = append(chanAnns, chanAnn)
+		}
 		if edge1 != nil {
-			chanAnns = append(chanAnns, edge1)
+			if flag.Contains(lnwire.QueryFlagChanUpdate1) {
+				chanAnns = append(chanAnns, edge1)
+			}
 
 			// If this edge has a validated node announcement, that
 			// we haven't yet sent, then we'll send that as well.
-			nodePub := channel.Policy1.Node.PubKeyBytes
-			hasNodeAnn := channel.Policy1.Node.HaveNodeAnnouncement
+			node := channel.Policy1.Node
+			nodePub := node.PubKeyBytes
+			hasNodeAnn := node.HaveNodeAnnouncement &&
+				flag.Contains(lnwire.QueryFlagNodeAnn1)
 			if _, ok := nodePubsSent[nodePub]; !ok && hasNodeAnn {
 				nodeAnn, err := channel.Policy1.Node.NodeAnnouncement(true)
 				if err != nil {
@@ -276,12 +384,16 @@ func (c *ChanSeries) FetchChanAnns(chain chainhash.Hash,
 			}
 		}
 		if edge2 != nil {
-			chanAnns = append(chanAnns, edge2)
+			if flag.Contains(lnwire.QueryFlagChanUpdate2) {
+				chanAnns = append(chanAnns, edge2)
+			}
 
 			// If this edge has a validated node announcement, that
 			// we haven't yet sent, then we'll send that as well.
-			nodePub := channel.Policy2.Node.PubKeyBytes
-			hasNodeAnn := channel.Policy2.Node.HaveNodeAnnouncement
+			node := channel.Policy2.Node
+			nodePub := node.PubKeyBytes
+			hasNodeAnn := node.HaveNodeAnnouncement &&
+				flag.Contains(lnwire.QueryFlagNodeAnn2)
 			if _, ok := nodePubsSent[nodePub]; !ok && hasNodeAnn {
 				nodeAnn, err := channel.Policy2.Node.NodeAnnouncement(true)
 				if err != nil {
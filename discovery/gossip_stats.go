@@ -0,0 +1,181 @@
+package discovery
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/route"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultPeerMsgRateLimit is the default number of announcements per
+	// second that we'll accept from a single peer on average.
+	DefaultPeerMsgRateLimit = 20
+
+	// DefaultPeerMsgBurst is the default number of announcements that
+	// we'll accept from a single peer in a burst, before the rate limit
+	// kicks in.
+	DefaultPeerMsgBurst = 1000
+)
+
+// PeerGossipStats summarizes the gossip traffic we've exchanged with a peer
+// since it connected.
+type PeerGossipStats struct {
+	// MsgsReceived is the number of gossip messages received from the
+	// peer, including the ones that were rate limited.
+	MsgsReceived uint64
+
+	// BytesReceived is the total size of the gossip messages received
+	// from the peer.
+	BytesReceived uint64
+
+	// MsgsRateLimited is the number of announcements received from the
+	// peer that were dropped as the peer exceeded its rate limit.
+	MsgsRateLimited uint64
+
+	// MsgsSent is the number of gossip messages sent to the peer.
+	MsgsSent uint64
+
+	// BytesSent is the total size of the gossip messages sent to the peer.
+	BytesSent uint64
+}
+
+// peerGossipAccount tracks the gossip traffic of a single peer.
+type peerGossipAccount struct {
+	stats   PeerGossipStats
+	limiter *rate.Limiter
+}
+
+// gossipAccountant keeps track of the gossip traffic we exchange with each
+// connected peer, and enforces a token bucket rate limit on the announcements
+// each of them sends us.
+type gossipAccountant struct {
+	rateLimit rate.Limit
+	burst     int
+
+	mu    sync.Mutex
+	peers map[route.Vertex]*peerGossipAccount
+}
+
+// newGossipAccountant creates a new gossipAccountant that accepts the given
+// number of announcements per second from each peer, with the given burst. A
+// rate limit of zero disables rate limiting.
+func newGossipAccountant(rateLimit float64, burst int) *gossipAccountant {
+	limit := rate.Limit(rateLimit)
+	if rateLimit <= 0 {
+		limit = rate.Inf
+	}
+
+	return &gossipAccountant{
+		rateLimit: limit,
+		burst:     burst,
+		peers:     make(map[route.Vertex]*peerGossipAccount),
+	}
+}
+
+// account returns the account of the given peer, creating it if needed.
+//
+// NOTE: This method must be called with the mutex held.
+func (a *gossipAccountant) account(peer route.Vertex) *peerGossipAccount {
+	account, ok := a.peers[peer]
+	if !ok {
+		account = &peerGossipAccount{
+			limiter: rate.NewLimiter(a.rateLimit, a.burst),
+		}
+		a.peers[peer] = account
+	}
+
+	return account
+}
+
+// recordReceived accounts for a gossip message received from the given peer.
+// If the message is subject to rate limiting, false is returned if the peer
+// has exceeded its rate limit and the message should be dropped.
+func (a *gossipAccountant) recordReceived(peer route.Vertex,
+	msg lnwire.Message, rateLimited bool) bool {
+
+	size := msgSize(msg)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	account := a.account(peer)
+	account.stats.MsgsReceived++
+	account.stats.BytesReceived += size
+
+	if rateLimited && !account.limiter.Allow() {
+		account.stats.MsgsRateLimited++
+		return false
+	}
+
+	return true
+}
+
+// recordSent accounts for gossip messages sent to the given peer.
+func (a *gossipAccountant) recordSent(peer route.Vertex,
+	msgs ...lnwire.Message) {
+
+	var size uint64
+	for _, msg := range msgs {
+		size += msgSize(msg)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	account := a.account(peer)
+	account.stats.MsgsSent += uint64(len(msgs))
+	account.stats.BytesSent += size
+}
+
+// removePeer drops the account of a disconnected peer.
+func (a *gossipAccountant) removePeer(peer route.Vertex) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.peers, peer)
+}
+
+// stats returns a snapshot of the gossip stats of all connected peers.
+func (a *gossipAccountant) stats() map[route.Vertex]PeerGossipStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	stats := make(map[route.Vertex]PeerGossipStats, len(a.peers))
+	for peer, account := range a.peers {
+		stats[peer] = account.stats
+	}
+
+	return stats
+}
+
+// msgSize returns the size of the given message on the wire, excluding the
+// transport overhead.
+func msgSize(msg lnwire.Message) uint64 {
+	// Encoding the gossip query messages populates their extra data with
+	// their TLV records, so we encode a copy of them to leave the message
+	// that is passed on untouched.
+	switch m := msg.(type) {
+	case *lnwire.QueryChannelRange:
+		c := *m
+		msg = &c
+
+	case *lnwire.ReplyChannelRange:
+		c := *m
+		msg = &c
+
+	case *lnwire.QueryShortChanIDs:
+		c := *m
+		msg = &c
+	}
+
+	var b bytes.Buffer
+	n, err := lnwire.WriteMessage(&b, msg, 0)
+	if err != nil {
+		return 0
+	}
+
+	return uint64(n)
+}
@@ -0,0 +1,119 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGossipAccountantRateLimit ensures that the gossipAccountant accounts for
+// all messages exchanged with a peer, and only drops the rate limited ones
+// once the peer exceeded its burst.
+func TestGossipAccountantRateLimit(t *testing.T) {
+	t.Parallel()
+
+	const burst = 3
+
+	// We'll use a rate limit that's low enough to not refill the bucket
+	// during the test.
+	accountant := newGossipAccountant(0.001, burst)
+
+	peer1 := route.Vertex{1}
+	peer2 := route.Vertex{2}
+
+	update := &lnwire.ChannelUpdate{
+		ExtraOpaqueData: make([]byte, 0),
+	}
+	updateSize := msgSize(update)
+	require.NotZero(t, updateSize)
+
+	// The first peer is allowed to send a burst of announcements, after
+	// which they're dropped.
+	for i := 0; i < burst; i++ {
+		require.True(t, accountant.recordReceived(peer1, update, true))
+	}
+	require.False(t, accountant.recordReceived(peer1, update, true))
+
+	// Messages that aren't subject to rate limiting are still accepted.
+	require.True(t, accountant.recordReceived(peer1, update, false))
+
+	// The rate limit of the second peer is independent of the first one.
+	require.True(t, accountant.recordReceived(peer2, update, true))
+
+	query := &lnwire.QueryShortChanIDs{
+		ShortChanIDs: []lnwire.ShortChannelID{
+			lnwire.NewShortChanIDFromInt(1),
+		},
+	}
+	accountant.recordSent(peer2, query, query)
+
+	stats := accountant.stats()
+	require.Equal(t, PeerGossipStats{
+		MsgsReceived:    burst + 2,
+		BytesReceived:   (burst + 2) * updateSize,
+		MsgsRateLimited: 1,
+	}, stats[peer1])
+	require.Equal(t, PeerGossipStats{
+		MsgsReceived:  1,
+		BytesReceived: updateSize,
+		MsgsSent:      2,
+		BytesSent:     2 * msgSize(query),
+	}, stats[peer2])
+
+	// Once a peer is removed, its stats are gone, and it starts off with
+	// a full bucket again.
+	accountant.removePeer(peer1)
+	require.NotContains(t, accountant.stats(), peer1)
+	require.True(t, accountant.recordReceived(peer1, update, true))
+}
+
+// TestGossipAccountantNoRateLimit ensures that a rate limit of zero disables
+// rate limiting.
+func TestGossipAccountantNoRateLimit(t *testing.T) {
+	t.Parallel()
+
+	accountant := newGossipAccountant(0, 0)
+
+	update := &lnwire.ChannelUpdate{}
+	for i := 0; i < 100; i++ {
+		require.True(t, accountant.recordReceived(
+			route.Vertex{1}, update, true,
+		))
+	}
+}
+
+// TestSyncManagerProcessRemoteMsg ensures that the SyncManager only rate limits
+// announcements that weren't requested through a gossip query.
+func TestSyncManagerProcessRemoteMsg(t *testing.T) {
+	t.Parallel()
+
+	syncMgr := newTestSyncManager(1)
+	syncMgr.accountant = newGossipAccountant(0.001, 1)
+
+	peer := randPeer(t, syncMgr.quit)
+	nodeID := route.Vertex(peer.PubKey())
+	s := syncMgr.createGossipSyncer(peer)
+	syncMgr.inactiveSyncers[nodeID] = s
+
+	update := &lnwire.ChannelUpdate{}
+	require.True(t, syncMgr.processRemoteMsg(nodeID, update))
+	require.False(t, syncMgr.processRemoteMsg(nodeID, update))
+
+	// Queries aren't subject to the rate limit.
+	query := &lnwire.QueryChannelRange{}
+	require.True(t, syncMgr.processRemoteMsg(nodeID, query))
+
+	// Neither are announcements we receive while waiting for the replies
+	// to our own queries.
+	s.setSyncState(waitingQueryChanReply)
+	require.True(t, syncMgr.processRemoteMsg(nodeID, update))
+
+	s.setSyncState(chansSynced)
+	require.False(t, syncMgr.processRemoteMsg(nodeID, update))
+
+	stats := syncMgr.GossipStats()[nodeID]
+	require.EqualValues(t, 5, stats.MsgsReceived)
+	require.EqualValues(t, 2, stats.MsgsRateLimited)
+}
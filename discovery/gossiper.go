@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/ltcsuite/lnd/aliasmgr"
 	"github.com/ltcsuite/lnd/batch"
 	"github.com/ltcsuite/lnd/chainntnfs"
 	"github.com/ltcsuite/lnd/channeldb"
@@ -167,6 +168,13 @@ type Config struct {
 	Broadcast func(skips map[route.Vertex]struct{},
 		msg ...lnwire.Message) error
 
+	// FindBaseByAlias finds the base short channel ID of one of our
+	// channels given one of its aliases. This is used to map remote
+	// ChannelUpdates that refer to a channel by an alias onto the edge in
+	// our graph.
+	FindBaseByAlias func(alias lnwire.ShortChannelID) (
+		lnwire.ShortChannelID, error)
+
 	// NotifyWhenOnline is a function that allows the gossiper to be
 	// notified when a certain peer comes online, allowing it to
 	// retry sending a peer message.
@@ -277,6 +285,15 @@ type Config struct {
 	// how often we should allow a new update for a specific channel and
 	// direction.
 	ChannelUpdateInterval time.Duration
+
+	// PeerMsgRateLimit is the number of announcements per second we'll
+	// accept from a single peer on average. A value of zero disables the
+	// limit.
+	PeerMsgRateLimit float64
+
+	// PeerMsgBurst is the number of announcements we'll accept from a
+	// single peer in a burst, before PeerMsgRateLimit is enforced.
+	PeerMsgBurst int
 }
 
 // cachedNetworkMsg is a wrapper around a network message that can be used with
@@ -448,6 +465,8 @@ func New(cfg Config, selfKeyDesc *keychain.KeyDescriptor) *AuthenticatedGossiper
 		IgnoreHistoricalFilters: cfg.IgnoreHistoricalFilters,
 		BestHeight:              gossiper.latestHeight,
 		PinnedSyncers:           cfg.PinnedSyncers,
+		PeerMsgRateLimit:        cfg.PeerMsgRateLimit,
+		PeerMsgBurst:            cfg.PeerMsgBurst,
 	})
 
 	gossiper.reliableSender = newReliableSender(&reliableSenderCfg{
@@ -643,6 +662,13 @@ func (d *AuthenticatedGossiper) ProcessRemoteAnnouncement(msg lnwire.Message,
 
 	errChan := make(chan error, 1)
 
+	// Before doing anything else, we'll account for the message, and drop
+	// it if the peer is flooding us with announcements.
+	if !d.syncMgr.processRemoteMsg(peer.PubKey(), msg) {
+		errChan <- nil
+		return errChan
+	}
+
 	// For messages in the known set of channel series queries, we'll
 	// dispatch the message directly to the GossipSyncer, and skip the main
 	// processing loop.
@@ -2083,14 +2109,31 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil, false
 		}
 
-		blockHeight := msg.ShortChannelID.BlockHeight
-		shortChanID := msg.ShortChannelID.ToUint64()
+		// If the update refers to one of our channels by an alias, we'll
+		// need the channel's base SCID to find the edge in our graph.
+		// The message itself is left untouched, as its signature
+		// commits to the alias.
+		graphScid := msg.ShortChannelID
+		isAlias := aliasmgr.IsAlias(msg.ShortChannelID)
+		if nMsg.isRemote && isAlias {
+			base, err := d.cfg.FindBaseByAlias(msg.ShortChannelID)
+			if err == nil {
+				graphScid = base
+			}
+		}
+
+		blockHeight := graphScid.BlockHeight
+		shortChanID := graphScid.ToUint64()
 
 		// If the advertised inclusionary block is beyond our knowledge
 		// of the chain tip, then we'll put the announcement in limbo
 		// to be fully verified once we advance forward in the chain.
+		// Aliases don't refer to a real block, so we skip this check
+		// for them.
 		d.Lock()
-		if nMsg.isRemote && d.isPremature(msg.ShortChannelID, 0, nMsg) {
+		if nMsg.isRemote && !isAlias &&
+			d.isPremature(msg.ShortChannelID, 0, nMsg) {
+
 			log.Warnf("Update announcement for "+
 				"short_chan_id(%v), is premature: advertises "+
 				"height %v, only height %v is known",
@@ -2107,7 +2150,7 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// channel in order to quickly reject it.
 		timestamp := time.Unix(int64(msg.Timestamp), 0)
 		if d.cfg.Router.IsStaleEdgePolicy(
-			msg.ShortChannelID, timestamp, msg.ChannelFlags,
+			graphScid, timestamp, msg.ChannelFlags,
 		) {
 
 			log.Debugf("Ignored stale edge policy: peer=%v, "+
@@ -2127,9 +2170,9 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// before we access the database. This ensures the state
 		// we read from the database has not changed between this
 		// point and when we call UpdateEdge() later.
-		d.channelMtx.Lock(msg.ShortChannelID.ToUint64())
-		defer d.channelMtx.Unlock(msg.ShortChannelID.ToUint64())
-		chanInfo, edge1, edge2, err := d.cfg.Router.GetChannelByID(msg.ShortChannelID)
+		d.channelMtx.Lock(shortChanID)
+		defer d.channelMtx.Unlock(shortChanID)
+		chanInfo, edge1, edge2, err := d.cfg.Router.GetChannelByID(graphScid)
 		switch err {
 		// No error, break.
 		case nil:
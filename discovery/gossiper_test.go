@@ -758,6 +758,12 @@ func createTestCtx(startHeight uint32) (*testCtx, func(), error) {
 			c := make(chan struct{})
 			return c
 		},
+		FindBaseByAlias: func(lnwire.ShortChannelID) (
+			lnwire.ShortChannelID, error) {
+
+			return lnwire.ShortChannelID{}, fmt.Errorf("no base " +
+				"scid")
+		},
 		SelfNodeAnnouncement: func(bool) (lnwire.NodeAnnouncement, error) {
 			return lnwire.NodeAnnouncement{
 				Timestamp: testTimestamp,
@@ -1460,6 +1466,7 @@ func TestSignatureAnnouncementRetryAtStartup(t *testing.T) {
 		Broadcast:            ctx.gossiper.cfg.Broadcast,
 		NotifyWhenOnline:     ctx.gossiper.reliableSender.cfg.NotifyWhenOnline,
 		NotifyWhenOffline:    ctx.gossiper.reliableSender.cfg.NotifyWhenOffline,
+		FindBaseByAlias:      ctx.gossiper.cfg.FindBaseByAlias,
 		SelfNodeAnnouncement: ctx.gossiper.cfg.SelfNodeAnnouncement,
 		Router:               ctx.gossiper.cfg.Router,
 		TrickleDelay:         trickleDelay,
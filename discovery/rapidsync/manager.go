@@ -0,0 +1,417 @@
+package rapidsync
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/input"
+	"github.com/ltcsuite/lnd/keychain"
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+)
+
+// sigLen is the length of the signature that is appended to every snapshot.
+const sigLen = 64
+
+var (
+	// ErrServingDisabled is returned when a snapshot is requested, but we
+	// aren't configured to serve them.
+	ErrServingDisabled = errors.New("serving rapid sync snapshots is " +
+		"disabled")
+
+	// ErrNoTrustedSources is returned when a snapshot should be applied,
+	// but no trusted sources of snapshots are configured.
+	ErrNoTrustedSources = errors.New("no trusted rapid sync sources " +
+		"configured")
+
+	// ErrUntrustedSource is returned when a snapshot was created by a node
+	// we don't trust.
+	ErrUntrustedSource = errors.New("rapid sync snapshot created by " +
+		"untrusted node")
+
+	// ErrInvalidSignature is returned when the signature of a snapshot
+	// doesn't match its content.
+	ErrInvalidSignature = errors.New("invalid rapid sync snapshot " +
+		"signature")
+)
+
+// Config houses the dependencies of the Manager.
+type Config struct {
+	// Graph is the channel graph snapshots are created from and applied
+	// to.
+	Graph *channeldb.ChannelGraph
+
+	// ChainHash is the genesis hash of the chain we're operating on.
+	ChainHash chainhash.Hash
+
+	// SelfKey is the identity public key of our node.
+	SelfKey route.Vertex
+
+	// Serve indicates whether we create snapshots of our graph for others.
+	Serve bool
+
+	// KeyLocator is the locator of our identity key, which is used to sign
+	// the snapshots we create.
+	KeyLocator keychain.KeyLocator
+
+	// Signer is used to sign the snapshots we create.
+	Signer lnwallet.MessageSigner
+
+	// TrustedSources are the nodes whose snapshots we apply to our graph.
+	// If it is empty, no snapshots are applied.
+	TrustedSources []route.Vertex
+
+	// WatchChannels is called with the funding outputs of the channels
+	// that were added to the graph from a snapshot, so that they are
+	// pruned once they're closed.
+	WatchChannels func([]channeldb.EdgePoint) error
+}
+
+// ApplyStats describes the changes a snapshot made to our graph.
+type ApplyStats struct {
+	// NumChannels is the number of channels that were added.
+	NumChannels int
+
+	// NumPolicies is the number of policies that were added or updated.
+	NumPolicies int
+
+	// NumNodes is the number of nodes whose features were learned.
+	NumNodes int
+
+	// Timestamp is the timestamp of the snapshot, which should be passed
+	// to the source to request the next delta snapshot.
+	Timestamp time.Time
+}
+
+// Manager creates rapid sync snapshots of our graph, and applies the
+// snapshots of trusted nodes to it. As the records of a snapshot don't carry
+// their signatures, channels and policies from snapshots are only ever added
+// to parts of the graph that we don't have a signed announcement of.
+type Manager struct {
+	cfg *Config
+
+	trusted map[route.Vertex]struct{}
+
+	// applyMtx serializes the application of snapshots, so that the
+	// comparison against the existing policies isn't racy.
+	applyMtx sync.Mutex
+}
+
+// NewManager creates a new rapid sync manager from the given config.
+func NewManager(cfg *Config) *Manager {
+	trusted := make(map[route.Vertex]struct{}, len(cfg.TrustedSources))
+	for _, source := range cfg.TrustedSources {
+		trusted[source] = struct{}{}
+	}
+
+	return &Manager{
+		cfg:     cfg,
+		trusted: trusted,
+	}
+}
+
+// CreateSnapshot creates a signed snapshot of the announced channels of our
+// graph that have a policy that was updated at or after the given time. If
+// the time is zero, a snapshot of the whole graph is created. The timestamp of
+// the snapshot is returned along with it.
+func (m *Manager) CreateSnapshot(since time.Time) ([]byte, time.Time, error) {
+	if !m.cfg.Serve {
+		return nil, time.Time{}, ErrServingDisabled
+	}
+
+	// The update index of the graph is keyed by unix timestamps, so we
+	// need to start from the epoch rather than the zero time.
+	startTime := since
+	if startTime.IsZero() {
+		startTime = time.Unix(0, 0)
+	}
+	edges, err := m.cfg.Graph.ChanUpdatesInHorizon(startTime, time.Now())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	snapshot := &Snapshot{
+		ChainHash: m.cfg.ChainHash,
+		Source:    m.cfg.SelfKey,
+		Since:     since,
+		Timestamp: startTime,
+		Features:  make(map[route.Vertex]*lnwire.RawFeatureVector),
+	}
+	for _, edge := range edges {
+		// We only serve channels that were announced to us, which
+		// excludes our private channels as well as channels we
+		// learned about from snapshots ourselves.
+		if edge.Info.AuthProof == nil {
+			continue
+		}
+
+		channel := &Channel{
+			Info: edge.Info,
+		}
+		policies := []*channeldb.ChannelEdgePolicy{
+			edge.Policy1, edge.Policy2,
+		}
+		var hasPolicy bool
+		for direction, policy := range policies {
+			if policy == nil {
+				continue
+			}
+			if policy.LastUpdate.Before(startTime) {
+				continue
+			}
+
+			channel.Policies[direction] = policy
+			hasPolicy = true
+
+			if policy.LastUpdate.After(snapshot.Timestamp) {
+				snapshot.Timestamp = policy.LastUpdate
+			}
+		}
+		if !hasPolicy {
+			continue
+		}
+
+		snapshot.Channels = append(snapshot.Channels, channel)
+	}
+
+	for _, channel := range snapshot.Channels {
+		nodes := []route.Vertex{
+			channel.Info.NodeKey1Bytes, channel.Info.NodeKey2Bytes,
+		}
+		for _, node := range nodes {
+			if _, ok := snapshot.Features[node]; ok {
+				continue
+			}
+
+			features, err := m.cfg.Graph.FetchNodeFeatures(node)
+			if err != nil {
+				return nil, time.Time{}, err
+			}
+			snapshot.Features[node] = features.RawFeatureVector
+		}
+	}
+
+	var b bytes.Buffer
+	if err := snapshot.Encode(&b); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	sig, err := m.cfg.Signer.SignMessage(m.cfg.KeyLocator, b.Bytes(), true)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	wireSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	b.Write(wireSig[:])
+
+	log.Debugf("Created rapid sync snapshot with %d channels since %v",
+		len(snapshot.Channels), since)
+
+	return b.Bytes(), snapshot.Timestamp, nil
+}
+
+// ApplySnapshot verifies that the given snapshot was created by one of our
+// trusted sources, and adds its channels, policies and node features to the
+// graph. Channels and policies are only added if we don't have a signed
+// announcement of the channel, and it isn't one of our own channels. Policies
+// are only updated if they're newer than the ones we know.
+func (m *Manager) ApplySnapshot(raw []byte) (*ApplyStats, error) {
+	if len(m.trusted) == 0 {
+		return nil, ErrNoTrustedSources
+	}
+
+	snapshot, err := m.verifySnapshot(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	m.applyMtx.Lock()
+	defer m.applyMtx.Unlock()
+
+	var (
+		graph      = m.cfg.Graph
+		stats      = &ApplyStats{Timestamp: snapshot.Timestamp}
+		edgePoints []channeldb.EdgePoint
+	)
+	for _, channel := range snapshot.Channels {
+		info := channel.Info
+		if info.NodeKey1Bytes == m.cfg.SelfKey ||
+			info.NodeKey2Bytes == m.cfg.SelfKey {
+
+			continue
+		}
+
+		var existing [2]*channeldb.ChannelEdgePolicy
+		dbInfo, policy1, policy2, err := graph.FetchChannelEdgesByID(
+			info.ChannelID,
+		)
+		switch {
+		// We don't resurrect channels we consider to be zombies.
+		case err == channeldb.ErrZombieEdge:
+			continue
+
+		case err == channeldb.ErrEdgeNotFound,
+			err == channeldb.ErrGraphNoEdgesFound,
+			err == channeldb.ErrGraphNotFound:
+
+			edgePoint, err := m.addChannel(info)
+			if err != nil {
+				return nil, err
+			}
+			if edgePoint == nil {
+				continue
+			}
+
+			edgePoints = append(edgePoints, *edgePoint)
+			stats.NumChannels++
+
+		case err != nil:
+			return nil, err
+
+		// Channels we have a signed announcement of are kept up to
+		// date through gossip, which we don't want to override with
+		// unsigned policies.
+		case dbInfo.AuthProof != nil:
+			continue
+
+		default:
+			existing[0], existing[1] = policy1, policy2
+		}
+
+		for direction, policy := range channel.Policies {
+			if policy == nil {
+				continue
+			}
+
+			current := existing[direction]
+			if current != nil &&
+				!policy.LastUpdate.After(current.LastUpdate) {
+
+				continue
+			}
+
+			err := graph.UpdateEdgePolicy(policy)
+			switch {
+			case err == channeldb.ErrEdgeNotFound:
+				continue
+
+			case err != nil:
+				return nil, err
+			}
+			stats.NumPolicies++
+		}
+	}
+
+	for node, rawFeatures := range snapshot.Features {
+		// The features of nodes that we have an announcement of are
+		// already known, and we don't track nodes we don't know any
+		// channels of.
+		dbNode, err := graph.FetchLightningNode(node)
+		switch {
+		case err == channeldb.ErrGraphNodeNotFound:
+			continue
+
+		case err != nil:
+			return nil, err
+
+		case dbNode.HaveNodeAnnouncement:
+			continue
+		}
+
+		graph.SetCachedNodeFeatures(
+			node, lnwire.NewFeatureVector(
+				rawFeatures, lnwire.Features,
+			),
+		)
+		stats.NumNodes++
+	}
+
+	if m.cfg.WatchChannels != nil {
+		if err := m.cfg.WatchChannels(edgePoints); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Infof("Applied rapid sync snapshot of %v: added %d channels, "+
+		"%d policies and features of %d nodes", snapshot.Source,
+		stats.NumChannels, stats.NumPolicies, stats.NumNodes)
+
+	return stats, nil
+}
+
+// verifySnapshot decodes the given snapshot, and checks that it is signed by
+// one of our trusted sources and belongs to our chain.
+func (m *Manager) verifySnapshot(raw []byte) (*Snapshot, error) {
+	if len(raw) < sigLen {
+		return nil, ErrUnknownFormat
+	}
+	body := raw[:len(raw)-sigLen]
+
+	snapshot, err := Decode(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := m.trusted[snapshot.Source]; !ok {
+		return nil, ErrUntrustedSource
+	}
+
+	var wireSig lnwire.Sig
+	copy(wireSig[:], raw[len(raw)-sigLen:])
+	sig, err := wireSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := btcec.ParsePubKey(snapshot.Source[:])
+	if err != nil {
+		return nil, err
+	}
+	if !sig.Verify(chainhash.DoubleHashB(body), pubKey) {
+		return nil, ErrInvalidSignature
+	}
+
+	if snapshot.ChainHash != m.cfg.ChainHash {
+		return nil, fmt.Errorf("rapid sync snapshot belongs to chain "+
+			"%v", snapshot.ChainHash)
+	}
+
+	return snapshot, nil
+}
+
+// addChannel adds a channel of a snapshot to the graph, and returns its
+// funding output. If the channel was added concurrently, nil is returned.
+func (m *Manager) addChannel(
+	info *channeldb.ChannelEdgeInfo) (*channeldb.EdgePoint, error) {
+
+	_, fundingOutput, err := input.GenFundingPkScript(
+		info.BitcoinKey1Bytes[:], info.BitcoinKey2Bytes[:],
+		int64(info.Capacity),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel %v: %v",
+			info.ChannelID, err)
+	}
+
+	err = m.cfg.Graph.AddChannelEdge(info)
+	switch {
+	case err == channeldb.ErrEdgeAlreadyExist:
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &channeldb.EdgePoint{
+		FundingPkScript: fundingOutput.PkScript,
+		OutPoint:        info.ChannelPoint,
+	}, nil
+}
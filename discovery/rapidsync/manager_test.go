@@ -0,0 +1,249 @@
+package rapidsync
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/keychain"
+	"github.com/ltcsuite/lnd/kvdb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/netann"
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/btcec/v2/ecdsa"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+var testChainHash = chainhash.Hash{1, 2, 3}
+
+// makeTestGraph creates a channel graph with its graph cache enabled, that is
+// cleaned up once the test finishes.
+func makeTestGraph(t *testing.T) *channeldb.ChannelGraph {
+	tempDir, err := ioutil.TempDir("", "rapidsync")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(tempDir)
+	})
+
+	backend, cleanUp, err := kvdb.GetTestBackend(tempDir, "cgr")
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	opts := channeldb.DefaultOptions()
+	graph, err := channeldb.NewChannelGraph(
+		backend, opts.RejectCacheSize, opts.ChannelCacheSize,
+		opts.BatchCommitInterval, opts.PreAllocCacheNumNodes, true,
+	)
+	require.NoError(t, err)
+
+	return graph
+}
+
+// newTestVertex returns the public key of a new random private key, along
+// with the key itself.
+func newTestVertex(t *testing.T) (route.Vertex, *btcec.PrivateKey) {
+	priv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return route.NewVertex(priv.PubKey()), priv
+}
+
+// addTestChannel adds a channel between the two nodes to the graph, with a
+// policy in each direction that was last updated at the given time.
+func addTestChannel(t *testing.T, graph *channeldb.ChannelGraph,
+	chanID uint64, node1, node2 route.Vertex, announced bool,
+	lastUpdate time.Time) {
+
+	sig := ecdsa.Sign(testPrivKey, chainhash.DoubleHashB(nil)).Serialize()
+
+	info := &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID,
+		ChainHash:        testChainHash,
+		NodeKey1Bytes:    node1,
+		NodeKey2Bytes:    node2,
+		BitcoinKey1Bytes: node1,
+		BitcoinKey2Bytes: node2,
+		ChannelPoint: wire.OutPoint{
+			Index: uint32(chanID),
+		},
+		Capacity: 100000,
+	}
+	if announced {
+		info.AuthProof = &channeldb.ChannelAuthProof{
+			NodeSig1Bytes:    sig,
+			NodeSig2Bytes:    sig,
+			BitcoinSig1Bytes: sig,
+			BitcoinSig2Bytes: sig,
+		}
+	}
+	require.NoError(t, graph.AddChannelEdge(info))
+
+	for direction := 0; direction < 2; direction++ {
+		policy := testPolicy(chanID, direction, lastUpdate.Unix())
+		policy.SigBytes = sig
+		require.NoError(t, graph.UpdateEdgePolicy(policy))
+	}
+}
+
+var testPrivKey, _ = btcec.NewPrivateKey()
+
+// TestRapidSync asserts that snapshots created by a trusted source are
+// applied to the graph of a client, and that delta snapshots only contain
+// the updates since the previous snapshot.
+func TestRapidSync(t *testing.T) {
+	t.Parallel()
+
+	sourceKey, sourcePriv := newTestVertex(t)
+	clientKey, _ := newTestVertex(t)
+	node1, _ := newTestVertex(t)
+	node2, _ := newTestVertex(t)
+	node3, _ := newTestVertex(t)
+
+	// The graph of the source has two announced channels, and one that
+	// isn't announced and therefore shouldn't be served.
+	sourceGraph := makeTestGraph(t)
+	start := time.Unix(1600000000, 0)
+	addTestChannel(t, sourceGraph, 1, node1, node2, true, start)
+	addTestChannel(t, sourceGraph, 2, node2, node3, true, start)
+	addTestChannel(t, sourceGraph, 3, node1, node3, false, start)
+
+	features := lnwire.NewRawFeatureVector(
+		lnwire.TLVOnionPayloadOptional,
+	)
+	require.NoError(t, sourceGraph.AddLightningNode(
+		&channeldb.LightningNode{
+			HaveNodeAnnouncement: true,
+			PubKeyBytes:          node1,
+			LastUpdate:           start,
+			AuthSigBytes:         []byte{1},
+			Features: lnwire.NewFeatureVector(
+				features, lnwire.Features,
+			),
+		},
+	))
+
+	keyLoc := keychain.KeyLocator{Family: keychain.KeyFamilyNodeKey}
+	source := NewManager(&Config{
+		Graph:     sourceGraph,
+		ChainHash: testChainHash,
+		SelfKey:   sourceKey,
+		Serve:     true,
+		Signer: netann.NewNodeSigner(
+			keychain.NewPrivKeyMessageSigner(sourcePriv, keyLoc),
+		),
+		KeyLocator: keyLoc,
+	})
+
+	// The client already knows the first channel through gossip, which
+	// shouldn't be overridden by the snapshot.
+	clientGraph := makeTestGraph(t)
+	addTestChannel(
+		t, clientGraph, 1, node1, node2, true, start.Add(-time.Hour),
+	)
+
+	var watched []channeldb.EdgePoint
+	client := NewManager(&Config{
+		Graph:          clientGraph,
+		ChainHash:      testChainHash,
+		SelfKey:        clientKey,
+		TrustedSources: []route.Vertex{sourceKey},
+		WatchChannels: func(edgePoints []channeldb.EdgePoint) error {
+			watched = append(watched, edgePoints...)
+			return nil
+		},
+	})
+
+	snapshot, timestamp, err := source.CreateSnapshot(time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, start, timestamp)
+
+	// A client can't create snapshots, and the source doesn't trust
+	// anyone.
+	_, _, err = client.CreateSnapshot(time.Time{})
+	require.Equal(t, ErrServingDisabled, err)
+	_, err = source.ApplySnapshot(snapshot)
+	require.Equal(t, ErrNoTrustedSources, err)
+
+	stats, err := client.ApplySnapshot(snapshot)
+	require.NoError(t, err)
+	require.Equal(t, &ApplyStats{
+		NumChannels: 1,
+		NumPolicies: 2,
+		NumNodes:    1,
+		Timestamp:   start,
+	}, stats)
+	require.Len(t, watched, 1)
+	require.Equal(t, uint32(2), watched[0].OutPoint.Index)
+
+	info, policy1, policy2, err := clientGraph.FetchChannelEdgesByID(2)
+	require.NoError(t, err)
+	require.Nil(t, info.AuthProof)
+	require.Equal(t, start, policy1.LastUpdate)
+	require.Equal(t, start, policy2.LastUpdate)
+
+	// The policies of the announced channel should be untouched.
+	_, policy1, _, err = clientGraph.FetchChannelEdgesByID(1)
+	require.NoError(t, err)
+	require.Equal(t, start.Add(-time.Hour), policy1.LastUpdate)
+
+	_, _, exists, _, err := clientGraph.HasChannelEdge(3)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// The features of the first node should be known now.
+	nodeFeatures, err := clientGraph.FetchNodeFeatures(node1)
+	require.NoError(t, err)
+	require.True(t, nodeFeatures.HasFeature(
+		lnwire.TLVOnionPayloadOptional,
+	))
+
+	// Now update a policy of the second channel, and request a delta
+	// snapshot. It should only contain that update.
+	update := testPolicy(2, 1, start.Add(time.Hour).Unix())
+	update.FeeBaseMSat = 5000
+	require.NoError(t, sourceGraph.UpdateEdgePolicy(update))
+
+	snapshot, timestamp, err = source.CreateSnapshot(
+		timestamp.Add(time.Second),
+	)
+	require.NoError(t, err)
+	require.Equal(t, update.LastUpdate, timestamp)
+
+	stats, err = client.ApplySnapshot(snapshot)
+	require.NoError(t, err)
+	require.Equal(t, &ApplyStats{
+		NumPolicies: 1,
+		Timestamp:   update.LastUpdate,
+	}, stats)
+
+	_, _, policy2, err = clientGraph.FetchChannelEdgesByID(2)
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(5000), policy2.FeeBaseMSat)
+
+	// Applying the same snapshot again shouldn't change anything.
+	stats, err = client.ApplySnapshot(snapshot)
+	require.NoError(t, err)
+	require.Zero(t, stats.NumPolicies)
+
+	// Snapshots that were tampered with, or created by nodes we don't
+	// trust, should be rejected.
+	tampered := append([]byte{}, snapshot...)
+	tampered[len(tampered)-sigLen-1] ^= 1
+	_, err = client.ApplySnapshot(tampered)
+	require.Equal(t, ErrInvalidSignature, err)
+
+	otherKey, _ := newTestVertex(t)
+	untrusting := NewManager(&Config{
+		Graph:          clientGraph,
+		ChainHash:      testChainHash,
+		SelfKey:        clientKey,
+		TrustedSources: []route.Vertex{otherKey},
+	})
+	_, err = untrusting.ApplySnapshot(snapshot)
+	require.Equal(t, ErrUntrustedSource, err)
+}
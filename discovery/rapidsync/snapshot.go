@@ -0,0 +1,643 @@
+package rapidsync
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+const (
+	// SnapshotVersion is the version of the snapshot format that is
+	// written by this package.
+	SnapshotVersion uint16 = 1
+)
+
+var (
+	// byteOrder is the byte order of all fixed size integers within a
+	// snapshot.
+	byteOrder = binary.BigEndian
+
+	// snapshotMagic are the bytes every snapshot starts with.
+	snapshotMagic = [4]byte{'l', 'n', 'r', 's'}
+
+	// ErrUnknownFormat is returned when decoding data that isn't a rapid
+	// sync snapshot.
+	ErrUnknownFormat = errors.New("unknown rapid sync snapshot format")
+)
+
+// ErrUnknownVersion is returned when a snapshot was written with a version of
+// the format we don't know.
+type ErrUnknownVersion uint16
+
+// Error returns a human readable string describing the error.
+func (e ErrUnknownVersion) Error() string {
+	return fmt.Sprintf("unknown rapid sync snapshot version: %d",
+		uint16(e))
+}
+
+// policyFlags describe how a policy is encoded within a snapshot. Fields of a
+// policy that are equal to the default policy of the snapshot are omitted.
+type policyFlags uint8
+
+const (
+	// policyDisabled is set if the channel is disabled in the direction of
+	// the policy.
+	policyDisabled policyFlags = 1 << iota
+
+	// policyNoMaxHTLC is set if the policy doesn't specify a maximum HTLC
+	// amount.
+	policyNoMaxHTLC
+
+	// policyTimeLockDelta is set if the time lock delta of the policy is
+	// included.
+	policyTimeLockDelta
+
+	// policyMinHTLC is set if the minimum HTLC amount of the policy is
+	// included.
+	policyMinHTLC
+
+	// policyMaxHTLC is set if the maximum HTLC amount of the policy is
+	// included.
+	policyMaxHTLC
+
+	// policyFeeBase is set if the base fee of the policy is included.
+	policyFeeBase
+
+	// policyFeeRate is set if the proportional fee of the policy is
+	// included.
+	policyFeeRate
+)
+
+// Channel is a channel within a snapshot, along with those of its policies
+// that were updated within the time range of the snapshot.
+type Channel struct {
+	// Info holds the static information of the channel. Its
+	// authentication proof isn't part of the snapshot.
+	Info *channeldb.ChannelEdgeInfo
+
+	// Policies holds the policies of the channel, indexed by their
+	// direction. Policies that aren't part of the snapshot are nil.
+	Policies [2]*channeldb.ChannelEdgePolicy
+}
+
+// Snapshot is a compact view of the channel graph, or of the changes to it
+// since some point in time. All signatures are stripped from the records of a
+// snapshot, so it can only be verified as a whole by checking the signature of
+// the node that created it.
+type Snapshot struct {
+	// ChainHash is the genesis hash of the chain the channels of the
+	// snapshot belong to.
+	ChainHash chainhash.Hash
+
+	// Source is the public key of the node that created the snapshot.
+	Source route.Vertex
+
+	// Since is the time from which on the policies of the snapshot were
+	// updated. It is zero for a full snapshot.
+	Since time.Time
+
+	// Timestamp is the time of the most recent update within the
+	// snapshot, which should be used as the start time of the next delta
+	// snapshot.
+	Timestamp time.Time
+
+	// Channels are the channels of the snapshot.
+	Channels []*Channel
+
+	// Features holds the known features of the nodes of the channels.
+	Features map[route.Vertex]*lnwire.RawFeatureVector
+}
+
+// policyDefaults holds the values a policy within a snapshot is assumed to
+// have, unless they're included explicitly.
+type policyDefaults struct {
+	timeLockDelta uint16
+	minHTLC       lnwire.MilliSatoshi
+	maxHTLC       lnwire.MilliSatoshi
+	feeBase       lnwire.MilliSatoshi
+	feeRate       lnwire.MilliSatoshi
+}
+
+// newPolicyDefaults returns the most common value of each field across the
+// given channels' policies, so that they can be omitted for most policies.
+func newPolicyDefaults(channels []*Channel) policyDefaults {
+	var (
+		timeLockDeltas = make(map[uint64]int)
+		minHTLCs       = make(map[uint64]int)
+		maxHTLCs       = make(map[uint64]int)
+		feeBases       = make(map[uint64]int)
+		feeRates       = make(map[uint64]int)
+	)
+	for _, channel := range channels {
+		for _, policy := range channel.Policies {
+			if policy == nil {
+				continue
+			}
+
+			timeLockDeltas[uint64(policy.TimeLockDelta)]++
+			minHTLCs[uint64(policy.MinHTLC)]++
+			maxHTLCs[uint64(policy.MaxHTLC)]++
+			feeBases[uint64(policy.FeeBaseMSat)]++
+			feeRates[uint64(policy.FeeProportionalMillionths)]++
+		}
+	}
+
+	mostCommon := func(counts map[uint64]int) uint64 {
+		var value uint64
+		maxCount := 0
+		for v, count := range counts {
+			// Ties are broken by value to make the encoding
+			// deterministic.
+			if count < maxCount {
+				continue
+			}
+			if count == maxCount && v > value {
+				continue
+			}
+
+			value = v
+			maxCount = count
+		}
+
+		return value
+	}
+
+	return policyDefaults{
+		timeLockDelta: uint16(mostCommon(timeLockDeltas)),
+		minHTLC:       lnwire.MilliSatoshi(mostCommon(minHTLCs)),
+		maxHTLC:       lnwire.MilliSatoshi(mostCommon(maxHTLCs)),
+		feeBase:       lnwire.MilliSatoshi(mostCommon(feeBases)),
+		feeRate:       lnwire.MilliSatoshi(mostCommon(feeRates)),
+	}
+}
+
+// Encode writes the snapshot to the given writer. Channels are written in
+// order of their short channel IDs, which are delta encoded, and nodes are
+// referenced by their index within a table of all nodes of the snapshot.
+func (s *Snapshot) Encode(w io.Writer) error {
+	channels := make([]*Channel, len(s.Channels))
+	copy(channels, s.Channels)
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].Info.ChannelID < channels[j].Info.ChannelID
+	})
+
+	var (
+		nodes     []route.Vertex
+		nodeIndex = make(map[route.Vertex]uint64)
+	)
+	addNode := func(node route.Vertex) {
+		if _, ok := nodeIndex[node]; ok {
+			return
+		}
+		nodeIndex[node] = uint64(len(nodes))
+		nodes = append(nodes, node)
+	}
+	for _, channel := range channels {
+		addNode(channel.Info.NodeKey1Bytes)
+		addNode(channel.Info.NodeKey2Bytes)
+	}
+
+	if _, err := w.Write(snapshotMagic[:]); err != nil {
+		return err
+	}
+	err := writeElements(
+		w, SnapshotVersion, s.ChainHash[:], s.Source[:],
+		unixTime(s.Since), unixTime(s.Timestamp),
+	)
+	if err != nil {
+		return err
+	}
+
+	defaults := newPolicyDefaults(channels)
+	err = writeElements(w, defaults.timeLockDelta)
+	if err != nil {
+		return err
+	}
+	err = writeVarInts(
+		w, uint64(defaults.minHTLC), uint64(defaults.maxHTLC),
+		uint64(defaults.feeBase), uint64(defaults.feeRate),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := wire.WriteVarInt(w, 0, uint64(len(nodes))); err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		if _, err := w.Write(node[:]); err != nil {
+			return err
+		}
+
+		features, ok := s.Features[node]
+		if !ok || features == nil {
+			features = lnwire.NewRawFeatureVector()
+		}
+		if err := features.Encode(w); err != nil {
+			return err
+		}
+	}
+
+	if err := wire.WriteVarInt(w, 0, uint64(len(channels))); err != nil {
+		return err
+	}
+	var prevChanID uint64
+	for _, channel := range channels {
+		info := channel.Info
+
+		err := writeVarInts(
+			w, info.ChannelID-prevChanID,
+			nodeIndex[info.NodeKey1Bytes],
+			nodeIndex[info.NodeKey2Bytes],
+		)
+		if err != nil {
+			return err
+		}
+		prevChanID = info.ChannelID
+
+		err = writeElements(
+			w, info.BitcoinKey1Bytes[:], info.BitcoinKey2Bytes[:],
+			info.ChannelPoint.Hash[:],
+		)
+		if err != nil {
+			return err
+		}
+		err = writeVarInts(
+			w, uint64(info.ChannelPoint.Index),
+			uint64(info.Capacity),
+		)
+		if err != nil {
+			return err
+		}
+
+		var present uint8
+		for direction, policy := range channel.Policies {
+			if policy != nil {
+				present |= 1 << uint(direction)
+			}
+		}
+		if err := writeElements(w, present); err != nil {
+			return err
+		}
+
+		for _, policy := range channel.Policies {
+			if policy == nil {
+				continue
+			}
+
+			err := encodePolicy(w, policy, &defaults, s.Since)
+			if err != nil {
+				return fmt.Errorf("unable to encode policy of "+
+					"channel %v: %v", info.ChannelID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// encodePolicy writes the fields of the policy that differ from the defaults,
+// along with its update time relative to the start time of the snapshot.
+func encodePolicy(w io.Writer, policy *channeldb.ChannelEdgePolicy,
+	defaults *policyDefaults, since time.Time) error {
+
+	timeDelta := policy.LastUpdate.Unix() - int64(unixTime(since))
+	if timeDelta < 0 {
+		return fmt.Errorf("policy updated at %v before start of "+
+			"snapshot", policy.LastUpdate)
+	}
+
+	var flags policyFlags
+	if policy.ChannelFlags.IsDisabled() {
+		flags |= policyDisabled
+	}
+	if policy.MessageFlags&lnwire.ChanUpdateOptionMaxHtlc == 0 {
+		flags |= policyNoMaxHTLC
+	}
+
+	var fields []uint64
+	if policy.TimeLockDelta != defaults.timeLockDelta {
+		flags |= policyTimeLockDelta
+		fields = append(fields, uint64(policy.TimeLockDelta))
+	}
+	if policy.MinHTLC != defaults.minHTLC {
+		flags |= policyMinHTLC
+		fields = append(fields, uint64(policy.MinHTLC))
+	}
+	if flags&policyNoMaxHTLC == 0 && policy.MaxHTLC != defaults.maxHTLC {
+		flags |= policyMaxHTLC
+		fields = append(fields, uint64(policy.MaxHTLC))
+	}
+	if policy.FeeBaseMSat != defaults.feeBase {
+		flags |= policyFeeBase
+		fields = append(fields, uint64(policy.FeeBaseMSat))
+	}
+	feeRate := policy.FeeProportionalMillionths
+	if feeRate != defaults.feeRate {
+		flags |= policyFeeRate
+		fields = append(fields, uint64(feeRate))
+	}
+
+	if err := writeElements(w, uint8(flags)); err != nil {
+		return err
+	}
+	if err := wire.WriteVarInt(w, 0, uint64(timeDelta)); err != nil {
+		return err
+	}
+
+	return writeVarInts(w, fields...)
+}
+
+// Decode reads a snapshot that was written by Encode from the given reader.
+func Decode(r io.Reader) (*Snapshot, error) {
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, ErrUnknownFormat
+	}
+	if magic != snapshotMagic {
+		return nil, ErrUnknownFormat
+	}
+
+	var version uint16
+	if err := binary.Read(r, byteOrder, &version); err != nil {
+		return nil, err
+	}
+	if version != SnapshotVersion {
+		return nil, ErrUnknownVersion(version)
+	}
+
+	s := &Snapshot{
+		Features: make(map[route.Vertex]*lnwire.RawFeatureVector),
+	}
+	var since, timestamp uint32
+	err := readElements(
+		r, s.ChainHash[:], s.Source[:], &since, &timestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if since != 0 {
+		s.Since = time.Unix(int64(since), 0)
+	}
+	s.Timestamp = time.Unix(int64(timestamp), 0)
+
+	var (
+		defaults                           policyDefaults
+		minHTLC, maxHTLC, feeBase, feeRate uint64
+	)
+	err = readElements(r, &defaults.timeLockDelta)
+	if err != nil {
+		return nil, err
+	}
+	err = readVarInts(r, &minHTLC, &maxHTLC, &feeBase, &feeRate)
+	if err != nil {
+		return nil, err
+	}
+	defaults.minHTLC = lnwire.MilliSatoshi(minHTLC)
+	defaults.maxHTLC = lnwire.MilliSatoshi(maxHTLC)
+	defaults.feeBase = lnwire.MilliSatoshi(feeBase)
+	defaults.feeRate = lnwire.MilliSatoshi(feeRate)
+
+	// The counts of the nodes and channels aren't trusted to
+	// preallocate memory, as the snapshot would need to be at least
+	// that long for them to be valid.
+	numNodes, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	var nodes []route.Vertex
+	for i := uint64(0); i < numNodes; i++ {
+		var node route.Vertex
+		if _, err := io.ReadFull(r, node[:]); err != nil {
+			return nil, err
+		}
+
+		features := lnwire.NewRawFeatureVector()
+		if err := features.Decode(r); err != nil {
+			return nil, err
+		}
+		if !features.IsEmpty() {
+			s.Features[node] = features
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	numChannels, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	var chanID uint64
+	for i := uint64(0); i < numChannels; i++ {
+		var chanIDDelta, node1, node2 uint64
+		err := readVarInts(r, &chanIDDelta, &node1, &node2)
+		if err != nil {
+			return nil, err
+		}
+		if node1 >= uint64(len(nodes)) || node2 >= uint64(len(nodes)) {
+			return nil, fmt.Errorf("channel references unknown " +
+				"node")
+		}
+		chanID += chanIDDelta
+
+		info := &channeldb.ChannelEdgeInfo{
+			ChannelID:     chanID,
+			ChainHash:     s.ChainHash,
+			NodeKey1Bytes: nodes[node1],
+			NodeKey2Bytes: nodes[node2],
+		}
+		err = readElements(
+			r, info.BitcoinKey1Bytes[:], info.BitcoinKey2Bytes[:],
+			info.ChannelPoint.Hash[:],
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var outputIndex, capacity uint64
+		err = readVarInts(r, &outputIndex, &capacity)
+		if err != nil {
+			return nil, err
+		}
+		info.ChannelPoint.Index = uint32(outputIndex)
+		info.Capacity = ltcutil.Amount(capacity)
+
+		channel := &Channel{
+			Info: info,
+		}
+
+		var present uint8
+		if err := readElements(r, &present); err != nil {
+			return nil, err
+		}
+		for direction := range channel.Policies {
+			if present&(1<<uint(direction)) == 0 {
+				continue
+			}
+
+			policy, err := decodePolicy(r, &defaults, s.Since)
+			if err != nil {
+				return nil, err
+			}
+			policy.ChannelID = chanID
+			if direction == 1 {
+				policy.ChannelFlags |=
+					lnwire.ChanUpdateDirection
+			}
+
+			channel.Policies[direction] = policy
+		}
+
+		s.Channels = append(s.Channels, channel)
+	}
+
+	return s, nil
+}
+
+// decodePolicy reads a policy that was written by encodePolicy.
+func decodePolicy(r io.Reader, defaults *policyDefaults,
+	since time.Time) (*channeldb.ChannelEdgePolicy, error) {
+
+	var flags uint8
+	if err := readElements(r, &flags); err != nil {
+		return nil, err
+	}
+	timeDelta, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		timeLockDelta = uint64(defaults.timeLockDelta)
+		minHTLC       = uint64(defaults.minHTLC)
+		maxHTLC       = uint64(defaults.maxHTLC)
+		feeBase       = uint64(defaults.feeBase)
+		feeRate       = uint64(defaults.feeRate)
+	)
+	fields := []struct {
+		flag  policyFlags
+		value *uint64
+	}{
+		{policyTimeLockDelta, &timeLockDelta},
+		{policyMinHTLC, &minHTLC},
+		{policyMaxHTLC, &maxHTLC},
+		{policyFeeBase, &feeBase},
+		{policyFeeRate, &feeRate},
+	}
+	for _, field := range fields {
+		if policyFlags(flags)&field.flag == 0 {
+			continue
+		}
+
+		*field.value, err = wire.ReadVarInt(r, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	lastUpdate := int64(unixTime(since)) + int64(timeDelta)
+	policy := &channeldb.ChannelEdgePolicy{
+		LastUpdate:                time.Unix(lastUpdate, 0),
+		MessageFlags:              lnwire.ChanUpdateOptionMaxHtlc,
+		TimeLockDelta:             uint16(timeLockDelta),
+		MinHTLC:                   lnwire.MilliSatoshi(minHTLC),
+		MaxHTLC:                   lnwire.MilliSatoshi(maxHTLC),
+		FeeBaseMSat:               lnwire.MilliSatoshi(feeBase),
+		FeeProportionalMillionths: lnwire.MilliSatoshi(feeRate),
+	}
+	if policyFlags(flags)&policyDisabled != 0 {
+		policy.ChannelFlags |= lnwire.ChanUpdateDisabled
+	}
+	if policyFlags(flags)&policyNoMaxHTLC != 0 {
+		policy.MessageFlags = 0
+		policy.MaxHTLC = 0
+	}
+
+	return policy, nil
+}
+
+// unixTime returns the given time as a 32-bit unix timestamp, mapping the zero
+// time to zero.
+func unixTime(t time.Time) uint32 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return uint32(t.Unix())
+}
+
+// writeElements writes each of the given byte slices and fixed size integers
+// to the writer.
+func writeElements(w io.Writer, elements ...interface{}) error {
+	for _, element := range elements {
+		var err error
+		switch e := element.(type) {
+		case []byte:
+			_, err = w.Write(e)
+
+		default:
+			err = binary.Write(w, byteOrder, e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readElements reads into each of the given byte slices and pointers to fixed
+// size integers from the reader.
+func readElements(r io.Reader, elements ...interface{}) error {
+	for _, element := range elements {
+		var err error
+		switch e := element.(type) {
+		case []byte:
+			_, err = io.ReadFull(r, e)
+
+		default:
+			err = binary.Read(r, byteOrder, e)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeVarInts writes each of the given integers as a variable length
+// integer.
+func writeVarInts(w io.Writer, values ...uint64) error {
+	for _, value := range values {
+		if err := wire.WriteVarInt(w, 0, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readVarInts reads a variable length integer into each of the given
+// pointers.
+func readVarInts(r io.Reader, values ...*uint64) error {
+	for _, value := range values {
+		var err error
+		*value, err = wire.ReadVarInt(r, 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
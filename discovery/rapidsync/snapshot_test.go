@@ -0,0 +1,145 @@
+package rapidsync
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// testPolicy returns a policy with the most common values of the policies of
+// the test snapshot.
+func testPolicy(chanID uint64, direction int,
+	lastUpdate int64) *channeldb.ChannelEdgePolicy {
+
+	policy := &channeldb.ChannelEdgePolicy{
+		ChannelID:                 chanID,
+		LastUpdate:                time.Unix(lastUpdate, 0),
+		MessageFlags:              lnwire.ChanUpdateOptionMaxHtlc,
+		TimeLockDelta:             40,
+		MinHTLC:                   1000,
+		MaxHTLC:                   990000000,
+		FeeBaseMSat:               1000,
+		FeeProportionalMillionths: 1,
+	}
+	if direction == 1 {
+		policy.ChannelFlags = lnwire.ChanUpdateDirection
+	}
+
+	return policy
+}
+
+// TestSnapshotEncodeDecode asserts that a snapshot is decoded to the same
+// channels, policies and features it was encoded from.
+func TestSnapshotEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	var node1, node2, node3 route.Vertex
+	node1[0], node2[0], node3[0] = 2, 3, 2
+	node1[1], node2[1], node3[1] = 1, 2, 3
+
+	newInfo := func(chanID uint64,
+		n1, n2 route.Vertex) *channeldb.ChannelEdgeInfo {
+
+		return &channeldb.ChannelEdgeInfo{
+			ChannelID:        chanID,
+			ChainHash:        chainhash.Hash{1},
+			NodeKey1Bytes:    n1,
+			NodeKey2Bytes:    n2,
+			BitcoinKey1Bytes: n2,
+			BitcoinKey2Bytes: n1,
+			ChannelPoint: wire.OutPoint{
+				Hash:  chainhash.Hash{byte(chanID)},
+				Index: uint32(chanID % 3),
+			},
+			Capacity: 1000000,
+		}
+	}
+
+	since := int64(1600000000)
+
+	// The first channel has default values in both directions.
+	chan1 := &Channel{
+		Info: newInfo(700000<<40, node1, node2),
+		Policies: [2]*channeldb.ChannelEdgePolicy{
+			testPolicy(700000<<40, 0, since),
+			testPolicy(700000<<40, 1, since+100),
+		},
+	}
+
+	// The second channel has custom values, and only a single policy
+	// that doesn't set a maximum HTLC and is disabled.
+	custom := testPolicy(700001<<40, 1, since+5000)
+	custom.MessageFlags = 0
+	custom.MaxHTLC = 0
+	custom.ChannelFlags |= lnwire.ChanUpdateDisabled
+	custom.TimeLockDelta = 144
+	custom.MinHTLC = 1
+	custom.FeeBaseMSat = 0
+	custom.FeeProportionalMillionths = 5000
+	chan2 := &Channel{
+		Info: newInfo(700001<<40, node2, node3),
+		Policies: [2]*channeldb.ChannelEdgePolicy{
+			nil, custom,
+		},
+	}
+
+	// The third channel has a custom maximum HTLC amount.
+	maxHTLC := testPolicy(700000<<40|5, 0, since+10)
+	maxHTLC.MaxHTLC = 5000000
+	chan3 := &Channel{
+		Info: newInfo(700000<<40|5, node1, node3),
+		Policies: [2]*channeldb.ChannelEdgePolicy{
+			maxHTLC, testPolicy(700000<<40|5, 1, since+20),
+		},
+	}
+
+	features := lnwire.NewRawFeatureVector(
+		lnwire.TLVOnionPayloadOptional, lnwire.PaymentAddrRequired,
+	)
+	snapshot := &Snapshot{
+		ChainHash: chainhash.Hash{1},
+		Source:    node3,
+		Since:     time.Unix(since, 0),
+		Timestamp: time.Unix(since+5000, 0),
+		Channels:  []*Channel{chan2, chan3, chan1},
+		Features: map[route.Vertex]*lnwire.RawFeatureVector{
+			node1: features,
+			node3: features,
+		},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, snapshot.Encode(&b))
+
+	decoded, err := Decode(bytes.NewReader(b.Bytes()))
+	require.NoError(t, err)
+
+	// The channels are written in order of their IDs.
+	require.Equal(t, snapshot.ChainHash, decoded.ChainHash)
+	require.Equal(t, snapshot.Source, decoded.Source)
+	require.Equal(t, snapshot.Since, decoded.Since)
+	require.Equal(t, snapshot.Timestamp, decoded.Timestamp)
+	require.Equal(t, []*Channel{chan1, chan3, chan2}, decoded.Channels)
+	require.Equal(t, snapshot.Features, decoded.Features)
+
+	// A policy that is older than the start of the snapshot can't be
+	// encoded.
+	snapshot.Channels = append(snapshot.Channels, &Channel{
+		Info: newInfo(1, node1, node2),
+		Policies: [2]*channeldb.ChannelEdgePolicy{
+			testPolicy(1, 0, since-1),
+		},
+	})
+	require.Error(t, snapshot.Encode(&b))
+
+	// Other data shouldn't be mistaken for a snapshot.
+	_, err = Decode(bytes.NewReader([]byte("not a snapshot")))
+	require.Equal(t, ErrUnknownFormat, err)
+}
@@ -97,6 +97,15 @@ type SyncManagerCfg struct {
 	// ActiveSync upon connection. These peers will never transition to
 	// PassiveSync.
 	PinnedSyncers PinnedSyncers
+
+	// PeerMsgRateLimit is the number of announcements per second we'll
+	// accept from a single peer on average. Announcements that exceed the
+	// limit are dropped. A value of zero disables the limit.
+	PeerMsgRateLimit float64
+
+	// PeerMsgBurst is the number of announcements we'll accept from a
+	// single peer in a burst, before PeerMsgRateLimit is enforced.
+	PeerMsgBurst int
 }
 
 // SyncManager is a subsystem of the gossiper that manages the gossip syncers
@@ -151,6 +160,10 @@ type SyncManager struct {
 	// duration of the connection.
 	pinnedActiveSyncers map[route.Vertex]*GossipSyncer
 
+	// accountant keeps track of the gossip traffic we exchange with each
+	// peer, and rate limits the announcements they send us.
+	accountant *gossipAccountant
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -168,6 +181,9 @@ func newSyncManager(cfg *SyncManagerCfg) *SyncManager {
 		pinnedActiveSyncers: make(
 			map[route.Vertex]*GossipSyncer, len(cfg.PinnedSyncers),
 		),
+		accountant: newGossipAccountant(
+			cfg.PeerMsgRateLimit, cfg.PeerMsgBurst,
+		),
 		quit: make(chan struct{}),
 	}
 }
@@ -481,9 +497,11 @@ func (m *SyncManager) createGossipSyncer(peer lnpeer.Peer) *GossipSyncer {
 		chunkSize:     encodingTypeToChunkSize[encoding],
 		batchSize:     requestBatchSize,
 		sendToPeer: func(msgs ...lnwire.Message) error {
+			m.accountant.recordSent(nodeID, msgs...)
 			return peer.SendMessageLazy(false, msgs...)
 		},
 		sendToPeerSync: func(msgs ...lnwire.Message) error {
+			m.accountant.recordSent(nodeID, msgs...)
 			return peer.SendMessageLazy(true, msgs...)
 		},
 		ignoreHistoricalFilters:   m.cfg.IgnoreHistoricalFilters,
@@ -520,6 +538,8 @@ func (m *SyncManager) removeGossipSyncer(peer route.Vertex) {
 
 	log.Infof("Removing GossipSyncer for peer=%v", peer)
 
+	m.accountant.removePeer(peer)
+
 	// We'll stop the GossipSyncer for the disconnected peer in a goroutine
 	// to prevent blocking the SyncManager.
 	go s.Stop()
@@ -768,6 +788,41 @@ func (m *SyncManager) gossipSyncers() map[route.Vertex]*GossipSyncer {
 	return syncers
 }
 
+// processRemoteMsg accounts for a gossip message received from the peer, and
+// returns false if the message should be dropped as the peer has exceeded its
+// announcement rate limit. Announcements received while we're waiting for the
+// replies to our own queries aren't rate limited, as we've asked for them.
+func (m *SyncManager) processRemoteMsg(peer route.Vertex,
+	msg lnwire.Message) bool {
+
+	s, ok := m.GossipSyncer(peer)
+	if !ok {
+		return true
+	}
+
+	var rateLimited bool
+	switch msg.(type) {
+	case *lnwire.ChannelAnnouncement, *lnwire.ChannelUpdate,
+		*lnwire.NodeAnnouncement:
+
+		rateLimited = !s.awaitingQueryReplies()
+	}
+
+	if !m.accountant.recordReceived(peer, msg, rateLimited) {
+		log.Debugf("Dropping %v from peer=%x: rate limit exceeded",
+			msg.MsgType(), peer[:])
+		return false
+	}
+
+	return true
+}
+
+// GossipStats returns the gossip traffic we've exchanged with each of the
+// currently connected peers.
+func (m *SyncManager) GossipStats() map[route.Vertex]PeerGossipStats {
+	return m.accountant.stats()
+}
+
 // markGraphSynced allows us to report that the initial historical sync has
 // completed.
 func (m *SyncManager) markGraphSynced() {
@@ -278,6 +278,7 @@ func TestSyncManagerInitialHistoricalSync(t *testing.T) {
 	assertMsgSent(t, peer, &lnwire.QueryChannelRange{
 		FirstBlockHeight: 0,
 		NumBlocks:        latestKnownHeight,
+		QueryOptions:     &defaultQueryOptions,
 	})
 
 	// The graph should not be considered as synced since the initial
@@ -380,6 +381,7 @@ func TestSyncManagerForceHistoricalSync(t *testing.T) {
 	assertMsgSent(t, peer, &lnwire.QueryChannelRange{
 		FirstBlockHeight: 0,
 		NumBlocks:        latestKnownHeight,
+		QueryOptions:     &defaultQueryOptions,
 	})
 
 	// If an additional peer connects, then a historical sync should not be
@@ -395,6 +397,7 @@ func TestSyncManagerForceHistoricalSync(t *testing.T) {
 	assertMsgSent(t, extraPeer, &lnwire.QueryChannelRange{
 		FirstBlockHeight: 0,
 		NumBlocks:        latestKnownHeight,
+		QueryOptions:     &defaultQueryOptions,
 	})
 }
 
@@ -416,6 +419,7 @@ func TestSyncManagerGraphSyncedAfterHistoricalSyncReplacement(t *testing.T) {
 	assertMsgSent(t, peer, &lnwire.QueryChannelRange{
 		FirstBlockHeight: 0,
 		NumBlocks:        latestKnownHeight,
+		QueryOptions:     &defaultQueryOptions,
 	})
 
 	// The graph should not be considered as synced since the initial
@@ -621,6 +625,7 @@ func assertTransitionToChansSynced(t *testing.T, s *GossipSyncer, peer *mockPeer
 	query := &lnwire.QueryChannelRange{
 		FirstBlockHeight: 0,
 		NumBlocks:        latestKnownHeight,
+		QueryOptions:     &defaultQueryOptions,
 	}
 	assertMsgSent(t, peer, query)
 
@@ -179,6 +179,18 @@ const (
 	// requestBatchSize is the maximum number of channels we will query the
 	// remote peer for in a QueryShortChanIDs message.
 	requestBatchSize = 500
+
+	// chanUpdateRefreshInterval is the age difference after which we'll
+	// query the remote peer for a newer channel update, even if its
+	// checksum shows that it announces the same policy as ours. This
+	// ensures that we don't prune channels that are only kept alive by
+	// periodic updates.
+	chanUpdateRefreshInterval = 7 * 24 * time.Hour
+
+	// chanUpdateInfoSize is the number of bytes the timestamps or the
+	// checksums of the updates of a channel add to a ReplyChannelRange
+	// message.
+	chanUpdateInfoSize = 8
 )
 
 var (
@@ -363,6 +375,11 @@ type GossipSyncer struct {
 	// buffer all the chunked response to our query.
 	bufferedChanRangeReplies []lnwire.ShortChannelID
 
+	// bufferedChanUpdateInfos is used in the waitingQueryChanReply state
+	// to buffer the timestamps and checksums of the channel updates the
+	// remote peer included in its replies to our query.
+	bufferedChanUpdateInfos map[lnwire.ShortChannelID]*ChanUpdateInfo
+
 	// numChanRangeRepliesRcvd is used to track the number of replies
 	// received as part of a QueryChannelRange. This field is primarily used
 	// within the waitingQueryChanReply state.
@@ -373,6 +390,11 @@ type GossipSyncer struct {
 	// state.
 	newChansToQuery []lnwire.ShortChannelID
 
+	// newChanQueryFlags holds the query flags of each of the channels in
+	// newChansToQuery. It's only set if we're querying for the updated
+	// policies of channels we already know of.
+	newChanQueryFlags []lnwire.QueryFlag
+
 	cfg gossipSyncerCfg
 
 	// rateLimiter dictates the frequency with which we will reply to gossip
@@ -715,13 +737,18 @@ func (g *GossipSyncer) synchronizeChanIDs() (bool, error) {
 
 	// Otherwise, we'll issue our next chunked query to receive replies
 	// for.
-	var queryChunk []lnwire.ShortChannelID
+	var (
+		queryChunk []lnwire.ShortChannelID
+		flagsChunk []lnwire.QueryFlag
+	)
 
 	// If the number of channels to query for is less than the chunk size,
 	// then we can issue a single query.
 	if int32(len(g.newChansToQuery)) < g.cfg.batchSize {
 		queryChunk = g.newChansToQuery
+		flagsChunk = g.newChanQueryFlags
 		g.newChansToQuery = nil
+		g.newChanQueryFlags = nil
 
 	} else {
 		// Otherwise, we'll need to only query for the next chunk.
@@ -729,6 +756,12 @@ func (g *GossipSyncer) synchronizeChanIDs() (bool, error) {
 		// pointer down by the chunk size.
 		queryChunk = g.newChansToQuery[:g.cfg.batchSize]
 		g.newChansToQuery = g.newChansToQuery[g.cfg.batchSize:]
+
+		if g.newChanQueryFlags != nil {
+			flagsChunk = g.newChanQueryFlags[:g.cfg.batchSize]
+			g.newChanQueryFlags =
+				g.newChanQueryFlags[g.cfg.batchSize:]
+		}
 	}
 
 	log.Infof("GossipSyncer(%x): querying for %v new channels",
@@ -740,6 +773,7 @@ func (g *GossipSyncer) synchronizeChanIDs() (bool, error) {
 		ChainHash:    g.cfg.chainHash,
 		EncodingType: lnwire.EncodingSortedPlain,
 		ShortChanIDs: queryChunk,
+		QueryFlags:   flagsChunk,
 	})
 
 	return false, err
@@ -808,6 +842,7 @@ func (g *GossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 	g.bufferedChanRangeReplies = append(
 		g.bufferedChanRangeReplies, msg.ShortChanIDs...,
 	)
+	g.bufferChanUpdateInfos(msg)
 	switch g.cfg.encodingType {
 	case lnwire.EncodingSortedPlain:
 		g.numChanRangeRepliesRcvd++
@@ -858,17 +893,25 @@ func (g *GossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 		return fmt.Errorf("unable to filter chan ids: %v", err)
 	}
 
+	// Among the channels we do know of, we'll also look for those the
+	// remote peer has newer updates of.
+	staleChans, staleFlags, err := g.filterStaleChans(newChans)
+	if err != nil {
+		return fmt.Errorf("unable to filter stale chans: %v", err)
+	}
+
 	// As we've received the entirety of the reply, we no longer need to
 	// hold on to the set of buffered replies or the original query that
 	// prompted the replies, so we'll let that be garbage collected now.
 	g.curQueryRangeMsg = nil
 	g.prevReplyChannelRange = nil
 	g.bufferedChanRangeReplies = nil
+	g.bufferedChanUpdateInfos = nil
 	g.numChanRangeRepliesRcvd = 0
 
 	// If there aren't any channels that we don't know of, then we can
 	// switch straight to our terminal state.
-	if len(newChans) == 0 {
+	if len(newChans) == 0 && len(staleChans) == 0 {
 		log.Infof("GossipSyncer(%x): remote peer has no new chans",
 			g.cfg.peerPub[:])
 
@@ -882,16 +925,159 @@ func (g *GossipSyncer) processChanRangeReply(msg *lnwire.ReplyChannelRange) erro
 	}
 
 	// Otherwise, we'll set the set of channels that we need to query for
-	// the next state, and also transition our state.
-	g.newChansToQuery = newChans
+	// the next state, and also transition our state. We only need to
+	// specify query flags if we're querying for updates of channels we
+	// already know of, as everything is queried for new channels.
+	numNewChans := len(newChans)
+	g.newChansToQuery = append(newChans, staleChans...)
+	g.newChanQueryFlags = nil
+	if len(staleChans) > 0 {
+		g.newChanQueryFlags = make(
+			[]lnwire.QueryFlag, numNewChans, len(g.newChansToQuery),
+		)
+		for i := range g.newChanQueryFlags {
+			g.newChanQueryFlags[i] = lnwire.QueryFlagAll
+		}
+		g.newChanQueryFlags = append(g.newChanQueryFlags, staleFlags...)
+	}
 	g.setSyncState(queryNewChannels)
 
-	log.Infof("GossipSyncer(%x): starting query for %v new chans",
-		g.cfg.peerPub[:], len(newChans))
+	log.Infof("GossipSyncer(%x): starting query for %v new chans and %v "+
+		"chans with updated policies", g.cfg.peerPub[:], numNewChans,
+		len(staleChans))
 
 	return nil
 }
 
+// bufferChanUpdateInfos buffers the timestamps and checksums of the channel
+// updates included in a reply to our channel range query, if the remote peer
+// included them for all of its channels.
+func (g *GossipSyncer) bufferChanUpdateInfos(msg *lnwire.ReplyChannelRange) {
+	if len(msg.Timestamps) != len(msg.ShortChanIDs) {
+		return
+	}
+	withChecksums := len(msg.Checksums) == len(msg.ShortChanIDs)
+
+	if g.bufferedChanUpdateInfos == nil {
+		g.bufferedChanUpdateInfos = make(
+			map[lnwire.ShortChannelID]*ChanUpdateInfo,
+		)
+	}
+	for i, chanID := range msg.ShortChanIDs {
+		info := &ChanUpdateInfo{
+			Timestamps: msg.Timestamps[i],
+		}
+		if withChecksums {
+			info.Checksums = msg.Checksums[i]
+		}
+		g.bufferedChanUpdateInfos[chanID] = info
+	}
+}
+
+// filterStaleChans returns the channels among the buffered channel range
+// replies that we already know of, but that the remote peer has newer updates
+// of, along with the query flags to request only those updates. Updates that
+// only refresh the timestamp of a policy we know are skipped, as long as our
+// own update isn't about to go stale.
+func (g *GossipSyncer) filterStaleChans(newChans []lnwire.ShortChannelID) (
+	[]lnwire.ShortChannelID, []lnwire.QueryFlag, error) {
+
+	if len(g.bufferedChanUpdateInfos) == 0 {
+		return nil, nil, nil
+	}
+
+	// We'll skip the new channels, as well as any duplicates in the
+	// replies.
+	skip := make(map[lnwire.ShortChannelID]struct{}, len(newChans))
+	for _, chanID := range newChans {
+		skip[chanID] = struct{}{}
+	}
+
+	knownChans := make(
+		[]lnwire.ShortChannelID, 0, len(g.bufferedChanUpdateInfos),
+	)
+	for _, chanID := range g.bufferedChanRangeReplies {
+		if _, ok := skip[chanID]; ok {
+			continue
+		}
+		if _, ok := g.bufferedChanUpdateInfos[chanID]; !ok {
+			continue
+		}
+
+		knownChans = append(knownChans, chanID)
+		skip[chanID] = struct{}{}
+	}
+	if len(knownChans) == 0 {
+		return nil, nil, nil
+	}
+
+	localInfos, err := g.cfg.channelSeries.FetchChanUpdateInfos(
+		g.cfg.chainHash, knownChans,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		staleChans []lnwire.ShortChannelID
+		staleFlags []lnwire.QueryFlag
+	)
+	for _, chanID := range knownChans {
+		local, ok := localInfos[chanID]
+		if !ok {
+			continue
+		}
+		remote := g.bufferedChanUpdateInfos[chanID]
+
+		var flags lnwire.QueryFlag
+		localTs, remoteTs := local.Timestamps, remote.Timestamps
+		localCs, remoteCs := local.Checksums, remote.Checksums
+		if isStaleUpdate(
+			localTs.Timestamp1, localCs.Checksum1,
+			remoteTs.Timestamp1, remoteCs.Checksum1,
+		) {
+			flags |= lnwire.QueryFlagChanUpdate1
+		}
+		if isStaleUpdate(
+			localTs.Timestamp2, localCs.Checksum2,
+			remoteTs.Timestamp2, remoteCs.Checksum2,
+		) {
+			flags |= lnwire.QueryFlagChanUpdate2
+		}
+		if flags == 0 {
+			continue
+		}
+
+		staleChans = append(staleChans, chanID)
+		staleFlags = append(staleFlags, flags)
+	}
+
+	return staleChans, staleFlags, nil
+}
+
+// isStaleUpdate returns true if the remote peer's channel update for one
+// direction of a channel is newer than ours, and either announces a different
+// policy, or our update is old enough that we need the refreshed timestamp to
+// keep the channel from being pruned. A zero checksum denotes that it isn't
+// known.
+func isStaleUpdate(localTimestamp, localChecksum, remoteTimestamp,
+	remoteChecksum uint32) bool {
+
+	if remoteTimestamp <= localTimestamp {
+		return false
+	}
+
+	if localChecksum == 0 || remoteChecksum == 0 ||
+		localChecksum != remoteChecksum {
+
+		return true
+	}
+
+	return remoteTimestamp-localTimestamp >= uint32(
+		chanUpdateRefreshInterval.Seconds(),
+	)
+}
+
 // genChanRangeQuery generates the initial message we'll send to the remote
 // party when we're kicking off the channel graph synchronization upon
 // connection. The historicalQuery boolean can be used to generate a query from
@@ -936,10 +1122,13 @@ func (g *GossipSyncer) genChanRangeQuery(
 	// Finally, we'll craft the channel range query, using our starting
 	// height, then asking for all known channels to the foreseeable end of
 	// the main chain.
+	queryOptions := lnwire.QueryOptionTimestamps |
+		lnwire.QueryOptionChecksums
 	query := &lnwire.QueryChannelRange{
 		ChainHash:        g.cfg.chainHash,
 		FirstBlockHeight: startHeight,
 		NumBlocks:        numBlocks,
+		QueryOptions:     &queryOptions,
 	}
 	g.curQueryRangeMsg = query
 
@@ -1023,6 +1212,31 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		return err
 	}
 
+	// If the remote peer requested the timestamps or checksums of the
+	// channel updates, we may need to reduce the number of channels per
+	// reply so that they still fit into a single message.
+	var queryOptions lnwire.QueryOptions
+	if query.QueryOptions != nil {
+		queryOptions = *query.QueryOptions
+	}
+	withTimestamps := queryOptions.Contains(lnwire.QueryOptionTimestamps)
+	withChecksums := queryOptions.Contains(lnwire.QueryOptionChecksums)
+
+	const shortChanIDSize = 8
+	chanSize := int32(shortChanIDSize)
+	if withTimestamps {
+		chanSize += chanUpdateInfoSize
+	}
+	if withChecksums {
+		chanSize += chanUpdateInfoSize
+	}
+	maxReplyBytes := encodingTypeToChunkSize[lnwire.EncodingSortedPlain] *
+		shortChanIDSize
+	replyChunkSize := g.cfg.chunkSize
+	if replyChunkSize > maxReplyBytes/chanSize {
+		replyChunkSize = maxReplyBytes / chanSize
+	}
+
 	// TODO(roasbeef): means can't send max uint above?
 	//  * or make internal 64
 
@@ -1043,14 +1257,22 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 			complete = 1
 		}
 
-		return g.cfg.sendToPeerSync(&lnwire.ReplyChannelRange{
+		reply := &lnwire.ReplyChannelRange{
 			ChainHash:        query.ChainHash,
 			NumBlocks:        numBlocks,
 			FirstBlockHeight: firstHeight,
 			Complete:         complete,
 			EncodingType:     g.cfg.encodingType,
 			ShortChanIDs:     channelChunk,
-		})
+		}
+		err := g.addChanUpdateInfos(
+			reply, withTimestamps, withChecksums,
+		)
+		if err != nil {
+			return err
+		}
+
+		return g.cfg.sendToPeerSync(reply)
 	}
 
 	var (
@@ -1061,7 +1283,7 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 	for _, channelRange := range channelRanges {
 		channels := channelRange.Channels
 		numChannels := int32(len(channels))
-		numLeftToAdd := g.cfg.chunkSize - int32(len(channelChunk))
+		numLeftToAdd := replyChunkSize - int32(len(channelChunk))
 
 		// Include the current block in the ongoing chunk if it can fit
 		// and move on to the next block.
@@ -1092,12 +1314,12 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 		// assume a historical gossip sync is performed at a later time.
 		firstHeight = channelRange.Height
 		chunkSize := numChannels
-		exceedsChunkSize := numChannels > g.cfg.chunkSize
+		exceedsChunkSize := numChannels > replyChunkSize
 		if exceedsChunkSize {
 			rand.Shuffle(len(channels), func(i, j int) {
 				channels[i], channels[j] = channels[j], channels[i]
 			})
-			chunkSize = g.cfg.chunkSize
+			chunkSize = replyChunkSize
 		}
 		channelChunk = channels[:chunkSize]
 
@@ -1118,6 +1340,46 @@ func (g *GossipSyncer) replyChanRangeQuery(query *lnwire.QueryChannelRange) erro
 	)
 }
 
+// addChanUpdateInfos adds the requested timestamps and checksums of the
+// channel updates of the reply's channels to it.
+func (g *GossipSyncer) addChanUpdateInfos(reply *lnwire.ReplyChannelRange,
+	withTimestamps, withChecksums bool) error {
+
+	if !withTimestamps && !withChecksums {
+		return nil
+	}
+
+	infos, err := g.cfg.channelSeries.FetchChanUpdateInfos(
+		reply.ChainHash, reply.ShortChanIDs,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch chan update infos: %v", err)
+	}
+
+	numChans := len(reply.ShortChanIDs)
+	if withTimestamps {
+		reply.Timestamps = make([]lnwire.ChanUpdateTimestamps, numChans)
+	}
+	if withChecksums {
+		reply.Checksums = make([]lnwire.ChanUpdateChecksums, numChans)
+	}
+	for i, chanID := range reply.ShortChanIDs {
+		info, ok := infos[chanID]
+		if !ok {
+			continue
+		}
+
+		if withTimestamps {
+			reply.Timestamps[i] = info.Timestamps
+		}
+		if withChecksums {
+			reply.Checksums[i] = info.Checksums
+		}
+	}
+
+	return nil
+}
+
 // replyShortChanIDs will be dispatched in response to a query by the remote
 // node for information concerning a set of short channel ID's. Our response
 // will be sent in a streaming chunked manner to ensure that we remain below
@@ -1151,8 +1413,14 @@ func (g *GossipSyncer) replyShortChanIDs(query *lnwire.QueryShortChanIDs) error
 	// time series for the set of messages that we know of which satisfies
 	// the requirement of being a chan ann, chan update, or a node ann
 	// related to the set of queried channels.
+	// The query flags are only valid if there's one for each channel,
+	// otherwise we'll reply with all messages of the channels.
+	queryFlags := query.QueryFlags
+	if len(queryFlags) != len(query.ShortChanIDs) {
+		queryFlags = nil
+	}
 	replyMsgs, err := g.cfg.channelSeries.FetchChanAnns(
-		query.ChainHash, query.ShortChanIDs,
+		query.ChainHash, query.ShortChanIDs, queryFlags,
 	)
 	if err != nil {
 		return fmt.Errorf("unable to fetch chan anns for %v..., %v",
@@ -1405,6 +1673,13 @@ func (g *GossipSyncer) syncState() syncerState {
 	return syncerState(atomic.LoadUint32(&g.state))
 }
 
+// awaitingQueryReplies returns true if the GossipSyncer is in the process of
+// querying the peer for the announcements of new channels.
+func (g *GossipSyncer) awaitingQueryReplies() bool {
+	state := g.syncState()
+	return state == queryNewChannels || state == waitingQueryChanReply
+}
+
 // ResetSyncedSignal returns a channel that will be closed in order to serve as
 // a signal for when the GossipSyncer has reached its chansSynced state.
 func (g *GossipSyncer) ResetSyncedSignal() chan struct{} {
@@ -25,6 +25,11 @@ const (
 
 var (
 	defaultChunkSize = encodingTypeToChunkSize[defaultEncoding]
+
+	// defaultQueryOptions are the query options of every channel range
+	// query sent by a GossipSyncer.
+	defaultQueryOptions = lnwire.QueryOptionTimestamps |
+		lnwire.QueryOptionChecksums
 )
 
 type horizonQuery struct {
@@ -53,6 +58,11 @@ type mockChannelGraphTimeSeries struct {
 
 	updateReq  chan lnwire.ShortChannelID
 	updateResp chan []*lnwire.ChannelUpdate
+
+	annFlagsReq chan []lnwire.QueryFlag
+
+	updateInfosMtx sync.Mutex
+	updateInfos    map[lnwire.ShortChannelID]*ChanUpdateInfo
 }
 
 func newMockChannelGraphTimeSeries(
@@ -75,9 +85,24 @@ func newMockChannelGraphTimeSeries(
 
 		updateReq:  make(chan lnwire.ShortChannelID, 1),
 		updateResp: make(chan []*lnwire.ChannelUpdate, 1),
+
+		annFlagsReq: make(chan []lnwire.QueryFlag, 1),
+
+		updateInfos: make(map[lnwire.ShortChannelID]*ChanUpdateInfo),
 	}
 }
 
+// setUpdateInfo sets the update timestamps and checksums that are returned
+// for the given channel.
+func (m *mockChannelGraphTimeSeries) setUpdateInfo(
+	chanID lnwire.ShortChannelID, info *ChanUpdateInfo) {
+
+	m.updateInfosMtx.Lock()
+	defer m.updateInfosMtx.Unlock()
+
+	m.updateInfos[chanID] = info
+}
+
 func (m *mockChannelGraphTimeSeries) HighestChanID(chain chainhash.Hash) (*lnwire.ShortChannelID, error) {
 	return &m.highestID, nil
 }
@@ -129,9 +154,31 @@ func (m *mockChannelGraphTimeSeries) FilterChannelRange(chain chainhash.Hash,
 
 	return channelRanges, nil
 }
+func (m *mockChannelGraphTimeSeries) FetchChanUpdateInfos(
+	chain chainhash.Hash, shortChanIDs []lnwire.ShortChannelID) (
+	map[lnwire.ShortChannelID]*ChanUpdateInfo, error) {
+
+	m.updateInfosMtx.Lock()
+	defer m.updateInfosMtx.Unlock()
+
+	infos := make(map[lnwire.ShortChannelID]*ChanUpdateInfo)
+	for _, chanID := range shortChanIDs {
+		if info, ok := m.updateInfos[chanID]; ok {
+			infos[chanID] = info
+		}
+	}
+
+	return infos, nil
+}
 func (m *mockChannelGraphTimeSeries) FetchChanAnns(chain chainhash.Hash,
-	shortChanIDs []lnwire.ShortChannelID) ([]lnwire.Message, error) {
+	shortChanIDs []lnwire.ShortChannelID,
+	queryFlags []lnwire.QueryFlag) ([]lnwire.Message, error) {
 
+	// The query flags are only recorded if the test is interested in
+	// them, to not block the tests that aren't.
+	if queryFlags != nil {
+		m.annFlagsReq <- queryFlags
+	}
 	m.annReq <- shortChanIDs
 
 	return <-m.annResp, nil
@@ -2265,6 +2312,7 @@ func TestGossipSyncerHistoricalSync(t *testing.T) {
 	expectedMsg := &lnwire.QueryChannelRange{
 		FirstBlockHeight: 0,
 		NumBlocks:        latestKnownHeight,
+		QueryOptions:     &defaultQueryOptions,
 	}
 
 	select {
@@ -2413,3 +2461,201 @@ func TestGossipSyncerMaxChannelRangeReplies(t *testing.T) {
 		},
 	}, nil))
 }
+
+// TestGossipSyncerReplyChanRangeQueryUpdateInfos tests that we include the
+// timestamps and checksums of our channel updates in our replies to a channel
+// range query if they were requested.
+func TestGossipSyncerReplyChanRangeQueryUpdateInfos(t *testing.T) {
+	t.Parallel()
+
+	msgChan, syncer, chanSeries := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding,
+		defaultChunkSize,
+	)
+
+	chan1 := lnwire.ShortChannelID{BlockHeight: 100}
+	chan2 := lnwire.ShortChannelID{BlockHeight: 101}
+	info1 := &ChanUpdateInfo{
+		Timestamps: lnwire.ChanUpdateTimestamps{
+			Timestamp1: 1000, Timestamp2: 2000,
+		},
+		Checksums: lnwire.ChanUpdateChecksums{
+			Checksum1: 1, Checksum2: 2,
+		},
+	}
+	chanSeries.setUpdateInfo(chan1, info1)
+
+	// We only know the updates of the first channel, so the second one
+	// should be replied with zero timestamps and checksums.
+	chanSeries.filterRangeResp <- []lnwire.ShortChannelID{chan1, chan2}
+	query := &lnwire.QueryChannelRange{
+		FirstBlockHeight: 100,
+		NumBlocks:        50,
+		QueryOptions:     &defaultQueryOptions,
+	}
+	require.NoError(t, syncer.replyChanRangeQuery(query))
+	<-chanSeries.filterRangeReqs
+
+	select {
+	case <-time.After(time.Second * 15):
+		t.Fatalf("no msgs received")
+
+	case msgs := <-msgChan:
+		require.Len(t, msgs, 1)
+		reply, ok := msgs[0].(*lnwire.ReplyChannelRange)
+		require.True(t, ok)
+
+		require.Equal(t, []lnwire.ShortChannelID{chan1, chan2},
+			reply.ShortChanIDs)
+		require.Equal(t, []lnwire.ChanUpdateTimestamps{
+			info1.Timestamps, {},
+		}, reply.Timestamps)
+		require.Equal(t, []lnwire.ChanUpdateChecksums{
+			info1.Checksums, {},
+		}, reply.Checksums)
+	}
+}
+
+// TestGossipSyncerProcessChanRangeReplyStaleUpdates tests that we query the
+// remote peer for the updates of channels we already know of, if its updates
+// are newer and announce a different policy.
+func TestGossipSyncerProcessChanRangeReplyStaleUpdates(t *testing.T) {
+	t.Parallel()
+
+	highestID := lnwire.ShortChannelID{
+		BlockHeight: latestKnownHeight,
+	}
+	_, syncer, chanSeries := newTestSyncer(
+		highestID, defaultEncoding, defaultChunkSize,
+	)
+
+	query, err := syncer.genChanRangeQuery(true)
+	require.NoError(t, err)
+	require.Equal(t, defaultQueryOptions, *query.QueryOptions)
+
+	refresh := uint32(chanUpdateRefreshInterval.Seconds())
+	var (
+		// We don't know of this channel yet, so we'll query for all
+		// of its messages.
+		newChan = lnwire.ShortChannelID{BlockHeight: 10}
+
+		// Only the first update of this channel has changed.
+		changedChan = lnwire.ShortChannelID{BlockHeight: 11}
+
+		// The update of this channel only refreshes its timestamp.
+		refreshedChan = lnwire.ShortChannelID{BlockHeight: 12}
+
+		// The update of this channel only refreshes its timestamp,
+		// but ours is about to go stale.
+		staleChan = lnwire.ShortChannelID{BlockHeight: 13}
+
+		// We know of a newer update of this channel.
+		olderChan = lnwire.ShortChannelID{BlockHeight: 14}
+	)
+	chanSeries.setUpdateInfo(changedChan, &ChanUpdateInfo{
+		Timestamps: lnwire.ChanUpdateTimestamps{
+			Timestamp1: 100, Timestamp2: 100,
+		},
+		Checksums: lnwire.ChanUpdateChecksums{
+			Checksum1: 1, Checksum2: 2,
+		},
+	})
+	chanSeries.setUpdateInfo(refreshedChan, &ChanUpdateInfo{
+		Timestamps: lnwire.ChanUpdateTimestamps{Timestamp1: 100},
+		Checksums:  lnwire.ChanUpdateChecksums{Checksum1: 3},
+	})
+	chanSeries.setUpdateInfo(staleChan, &ChanUpdateInfo{
+		Timestamps: lnwire.ChanUpdateTimestamps{Timestamp1: 100},
+		Checksums:  lnwire.ChanUpdateChecksums{Checksum1: 4},
+	})
+	chanSeries.setUpdateInfo(olderChan, &ChanUpdateInfo{
+		Timestamps: lnwire.ChanUpdateTimestamps{Timestamp1: 300},
+		Checksums:  lnwire.ChanUpdateChecksums{Checksum1: 5},
+	})
+
+	reply := &lnwire.ReplyChannelRange{
+		FirstBlockHeight: 0,
+		NumBlocks:        query.NumBlocks,
+		Complete:         1,
+		ShortChanIDs: []lnwire.ShortChannelID{
+			newChan, changedChan, refreshedChan, staleChan,
+			olderChan,
+		},
+		Timestamps: []lnwire.ChanUpdateTimestamps{
+			{Timestamp1: 100, Timestamp2: 100},
+			{Timestamp1: 200, Timestamp2: 200},
+			{Timestamp1: 200},
+			{Timestamp1: 100 + refresh},
+			{Timestamp1: 200},
+		},
+		Checksums: []lnwire.ChanUpdateChecksums{
+			{Checksum1: 9, Checksum2: 9},
+			{Checksum1: 6, Checksum2: 2},
+			{Checksum1: 3},
+			{Checksum1: 4},
+			{Checksum1: 7},
+		},
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		select {
+		case <-time.After(time.Second * 15):
+			errCh <- errors.New("no query received")
+
+		case <-chanSeries.filterReq:
+			chanSeries.filterResp <- []lnwire.ShortChannelID{
+				newChan,
+			}
+			errCh <- nil
+		}
+	}()
+
+	require.NoError(t, syncer.processChanRangeReply(reply))
+	require.NoError(t, <-errCh)
+
+	require.Equal(t, queryNewChannels, syncer.syncState())
+	require.Equal(t, []lnwire.ShortChannelID{
+		newChan, changedChan, staleChan,
+	}, syncer.newChansToQuery)
+	require.Equal(t, []lnwire.QueryFlag{
+		lnwire.QueryFlagAll, lnwire.QueryFlagChanUpdate1,
+		lnwire.QueryFlagChanUpdate1,
+	}, syncer.newChanQueryFlags)
+}
+
+// TestGossipSyncerReplyShortChanIDsQueryFlags tests that the query flags of a
+// short channel ID query are passed on when fetching the requested messages,
+// as long as there's one for each channel.
+func TestGossipSyncerReplyShortChanIDsQueryFlags(t *testing.T) {
+	t.Parallel()
+
+	msgChan, syncer, chanSeries := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding,
+		defaultChunkSize,
+	)
+
+	queryFlags := []lnwire.QueryFlag{
+		lnwire.QueryFlagChanUpdate1, lnwire.QueryFlagAll,
+	}
+	chanSeries.annResp <- nil
+	err := syncer.replyShortChanIDs(&lnwire.QueryShortChanIDs{
+		ShortChanIDs: []lnwire.ShortChannelID{
+			lnwire.NewShortChanIDFromInt(1),
+			lnwire.NewShortChanIDFromInt(2),
+		},
+		QueryFlags: queryFlags,
+	})
+	require.NoError(t, err)
+	require.Equal(t, queryFlags, <-chanSeries.annFlagsReq)
+	<-chanSeries.annReq
+
+	select {
+	case <-time.After(time.Second * 15):
+		t.Fatalf("no msgs received")
+
+	case msgs := <-msgChan:
+		require.Len(t, msgs, 1)
+		require.IsType(t, &lnwire.ReplyShortChanIDsEnd{}, msgs[0])
+	}
+}
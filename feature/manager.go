@@ -27,6 +27,35 @@ type Config struct {
 	// NoScriptEnforcementLease unsets any bits signaling support for script
 	// enforced leases.
 	NoScriptEnforcementLease bool
+
+	// NoOptionScidAlias unsets any bits signalling support for
+	// option_scid_alias. This also implicitly disables zero-conf channels.
+	NoOptionScidAlias bool
+
+	// NoZeroConf unsets any bits signalling support for zero-conf
+	// channels. This should be used instead of NoOptionScidAlias to still
+	// keep option-scid-alias support.
+	NoZeroConf bool
+
+	// NoRouteBlinding unsets any bits signalling support for forwarding
+	// and receiving payments over blinded routes.
+	NoRouteBlinding bool
+
+	// NoOnionMessages unsets any bits signalling support for sending,
+	// forwarding and receiving onion messages.
+	NoOnionMessages bool
+
+	// NoDualFund unsets any bits signalling support for dual funded
+	// channels. This also implicitly disables splicing.
+	NoDualFund bool
+
+	// NoSplice unsets any bits signalling support for splicing funds into
+	// and out of existing channels.
+	NoSplice bool
+
+	// NoSimpleClose unsets any bits signalling support for the simple
+	// close protocol.
+	NoSimpleClose bool
 }
 
 // Manager is responsible for generating feature vectors for different requested
@@ -115,6 +144,38 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.ScriptEnforcedLeaseOptional)
 			raw.Unset(lnwire.ScriptEnforcedLeaseRequired)
 		}
+		if cfg.NoOptionScidAlias {
+			raw.Unset(lnwire.ScidAliasOptional)
+			raw.Unset(lnwire.ScidAliasRequired)
+			raw.Unset(lnwire.ZeroConfOptional)
+			raw.Unset(lnwire.ZeroConfRequired)
+		}
+		if cfg.NoZeroConf {
+			raw.Unset(lnwire.ZeroConfOptional)
+			raw.Unset(lnwire.ZeroConfRequired)
+		}
+		if cfg.NoRouteBlinding {
+			raw.Unset(lnwire.RouteBlindingOptional)
+			raw.Unset(lnwire.RouteBlindingRequired)
+		}
+		if cfg.NoOnionMessages {
+			raw.Unset(lnwire.OnionMessagesOptional)
+			raw.Unset(lnwire.OnionMessagesRequired)
+		}
+		if cfg.NoDualFund {
+			raw.Unset(lnwire.DualFundOptional)
+			raw.Unset(lnwire.DualFundRequired)
+			raw.Unset(lnwire.SpliceOptional)
+			raw.Unset(lnwire.SpliceRequired)
+		}
+		if cfg.NoSplice {
+			raw.Unset(lnwire.SpliceOptional)
+			raw.Unset(lnwire.SpliceRequired)
+		}
+		if cfg.NoSimpleClose {
+			raw.Unset(lnwire.SimpleCloseOptional)
+			raw.Unset(lnwire.SimpleCloseRequired)
+		}
 
 		// Ensure that all of our feature sets properly set any
 		// dependent features.
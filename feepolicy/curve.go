@@ -0,0 +1,85 @@
+package feepolicy
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Point is a point of a curve.
+type Point struct {
+	// X is the input value of the point.
+	X float64
+
+	// Y is the output value of the point.
+	Y float64
+}
+
+// Curve is a piecewise linear function that is defined by its points, sorted
+// by their input value. Between two points the output is interpolated
+// linearly, and outside of the points it's the output of the nearest point.
+// An empty curve has no output value.
+type Curve []Point
+
+// ParseCurve parses a curve from a list of points in the format x:y. The
+// points may be given in any order, but their input values must be unique.
+func ParseCurve(points []string) (Curve, error) {
+	curve := make(Curve, 0, len(points))
+	for _, point := range points {
+		parts := strings.Split(point, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid curve point %v, "+
+				"expected x:y", point)
+		}
+
+		x, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid curve point %v: %v",
+				point, err)
+		}
+		y, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid curve point %v: %v",
+				point, err)
+		}
+
+		curve = append(curve, Point{X: x, Y: y})
+	}
+
+	sort.Slice(curve, func(i, j int) bool {
+		return curve[i].X < curve[j].X
+	})
+
+	for i := 1; i < len(curve); i++ {
+		if curve[i].X == curve[i-1].X {
+			return nil, fmt.Errorf("duplicate curve point for %v",
+				curve[i].X)
+		}
+	}
+
+	return curve, nil
+}
+
+// Value returns the output of the curve for the given input.
+func (c Curve) Value(x float64) float64 {
+	switch {
+	case len(c) == 0:
+		return 0
+
+	case x <= c[0].X:
+		return c[0].Y
+
+	case x >= c[len(c)-1].X:
+		return c[len(c)-1].Y
+	}
+
+	// Find the first point beyond x, and interpolate between it and the
+	// point before it.
+	i := sort.Search(len(c), func(i int) bool {
+		return c[i].X > x
+	})
+	lower, upper := c[i-1], c[i]
+
+	return lower.Y + (x-lower.X)*(upper.Y-lower.Y)/(upper.X-lower.X)
+}
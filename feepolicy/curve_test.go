@@ -0,0 +1,55 @@
+package feepolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseCurve tests parsing curves from their points.
+func TestParseCurve(t *testing.T) {
+	t.Parallel()
+
+	curve, err := ParseCurve([]string{"1:0", "0:1000", "0.5:200"})
+	require.NoError(t, err)
+	require.Equal(t, Curve{{0, 1000}, {0.5, 200}, {1, 0}}, curve)
+
+	invalidCurves := [][]string{
+		{"0"},
+		{"0:1:2"},
+		{"a:1"},
+		{"0:b"},
+		{"0.5:1", "0.5:2"},
+	}
+	for _, points := range invalidCurves {
+		_, err := ParseCurve(points)
+		require.Error(t, err, points)
+	}
+}
+
+// TestCurveValue tests that curves interpolate linearly between their points
+// and are constant outside of them.
+func TestCurveValue(t *testing.T) {
+	t.Parallel()
+
+	curve := Curve{{0, 1000}, {0.5, 200}, {1, 0}}
+
+	tests := []struct {
+		x float64
+		y float64
+	}{
+		{x: -1, y: 1000},
+		{x: 0, y: 1000},
+		{x: 0.25, y: 600},
+		{x: 0.5, y: 200},
+		{x: 0.75, y: 100},
+		{x: 1, y: 0},
+		{x: 2, y: 0},
+	}
+	for _, test := range tests {
+		require.InDelta(t, test.y, curve.Value(test.x), 1e-9, test.x)
+	}
+
+	require.Zero(t, Curve{}.Value(0.5))
+	require.Equal(t, 5.0, Curve{{0.5, 5}}.Value(0))
+}
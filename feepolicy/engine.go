@@ -0,0 +1,386 @@
+package feepolicy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/clock"
+	"github.com/ltcsuite/lnd/kvdb"
+	"github.com/ltcsuite/lnd/lnrpc"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing"
+	"github.com/ltcsuite/lnd/ticker"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+// maxForwardingEvents is the number of forwarding events that are queried
+// from the forwarding log at once.
+const maxForwardingEvents = 1000
+
+// Policy describes how the fees of our channels are derived from their
+// state.
+type Policy struct {
+	// FeeRateCurve maps the ratio of a channel's capacity that is our
+	// local balance to the fee rate of the channel in parts per million.
+	FeeRateCurve Curve
+
+	// BaseFeeCurve maps the ratio of a channel's capacity that is our
+	// local balance to the base fee of the channel in millisatoshis. If
+	// it's empty, the base fee of the channel isn't changed.
+	BaseFeeCurve Curve
+
+	// VolumeCurve maps the amount that was forwarded out of a channel
+	// during the VolumeWindow, as a ratio of its capacity, to a factor
+	// that the fee rate is multiplied with. If it's empty, the forwarding
+	// volume doesn't affect the fee rate.
+	VolumeCurve Curve
+
+	// VolumeWindow is the period of time over which the forwarding volume
+	// of a channel is measured.
+	VolumeWindow time.Duration
+
+	// MinUpdateInterval is the minimum time between two policy updates of
+	// the same channel, which limits the number of channel updates we
+	// broadcast.
+	MinUpdateInterval time.Duration
+
+	// MinChange is the relative change of the fee rate or base fee of a
+	// channel below which the policy of the channel isn't updated.
+	MinChange float64
+}
+
+// Config contains the dependencies of the fee policy engine.
+type Config struct {
+	// Policy is the policy that our channel fees are derived from.
+	Policy *Policy
+
+	// ForAllOutgoingChannels iterates over all our local channels along
+	// with their current policy.
+	ForAllOutgoingChannels func(cb func(kvdb.RTx,
+		*channeldb.ChannelEdgeInfo,
+		*channeldb.ChannelEdgePolicy) error) error
+
+	// FetchChannel fetches the state of one of our channels. Optionally
+	// an existing db tx can be supplied.
+	FetchChannel func(tx kvdb.RTx, chanPoint wire.OutPoint) (
+		*channeldb.OpenChannel, error)
+
+	// QueryForwardingLog queries the forwarding events of our node.
+	QueryForwardingLog func(channeldb.ForwardingEventQuery) (
+		channeldb.ForwardingLogTimeSlice, error)
+
+	// UpdatePolicy updates the policy of the given channels, and
+	// broadcasts the new policy to the network.
+	UpdatePolicy func(routing.ChannelPolicy,
+		...wire.OutPoint) ([]*lnrpc.FailedUpdate, error)
+
+	// Clock is the time source of the engine.
+	Clock clock.Clock
+
+	// UpdateTicker determines how often the fees of our channels are
+	// recomputed.
+	UpdateTicker ticker.Ticker
+}
+
+// channelState is the state of one of our channels that its fees are derived
+// from.
+type channelState struct {
+	chanPoint    wire.OutPoint
+	chanID       lnwire.ShortChannelID
+	capacity     ltcutil.Amount
+	localBalance ltcutil.Amount
+	policy       routing.ChannelPolicy
+}
+
+// Engine periodically recomputes the fees of our channels from their local
+// balance and forwarding volume, and updates the policy of the channels
+// whose fees changed significantly.
+type Engine struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// lastUpdate holds the time of the last policy update of each channel
+	// that the engine updated.
+	lastUpdate map[wire.OutPoint]time.Time
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new fee policy engine.
+func New(cfg *Config) *Engine {
+	return &Engine{
+		cfg:        cfg,
+		lastUpdate: make(map[wire.OutPoint]time.Time),
+		quit:       make(chan struct{}),
+	}
+}
+
+// Start starts the engine. The fees of our channels are first updated on the
+// first tick of the update ticker.
+func (e *Engine) Start() error {
+	e.started.Do(func() {
+		log.Info("Fee policy engine starting")
+
+		e.cfg.UpdateTicker.Resume()
+
+		e.wg.Add(1)
+		go e.updateLoop()
+	})
+
+	return nil
+}
+
+// Stop stops the engine.
+func (e *Engine) Stop() error {
+	e.stopped.Do(func() {
+		log.Info("Fee policy engine shutting down")
+
+		close(e.quit)
+		e.wg.Wait()
+
+		// Stop the ticker after the goroutine reading from it has
+		// exited, to avoid a race.
+		e.cfg.UpdateTicker.Stop()
+	})
+
+	return nil
+}
+
+// updateLoop updates the fees of our channels on every tick of the update
+// ticker.
+func (e *Engine) updateLoop() {
+	defer e.wg.Done()
+
+	for {
+		select {
+		case <-e.cfg.UpdateTicker.Ticks():
+			if err := e.updateFees(); err != nil {
+				log.Errorf("Unable to update channel fees: %v",
+					err)
+			}
+
+		case <-e.quit:
+			return
+		}
+	}
+}
+
+// updateFees recomputes the fees of all our channels, and updates the policy
+// of those whose fees changed significantly.
+func (e *Engine) updateFees() error {
+	channels, err := e.fetchChannels()
+	if err != nil {
+		return err
+	}
+
+	var volumes map[lnwire.ShortChannelID]lnwire.MilliSatoshi
+	if len(e.cfg.Policy.VolumeCurve) != 0 {
+		volumes, err = e.forwardingVolumes()
+		if err != nil {
+			return err
+		}
+	}
+
+	now := e.cfg.Clock.Now()
+	for _, channel := range channels {
+		newPolicy := computePolicy(
+			e.cfg.Policy, channel, volumes[channel.chanID],
+		)
+		if !e.shouldUpdate(channel, newPolicy, now) {
+			continue
+		}
+
+		log.Debugf("Updating fees of channel %v from base fee %v, "+
+			"fee rate %v to base fee %v, fee rate %v",
+			channel.chanPoint, channel.policy.BaseFee,
+			channel.policy.FeeRate, newPolicy.BaseFee,
+			newPolicy.FeeRate)
+
+		failedUpdates, err := e.cfg.UpdatePolicy(
+			newPolicy, channel.chanPoint,
+		)
+		if err != nil {
+			return err
+		}
+		if len(failedUpdates) != 0 {
+			log.Warnf("Unable to update fees of channel %v: %v",
+				channel.chanPoint, failedUpdates[0].UpdateError)
+			continue
+		}
+
+		e.lastUpdate[channel.chanPoint] = now
+	}
+
+	return nil
+}
+
+// fetchChannels returns the state of all our confirmed channels.
+func (e *Engine) fetchChannels() ([]channelState, error) {
+	var channels []channelState
+	err := e.cfg.ForAllOutgoingChannels(func(tx kvdb.RTx,
+		info *channeldb.ChannelEdgeInfo,
+		edge *channeldb.ChannelEdgePolicy) error {
+
+		// We can't update the policy of a channel we didn't announce a
+		// policy for yet.
+		if edge == nil {
+			return nil
+		}
+
+		// Channels that were just closed may still be part of the
+		// graph, so we skip them.
+		channel, err := e.cfg.FetchChannel(tx, info.ChannelPoint)
+		if errors.Is(err, channeldb.ErrChannelNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("unable to fetch channel %v: %v",
+				info.ChannelPoint, err)
+		}
+		if channel.IsPending {
+			return nil
+		}
+
+		chanID := lnwire.NewShortChanIDFromInt(info.ChannelID)
+		localBalance := channel.LocalCommitment.LocalBalance
+		channels = append(channels, channelState{
+			chanPoint:    info.ChannelPoint,
+			chanID:       chanID,
+			capacity:     channel.Capacity,
+			localBalance: localBalance.ToSatoshis(),
+			policy: routing.ChannelPolicy{
+				FeeSchema: routing.FeeSchema{
+					BaseFee: edge.FeeBaseMSat,
+					FeeRate: uint32(
+						edge.FeeProportionalMillionths,
+					),
+				},
+				TimeLockDelta: uint32(edge.TimeLockDelta),
+			},
+		})
+
+		return nil
+	})
+
+	return channels, err
+}
+
+// forwardingVolumes returns the amount that was forwarded out of each of our
+// channels during the volume window.
+func (e *Engine) forwardingVolumes() (
+	map[lnwire.ShortChannelID]lnwire.MilliSatoshi, error) {
+
+	now := e.cfg.Clock.Now()
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    now.Add(-e.cfg.Policy.VolumeWindow),
+		EndTime:      now,
+		NumMaxEvents: maxForwardingEvents,
+	}
+
+	volumes := make(map[lnwire.ShortChannelID]lnwire.MilliSatoshi)
+	for {
+		timeSlice, err := e.cfg.QueryForwardingLog(query)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(timeSlice.ForwardingEvents) == 0 {
+			return volumes, nil
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			volumes[event.OutgoingChanID] += event.AmtOut
+		}
+
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+}
+
+// computePolicy returns the policy of the channel as derived from its state.
+// Fields of the policy that aren't derived from the channel state are left
+// unchanged.
+func computePolicy(policy *Policy, channel channelState,
+	volume lnwire.MilliSatoshi) routing.ChannelPolicy {
+
+	newPolicy := channel.policy
+	if channel.capacity == 0 {
+		return newPolicy
+	}
+
+	ratio := float64(channel.localBalance) / float64(channel.capacity)
+
+	feeRate := policy.FeeRateCurve.Value(ratio)
+	if len(policy.VolumeCurve) != 0 {
+		volumeRatio := float64(volume.ToSatoshis()) /
+			float64(channel.capacity)
+		feeRate *= policy.VolumeCurve.Value(volumeRatio)
+	}
+	newPolicy.FeeRate = uint32(math.Round(math.Max(feeRate, 0)))
+
+	if len(policy.BaseFeeCurve) != 0 {
+		baseFee := policy.BaseFeeCurve.Value(ratio)
+		newPolicy.BaseFee = lnwire.MilliSatoshi(
+			math.Round(math.Max(baseFee, 0)),
+		)
+	}
+
+	return newPolicy
+}
+
+// shouldUpdate returns true if the policy of the channel should be replaced
+// by the new policy. To limit the number of channel updates we broadcast, the
+// policy of a channel isn't updated if the fees barely changed, or if it was
+// updated recently.
+func (e *Engine) shouldUpdate(channel channelState,
+	newPolicy routing.ChannelPolicy, now time.Time) bool {
+
+	oldPolicy := channel.policy
+	feeRateChange := relativeChange(
+		float64(oldPolicy.FeeRate), float64(newPolicy.FeeRate),
+	)
+	baseFeeChange := relativeChange(
+		float64(oldPolicy.BaseFee), float64(newPolicy.BaseFee),
+	)
+	minChange := e.cfg.Policy.MinChange
+
+	switch {
+	case feeRateChange == 0 && baseFeeChange == 0:
+		return false
+
+	case feeRateChange < minChange && baseFeeChange < minChange:
+		log.Tracef("Not updating fees of channel %v, change below "+
+			"threshold", channel.chanPoint)
+		return false
+	}
+
+	lastUpdate, ok := e.lastUpdate[channel.chanPoint]
+	if ok && now.Sub(lastUpdate) < e.cfg.Policy.MinUpdateInterval {
+		log.Debugf("Not updating fees of channel %v, last updated "+
+			"at %v", channel.chanPoint, lastUpdate)
+		return false
+	}
+
+	return true
+}
+
+// relativeChange returns the change from the old to the new value relative
+// to the old value. Any change from zero is a full change.
+func relativeChange(oldValue, newValue float64) float64 {
+	switch {
+	case oldValue == newValue:
+		return 0
+
+	case oldValue == 0:
+		return 1
+	}
+
+	return math.Abs(newValue-oldValue) / oldValue
+}
@@ -0,0 +1,352 @@
+package feepolicy
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/clock"
+	"github.com/ltcsuite/lnd/kvdb"
+	"github.com/ltcsuite/lnd/lnrpc"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing"
+	"github.com/ltcsuite/lnd/ticker"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+var testTime = time.Unix(1_700_000_000, 0)
+
+// testChannel is a channel of the mock node.
+type testChannel struct {
+	info         *channeldb.ChannelEdgeInfo
+	edge         *channeldb.ChannelEdgePolicy
+	capacity     ltcutil.Amount
+	localBalance ltcutil.Amount
+}
+
+// mockNode mocks the channels, forwarding log and policy updates of our node.
+type mockNode struct {
+	sync.Mutex
+
+	channels []*testChannel
+	events   []channeldb.ForwardingEvent
+	updates  map[wire.OutPoint]routing.ChannelPolicy
+}
+
+func newMockNode() *mockNode {
+	return &mockNode{
+		updates: make(map[wire.OutPoint]routing.ChannelPolicy),
+	}
+}
+
+// addChannel adds a channel with the given fees and balance.
+func (m *mockNode) addChannel(id uint64, baseFee lnwire.MilliSatoshi,
+	feeRate uint32, localBalance ltcutil.Amount) *testChannel {
+
+	channel := &testChannel{
+		info: &channeldb.ChannelEdgeInfo{
+			ChannelID:    id,
+			ChannelPoint: wire.OutPoint{Index: uint32(id)},
+		},
+		edge: &channeldb.ChannelEdgePolicy{
+			FeeBaseMSat:               baseFee,
+			FeeProportionalMillionths: lnwire.MilliSatoshi(feeRate),
+			TimeLockDelta:             40,
+		},
+		capacity:     1e6,
+		localBalance: localBalance,
+	}
+	m.channels = append(m.channels, channel)
+
+	return channel
+}
+
+// popUpdates returns the policy updates since the last call, and applies them
+// to the channels.
+func (m *mockNode) popUpdates() map[wire.OutPoint]routing.ChannelPolicy {
+	m.Lock()
+	defer m.Unlock()
+
+	updates := m.updates
+	m.updates = make(map[wire.OutPoint]routing.ChannelPolicy)
+
+	for _, channel := range m.channels {
+		policy, ok := updates[channel.info.ChannelPoint]
+		if !ok {
+			continue
+		}
+
+		channel.edge.FeeBaseMSat = policy.BaseFee
+		channel.edge.FeeProportionalMillionths = lnwire.MilliSatoshi(
+			policy.FeeRate,
+		)
+	}
+
+	return updates
+}
+
+func (m *mockNode) config(policy *Policy, clock clock.Clock,
+	updateTicker ticker.Ticker) *Config {
+
+	return &Config{
+		Policy: policy,
+		ForAllOutgoingChannels: func(cb func(kvdb.RTx,
+			*channeldb.ChannelEdgeInfo,
+			*channeldb.ChannelEdgePolicy) error) error {
+
+			for _, channel := range m.channels {
+				err := cb(nil, channel.info, channel.edge)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		FetchChannel: func(_ kvdb.RTx, chanPoint wire.OutPoint) (
+			*channeldb.OpenChannel, error) {
+
+			for _, channel := range m.channels {
+				if channel.info.ChannelPoint != chanPoint {
+					continue
+				}
+
+				localBalance := lnwire.NewMSatFromSatoshis(
+					channel.localBalance,
+				)
+				commitment := channeldb.ChannelCommitment{
+					LocalBalance: localBalance,
+				}
+
+				return &channeldb.OpenChannel{
+					Capacity:        channel.capacity,
+					LocalCommitment: commitment,
+				}, nil
+			}
+
+			return nil, channeldb.ErrChannelNotFound
+		},
+		QueryForwardingLog: func(q channeldb.ForwardingEventQuery) (
+			channeldb.ForwardingLogTimeSlice, error) {
+
+			var events []channeldb.ForwardingEvent
+			for _, event := range m.events {
+				if event.Timestamp.Before(q.StartTime) ||
+					event.Timestamp.After(q.EndTime) {
+
+					continue
+				}
+				events = append(events, event)
+			}
+
+			start := int(q.IndexOffset)
+			end := start + int(q.NumMaxEvents)
+			if end > len(events) {
+				end = len(events)
+			}
+
+			return channeldb.ForwardingLogTimeSlice{
+				ForwardingEvents: events[start:end],
+				LastIndexOffset:  uint32(end),
+			}, nil
+		},
+		UpdatePolicy: func(policy routing.ChannelPolicy,
+			chanPoints ...wire.OutPoint) ([]*lnrpc.FailedUpdate,
+			error) {
+
+			m.Lock()
+			defer m.Unlock()
+
+			for _, chanPoint := range chanPoints {
+				m.updates[chanPoint] = policy
+			}
+
+			return nil, nil
+		},
+		Clock:        clock,
+		UpdateTicker: updateTicker,
+	}
+}
+
+// TestComputePolicy tests deriving the policy of channels from their state.
+func TestComputePolicy(t *testing.T) {
+	t.Parallel()
+
+	channel := channelState{
+		capacity:     1e6,
+		localBalance: 250e3,
+		policy: routing.ChannelPolicy{
+			FeeSchema: routing.FeeSchema{
+				BaseFee: 1000,
+				FeeRate: 1,
+			},
+			TimeLockDelta: 40,
+		},
+	}
+
+	policy := &Policy{
+		FeeRateCurve: Curve{{0, 1000}, {0.5, 200}, {1, 0}},
+	}
+
+	// Only the fee rate is derived if there are no other curves.
+	newPolicy := computePolicy(policy, channel, 0)
+	require.Equal(t, uint32(600), newPolicy.FeeRate)
+	require.Equal(t, lnwire.MilliSatoshi(1000), newPolicy.BaseFee)
+	require.Equal(t, uint32(40), newPolicy.TimeLockDelta)
+
+	// The base fee is derived from its own curve.
+	policy.BaseFeeCurve = Curve{{0, 2000}, {1, 0}}
+	newPolicy = computePolicy(policy, channel, 0)
+	require.Equal(t, uint32(600), newPolicy.FeeRate)
+	require.Equal(t, lnwire.MilliSatoshi(1500), newPolicy.BaseFee)
+
+	// The fee rate is scaled by the forwarding volume of the channel.
+	policy.VolumeCurve = Curve{{0, 0.5}, {1, 2}}
+	newPolicy = computePolicy(policy, channel, 0)
+	require.Equal(t, uint32(300), newPolicy.FeeRate)
+
+	newPolicy = computePolicy(
+		policy, channel, lnwire.NewMSatFromSatoshis(5e6),
+	)
+	require.Equal(t, uint32(1200), newPolicy.FeeRate)
+
+	// Channels without capacity keep their policy.
+	channel.capacity = 0
+	require.Equal(t, channel.policy, computePolicy(policy, channel, 0))
+}
+
+// TestUpdateFees tests that the engine only updates the fees of channels
+// that changed significantly, and not more often than allowed.
+func TestUpdateFees(t *testing.T) {
+	t.Parallel()
+
+	node := newMockNode()
+	chan1 := node.addChannel(1, 1000, 1, 250e3)
+	chan2 := node.addChannel(2, 1000, 200, 500e3)
+
+	testClock := clock.NewTestClock(testTime)
+	policy := &Policy{
+		FeeRateCurve:      Curve{{0, 1000}, {0.5, 200}, {1, 0}},
+		MinUpdateInterval: time.Hour,
+		MinChange:         0.1,
+	}
+	engine := New(node.config(
+		policy, testClock, ticker.NewForce(time.Hour),
+	))
+
+	// The first channel's fee rate is updated, while the second one
+	// already matches the curve.
+	require.NoError(t, engine.updateFees())
+	updates := node.popUpdates()
+	require.Len(t, updates, 1)
+	require.Equal(t, uint32(600), updates[chan1.info.ChannelPoint].FeeRate)
+	require.Equal(
+		t, uint32(40), updates[chan1.info.ChannelPoint].TimeLockDelta,
+	)
+
+	// Changes below the threshold aren't broadcast.
+	chan2.localBalance = 490e3
+	require.NoError(t, engine.updateFees())
+	require.Empty(t, node.popUpdates())
+
+	// The first channel was updated recently, so it isn't updated again
+	// until the update interval passed, unlike the second channel.
+	chan1.localBalance = 0
+	chan2.localBalance = 300e3
+	require.NoError(t, engine.updateFees())
+	updates = node.popUpdates()
+	require.Len(t, updates, 1)
+	require.Equal(t, uint32(520), updates[chan2.info.ChannelPoint].FeeRate)
+
+	testClock.SetTime(testTime.Add(time.Hour))
+	require.NoError(t, engine.updateFees())
+	updates = node.popUpdates()
+	require.Len(t, updates, 1)
+	require.Equal(t, uint32(1000), updates[chan1.info.ChannelPoint].FeeRate)
+}
+
+// TestUpdateFeesVolume tests that the forwarding volume within the volume
+// window affects the fee rate.
+func TestUpdateFeesVolume(t *testing.T) {
+	t.Parallel()
+
+	node := newMockNode()
+	chan1 := node.addChannel(1, 1000, 200, 500e3)
+	chan2 := node.addChannel(2, 1000, 200, 500e3)
+
+	// The first channel forwarded its whole capacity within the window, in
+	// more events than fit into a single query. The event that is outside
+	// of the window is ignored.
+	for i := 0; i < maxForwardingEvents+1; i++ {
+		node.events = append(node.events, channeldb.ForwardingEvent{
+			Timestamp: testTime.Add(-time.Minute),
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(
+				chan1.info.ChannelID,
+			),
+			AmtOut: lnwire.NewMSatFromSatoshis(1e6) /
+				(maxForwardingEvents + 1),
+		})
+	}
+	node.events = append(node.events, channeldb.ForwardingEvent{
+		Timestamp: testTime.Add(-2 * time.Hour),
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(
+			chan2.info.ChannelID,
+		),
+		AmtOut: lnwire.NewMSatFromSatoshis(1e6),
+	})
+
+	policy := &Policy{
+		FeeRateCurve: Curve{{0, 1000}, {0.5, 200}, {1, 0}},
+		VolumeCurve:  Curve{{0, 1}, {1, 2}},
+		VolumeWindow: time.Hour,
+	}
+	testClock := clock.NewTestClock(testTime)
+	engine := New(node.config(
+		policy, testClock, ticker.NewForce(time.Hour),
+	))
+
+	require.NoError(t, engine.updateFees())
+	updates := node.popUpdates()
+	require.Len(t, updates, 1)
+	require.InDelta(
+		t, 400, updates[chan1.info.ChannelPoint].FeeRate, 1,
+	)
+}
+
+// TestEngineStartStop tests that the engine updates fees on every tick.
+func TestEngineStartStop(t *testing.T) {
+	t.Parallel()
+
+	node := newMockNode()
+	chan1 := node.addChannel(1, 1000, 1, 250e3)
+
+	updateTicker := ticker.NewForce(time.Hour)
+	policy := &Policy{
+		FeeRateCurve: Curve{{0, 1000}, {0.5, 200}, {1, 0}},
+	}
+	engine := New(node.config(
+		policy, clock.NewTestClock(testTime), updateTicker,
+	))
+
+	require.NoError(t, engine.Start())
+	defer func() {
+		require.NoError(t, engine.Stop())
+	}()
+
+	select {
+	case updateTicker.Force <- testTime:
+	case <-time.After(time.Second):
+		t.Fatal("engine didn't receive tick")
+	}
+
+	require.Eventually(t, func() bool {
+		node.Lock()
+		defer node.Unlock()
+
+		_, ok := node.updates[chan1.info.ChannelPoint]
+		return ok
+	}, time.Second, 10*time.Millisecond)
+}
@@ -61,6 +61,23 @@ func explicitNegotiateCommitmentType(channelType lnwire.ChannelType,
 	local, remote *lnwire.FeatureVector) (lnwallet.CommitmentType, error) {
 
 	channelFeatures := lnwire.RawFeatureVector(channelType)
+	channelFeatures = *channelFeatures.Clone()
+
+	// The zero-conf and scid-alias bits may be combined with any of the
+	// base commitment types below, so we check them separately and strip
+	// them before matching on the commitment type itself.
+	if channelFeatures.IsSet(lnwire.ZeroConfRequired) {
+		if !hasFeatures(local, remote, lnwire.ZeroConfOptional) {
+			return 0, errUnsupportedChannelType
+		}
+		channelFeatures.Unset(lnwire.ZeroConfRequired)
+	}
+	if channelFeatures.IsSet(lnwire.ScidAliasRequired) {
+		if !hasFeatures(local, remote, lnwire.ScidAliasOptional) {
+			return 0, errUnsupportedChannelType
+		}
+		channelFeatures.Unset(lnwire.ScidAliasRequired)
+	}
 
 	switch {
 	// Lease script enforcement + anchors zero fee + static remote key
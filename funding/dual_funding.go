@@ -0,0 +1,1102 @@
+package funding
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/ltcsuite/lnd/chainntnfs"
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/input"
+	"github.com/ltcsuite/lnd/keychain"
+	"github.com/ltcsuite/lnd/labels"
+	"github.com/ltcsuite/lnd/lnpeer"
+	"github.com/ltcsuite/lnd/lnrpc"
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/lnwallet/chainfee"
+	"github.com/ltcsuite/lnd/lnwallet/chanfunding"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/txscript"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+const (
+	// dualFundSequence is the sequence number of the inputs that we add to
+	// the funding transaction of a dual funded channel. The interactive
+	// transaction protocol requires all inputs to signal replaceability.
+	dualFundSequence = wire.MaxTxInSequenceNum - 2
+)
+
+var (
+	// errDualFundUnexpectedMsg is returned if the remote party sends a
+	// message of the interactive transaction protocol that we don't expect
+	// in the current state of the funding flow.
+	errDualFundUnexpectedMsg = errors.New("unexpected dual funding " +
+		"message")
+)
+
+// dualFundInput is one of our wallet inputs that we add to the funding
+// transaction of a dual funded channel.
+type dualFundInput struct {
+	// prevTx is the transaction that created the output that we spend.
+	prevTx *wire.MsgTx
+
+	// prevTxOut is the index of the spent output within prevTx.
+	prevTxOut uint32
+}
+
+// dualFundingState tracks the construction of the funding transaction of a
+// dual funded channel, from the exchange of the open_channel2 and
+// accept_channel2 messages until both parties have sent their tx_signatures.
+type dualFundingState struct {
+	// lockTime is the locktime of the funding transaction as proposed by
+	// the initiator.
+	lockTime uint32
+
+	// feeRate is the fee rate of the funding transaction as proposed by
+	// the initiator.
+	feeRate chainfee.SatPerKWeight
+
+	// tx is the state machine of the interactive construction of the
+	// funding transaction. It's set once both parties know each other's
+	// multisig keys.
+	tx *chanfunding.InteractiveTx
+
+	// remoteContribution is the contribution of the remote party. It's
+	// handed to the wallet once the funding transaction is complete.
+	remoteContribution *lnwallet.ChannelContribution
+
+	// pendingInputs and pendingOutputs are our inputs and outputs that we
+	// haven't sent to the remote party yet. As the parties take turns, we
+	// send one of them each time the remote party sent a message.
+	pendingInputs  []*dualFundInput
+	pendingOutputs []*wire.TxOut
+
+	// fundingTx is the negotiated funding transaction. Our own inputs are
+	// signed as soon as the negotiation is complete, the witnesses of the
+	// remote party are added once we receive its tx_signatures.
+	fundingTx *wire.MsgTx
+
+	// completeChan is the pending channel that was written to disk after
+	// we received a valid commitment signature from the remote party.
+	completeChan *channeldb.OpenChannel
+
+	// sentTxSigs is true once we sent our tx_signatures to the remote
+	// party, which allows it to publish the funding transaction.
+	sentTxSigs bool
+}
+
+// dualFundReserve returns the channel reserve of both parties of a dual funded
+// channel. As the reserve isn't negotiated in the dual funding protocol, both
+// parties derive it from the capacity of the channel and the dust limits.
+func dualFundReserve(capacity, localDustLimit,
+	remoteDustLimit ltcutil.Amount) ltcutil.Amount {
+
+	reserve := capacity / 100
+
+	dustLimit := localDustLimit
+	if remoteDustLimit > dustLimit {
+		dustLimit = remoteDustLimit
+	}
+	if reserve < dustLimit {
+		reserve = dustLimit
+	}
+
+	return reserve
+}
+
+// openChannelFromV2 converts an open_channel2 message into the equivalent
+// open_channel message, which is what our channel acceptors operate on. Note
+// that the dual funding protocol doesn't negotiate a channel reserve, so the
+// reserve of the returned message isn't set.
+func openChannelFromV2(msg *lnwire.OpenChannel2) *lnwire.OpenChannel {
+	return &lnwire.OpenChannel{
+		ChainHash:             msg.ChainHash,
+		PendingChannelID:      msg.PendingChannelID,
+		FundingAmount:         msg.FundingAmount,
+		DustLimit:             msg.DustLimit,
+		MaxValueInFlight:      msg.MaxValueInFlight,
+		HtlcMinimum:           msg.HtlcMinimum,
+		FeePerKiloWeight:      msg.CommitFeePerKWeight,
+		CsvDelay:              msg.CsvDelay,
+		MaxAcceptedHTLCs:      msg.MaxAcceptedHTLCs,
+		FundingKey:            msg.FundingKey,
+		RevocationPoint:       msg.RevocationPoint,
+		PaymentPoint:          msg.PaymentPoint,
+		DelayedPaymentPoint:   msg.DelayedPaymentPoint,
+		HtlcPoint:             msg.HtlcPoint,
+		FirstCommitmentPoint:  msg.FirstCommitmentPoint,
+		ChannelFlags:          msg.ChannelFlags,
+		UpfrontShutdownScript: msg.UpfrontShutdownScript,
+		ChannelType:           msg.ChannelType,
+	}
+}
+
+// sendOpenChannel2 kicks off the funding flow of a dual funded channel that we
+// initiate, sending our open_channel2 message to the remote peer.
+func (f *Manager) sendOpenChannel2(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, chanType *lnwire.ChannelType,
+	commitFeePerKw chainfee.SatPerKWeight, channelFlags lnwire.FundingFlag,
+	shutdown lnwire.DeliveryAddress) error {
+
+	reservation := resCtx.reservation
+	ourContribution := reservation.OurContribution()
+
+	secondCommitPoint, err := reservation.SecondCommitmentPoint()
+	if err != nil {
+		return err
+	}
+
+	// The locktime of the funding transaction is set to the current
+	// height, which discourages fee sniping.
+	_, bestHeight, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+	resCtx.dualFund.lockTime = uint32(bestHeight)
+
+	log.Infof("Starting dual funding workflow with %v for pending_id(%x)",
+		resCtx.peer.Address(), pendingChanID)
+
+	// At this point, the capacity of the reservation is made up of our
+	// own contribution only.
+	fundingOpen := &lnwire.OpenChannel2{
+		ChainHash:             *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
+		PendingChannelID:      pendingChanID,
+		FundingFeePerKWeight:  uint32(resCtx.dualFund.feeRate),
+		CommitFeePerKWeight:   uint32(commitFeePerKw),
+		FundingAmount:         reservation.Capacity(),
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      resCtx.remoteMaxValue,
+		HtlcMinimum:           resCtx.remoteMinHtlc,
+		CsvDelay:              resCtx.remoteCsvDelay,
+		MaxAcceptedHTLCs:      resCtx.remoteMaxHtlcs,
+		LockTime:              resCtx.dualFund.lockTime,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		SecondCommitmentPoint: secondCommitPoint,
+		ChannelFlags:          channelFlags,
+		UpfrontShutdownScript: shutdown,
+		ChannelType:           chanType,
+	}
+
+	return resCtx.peer.SendMessage(true, fundingOpen)
+}
+
+// handleFundingOpen2 processes a request to open a dual funded channel. Our
+// channel acceptor decides how much we contribute to the channel, after which
+// we respond with an accept_channel2 message and wait for the initiator to
+// start the construction of the funding transaction.
+func (f *Manager) handleFundingOpen2(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel2) {
+
+	peerIDKey := newSerializedKey(peer.IdentityKey())
+	amt := msg.FundingAmount
+
+	err := f.checkInboundChannel(peer, amt, 0)
+	if err != nil {
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	// Our channel acceptor is queried with the equivalent open_channel
+	// message, and decides how much we contribute to the channel.
+	openMsg := openChannelFromV2(msg)
+	chanReq, err := f.newChannelAcceptRequest(peer, openMsg, true)
+	if err != nil {
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+	acceptorResp := f.cfg.OpenChannelPredicate.Accept(chanReq)
+	if acceptorResp.RejectChannel() {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			acceptorResp.ChanAcceptError,
+		)
+		return
+	}
+
+	localAmt := acceptorResp.FundingAmt
+	total := amt + localAmt
+	if total > f.cfg.MaxChanSize {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			lnwallet.ErrChanTooLarge(total, f.cfg.MaxChanSize),
+		)
+		return
+	}
+
+	log.Infof("Recv'd dual fundingRequest(amt=%v, local_amt=%v, "+
+		"delay=%v, pendingId=%x) from peer(%x)", amt, localAmt,
+		msg.CsvDelay, msg.PendingChannelID,
+		peer.IdentityKey().SerializeCompressed())
+
+	inbound, err := negotiateInboundChannelType(
+		peer, msg.ChannelType, msg.ChannelFlags, acceptorResp,
+	)
+	if err != nil {
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	// The lease expiry of a script enforced lease is carried in a TLV
+	// record of the open_channel message only.
+	if inbound.commitType == lnwallet.CommitmentTypeScriptEnforcedLease {
+		err := errors.New("script enforced lease channels can't be " +
+			"dual funded")
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	chainHash := chainhash.Hash(msg.ChainHash)
+	feeRate := chainfee.SatPerKWeight(msg.FundingFeePerKWeight)
+	commitFeeRate := chainfee.SatPerKWeight(msg.CommitFeePerKWeight)
+	req := &lnwallet.InitFundingReserveMsg{
+		ChainHash:        &chainHash,
+		PendingChanID:    msg.PendingChannelID,
+		NodeID:           peer.IdentityKey(),
+		NodeAddr:         peer.Address(),
+		LocalFundingAmt:  localAmt,
+		RemoteFundingAmt: amt,
+		CommitFeePerKw:   commitFeeRate,
+		FundingFeePerKw:  feeRate,
+		Flags:            msg.ChannelFlags,
+		MinConfs:         1,
+		CommitType:       inbound.commitType,
+		ZeroConf:         inbound.zeroConf,
+		OptionScidAlias:  inbound.scidAlias,
+		ScidAliasFeature: inbound.scidAliasFeature,
+		DualFund:         true,
+	}
+
+	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
+	if err != nil {
+		log.Errorf("Unable to initialize reservation: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+	capacity := reservation.Capacity()
+
+	numConfsReq := f.cfg.NumRequiredConfs(capacity, 0)
+	if acceptorResp.MinAcceptDepth != 0 {
+		numConfsReq = acceptorResp.MinAcceptDepth
+	}
+	if inbound.zeroConf {
+		numConfsReq = 0
+	}
+	reservation.SetNumConfsRequired(numConfsReq)
+
+	// Both parties are held to the same reserve, which depends on the
+	// total capacity of the channel.
+	ourContribution := reservation.OurContribution()
+	chanReserve := dualFundReserve(
+		capacity, ourContribution.DustLimit, msg.DustLimit,
+	)
+
+	channelConstraints := &channeldb.ChannelConstraints{
+		DustLimit:        msg.DustLimit,
+		ChanReserve:      chanReserve,
+		MaxPendingAmount: msg.MaxValueInFlight,
+		MinHTLC:          msg.HtlcMinimum,
+		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
+		CsvDelay:         msg.CsvDelay,
+	}
+	err = reservation.CommitConstraints(
+		channelConstraints, f.cfg.MaxLocalCSVDelay, true,
+	)
+	if err != nil {
+		log.Errorf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	shutdown, err := getUpfrontShutdownScript(
+		f.cfg.EnableUpfrontShutdown, peer, acceptorResp.UpfrontShutdown,
+		func() (lnwire.DeliveryAddress, error) {
+			addr, err := f.cfg.Wallet.NewAddress(
+				lnwallet.WitnessPubKey, false,
+				lnwallet.DefaultAccountName,
+			)
+			if err != nil {
+				return nil, err
+			}
+			return txscript.PayToAddrScript(addr)
+		},
+	)
+	if err != nil {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			fmt.Errorf("getUpfrontShutdownScript error: %v", err),
+		)
+		return
+	}
+	reservation.SetOurUpfrontShutdown(shutdown)
+
+	// Generate our required constraints for the remote party, using the
+	// values provided by the channel acceptor if they are non-zero.
+	remoteCsvDelay := f.cfg.RequiredRemoteDelay(capacity)
+	if acceptorResp.CSVDelay != 0 {
+		remoteCsvDelay = acceptorResp.CSVDelay
+	}
+	remoteMaxValue := f.cfg.RequiredRemoteMaxValue(capacity)
+	if acceptorResp.InFlightTotal != 0 {
+		remoteMaxValue = acceptorResp.InFlightTotal
+	}
+	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(capacity)
+	if acceptorResp.HtlcLimit != 0 {
+		maxHtlcs = acceptorResp.HtlcLimit
+	}
+	minHtlc := f.cfg.DefaultMinHtlcIn
+	if acceptorResp.MinHtlcIn != 0 {
+		minHtlc = acceptorResp.MinHtlcIn
+	}
+
+	remoteContribution := &lnwallet.ChannelContribution{
+		FundingAmount:        amt,
+		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		ChannelConfig: &channeldb.ChannelConfig{
+			ChannelConstraints: channeldb.ChannelConstraints{
+				DustLimit:        msg.DustLimit,
+				MaxPendingAmount: remoteMaxValue,
+				ChanReserve:      chanReserve,
+				MinHTLC:          minHtlc,
+				MaxAcceptedHtlcs: maxHtlcs,
+				CsvDelay:         remoteCsvDelay,
+			},
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.FundingKey),
+			},
+			RevocationBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.RevocationPoint),
+			},
+			PaymentBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.PaymentPoint),
+			},
+			DelayBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.DelayedPaymentPoint),
+			},
+			HtlcBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.HtlcPoint),
+			},
+		},
+		UpfrontShutdown: msg.UpfrontShutdownScript,
+	}
+
+	resCtx := &reservationWithCtx{
+		reservation:    reservation,
+		chanAmt:        capacity,
+		remoteCsvDelay: remoteCsvDelay,
+		remoteMinHtlc:  minHtlc,
+		remoteMaxValue: remoteMaxValue,
+		remoteMaxHtlcs: maxHtlcs,
+		maxLocalCsv:    f.cfg.MaxLocalCSVDelay,
+		channelType:    msg.ChannelType,
+		dualFund: &dualFundingState{
+			lockTime:           msg.LockTime,
+			feeRate:            feeRate,
+			remoteContribution: remoteContribution,
+		},
+		err:  make(chan error, 1),
+		peer: peer,
+	}
+
+	f.resMtx.Lock()
+	if _, ok := f.activeReservations[peerIDKey]; !ok {
+		f.activeReservations[peerIDKey] = make(pendingChannels)
+	}
+	f.activeReservations[peerIDKey][msg.PendingChannelID] = resCtx
+	f.resMtx.Unlock()
+
+	// Update the timestamp once the open_channel2 message has been
+	// handled.
+	defer resCtx.updateTimestamp()
+
+	err = f.initInteractiveTx(resCtx, capacity-amt, amt, false)
+	if err != nil {
+		log.Errorf("Unable to initialize interactive tx: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	secondCommitPoint, err := reservation.SecondCommitmentPoint()
+	if err != nil {
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	log.Infof("Sending dual fundingResp for pending_id(%x), "+
+		"local_amt=%v, num_confs=%v", msg.PendingChannelID,
+		capacity-amt, numConfsReq)
+	log.Debugf("Remote party accepted commitment constraints: %v",
+		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
+
+	fundingAccept := &lnwire.AcceptChannel2{
+		PendingChannelID:      msg.PendingChannelID,
+		FundingAmount:         capacity - amt,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      remoteMaxValue,
+		HtlcMinimum:           minHtlc,
+		MinAcceptDepth:        uint32(numConfsReq),
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		SecondCommitmentPoint: secondCommitPoint,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
+		ChannelType:           inbound.chanType,
+	}
+	if err := peer.SendMessage(true, fundingAccept); err != nil {
+		log.Errorf("unable to send funding response to peer: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+}
+
+// handleFundingAccept2 processes the response to our request to open a dual
+// funded channel. Once the contribution of the remote party is recorded, we
+// start the construction of the funding transaction by sending the funding
+// output.
+func (f *Manager) handleFundingAccept2(peer lnpeer.Peer,
+	msg *lnwire.AcceptChannel2) {
+
+	pendingChanID := msg.PendingChannelID
+	peerKey := peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		log.Warnf("Can't find reservation (peerKey:%v, chan_id:%v)",
+			peerKey, pendingChanID)
+		return
+	}
+	if resCtx.dualFund == nil || resCtx.dualFund.tx != nil {
+		f.failFundingFlow(peer, pendingChanID, errDualFundUnexpectedMsg)
+		return
+	}
+
+	// Update the timestamp once the accept_channel2 message has been
+	// handled.
+	defer resCtx.updateTimestamp()
+
+	log.Infof("Recv'd dual fundingResponse for pending_id(%x), "+
+		"remote_amt=%v", pendingChanID[:], msg.FundingAmount)
+
+	// The responder must echo the explicit channel type we proposed, and
+	// must not come up with one if we didn't.
+	switch {
+	case resCtx.channelType == nil && msg.ChannelType != nil:
+		err = errors.New("received unexpected channel type")
+
+	case resCtx.channelType != nil && msg.ChannelType == nil:
+		err = errors.New("explicit channel type not echoed back")
+
+	case resCtx.channelType != nil:
+		proposedFeatures := lnwire.RawFeatureVector(*resCtx.channelType)
+		ackedFeatures := lnwire.RawFeatureVector(*msg.ChannelType)
+		if !proposedFeatures.Equals(&ackedFeatures) {
+			err = errors.New("channel type mismatch")
+		}
+	}
+	if err != nil {
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	if msg.MinAcceptDepth > chainntnfs.MaxNumConfs {
+		err := lnwallet.ErrNumConfsTooLarge(
+			msg.MinAcceptDepth, chainntnfs.MaxNumConfs,
+		)
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+	if resCtx.reservation.IsZeroConf() && msg.MinAcceptDepth != 0 {
+		err = errors.New("non-zero min_depth for zero-conf channel")
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	// With the contribution of the remote party known, we can update the
+	// capacity of the channel and derive the reserve of both parties.
+	localAmt := resCtx.reservation.Capacity()
+	err = resCtx.reservation.SetRemoteFundingAmt(msg.FundingAmount)
+	if err != nil {
+		log.Errorf("Unable to set remote funding amount: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+	capacity := resCtx.reservation.Capacity()
+	resCtx.chanAmt = capacity
+
+	chanReserve := dualFundReserve(
+		capacity, resCtx.reservation.OurContribution().DustLimit,
+		msg.DustLimit,
+	)
+
+	resCtx.reservation.SetNumConfsRequired(uint16(msg.MinAcceptDepth))
+	channelConstraints := &channeldb.ChannelConstraints{
+		DustLimit:        msg.DustLimit,
+		ChanReserve:      chanReserve,
+		MaxPendingAmount: msg.MaxValueInFlight,
+		MinHTLC:          msg.HtlcMinimum,
+		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
+		CsvDelay:         msg.CsvDelay,
+	}
+	err = resCtx.reservation.CommitConstraints(
+		channelConstraints, resCtx.maxLocalCsv, false,
+	)
+	if err != nil {
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	resCtx.dualFund.remoteContribution = &lnwallet.ChannelContribution{
+		FundingAmount:        msg.FundingAmount,
+		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		ChannelConfig: &channeldb.ChannelConfig{
+			ChannelConstraints: channeldb.ChannelConstraints{
+				DustLimit:        msg.DustLimit,
+				MaxPendingAmount: resCtx.remoteMaxValue,
+				ChanReserve:      chanReserve,
+				MinHTLC:          resCtx.remoteMinHtlc,
+				MaxAcceptedHtlcs: resCtx.remoteMaxHtlcs,
+				CsvDelay:         resCtx.remoteCsvDelay,
+			},
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.FundingKey),
+			},
+			RevocationBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.RevocationPoint),
+			},
+			PaymentBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.PaymentPoint),
+			},
+			DelayBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.DelayedPaymentPoint),
+			},
+			HtlcBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.HtlcPoint),
+			},
+		},
+		UpfrontShutdown: msg.UpfrontShutdownScript,
+	}
+
+	log.Infof("pendingChan(%x): remote party proposes num_confs=%v, "+
+		"csv_delay=%v", pendingChanID[:], msg.MinAcceptDepth,
+		msg.CsvDelay)
+
+	err = f.initInteractiveTx(resCtx, localAmt, msg.FundingAmount, true)
+	if err != nil {
+		log.Errorf("Unable to initialize interactive tx: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	// As the initiator, it's our turn to send the first message of the
+	// interactive construction of the funding transaction.
+	f.sendNextTxUpdate(resCtx, pendingChanID)
+}
+
+// initInteractiveTx sets up the state machine for the interactive construction
+// of the funding transaction, and queues our own inputs and outputs to be
+// sent to the remote party.
+func (f *Manager) initInteractiveTx(resCtx *reservationWithCtx, localAmt,
+	remoteAmt ltcutil.Amount, initiator bool) error {
+
+	ourContribution := resCtx.reservation.OurContribution()
+	theirContribution := resCtx.dualFund.remoteContribution
+
+	_, fundingOutput, err := input.GenFundingPkScript(
+		ourContribution.MultiSigKey.PubKey.SerializeCompressed(),
+		theirContribution.MultiSigKey.PubKey.SerializeCompressed(),
+		int64(localAmt+remoteAmt),
+	)
+	if err != nil {
+		return err
+	}
+
+	state := resCtx.dualFund
+	state.tx = chanfunding.NewInteractiveTx(chanfunding.InteractiveTxConfig{
+		Initiator:        initiator,
+		FundingOutput:    fundingOutput,
+		LocalFundingAmt:  localAmt,
+		RemoteFundingAmt: remoteAmt,
+		FeeRate:          state.feeRate,
+		LockTime:         state.lockTime,
+		DustLimit:        ourContribution.DustLimit,
+	})
+
+	// It's up to the initiator to add the funding output.
+	if initiator {
+		state.pendingOutputs = append(
+			state.pendingOutputs, fundingOutput,
+		)
+	}
+
+	// The remote party needs the full previous transaction of each of our
+	// inputs to verify that they spend witness outputs.
+	for _, txIn := range ourContribution.Inputs {
+		prevTx, err := f.cfg.FetchTx(txIn.PreviousOutPoint.Hash)
+		if err != nil {
+			return fmt.Errorf("unable to fetch previous tx of "+
+				"input %v: %v", txIn.PreviousOutPoint, err)
+		}
+
+		in := &dualFundInput{
+			prevTx:    prevTx,
+			prevTxOut: txIn.PreviousOutPoint.Index,
+		}
+		state.pendingInputs = append(state.pendingInputs, in)
+	}
+	state.pendingOutputs = append(
+		state.pendingOutputs, ourContribution.ChangeOutputs...,
+	)
+
+	return nil
+}
+
+// sendNextTxUpdate sends the next of our queued inputs and outputs to the
+// remote party, or tx_complete once there are none left. If this completes
+// the negotiation, the funding transaction is signed.
+func (f *Manager) sendNextTxUpdate(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) {
+
+	state := resCtx.dualFund
+
+	var (
+		msg lnwire.Message
+		err error
+	)
+	switch {
+	case len(state.pendingInputs) > 0:
+		in := state.pendingInputs[0]
+		state.pendingInputs = state.pendingInputs[1:]
+
+		var prevTx bytes.Buffer
+		if err := in.prevTx.Serialize(&prevTx); err != nil {
+			f.failFundingFlow(resCtx.peer, pendingChanID, err)
+			return
+		}
+
+		var serialID uint64
+		serialID, err = state.tx.AddLocalInput(
+			in.prevTx, in.prevTxOut, dualFundSequence,
+		)
+		msg = &lnwire.TxAddInput{
+			ChanID:    pendingChanID,
+			SerialID:  serialID,
+			PrevTx:    prevTx.Bytes(),
+			PrevTxOut: in.prevTxOut,
+			Sequence:  dualFundSequence,
+		}
+
+	case len(state.pendingOutputs) > 0:
+		output := state.pendingOutputs[0]
+		state.pendingOutputs = state.pendingOutputs[1:]
+
+		var serialID uint64
+		serialID, err = state.tx.AddLocalOutput(output)
+		msg = &lnwire.TxAddOutput{
+			ChanID:   pendingChanID,
+			SerialID: serialID,
+			Amount:   ltcutil.Amount(output.Value),
+			PkScript: output.PkScript,
+		}
+
+	default:
+		err = state.tx.SendComplete()
+		msg = &lnwire.TxComplete{
+			ChanID: pendingChanID,
+		}
+	}
+	if err != nil {
+		log.Errorf("Unable to update funding tx of pending_id(%x): %v",
+			pendingChanID[:], err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
+	if err := resCtx.peer.SendMessage(true, msg); err != nil {
+		log.Errorf("Unable to send %v: %v", msg.MsgType(), err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
+	if state.tx.IsComplete() {
+		f.completeInteractiveTx(resCtx, pendingChanID)
+	}
+}
+
+// handleInteractiveTxMsg processes an update of the funding transaction of a
+// dual funded channel that was sent by the remote party. Unless this
+// completes the negotiation, we respond with our own next update.
+func (f *Manager) handleInteractiveTxMsg(peer lnpeer.Peer,
+	msg lnwire.Message) {
+
+	var pendingChanID lnwire.ChannelID
+	switch msg := msg.(type) {
+	case *lnwire.TxAddInput:
+		pendingChanID = msg.ChanID
+	case *lnwire.TxAddOutput:
+		pendingChanID = msg.ChanID
+	case *lnwire.TxRemoveInput:
+		pendingChanID = msg.ChanID
+	case *lnwire.TxRemoveOutput:
+		pendingChanID = msg.ChanID
+	case *lnwire.TxComplete:
+		pendingChanID = msg.ChanID
+	}
+
+	peerKey := peer.IdentityKey()
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		log.Warnf("Can't find reservation (peerKey:%v, chan_id:%v)",
+			peerKey, pendingChanID)
+		return
+	}
+
+	// Update the timestamp once the message has been handled.
+	defer resCtx.updateTimestamp()
+
+	state := resCtx.dualFund
+	if state == nil || state.tx == nil || state.tx.IsComplete() {
+		f.failFundingFlow(peer, pendingChanID, errDualFundUnexpectedMsg)
+		return
+	}
+
+	switch msg := msg.(type) {
+	case *lnwire.TxAddInput:
+		err = state.tx.ReceiveAddInput(
+			msg.SerialID, msg.PrevTx, msg.PrevTxOut, msg.Sequence,
+		)
+	case *lnwire.TxAddOutput:
+		err = state.tx.ReceiveAddOutput(
+			msg.SerialID, msg.Amount, msg.PkScript,
+		)
+	case *lnwire.TxRemoveInput:
+		err = state.tx.ReceiveRemoveInput(msg.SerialID)
+	case *lnwire.TxRemoveOutput:
+		err = state.tx.ReceiveRemoveOutput(msg.SerialID)
+	case *lnwire.TxComplete:
+		err = state.tx.ReceiveComplete()
+	}
+	if err != nil {
+		log.Errorf("Invalid %v for pending_id(%x): %v", msg.MsgType(),
+			pendingChanID[:], err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	if state.tx.IsComplete() {
+		f.completeInteractiveTx(resCtx, pendingChanID)
+		return
+	}
+
+	f.sendNextTxUpdate(resCtx, pendingChanID)
+}
+
+// completeInteractiveTx hands the negotiated funding transaction to the
+// wallet, which signs our inputs and the commitment transaction of the remote
+// party. The commitment signature is then sent to the remote party.
+func (f *Manager) completeInteractiveTx(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) {
+
+	state := resCtx.dualFund
+	fundingTx, err := state.tx.Tx()
+	if err != nil {
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
+	err = resCtx.reservation.ProcessInteractiveTx(
+		state.remoteContribution, fundingTx,
+	)
+	if err != nil {
+		log.Errorf("Unable to process interactive tx for "+
+			"pending_id(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+	state.fundingTx = fundingTx
+
+	// A new channel has almost finished the funding process. In order to
+	// properly synchronize with the writeHandler goroutine, we add a new
+	// channel to the barriers map which will be closed once the channel is
+	// fully open.
+	outPoint := resCtx.reservation.FundingOutpoint()
+	channelID := lnwire.NewChanIDFromOutPoint(outPoint)
+	f.barrierMtx.Lock()
+	log.Debugf("Creating chan barrier for ChanID(%v)", channelID)
+	f.newChanBarriers[channelID] = make(chan struct{})
+	f.barrierMtx.Unlock()
+
+	log.Infof("Negotiated ChannelPoint(%v) for pending_id(%x)", outPoint,
+		pendingChanID[:])
+
+	_, sig := resCtx.reservation.OurSignatures()
+	commitSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		log.Errorf("Unable to parse signature: %v", err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
+	// Until the funding transaction is signed by both parties, the
+	// channel is still referenced by its pending channel ID.
+	err = resCtx.peer.SendMessage(true, &lnwire.CommitSig{
+		ChanID:    pendingChanID,
+		CommitSig: commitSig,
+		HtlcSigs:  []lnwire.Sig{},
+	})
+	if err != nil {
+		log.Errorf("Unable to send commitment signature: %v", err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+}
+
+// handleDualFundCommitSig processes the signature of the remote party for our
+// commitment transaction of a dual funded channel. Once it's verified, the
+// pending channel is written to disk, and it's safe to sign the funding
+// transaction.
+func (f *Manager) handleDualFundCommitSig(peer lnpeer.Peer,
+	msg *lnwire.CommitSig) {
+
+	pendingChanID := msg.ChanID
+	peerKey := peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		log.Warnf("Can't find reservation (peerKey:%v, chan_id:%v)",
+			peerKey, pendingChanID)
+		return
+	}
+
+	state := resCtx.dualFund
+	if state == nil || state.fundingTx == nil || state.completeChan != nil {
+		f.failFundingFlow(peer, pendingChanID, errDualFundUnexpectedMsg)
+		return
+	}
+
+	commitSig, err := msg.CommitSig.ToSignature()
+	if err != nil {
+		log.Errorf("Unable to parse signature: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	// The witnesses of the remote party's inputs are only exchanged after
+	// this step, so no input scripts are passed to the wallet. Note that
+	// this marks the channel as pending in the database.
+	completeChan, err := resCtx.reservation.CompleteReservation(
+		nil, commitSig,
+	)
+	if err != nil {
+		log.Errorf("Unable to complete reservation sign "+
+			"complete: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+	state.completeChan = completeChan
+
+	// Create an entry in the local discovery map so we can ensure that we
+	// process the channel confirmation fully before we receive a funding
+	// locked message.
+	permChanID := lnwire.NewChanIDFromOutPoint(
+		&completeChan.FundingOutpoint,
+	)
+	f.localDiscoveryMtx.Lock()
+	f.localDiscoverySignals[permChanID] = make(chan struct{})
+	f.localDiscoveryMtx.Unlock()
+
+	if !state.tx.SendSignaturesFirst() {
+		return
+	}
+
+	if err := f.sendTxSignatures(resCtx, pendingChanID); err != nil {
+		log.Errorf("Unable to send tx signatures: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+	}
+}
+
+// sendTxSignatures sends the witnesses of our inputs of the funding
+// transaction to the remote party.
+func (f *Manager) sendTxSignatures(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) error {
+
+	state := resCtx.dualFund
+
+	// The witnesses are sent in the order of the serial IDs of our
+	// inputs, which is the order of the inputs in the transaction.
+	witnesses := make([]wire.TxWitness, 0)
+	for i, in := range state.tx.Inputs() {
+		if in.Local {
+			witnesses = append(
+				witnesses, state.fundingTx.TxIn[i].Witness,
+			)
+		}
+	}
+
+	// Once our signatures are sent, the remote party is able to publish
+	// the funding transaction. We'll therefore watch the channel from now
+	// on.
+	completeChan := state.completeChan
+	err := f.cfg.WatchNewChannel(completeChan, resCtx.peer.IdentityKey())
+	if err != nil {
+		log.Errorf("Unable to send new ChannelPoint(%v) for "+
+			"arbitration: %v", completeChan.FundingOutpoint, err)
+	}
+	state.sentTxSigs = true
+
+	return resCtx.peer.SendMessage(true, &lnwire.TxSignatures{
+		ChanID:    pendingChanID,
+		TxHash:    state.fundingTx.TxHash(),
+		Witnesses: witnesses,
+	})
+}
+
+// handleTxSignatures processes the witnesses of the remote party's inputs of
+// the funding transaction. With those, the funding transaction is complete and
+// can be published, after which we wait for it to confirm just like for a
+// single funded channel.
+func (f *Manager) handleTxSignatures(peer lnpeer.Peer,
+	msg *lnwire.TxSignatures) {
+
+	pendingChanID := msg.ChanID
+	peerKey := peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		log.Warnf("Can't find reservation (peerKey:%v, chan_id:%v)",
+			peerKey, pendingChanID)
+		return
+	}
+
+	state := resCtx.dualFund
+	if state == nil || state.completeChan == nil {
+		f.failFundingFlow(peer, pendingChanID, errDualFundUnexpectedMsg)
+		return
+	}
+
+	fundingTx := state.fundingTx
+	if msg.TxHash != fundingTx.TxHash() {
+		err := fmt.Errorf("tx signatures for unknown tx %v",
+			msg.TxHash)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	err = state.tx.AddRemoteWitnesses(fundingTx, msg.Witnesses)
+	if err != nil {
+		log.Errorf("Invalid tx signatures for pending_id(%x): %v",
+			pendingChanID[:], err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	// We already have all signatures at this point, so we'll publish the
+	// funding transaction even if we fail to send ours.
+	if !state.sentTxSigs {
+		err := f.sendTxSignatures(resCtx, pendingChanID)
+		if err != nil {
+			log.Errorf("Unable to send tx signatures: %v", err)
+		}
+	}
+
+	// The funding transaction is fully signed, and the channel no longer
+	// needs to be tracked as a reservation.
+	f.deleteReservationCtx(peerKey, pendingChanID)
+
+	completeChan := state.completeChan
+	fundingPoint := completeChan.FundingOutpoint
+
+	log.Infof("Broadcasting dual funded tx for ChannelPoint(%v): %v",
+		fundingPoint, spew.Sdump(fundingTx))
+
+	label := labels.MakeLabel(labels.LabelTypeChannelOpen, nil)
+	if err := f.cfg.PublishTransaction(fundingTx, label); err != nil {
+		// The remote party may publish the transaction as well, so
+		// we'll keep watching the channel regardless.
+		log.Errorf("Unable to broadcast funding tx for "+
+			"ChannelPoint(%v): %v", fundingPoint, err)
+	}
+
+	// Only the initiator has a caller waiting for updates.
+	if resCtx.updates != nil {
+		upd := &lnrpc.OpenStatusUpdate{
+			Update: &lnrpc.OpenStatusUpdate_ChanPending{
+				ChanPending: &lnrpc.PendingUpdate{
+					Txid:        fundingPoint.Hash[:],
+					OutputIndex: fundingPoint.Index,
+				},
+			},
+			PendingChanId: pendingChanID[:],
+		}
+
+		select {
+		case resCtx.updates <- upd:
+		case <-f.quit:
+			return
+		}
+	}
+
+	// Inform the ChannelNotifier that the channel has entered pending
+	// open state.
+	f.cfg.NotifyPendingOpenChannelEvent(fundingPoint, completeChan)
+
+	f.wg.Add(1)
+	go f.advanceFundingState(completeChan, pendingChanID, resCtx.updates)
+}
+
+// abandonDualFundedChannel forgets about a dual funded channel that was
+// already written to disk, but whose funding transaction wasn't completely
+// signed. As long as we didn't send our tx_signatures, the funding transaction
+// can't be published and the channel is removed. Otherwise, we keep waiting
+// for the channel to confirm, as the remote party is able to publish it.
+func (f *Manager) abandonDualFundedChannel(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) {
+
+	completeChan := resCtx.dualFund.completeChan
+	if resCtx.dualFund.sentTxSigs {
+		log.Warnf("Abandoning signed dual funded ChannelPoint(%v), "+
+			"waiting for it to confirm",
+			completeChan.FundingOutpoint)
+
+		f.wg.Add(1)
+		go f.advanceFundingState(completeChan, pendingChanID, nil)
+
+		return
+	}
+
+	localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChanPoint:               completeChan.FundingOutpoint,
+		ChainHash:               completeChan.ChainHash,
+		RemotePub:               completeChan.IdentityPub,
+		CloseType:               channeldb.FundingCanceled,
+		Capacity:                completeChan.Capacity,
+		SettledBalance:          localBalance,
+		RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
+		RemoteNextRevocation:    completeChan.RemoteNextRevocation,
+		LocalChanConfig:         completeChan.LocalChanCfg,
+	}
+	err := completeChan.CloseChannel(
+		closeInfo, channeldb.ChanStatusLocalCloseInitiator,
+	)
+	if err != nil {
+		log.Errorf("Failed closing channel %v: %v",
+			completeChan.FundingOutpoint, err)
+	}
+}
@@ -0,0 +1,171 @@
+package funding
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/ltcutil"
+)
+
+// InboundReservation describes a reservation for a channel that a remote peer
+// is currently negotiating to open with us.
+type InboundReservation struct {
+	// Peer is the identity key of the node opening the channel.
+	Peer *btcec.PublicKey
+
+	// PendingChanID is the temporary ID of the channel.
+	PendingChanID [32]byte
+
+	// Capacity is the capacity of the channel.
+	Capacity ltcutil.Amount
+
+	// LastUpdated is the last time the funding flow progressed. It's the
+	// zero time if the reservation is locked waiting for the funding
+	// transaction.
+	LastUpdated time.Time
+
+	// Reputation is the reputation score of the peer, in the range of
+	// (0, 1].
+	Reputation float64
+}
+
+// peerReputation returns a score in the range of (0, 1] that reflects how
+// reliably the given peer stayed connected to us, based on the number of
+// flaps recorded for it. A peer without any flaps has a perfect score of 1,
+// which halves once the peer reaches PendingFlapPenalty flaps.
+func (f *Manager) peerReputation(peerKey *btcec.PublicKey) float64 {
+	if f.cfg.PendingFlapPenalty == 0 || f.cfg.FlapCount == nil {
+		return 1
+	}
+
+	flapCount, _, err := f.cfg.FlapCount(route.NewVertex(peerKey))
+	if err != nil {
+		// We don't want to reject channels because we can't look up
+		// the flap count, so we'll just assume the peer is reliable.
+		log.Warnf("Unable to fetch flap count of peer %x: %v",
+			peerKey.SerializeCompressed(), err)
+		return 1
+	}
+
+	penalty := float64(f.cfg.PendingFlapPenalty)
+
+	return penalty / (penalty + float64(flapCount))
+}
+
+// maxPendingChans returns the maximum number of pending channels we allow for
+// the given peer. It's the MaxPendingChannels limit scaled by the reputation
+// of the peer and rounded to the nearest integer, so that unreliable peers can
+// tie up fewer of our resources. A peer whose limit rounds down to zero isn't
+// allowed to open any channels.
+func (f *Manager) maxPendingChans(peerKey *btcec.PublicKey) int {
+	reputation := f.peerReputation(peerKey)
+
+	return int(math.Round(float64(f.cfg.MaxPendingChannels) * reputation))
+}
+
+// numPendingInbound returns the number of pending inbound channels across all
+// peers. Just like for the per peer limit, these are the reservations for
+// inbound channels and the inbound channels in the database that are waiting
+// for the funding transaction to confirm. We don't count channels that were
+// created from a canned funding shim.
+func (f *Manager) numPendingInbound() (int, error) {
+	var numPending int
+
+	f.resMtx.RLock()
+	for _, reservations := range f.activeReservations {
+		for _, resCtx := range reservations {
+			res := resCtx.reservation
+			if !res.IsInitiator() && !res.IsCannedShim() {
+				numPending++
+			}
+		}
+	}
+	f.resMtx.RUnlock()
+
+	channels, err := f.cfg.Wallet.Cfg.Database.FetchPendingChannels()
+	if err != nil {
+		return 0, err
+	}
+
+	// As for the per peer limit, channels with a non-zero thaw height were
+	// created through a canned funding shim.
+	for _, c := range channels {
+		if !c.IsInitiator && c.ThawHeight == 0 {
+			numPending++
+		}
+	}
+
+	return numPending, nil
+}
+
+// InboundReservations returns the reservations for all channels that remote
+// peers are currently negotiating to open with us.
+func (f *Manager) InboundReservations() []InboundReservation {
+	var inbound []InboundReservation
+
+	f.resMtx.RLock()
+	for _, reservations := range f.activeReservations {
+		for pendingChanID, resCtx := range reservations {
+			if resCtx.reservation.IsInitiator() {
+				continue
+			}
+
+			resCtx.updateMtx.RLock()
+			lastUpdated := resCtx.lastUpdated
+			resCtx.updateMtx.RUnlock()
+
+			inbound = append(inbound, InboundReservation{
+				Peer:          resCtx.peer.IdentityKey(),
+				PendingChanID: pendingChanID,
+				Capacity:      resCtx.reservation.Capacity(),
+				LastUpdated:   lastUpdated,
+			})
+		}
+	}
+	f.resMtx.RUnlock()
+
+	// We look up the reputation of the peers without holding the mutex,
+	// as it requires a round trip to the channel event store.
+	for i := range inbound {
+		inbound[i].Reputation = f.peerReputation(inbound[i].Peer)
+	}
+
+	return inbound
+}
+
+// CancelInboundReservation cancels the reservation of the inbound channel with
+// the given pending channel ID, and fails the funding flow with the remote
+// peer. This allows operators to free the resources that are tied up by peers
+// that stall the funding flow.
+func (f *Manager) CancelInboundReservation(pendingChanID [32]byte) error {
+	var resCtx *reservationWithCtx
+
+	f.resMtx.RLock()
+	for _, reservations := range f.activeReservations {
+		ctx, ok := reservations[pendingChanID]
+		if ok && !ctx.reservation.IsInitiator() {
+			resCtx = ctx
+			break
+		}
+	}
+	f.resMtx.RUnlock()
+
+	if resCtx == nil {
+		return fmt.Errorf("no inbound reservation with pending "+
+			"channel ID %x", pendingChanID[:])
+	}
+
+	peerKey := resCtx.peer.IdentityKey()
+	log.Infof("Cancelling inbound reservation %x of peer %x on request",
+		pendingChanID[:], peerKey.SerializeCompressed())
+
+	f.failFundingFlow(
+		resCtx.peer, pendingChanID,
+		fmt.Errorf("reservation canceled by operator"),
+	)
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
+	"github.com/ltcsuite/lnd/aliasmgr"
 	"github.com/ltcsuite/lnd/chainntnfs"
 	"github.com/ltcsuite/lnd/chainreg"
 	"github.com/ltcsuite/lnd/chanacceptor"
@@ -26,6 +27,7 @@ import (
 	"github.com/ltcsuite/lnd/lnwallet/chanfunding"
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/routing"
+	"github.com/ltcsuite/lnd/routing/route"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/btcec/v2/ecdsa"
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
@@ -151,6 +153,10 @@ type reservationWithCtx struct {
 	// the channel.
 	channelType *lnwire.ChannelType
 
+	// dualFund tracks the interactive construction of the funding
+	// transaction. It's only set for dual funded channels.
+	dualFund *dualFundingState
+
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
@@ -307,6 +313,11 @@ type Config struct {
 	// transaction to the network.
 	PublishTransaction func(*wire.MsgTx, string) error
 
+	// FetchTx returns the wallet transaction with the given hash. It's used
+	// to look up the transactions our inputs spend from, as they're sent
+	// to the remote party when negotiating a dual funded channel.
+	FetchTx func(chainhash.Hash) (*wire.MsgTx, error)
+
 	// UpdateLabel updates the label that a transaction has in our wallet,
 	// overwriting any existing labels.
 	UpdateLabel func(chainhash.Hash, string) error
@@ -431,6 +442,20 @@ type Config struct {
 	// allow for each peer.
 	MaxPendingChannels int
 
+	// MaxPendingInbound is the maximum number of pending inbound channels
+	// we allow across all peers, counting both reservations and channels
+	// waiting for confirmation. A value of zero disables the limit.
+	MaxPendingInbound int
+
+	// PendingFlapPenalty is the number of flaps after which the number of
+	// pending channels we allow for a peer is halved. A value of zero
+	// disables reputation based limits.
+	PendingFlapPenalty int
+
+	// FlapCount returns the number of times the given peer flapped, along
+	// with the time of its last flap.
+	FlapCount func(route.Vertex) (int, *time.Time, error)
+
 	// RejectPush is set true if the fundingmanager should reject any
 	// incoming channels having a non-zero push amount.
 	RejectPush bool
@@ -463,6 +488,43 @@ type Config struct {
 	// MaxAnchorsCommitFeeRate is the max commitment fee rate we'll use as
 	// the initiator for channels of the anchor type.
 	MaxAnchorsCommitFeeRate chainfee.SatPerKWeight
+
+	// AliasManager is used to request new aliases for zero-conf and
+	// option-scid-alias channels, and to store the aliases our peers
+	// have given us.
+	AliasManager aliasHandler
+
+	// AddAliasForLink makes the switch aware of a new alias for an
+	// existing link, so that HTLCs that use it can be forwarded.
+	AddAliasForLink func(chanID lnwire.ChannelID,
+		alias lnwire.ShortChannelID) error
+
+	// DeleteAliasEdge removes the graph edge of a public zero-conf
+	// channel that was added under its alias, so that it can be replaced
+	// by an edge using the confirmed short channel ID.
+	DeleteAliasEdge func(scid lnwire.ShortChannelID) error
+}
+
+// aliasHandler is an interface that abstracts the alias manager away from
+// the funding manager.
+type aliasHandler interface {
+	// RequestAlias returns the next available alias.
+	RequestAlias() (lnwire.ShortChannelID, error)
+
+	// AddLocalAlias persists an alias for the channel with the given base
+	// short channel ID.
+	AddLocalAlias(alias, base lnwire.ShortChannelID) error
+
+	// GetAliases returns the set of aliases for the given base short
+	// channel ID.
+	GetAliases(base lnwire.ShortChannelID) []lnwire.ShortChannelID
+
+	// PutPeerAlias stores the alias our peer sent us in FundingLocked.
+	PutPeerAlias(chanID lnwire.ChannelID,
+		alias lnwire.ShortChannelID) error
+
+	// GetPeerAlias returns the alias our peer sent us for the channel.
+	GetPeerAlias(chanID lnwire.ChannelID) (lnwire.ShortChannelID, error)
 }
 
 // Manager acts as an orchestrator/bridge between the wallet's
@@ -837,6 +899,24 @@ func (f *Manager) reservationCoordinator() {
 			case *lnwire.FundingLocked:
 				f.wg.Add(1)
 				go f.handleFundingLocked(fmsg.peer, msg)
+			case *lnwire.OpenChannel2:
+				f.handleFundingOpen2(fmsg.peer, msg)
+			case *lnwire.AcceptChannel2:
+				f.handleFundingAccept2(fmsg.peer, msg)
+			case *lnwire.TxAddInput, *lnwire.TxAddOutput,
+				*lnwire.TxRemoveInput, *lnwire.TxRemoveOutput,
+				*lnwire.TxComplete:
+
+				f.handleInteractiveTxMsg(fmsg.peer, msg)
+			case *lnwire.CommitSig:
+				f.handleDualFundCommitSig(fmsg.peer, msg)
+			case *lnwire.TxSignatures:
+				f.handleTxSignatures(fmsg.peer, msg)
+			case *lnwire.TxAbort:
+				f.handleErrorMsg(fmsg.peer, &lnwire.Error{
+					ChanID: msg.ChanID,
+					Data:   msg.Data,
+				})
 			case *lnwire.Error:
 				f.handleErrorMsg(fmsg.peer, msg)
 			}
@@ -866,8 +946,19 @@ func (f *Manager) advanceFundingState(channel *channeldb.OpenChannel,
 	defer f.wg.Done()
 
 	// If the channel is still pending we must wait for the funding
-	// transaction to confirm.
-	if channel.IsPending {
+	// transaction to confirm, unless this is a zero-conf channel, which
+	// we can mark as open right away.
+	switch {
+	case channel.IsPending && channel.IsZeroConf():
+		err := f.handleZeroConfOpen(channel)
+		if err != nil {
+			log.Errorf("Unable to open zero-conf "+
+				"ChannelPoint(%v): %v",
+				channel.FundingOutpoint, err)
+			return
+		}
+
+	case channel.IsPending:
 		err := f.advancePendingChannelState(channel, pendingChanID)
 		if err != nil {
 			log.Errorf("Unable to advance pending state of "+
@@ -1135,6 +1226,62 @@ func (f *Manager) advancePendingChannelState(
 	return nil
 }
 
+// handleZeroConfOpen marks a zero-conf channel as open without waiting for
+// its funding transaction to confirm. As the real short channel ID isn't known
+// yet, a freshly allocated alias is used as the channel's base short channel
+// ID. The real one is stored once the funding transaction confirms, see
+// annAfterSixConfs.
+func (f *Manager) handleZeroConfOpen(completeChan *channeldb.OpenChannel) error {
+	fundingPoint := completeChan.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+
+	alias, err := f.cfg.AliasManager.RequestAlias()
+	if err != nil {
+		return fmt.Errorf("unable to request alias: %v", err)
+	}
+
+	// The alias is its own base, which lets the switch and the graph
+	// look up the channel by it.
+	err = f.cfg.AliasManager.AddLocalAlias(alias, alias)
+	if err != nil {
+		return fmt.Errorf("unable to add local alias: %v", err)
+	}
+
+	// As with regular channels, we set the opening state before marking
+	// the channel as open so we can recover if either write fails.
+	err = f.saveChannelOpeningState(&fundingPoint, markedOpen, &alias)
+	if err != nil {
+		return fmt.Errorf("error setting channel state to markedOpen: %v",
+			err)
+	}
+
+	err = completeChan.MarkAsOpen(alias)
+	if err != nil {
+		return fmt.Errorf("error setting channel pending flag to false: "+
+			"%v", err)
+	}
+
+	log.Infof("Zero-conf ChannelPoint(%v) is now active with alias %v",
+		fundingPoint, alias)
+
+	f.cfg.NotifyOpenChannelEvent(fundingPoint)
+
+	err = f.cfg.ReportShortChanID(fundingPoint)
+	if err != nil {
+		log.Errorf("unable to report short chan id: %v", err)
+	}
+
+	// Let the handler of the peer's FundingLocked know that the channel
+	// is now marked as open in the database.
+	f.localDiscoveryMtx.Lock()
+	if discoverySignal, ok := f.localDiscoverySignals[chanID]; ok {
+		close(discoverySignal)
+	}
+	f.localDiscoveryMtx.Unlock()
+
+	return nil
+}
+
 // ProcessFundingMsg sends a message to the internal fundingManager goroutine,
 // allowing it to handle the lnwire.Message.
 func (f *Manager) ProcessFundingMsg(msg lnwire.Message, peer lnpeer.Peer) {
@@ -1145,23 +1292,12 @@ func (f *Manager) ProcessFundingMsg(msg lnwire.Message, peer lnpeer.Peer) {
 	}
 }
 
-// handleFundingOpen creates an initial 'ChannelReservation' within the wallet,
-// then responds to the source peer with an accept channel message progressing
-// the funding workflow.
-//
-// TODO(roasbeef): add error chan to all, let channelManager handle
-// error+propagate
-func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
-	msg *lnwire.OpenChannel) {
-
-	// Check number of pending channels to be smaller than maximum allowed
-	// number and send ErrorGeneric to remote peer if condition is
-	// violated.
-	peerPubKey := peer.IdentityKey()
+// numPendingChans returns the number of channels that are currently pending
+// open with the given peer, excluding the ones that were created from a canned
+// funding shim.
+func (f *Manager) numPendingChans(peerPubKey *btcec.PublicKey) (int, error) {
 	peerIDKey := newSerializedKey(peerPubKey)
 
-	amt := msg.FundingAmount
-
 	// We get all pending channels for this peer. This is the list of the
 	// active reservations and the channels pending open in the database.
 	f.resMtx.RLock()
@@ -1182,10 +1318,7 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 	// the underlying intent anymore, unfortunately.
 	channels, err := f.cfg.Wallet.Cfg.Database.FetchOpenChannels(peerPubKey)
 	if err != nil {
-		f.failFundingFlow(
-			peer, msg.PendingChannelID, err,
-		)
-		return
+		return 0, err
 	}
 
 	for _, c := range channels {
@@ -1200,14 +1333,70 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		}
 	}
 
+	return numPending, nil
+}
+
+// newChannelAcceptRequest assembles the request our channel acceptor is
+// queried with for the channel proposed by the given open_channel message.
+func (f *Manager) newChannelAcceptRequest(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel,
+	dualFunded bool) (*chanacceptor.ChannelAcceptRequest, error) {
+
+	_, _, commitType, err := negotiateCommitmentType(
+		msg.ChannelType, peer.LocalFeatures(), peer.RemoteFeatures(),
+		false,
+	)
+	if err != nil {
+		log.Errorf("channel type negotiation failed: %v", err)
+		return nil, err
+	}
+
+	numPending, err := f.numPendingChans(peer.IdentityKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return &chanacceptor.ChannelAcceptRequest{
+		Node:            peer.IdentityKey(),
+		OpenChanMsg:     msg,
+		DualFunded:      dualFunded,
+		Features:        peer.RemoteFeatures(),
+		CommitType:      commitType,
+		NumPendingChans: numPending,
+	}, nil
+}
+
+// checkInboundChannel checks whether we're able and willing to accept another
+// inbound channel of the given size from the peer. A non-nil error is
+// returned if the channel should be rejected.
+func (f *Manager) checkInboundChannel(peer lnpeer.Peer, amt ltcutil.Amount,
+	pushAmt lnwire.MilliSatoshi) error {
+
+	// Check number of pending channels to be smaller than maximum allowed
+	// number and send ErrorGeneric to remote peer if condition is
+	// violated.
+	numPending, err := f.numPendingChans(peer.IdentityKey())
+	if err != nil {
+		return err
+	}
+
 	// TODO(roasbeef): modify to only accept a _single_ pending channel per
 	// block unless white listed
-	if numPending >= f.cfg.MaxPendingChannels {
-		f.failFundingFlow(
-			peer, msg.PendingChannelID,
-			lnwire.ErrMaxPendingChannels,
-		)
-		return
+	if numPending >= f.maxPendingChans(peer.IdentityKey()) {
+		return lnwire.ErrMaxPendingChannels
+	}
+
+	// We also limit the total number of pending inbound channels, so that
+	// a set of peers can't tie up our resources either.
+	if f.cfg.MaxPendingInbound != 0 {
+		numPendingInbound, err := f.numPendingInbound()
+		if err != nil {
+			return err
+		}
+
+		if numPendingInbound >= f.cfg.MaxPendingInbound {
+			return lnwire.ErrMaxPendingChannels
+		}
 	}
 
 	// We'll also reject any requests to create channels until we're fully
@@ -1218,47 +1407,152 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		if err != nil {
 			log.Errorf("unable to query wallet: %v", err)
 		}
-		f.failFundingFlow(
-			peer, msg.PendingChannelID,
-			lnwire.ErrSynchronizingChain,
-		)
-		return
+		return lnwire.ErrSynchronizingChain
 	}
 
 	// Ensure that the remote party respects our maximum channel size.
 	if amt > f.cfg.MaxChanSize {
-		f.failFundingFlow(
-			peer, msg.PendingChannelID,
-			lnwallet.ErrChanTooLarge(amt, f.cfg.MaxChanSize),
-		)
-		return
+		return lnwallet.ErrChanTooLarge(amt, f.cfg.MaxChanSize)
 	}
 
 	// We'll, also ensure that the remote party isn't attempting to propose
 	// a channel that's below our current min channel size.
 	if amt < f.cfg.MinChanSize {
-		f.failFundingFlow(
-			peer, msg.PendingChannelID,
-			lnwallet.ErrChanTooSmall(amt, ltcutil.Amount(f.cfg.MinChanSize)),
+		return lnwallet.ErrChanTooSmall(
+			amt, ltcutil.Amount(f.cfg.MinChanSize),
 		)
-		return
 	}
 
 	// If request specifies non-zero push amount and 'rejectpush' is set,
 	// signal an error.
-	if f.cfg.RejectPush && msg.PushAmount > 0 {
-		f.failFundingFlow(
-			peer, msg.PendingChannelID,
-			lnwallet.ErrNonZeroPushAmount(),
+	if f.cfg.RejectPush && pushAmt > 0 {
+		return lnwallet.ErrNonZeroPushAmount()
+	}
+
+	return nil
+}
+
+// inboundChannelType is the outcome of negotiating the channel type of an
+// inbound channel.
+type inboundChannelType struct {
+	// chanType is the channel type that we echo back to the initiator. It
+	// is nil if the channel type was negotiated implicitly.
+	chanType *lnwire.ChannelType
+
+	// commitType is the commitment type of the channel.
+	commitType lnwallet.CommitmentType
+
+	// zeroConf is true if the channel type has the zero-conf bit set.
+	zeroConf bool
+
+	// scidAlias is true if the channel type has the scid-alias bit set.
+	scidAlias bool
+
+	// scidAliasFeature is true if both parties signal the scid-alias
+	// feature bit.
+	scidAliasFeature bool
+}
+
+// negotiateInboundChannelType determines the commitment format of an inbound
+// channel, and checks the zero-conf and scid-alias bits of the proposed
+// channel type against the response of our channel acceptor.
+func negotiateInboundChannelType(peer lnpeer.Peer,
+	channelType *lnwire.ChannelType, flags lnwire.FundingFlag,
+	acceptorResp *chanacceptor.ChannelAcceptResponse) (*inboundChannelType,
+	error) {
+
+	// The commitment format we can use with this peer is dependent on
+	// *both* us and the remote peer are signaling the proper feature bit
+	// if we're using implicit negotiation, and simply the channel type
+	// sent over if we're using explicit negotiation.
+	wasExplicit, _, commitType, err := negotiateCommitmentType(
+		channelType, peer.LocalFeatures(), peer.RemoteFeatures(),
+		false,
+	)
+	if err != nil {
+		// TODO(roasbeef): should be using soft errors
+		log.Errorf("channel type negotiation failed: %v", err)
+		return nil, err
+	}
+
+	inbound := &inboundChannelType{
+		commitType: commitType,
+	}
+
+	// Only echo back a channel type in AcceptChannel if we actually used
+	// explicit negotiation above.
+	if wasExplicit {
+		inbound.chanType = channelType
+
+		// Check whether the zero-conf or scid-alias bits were set as
+		// part of the channel type.
+		channelFeatures := lnwire.RawFeatureVector(*channelType)
+		inbound.zeroConf = channelFeatures.IsSet(
+			lnwire.ZeroConfRequired,
 		)
+		inbound.scidAlias = channelFeatures.IsSet(
+			lnwire.ScidAliasRequired,
+		)
+	}
+
+	// A zero-conf channel can only be opened if our channel acceptor
+	// explicitly agreed to trust the funder with the funding transaction.
+	// Likewise, the acceptor shouldn't be able to turn a regular channel
+	// into a zero-conf one without the funder asking for it.
+	switch {
+	case inbound.zeroConf && !acceptorResp.ZeroConf:
+		return nil, errors.New("zero-conf channel not accepted")
+
+	case !inbound.zeroConf && acceptorResp.ZeroConf:
+		return nil, errors.New("zero-conf channel type not negotiated")
+	}
+
+	// The scid-alias channel type is only meant for private channels, as
+	// the real short channel ID of a public channel is revealed anyway once
+	// it's announced.
+	public := flags&lnwire.FFAnnounceChannel != 0
+	if inbound.scidAlias && public {
+		return nil, errors.New("scid-alias channel type for public " +
+			"channel")
+	}
+
+	// Even if the scid-alias channel type wasn't negotiated, we'll still
+	// exchange aliases in funding_locked if both sides support the
+	// feature.
+	inbound.scidAliasFeature = hasFeatures(
+		peer.LocalFeatures(), peer.RemoteFeatures(),
+		lnwire.ScidAliasOptional,
+	)
+
+	return inbound, nil
+}
+
+// handleFundingOpen creates an initial 'ChannelReservation' within the wallet,
+// then responds to the source peer with an accept channel message progressing
+// the funding workflow.
+//
+// TODO(roasbeef): add error chan to all, let channelManager handle
+// error+propagate
+func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel) {
+
+	peerIDKey := newSerializedKey(peer.IdentityKey())
+	amt := msg.FundingAmount
+
+	// Make sure that we're able and willing to accept another inbound
+	// channel of this size before we consider it any further.
+	err := f.checkInboundChannel(peer, amt, msg.PushAmount)
+	if err != nil {
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
 		return
 	}
 
 	// Send the OpenChannel request to the ChannelAcceptor to determine whether
 	// this node will accept the channel.
-	chanReq := &chanacceptor.ChannelAcceptRequest{
-		Node:        peer.IdentityKey(),
-		OpenChanMsg: msg,
+	chanReq, err := f.newChannelAcceptRequest(peer, msg, false)
+	if err != nil {
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
 	}
 
 	// Query our channel acceptor to determine whether we should reject
@@ -1277,35 +1571,22 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		msg.CsvDelay, msg.PendingChannelID,
 		peer.IdentityKey().SerializeCompressed())
 
-	// Attempt to initialize a reservation within the wallet. If the wallet
-	// has insufficient resources to create the channel, then the
-	// reservation attempt may be rejected. Note that since we're on the
-	// responding side of a single funder workflow, we don't commit any
-	// funds to the channel ourselves.
-	//
-	// Before we init the channel, we'll also check to see what commitment
-	// format we can use with this peer. This is dependent on *both* us and
-	// the remote peer are signaling the proper feature bit if we're using
-	// implicit negotiation, and simply the channel type sent over if we're
-	// using explicit negotiation.
-	wasExplicit, _, commitType, err := negotiateCommitmentType(
-		msg.ChannelType, peer.LocalFeatures(), peer.RemoteFeatures(),
-		false,
+	// Before we init the channel, we'll check to see what commitment
+	// format we can use with this peer.
+	inbound, err := negotiateInboundChannelType(
+		peer, msg.ChannelType, msg.ChannelFlags, acceptorResp,
 	)
 	if err != nil {
-		// TODO(roasbeef): should be using soft errors
-		log.Errorf("channel type negotiation failed: %v", err)
 		f.failFundingFlow(peer, msg.PendingChannelID, err)
 		return
 	}
+	commitType := inbound.commitType
 
-	// Only echo back a channel type in AcceptChannel if we actually used
-	// explicit negotiation above.
-	var chanTypeFeatureBits *lnwire.ChannelType
-	if wasExplicit {
-		chanTypeFeatureBits = msg.ChannelType
-	}
-
+	// Attempt to initialize a reservation within the wallet. If the wallet
+	// has insufficient resources to create the channel, then the
+	// reservation attempt may be rejected. Note that since we're on the
+	// responding side of a single funder workflow, we don't commit any
+	// funds to the channel ourselves.
 	chainHash := chainhash.Hash(msg.ChainHash)
 	req := &lnwallet.InitFundingReserveMsg{
 		ChainHash:        &chainHash,
@@ -1320,6 +1601,9 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		Flags:            msg.ChannelFlags,
 		MinConfs:         1,
 		CommitType:       commitType,
+		ZeroConf:         inbound.zeroConf,
+		OptionScidAlias:  inbound.scidAlias,
+		ScidAliasFeature: inbound.scidAliasFeature,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -1339,6 +1623,12 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 	if acceptorResp.MinAcceptDepth != 0 {
 		numConfsReq = acceptorResp.MinAcceptDepth
 	}
+
+	// A zero-conf channel is usable right away, so we don't require any
+	// confirmations at all.
+	if inbound.zeroConf {
+		numConfsReq = 0
+	}
 	reservation.SetNumConfsRequired(numConfsReq)
 
 	// We'll also validate and apply all the constraints the initiating
@@ -1542,7 +1832,7 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
 		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
 		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
-		ChannelType:           chanTypeFeatureBits,
+		ChannelType:           inbound.chanType,
 		LeaseExpiry:           msg.LeaseExpiry,
 	}
 
@@ -1653,6 +1943,15 @@ func (f *Manager) handleFundingAccept(peer lnpeer.Peer,
 		return
 	}
 
+	// If we asked for a zero-conf channel, the responder must not require
+	// any confirmations.
+	if resCtx.reservation.IsZeroConf() && msg.MinAcceptDepth != 0 {
+		err = errors.New("non-zero min_depth for zero-conf channel")
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
 	// We'll also specify the responder's preference for the number of
 	// required confirmations, and also the set of channel constraints
 	// they've specified for commitment states we can create.
@@ -2307,7 +2606,13 @@ func (f *Manager) waitForFundingConfirmation(
 			err)
 		return
 	}
+	// A zero-conf channel doesn't require any confirmations to be used,
+	// but we still need the funding transaction to be mined to learn its
+	// real short channel ID.
 	numConfs := uint32(completeChan.NumConfsRequired)
+	if numConfs == 0 {
+		numConfs = 1
+	}
 	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
 		&txid, fundingScript, numConfs,
 		completeChan.FundingBroadcastHeight,
@@ -2544,6 +2849,18 @@ func (f *Manager) sendFundingLocked(
 	}
 	fundingLockedMsg := lnwire.NewFundingLocked(chanID, nextRevocation)
 
+	// If the scid-alias feature or channel type was negotiated, we'll
+	// also give our peer an alias it can use to refer to the channel.
+	if completeChan.IsZeroConf() || completeChan.IsOptionScidAlias() ||
+		completeChan.NegotiatedAliasFeature() {
+
+		alias, err := f.fundingLockedAlias(completeChan, shortChanID)
+		if err != nil {
+			return err
+		}
+		fundingLockedMsg.AliasScid = &alias
+	}
+
 	// If the peer has disconnected before we reach this point, we will need
 	// to wait for him to come back online before sending the fundingLocked
 	// message. This is special for fundingLocked, since failing to send any
@@ -2582,6 +2899,48 @@ func (f *Manager) sendFundingLocked(
 	return nil
 }
 
+// fundingLockedAlias returns the alias that we'll send to our peer in
+// FundingLocked. If the channel already has an alias, e.g. because it's a
+// zero-conf channel or we have sent FundingLocked before, that alias is
+// reused. Otherwise a new one is allocated for the given base short channel
+// ID.
+func (f *Manager) fundingLockedAlias(completeChan *channeldb.OpenChannel,
+	shortChanID *lnwire.ShortChannelID) (lnwire.ShortChannelID, error) {
+
+	// A zero-conf channel's base is already an alias, so we can just hand
+	// it out directly.
+	if aliasmgr.IsAlias(*shortChanID) {
+		return *shortChanID, nil
+	}
+
+	aliases := f.cfg.AliasManager.GetAliases(*shortChanID)
+	if len(aliases) > 0 {
+		return aliases[0], nil
+	}
+
+	alias, err := f.cfg.AliasManager.RequestAlias()
+	if err != nil {
+		return lnwire.ShortChannelID{}, fmt.Errorf("unable to request "+
+			"alias: %v", err)
+	}
+
+	err = f.cfg.AliasManager.AddLocalAlias(alias, *shortChanID)
+	if err != nil {
+		return lnwire.ShortChannelID{}, fmt.Errorf("unable to add "+
+			"local alias: %v", err)
+	}
+
+	// If the channel's link is already active, it needs to know about
+	// the new alias as well.
+	chanID := lnwire.NewChanIDFromOutPoint(&completeChan.FundingOutpoint)
+	if err := f.cfg.AddAliasForLink(chanID, alias); err != nil {
+		log.Debugf("Unable to add alias %v for link of "+
+			"ChannelID(%v): %v", alias, chanID, err)
+	}
+
+	return alias, nil
+}
+
 // addToRouterGraph sends a ChannelAnnouncement and a ChannelUpdate to the
 // gossiper so that the channel is added to the Router's internal graph.
 // These announcement messages are NOT broadcasted to the greater network,
@@ -2679,6 +3038,22 @@ func (f *Manager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 	// we'll only send our NodeAnnouncement to our counterparty to ensure we
 	// don't leak any of our information.
 	announceChan := completeChan.ChannelFlags&lnwire.FFAnnounceChannel != 0
+
+	// A zero-conf channel has been in use under its alias so far. Before
+	// we can go on, we need to wait for the funding transaction to
+	// confirm so we learn the channel's real short channel ID.
+	if completeChan.IsZeroConf() {
+		realScid, err := f.handleZeroConfConfirmation(
+			completeChan, shortChanID, announceChan,
+		)
+		if err != nil {
+			return err
+		}
+
+		// A public channel is announced using its real short
+		// channel ID.
+		shortChanID = realScid
+	}
 	if !announceChan {
 		log.Debugf("Will not announce private channel %v.",
 			shortChanID.ToUint64())
@@ -2793,6 +3168,85 @@ func (f *Manager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 	return nil
 }
 
+// handleZeroConfConfirmation waits for the funding transaction of a zero-conf
+// channel to confirm and stores the channel's real short channel ID. For
+// public channels, the edge that was added to the graph under the channel's
+// alias is replaced with one using the real short channel ID, which is also
+// registered as an additional alias of the channel so that HTLCs using it can
+// be forwarded.
+func (f *Manager) handleZeroConfConfirmation(
+	completeChan *channeldb.OpenChannel, baseScid *lnwire.ShortChannelID,
+	announceChan bool) (*lnwire.ShortChannelID, error) {
+
+	fundingPoint := completeChan.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+
+	confChannel, err := f.waitForFundingWithTimeout(completeChan)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for zero-conf funding "+
+			"confirmation for ChannelPoint(%v): %v", fundingPoint,
+			err)
+	}
+
+	err = f.cfg.Wallet.ValidateChannel(completeChan, confChannel.fundingTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate channel: %v", err)
+	}
+
+	realScid := confChannel.shortChanID
+	if err := completeChan.MarkRealScid(realScid); err != nil {
+		return nil, fmt.Errorf("unable to store real scid: %v", err)
+	}
+
+	log.Infof("Zero-conf ChannelPoint(%v) confirmed with "+
+		"short_chan_id=%v", fundingPoint, realScid)
+
+	// Now that the real short channel ID is known, we can update the
+	// label of our funding transaction.
+	if completeChan.IsInitiator && completeChan.ChanType.HasFundingTx() {
+		label := labels.MakeLabel(
+			labels.LabelTypeChannelOpen, &realScid,
+		)
+
+		err = f.cfg.UpdateLabel(fundingPoint.Hash, label)
+		if err != nil {
+			log.Errorf("unable to update label: %v", err)
+		}
+	}
+
+	// Private channels keep using their alias in the graph.
+	if !announceChan {
+		return &realScid, nil
+	}
+
+	err = f.cfg.AliasManager.AddLocalAlias(realScid, *baseScid)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add real scid as alias: %v",
+			err)
+	}
+
+	// The link may not be active yet, in which case the switch picks up
+	// the new alias once it's added.
+	if err := f.cfg.AddAliasForLink(chanID, realScid); err != nil {
+		log.Debugf("Unable to add real scid %v for link of "+
+			"ChannelID(%v): %v", realScid, chanID, err)
+	}
+
+	// We may be resuming after a restart, in which case the alias edge
+	// is already gone.
+	err = f.cfg.DeleteAliasEdge(*baseScid)
+	if err != nil && err != channeldb.ErrEdgeNotFound {
+		return nil, fmt.Errorf("unable to delete alias edge: %v", err)
+	}
+
+	if err := f.addToRouterGraph(completeChan, &realScid); err != nil {
+		return nil, fmt.Errorf("failed adding confirmed channel to "+
+			"router graph: %v", err)
+	}
+
+	return &realScid, nil
+}
+
 // handleFundingLocked finalizes the channel funding process and enables the
 // channel to enter normal operating mode.
 func (f *Manager) handleFundingLocked(peer lnpeer.Peer,
@@ -2868,6 +3322,17 @@ func (f *Manager) handleFundingLocked(peer lnpeer.Peer,
 		return
 	}
 
+	// If our peer gave us an alias, we'll store it so that we can use it
+	// in our invoices' hop hints.
+	if msg.AliasScid != nil {
+		err := f.cfg.AliasManager.PutPeerAlias(chanID, *msg.AliasScid)
+		if err != nil {
+			log.Errorf("Unable to store peer alias for "+
+				"ChannelID(%v): %v", chanID, err)
+			return
+		}
+	}
+
 	// The funding locked message contains the next commitment point we'll
 	// need to create the next commitment state for the remote party. So
 	// we'll insert that into the channel now before passing it along to
@@ -3282,6 +3747,40 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		return
 	}
 
+	var (
+		zeroConf  bool
+		scidAlias bool
+	)
+	if chanType != nil {
+		channelFeatures := lnwire.RawFeatureVector(*chanType)
+		zeroConf = channelFeatures.IsSet(lnwire.ZeroConfRequired)
+		scidAlias = channelFeatures.IsSet(lnwire.ScidAliasRequired)
+	}
+
+	// The scid-alias channel type can't be used for public channels.
+	if scidAlias && !msg.Private {
+		err := errors.New("scid-alias channel type requires a " +
+			"private channel")
+		log.Error(err)
+		msg.Err <- err
+		return
+	}
+
+	scidAliasFeature := hasFeatures(
+		msg.Peer.LocalFeatures(), msg.Peer.RemoteFeatures(),
+		lnwire.ScidAliasOptional,
+	)
+
+	// We'll open a dual funded channel if both parties support it. As
+	// nothing can be pushed to the remote party in the dual funding
+	// protocol, and the funding transaction must be signed by our wallet
+	// during the negotiation, this is only possible for regular opens.
+	dualFund := hasFeatures(
+		msg.Peer.LocalFeatures(), msg.Peer.RemoteFeatures(),
+		lnwire.DualFundOptional,
+	) && msg.PushAmt == 0 && msg.ChanFunder == nil &&
+		commitType != lnwallet.CommitmentTypeScriptEnforcedLease
+
 	// First, we'll query the fee estimator for a fee that should get the
 	// commitment transaction confirmed by the next few blocks (conf target
 	// of 3). We target the near blocks here to ensure that we'll be able
@@ -3314,6 +3813,10 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		MinConfs:         msg.MinConfs,
 		CommitType:       commitType,
 		ChanFunder:       msg.ChanFunder,
+		ZeroConf:         zeroConf,
+		OptionScidAlias:  scidAlias,
+		ScidAliasFeature: scidAliasFeature,
+		DualFund:         dualFund,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -3377,6 +3880,11 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		updates:        msg.Updates,
 		err:            msg.Err,
 	}
+	if dualFund {
+		resCtx.dualFund = &dualFundingState{
+			feeRate: msg.FundingFeePerKw,
+		}
+	}
 	f.activeReservations[peerIDKey][chanID] = resCtx
 	f.resMtx.Unlock()
 
@@ -3393,6 +3901,29 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 
 	log.Infof("Dust limit for pendingID(%x): %v", chanID, ourDustLimit)
 
+	// The dual funding protocol uses its own message to open the channel,
+	// which doesn't carry a channel reserve.
+	if dualFund {
+		err := f.sendOpenChannel2(
+			resCtx, chanID, chanType, commitFeePerKw, channelFlags,
+			shutdown,
+		)
+		if err != nil {
+			e := fmt.Errorf("unable to send funding request "+
+				"message: %v", err)
+			log.Errorf(e.Error())
+
+			_, err := f.cancelReservationCtx(peerKey, chanID, false)
+			if err != nil {
+				log.Errorf("unable to cancel reservation: %v",
+					err)
+			}
+
+			msg.Err <- e
+		}
+		return
+	}
+
 	// Finally, we'll use the current value of the channels and our default
 	// policy to determine of required commitment constraints for the
 	// remote party.
@@ -3556,7 +4087,11 @@ func (f *Manager) cancelReservationCtx(peerKey *btcec.PublicKey,
 		ctx.reservation.RemoteCanceled()
 	}
 
-	if err := ctx.reservation.Cancel(); err != nil {
+	// The reservation of a dual funded channel is already complete once
+	// the channel was written to disk, so we abandon the channel instead.
+	if ctx.dualFund != nil && ctx.dualFund.completeChan != nil {
+		f.abandonDualFundedChannel(ctx, pendingChanID)
+	} else if err := ctx.reservation.Cancel(); err != nil {
 		return nil, errors.Errorf("unable to cancel reservation: %v",
 			err)
 	}
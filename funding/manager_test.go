@@ -17,6 +17,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ltcsuite/lnd/aliasmgr"
 	"github.com/ltcsuite/lnd/chainntnfs"
 	"github.com/ltcsuite/lnd/chainreg"
 	"github.com/ltcsuite/lnd/chanacceptor"
@@ -33,6 +34,7 @@ import (
 	"github.com/ltcsuite/lnd/lnwallet"
 	"github.com/ltcsuite/lnd/lnwallet/chainfee"
 	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/route"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/btcec/v2/ecdsa"
 	"github.com/ltcsuite/ltcd/chaincfg"
@@ -343,6 +345,11 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 
 	cdb := fullDB.ChannelStateDB()
 
+	aliasMgr, err := aliasmgr.NewManager(fullDB)
+	if err != nil {
+		return nil, err
+	}
+
 	keyRing := &mock.SecretKeyRing{
 		RootKey: alicePrivKey,
 	}
@@ -454,6 +461,15 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		OpenChannelPredicate:          chainedAcceptor,
 		NotifyPendingOpenChannelEvent: evt.NotifyPendingOpenChannelEvent,
 		RegisteredChains:              chainreg.NewChainRegistry(),
+		AliasManager:                  aliasMgr,
+		AddAliasForLink: func(lnwire.ChannelID,
+			lnwire.ShortChannelID) error {
+
+			return nil
+		},
+		DeleteAliasEdge: func(lnwire.ShortChannelID) error {
+			return nil
+		},
 	}
 
 	for _, op := range options {
@@ -558,6 +574,9 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 		ZombieSweeperInterval: oldCfg.ZombieSweeperInterval,
 		ReservationTimeout:    oldCfg.ReservationTimeout,
 		OpenChannelPredicate:  chainedAcceptor,
+		AliasManager:          oldCfg.AliasManager,
+		AddAliasForLink:       oldCfg.AddAliasForLink,
+		DeleteAliasEdge:       oldCfg.DeleteAliasEdge,
 	})
 	if err != nil {
 		t.Fatalf("failed recreating aliceFundingManager: %v", err)
@@ -827,6 +846,8 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		ok      bool
 	)
 	switch msgType {
+	case "OpenChannel":
+		sentMsg, ok = msg.(*lnwire.OpenChannel)
 	case "AcceptChannel":
 		sentMsg, ok = msg.(*lnwire.AcceptChannel)
 	case "FundingCreated":
@@ -3053,6 +3074,219 @@ func TestFundingManagerMaxPendingChannels(t *testing.T) {
 	).(*lnwire.AcceptChannel)
 }
 
+// TestFundingManagerInboundReservations checks that the global limit on
+// inbound reservations is enforced, and that inbound reservations can be
+// listed and canceled.
+func TestFundingManagerInboundReservations(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(
+		t, func(cfg *Config) {
+			cfg.MaxPendingChannels = maxPending
+			cfg.MaxPendingInbound = 1
+		},
+	)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// sendOpen makes Alice initiate a channel open with Bob, and returns
+	// the OpenChannel message she sent.
+	sendOpen := func() *lnwire.OpenChannel {
+		initReq := &InitFundingMsg{
+			Peer:            bob,
+			TargetPubkey:    bob.privKey.PubKey(),
+			ChainHash:       *fundingNetParams.GenesisHash,
+			LocalFundingAmt: 5000000,
+			PushAmt:         lnwire.NewMSatFromSatoshis(0),
+			Updates:         make(chan *lnrpc.OpenStatusUpdate),
+			Err:             make(chan error, 1),
+		}
+		alice.fundingMgr.InitFundingWorkflow(initReq)
+
+		openChan := assertFundingMsgSent(
+			t, alice.msgChan, "OpenChannel",
+		).(*lnwire.OpenChannel)
+		bob.fundingMgr.ProcessFundingMsg(openChan, alice)
+
+		return openChan
+	}
+
+	// Bob should accept the first channel, for which he now has an
+	// inbound reservation.
+	openChan := sendOpen()
+	assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
+
+	reservations := bob.fundingMgr.InboundReservations()
+	require.Len(t, reservations, 1)
+	require.Equal(
+		t, openChan.PendingChannelID, reservations[0].PendingChanID,
+	)
+	require.Equal(t, alice.privKey.PubKey(), reservations[0].Peer)
+	require.Equal(t, openChan.FundingAmount, reservations[0].Capacity)
+	require.Equal(t, 1.0, reservations[0].Reputation)
+
+	// Alice's reservation is outbound, so it's not listed.
+	require.Empty(t, alice.fundingMgr.InboundReservations())
+
+	// A second channel exceeds the global limit, even though the per peer
+	// limit would allow it.
+	sendOpen()
+	assertFundingMsgSent(t, bob.msgChan, "Error")
+
+	// Once Bob cancels the reservation of the first channel, the funding
+	// flow is failed with Alice. The error is sent synchronously, so we
+	// cancel the reservation in a goroutine.
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- bob.fundingMgr.CancelInboundReservation(
+			openChan.PendingChannelID,
+		)
+	}()
+	assertFundingMsgSent(t, bob.msgChan, "Error")
+	require.NoError(t, <-errChan)
+	require.Empty(t, bob.fundingMgr.InboundReservations())
+
+	// The reservation can't be canceled twice.
+	err := bob.fundingMgr.CancelInboundReservation(
+		openChan.PendingChannelID,
+	)
+	require.Error(t, err)
+
+	// Now that the reservation is gone, Bob accepts new channels again.
+	sendOpen()
+	assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
+}
+
+// TestFundingManagerMaxPendingInboundConfirming checks that inbound channels
+// waiting for the funding transaction to confirm count towards the global
+// limit on pending inbound channels.
+func TestFundingManagerMaxPendingInboundConfirming(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(
+		t, func(cfg *Config) {
+			cfg.MaxPendingChannels = maxPending
+			cfg.MaxPendingInbound = 1
+		},
+	)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Run through the process of opening a channel, up until the funding
+	// transaction is broadcast. Bob no longer has a reservation for the
+	// channel, but it's pending in his database.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint, fundingTx := openChannel(
+		t, alice, bob, 500000, 0, 1, updateChan, true,
+	)
+	require.Empty(t, bob.fundingMgr.InboundReservations())
+
+	// Another channel exceeds the global limit, even though the per peer
+	// limit would allow it.
+	initReq := &InitFundingMsg{
+		Peer:            bob,
+		TargetPubkey:    bob.privKey.PubKey(),
+		ChainHash:       *fundingNetParams.GenesisHash,
+		LocalFundingAmt: 500000,
+		PushAmt:         lnwire.NewMSatFromSatoshis(0),
+		Updates:         make(chan *lnrpc.OpenStatusUpdate),
+		Err:             make(chan error, 1),
+	}
+	alice.fundingMgr.InitFundingWorkflow(initReq)
+	openChan := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	bob.fundingMgr.ProcessFundingMsg(openChan, alice)
+	assertFundingMsgSent(t, bob.msgChan, "Error")
+
+	// Once the funding transaction confirms, the channel is no longer
+	// pending.
+	alice.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{
+		Tx: fundingTx,
+	}
+	bob.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{
+		Tx: fundingTx,
+	}
+	assertMarkedOpen(t, alice, bob, fundingOutPoint)
+	assertFundingMsgSent(t, alice.msgChan, "FundingLocked")
+	assertFundingMsgSent(t, bob.msgChan, "FundingLocked")
+
+	// Now Bob accepts another channel.
+	bob.fundingMgr.ProcessFundingMsg(openChan, alice)
+	assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
+}
+
+// TestMaxPendingChansReputation checks that the number of pending channels
+// we allow for a peer is scaled by its reputation.
+func TestMaxPendingChansReputation(t *testing.T) {
+	t.Parallel()
+
+	peerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		penalty    int
+		flapCount  int
+		flapErr    error
+		maxPending int
+	}{
+		{
+			name:       "reputation disabled",
+			penalty:    0,
+			flapCount:  100,
+			maxPending: 4,
+		},
+		{
+			name:       "no flaps",
+			penalty:    10,
+			flapCount:  0,
+			maxPending: 4,
+		},
+		{
+			name:       "penalty reached",
+			penalty:    10,
+			flapCount:  10,
+			maxPending: 2,
+		},
+		{
+			name:       "many flaps",
+			penalty:    10,
+			flapCount:  100,
+			maxPending: 0,
+		},
+		{
+			name:       "flap count unknown",
+			penalty:    10,
+			flapErr:    errors.New("unknown"),
+			maxPending: 4,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			f := &Manager{
+				cfg: &Config{
+					MaxPendingChannels: 4,
+					PendingFlapPenalty: test.penalty,
+					FlapCount: func(route.Vertex) (int,
+						*time.Time, error) {
+
+						return test.flapCount, nil,
+							test.flapErr
+					},
+				},
+			}
+
+			require.Equal(
+				t, test.maxPending,
+				f.maxPendingChans(peerKey.PubKey()),
+			)
+		})
+	}
+}
+
 // TestFundingManagerRejectPush checks behaviour of 'rejectpush'
 // option, namely that non-zero incoming push amounts are disabled.
 func TestFundingManagerRejectPush(t *testing.T) {
@@ -0,0 +1,17 @@
+//go:build gofuzz
+// +build gofuzz
+
+package lnwirefuzz
+
+import (
+	"github.com/ltcsuite/lnd/lnwire"
+)
+
+// Fuzz_accept_channel2 is used by go-fuzz.
+func Fuzz_accept_channel2(data []byte) int {
+	// Prefix with MsgAcceptChannel2.
+	data = prefixWithMsgType(data, lnwire.MsgAcceptChannel2)
+
+	// Pass the message into our general fuzz harness for wire messages!
+	return harness(data)
+}
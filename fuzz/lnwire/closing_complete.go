@@ -0,0 +1,17 @@
+//go:build gofuzz
+// +build gofuzz
+
+package lnwirefuzz
+
+import (
+	"github.com/ltcsuite/lnd/lnwire"
+)
+
+// Fuzz_closing_complete is used by go-fuzz.
+func Fuzz_closing_complete(data []byte) int {
+	// Prefix with MsgClosingComplete.
+	data = prefixWithMsgType(data, lnwire.MsgClosingComplete)
+
+	// Pass the message into our general fuzz harness for wire messages!
+	return harness(data)
+}
@@ -0,0 +1,17 @@
+//go:build gofuzz
+// +build gofuzz
+
+package lnwirefuzz
+
+import (
+	"github.com/ltcsuite/lnd/lnwire"
+)
+
+// Fuzz_closing_sig is used by go-fuzz.
+func Fuzz_closing_sig(data []byte) int {
+	// Prefix with MsgClosingSig.
+	data = prefixWithMsgType(data, lnwire.MsgClosingSig)
+
+	// Pass the message into our general fuzz harness for wire messages!
+	return harness(data)
+}
@@ -0,0 +1,17 @@
+//go:build gofuzz
+// +build gofuzz
+
+package lnwirefuzz
+
+import (
+	"github.com/ltcsuite/lnd/lnwire"
+)
+
+// Fuzz_splice_ack is used by go-fuzz.
+func Fuzz_splice_ack(data []byte) int {
+	// Prefix with MsgSpliceAck.
+	data = prefixWithMsgType(data, lnwire.MsgSpliceAck)
+
+	// Pass the message into our general fuzz harness for wire messages!
+	return harness(data)
+}
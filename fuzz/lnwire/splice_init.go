@@ -0,0 +1,17 @@
+//go:build gofuzz
+// +build gofuzz
+
+package lnwirefuzz
+
+import (
+	"github.com/ltcsuite/lnd/lnwire"
+)
+
+// Fuzz_splice_init is used by go-fuzz.
+func Fuzz_splice_init(data []byte) int {
+	// Prefix with MsgSpliceInit.
+	data = prefixWithMsgType(data, lnwire.MsgSpliceInit)
+
+	// Pass the message into our general fuzz harness for wire messages!
+	return harness(data)
+}
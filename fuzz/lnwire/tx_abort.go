@@ -0,0 +1,17 @@
+//go:build gofuzz
+// +build gofuzz
+
+package lnwirefuzz
+
+import (
+	"github.com/ltcsuite/lnd/lnwire"
+)
+
+// Fuzz_tx_abort is used by go-fuzz.
+func Fuzz_tx_abort(data []byte) int {
+	// Prefix with MsgTxAbort.
+	data = prefixWithMsgType(data, lnwire.MsgTxAbort)
+
+	// Pass the message into our general fuzz harness for wire messages!
+	return harness(data)
+}
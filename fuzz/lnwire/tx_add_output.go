@@ -0,0 +1,17 @@
+//go:build gofuzz
+// +build gofuzz
+
+package lnwirefuzz
+
+import (
+	"github.com/ltcsuite/lnd/lnwire"
+)
+
+// Fuzz_tx_add_output is used by go-fuzz.
+func Fuzz_tx_add_output(data []byte) int {
+	// Prefix with MsgTxAddOutput.
+	data = prefixWithMsgType(data, lnwire.MsgTxAddOutput)
+
+	// Pass the message into our general fuzz harness for wire messages!
+	return harness(data)
+}
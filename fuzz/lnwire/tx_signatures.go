@@ -0,0 +1,17 @@
+//go:build gofuzz
+// +build gofuzz
+
+package lnwirefuzz
+
+import (
+	"github.com/ltcsuite/lnd/lnwire"
+)
+
+// Fuzz_tx_signatures is used by go-fuzz.
+func Fuzz_tx_signatures(data []byte) int {
+	// Prefix with MsgTxSignatures.
+	data = prefixWithMsgType(data, lnwire.MsgTxSignatures)
+
+	// Pass the message into our general fuzz harness for wire messages!
+	return harness(data)
+}
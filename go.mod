@@ -53,6 +53,7 @@ require (
 	github.com/ltcsuite/ltcwallet/wtxmgr v1.5.0
 	github.com/ltcsuite/neutrino v0.13.3-0.20220622053416-e267826b6b4d
 	github.com/mattn/go-runewidth v0.0.9 // indirect
+	github.com/mattn/go-sqlite3 v1.14.16
 	github.com/miekg/dns v1.1.43
 	github.com/ory/go-acc v0.2.6
 	github.com/prometheus/client_golang v1.11.0
@@ -84,16 +84,16 @@ func initTestExtracter() {
 // newOnionProcessor creates starts a new htlcswitch.OnionProcessor using a temp
 // db and no garbage collection.
 func newOnionProcessor(t *testing.T) *hop.OnionProcessor {
+	nodeKey := &keychain.PrivKeyECDH{PrivKey: sphinxPrivKey}
 	sphinxRouter := sphinx.NewRouter(
-		&keychain.PrivKeyECDH{PrivKey: sphinxPrivKey},
-		&bitcoinCfg.SimNetParams, sphinx.NewMemoryReplayLog(),
+		nodeKey, &bitcoinCfg.SimNetParams, sphinx.NewMemoryReplayLog(),
 	)
 
 	if err := sphinxRouter.Start(); err != nil {
 		t.Fatalf("unable to start sphinx router: %v", err)
 	}
 
-	return hop.NewOnionProcessor(sphinxRouter)
+	return hop.NewOnionProcessor(sphinxRouter, nodeKey)
 }
 
 // newCircuitMap creates a new htlcswitch.CircuitMap using a temp db and a
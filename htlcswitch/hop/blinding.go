@@ -0,0 +1,199 @@
+package hop
+
+import (
+	"bytes"
+	"fmt"
+
+	sphinx "github.com/ltcsuite/lightning-onion"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/record"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+)
+
+// BlindingKit contains the components required to extract the forwarding
+// instructions of a hop within a blinded route.
+type BlindingKit struct {
+	// NodeKey is our node's key, which is used to decrypt the blinded
+	// route data and derive the next blinding point.
+	NodeKey sphinx.SingleKeyECDH
+
+	// UpdateAddBlinding is the blinding point that was provided in the
+	// update_add_htlc message. It is set for all hops within a blinded
+	// route except for the introduction node.
+	UpdateAddBlinding *btcec.PublicKey
+
+	// IncomingCltv is the expiry of the incoming HTLC.
+	IncomingCltv uint32
+
+	// IncomingAmount is the amount of the incoming HTLC.
+	IncomingAmount lnwire.MilliSatoshi
+}
+
+// DecryptAndValidate decrypts the blinded route data contained in the passed
+// payload and replaces its forwarding information with the instructions that
+// the recipient provided for our hop. The finalHop boolean should be true if
+// the onion indicated that we are the exit hop.
+func (b *BlindingKit) DecryptAndValidate(payload *Payload,
+	finalHop bool) (*Payload, error) {
+
+	// Exactly one of the update_add_htlc message and the onion payload
+	// should provide us with a blinding point.
+	blindingPoint := b.UpdateAddBlinding
+	switch {
+	case blindingPoint != nil && payload.BlindingPoint != nil:
+		return nil, fmt.Errorf("%w: blinding point provided in both "+
+			"update_add_htlc and onion payload", ErrInvalidBlinding)
+
+	case payload.BlindingPoint != nil:
+		blindingPoint = payload.BlindingPoint
+
+	case blindingPoint == nil:
+		return nil, fmt.Errorf("%w: no blinding point provided",
+			ErrInvalidBlinding)
+	}
+
+	if b.NodeKey == nil {
+		return nil, fmt.Errorf("%w: route blinding not supported",
+			ErrInvalidBlinding)
+	}
+
+	plainText, err := blindedpath.DecryptBlindedHopData(
+		b.NodeKey, blindingPoint, payload.EncryptedData,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to decrypt blinded route "+
+			"data: %v", ErrInvalidBlinding, err)
+	}
+
+	routeData, err := record.DecodeBlindedRouteData(
+		bytes.NewReader(plainText),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to decode blinded route "+
+			"data: %v", ErrInvalidBlinding, err)
+	}
+
+	// The recipient may restrict the HTLCs that can be sent over its
+	// route, so that the route can't be probed with arbitrary amounts and
+	// expiries.
+	if constraints := routeData.Constraints; constraints != nil {
+		if b.IncomingCltv > constraints.MaxCltvExpiry {
+			return nil, fmt.Errorf("%w: incoming expiry %v exceeds "+
+				"maximum %v", ErrInvalidBlinding,
+				b.IncomingCltv, constraints.MaxCltvExpiry)
+		}
+
+		if b.IncomingAmount < constraints.HtlcMinimumMsat {
+			return nil, fmt.Errorf("%w: incoming amount %v below "+
+				"minimum %v", ErrInvalidBlinding,
+				b.IncomingAmount, constraints.HtlcMinimumMsat)
+		}
+	}
+
+	if finalHop {
+		return b.finalHopPayload(payload, routeData)
+	}
+
+	return b.forwardingPayload(payload, routeData, blindingPoint)
+}
+
+// finalHopPayload populates the payload of the final hop of a blinded route.
+// The path ID that we included in our own route data is the payment address
+// of the invoice that the route was created for, so we present it to the
+// invoice registry as an MPP record along with the total payment amount.
+func (b *BlindingKit) finalHopPayload(payload *Payload,
+	routeData *record.BlindedRouteData) (*Payload, error) {
+
+	var paymentAddr [32]byte
+	if len(routeData.PathID) != len(paymentAddr) {
+		return nil, fmt.Errorf("%w: invalid path id length %v",
+			ErrInvalidBlinding, len(routeData.PathID))
+	}
+	copy(paymentAddr[:], routeData.PathID)
+
+	payload.MPP = record.NewMPP(payload.TotalAmtMsat, paymentAddr)
+
+	return payload, nil
+}
+
+// forwardingPayload populates the forwarding information of an intermediate
+// hop in a blinded route. The amount and expiry of the outgoing HTLC are not
+// provided by the sender, so they are derived from the incoming HTLC using
+// the relay parameters that the recipient chose for our hop.
+func (b *BlindingKit) forwardingPayload(payload *Payload,
+	routeData *record.BlindedRouteData,
+	blindingPoint *btcec.PublicKey) (*Payload, error) {
+
+	// We only support forwarding to a channel, as we'd otherwise need to
+	// look up a channel with the next node.
+	if routeData.ShortChannelID == nil {
+		return nil, fmt.Errorf("%w: no outgoing channel provided",
+			ErrInvalidBlinding)
+	}
+
+	relayInfo := routeData.RelayInfo
+	if relayInfo == nil {
+		return nil, fmt.Errorf("%w: no relay info provided",
+			ErrInvalidBlinding)
+	}
+
+	fwdAmt, err := calculateForwardingAmount(
+		b.IncomingAmount, relayInfo.BaseFee, relayInfo.FeeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cltvDelta := uint32(relayInfo.CltvExpiryDelta)
+	if b.IncomingCltv < cltvDelta {
+		return nil, fmt.Errorf("%w: incoming expiry %v below cltv "+
+			"delta %v", ErrInvalidBlinding, b.IncomingCltv,
+			cltvDelta)
+	}
+
+	// The recipient may instruct us to hand a specific blinding point to
+	// the next hop, otherwise we derive it from our own.
+	nextBlinding := routeData.NextBlindingOverride
+	if nextBlinding == nil {
+		nextBlinding, err = blindedpath.NextBlindingPoint(
+			b.NodeKey, blindingPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unable to derive next "+
+				"blinding point: %v", ErrInvalidBlinding, err)
+		}
+	}
+
+	payload.FwdInfo = ForwardingInfo{
+		Network:         BitcoinNetwork,
+		NextHop:         *routeData.ShortChannelID,
+		AmountToForward: fwdAmt,
+		OutgoingCTLV:    b.IncomingCltv - cltvDelta,
+		NextBlinding:    nextBlinding,
+	}
+
+	return payload, nil
+}
+
+// calculateForwardingAmount calculates the amount to forward for a hop within
+// a blinded route, given the amount of the incoming HTLC and the fees that the
+// hop charges. The fee is expressed in terms of the outgoing amount, so we
+// invert the regular fee calculation, rounding up as specified in BOLT 04:
+//
+//	amt_to_forward = ceil((incoming - base_fee) * 1e6 / (1e6 + fee_rate))
+func calculateForwardingAmount(incomingAmount lnwire.MilliSatoshi, baseFee,
+	feeRate uint32) (lnwire.MilliSatoshi, error) {
+
+	if incomingAmount < lnwire.MilliSatoshi(baseFee) {
+		return 0, fmt.Errorf("%w: incoming amount %v less than base "+
+			"fee %v", ErrInvalidBlinding, incomingAmount, baseFee)
+	}
+
+	numerator := (uint64(incomingAmount) - uint64(baseFee)) * 1e6
+	denominator := 1e6 + uint64(feeRate)
+
+	return lnwire.MilliSatoshi(
+		(numerator + denominator - 1) / denominator,
+	), nil
+}
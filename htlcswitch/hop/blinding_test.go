@@ -0,0 +1,178 @@
+package hop
+
+import (
+	"errors"
+	"testing"
+
+	sphinx "github.com/ltcsuite/lightning-onion"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/record"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlindingKitDecryptAndValidate tests that forwarding instructions are
+// correctly extracted from the encrypted data of hops in a blinded route.
+func TestBlindingKitDecryptAndValidate(t *testing.T) {
+	t.Parallel()
+
+	relayPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	recipientPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	scid := lnwire.NewShortChanIDFromInt(1234)
+	relayData, err := record.EncodeBlindedRouteData(
+		&record.BlindedRouteData{
+			ShortChannelID: &scid,
+			RelayInfo: &record.PaymentRelayInfo{
+				CltvExpiryDelta: 40,
+				FeeRate:         1000,
+				BaseFee:         100,
+			},
+			Constraints: &record.PaymentConstraints{
+				MaxCltvExpiry:   1000,
+				HtlcMinimumMsat: 1000,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	var paymentAddr [32]byte
+	paymentAddr[0] = 1
+	recipientData, err := record.EncodeBlindedRouteData(
+		&record.BlindedRouteData{
+			PathID: paymentAddr[:],
+		},
+	)
+	require.NoError(t, err)
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	path, err := blindedpath.BuildBlindedPath(
+		sessionKey, []*blindedpath.HopInfo{
+			{
+				NodePub:   relayPriv.PubKey(),
+				PlainText: relayData,
+			},
+			{
+				NodePub:   recipientPriv.PubKey(),
+				PlainText: recipientData,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	// The introduction node receives its blinding point in the onion
+	// payload.
+	relayKit := BlindingKit{
+		NodeKey:        &sphinx.PrivKeyECDH{PrivKey: relayPriv},
+		IncomingCltv:   500,
+		IncomingAmount: 100100,
+	}
+	relayPayload, err := relayKit.DecryptAndValidate(&Payload{
+		EncryptedData: path.BlindedHops[0].CipherText,
+		BlindingPoint: path.BlindingPoint,
+	}, false)
+	require.NoError(t, err)
+
+	fwdInfo := relayPayload.ForwardingInfo()
+	require.Equal(t, scid, fwdInfo.NextHop)
+	require.Equal(t, lnwire.MilliSatoshi(99901), fwdInfo.AmountToForward)
+	require.Equal(t, uint32(460), fwdInfo.OutgoingCTLV)
+	require.NotNil(t, fwdInfo.NextBlinding)
+
+	// Providing the blinding point in both the onion and the
+	// update_add_htlc message is invalid.
+	relayKit.UpdateAddBlinding = path.BlindingPoint
+	_, err = relayKit.DecryptAndValidate(&Payload{
+		EncryptedData: path.BlindedHops[0].CipherText,
+		BlindingPoint: path.BlindingPoint,
+	}, false)
+	require.True(t, errors.Is(err, ErrInvalidBlinding))
+
+	// An HTLC that exceeds the maximum expiry should be rejected.
+	relayKit.UpdateAddBlinding = nil
+	relayKit.IncomingCltv = 1001
+	_, err = relayKit.DecryptAndValidate(&Payload{
+		EncryptedData: path.BlindedHops[0].CipherText,
+		BlindingPoint: path.BlindingPoint,
+	}, false)
+	require.True(t, errors.Is(err, ErrInvalidBlinding))
+
+	// The recipient receives its blinding point in the update_add_htlc
+	// message and should present the path ID as the payment address.
+	recipientKit := BlindingKit{
+		NodeKey:           &sphinx.PrivKeyECDH{PrivKey: recipientPriv},
+		UpdateAddBlinding: fwdInfo.NextBlinding,
+		IncomingCltv:      fwdInfo.OutgoingCTLV,
+		IncomingAmount:    fwdInfo.AmountToForward,
+	}
+	recipientPayload, err := recipientKit.DecryptAndValidate(&Payload{
+		EncryptedData: path.BlindedHops[1].CipherText,
+		TotalAmtMsat:  fwdInfo.AmountToForward,
+	}, true)
+	require.NoError(t, err)
+	require.NotNil(t, recipientPayload.MPP)
+	require.Equal(t, paymentAddr, recipientPayload.MPP.PaymentAddr())
+	require.Equal(
+		t, fwdInfo.AmountToForward, recipientPayload.MPP.TotalMsat(),
+	)
+}
+
+// TestCalculateForwardingAmount tests the derivation of the outgoing amount of
+// an HTLC forwarded within a blinded route.
+func TestCalculateForwardingAmount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		incoming    lnwire.MilliSatoshi
+		baseFee     uint32
+		feeRate     uint32
+		expected    lnwire.MilliSatoshi
+		expectedErr error
+	}{
+		{
+			name:     "no fees",
+			incoming: 100000,
+			expected: 100000,
+		},
+		{
+			name:     "base and proportional fee",
+			incoming: 100100,
+			baseFee:  100,
+			feeRate:  1000,
+			expected: 99901,
+		},
+		{
+			name:     "rounded up",
+			incoming: 1000,
+			feeRate:  300000,
+			expected: 770,
+		},
+		{
+			name:        "insufficient for base fee",
+			incoming:    10,
+			baseFee:     100,
+			expectedErr: ErrInvalidBlinding,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			amt, err := calculateForwardingAmount(
+				test.incoming, test.baseFee, test.feeRate,
+			)
+			require.True(t, errors.Is(err, test.expectedErr))
+			require.Equal(t, test.expected, amt)
+		})
+	}
+}
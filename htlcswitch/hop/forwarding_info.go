@@ -2,6 +2,7 @@ package hop
 
 import (
 	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 )
 
 // ForwardingInfo contains all the information that is necessary to forward and
@@ -26,4 +27,9 @@ type ForwardingInfo struct {
 	// OutgoingCTLV is the specified value of the CTLV timelock to be used
 	// in the outgoing HTLC.
 	OutgoingCTLV uint32
+
+	// NextBlinding is the blinding point that should be sent to the next
+	// hop in the update_add_htlc message. It is only set when forwarding
+	// within a blinded route.
+	NextBlinding *btcec.PublicKey
 }
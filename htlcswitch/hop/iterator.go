@@ -8,6 +8,7 @@ import (
 
 	sphinx "github.com/ltcsuite/lightning-onion"
 	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 )
 
@@ -47,16 +48,23 @@ type sphinxHopIterator struct {
 	// includes the information required to properly forward the packet to
 	// the next hop.
 	processedPacket *sphinx.ProcessedPacket
+
+	// blindingKit holds the components required to extract forwarding
+	// instructions from the payload if we are a hop within a blinded
+	// route.
+	blindingKit BlindingKit
 }
 
 // makeSphinxHopIterator converts a processed packet returned from a sphinx
 // router and converts it into an hop iterator for usage in the link.
 func makeSphinxHopIterator(ogPacket *sphinx.OnionPacket,
-	packet *sphinx.ProcessedPacket) *sphinxHopIterator {
+	packet *sphinx.ProcessedPacket,
+	blindingKit BlindingKit) *sphinxHopIterator {
 
 	return &sphinxHopIterator{
 		ogPacket:        ogPacket,
 		processedPacket: packet,
+		blindingKit:     blindingKit,
 	}
 }
 
@@ -90,9 +98,28 @@ func (r *sphinxHopIterator) HopPayload() (*Payload, error) {
 	// Otherwise, if this is the TLV payload, then we'll make a new stream
 	// to decode only what we need to make routing decisions.
 	case sphinx.PayloadTLV:
-		return NewPayloadFromReader(bytes.NewReader(
+		finalHop := r.processedPacket.Action == sphinx.ExitNode
+		payload, err := NewPayloadFromReader(bytes.NewReader(
 			r.processedPacket.Payload.Payload,
-		))
+		), finalHop)
+		if err != nil {
+			return nil, err
+		}
+
+		// If the payload doesn't contain any encrypted data, we
+		// aren't part of a blinded route, in which case we should not
+		// have been given a blinding point either.
+		if payload.EncryptedData == nil {
+			if r.blindingKit.UpdateAddBlinding != nil {
+				return nil, fmt.Errorf("%w: blinding point "+
+					"provided without encrypted data",
+					ErrInvalidBlinding)
+			}
+
+			return payload, nil
+		}
+
+		return r.blindingKit.DecryptAndValidate(payload, finalHop)
 
 	default:
 		return nil, fmt.Errorf("unknown sphinx payload type: %v",
@@ -123,11 +150,22 @@ func (r *sphinxHopIterator) ExtractErrorEncrypter(
 // tests dependent from the sphinx internal parts.
 type OnionProcessor struct {
 	router *sphinx.Router
+
+	// nodeKey is the key used by the router to process onions. It is also
+	// used to process HTLCs that are received over blinded routes, which
+	// can't be done by the router itself.
+	nodeKey sphinx.SingleKeyECDH
 }
 
-// NewOnionProcessor creates new instance of decoder.
-func NewOnionProcessor(router *sphinx.Router) *OnionProcessor {
-	return &OnionProcessor{router}
+// NewOnionProcessor creates new instance of decoder. The node key should be
+// the same key that the passed router was created with.
+func NewOnionProcessor(router *sphinx.Router,
+	nodeKey sphinx.SingleKeyECDH) *OnionProcessor {
+
+	return &OnionProcessor{
+		router:  router,
+		nodeKey: nodeKey,
+	}
 }
 
 // Start spins up the onion processor's sphinx router.
@@ -185,20 +223,53 @@ func (p *OnionProcessor) DecodeHopIterator(r io.Reader, rHash []byte,
 		}
 	}
 
-	return makeSphinxHopIterator(onionPkt, sphinxPacket), lnwire.CodeNone
+	blindingKit := BlindingKit{
+		NodeKey:      p.nodeKey,
+		IncomingCltv: incomingCltv,
+	}
+
+	return makeSphinxHopIterator(onionPkt, sphinxPacket, blindingKit),
+		lnwire.CodeNone
+}
+
+// ReconstructBlindingInfo contains the information required to reconstruct
+// the hop iterator of an HTLC that was received over a blinded route.
+type ReconstructBlindingInfo struct {
+	// BlindingKey is the blinding point that was provided in the
+	// update_add_htlc message, if any.
+	BlindingKey *btcec.PublicKey
+
+	// IncomingAmt is the amount of the incoming HTLC.
+	IncomingAmt lnwire.MilliSatoshi
+
+	// IncomingExpiry is the expiry of the incoming HTLC.
+	IncomingExpiry uint32
 }
 
 // ReconstructHopIterator attempts to decode a valid sphinx packet from the passed io.Reader
 // instance using the rHash as the associated data when checking the relevant
 // MACs during the decoding process.
-func (p *OnionProcessor) ReconstructHopIterator(r io.Reader, rHash []byte) (
-	Iterator, error) {
+func (p *OnionProcessor) ReconstructHopIterator(r io.Reader, rHash []byte,
+	blindingInfo ReconstructBlindingInfo) (Iterator, error) {
 
 	onionPkt := &sphinx.OnionPacket{}
 	if err := onionPkt.Decode(r); err != nil {
 		return nil, err
 	}
 
+	// If the HTLC was received over a blinded route, the onion was
+	// encrypted to our blinded node ID.
+	var ogEphemeralKey *btcec.PublicKey
+	if blindingInfo.BlindingKey != nil {
+		var err error
+		ogEphemeralKey, err = p.blindOnionKey(
+			onionPkt, blindingInfo.BlindingKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Attempt to process the Sphinx packet. We include the payment hash of
 	// the HTLC as it's authenticated within the Sphinx packet itself as
 	// associated data in order to thwart attempts a replay attacks. In the
@@ -209,7 +280,72 @@ func (p *OnionProcessor) ReconstructHopIterator(r io.Reader, rHash []byte) (
 		return nil, err
 	}
 
-	return makeSphinxHopIterator(onionPkt, sphinxPacket), nil
+	if ogEphemeralKey != nil {
+		err := p.fixNextEphemeralKey(
+			onionPkt, sphinxPacket, ogEphemeralKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	blindingKit := BlindingKit{
+		NodeKey:           p.nodeKey,
+		UpdateAddBlinding: blindingInfo.BlindingKey,
+		IncomingCltv:      blindingInfo.IncomingExpiry,
+		IncomingAmount:    blindingInfo.IncomingAmt,
+	}
+
+	return makeSphinxHopIterator(onionPkt, sphinxPacket, blindingKit), nil
+}
+
+// blindOnionKey replaces the ephemeral key of an onion packet that was
+// received over a blinded route with a key that allows the sphinx router to
+// derive the shared secret that the sender used for our blinded node ID. The
+// original ephemeral key is returned, as it is required to derive the
+// ephemeral key of the next hop once the packet has been processed.
+func (p *OnionProcessor) blindOnionKey(onionPkt *sphinx.OnionPacket,
+	blindingPoint *btcec.PublicKey) (*btcec.PublicKey, error) {
+
+	if p.nodeKey == nil {
+		return nil, ErrInvalidBlinding
+	}
+
+	tweakedKey, err := blindedpath.TweakOnionKey(
+		p.nodeKey, blindingPoint, onionPkt.EphemeralKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ogEphemeralKey := onionPkt.EphemeralKey
+	onionPkt.EphemeralKey = tweakedKey
+
+	return ogEphemeralKey, nil
+}
+
+// fixNextEphemeralKey corrects the ephemeral key of the onion packet destined
+// for the next hop after processing a packet that was received over a blinded
+// route. The sphinx router derives it from the tweaked key that we provided,
+// whereas the sender derived it from the original ephemeral key.
+func (p *OnionProcessor) fixNextEphemeralKey(onionPkt *sphinx.OnionPacket,
+	packet *sphinx.ProcessedPacket, ogEphemeralKey *btcec.PublicKey) error {
+
+	// The final hop doesn't have a next packet to forward.
+	if packet.NextPacket == nil {
+		return nil
+	}
+
+	sharedSecret, err := p.nodeKey.ECDH(onionPkt.EphemeralKey)
+	if err != nil {
+		return err
+	}
+
+	packet.NextPacket.EphemeralKey = blindedpath.NextEphemeral(
+		ogEphemeralKey, sharedSecret,
+	)
+
+	return nil
 }
 
 // DecodeHopIteratorRequest encapsulates all date necessary to process an onion
@@ -219,6 +355,14 @@ type DecodeHopIteratorRequest struct {
 	OnionReader  io.Reader
 	RHash        []byte
 	IncomingCltv uint32
+
+	// IncomingAmount is the amount of the incoming HTLC, which is used
+	// to derive the forwarding amount within a blinded route.
+	IncomingAmount lnwire.MilliSatoshi
+
+	// BlindingPoint is the blinding point provided in the update_add_htlc
+	// message, which is set if the HTLC was received over a blinded route.
+	BlindingPoint *btcec.PublicKey
 }
 
 // DecodeHopIteratorResponse encapsulates the outcome of a batched sphinx onion
@@ -251,6 +395,10 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 		batchSize = len(reqs)
 		onionPkts = make([]sphinx.OnionPacket, batchSize)
 		resps     = make([]DecodeHopIteratorResponse, batchSize)
+
+		// ogEphemeralKeys holds the original ephemeral keys of any
+		// packets that were received over a blinded route.
+		ogEphemeralKeys = make([]*btcec.PublicKey, batchSize)
 	)
 
 	tx := p.router.BeginTxn(id, batchSize)
@@ -274,6 +422,17 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 			return lnwire.CodeInvalidOnionKey
 		}
 
+		// If the HTLC was received over a blinded route, we'll need
+		// to blind the onion's ephemeral key before processing it.
+		if req.BlindingPoint != nil {
+			ogKey, err := p.blindOnionKey(onionPkt, req.BlindingPoint)
+			if err != nil {
+				log.Errorf("unable to blind onion key: %v", err)
+				return lnwire.CodeInvalidBlinding
+			}
+			ogEphemeralKeys[seqNum] = ogKey
+		}
+
 		err = tx.ProcessOnionPacket(
 			seqNum, onionPkt, req.RHash, req.IncomingCltv,
 		)
@@ -366,9 +525,31 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 			continue
 		}
 
+		// For packets that were received over a blinded route, we'll
+		// need to restore the ephemeral key that the next hop expects.
+		if ogEphemeralKeys[i] != nil {
+			err := p.fixNextEphemeralKey(
+				&onionPkts[i], &packets[i], ogEphemeralKeys[i],
+			)
+			if err != nil {
+				log.Errorf("unable to derive next ephemeral "+
+					"key: %v", err)
+				resp.FailCode = lnwire.CodeInvalidBlinding
+				continue
+			}
+		}
+
 		// Finally, construct a hop iterator from our processed sphinx
 		// packet, simultaneously caching the original onion packet.
-		resp.HopIterator = makeSphinxHopIterator(&onionPkts[i], &packets[i])
+		blindingKit := BlindingKit{
+			NodeKey:           p.nodeKey,
+			UpdateAddBlinding: reqs[i].BlindingPoint,
+			IncomingCltv:      reqs[i].IncomingCltv,
+			IncomingAmount:    reqs[i].IncomingAmount,
+		}
+		resp.HopIterator = makeSphinxHopIterator(
+			&onionPkts[i], &packets[i], blindingKit,
+		)
 	}
 
 	return resps, nil
@@ -2,6 +2,7 @@ package hop
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/record"
 	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 )
 
 // PayloadViolation is an enum encapsulating the possible invalid payload
@@ -46,6 +48,13 @@ func (v PayloadViolation) String() string {
 	}
 }
 
+// ErrInvalidBlinding is returned when a hop in a blinded route is unable to
+// process the blinded route data provided to it, or when an HTLC does not
+// satisfy the constraints that the recipient placed on it. Hops within a
+// blinded route should not reveal the exact cause of the failure to the
+// sender, so all such failures are reported using this error.
+var ErrInvalidBlinding = errors.New("invalid blinded route")
+
 // ErrInvalidPayload is an error returned when a parsed onion payload either
 // included or omitted incorrect records for a particular hop type.
 type ErrInvalidPayload struct {
@@ -90,6 +99,20 @@ type Payload struct {
 	// a TLV onion payload.
 	AMP *record.AMP
 
+	// EncryptedData holds the blinded route data that the recipient of a
+	// payment encrypted for this hop. It is only set for hops within a
+	// blinded route.
+	EncryptedData []byte
+
+	// BlindingPoint is the blinding point provided to the introduction
+	// node of a blinded route. Other hops in the route receive their
+	// blinding point in the update_add_htlc message instead.
+	BlindingPoint *btcec.PublicKey
+
+	// TotalAmtMsat is the total amount of a payment made to a blinded
+	// route, which is provided to the final hop of the route.
+	TotalAmtMsat lnwire.MilliSatoshi
+
 	// customRecords are user-defined records in the custom type range that
 	// were included in the payload.
 	customRecords record.CustomSet
@@ -112,14 +135,20 @@ func NewLegacyPayload(f *sphinx.HopData) *Payload {
 }
 
 // NewPayloadFromReader builds a new Hop from the passed io.Reader. The reader
-// should correspond to the bytes encapsulated in a TLV onion payload.
-func NewPayloadFromReader(r io.Reader) (*Payload, error) {
+// should correspond to the bytes encapsulated in a TLV onion payload. The
+// finalHop boolean should be true if the onion indicated that we are the exit
+// hop, which is required to validate payloads of hops within a blinded route
+// as they don't include a next hop.
+func NewPayloadFromReader(r io.Reader, finalHop bool) (*Payload, error) {
 	var (
-		cid  uint64
-		amt  uint64
-		cltv uint32
-		mpp  = &record.MPP{}
-		amp  = &record.AMP{}
+		cid           uint64
+		amt           uint64
+		cltv          uint32
+		totalAmtMsat  uint64
+		encryptedData []byte
+		blindingPoint *btcec.PublicKey
+		mpp           = &record.MPP{}
+		amp           = &record.AMP{}
 	)
 
 	tlvStream, err := tlv.NewStream(
@@ -127,7 +156,10 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		record.NewLockTimeRecord(&cltv),
 		record.NewNextHopIDRecord(&cid),
 		mpp.Record(),
+		record.NewEncryptedDataRecord(&encryptedData),
+		record.NewBlindingPointRecord(&blindingPoint),
 		amp.Record(),
+		record.NewTotalAmtMsatBlindedRecord(&totalAmtMsat),
 	)
 	if err != nil {
 		return nil, err
@@ -139,9 +171,16 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 	}
 
 	// Validate whether the sender properly included or omitted tlv records
-	// in accordance with BOLT 04.
+	// in accordance with BOLT 04. Hops within a blinded route receive
+	// their forwarding instructions in the encrypted data, so they are
+	// subject to a different set of rules.
 	nextHop := lnwire.NewShortChanIDFromInt(cid)
-	err = ValidateParsedPayloadTypes(parsedTypes, nextHop)
+	_, isBlinded := parsedTypes[record.EncryptedDataOnionType]
+	if isBlinded {
+		err = ValidateBlindedPayloadTypes(parsedTypes, finalHop)
+	} else {
+		err = ValidateParsedPayloadTypes(parsedTypes, nextHop)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -168,6 +207,12 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		amp = nil
 	}
 
+	// If no blinding point was parsed, set the field on the resulting
+	// payload to nil.
+	if _, ok := parsedTypes[record.BlindingPointOnionType]; !ok {
+		blindingPoint = nil
+	}
+
 	// Filter out the custom records.
 	customRecords := NewCustomRecords(parsedTypes)
 
@@ -180,10 +225,18 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		},
 		MPP:           mpp,
 		AMP:           amp,
+		EncryptedData: encryptedData,
+		BlindingPoint: blindingPoint,
+		TotalAmtMsat:  lnwire.MilliSatoshi(totalAmtMsat),
 		customRecords: customRecords,
 	}, nil
 }
 
+// Blinded returns true if the payload was received over a blinded route.
+func (h *Payload) Blinded() bool {
+	return len(h.EncryptedData) > 0
+}
+
 // ForwardingInfo returns the basic parameters required for HTLC forwarding,
 // e.g. amount, cltv, and next hop.
 func (h *Payload) ForwardingInfo() ForwardingInfo {
@@ -217,6 +270,8 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 	_, hasNextHop := parsedTypes[record.NextHopOnionType]
 	_, hasMPP := parsedTypes[record.MPPOnionType]
 	_, hasAMP := parsedTypes[record.AMPOnionType]
+	_, hasBlindingPoint := parsedTypes[record.BlindingPointOnionType]
+	_, hasTotalAmt := parsedTypes[record.TotalAmtMsatBlindedType]
 
 	switch {
 
@@ -261,6 +316,117 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 			Violation: IncludedViolation,
 			FinalHop:  isFinalHop,
 		}
+
+	// A blinding point is only useful alongside encrypted data, so it
+	// should not be provided outside of a blinded route.
+	case hasBlindingPoint:
+		return ErrInvalidPayload{
+			Type:      record.BlindingPointOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// The total amount record is reserved for the final hop of blinded
+	// routes, regular payments use the MPP record instead.
+	case hasTotalAmt:
+		return ErrInvalidPayload{
+			Type:      record.TotalAmtMsatBlindedType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+	}
+
+	return nil
+}
+
+// ValidateBlindedPayloadTypes checks the types parsed from the payload of a hop
+// within a blinded route to ensure that the proper fields are either included
+// or omitted. Intermediate hops receive their forwarding instructions in the
+// encrypted data, so they must not be given any in the clear. The final hop
+// must be told the amount and expiry of the HTLC, along with the total amount
+// of the payment. Violations are wrapped in ErrInvalidBlinding, as the sender
+// should not learn which hop in the route failed the payment.
+func ValidateBlindedPayloadTypes(parsedTypes tlv.TypeMap,
+	finalHop bool) error {
+
+	_, hasAmt := parsedTypes[record.AmtOnionType]
+	_, hasLockTime := parsedTypes[record.LockTimeOnionType]
+	_, hasNextHop := parsedTypes[record.NextHopOnionType]
+	_, hasMPP := parsedTypes[record.MPPOnionType]
+	_, hasAMP := parsedTypes[record.AMPOnionType]
+	_, hasTotalAmt := parsedTypes[record.TotalAmtMsatBlindedType]
+
+	var violation *ErrInvalidPayload
+	switch {
+
+	// The next hop is always provided in the encrypted data.
+	case hasNextHop:
+		violation = &ErrInvalidPayload{
+			Type:      record.NextHopOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  finalHop,
+		}
+
+	// Payments to blinded routes use the total amount record and the path
+	// ID in the encrypted data rather than MPP fields.
+	case hasMPP:
+		violation = &ErrInvalidPayload{
+			Type:      record.MPPOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  finalHop,
+		}
+
+	case hasAMP:
+		violation = &ErrInvalidPayload{
+			Type:      record.AMPOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  finalHop,
+		}
+
+	// Intermediate hops derive the amount and expiry of the outgoing HTLC
+	// from the relay parameters in the encrypted data.
+	case !finalHop && hasAmt:
+		violation = &ErrInvalidPayload{
+			Type:      record.AmtOnionType,
+			Violation: IncludedViolation,
+		}
+
+	case !finalHop && hasLockTime:
+		violation = &ErrInvalidPayload{
+			Type:      record.LockTimeOnionType,
+			Violation: IncludedViolation,
+		}
+
+	case !finalHop && hasTotalAmt:
+		violation = &ErrInvalidPayload{
+			Type:      record.TotalAmtMsatBlindedType,
+			Violation: IncludedViolation,
+		}
+
+	case finalHop && !hasAmt:
+		violation = &ErrInvalidPayload{
+			Type:      record.AmtOnionType,
+			Violation: OmittedViolation,
+			FinalHop:  true,
+		}
+
+	case finalHop && !hasLockTime:
+		violation = &ErrInvalidPayload{
+			Type:      record.LockTimeOnionType,
+			Violation: OmittedViolation,
+			FinalHop:  true,
+		}
+
+	case finalHop && !hasTotalAmt:
+		violation = &ErrInvalidPayload{
+			Type:      record.TotalAmtMsatBlindedType,
+			Violation: OmittedViolation,
+			FinalHop:  true,
+		}
+	}
+
+	if violation != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidBlinding, violation)
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package hop_test
 
 import (
 	"bytes"
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -16,6 +17,7 @@ const testUnknownRequiredType = 0x10
 type decodePayloadTest struct {
 	name             string
 	payload          []byte
+	finalHop         bool
 	expErr           error
 	expCustomRecords map[uint64][]byte
 	shouldHaveMPP    bool
@@ -258,6 +260,82 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 		shouldHaveAMP: true,
 	},
+	{
+		name: "blinding point without encrypted data",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// blinding point
+			0x0c, 0x21,
+			0x02, 0xee, 0xc7, 0x24, 0x5d, 0x6b, 0x7d, 0x2c,
+			0xcb, 0x30, 0x38, 0x0b, 0xfb, 0xe2, 0xa3, 0x64,
+			0x8c, 0xd7, 0xa9, 0x42, 0x65, 0x3f, 0x5a, 0xa3,
+			0x40, 0xed, 0xce, 0xa1, 0xf2, 0x83, 0x68, 0x66,
+			0x19,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.BlindingPointOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  true,
+		},
+	},
+	{
+		name: "blinded intermediate hop valid",
+		payload: []byte{
+			// encrypted data
+			0x0a, 0x02, 0x01, 0x02,
+		},
+	},
+	{
+		name: "blinded intermediate hop with amount",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// encrypted data
+			0x0a, 0x02, 0x01, 0x02,
+		},
+		expErr: fmt.Errorf("%w: %v", hop.ErrInvalidBlinding,
+			&hop.ErrInvalidPayload{
+				Type:      record.AmtOnionType,
+				Violation: hop.IncludedViolation,
+			},
+		),
+	},
+	{
+		name: "blinded final hop valid",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// encrypted data
+			0x0a, 0x02, 0x01, 0x02,
+			// total amount
+			0x12, 0x00,
+		},
+		finalHop: true,
+	},
+	{
+		name: "blinded final hop no total amount",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// encrypted data
+			0x0a, 0x02, 0x01, 0x02,
+		},
+		finalHop: true,
+		expErr: fmt.Errorf("%w: %v", hop.ErrInvalidBlinding,
+			&hop.ErrInvalidPayload{
+				Type:      record.TotalAmtMsatBlindedType,
+				Violation: hop.OmittedViolation,
+				FinalHop:  true,
+			},
+		),
+	},
 }
 
 // TestDecodeHopPayloadRecordValidation asserts that parsing the payloads in the
@@ -296,7 +374,9 @@ func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
 		testChildIndex = uint32(9)
 	)
 
-	p, err := hop.NewPayloadFromReader(bytes.NewReader(test.payload))
+	p, err := hop.NewPayloadFromReader(
+		bytes.NewReader(test.payload), test.finalHop,
+	)
 	if !reflect.DeepEqual(test.expErr, err) {
 		t.Fatalf("expected error mismatch, want: %v, got: %v",
 			test.expErr, err)
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/go-errors/errors"
+	"github.com/ltcsuite/lnd/chainntnfs"
 	"github.com/ltcsuite/lnd/channeldb"
 	"github.com/ltcsuite/lnd/htlcswitch/hop"
 	"github.com/ltcsuite/lnd/lntypes"
@@ -15,6 +16,10 @@ var (
 	// ErrFwdNotExists is an error returned when the caller tries to resolve
 	// a forward that doesn't exist anymore.
 	ErrFwdNotExists = errors.New("forward does not exist")
+
+	// ErrUnsupportedFailureCode is returned when an intercepted forward is
+	// failed with a failure code that requires details we can't provide.
+	ErrUnsupportedFailureCode = errors.New("unsupported failure code")
 )
 
 // InterceptableSwitch is an implementation of ForwardingSwitch interface.
@@ -22,32 +27,254 @@ var (
 // intercepts forward requests. A reference to the Switch is held in order
 // to communicate back the interception result where the options are:
 // Resume - forwards the original request to the switch as is.
+// ResumeModified - forwards a modified request to the switch.
 // Settle - routes UpdateFulfillHTLC to the originating link.
 // Fail - routes UpdateFailHTLC to the originating link.
+//
+// The forwards that are held by the interceptor are tracked by the
+// InterceptableSwitch. If the interceptor goes away, they are either resumed,
+// or kept held and replayed to the next interceptor that is set, in which
+// case forwards are held even while no interceptor is set. Held forwards are
+// failed back once their incoming htlc gets close to its expiry.
 type InterceptableSwitch struct {
+	started sync.Once
+	stopped sync.Once
+
 	sync.RWMutex
 
 	// htlcSwitch is the underline switch
 	htlcSwitch *Switch
 
+	// notifier is used to be notified of new blocks, in order to fail back
+	// held forwards that are about to expire. If nil, held forwards aren't
+	// failed back automatically.
+	notifier chainntnfs.ChainNotifier
+
+	// requireInterceptor indicates whether forwards are held while no
+	// interceptor is set, rather than being forwarded.
+	requireInterceptor bool
+
+	// heldFailDelta is the number of blocks before the expiry of the
+	// incoming htlc at which a held forward is failed back.
+	heldFailDelta uint32
+
 	// fwdInterceptor is the callback that is called for each forward of
 	// an incoming htlc. It should return true if it is interested in handling
 	// it.
 	fwdInterceptor ForwardInterceptor
+
+	// heldForwards is the set of forwards that are currently held, keyed
+	// by their incoming circuit.
+	heldForwards map[channeldb.CircuitKey]*interceptedForward
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// InterceptableSwitchConfig houses the configuration of an
+// InterceptableSwitch.
+type InterceptableSwitchConfig struct {
+	// Switch is the switch that forwards are passed on to.
+	Switch *Switch
+
+	// Notifier is used to be notified of new blocks, in order to fail back
+	// held forwards that are about to expire. If nil, held forwards aren't
+	// failed back automatically.
+	Notifier chainntnfs.ChainNotifier
+
+	// RequireInterceptor indicates whether forwards should be held while no
+	// interceptor is set. If true, held forwards remain held when the
+	// interceptor goes away, and are replayed to the next interceptor. If
+	// false, forwards are resumed in that case.
+	RequireInterceptor bool
+
+	// HeldFailDelta is the number of blocks before the expiry of the
+	// incoming htlc at which a held forward is failed back.
+	HeldFailDelta uint32
 }
 
 // NewInterceptableSwitch returns an instance of InterceptableSwitch.
-func NewInterceptableSwitch(s *Switch) *InterceptableSwitch {
-	return &InterceptableSwitch{htlcSwitch: s}
+func NewInterceptableSwitch(
+	cfg *InterceptableSwitchConfig) *InterceptableSwitch {
+
+	return &InterceptableSwitch{
+		htlcSwitch:         cfg.Switch,
+		notifier:           cfg.Notifier,
+		requireInterceptor: cfg.RequireInterceptor,
+		heldFailDelta:      cfg.HeldFailDelta,
+		heldForwards: make(
+			map[channeldb.CircuitKey]*interceptedForward,
+		),
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts failing back held forwards that are about to expire.
+func (s *InterceptableSwitch) Start() error {
+	var err error
+	s.started.Do(func() {
+		if s.notifier == nil {
+			return
+		}
+
+		var blockEpochs *chainntnfs.BlockEpochEvent
+		blockEpochs, err = s.notifier.RegisterBlockEpochNtfn(nil)
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go s.blockHandler(blockEpochs)
+	})
+
+	return err
+}
+
+// Stop stops the InterceptableSwitch. Held forwards remain held.
+func (s *InterceptableSwitch) Stop() error {
+	s.stopped.Do(func() {
+		close(s.quit)
+		s.wg.Wait()
+	})
+
+	return nil
+}
+
+// blockHandler fails back the held forwards that are about to expire on every
+// new block.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *InterceptableSwitch) blockHandler(
+	blockEpochs *chainntnfs.BlockEpochEvent) {
+
+	defer s.wg.Done()
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			s.failExpiringForwards(uint32(epoch.Height))
+
+		case <-s.quit:
+			return
+		}
+	}
 }
 
-// SetInterceptor sets the ForwardInterceptor to be used.
+// failExpiringForwards fails back all held forwards whose incoming htlc
+// expires within heldFailDelta blocks of the given height.
+func (s *InterceptableSwitch) failExpiringForwards(height uint32) {
+	var expiring []*interceptedForward
+
+	s.RLock()
+	for _, fwd := range s.heldForwards {
+		if fwd.packet.incomingTimeout <= height+s.heldFailDelta {
+			expiring = append(expiring, fwd)
+		}
+	}
+	s.RUnlock()
+
+	for _, fwd := range expiring {
+		packet, _ := fwd.heldPacket()
+		log.Infof("Failing back held forward %v expiring at height %v",
+			fwd.circuitKey(), packet.incomingTimeout)
+
+		// The forward might have been resolved in the meantime, in
+		// which case there's nothing left to do.
+		err := fwd.Fail()
+		if err != nil && err != ErrFwdNotExists {
+			log.Errorf("Unable to fail back held forward %v: %v",
+				fwd.circuitKey(), err)
+		}
+	}
+}
+
+// SetInterceptor sets the ForwardInterceptor to be used. The forwards that are
+// currently held are replayed to the new interceptor. If the interceptor is
+// removed, the held forwards are resumed, unless an interceptor is required.
 func (s *InterceptableSwitch) SetInterceptor(
 	interceptor ForwardInterceptor) {
 
 	s.Lock()
-	defer s.Unlock()
 	s.fwdInterceptor = interceptor
+	held := make([]*interceptedForward, 0, len(s.heldForwards))
+	for _, fwd := range s.heldForwards {
+		held = append(held, fwd)
+	}
+	s.Unlock()
+
+	if interceptor != nil {
+		if len(held) == 0 {
+			return
+		}
+
+		// The interceptor might only be able to accept the forwards
+		// once this call returns, so we replay them in the
+		// background.
+		go func() {
+			for _, fwd := range held {
+				if !interceptor(fwd) {
+					return
+				}
+			}
+		}()
+
+		return
+	}
+
+	if s.requireInterceptor {
+		log.Infof("Interceptor removed, keeping %d forwards held",
+			len(held))
+		return
+	}
+
+	for _, fwd := range held {
+		err := fwd.Resume()
+		if err != nil && err != ErrFwdNotExists {
+			log.Errorf("Failed to resume held forward %v: %v",
+				fwd.circuitKey(), err)
+		}
+	}
+}
+
+// holdForward adds the forward to the set of held forwards. False is returned
+// if a forward of the same incoming htlc is held already. In that case the
+// forward is replayed by a restarted link, so the held forward takes over its
+// packet and link quit signal, as the ones of the previous link are stale.
+func (s *InterceptableSwitch) holdForward(fwd *interceptedForward) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	key := fwd.circuitKey()
+	if held, ok := s.heldForwards[key]; ok {
+		held.linkQuit = fwd.linkQuit
+		held.packet = fwd.packet
+		held.htlc = fwd.htlc
+
+		return false
+	}
+	s.heldForwards[key] = fwd
+
+	return true
+}
+
+// releaseForward removes the forward from the set of held forwards. False is
+// returned if the forward isn't held, as it has been resolved already.
+func (s *InterceptableSwitch) releaseForward(fwd *interceptedForward) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	key := fwd.circuitKey()
+	if s.heldForwards[key] != fwd {
+		return false
+	}
+	delete(s.heldForwards, key)
+
+	return true
 }
 
 // ForwardPackets attempts to forward the batch of htlcs through the
@@ -63,7 +290,7 @@ func (s *InterceptableSwitch) ForwardPackets(linkQuit chan struct{},
 	s.Unlock()
 
 	// Optimize for the case we don't have an interceptor.
-	if interceptor == nil {
+	if interceptor == nil && !s.requireInterceptor {
 		return s.htlcSwitch.ForwardPackets(linkQuit, packets...)
 	}
 
@@ -91,14 +318,41 @@ func (s *InterceptableSwitch) interceptForward(packet *htlcPacket,
 		}
 
 		intercepted := &interceptedForward{
-			linkQuit:   linkQuit,
-			htlc:       htlc,
-			packet:     packet,
-			htlcSwitch: s.htlcSwitch,
+			incomingCircuit: channeldb.CircuitKey{
+				ChanID: packet.incomingChanID,
+				HtlcID: packet.incomingHTLCID,
+			},
+			linkQuit:            linkQuit,
+			htlc:                htlc,
+			packet:              packet,
+			htlcSwitch:          s.htlcSwitch,
+			interceptableSwitch: s,
+		}
+
+		// If the forward is held already, the interceptor knows about
+		// it, so there's nothing left to do after the held forward has
+		// been updated.
+		if !s.holdForward(intercepted) {
+			return true
+		}
+
+		// If an interceptor is required, the forward remains held even
+		// if there's no interceptor to hand it to right now. It will
+		// be replayed to the next interceptor that is set.
+		if s.requireInterceptor {
+			if interceptor != nil {
+				interceptor(intercepted)
+			}
+			return true
 		}
 
 		// If this htlc was intercepted, don't handle the forward.
-		return interceptor(intercepted)
+		if interceptor(intercepted) {
+			return true
+		}
+		s.releaseForward(intercepted)
+
+		return false
 	default:
 		return false
 	}
@@ -108,47 +362,170 @@ func (s *InterceptableSwitch) interceptForward(packet *htlcPacket,
 // It is passed from the switch to external interceptors that are interested
 // in holding forwards and resolve them manually.
 type interceptedForward struct {
-	linkQuit   chan struct{}
-	htlc       *lnwire.UpdateAddHTLC
-	packet     *htlcPacket
-	htlcSwitch *Switch
+	incomingCircuit channeldb.CircuitKey
+
+	// linkQuit, htlc and packet are replaced under the lock of the
+	// interceptable switch when the forward is replayed while held. They
+	// must only be accessed directly once the forward has been released.
+	linkQuit chan struct{}
+	htlc     *lnwire.UpdateAddHTLC
+	packet   *htlcPacket
+
+	htlcSwitch          *Switch
+	interceptableSwitch *InterceptableSwitch
+}
+
+// circuitKey returns the incoming circuit of the forward.
+func (f *interceptedForward) circuitKey() channeldb.CircuitKey {
+	return f.incomingCircuit
+}
+
+// heldPacket returns the current packet and htlc of the forward, which may be
+// replaced while the forward is held.
+func (f *interceptedForward) heldPacket() (*htlcPacket,
+	*lnwire.UpdateAddHTLC) {
+
+	f.interceptableSwitch.RLock()
+	defer f.interceptableSwitch.RUnlock()
+
+	return f.packet, f.htlc
+}
+
+// release marks the forward as resolved. False is returned if it has been
+// resolved already.
+func (f *interceptedForward) release() bool {
+	return f.interceptableSwitch.releaseForward(f)
 }
 
 // Packet returns the intercepted htlc packet.
 func (f *interceptedForward) Packet() InterceptedPacket {
+	packet, htlc := f.heldPacket()
+
 	return InterceptedPacket{
-		IncomingCircuit: channeldb.CircuitKey{
-			ChanID: f.packet.incomingChanID,
-			HtlcID: f.packet.incomingHTLCID,
-		},
-		OutgoingChanID: f.packet.outgoingChanID,
-		Hash:           f.htlc.PaymentHash,
-		OutgoingExpiry: f.htlc.Expiry,
-		OutgoingAmount: f.htlc.Amount,
-		IncomingAmount: f.packet.incomingAmount,
-		IncomingExpiry: f.packet.incomingTimeout,
-		CustomRecords:  f.packet.customRecords,
-		OnionBlob:      f.htlc.OnionBlob,
+		IncomingCircuit: f.circuitKey(),
+		OutgoingChanID:  packet.outgoingChanID,
+		Hash:            htlc.PaymentHash,
+		OutgoingExpiry:  htlc.Expiry,
+		OutgoingAmount:  htlc.Amount,
+		IncomingAmount:  packet.incomingAmount,
+		IncomingExpiry:  packet.incomingTimeout,
+		CustomRecords:   packet.customRecords,
+		OnionBlob:       htlc.OnionBlob,
 	}
 }
 
 // Resume resumes the default behavior as if the packet was not intercepted.
 func (f *interceptedForward) Resume() error {
+	if !f.release() {
+		return ErrFwdNotExists
+	}
+
+	return f.htlcSwitch.ForwardPackets(f.linkQuit, f.packet)
+}
+
+// ResumeModified applies the given modifications to the packet, and forwards
+// it to the switch. The modified htlc is checked against the policy of the
+// outgoing channel first, so that the caller learns about a violation rather
+// than the htlc being failed back.
+func (f *interceptedForward) ResumeModified(mods ResumeModifications) error {
+	packet, htlc := f.heldPacket()
+
+	outgoingChanID := packet.outgoingChanID
+	if mods.OutgoingChanID != nil {
+		outgoingChanID = *mods.OutgoingChanID
+	}
+	amount := htlc.Amount
+	if mods.OutgoingAmount != nil {
+		amount = *mods.OutgoingAmount
+	}
+
+	if amount == 0 {
+		return errors.New("outgoing amount must be positive")
+	}
+	if amount > packet.incomingAmount {
+		return fmt.Errorf("outgoing amount %v exceeds incoming amount "+
+			"%v", amount, packet.incomingAmount)
+	}
+
+	link, err := f.htlcSwitch.GetLinkByShortID(outgoingChanID)
+	if err != nil {
+		return fmt.Errorf("unable to find outgoing channel %v: %v",
+			outgoingChanID, err)
+	}
+	linkErr := link.CheckHtlcForward(
+		htlc.PaymentHash, packet.incomingAmount, amount,
+		packet.incomingTimeout, packet.outgoingTimeout,
+		f.htlcSwitch.BestHeight(),
+	)
+	if linkErr != nil {
+		return fmt.Errorf("modified htlc violates policy of channel "+
+			"%v: %v", outgoingChanID, linkErr)
+	}
+
+	if !f.release() {
+		return ErrFwdNotExists
+	}
+
+	f.packet.outgoingChanID = outgoingChanID
+	f.packet.amount = amount
+	f.htlc.Amount = amount
+	if mods.OnionBlob != nil {
+		f.htlc.OnionBlob = *mods.OnionBlob
+	}
+
 	return f.htlcSwitch.ForwardPackets(f.linkQuit, f.packet)
 }
 
 // Fail forward a failed packet to the switch.
 func (f *interceptedForward) Fail() error {
-	update, err := f.htlcSwitch.cfg.FetchLastChannelUpdate(
-		f.packet.incomingChanID,
-	)
-	if err != nil {
-		return err
+	return f.FailWithCode(lnwire.CodeTemporaryChannelFailure)
+}
+
+// FailWithCode forwards a packet that is failed with the given failure code to
+// the switch. Only failure codes that don't require details of the onion
+// payload are supported.
+func (f *interceptedForward) FailWithCode(code lnwire.FailCode) error {
+	packet, _ := f.heldPacket()
+
+	var failure lnwire.FailureMessage
+	switch code {
+	case lnwire.CodeTemporaryChannelFailure:
+		update, err := f.htlcSwitch.cfg.FetchLastChannelUpdate(
+			packet.incomingChanID,
+		)
+		if err != nil {
+			return err
+		}
+		failure = lnwire.NewTemporaryChannelFailure(update)
+
+	case lnwire.CodeIncorrectOrUnknownPaymentDetails:
+		failure = lnwire.NewFailIncorrectDetails(
+			packet.incomingAmount, f.htlcSwitch.BestHeight(),
+		)
+
+	case lnwire.CodeTemporaryNodeFailure:
+		failure = &lnwire.FailTemporaryNodeFailure{}
+
+	case lnwire.CodePermanentNodeFailure:
+		failure = &lnwire.FailPermanentNodeFailure{}
+
+	case lnwire.CodePermanentChannelFailure:
+		failure = &lnwire.FailPermanentChannelFailure{}
+
+	case lnwire.CodeRequiredNodeFeatureMissing:
+		failure = &lnwire.FailRequiredNodeFeatureMissing{}
+
+	case lnwire.CodeRequiredChannelFeatureMissing:
+		failure = &lnwire.FailRequiredChannelFeatureMissing{}
+
+	case lnwire.CodeUnknownNextPeer:
+		failure = &lnwire.FailUnknownNextPeer{}
+
+	default:
+		return ErrUnsupportedFailureCode
 	}
 
-	reason, err := f.packet.obfuscator.EncryptFirstHop(
-		lnwire.NewTemporaryChannelFailure(update),
-	)
+	reason, err := packet.obfuscator.EncryptFirstHop(failure)
 	if err != nil {
 		return fmt.Errorf("failed to encrypt failure reason %v", err)
 	}
@@ -159,7 +536,8 @@ func (f *interceptedForward) Fail() error {
 
 // Settle forwards a settled packet to the switch.
 func (f *interceptedForward) Settle(preimage lntypes.Preimage) error {
-	if !preimage.Matches(f.htlc.PaymentHash) {
+	_, htlc := f.heldPacket()
+	if !preimage.Matches(htlc.PaymentHash) {
 		return errors.New("preimage does not match hash")
 	}
 	return f.resolve(&lnwire.UpdateFulfillHTLC{
@@ -170,6 +548,10 @@ func (f *interceptedForward) Settle(preimage lntypes.Preimage) error {
 // resolve is used for both Settle and Fail and forwards the message to the
 // switch.
 func (f *interceptedForward) resolve(message lnwire.Message) error {
+	if !f.release() {
+		return ErrFwdNotExists
+	}
+
 	pkt := &htlcPacket{
 		incomingChanID: f.packet.incomingChanID,
 		incomingHTLCID: f.packet.incomingHTLCID,
@@ -279,11 +279,27 @@ type InterceptedPacket struct {
 	OnionBlob [lnwire.OnionPacketSize]byte
 }
 
+// ResumeModifications describes the changes an interceptor makes to a held
+// forward before resuming it. Fields that are nil are left unchanged.
+type ResumeModifications struct {
+	// OutgoingAmount is the amount to forward instead of the one requested
+	// by the sender.
+	OutgoingAmount *lnwire.MilliSatoshi
+
+	// OutgoingChanID is the channel to forward the htlc over instead of
+	// the one requested by the sender.
+	OutgoingChanID *lnwire.ShortChannelID
+
+	// OnionBlob is the onion packet to pass on to the next hop instead of
+	// the one we received.
+	OnionBlob *[lnwire.OnionPacketSize]byte
+}
+
 // InterceptedForward is passed to the ForwardInterceptor for every forwarded
 // htlc. It contains all the information about the packet which accordingly
 // the interceptor decides if to hold or not.
 // In addition this interface allows a later resolution by calling either
-// Resume, Settle or Fail.
+// Resume, ResumeModified, Settle, Fail or FailWithCode.
 type InterceptedForward interface {
 	// Packet returns the intercepted packet.
 	Packet() InterceptedPacket
@@ -293,12 +309,22 @@ type InterceptedForward interface {
 	// this htlc which usually means forward it.
 	Resume() error
 
+	// ResumeModified notifies the intention to resume an existing hold
+	// forward with the given modifications. An error is returned without
+	// resuming the forward if the modified htlc violates the policy of the
+	// outgoing channel.
+	ResumeModified(ResumeModifications) error
+
 	// Settle notifies the intention to settle an existing hold
 	// forward with a given preimage.
 	Settle(lntypes.Preimage) error
 
 	// Fails notifies the intention to fail an existing hold forward
 	Fail() error
+
+	// FailWithCode notifies the intention to fail an existing hold forward
+	// with the given failure code.
+	FailWithCode(lnwire.FailCode) error
 }
 
 // htlcNotifier is an interface which represents the input side of the
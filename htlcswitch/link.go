@@ -3,6 +3,7 @@ package htlcswitch
 import (
 	"bytes"
 	"crypto/sha256"
+	goErrors "errors"
 	"fmt"
 	prand "math/rand"
 	"sync"
@@ -1721,6 +1722,11 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			failure = &lnwire.FailInvalidOnionKey{
 				OnionSHA256: msg.ShaOnionBlob,
 			}
+
+		case lnwire.CodeInvalidBlinding:
+			failure = &lnwire.FailInvalidBlinding{
+				OnionSHA256: msg.ShaOnionBlob,
+			}
 		default:
 			l.log.Warnf("unexpected failure code received in "+
 				"UpdateFailMailformedHTLC: %v", msg.FailureCode)
@@ -1799,7 +1805,7 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		// We just received a new updates to our local commitment
 		// chain, validate this new commitment, closing the link if
 		// invalid.
-		err = l.channel.ReceiveNewCommitment(msg.CommitSig, msg.HtlcSigs)
+		err = l.channel.ReceiveCommitSig(msg)
 		if err != nil {
 			// If we were unable to reconstruct their proposed
 			// commitment, then we'll examine the type of error. If
@@ -2101,10 +2107,13 @@ func (l *channelLink) updateCommitTx() error {
 		return ErrLinkShuttingDown
 	}
 
+	// If the channel has a pending splice, the signatures for the splice
+	// version of the new commitment are sent along.
 	commitSig := &lnwire.CommitSig{
-		ChanID:    l.ChanID(),
-		CommitSig: theirCommitSig,
-		HtlcSigs:  htlcSigs,
+		ChanID:     l.ChanID(),
+		CommitSig:  theirCommitSig,
+		HtlcSigs:   htlcSigs,
+		SpliceSigs: l.channel.PendingSpliceSigs(),
 	}
 	l.cfg.Peer.SendMessage(false, commitSig)
 
@@ -2735,9 +2744,11 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			onionReader := bytes.NewReader(pd.OnionBlob)
 
 			req := hop.DecodeHopIteratorRequest{
-				OnionReader:  onionReader,
-				RHash:        pd.RHash[:],
-				IncomingCltv: pd.Timeout,
+				OnionReader:    onionReader,
+				RHash:          pd.RHash[:],
+				IncomingCltv:   pd.Timeout,
+				IncomingAmount: pd.Amount,
+				BlindingPoint:  pd.BlindingPoint,
 			}
 
 			decodeReqs = append(decodeReqs, req)
@@ -2821,7 +2832,32 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 		heightNow := l.cfg.BestHeight()
 
 		pld, err := chanIterator.HopPayload()
-		if err != nil {
+		switch {
+		// If the HTLC is part of a blinded route, we shouldn't reveal
+		// why we were unable to process it. If we're the introduction
+		// node, the sender already knows our identity so we can send
+		// an encrypted error. Otherwise, we'll fail the HTLC as
+		// malformed so that the failure is converted by the previous
+		// hop.
+		case goErrors.Is(err, hop.ErrInvalidBlinding):
+			l.log.Errorf("unable to process blinded hop "+
+				"payload: %v", err)
+
+			if pd.BlindingPoint != nil {
+				l.sendMalformedHTLCError(
+					pd.HtlcIndex, lnwire.CodeInvalidBlinding,
+					onionBlob[:], pd.SourceRef,
+				)
+				continue
+			}
+
+			failure := lnwire.NewInvalidBlinding(onionBlob[:])
+			l.sendHTLCError(
+				pd, NewLinkError(failure), obfuscator, false,
+			)
+			continue
+
+		case err != nil:
 			// If we're unable to process the onion payload, or we
 			// received invalid onion payload failure, then we
 			// should send an error back to the caller so the HTLC
@@ -2888,9 +2924,10 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				// Otherwise, it was already processed, we can
 				// can collect it and continue.
 				addMsg := &lnwire.UpdateAddHTLC{
-					Expiry:      fwdInfo.OutgoingCTLV,
-					Amount:      fwdInfo.AmountToForward,
-					PaymentHash: pd.RHash,
+					Expiry:        fwdInfo.OutgoingCTLV,
+					Amount:        fwdInfo.AmountToForward,
+					PaymentHash:   pd.RHash,
+					BlindingPoint: fwdInfo.NextBlinding,
 				}
 
 				// Finally, we'll encode the onion packet for
@@ -2930,9 +2967,10 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// create the outgoing HTLC using the parameters as
 			// specified in the forwarding info.
 			addMsg := &lnwire.UpdateAddHTLC{
-				Expiry:      fwdInfo.OutgoingCTLV,
-				Amount:      fwdInfo.AmountToForward,
-				PaymentHash: pd.RHash,
+				Expiry:        fwdInfo.OutgoingCTLV,
+				Amount:        fwdInfo.AmountToForward,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: fwdInfo.NextBlinding,
 			}
 
 			// Finally, we'll encode the onion packet for the
@@ -3017,7 +3055,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 // returns a boolean indicating whether the commitment tx needs an update.
 func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 	obfuscator hop.ErrorEncrypter, fwdInfo hop.ForwardingInfo,
-	heightNow uint32, payload invoices.Payload) error {
+	heightNow uint32, payload *hop.Payload) error {
 
 	// If hodl.ExitSettle is requested, we will not validate the final hop's
 	// ADD, nor will we settle the corresponding invoice or respond with the
@@ -3030,8 +3068,14 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 
 	// As we're the exit hop, we'll double check the hop-payload included in
 	// the HTLC to ensure that it was crafted correctly by the sender and
-	// matches the HTLC we were extended.
-	if pd.Amount != fwdInfo.AmountToForward {
+	// matches the HTLC we were extended. The sender of a payment over a
+	// blinded route only knows the aggregate relay parameters of the
+	// route, so we only require that it didn't deliver less than it
+	// committed to.
+	blinded := payload.Blinded()
+	if pd.Amount != fwdInfo.AmountToForward &&
+		!(blinded && pd.Amount > fwdInfo.AmountToForward) {
+
 
 		l.log.Errorf("onion payload of incoming htlc(%x) has incorrect "+
 			"value: expected %v, got %v", pd.RHash,
@@ -3047,7 +3091,9 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 
 	// We'll also ensure that our time-lock value has been computed
 	// correctly.
-	if pd.Timeout != fwdInfo.OutgoingCTLV {
+	if pd.Timeout != fwdInfo.OutgoingCTLV &&
+		!(blinded && pd.Timeout > fwdInfo.OutgoingCTLV) {
+
 		l.log.Errorf("onion payload of incoming htlc(%x) has incorrect "+
 			"time-lock: expected %v, got %v",
 			pd.RHash[:], pd.Timeout, fwdInfo.OutgoingCTLV)
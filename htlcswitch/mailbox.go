@@ -794,9 +794,13 @@ type mailOrchestrator struct {
 	mailboxes map[lnwire.ChannelID]MailBox
 
 	// liveIndex maps a live short chan id to the primary mailbox key.
-	// An index in liveIndex map is only entered under two conditions:
+	// An index in liveIndex map is only entered under three conditions:
 	//   1. A link has a non-zero short channel id at time of AddLink.
 	//   2. A link receives a non-zero short channel via UpdateShortChanID.
+	//   3. A link is given a new alias via AddAliasForLink.
+	// Besides a link's base short chan id, the index also holds the
+	// aliases of zero-conf and option-scid-alias channels, all of which
+	// map to the same mailbox.
 	liveIndex map[lnwire.ShortChannelID]lnwire.ChannelID
 
 	// TODO(conner): add another pair of indexes:
@@ -199,6 +199,11 @@ func initSwitchWithDB(startingHeight uint32, db *channeldb.DB) (*Switch, error)
 		Clock:          clock.NewDefaultClock(),
 		HTLCExpiry:     time.Hour,
 		DustThreshold:  DefaultDustThreshold,
+		GetAliases: func(
+			lnwire.ShortChannelID) []lnwire.ShortChannelID {
+
+			return nil
+		},
 	}
 
 	return New(cfg, startingHeight)
@@ -134,6 +134,11 @@ type Config struct {
 	// persistent circuit map.
 	DB kvdb.Backend
 
+	// GetAliases returns the set of aliases for the given base short
+	// channel ID of a link. HTLCs that use one of these aliases as their
+	// outgoing channel are forwarded to the link.
+	GetAliases func(base lnwire.ShortChannelID) []lnwire.ShortChannelID
+
 	// FetchAllOpenChannels is a function that fetches all currently open
 	// channels from the channel database.
 	FetchAllOpenChannels func() ([]*channeldb.OpenChannel, error)
@@ -1025,8 +1030,19 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 		// allow forwards of this nature, we fail the htlc early. This
 		// check is in place to disallow inefficiently routed htlcs from
 		// locking up our balance.
+		//
+		// The outgoing channel may be referred to by one of its
+		// aliases, so we compare against the base short channel ID of
+		// its link if we have one.
+		s.indexMtx.RLock()
+		outgoingChanID := packet.outgoingChanID
+		if link, err := s.getLinkByShortID(outgoingChanID); err == nil {
+			outgoingChanID = link.ShortChanID()
+		}
+		s.indexMtx.RUnlock()
+
 		linkErr := checkCircularForward(
-			packet.incomingChanID, packet.outgoingChanID,
+			packet.incomingChanID, outgoingChanID,
 			s.cfg.AllowCircularRoute, htlc.PaymentHash,
 		)
 		if linkErr != nil {
@@ -1103,7 +1119,7 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			// At this point, some or all of the links rejected the
 			// HTLC so we couldn't forward it. So we'll try to look
 			// up the error that came from the source.
-			linkErr, ok := linkErrs[packet.outgoingChanID]
+			linkErr, ok := linkErrs[targetLink.ShortChanID()]
 			if !ok {
 				// If we can't find the error of the source,
 				// then we'll return an unknown next peer,
@@ -2084,6 +2100,11 @@ func (s *Switch) AddLink(link ChannelLink) error {
 		s.mailOrchestrator.BindLiveShortChanID(
 			mailbox, chanID, shortChanID,
 		)
+		for _, alias := range s.cfg.GetAliases(shortChanID) {
+			s.mailOrchestrator.BindLiveShortChanID(
+				mailbox, chanID, alias,
+			)
+		}
 	}
 
 	return nil
@@ -2099,6 +2120,11 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 	s.linkIndex[link.ChanID()] = link
 	s.forwardingIndex[link.ShortChanID()] = link
 
+	// Any aliases of the link can be used to forward HTLCs to it as well.
+	for _, alias := range s.cfg.GetAliases(link.ShortChanID()) {
+		s.forwardingIndex[alias] = link
+	}
+
 	// Next we'll add the link to the interface index so we can
 	// quickly look up all the channels for a particular node.
 	peerPub := link.Peer().PubKey()
@@ -2198,6 +2224,9 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 	delete(s.pendingLinkIndex, link.ChanID())
 	delete(s.linkIndex, link.ChanID())
 	delete(s.forwardingIndex, link.ShortChanID())
+	for _, alias := range s.cfg.GetAliases(link.ShortChanID()) {
+		delete(s.forwardingIndex, alias)
+	}
 
 	// If the link has been added to the peer index, then we'll move to
 	// delete the entry within the index.
@@ -2259,6 +2288,35 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	s.mailOrchestrator.BindLiveShortChanID(
 		mailbox, chanID, shortChanID,
 	)
+	for _, alias := range s.cfg.GetAliases(shortChanID) {
+		s.mailOrchestrator.BindLiveShortChanID(mailbox, chanID, alias)
+	}
+
+	return nil
+}
+
+// AddAliasForLink makes an additional alias of an active link known to the
+// switch, so that HTLCs using the alias as their outgoing channel are
+// forwarded to the link.
+func (s *Switch) AddAliasForLink(chanID lnwire.ChannelID,
+	alias lnwire.ShortChannelID) error {
+
+	s.indexMtx.Lock()
+	defer s.indexMtx.Unlock()
+
+	link, ok := s.linkIndex[chanID]
+	if !ok {
+		return fmt.Errorf("link %v not found", chanID)
+	}
+
+	s.forwardingIndex[alias] = link
+
+	mailbox := s.mailOrchestrator.GetOrCreateMailBox(
+		chanID, link.ShortChanID(),
+	)
+	s.mailOrchestrator.BindLiveShortChanID(mailbox, chanID, alias)
+
+	log.Infof("Added alias %v for ChannelLink(%v)", alias, chanID)
 
 	return nil
 }
@@ -1,19 +1,24 @@
 package htlcswitch
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/ltcsuite/lnd/aliasmgr"
+	"github.com/ltcsuite/lnd/chainntnfs"
 	"github.com/ltcsuite/lnd/channeldb"
 	"github.com/ltcsuite/lnd/htlcswitch/hodl"
 	"github.com/ltcsuite/lnd/htlcswitch/hop"
+	"github.com/ltcsuite/lnd/lntest/mock"
 	"github.com/ltcsuite/lnd/lntypes"
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/ticker"
@@ -399,6 +404,86 @@ func TestSwitchForward(t *testing.T) {
 	}
 }
 
+// TestSwitchForwardAlias tests that HTLCs using an alias of a link as their
+// outgoing channel are forwarded to that link, both for aliases known when
+// the link is added and for ones added later via AddAliasForLink.
+func TestSwitchForwardAlias(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+	bobPeer, err := newMockServer(
+		t, "bob", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	require.NoError(t, err)
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	alias1 := aliasmgr.StartingAlias
+	alias2 := lnwire.NewShortChanIDFromInt(alias1.ToUint64() + 1)
+	s.cfg.GetAliases = func(
+		base lnwire.ShortChannelID) []lnwire.ShortChannelID {
+
+		if base == bobChanID {
+			return []lnwire.ShortChannelID{alias1}
+		}
+
+		return nil
+	}
+
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	require.NoError(t, s.AddLink(aliceChannelLink))
+	require.NoError(t, s.AddLink(bobChannelLink))
+
+	// The second alias only becomes known once it's added explicitly.
+	_, err = s.GetLinkByShortID(alias2)
+	require.ErrorIs(t, err, ErrChannelLinkNotFound)
+	require.NoError(t, s.AddAliasForLink(chanID2, alias2))
+
+	for i, alias := range []lnwire.ShortChannelID{alias1, alias2} {
+		preimage, err := genPreimage()
+		require.NoError(t, err)
+		rhash := sha256.Sum256(preimage[:])
+
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: uint64(i),
+			outgoingChanID: alias,
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1,
+			},
+		}
+		require.NoError(t, s.ForwardPackets(nil, packet))
+
+		select {
+		case pkt := <-bobChannelLink.packets:
+			require.Equal(t, bobChanID, pkt.outgoingChanID)
+		case <-time.After(time.Second):
+			t.Fatalf("htlc using alias %v was not forwarded", alias)
+		}
+	}
+
+	// Once the link is removed, its aliases shouldn't resolve anymore.
+	s.RemoveLink(chanID2)
+	_, err = s.GetLinkByShortID(alias1)
+	require.ErrorIs(t, err, ErrChannelLinkNotFound)
+}
+
 func TestSwitchForwardFailAfterFullAdd(t *testing.T) {
 	t.Parallel()
 
@@ -3160,6 +3245,16 @@ func (m *mockForwardInterceptor) resume() error {
 	return m.intercepted.Resume()
 }
 
+func (m *mockForwardInterceptor) resumeModified(
+	mods ResumeModifications) error {
+
+	return m.intercepted.ResumeModified(mods)
+}
+
+func (m *mockForwardInterceptor) failWithCode(code lnwire.FailCode) error {
+	return m.intercepted.FailWithCode(code)
+}
+
 func assertNumCircuits(t *testing.T, s *Switch, pending, opened int) {
 	if s.circuits.NumPending() != pending {
 		t.Fatal("wrong amount of half circuits")
@@ -3259,7 +3354,9 @@ func TestSwitchHoldForward(t *testing.T) {
 	}
 
 	forwardInterceptor := &mockForwardInterceptor{}
-	switchForwardInterceptor := NewInterceptableSwitch(s)
+	switchForwardInterceptor := NewInterceptableSwitch(
+		&InterceptableSwitchConfig{Switch: s},
+	)
 	switchForwardInterceptor.SetInterceptor(forwardInterceptor.InterceptForwardHtlc)
 	linkQuit := make(chan struct{})
 
@@ -3321,6 +3418,346 @@ func TestSwitchHoldForward(t *testing.T) {
 	assertNumCircuits(t, s, 0, 0)
 }
 
+// TestSwitchHoldForwardModified tests that a held forward can be resumed with
+// a modified amount, outgoing channel and onion, and failed with a specific
+// failure code.
+func TestSwitchHoldForwardModified(t *testing.T) {
+	t.Parallel()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+	chanID3, carolChanID := genID()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+	bobPeer, err := newMockServer(
+		t, "bob", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+	carolPeer, err := newMockServer(
+		t, "carol", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	tempPath, err := ioutil.TempDir("", "circuitdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempPath)
+
+	cdb, err := channeldb.Open(tempPath)
+	require.NoError(t, err)
+	defer cdb.Close()
+
+	s, err := initSwitchWithDB(testStartingHeight, cdb)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	carolChannelLink := newMockChannelLink(
+		s, chanID3, carolChanID, carolPeer, true,
+	)
+	require.NoError(t, s.AddLink(aliceChannelLink))
+	require.NoError(t, s.AddLink(bobChannelLink))
+	require.NoError(t, s.AddLink(carolChannelLink))
+
+	// Create a request which should be forwarded from Alice's channel link
+	// to Bob's channel link.
+	preimage := [sha256.Size]byte{1}
+	rhash := sha256.Sum256(preimage[:])
+	newPacket := func() *htlcPacket {
+		return &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: 0,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			incomingAmount: 1000,
+			amount:         1000,
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1000,
+			},
+		}
+	}
+
+	forwardInterceptor := &mockForwardInterceptor{}
+	switchForwardInterceptor := NewInterceptableSwitch(
+		&InterceptableSwitchConfig{Switch: s},
+	)
+	switchForwardInterceptor.SetInterceptor(
+		forwardInterceptor.InterceptForwardHtlc,
+	)
+	linkQuit := make(chan struct{})
+
+	err = switchForwardInterceptor.ForwardPackets(linkQuit, newPacket())
+	require.NoError(t, err)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	// We can't forward more than we received.
+	amt := lnwire.MilliSatoshi(1001)
+	err = forwardInterceptor.resumeModified(ResumeModifications{
+		OutgoingAmount: &amt,
+	})
+	require.Error(t, err)
+
+	// Neither can we forward over a channel that doesn't exist.
+	unknownChanID := lnwire.NewShortChanIDFromInt(1 << 40)
+	err = forwardInterceptor.resumeModified(ResumeModifications{
+		OutgoingChanID: &unknownChanID,
+	})
+	require.Error(t, err)
+
+	// If the policy of the new outgoing channel is violated, the forward
+	// is held as well.
+	carolChannelLink.checkHtlcForwardResult = NewLinkError(
+		&lnwire.FailFeeInsufficient{},
+	)
+	err = forwardInterceptor.resumeModified(ResumeModifications{
+		OutgoingChanID: &carolChanID,
+	})
+	require.Error(t, err)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+	assertOutgoingLinkReceive(t, carolChannelLink, false)
+	assertNumCircuits(t, s, 0, 0)
+
+	// Once the policy is satisfied, the modified htlc is forwarded over
+	// Carol's channel instead.
+	carolChannelLink.checkHtlcForwardResult = nil
+	amt = 900
+	onionBlob := [lnwire.OnionPacketSize]byte{1, 2, 3}
+	err = forwardInterceptor.resumeModified(ResumeModifications{
+		OutgoingAmount: &amt,
+		OutgoingChanID: &carolChanID,
+		OnionBlob:      &onionBlob,
+	})
+	require.NoError(t, err)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	select {
+	case packet := <-carolChannelLink.packets:
+		require.Equal(t, amt, packet.amount)
+		htlc := packet.htlc.(*lnwire.UpdateAddHTLC)
+		require.Equal(t, amt, htlc.Amount)
+		require.Equal(t, onionBlob, htlc.OnionBlob)
+		require.NoError(t, carolChannelLink.completeCircuit(packet))
+
+	case <-time.After(time.Second):
+		t.Fatal("modified forward was not propagated to carol")
+	}
+	assertNumCircuits(t, s, 1, 1)
+
+	// Settle the htlc to close the circuit.
+	settle := &htlcPacket{
+		outgoingChanID: carolChannelLink.ShortChanID(),
+		outgoingHTLCID: 0,
+		amount:         amt,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+		},
+	}
+	err = switchForwardInterceptor.ForwardPackets(linkQuit, settle)
+	require.NoError(t, err)
+	assertOutgoingLinkReceive(t, aliceChannelLink, true)
+	assertNumCircuits(t, s, 0, 0)
+
+	// Finally, fail a held forward with a specific failure code.
+	err = switchForwardInterceptor.ForwardPackets(linkQuit, newPacket())
+	require.NoError(t, err)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	err = forwardInterceptor.failWithCode(lnwire.CodeFeeInsufficient)
+	require.Equal(t, ErrUnsupportedFailureCode, err)
+
+	err = forwardInterceptor.failWithCode(lnwire.CodeTemporaryNodeFailure)
+	require.NoError(t, err)
+
+	select {
+	case packet := <-aliceChannelLink.packets:
+		fail := packet.htlc.(*lnwire.UpdateFailHTLC)
+		failure, err := lnwire.DecodeFailure(
+			bytes.NewReader(fail.Reason), 0,
+		)
+		require.NoError(t, err)
+		require.IsType(t, &lnwire.FailTemporaryNodeFailure{}, failure)
+
+	case <-time.After(time.Second):
+		t.Fatal("failure was not propagated to alice")
+	}
+	assertNumCircuits(t, s, 0, 0)
+}
+
+// TestSwitchHoldForwardRequireInterceptor tests that forwards are held while
+// no interceptor is set if an interceptor is required, that they're replayed
+// to the next interceptor, resumed through the incoming link after it
+// restarted, and failed back once they're about to expire.
+func TestSwitchHoldForwardRequireInterceptor(t *testing.T) {
+	t.Parallel()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+	bobPeer, err := newMockServer(
+		t, "bob", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	tempPath, err := ioutil.TempDir("", "circuitdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempPath)
+
+	cdb, err := channeldb.Open(tempPath)
+	require.NoError(t, err)
+	defer cdb.Close()
+
+	s, err := initSwitchWithDB(testStartingHeight, cdb)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	require.NoError(t, s.AddLink(aliceChannelLink))
+	require.NoError(t, s.AddLink(bobChannelLink))
+
+	const (
+		heldFailDelta = 10
+		expiry        = testStartingHeight + 20
+	)
+	notifier := &mock.ChainNotifier{
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+	}
+	switchForwardInterceptor := NewInterceptableSwitch(
+		&InterceptableSwitchConfig{
+			Switch:             s,
+			Notifier:           notifier,
+			RequireInterceptor: true,
+			HeldFailDelta:      heldFailDelta,
+		},
+	)
+	require.NoError(t, switchForwardInterceptor.Start())
+	defer func() {
+		require.NoError(t, switchForwardInterceptor.Stop())
+	}()
+
+	preimage := [sha256.Size]byte{1}
+	rhash := sha256.Sum256(preimage[:])
+	newPacket := func(htlcID uint64) *htlcPacket {
+		return &htlcPacket{
+			incomingChanID:  aliceChannelLink.ShortChanID(),
+			incomingHTLCID:  htlcID,
+			outgoingChanID:  bobChannelLink.ShortChanID(),
+			incomingTimeout: expiry,
+			obfuscator:      NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1,
+			},
+		}
+	}
+
+	intercepted := make(chan InterceptedForward, 2)
+	interceptor := func(fwd InterceptedForward) bool {
+		intercepted <- fwd
+		return true
+	}
+	receiveIntercepted := func() InterceptedForward {
+		select {
+		case fwd := <-intercepted:
+			return fwd
+
+		case <-time.After(time.Second):
+			t.Fatal("forward not passed to interceptor")
+			return nil
+		}
+	}
+
+	// Without an interceptor, the forward is held rather than forwarded.
+	linkQuit := make(chan struct{})
+	err = switchForwardInterceptor.ForwardPackets(linkQuit, newPacket(0))
+	require.NoError(t, err)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	// Once an interceptor is set, the held forward is replayed to it.
+	switchForwardInterceptor.SetInterceptor(interceptor)
+	fwd := receiveIntercepted()
+	require.EqualValues(t, 0, fwd.Packet().IncomingCircuit.HtlcID)
+
+	// If the incoming link restarts, it replays the held forward with a
+	// new quit signal. The forward remains held without being passed to
+	// the interceptor again, and is resumed through the restarted link
+	// below.
+	close(linkQuit)
+	linkQuit = make(chan struct{})
+	err = switchForwardInterceptor.ForwardPackets(linkQuit, newPacket(0))
+	require.NoError(t, err)
+	select {
+	case <-intercepted:
+		t.Fatal("replayed forward passed to interceptor again")
+
+	case <-time.After(100 * time.Millisecond):
+	}
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	// If the interceptor goes away, the forward remains held, and is
+	// replayed to the next interceptor.
+	switchForwardInterceptor.SetInterceptor(nil)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	switchForwardInterceptor.SetInterceptor(interceptor)
+	fwd = receiveIntercepted()
+	require.NoError(t, fwd.Resume())
+	assertOutgoingLinkReceive(t, bobChannelLink, true)
+	assertNumCircuits(t, s, 1, 1)
+
+	// The forward can only be resolved once.
+	require.Equal(t, ErrFwdNotExists, fwd.Resume())
+
+	// Hold another forward, which is passed to the interceptor right
+	// away.
+	err = switchForwardInterceptor.ForwardPackets(linkQuit, newPacket(1))
+	require.NoError(t, err)
+	fwd = receiveIntercepted()
+	require.EqualValues(t, 1, fwd.Packet().IncomingCircuit.HtlcID)
+
+	// A block that doesn't bring the incoming htlc within the fail delta
+	// leaves the forward held.
+	notifier.EpochChan <- &chainntnfs.BlockEpoch{
+		Height: expiry - heldFailDelta - 1,
+	}
+	assertOutgoingLinkReceive(t, aliceChannelLink, false)
+
+	// Once it does, the forward is failed back.
+	notifier.EpochChan <- &chainntnfs.BlockEpoch{
+		Height: expiry - heldFailDelta,
+	}
+	select {
+	case packet := <-aliceChannelLink.packets:
+		require.IsType(t, &lnwire.UpdateFailHTLC{}, packet.htlc)
+		require.EqualValues(t, 1, packet.incomingHTLCID)
+
+	case <-time.After(time.Second):
+		t.Fatal("held forward was not failed back")
+	}
+	require.Equal(t, ErrFwdNotExists, fwd.Resume())
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+}
+
 // TestSwitchDustForwarding tests that the switch properly fails HTLC's which
 // have incoming or outgoing links that breach their dust thresholds.
 func TestSwitchDustForwarding(t *testing.T) {
@@ -1,9 +1,58 @@
 package invoices
 
 import (
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lntypes"
 	"github.com/ltcsuite/lnd/record"
 )
 
+// InvoiceDB is the persistent store of the invoices. It's implemented by the
+// kvdb based channeldb.DB, and by the native SQL invoice store.
+type InvoiceDB interface {
+	// AddInvoice inserts the invoice with the given payment hash into the
+	// store, and returns its add index.
+	AddInvoice(invoice *channeldb.Invoice,
+		paymentHash lntypes.Hash) (uint64, error)
+
+	// InvoicesAddedSince returns all invoices with an add index greater
+	// than the passed one.
+	InvoicesAddedSince(sinceAddIndex uint64) ([]channeldb.Invoice, error)
+
+	// LookupInvoice looks up the invoice that the passed reference points
+	// to.
+	LookupInvoice(ref channeldb.InvoiceRef) (channeldb.Invoice, error)
+
+	// ScanInvoices calls the passed scanFunc for each invoice in the
+	// store. The reset closure is called before the scan starts, and
+	// before it is retried.
+	ScanInvoices(scanFunc func(lntypes.Hash, *channeldb.Invoice) error,
+		reset func()) error
+
+	// QueryInvoices returns the invoices within the add index range of
+	// the passed query.
+	QueryInvoices(q channeldb.InvoiceQuery) (channeldb.InvoiceSlice,
+		error)
+
+	// UpdateInvoice atomically updates the invoice that the passed
+	// reference points to, with the update returned by the callback.
+	UpdateInvoice(ref channeldb.InvoiceRef, setIDHint *channeldb.SetID,
+		callback channeldb.InvoiceUpdateCallback) (*channeldb.Invoice,
+		error)
+
+	// InvoicesSettledSince returns all invoices with a settle index
+	// greater than the passed one.
+	InvoicesSettledSince(sinceSettleIndex uint64) ([]channeldb.Invoice,
+		error)
+
+	// DeleteInvoice deletes the invoices that the passed references point
+	// to.
+	DeleteInvoice(invoicesToDelete []channeldb.InvoiceDeleteRef) error
+}
+
+// A compile time check to ensure channeldb.DB implements the InvoiceDB
+// interface.
+var _ InvoiceDB = (*channeldb.DB)(nil)
+
 // Payload abstracts access to any additional fields provided in the final hop's
 // TLV onion payload.
 type Payload interface {
@@ -0,0 +1,105 @@
+package invoices
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/sqldb"
+)
+
+// invoicesKVMigration is the name that the migration of the invoices from the
+// kvdb backend is recorded under.
+const invoicesKVMigration = "invoices"
+
+// MigrateInvoicesToSQL copies all invoices of the passed kvdb invoice store to
+// the native SQL invoice store, unless that happened already. The add and
+// settle indexes of the invoices are retained, so clients can continue to
+// subscribe to invoice events from the indexes they know of.
+func MigrateInvoicesToSQL(kvStore InvoiceDB, sqlStore *SQLStore) error {
+	ctx := context.Background()
+	done, err := sqlStore.db.KVMigrationDone(ctx, invoicesKVMigration)
+	if err != nil {
+		return err
+	}
+	if done {
+		return nil
+	}
+
+	type hashedInvoice struct {
+		hash    lntypes.Hash
+		invoice channeldb.Invoice
+	}
+	var invoices []hashedInvoice
+	err = kvStore.ScanInvoices(
+		func(hash lntypes.Hash, invoice *channeldb.Invoice) error {
+			invoices = append(invoices, hashedInvoice{
+				hash:    hash,
+				invoice: *invoice,
+			})
+
+			return nil
+		}, func() {
+			invoices = nil
+		},
+	)
+	if err != nil && err != channeldb.ErrNoInvoicesCreated {
+		return err
+	}
+
+	sort.Slice(invoices, func(i, j int) bool {
+		return invoices[i].invoice.AddIndex <
+			invoices[j].invoice.AddIndex
+	})
+
+	log.Infof("Migrating %d invoices to the native SQL invoice store",
+		len(invoices))
+
+	return sqlStore.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		var lastAddIndex, lastSettleIndex uint64
+		for i := range invoices {
+			invoice := &invoices[i].invoice
+
+			_, err := insertInvoice(
+				ctx, tx, invoice, invoices[i].hash,
+			)
+			if err != nil {
+				return err
+			}
+
+			if invoice.AddIndex > lastAddIndex {
+				lastAddIndex = invoice.AddIndex
+			}
+			if invoice.SettleIndex > lastSettleIndex {
+				lastSettleIndex = invoice.SettleIndex
+			}
+			for _, state := range invoice.AMPState {
+				if state.SettleIndex > lastSettleIndex {
+					lastSettleIndex = state.SettleIndex
+				}
+			}
+		}
+
+		// New invoices and settles are indexed after the migrated
+		// ones.
+		_, err := tx.ExecContext(ctx, `
+			UPDATE invoice_sequences SET current_value = $1
+			WHERE name = $2`, int64(lastAddIndex), addIndexSequence,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			UPDATE invoice_sequences SET current_value = $1
+			WHERE name = $2`, int64(lastSettleIndex),
+			settleIndexSequence,
+		)
+		if err != nil {
+			return err
+		}
+
+		return sqldb.MarkKVMigrationDone(ctx, tx, invoicesKVMigration)
+	})
+}
@@ -0,0 +1,1306 @@
+package invoices
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/clock"
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/record"
+	"github.com/ltcsuite/lnd/sqldb"
+)
+
+const (
+	// addIndexSequence is the name of the sequence that the add indexes
+	// of the invoices are assigned from.
+	addIndexSequence = "add_index"
+
+	// settleIndexSequence is the name of the sequence that the settle
+	// indexes of the invoices and AMP sub-invoices are assigned from.
+	settleIndexSequence = "settle_index"
+
+	// invoiceColumns are the columns of the invoices table, in the order
+	// that fetchInvoice scans them.
+	invoiceColumns = "id, hash, preimage, payment_addr, memo, " +
+		"payment_request, amount_msat, cltv_delta, expiry, features, " +
+		"state, amount_paid_msat, is_hodl, add_index, settle_index, " +
+		"created_at, settled_at"
+
+	// htlcColumns are the columns of the invoice_htlcs table, in the order
+	// that scanHtlc expects them.
+	htlcColumns = "id, chan_id, htlc_id, amount_msat, total_mpp_msat, " +
+		"accept_height, accept_time, resolve_time, expiry_height, " +
+		"state, set_id, root_share, child_index, hash, preimage"
+)
+
+// SQLStore is an invoice store that keeps the invoices within the relational
+// tables of a native SQL database. As opposed to the kvdb invoice store, this
+// allows invoices to be queried by their indexes and attributes directly.
+type SQLStore struct {
+	db    *sqldb.DB
+	clock clock.Clock
+}
+
+// A compile time check to ensure SQLStore implements the InvoiceDB interface.
+var _ InvoiceDB = (*SQLStore)(nil)
+
+// NewSQLStore creates a new invoice store that is backed by the passed native
+// SQL database.
+func NewSQLStore(db *sqldb.DB, clock clock.Clock) *SQLStore {
+	return &SQLStore{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// AddInvoice inserts the targeted invoice into the database. If an invoice
+// with the same payment hash or payment address exists already, the insertion
+// is rejected. A side effect of this function is that it sets AddIndex on
+// newInvoice.
+//
+// NOTE: This is part of the InvoiceDB interface.
+func (s *SQLStore) AddInvoice(newInvoice *channeldb.Invoice,
+	paymentHash lntypes.Hash) (uint64, error) {
+
+	err := channeldb.ValidateInvoice(newInvoice, paymentHash)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx := context.Background()
+	var addIndex uint64
+	err = s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		id, err := queryInvoiceID(
+			ctx, tx, "SELECT id FROM invoices WHERE hash = $1",
+			paymentHash[:],
+		)
+		if err != nil {
+			return err
+		}
+		if id != 0 {
+			return channeldb.ErrDuplicateInvoice
+		}
+
+		// The all-zeroes payment address of legacy keysend invoices
+		// isn't stored, so it isn't subject to the unique constraint.
+		payAddr := newInvoice.Terms.PaymentAddr
+		if payAddr != channeldb.BlankPayAddr {
+			id, err := queryInvoiceID(
+				ctx, tx, "SELECT id FROM invoices WHERE "+
+					"payment_addr = $1", payAddr[:],
+			)
+			if err != nil {
+				return err
+			}
+			if id != 0 {
+				return channeldb.ErrDuplicatePayAddr
+			}
+		}
+
+		addIndex, err = nextSequence(ctx, tx, addIndexSequence)
+		if err != nil {
+			return err
+		}
+
+		invoice := *newInvoice
+		invoice.AddIndex = addIndex
+		_, err = insertInvoice(ctx, tx, &invoice, paymentHash)
+
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	newInvoice.AddIndex = addIndex
+
+	return addIndex, nil
+}
+
+// InvoicesAddedSince returns all invoices with an add index greater than the
+// specified sinceAddIndex.
+//
+// NOTE: The index starts from 1, as a result. We enforce that specifying a
+// value below the starting index value is a noop.
+//
+// NOTE: This is part of the InvoiceDB interface.
+func (s *SQLStore) InvoicesAddedSince(sinceAddIndex uint64) (
+	[]channeldb.Invoice, error) {
+
+	// If an index of zero was specified, then in order to maintain
+	// backwards compat, we won't send out any new invoices.
+	if sinceAddIndex == 0 {
+		return nil, nil
+	}
+
+	ctx := context.Background()
+	var newInvoices []channeldb.Invoice
+	err := s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		ids, err := queryInvoiceIDs(
+			ctx, tx, "SELECT id FROM invoices WHERE "+
+				"add_index > $1 ORDER BY add_index",
+			int64(sinceAddIndex),
+		)
+		if err != nil {
+			return err
+		}
+
+		newInvoices, err = fetchInvoices(ctx, tx, ids)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newInvoices, nil
+}
+
+// LookupInvoice attempts to look up an invoice according to the passed
+// reference. If the reference doesn't point to a known invoice,
+// ErrInvoiceNotFound is returned.
+//
+// NOTE: This is part of the InvoiceDB interface.
+func (s *SQLStore) LookupInvoice(ref channeldb.InvoiceRef) (channeldb.Invoice,
+	error) {
+
+	var setID *channeldb.SetID
+	switch {
+	// If this is a payment address ref, and the blank modified was
+	// specified, then we'll use the zero set ID to indicate that we won't
+	// want any HTLCs returned.
+	case ref.PayAddr() != nil &&
+		ref.Modifier() == channeldb.HtlcSetBlankModifier:
+
+		var zeroSetID channeldb.SetID
+		setID = &zeroSetID
+
+	// If this is a set ID ref, and the htlc set only modified was
+	// specified, then we'll pass through the specified setID so only that
+	// will be returned.
+	case ref.SetID() != nil &&
+		ref.Modifier() == channeldb.HtlcSetOnlyModifier:
+
+		setID = (*channeldb.SetID)(ref.SetID())
+	}
+
+	ctx := context.Background()
+	var invoice channeldb.Invoice
+	err := s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		id, err := invoiceIDByRef(ctx, tx, ref)
+		if err != nil {
+			return err
+		}
+
+		invoice, err = fetchInvoice(ctx, tx, id, setID)
+
+		return err
+	})
+
+	return invoice, err
+}
+
+// ScanInvoices scans through all invoices and calls the passed scanFunc for
+// each invoice with its respective payment hash. The reset closure is called
+// before the scan starts.
+//
+// NOTE: This is part of the InvoiceDB interface.
+func (s *SQLStore) ScanInvoices(
+	scanFunc func(lntypes.Hash, *channeldb.Invoice) error,
+	reset func()) error {
+
+	reset()
+
+	ctx := context.Background()
+	return s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		ids, err := queryInvoiceIDs(
+			ctx, tx, "SELECT id FROM invoices ORDER BY add_index",
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			invoice, err := fetchInvoice(ctx, tx, id, nil)
+			if err != nil {
+				return err
+			}
+
+			hash, err := fetchInvoiceHash(ctx, tx, id)
+			if err != nil {
+				return err
+			}
+
+			if err := scanFunc(hash, &invoice); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// QueryInvoices allows a caller to query the invoice database for invoices
+// within the specified add index range.
+//
+// NOTE: This is part of the InvoiceDB interface.
+func (s *SQLStore) QueryInvoices(q channeldb.InvoiceQuery) (
+	channeldb.InvoiceSlice, error) {
+
+	resp := channeldb.InvoiceSlice{
+		InvoiceQuery: q,
+	}
+
+	// The index offset is exclusive, and points to the end of the add
+	// index for reversed queries if it's zero.
+	var (
+		conditions []string
+		args       []interface{}
+		order      = "ASC"
+	)
+	switch {
+	case !q.Reversed:
+		conditions = append(conditions, "add_index > $1")
+		args = append(args, int64(q.IndexOffset))
+
+	case q.IndexOffset != 0:
+		conditions = append(conditions, "add_index < $1")
+		args = append(args, int64(q.IndexOffset))
+		order = "DESC"
+
+	default:
+		order = "DESC"
+	}
+
+	// Skip any settled or canceled invoices if the caller is only
+	// interested in pending ones.
+	if q.PendingOnly {
+		conditions = append(conditions, fmt.Sprintf(
+			"state IN (%d, %d)", channeldb.ContractOpen,
+			channeldb.ContractAccepted,
+		))
+	}
+
+	limit := q.NumMaxInvoices
+	if limit > math.MaxInt64 {
+		limit = math.MaxInt64
+	}
+
+	query := "SELECT id FROM invoices"
+	if len(conditions) != 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY add_index %s LIMIT %d", order, limit)
+
+	ctx := context.Background()
+	err := s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		ids, err := queryInvoiceIDs(ctx, tx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		resp.Invoices, err = fetchInvoices(ctx, tx, ids)
+
+		return err
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	// If we queried the add index in reverse order, then we'll need to
+	// reverse the slice of invoices to return them in forward order.
+	if q.Reversed {
+		numInvoices := len(resp.Invoices)
+		for i := 0; i < numInvoices/2; i++ {
+			opposite := numInvoices - i - 1
+			resp.Invoices[i], resp.Invoices[opposite] =
+				resp.Invoices[opposite], resp.Invoices[i]
+		}
+	}
+
+	// Finally, record the indexes of the first and last invoices returned
+	// so that the caller can resume from this point later on.
+	if len(resp.Invoices) > 0 {
+		resp.FirstIndexOffset = resp.Invoices[0].AddIndex
+		lastInvoice := resp.Invoices[len(resp.Invoices)-1]
+		resp.LastIndexOffset = lastInvoice.AddIndex
+	}
+
+	return resp, nil
+}
+
+// UpdateInvoice attempts to update the invoice that the passed reference
+// points to. The invoice is fetched, updated with the update descriptor
+// returned by the callback and written back within a single database
+// transaction.
+//
+// NOTE: This is part of the InvoiceDB interface.
+func (s *SQLStore) UpdateInvoice(ref channeldb.InvoiceRef,
+	setIDHint *channeldb.SetID,
+	callback channeldb.InvoiceUpdateCallback) (*channeldb.Invoice, error) {
+
+	ctx := context.Background()
+	var updatedInvoice *channeldb.Invoice
+	err := s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		id, err := invoiceIDByRef(ctx, tx, ref)
+		if err != nil {
+			return err
+		}
+
+		// If the set ID hint is non-nil, then we'll use it to only
+		// fetch the HTLCs of that set for AMP invoices. Otherwise we
+		// pass in the zero set ID, which means no HTLCs are fetched.
+		var invSetID channeldb.SetID
+		if setIDHint != nil {
+			invSetID = *setIDHint
+		}
+		invoice, err := fetchInvoice(ctx, tx, id, &invSetID)
+		if err != nil {
+			return err
+		}
+
+		// Call the callback with a deep copy of the invoice, to
+		// prevent any accidental modification, and obtain the update
+		// descriptor.
+		update, err := callback(channeldb.CopyInvoice(&invoice))
+		if err != nil || update == nil {
+			updatedInvoice = &invoice
+			return err
+		}
+
+		indexer := &sqlInvoiceIndexer{
+			ctx:       ctx,
+			tx:        tx,
+			invoiceID: id,
+		}
+		err = channeldb.ApplyInvoiceUpdate(
+			&invoice, ref.PayHash(), update, s.clock.Now(), indexer,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = persistInvoiceUpdate(ctx, tx, id, &invoice, update)
+		if err != nil {
+			return err
+		}
+		updatedInvoice = &invoice
+
+		return nil
+	})
+
+	return updatedInvoice, err
+}
+
+// InvoicesSettledSince returns all invoices, or AMP sub-invoices, with a
+// settle index greater than the passed sinceSettleIndex.
+//
+// NOTE: The index starts from 1, as a result. We enforce that specifying a
+// value below the starting index value is a noop.
+//
+// NOTE: This is part of the InvoiceDB interface.
+func (s *SQLStore) InvoicesSettledSince(sinceSettleIndex uint64) (
+	[]channeldb.Invoice, error) {
+
+	// If an index of zero was specified, then in order to maintain
+	// backwards compat, we won't send out any new invoices.
+	if sinceSettleIndex == 0 {
+		return nil, nil
+	}
+
+	ctx := context.Background()
+	var settledInvoices []channeldb.Invoice
+	err := s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		// AMP sub-invoices are settled individually, so we'll return
+		// the AMP invoice once for each of them, along with the HTLCs
+		// of the settled set only.
+		rows, err := tx.QueryContext(ctx, `
+			SELECT settle_index, id, NULL FROM invoices
+			WHERE settle_index > $1
+			UNION ALL
+			SELECT settle_index, invoice_id, set_id
+			FROM amp_sub_invoices
+			WHERE settle_index > $1
+			ORDER BY 1`, int64(sinceSettleIndex),
+		)
+		if err != nil {
+			return err
+		}
+
+		type settledInvoice struct {
+			id    int64
+			setID *channeldb.SetID
+		}
+		var settled []settledInvoice
+		for rows.Next() {
+			var (
+				settleIndex int64
+				entry       settledInvoice
+				setID       []byte
+			)
+			err := rows.Scan(&settleIndex, &entry.id, &setID)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+
+			if setID != nil {
+				entry.setID = new(channeldb.SetID)
+				copy(entry.setID[:], setID)
+			}
+
+			settled = append(settled, entry)
+		}
+		if err := rows.Close(); err != nil {
+			return err
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, entry := range settled {
+			invoice, err := fetchInvoice(
+				ctx, tx, entry.id, entry.setID,
+			)
+			if err != nil {
+				return err
+			}
+
+			settledInvoices = append(settledInvoices, invoice)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return settledInvoices, nil
+}
+
+// DeleteInvoice attempts to delete the passed invoices, along with their
+// HTLCs and AMP sub-invoices, from the database in one transaction.
+//
+// NOTE: This is part of the InvoiceDB interface.
+func (s *SQLStore) DeleteInvoice(
+	invoicesToDelete []channeldb.InvoiceDeleteRef) error {
+
+	ctx := context.Background()
+	return s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		for _, ref := range invoicesToDelete {
+			var (
+				id          int64
+				addIndex    int64
+				settleIndex sql.NullInt64
+			)
+			err := tx.QueryRowContext(ctx, `
+				SELECT id, add_index, settle_index
+				FROM invoices WHERE hash = $1`, ref.PayHash[:],
+			).Scan(&id, &addIndex, &settleIndex)
+			if err == sql.ErrNoRows {
+				return channeldb.ErrInvoiceNotFound
+			}
+			if err != nil {
+				return err
+			}
+
+			// To ensure consistency, check that the indexes of the
+			// reference match those of the invoice.
+			if uint64(addIndex) != ref.AddIndex {
+				return fmt.Errorf("unknown invoice in add " +
+					"index")
+			}
+			if ref.SettleIndex > 0 &&
+				uint64(settleIndex.Int64) != ref.SettleIndex {
+
+				return fmt.Errorf("unknown invoice in " +
+					"settle index")
+			}
+
+			// The HTLCs and AMP sub-invoices of the invoice are
+			// deleted along with it.
+			_, err = tx.ExecContext(
+				ctx, "DELETE FROM invoices WHERE id = $1", id,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// sqlInvoiceIndexer maintains the indexes of the SQL invoice store while an
+// invoice update is applied.
+type sqlInvoiceIndexer struct {
+	ctx       context.Context
+	tx        *sql.Tx
+	invoiceID int64
+}
+
+// A compile time check to ensure sqlInvoiceIndexer implements the
+// channeldb.InvoiceIndexer interface.
+var _ channeldb.InvoiceIndexer = (*sqlInvoiceIndexer)(nil)
+
+// IndexSetID indexes the invoice by the set ID of a newly added AMP HTLC, by
+// creating the AMP sub-invoice of the set if it doesn't exist yet.
+//
+// NOTE: This is part of the channeldb.InvoiceIndexer interface.
+func (s *sqlInvoiceIndexer) IndexSetID(setID channeldb.SetID) (bool, error) {
+	invoiceID, err := queryInvoiceID(
+		s.ctx, s.tx, "SELECT invoice_id FROM amp_sub_invoices "+
+			"WHERE set_id = $1", setID[:],
+	)
+	switch {
+	case err != nil:
+		return false, err
+
+	case invoiceID != 0:
+		return invoiceID == s.invoiceID, nil
+	}
+
+	_, err = s.tx.ExecContext(s.ctx, `
+		INSERT INTO amp_sub_invoices (set_id, invoice_id, state,
+			amount_paid_msat)
+		VALUES ($1, $2, $3, 0)`, setID[:], s.invoiceID,
+		channeldb.HtlcStateAccepted,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// NextSettleIndex returns the next settle index. The index is recorded along
+// with the settled invoice or AMP sub-invoice once the update is persisted.
+//
+// NOTE: This is part of the channeldb.InvoiceIndexer interface.
+func (s *sqlInvoiceIndexer) NextSettleIndex(_ *channeldb.SetID) (uint64,
+	error) {
+
+	return nextSequence(s.ctx, s.tx, settleIndexSequence)
+}
+
+// nextSequence increments the sequence with the given name and returns its
+// new value.
+func nextSequence(ctx context.Context, tx *sql.Tx, name string) (uint64,
+	error) {
+
+	var value int64
+	err := tx.QueryRowContext(ctx, `
+		UPDATE invoice_sequences SET current_value = current_value + 1
+		WHERE name = $1 RETURNING current_value`, name,
+	).Scan(&value)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(value), nil
+}
+
+// queryInvoiceID returns the invoice ID that is selected by the passed query,
+// or zero if it doesn't select any rows.
+func queryInvoiceID(ctx context.Context, tx *sql.Tx, query string,
+	args ...interface{}) (int64, error) {
+
+	var id int64
+	err := tx.QueryRowContext(ctx, query, args...).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+
+	return id, err
+}
+
+// queryInvoiceIDs returns all invoice IDs that are selected by the passed
+// query.
+func queryInvoiceIDs(ctx context.Context, tx *sql.Tx, query string,
+	args ...interface{}) ([]int64, error) {
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// invoiceIDByRef returns the ID of the invoice that the passed reference
+// points to. The payment address is treated as the primary key, falling back
+// to the payment hash if nothing is found for the payment address.
+// ErrInvoiceNotFound is returned if there is no such invoice.
+func invoiceIDByRef(ctx context.Context, tx *sql.Tx,
+	ref channeldb.InvoiceRef) (int64, error) {
+
+	// If the set id is present, we only consult the AMP sub-invoices for
+	// this invoice.
+	if setID := ref.SetID(); setID != nil {
+		id, err := queryInvoiceID(
+			ctx, tx, "SELECT invoice_id FROM amp_sub_invoices "+
+				"WHERE set_id = $1", setID[:],
+		)
+		if err != nil {
+			return 0, err
+		}
+		if id == 0 {
+			return 0, channeldb.ErrInvoiceNotFound
+		}
+
+		return id, nil
+	}
+
+	payHash := ref.PayHash()
+	payAddr := ref.PayAddr()
+
+	var idByHash, idByAddr int64
+	if payHash != nil {
+		var err error
+		idByHash, err = queryInvoiceID(
+			ctx, tx, "SELECT id FROM invoices WHERE hash = $1",
+			payHash[:],
+		)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Only allow lookups for payment address if it is not a blank payment
+	// address, which is a special-cased value for legacy keysend invoices.
+	if payAddr != nil && *payAddr != channeldb.BlankPayAddr {
+		var err error
+		idByAddr, err = queryInvoiceID(
+			ctx, tx, "SELECT id FROM invoices WHERE "+
+				"payment_addr = $1", payAddr[:],
+		)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	switch {
+	// If payment address and payment hash both reference an existing
+	// invoice, ensure they reference the _same_ invoice.
+	case idByAddr != 0 && idByHash != 0:
+		if idByAddr != idByHash {
+			return 0, channeldb.ErrInvRefEquivocation
+		}
+
+		return idByAddr, nil
+
+	// Return invoices by payment addr only if the reference doesn't
+	// contain a payment hash, since the payment hash of an HTLC must match
+	// that of the invoice for legacy and MPP payments.
+	case idByAddr != 0 && payHash == nil:
+		return idByAddr, nil
+
+	case idByHash != 0:
+		return idByHash, nil
+
+	default:
+		return 0, channeldb.ErrInvoiceNotFound
+	}
+}
+
+// fetchInvoiceHash returns the payment hash of the invoice with the given ID.
+func fetchInvoiceHash(ctx context.Context, tx *sql.Tx, id int64) (
+	lntypes.Hash, error) {
+
+	var (
+		hash    lntypes.Hash
+		rawHash []byte
+	)
+	err := tx.QueryRowContext(
+		ctx, "SELECT hash FROM invoices WHERE id = $1", id,
+	).Scan(&rawHash)
+	if err != nil {
+		return hash, err
+	}
+	copy(hash[:], rawHash)
+
+	return hash, nil
+}
+
+// fetchInvoices fetches the invoices with the given IDs, including all their
+// HTLCs.
+func fetchInvoices(ctx context.Context, tx *sql.Tx, ids []int64) (
+	[]channeldb.Invoice, error) {
+
+	var invoices []channeldb.Invoice
+	for _, id := range ids {
+		invoice, err := fetchInvoice(ctx, tx, id, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		invoices = append(invoices, invoice)
+	}
+
+	return invoices, nil
+}
+
+// fetchInvoice fetches the invoice with the given ID. If a set ID is passed
+// for an AMP invoice, then only the HTLCs of that set are returned, where the
+// blank set ID selects no HTLCs at all.
+func fetchInvoice(ctx context.Context, tx *sql.Tx, id int64,
+	setID *channeldb.SetID) (channeldb.Invoice, error) {
+
+	var (
+		invoice     channeldb.Invoice
+		rawHash     []byte
+		preimage    []byte
+		payAddr     []byte
+		payReq      sql.NullString
+		amt         int64
+		expiry      int64
+		features    []byte
+		state       int16
+		amtPaid     int64
+		addIndex    int64
+		settleIndex sql.NullInt64
+		createdAt   time.Time
+		settledAt   sql.NullTime
+	)
+	err := tx.QueryRowContext(
+		ctx, "SELECT "+invoiceColumns+" FROM invoices WHERE id = $1",
+		id,
+	).Scan(
+		&id, &rawHash, &preimage, &payAddr, &invoice.Memo, &payReq,
+		&amt, &invoice.Terms.FinalCltvDelta, &expiry, &features,
+		&state, &amtPaid, &invoice.HodlInvoice, &addIndex,
+		&settleIndex, &createdAt, &settledAt,
+	)
+	if err == sql.ErrNoRows {
+		return invoice, channeldb.ErrInvoiceNotFound
+	}
+	if err != nil {
+		return invoice, err
+	}
+
+	if preimage != nil {
+		var p lntypes.Preimage
+		copy(p[:], preimage)
+		invoice.Terms.PaymentPreimage = &p
+	}
+	copy(invoice.Terms.PaymentAddr[:], payAddr)
+	if payReq.Valid {
+		invoice.PaymentRequest = []byte(payReq.String)
+	}
+	invoice.Terms.Value = lnwire.MilliSatoshi(amt)
+	invoice.Terms.Expiry = time.Duration(expiry) * time.Second
+
+	rawFeatures := lnwire.NewRawFeatureVector()
+	err = rawFeatures.DecodeBase256(
+		bytes.NewReader(features), len(features),
+	)
+	if err != nil {
+		return invoice, err
+	}
+	invoice.Terms.Features = lnwire.NewFeatureVector(
+		rawFeatures, lnwire.Features,
+	)
+
+	invoice.State = channeldb.ContractState(state)
+	invoice.AmtPaid = lnwire.MilliSatoshi(amtPaid)
+	invoice.AddIndex = uint64(addIndex)
+	invoice.SettleIndex = uint64(settleIndex.Int64)
+	invoice.CreationDate = createdAt.Local()
+	invoice.SettleDate = sqldb.FromNullTime(settledAt)
+
+	invoice.AMPState, err = fetchAMPState(ctx, tx, id)
+	if err != nil {
+		return invoice, err
+	}
+
+	// The set ID filter only applies to AMP invoices, as all HTLCs of
+	// other invoices belong to the same set.
+	isAMP := invoice.Terms.Features.HasFeature(lnwire.AMPOptional)
+	if !isAMP {
+		setID = nil
+	}
+	invoice.Htlcs, err = fetchHtlcs(ctx, tx, id, setID)
+
+	return invoice, err
+}
+
+// fetchHtlcs fetches the HTLCs of the invoice with the given ID, along with
+// their custom records. If a set ID is passed, only the HTLCs of that set are
+// returned, with the blank set ID selecting no HTLCs.
+func fetchHtlcs(ctx context.Context, tx *sql.Tx, invoiceID int64,
+	setID *channeldb.SetID) (
+	map[channeldb.CircuitKey]*channeldb.InvoiceHTLC, error) {
+
+	htlcs := make(map[channeldb.CircuitKey]*channeldb.InvoiceHTLC)
+	if setID != nil && *setID == channeldb.BlankPayAddr {
+		return htlcs, nil
+	}
+
+	query := "SELECT " + htlcColumns + " FROM invoice_htlcs " +
+		"WHERE invoice_id = $1"
+	args := []interface{}{invoiceID}
+	if setID != nil {
+		query += " AND set_id = $2"
+		args = append(args, setID[:])
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	htlcsByID := make(map[int64]*channeldb.InvoiceHTLC)
+	for rows.Next() {
+		id, key, htlc, err := scanHtlc(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		htlcs[key] = htlc
+		htlcsByID[id] = htlc
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = tx.QueryContext(ctx, `
+		SELECT r.htlc_id, r.record_key, r.record_value
+		FROM invoice_htlc_custom_records r
+		JOIN invoice_htlcs h ON r.htlc_id = h.id
+		WHERE h.invoice_id = $1`, invoiceID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			htlcID int64
+			key    int64
+			value  []byte
+		)
+		if err := rows.Scan(&htlcID, &key, &value); err != nil {
+			return nil, err
+		}
+
+		// Skip the records of HTLCs that were filtered out.
+		htlc, ok := htlcsByID[htlcID]
+		if !ok {
+			continue
+		}
+		htlc.CustomRecords[uint64(key)] = value
+	}
+
+	return htlcs, rows.Err()
+}
+
+// scanHtlc scans an HTLC from the passed rows, which must select the HTLC
+// columns. The ID of its row is returned along with its circuit key.
+func scanHtlc(rows *sql.Rows) (int64, channeldb.CircuitKey,
+	*channeldb.InvoiceHTLC, error) {
+
+	var (
+		id           int64
+		key          channeldb.CircuitKey
+		chanID       int64
+		htlcID       int64
+		amt          int64
+		mppTotalAmt  int64
+		acceptHeight int64
+		acceptTime   time.Time
+		resolveTime  sql.NullTime
+		expiry       int64
+		state        int16
+		setID        []byte
+		rootShare    []byte
+		childIndex   sql.NullInt64
+		hash         []byte
+		preimage     []byte
+	)
+	err := rows.Scan(
+		&id, &chanID, &htlcID, &amt, &mppTotalAmt, &acceptHeight,
+		&acceptTime, &resolveTime, &expiry, &state, &setID, &rootShare,
+		&childIndex, &hash, &preimage,
+	)
+	if err != nil {
+		return 0, key, nil, err
+	}
+
+	key = channeldb.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(uint64(chanID)),
+		HtlcID: uint64(htlcID),
+	}
+	htlc := &channeldb.InvoiceHTLC{
+		Amt:           lnwire.MilliSatoshi(amt),
+		MppTotalAmt:   lnwire.MilliSatoshi(mppTotalAmt),
+		AcceptHeight:  uint32(acceptHeight),
+		AcceptTime:    acceptTime.Local(),
+		ResolveTime:   sqldb.FromNullTime(resolveTime),
+		Expiry:        uint32(expiry),
+		State:         channeldb.HtlcState(state),
+		CustomRecords: make(record.CustomSet),
+	}
+
+	if setID != nil {
+		var (
+			ampSetID     [32]byte
+			ampRootShare [32]byte
+		)
+		copy(ampSetID[:], setID)
+		copy(ampRootShare[:], rootShare)
+
+		htlc.AMP = &channeldb.InvoiceHtlcAMPData{
+			Record: *record.NewAMP(
+				ampRootShare, ampSetID,
+				uint32(childIndex.Int64),
+			),
+		}
+		copy(htlc.AMP.Hash[:], hash)
+
+		if preimage != nil {
+			var p lntypes.Preimage
+			copy(p[:], preimage)
+			htlc.AMP.Preimage = &p
+		}
+	}
+
+	return id, key, htlc, nil
+}
+
+// fetchAMPState fetches the state of the AMP sub-invoices of the invoice with
+// the given ID. The keys of the HTLCs of each sub-invoice are included, even
+// if the HTLCs themselves aren't fetched.
+func fetchAMPState(ctx context.Context, tx *sql.Tx, invoiceID int64) (
+	channeldb.AMPInvoiceState, error) {
+
+	ampState := make(channeldb.AMPInvoiceState)
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT set_id, state, amount_paid_msat, settle_index,
+			settled_at
+		FROM amp_sub_invoices WHERE invoice_id = $1`, invoiceID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		var (
+			rawSetID    []byte
+			setID       channeldb.SetID
+			state       int16
+			amtPaid     int64
+			settleIndex sql.NullInt64
+			settledAt   sql.NullTime
+		)
+		err := rows.Scan(
+			&rawSetID, &state, &amtPaid, &settleIndex, &settledAt,
+		)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		copy(setID[:], rawSetID)
+
+		ampState[setID] = channeldb.InvoiceStateAMP{
+			State:       channeldb.HtlcState(state),
+			SettleIndex: uint64(settleIndex.Int64),
+			SettleDate:  sqldb.FromNullTime(settledAt),
+			InvoiceKeys: make(map[channeldb.CircuitKey]struct{}),
+			AmtPaid:     lnwire.MilliSatoshi(amtPaid),
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(ampState) == 0 {
+		return ampState, nil
+	}
+
+	rows, err = tx.QueryContext(ctx, `
+		SELECT set_id, chan_id, htlc_id FROM invoice_htlcs
+		WHERE invoice_id = $1 AND set_id IS NOT NULL`, invoiceID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			rawSetID []byte
+			setID    channeldb.SetID
+			chanID   int64
+			htlcID   int64
+		)
+		if err := rows.Scan(&rawSetID, &chanID, &htlcID); err != nil {
+			return nil, err
+		}
+		copy(setID[:], rawSetID)
+
+		state, ok := ampState[setID]
+		if !ok {
+			continue
+		}
+		state.InvoiceKeys[channeldb.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(uint64(chanID)),
+			HtlcID: uint64(htlcID),
+		}] = struct{}{}
+	}
+
+	return ampState, rows.Err()
+}
+
+// insertInvoice inserts the passed invoice, including its HTLCs and AMP
+// sub-invoices, and returns its ID. The add index of the invoice must be set
+// already.
+func insertInvoice(ctx context.Context, tx *sql.Tx, invoice *channeldb.Invoice,
+	paymentHash lntypes.Hash) (int64, error) {
+
+	// The buffer is initialized with an empty slice, so that an empty
+	// feature vector is stored as an empty blob rather than NULL.
+	features := bytes.NewBuffer([]byte{})
+	if err := invoice.Terms.Features.EncodeBase256(features); err != nil {
+		return 0, err
+	}
+
+	var payAddr interface{}
+	if invoice.Terms.PaymentAddr != channeldb.BlankPayAddr {
+		payAddr = invoice.Terms.PaymentAddr[:]
+	}
+
+	var payReq sql.NullString
+	if len(invoice.PaymentRequest) != 0 {
+		payReq = sql.NullString{
+			String: string(invoice.PaymentRequest),
+			Valid:  true,
+		}
+	}
+
+	var id int64
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO invoices (hash, preimage, payment_addr, memo,
+			payment_request, amount_msat, cltv_delta, expiry,
+			features, state, amount_paid_msat, is_hodl, is_amp,
+			add_index, settle_index, created_at, settled_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+			$13, $14, $15, $16, $17)
+		RETURNING id`, paymentHash[:],
+		preimageBytes(invoice.Terms.PaymentPreimage), payAddr,
+		invoice.Memo, payReq, int64(invoice.Terms.Value),
+		invoice.Terms.FinalCltvDelta,
+		int64(invoice.Terms.Expiry/time.Second), features.Bytes(),
+		int16(invoice.State), int64(invoice.AmtPaid),
+		invoice.HodlInvoice,
+		invoice.Terms.Features.HasFeature(lnwire.AMPOptional),
+		int64(invoice.AddIndex), sqlIndex(invoice.SettleIndex),
+		invoice.CreationDate.UTC(), sqldb.NullTime(invoice.SettleDate),
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	for key, htlc := range invoice.Htlcs {
+		htlcID, err := upsertHtlc(ctx, tx, id, key, htlc)
+		if err != nil {
+			return 0, err
+		}
+
+		err = insertCustomRecords(ctx, tx, htlcID, htlc.CustomRecords)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	for setID, state := range invoice.AMPState {
+		err := upsertAMPState(ctx, tx, id, setID, state)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return id, nil
+}
+
+// persistInvoiceUpdate writes the changes of the passed invoice that result
+// from applying the update back to the database.
+func persistInvoiceUpdate(ctx context.Context, tx *sql.Tx, id int64,
+	invoice *channeldb.Invoice, update *channeldb.InvoiceUpdateDesc) error {
+
+	_, err := tx.ExecContext(ctx, `
+		UPDATE invoices SET preimage = $1, state = $2,
+			amount_paid_msat = $3, settle_index = $4,
+			settled_at = $5
+		WHERE id = $6`,
+		preimageBytes(invoice.Terms.PaymentPreimage),
+		int16(invoice.State), int64(invoice.AmtPaid),
+		sqlIndex(invoice.SettleIndex),
+		sqldb.NullTime(invoice.SettleDate), id,
+	)
+	if err != nil {
+		return err
+	}
+
+	// All HTLCs that were fetched may have been resolved by the update,
+	// so we'll write them all back. The custom records are only written
+	// for newly added HTLCs, as they never change afterwards.
+	for key, htlc := range invoice.Htlcs {
+		htlcID, err := upsertHtlc(ctx, tx, id, key, htlc)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := update.AddHtlcs[key]; !ok {
+			continue
+		}
+
+		err = insertCustomRecords(ctx, tx, htlcID, htlc.CustomRecords)
+		if err != nil {
+			return err
+		}
+	}
+
+	for setID, state := range invoice.AMPState {
+		err := upsertAMPState(ctx, tx, id, setID, state)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upsertHtlc inserts the passed HTLC of an invoice, or updates its resolution
+// if it exists already, and returns the ID of its row.
+func upsertHtlc(ctx context.Context, tx *sql.Tx, invoiceID int64,
+	key channeldb.CircuitKey, htlc *channeldb.InvoiceHTLC) (int64, error) {
+
+	var (
+		setID      interface{}
+		rootShare  interface{}
+		childIndex sql.NullInt64
+		hash       interface{}
+		preimage   interface{}
+	)
+	if htlc.AMP != nil {
+		ampSetID := htlc.AMP.Record.SetID()
+		ampRootShare := htlc.AMP.Record.RootShare()
+
+		setID = ampSetID[:]
+		rootShare = ampRootShare[:]
+		childIndex = sql.NullInt64{
+			Int64: int64(htlc.AMP.Record.ChildIndex()),
+			Valid: true,
+		}
+		hash = htlc.AMP.Hash[:]
+		preimage = preimageBytes(htlc.AMP.Preimage)
+	}
+
+	var id int64
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO invoice_htlcs (invoice_id, chan_id, htlc_id,
+			amount_msat, total_mpp_msat, accept_height,
+			accept_time, resolve_time, expiry_height, state,
+			set_id, root_share, child_index, hash, preimage)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+			$13, $14, $15)
+		ON CONFLICT (invoice_id, chan_id, htlc_id) DO UPDATE SET
+			resolve_time = excluded.resolve_time,
+			state = excluded.state,
+			preimage = excluded.preimage
+		RETURNING id`, invoiceID, int64(key.ChanID.ToUint64()),
+		int64(key.HtlcID), int64(htlc.Amt), int64(htlc.MppTotalAmt),
+		int64(htlc.AcceptHeight), htlc.AcceptTime.UTC(),
+		sqldb.NullTime(htlc.ResolveTime), int64(htlc.Expiry),
+		int16(htlc.State), setID, rootShare, childIndex, hash,
+		preimage,
+	).Scan(&id)
+
+	return id, err
+}
+
+// insertCustomRecords inserts the custom records of the HTLC with the given
+// row ID.
+func insertCustomRecords(ctx context.Context, tx *sql.Tx, htlcID int64,
+	records record.CustomSet) error {
+
+	for key, value := range records {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO invoice_htlc_custom_records (htlc_id,
+				record_key, record_value)
+			VALUES ($1, $2, $3)`, htlcID, int64(key), value,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// upsertAMPState inserts or updates the state of the AMP sub-invoice with the
+// given set ID.
+func upsertAMPState(ctx context.Context, tx *sql.Tx, invoiceID int64,
+	setID channeldb.SetID, state channeldb.InvoiceStateAMP) error {
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO amp_sub_invoices (set_id, invoice_id, state,
+			amount_paid_msat, settle_index, settled_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (set_id) DO UPDATE SET
+			state = excluded.state,
+			amount_paid_msat = excluded.amount_paid_msat,
+			settle_index = excluded.settle_index,
+			settled_at = excluded.settled_at`, setID[:], invoiceID,
+		int16(state.State), int64(state.AmtPaid),
+		sqlIndex(state.SettleIndex), sqldb.NullTime(state.SettleDate),
+	)
+
+	return err
+}
+
+// preimageBytes returns the bytes of the passed preimage, or NULL if it's
+// unknown.
+func preimageBytes(preimage *lntypes.Preimage) interface{} {
+	if preimage == nil {
+		return nil
+	}
+
+	return preimage[:]
+}
+
+// sqlIndex converts an add or settle index to its database representation,
+// which is NULL for an unassigned index.
+func sqlIndex(index uint64) sql.NullInt64 {
+	return sql.NullInt64{
+		Int64: int64(index),
+		Valid: index != 0,
+	}
+}
@@ -0,0 +1,555 @@
+package invoices
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/clock"
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/record"
+	"github.com/ltcsuite/lnd/sqldb"
+	"github.com/stretchr/testify/require"
+)
+
+var testAMPFeatures = lnwire.NewFeatureVector(
+	lnwire.NewRawFeatureVector(
+		lnwire.TLVOnionPayloadOptional,
+		lnwire.PaymentAddrOptional,
+		lnwire.AMPOptional,
+	), lnwire.Features,
+)
+
+// newTestSQLStore creates an SQL invoice store backed by a temporary sqlite
+// database.
+func newTestSQLStore(t *testing.T, clock clock.Clock) *SQLStore {
+	db, cleanUp, err := sqldb.NewTestSqliteStore()
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	return NewSQLStore(db, clock)
+}
+
+// preimageHash returns the hash of the preimage that consists of the passed
+// byte followed by zeroes.
+func preimageHash(b byte) lntypes.Hash {
+	preimage := lntypes.Preimage{b}
+	return preimage.Hash()
+}
+
+// newTestInvoiceDBs creates a kvdb and an SQL invoice store, which are
+// expected to behave identically.
+func newTestInvoiceDBs(t *testing.T) []InvoiceDB {
+	testClock := clock.NewTestClock(testTime)
+
+	cdb, cleanUp, err := newTestChannelDB(testClock)
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	return []InvoiceDB{cdb, newTestSQLStore(t, testClock)}
+}
+
+// normalizeInvoice normalizes the representation of the fields of an invoice
+// that may differ between the invoice stores without changing its meaning,
+// such as the location of timestamps.
+func normalizeInvoice(invoice channeldb.Invoice) channeldb.Invoice {
+	if len(invoice.Memo) == 0 {
+		invoice.Memo = nil
+	}
+	if len(invoice.PaymentRequest) == 0 {
+		invoice.PaymentRequest = nil
+	}
+	invoice.CreationDate = invoice.CreationDate.UTC()
+	invoice.SettleDate = invoice.SettleDate.UTC()
+
+	htlcs := make(map[channeldb.CircuitKey]*channeldb.InvoiceHTLC)
+	for key, htlc := range invoice.Htlcs {
+		htlc := htlc.Copy()
+		htlc.AcceptTime = htlc.AcceptTime.UTC()
+		htlc.ResolveTime = htlc.ResolveTime.UTC()
+		if len(htlc.CustomRecords) == 0 {
+			htlc.CustomRecords = nil
+		}
+
+		htlcs[key] = htlc
+	}
+	invoice.Htlcs = htlcs
+
+	ampState := make(channeldb.AMPInvoiceState)
+	for setID, state := range invoice.AMPState {
+		state.SettleDate = state.SettleDate.UTC()
+		ampState[setID] = state
+	}
+	invoice.AMPState = ampState
+
+	return invoice
+}
+
+// requireEqualInvoices asserts that the invoices returned by the different
+// invoice stores are equal.
+func requireEqualInvoices(t *testing.T, expected,
+	actual []channeldb.Invoice) {
+
+	t.Helper()
+
+	require.Len(t, actual, len(expected))
+	for i := range expected {
+		require.Equal(
+			t, normalizeInvoice(expected[i]),
+			normalizeInvoice(actual[i]),
+		)
+	}
+}
+
+// invoiceQuery is a query that returns a list of invoices.
+type invoiceQuery func(InvoiceDB) ([]channeldb.Invoice, error)
+
+// requireSameResults calls the passed query on all invoice stores, and
+// asserts that they return the same invoices and error.
+func requireSameResults(t *testing.T, dbs []InvoiceDB, query invoiceQuery) {
+
+	t.Helper()
+
+	expected, expectedErr := query(dbs[0])
+	for _, db := range dbs[1:] {
+		actual, err := query(db)
+		require.Equal(t, expectedErr, err)
+		requireEqualInvoices(t, expected, actual)
+	}
+}
+
+// lookupQuery returns a query that looks up the invoice with the passed
+// reference.
+func lookupQuery(ref channeldb.InvoiceRef) invoiceQuery {
+	return func(db InvoiceDB) ([]channeldb.Invoice, error) {
+		invoice, err := db.LookupInvoice(ref)
+		if err != nil {
+			return nil, err
+		}
+
+		return []channeldb.Invoice{invoice}, nil
+	}
+}
+
+// addedSinceQuery returns a query that returns the invoices added after the
+// passed add index.
+func addedSinceQuery(addIndex uint64) invoiceQuery {
+	return func(db InvoiceDB) ([]channeldb.Invoice, error) {
+		return db.InvoicesAddedSince(addIndex)
+	}
+}
+
+// settledSinceQuery returns a query that returns the invoices settled after
+// the passed settle index.
+func settledSinceQuery(settleIndex uint64) invoiceQuery {
+	return func(db InvoiceDB) ([]channeldb.Invoice, error) {
+		return db.InvoicesSettledSince(settleIndex)
+	}
+}
+
+// scanQuery is a query that returns all invoices found by ScanInvoices.
+func scanQuery(db InvoiceDB) ([]channeldb.Invoice, error) {
+	invoices := make(map[lntypes.Hash]channeldb.Invoice)
+	err := db.ScanInvoices(
+		func(hash lntypes.Hash, invoice *channeldb.Invoice) error {
+			invoices[hash] = *invoice
+			return nil
+		}, func() {
+			invoices = make(map[lntypes.Hash]channeldb.Invoice)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The invoices are scanned in different orders, so we'll sort them
+	// by their add index.
+	sorted := make([]channeldb.Invoice, 0, len(invoices))
+	for _, invoice := range invoices {
+		sorted = append(sorted, invoice)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].AddIndex < sorted[j].AddIndex
+	})
+
+	return sorted, nil
+}
+
+// htlcDescs is a shorthand for a set of HTLCs that are added to an invoice.
+type htlcDescs = map[channeldb.CircuitKey]*channeldb.HtlcAcceptDesc
+
+// acceptHtlcUpdate returns an update callback that adds an HTLC with the
+// given amount, and moves the invoice to the passed state if it's non-nil.
+func acceptHtlcUpdate(key channeldb.CircuitKey, amt lnwire.MilliSatoshi,
+	amp *channeldb.InvoiceHtlcAMPData,
+	state *channeldb.InvoiceStateUpdateDesc) func(*channeldb.Invoice) (
+	*channeldb.InvoiceUpdateDesc, error) {
+
+	return func(*channeldb.Invoice) (*channeldb.InvoiceUpdateDesc, error) {
+		return &channeldb.InvoiceUpdateDesc{
+			State: state,
+			AddHtlcs: htlcDescs{
+				key: {
+					AcceptHeight: testCurrentHeight,
+					Amt:          amt,
+					MppTotalAmt:  amt,
+					Expiry:       testHtlcExpiry,
+					CustomRecords: record.CustomSet{
+						record.CustomTypeStart: {1, 2},
+					},
+					AMP: amp,
+				},
+			},
+		}, nil
+	}
+}
+
+// cancelHtlcUpdate returns an update callback that cancels the HTLC with the
+// given circuit key.
+func cancelHtlcUpdate(key channeldb.CircuitKey) func(*channeldb.Invoice) (
+	*channeldb.InvoiceUpdateDesc, error) {
+
+	return func(*channeldb.Invoice) (*channeldb.InvoiceUpdateDesc, error) {
+		return &channeldb.InvoiceUpdateDesc{
+			CancelHtlcs: map[channeldb.CircuitKey]struct{}{
+				key: {},
+			},
+		}, nil
+	}
+}
+
+// stateUpdate returns an update callback that moves the invoice to the passed
+// state.
+func stateUpdate(state channeldb.InvoiceStateUpdateDesc) func(
+	*channeldb.Invoice) (*channeldb.InvoiceUpdateDesc, error) {
+
+	return func(*channeldb.Invoice) (*channeldb.InvoiceUpdateDesc, error) {
+		return &channeldb.InvoiceUpdateDesc{
+			State: &state,
+		}, nil
+	}
+}
+
+// populateInvoices adds a settled, a canceled, an open and a keysend invoice
+// to the passed invoice stores, and returns their payment hashes.
+func populateInvoices(t *testing.T, dbs []InvoiceDB) []lntypes.Hash {
+	settledPreimage := lntypes.Preimage{10}
+	settled := newTestInvoice(t, settledPreimage, testTime, 0)
+	settled.Memo = []byte("settled")
+
+	canceled := newTestInvoice(t, lntypes.Preimage{11}, testTime, 0)
+	canceled.HodlInvoice = true
+	canceled.Terms.PaymentPreimage = nil
+
+	open := newTestInvoice(t, lntypes.Preimage{12}, testTime, time.Minute)
+
+	keysendPreimage := lntypes.Preimage{13}
+	keysend := &channeldb.Invoice{
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: &keysendPreimage,
+			Value:           testInvoiceAmt,
+			Expiry:          time.Hour,
+			Features:        testFeatures,
+		},
+		CreationDate: testTime,
+	}
+
+	invoices := []*channeldb.Invoice{settled, canceled, open, keysend}
+	hashes := []lntypes.Hash{
+		settledPreimage.Hash(), preimageHash(11),
+		preimageHash(12), keysendPreimage.Hash(),
+	}
+
+	for _, db := range dbs {
+		for i, invoice := range invoices {
+			invoice := *invoice
+			addIndex, err := db.AddInvoice(&invoice, hashes[i])
+			require.NoError(t, err)
+			require.EqualValues(t, i+1, addIndex)
+			require.EqualValues(t, i+1, invoice.AddIndex)
+		}
+
+		// The settled invoice is paid by two HTLCs, one of which is
+		// canceled individually.
+		ref := channeldb.InvoiceRefByHash(hashes[0])
+		_, err := db.UpdateInvoice(
+			ref, nil, acceptHtlcUpdate(
+				getCircuitKey(1), testInvoiceAmt/2, nil, nil,
+			),
+		)
+		require.NoError(t, err)
+		_, err = db.UpdateInvoice(
+			ref, nil, cancelHtlcUpdate(getCircuitKey(1)),
+		)
+		require.NoError(t, err)
+		accepted := &channeldb.InvoiceStateUpdateDesc{
+			NewState: channeldb.ContractAccepted,
+		}
+		_, err = db.UpdateInvoice(
+			ref, nil, acceptHtlcUpdate(
+				getCircuitKey(2), testInvoiceAmt, nil, accepted,
+			),
+		)
+		require.NoError(t, err)
+
+		invoice, err := db.UpdateInvoice(
+			ref, nil, stateUpdate(channeldb.InvoiceStateUpdateDesc{
+				NewState: channeldb.ContractSettled,
+				Preimage: &settledPreimage,
+			}),
+		)
+		require.NoError(t, err)
+		require.Equal(t, channeldb.ContractSettled, invoice.State)
+		require.EqualValues(t, 1, invoice.SettleIndex)
+
+		_, err = db.UpdateInvoice(
+			channeldb.InvoiceRefByHash(hashes[1]), nil,
+			stateUpdate(channeldb.InvoiceStateUpdateDesc{
+				NewState: channeldb.ContractCanceled,
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	return hashes
+}
+
+// TestSQLStoreInvoices tests that the SQL invoice store behaves like the kvdb
+// invoice store when invoices are added, updated, queried and deleted.
+func TestSQLStoreInvoices(t *testing.T) {
+	t.Parallel()
+
+	dbs := newTestInvoiceDBs(t)
+	hashes := populateInvoices(t, dbs)
+
+	// Invoices with a known payment hash or payment address are rejected.
+	settled, err := dbs[0].LookupInvoice(channeldb.InvoiceRefByHash(
+		hashes[0],
+	))
+	require.NoError(t, err)
+	for _, db := range dbs {
+		preimage := lntypes.Preimage{10}
+		duplicate := newTestInvoice(t, preimage, testTime, 0)
+		_, err := db.AddInvoice(duplicate, hashes[0])
+		require.ErrorIs(t, err, channeldb.ErrDuplicateInvoice)
+
+		duplicate = newTestInvoice(t, lntypes.Preimage{20}, testTime, 0)
+		duplicate.Terms.PaymentAddr = settled.Terms.PaymentAddr
+		_, err = db.AddInvoice(duplicate, preimageHash(20))
+		require.ErrorIs(t, err, channeldb.ErrDuplicatePayAddr)
+	}
+
+	// The invoices are looked up by any kind of reference.
+	refs := []channeldb.InvoiceRef{
+		channeldb.InvoiceRefByHash(hashes[0]),
+		channeldb.InvoiceRefByAddr(settled.Terms.PaymentAddr),
+		channeldb.InvoiceRefByHashAndAddr(
+			hashes[0], settled.Terms.PaymentAddr,
+		),
+		channeldb.InvoiceRefByHashAndAddr(
+			hashes[1], settled.Terms.PaymentAddr,
+		),
+		channeldb.InvoiceRefByHash(hashes[3]),
+		channeldb.InvoiceRefByHash(lntypes.Hash{1}),
+	}
+	for _, ref := range refs {
+		requireSameResults(t, dbs, lookupQuery(ref))
+	}
+
+	requireSameResults(t, dbs, scanQuery)
+	for _, index := range []uint64{0, 1, 3, 10} {
+		requireSameResults(t, dbs, addedSinceQuery(index))
+		requireSameResults(t, dbs, settledSinceQuery(index))
+	}
+
+	// Queries are paginated by the add index in both directions.
+	queries := []channeldb.InvoiceQuery{
+		{NumMaxInvoices: 10},
+		{NumMaxInvoices: 2},
+		{IndexOffset: 1, NumMaxInvoices: 2},
+		{IndexOffset: 2, NumMaxInvoices: 10, PendingOnly: true},
+		{NumMaxInvoices: 2, Reversed: true},
+		{IndexOffset: 4, NumMaxInvoices: 2, Reversed: true},
+		{IndexOffset: 10, NumMaxInvoices: 1, Reversed: true},
+		{IndexOffset: 1, NumMaxInvoices: 10, Reversed: true},
+		{NumMaxInvoices: 10, PendingOnly: true, Reversed: true},
+	}
+	for _, q := range queries {
+		expected, err := dbs[0].QueryInvoices(q)
+		require.NoError(t, err)
+
+		actual, err := dbs[1].QueryInvoices(q)
+		require.NoError(t, err)
+		require.Equal(t, expected.InvoiceQuery, actual.InvoiceQuery)
+		require.Equal(t, expected.FirstIndexOffset,
+			actual.FirstIndexOffset)
+		require.Equal(t, expected.LastIndexOffset,
+			actual.LastIndexOffset)
+		requireEqualInvoices(t, expected.Invoices, actual.Invoices)
+	}
+
+	// Finally, we'll delete the settled invoice, which can't be looked up
+	// afterwards.
+	for _, db := range dbs {
+		err := db.DeleteInvoice([]channeldb.InvoiceDeleteRef{{
+			PayHash:     hashes[0],
+			PayAddr:     &settled.Terms.PaymentAddr,
+			AddIndex:    settled.AddIndex,
+			SettleIndex: settled.SettleIndex,
+		}})
+		require.NoError(t, err)
+
+		_, err = db.LookupInvoice(channeldb.InvoiceRefByHash(hashes[0]))
+		require.ErrorIs(t, err, channeldb.ErrInvoiceNotFound)
+	}
+	requireSameResults(t, dbs, scanQuery)
+}
+
+// TestSQLStoreAMPInvoices tests that the SQL invoice store keeps the HTLC sets
+// of AMP invoices like the kvdb invoice store.
+func TestSQLStoreAMPInvoices(t *testing.T) {
+	t.Parallel()
+
+	dbs := newTestInvoiceDBs(t)
+
+	invoice := newTestInvoice(t, lntypes.Preimage{1}, testTime, 0)
+	invoice.Terms.Features = testAMPFeatures
+	invoice.Terms.PaymentPreimage = nil
+	payHash := preimageHash(1)
+	payAddr := invoice.Terms.PaymentAddr
+
+	other := newTestInvoice(t, lntypes.Preimage{2}, testTime, 0)
+	other.Terms.Features = testAMPFeatures
+	other.Terms.PaymentPreimage = nil
+
+	setIDs := []channeldb.SetID{{1}, {2}, {3}}
+	for _, db := range dbs {
+		invoice := *invoice
+		_, err := db.AddInvoice(&invoice, payHash)
+		require.NoError(t, err)
+
+		// Each of the sets is paid by a single HTLC, and all but the
+		// last set are settled.
+		ref := channeldb.InvoiceRefByAddr(payAddr)
+		for i, setID := range setIDs {
+			setID := setID
+			key := channeldb.CircuitKey{HtlcID: uint64(i)}
+			childPreimage := lntypes.Preimage{byte(i)}
+
+			amp := &channeldb.InvoiceHtlcAMPData{
+				Record: *record.NewAMP(
+					[32]byte{}, setID, uint32(i),
+				),
+				Hash: childPreimage.Hash(),
+			}
+			accepted := &channeldb.InvoiceStateUpdateDesc{
+				NewState: channeldb.ContractAccepted,
+				SetID:    (*[32]byte)(&setID),
+			}
+			_, err := db.UpdateInvoice(
+				ref, &setID, acceptHtlcUpdate(
+					key, testInvoiceAmt, amp, accepted,
+				),
+			)
+			require.NoError(t, err)
+
+			if i == len(setIDs)-1 {
+				break
+			}
+
+			preimages := map[channeldb.CircuitKey]lntypes.Preimage{
+				key: childPreimage,
+			}
+			settled := channeldb.InvoiceStateUpdateDesc{
+				NewState:      channeldb.ContractSettled,
+				SetID:         (*[32]byte)(&setID),
+				HTLCPreimages: preimages,
+			}
+			_, err = db.UpdateInvoice(
+				ref, &setID, stateUpdate(settled),
+			)
+			require.NoError(t, err)
+		}
+
+		// A set ID can't be used for another invoice.
+		other := *other
+		_, err = db.AddInvoice(&other, preimageHash(2))
+		require.NoError(t, err)
+
+		amp := &channeldb.InvoiceHtlcAMPData{
+			Record: *record.NewAMP([32]byte{}, setIDs[0], 0),
+		}
+		_, err = db.UpdateInvoice(
+			channeldb.InvoiceRefByAddr(other.Terms.PaymentAddr),
+			&setIDs[0], acceptHtlcUpdate(
+				channeldb.CircuitKey{HtlcID: 10},
+				testInvoiceAmt, amp, nil,
+			),
+		)
+		require.IsType(t, channeldb.ErrDuplicateSetID{}, err)
+	}
+
+	refs := []channeldb.InvoiceRef{
+		channeldb.InvoiceRefByAddr(payAddr),
+		channeldb.InvoiceRefByHash(payHash),
+		channeldb.InvoiceRefByAddrBlankHtlc(payAddr),
+		channeldb.InvoiceRefBySetID(setIDs[1]),
+		channeldb.InvoiceRefBySetIDFiltered(setIDs[1]),
+		channeldb.InvoiceRefBySetIDFiltered(setIDs[2]),
+		channeldb.InvoiceRefBySetIDFiltered(channeldb.SetID{9}),
+	}
+	for _, ref := range refs {
+		requireSameResults(t, dbs, lookupQuery(ref))
+	}
+
+	requireSameResults(t, dbs, scanQuery)
+	requireSameResults(t, dbs, settledSinceQuery(1))
+}
+
+// TestMigrateInvoicesToSQL tests that the invoices of the kvdb invoice store
+// are migrated to the SQL invoice store, after which new invoices are indexed
+// after the migrated ones.
+func TestMigrateInvoicesToSQL(t *testing.T) {
+	t.Parallel()
+
+	dbs := newTestInvoiceDBs(t)
+	populateInvoices(t, dbs[:1])
+
+	sqlStore := dbs[1].(*SQLStore)
+	require.NoError(t, MigrateInvoicesToSQL(dbs[0], sqlStore))
+
+	requireSameResults(t, dbs, scanQuery)
+	requireSameResults(t, dbs, settledSinceQuery(0))
+
+	// A second migration is a no-op.
+	require.NoError(t, MigrateInvoicesToSQL(dbs[0], sqlStore))
+	requireSameResults(t, dbs, scanQuery)
+
+	// New invoices and settles continue the indexes of the migrated
+	// invoices.
+	preimage := lntypes.Preimage{30}
+	newInvoice := newTestInvoice(t, preimage, testTime, 0)
+	for _, db := range dbs {
+		invoice := *newInvoice
+		addIndex, err := db.AddInvoice(&invoice, preimage.Hash())
+		require.NoError(t, err)
+		require.EqualValues(t, 5, addIndex)
+
+		ref := channeldb.InvoiceRefByHash(preimage.Hash())
+		settled := &channeldb.InvoiceStateUpdateDesc{
+			NewState: channeldb.ContractSettled,
+			Preimage: &preimage,
+		}
+		_, err = db.UpdateInvoice(
+			ref, nil, acceptHtlcUpdate(
+				getCircuitKey(1), testInvoiceAmt, nil, settled,
+			),
+		)
+		require.NoError(t, err)
+	}
+
+	requireSameResults(t, dbs, scanQuery)
+	requireSameResults(t, dbs, settledSinceQuery(1))
+}
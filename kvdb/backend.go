@@ -238,12 +238,11 @@ func updateLastCompactionDate(dbFile string) error {
 	return ioutil.WriteFile(tsFile, tsBytes[:], 0600)
 }
 
-// GetTestBackend opens (or creates if doesn't exist) a bbolt or etcd
-// backed database (for testing), and returns a kvdb.Backend and a cleanup
-// func. Whether to create/open bbolt or embedded etcd database is based
-// on the TestBackend constant which is conditionally compiled with build tag.
-// The passed path is used to hold all db files, while the name is only used
-// for bbolt.
+// GetTestBackend opens (or creates if doesn't exist) a bbolt, etcd, postgres
+// or sqlite backed database (for testing), and returns a kvdb.Backend and a
+// cleanup func. Which database is created/opened is based on the build tags
+// the tests are compiled with. The passed path is used to hold all db files,
+// while the name is only used for bbolt and sqlite.
 func GetTestBackend(path, name string) (Backend, func(), error) {
 	empty := func() {}
 
@@ -260,6 +259,15 @@ func GetTestBackend(path, name string) (Backend, func(), error) {
 			_ = f.DB().Close()
 		}, nil
 
+	case SqliteBackend:
+		db, err := StartSqliteTestBackend(path, name, "test")
+		if err != nil {
+			return nil, empty, err
+		}
+		return db, func() {
+			_ = db.Close()
+		}, nil
+
 	case TestBackend == BoltBackendName:
 		db, err := GetBoltBackend(&BoltBackendConfig{
 			DBPath:         path,
@@ -0,0 +1,19 @@
+//go:build !kvdb_sqlite
+// +build !kvdb_sqlite
+
+package kvdb
+
+import (
+	"errors"
+
+	"github.com/ltcsuite/ltcwallet/walletdb"
+)
+
+// SqliteBackend is false when the kvdb_sqlite build tag is not defined.
+const SqliteBackend = false
+
+// StartSqliteTestBackend is a stub returning an error, as the sqlite backend
+// is not available.
+func StartSqliteTestBackend(path, name, table string) (walletdb.DB, error) {
+	return nil, errors.New("sqlite backend not available")
+}
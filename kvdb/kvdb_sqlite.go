@@ -0,0 +1,29 @@
+//go:build kvdb_sqlite
+// +build kvdb_sqlite
+
+package kvdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/ltcsuite/lnd/kvdb/sqlbase"
+	"github.com/ltcsuite/lnd/kvdb/sqlite"
+	"github.com/ltcsuite/ltcwallet/walletdb"
+)
+
+// SqliteBackend is conditionally set to true when the kvdb_sqlite build tag is
+// defined, allowing testing our database code with the sqlite backend.
+const SqliteBackend = true
+
+// StartSqliteTestBackend opens a sqlite backend for testing, storing the
+// database in the file with the passed name within the passed directory.
+func StartSqliteTestBackend(path, name, table string) (walletdb.DB, error) {
+	sqlbase.Init(0)
+
+	return sqlite.NewSqliteBackend(
+		context.Background(), &sqlite.Config{
+			BusyTimeout: 5 * time.Second,
+		}, path, name, table,
+	)
+}
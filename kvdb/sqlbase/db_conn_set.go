@@ -1,11 +1,9 @@
-package postgres
+package sqlbase
 
 import (
 	"database/sql"
 	"fmt"
 	"sync"
-
-	_ "github.com/jackc/pgx/v4/stdlib"
 )
 
 // dbConn stores the actual connection and a user count.
@@ -30,9 +28,10 @@ func newDbConnSet(maxConnections int) *dbConnSet {
 	}
 }
 
-// Open opens a new database connection. If a connection already exists for the
-// given dsn, the existing connection is returned.
-func (d *dbConnSet) Open(dsn string) (*sql.DB, error) {
+// Open opens a new database connection using the given registered sql driver.
+// If a connection already exists for the given dsn, the existing connection is
+// returned.
+func (d *dbConnSet) Open(driver, dsn string) (*sql.DB, error) {
 	d.Lock()
 	defer d.Unlock()
 
@@ -42,7 +41,7 @@ func (d *dbConnSet) Open(dsn string) (*sql.DB, error) {
 		return dbConn.db, nil
 	}
 
-	db, err := sql.Open("pgx", dsn)
+	db, err := sql.Open(driver, dsn)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,7 @@
-//go:build kvdb_postgres
-// +build kvdb_postgres
+//go:build kvdb_postgres || kvdb_sqlite
+// +build kvdb_postgres kvdb_sqlite
 
-package postgres
+package sqlbase
 
 import (
 	"database/sql"
@@ -163,8 +163,8 @@ func (b *readWriteBucket) CreateBucket(key []byte) (
 		return nil, err
 	}
 
-	// Bucket does not yet exist, so create it. Postgres will generate a
-	// bucket id for the new bucket.
+	// Bucket does not yet exist, so create it. The database will generate
+	// a bucket id for the new bucket.
 	row, cancel = b.tx.QueryRow(
 		"INSERT INTO "+b.table+" (parent_id, key) "+
 			"VALUES($1, $2) RETURNING id", b.id, key,
@@ -203,8 +203,8 @@ func (b *readWriteBucket) CreateBucketIfNotExists(key []byte) (
 	err := row.Scan(&id, &value)
 
 	switch {
-	// Bucket does not yet exist, so create it now. Postgres will generate a
-	// bucket id for the new bucket.
+	// Bucket does not yet exist, so create it now. The database will
+	// generate a bucket id for the new bucket.
 	case err == sql.ErrNoRows:
 		row, cancel := b.tx.QueryRow(
 			"INSERT INTO "+b.table+" (parent_id, key) "+
@@ -278,7 +278,8 @@ func (b *readWriteBucket) Put(key, value []byte) error {
 	// below.
 	if b.id == nil {
 		// ON CONFLICT requires the WHERE parent_id IS NULL hint to let
-		// Postgres find the NULL-parent_id unique index (<table>_unp).
+		// the database find the NULL-parent_id unique index
+		// (<table>_unp).
 		result, err = b.tx.Exec(
 			"INSERT INTO "+b.table+" (key, value) VALUES($1, $2) "+
 				"ON CONFLICT (key) WHERE parent_id IS NULL "+
@@ -288,7 +289,7 @@ func (b *readWriteBucket) Put(key, value []byte) error {
 		)
 	} else {
 		// ON CONFLICT requires the WHERE parent_id NOT IS NULL hint to
-		// let Postgres find the non-NULL-parent_id unique index
+		// let the database find the non-NULL-parent_id unique index
 		// (<table>_up).
 		result, err = b.tx.Exec(
 			"INSERT INTO "+b.table+" (key, value, parent_id) "+
@@ -382,9 +383,11 @@ func (b *readWriteBucket) SetSequence(v uint64) error {
 		panic("sequence not supported on top level bucket")
 	}
 
+	// The placeholders are numbered in the order of their appearance,
+	// as sqlite binds the arguments in that order.
 	result, err := b.tx.Exec(
-		"UPDATE "+b.table+" SET sequence=$2 WHERE id=$1",
-		b.id, int64(v),
+		"UPDATE "+b.table+" SET sequence=$1 WHERE id=$2",
+		int64(v), b.id,
 	)
 	if err != nil {
 		return err
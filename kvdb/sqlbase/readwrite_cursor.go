@@ -1,7 +1,7 @@
-//go:build kvdb_postgres
-// +build kvdb_postgres
+//go:build kvdb_postgres || kvdb_sqlite
+// +build kvdb_postgres kvdb_sqlite
 
-package postgres
+package sqlbase
 
 import (
 	"database/sql"
@@ -1,7 +1,7 @@
-//go:build kvdb_postgres
-// +build kvdb_postgres
+//go:build kvdb_postgres || kvdb_sqlite
+// +build kvdb_postgres kvdb_sqlite
 
-package postgres
+package sqlbase
 
 import (
 	"context"
@@ -11,7 +11,7 @@ import (
 	"github.com/ltcsuite/ltcwallet/walletdb"
 )
 
-// readWriteTx holds a reference to an open postgres transaction.
+// readWriteTx holds a reference to an open sql transaction.
 type readWriteTx struct {
 	db *db
 	tx *sql.Tx
@@ -30,10 +30,10 @@ type readWriteTx struct {
 // specified pool.
 func newReadWriteTx(db *db, readOnly bool) (*readWriteTx, error) {
 	// Obtain the global lock instance. An alternative here is to obtain a
-	// database lock from Postgres. Unfortunately there is no database-level
-	// lock in Postgres, meaning that each table would need to be locked
-	// individually. Perhaps an advisory lock could perform this function
-	// too.
+	// database lock from the database. Unfortunately there is no
+	// database-level lock in Postgres, meaning that each table would need
+	// to be locked individually. Perhaps an advisory lock could perform
+	// this function too.
 	var locker sync.Locker = &db.lock
 	if readOnly {
 		locker = db.lock.RLocker()
@@ -0,0 +1,78 @@
+//go:build kvdb_postgres || kvdb_sqlite
+// +build kvdb_postgres kvdb_sqlite
+
+package sqlbase
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SQLiteCmdReplacements is a one to one mapping of the postgres keywords that
+// are used in the schema creation command, to the sqlite keywords that should
+// replace them.
+type SQLiteCmdReplacements map[string]string
+
+// newKVSchemaCreationCmd returns the command that sets up a kv table. Every row
+// points to the bucket that it is one via its parent_id field. A NULL
+// parent_id means that the key belongs to the upper-most bucket in this table.
+// A constraint on parent_id is enforcing referential integrity.
+//
+// Furthermore there is a <table>_p index on parent_id that is required for the
+// foreign key constraint.
+//
+// Finally there are unique indices on (parent_id, key) to prevent the same key
+// being present in a bucket more than once (<table>_up and <table>_unp). In
+// postgres, a single index wouldn't enforce the unique constraint on rows with
+// a NULL parent_id. Therefore two indices are defined.
+//
+// If a schema is given, the table is created within it. The command is written
+// in the postgres dialect, and the passed replacements are applied to it to
+// translate it to other dialects.
+func newKVSchemaCreationCmd(table, schema string,
+	replacements SQLiteCmdReplacements) string {
+
+	var (
+		tableInSchema = table
+		finalCmd      string
+	)
+	if schema != "" {
+		finalCmd = fmt.Sprintf(
+			"CREATE SCHEMA IF NOT EXISTS %s;", schema,
+		)
+		tableInSchema = fmt.Sprintf("%s.%s", schema, table)
+	}
+
+	finalCmd += fmt.Sprintf(`
+CREATE TABLE IF NOT EXISTS %s
+(
+    key BYTEA NOT NULL,
+    value BYTEA,
+    parent_id BIGINT,
+    id BIGSERIAL PRIMARY KEY,
+    sequence BIGINT,
+    CONSTRAINT %s_parent FOREIGN KEY (parent_id)
+        REFERENCES %s (id)
+        ON UPDATE NO ACTION
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS %s_p
+    ON %s (parent_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS %s_up
+    ON %s
+    (parent_id, key) WHERE parent_id IS NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS %s_unp
+    ON %s (key) WHERE parent_id IS NULL;
+`, tableInSchema, table, tableInSchema, table, tableInSchema, table,
+		tableInSchema, table, tableInSchema,
+	)
+
+	for from, to := range replacements {
+		finalCmd = strings.Replace(finalCmd, from, to, -1)
+	}
+
+	return finalCmd
+}
@@ -0,0 +1,10 @@
+package sqlite
+
+import "time"
+
+// Config holds sqlite configuration data.
+type Config struct {
+	Timeout        time.Duration `long:"timeout" description:"The time after which a database query should be timed out. Set to zero to disable."`
+	BusyTimeout    time.Duration `long:"busytimeout" description:"The maximum amount of time to wait for the database file to be unlocked by another connection before a query fails."`
+	MaxConnections int           `long:"maxconnections" description:"The maximum number of open connections to the database. Set to zero for unlimited."`
+}
@@ -0,0 +1,65 @@
+//go:build kvdb_sqlite
+// +build kvdb_sqlite
+
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/ltcsuite/lnd/kvdb/sqlbase"
+	"github.com/ltcsuite/ltcwallet/walletdb"
+
+	// Register the sqlite3 sql driver.
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqliteCmdReplacements defines a mapping from some postgres keywords that are
+// used in the kv schema to the equivalent sqlite keywords.
+var sqliteCmdReplacements = sqlbase.SQLiteCmdReplacements{
+	"BYTEA":                 "BLOB",
+	"BIGSERIAL PRIMARY KEY": "INTEGER PRIMARY KEY",
+}
+
+// NewSqliteBackend returns a db object initialized with the passed backend
+// config. The database is kept in the file with the given name within the
+// given directory, which is created if it doesn't exist yet.
+func NewSqliteBackend(ctx context.Context, cfg *Config, dbPath,
+	fileName, prefix string) (walletdb.DB, error) {
+
+	if err := os.MkdirAll(dbPath, 0700); err != nil {
+		return nil, err
+	}
+
+	// Foreign keys are disabled by default in sqlite, so we'll enable them
+	// to have deletions of buckets cascade. The write-ahead log lets
+	// readers proceed while a write transaction is open, and together
+	// with a full sync on every commit keeps the file consistent if the
+	// process or machine crashes.
+	options := url.Values{}
+	options.Set("_foreign_keys", "on")
+	options.Set("_journal_mode", "WAL")
+	options.Set("_synchronous", "FULL")
+	options.Set(
+		"_busy_timeout",
+		fmt.Sprintf("%d", cfg.BusyTimeout.Milliseconds()),
+	)
+
+	dsn := fmt.Sprintf(
+		"file:%s?%s", filepath.Join(dbPath, fileName),
+		options.Encode(),
+	)
+
+	sqlCfg := &sqlbase.Config{
+		DriverName:            "sqlite3",
+		Dsn:                   dsn,
+		Timeout:               cfg.Timeout,
+		TableNamePrefix:       prefix,
+		SQLiteCmdReplacements: sqliteCmdReplacements,
+	}
+
+	return sqlbase.NewSqlBackend(ctx, sqlCfg)
+}
@@ -0,0 +1,27 @@
+//go:build kvdb_sqlite
+// +build kvdb_sqlite
+
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ltcsuite/lnd/kvdb/sqlbase"
+	"github.com/ltcsuite/ltcwallet/walletdb/walletdbtest"
+)
+
+// TestInterface performs all interfaces tests for this database driver.
+func TestInterface(t *testing.T) {
+	sqlbase.Init(0)
+
+	ctx := context.Background()
+	cfg := &Config{
+		BusyTimeout: time.Second * 5,
+	}
+
+	walletdbtest.TestInterface(
+		t, dbType, ctx, cfg, t.TempDir(), "tmp.db", "test",
+	)
+}
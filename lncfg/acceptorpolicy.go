@@ -0,0 +1,119 @@
+package lncfg
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ltcsuite/lnd/chanacceptor"
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/ltcutil"
+)
+
+// AcceptorPolicy holds the configuration of a static policy that inbound
+// channels are checked against, in addition to any RPC channel acceptors.
+type AcceptorPolicy struct {
+	MinChanSize int64 `long:"minchansize" description:"The smallest channel size (in litoshis) that we accept from remote nodes. Set to 0 to not enforce a minimum."`
+
+	MaxChanSize int64 `long:"maxchansize" description:"The largest channel size (in litoshis) that we accept from remote nodes. Set to 0 to not enforce a maximum."`
+
+	AllowedPeersRaw []string `long:"allowedpeer" description:"The hex-encoded pubkey of a node that is allowed to open channels to us. Can be specified multiple times. If no peers are set, all nodes are allowed to open channels."`
+
+	RequiredFeatures []uint16 `long:"requiredfeature" description:"A feature bit that nodes must signal, either as optional or required, to open channels to us. Can be specified multiple times."`
+
+	CommitTypesRaw []string `long:"committype" description:"A commitment type that inbound channels may use. Can be specified multiple times. If no types are set, all commitment types are accepted." choice:"legacy" choice:"tweakless" choice:"anchors-zero-fee-second-level" choice:"script-enforced-lease"`
+
+	MaxPendingChans int `long:"maxpendingchans" description:"The maximum number of channels a single node may have pending open with us at the same time. Set to 0 to only enforce the global maxpendingchannels limit."`
+
+	// Policy is the parsed policy, or nil if no policy is configured.
+	Policy *chanacceptor.PolicyConfig
+}
+
+// Validate checks the configured policy and parses it into a
+// chanacceptor.PolicyConfig.
+//
+// NOTE: This is part of the Validator interface.
+func (a *AcceptorPolicy) Validate() error {
+	a.Policy = nil
+
+	if a.MinChanSize < 0 || a.MaxChanSize < 0 {
+		return fmt.Errorf("acceptorpolicy channel sizes must be " +
+			"non-negative")
+	}
+	if a.MaxChanSize != 0 && a.MinChanSize > a.MaxChanSize {
+		return fmt.Errorf("acceptorpolicy.minchansize must not " +
+			"exceed acceptorpolicy.maxchansize")
+	}
+	if a.MaxPendingChans < 0 {
+		return fmt.Errorf("acceptorpolicy.maxpendingchans must be " +
+			"non-negative")
+	}
+
+	policy := &chanacceptor.PolicyConfig{
+		MinChanSize:     ltcutil.Amount(a.MinChanSize),
+		MaxChanSize:     ltcutil.Amount(a.MaxChanSize),
+		MaxPendingChans: a.MaxPendingChans,
+	}
+
+	for _, pubKeyStr := range a.AllowedPeersRaw {
+		pubKeyBytes, err := hex.DecodeString(pubKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid acceptorpolicy allowed "+
+				"peer %v: %v", pubKeyStr, err)
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes)
+		if err != nil {
+			return fmt.Errorf("invalid acceptorpolicy allowed "+
+				"peer %v: %v", pubKeyStr, err)
+		}
+		policy.AllowedPeers = append(policy.AllowedPeers, pubKey)
+	}
+
+	for _, bit := range a.RequiredFeatures {
+		policy.RequiredFeatures = append(
+			policy.RequiredFeatures, lnwire.FeatureBit(bit),
+		)
+	}
+
+	for _, commitTypeStr := range a.CommitTypesRaw {
+		commitType, err := parseCommitmentType(commitTypeStr)
+		if err != nil {
+			return err
+		}
+		policy.CommitTypes = append(policy.CommitTypes, commitType)
+	}
+
+	// We only enable the policy acceptor if at least one of the checks is
+	// configured.
+	if policy.MinChanSize != 0 || policy.MaxChanSize != 0 ||
+		policy.MaxPendingChans != 0 || len(policy.AllowedPeers) != 0 ||
+		len(policy.RequiredFeatures) != 0 ||
+		len(policy.CommitTypes) != 0 {
+
+		a.Policy = policy
+	}
+
+	return nil
+}
+
+// parseCommitmentType returns the commitment type with the given name.
+func parseCommitmentType(name string) (lnwallet.CommitmentType, error) {
+	commitTypes := []lnwallet.CommitmentType{
+		lnwallet.CommitmentTypeLegacy,
+		lnwallet.CommitmentTypeTweakless,
+		lnwallet.CommitmentTypeAnchorsZeroFeeHtlcTx,
+		lnwallet.CommitmentTypeScriptEnforcedLease,
+	}
+	for _, commitType := range commitTypes {
+		if commitType.String() == name {
+			return commitType, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown acceptorpolicy commitment type %v", name)
+}
+
+// Compile-time constraint to ensure AcceptorPolicy implements the Validator
+// interface.
+var _ Validator = (*AcceptorPolicy)(nil)
@@ -16,6 +16,10 @@ const (
 	// pending channels permitted per peer.
 	DefaultMaxPendingChannels = 1
 
+	// DefaultMaxPendingInbound is the default maximum number of incoming
+	// pending channels permitted across all peers.
+	DefaultMaxPendingInbound = 50
+
 	// DefaultIncomingBroadcastDelta defines the number of blocks before the
 	// expiry of an incoming htlc at which we force close the channel. We
 	// only go to chain if we also have the preimage to actually pull in the
@@ -40,6 +44,12 @@ const (
 	// push us in the broadcast window.
 	DefaultFinalCltvRejectDelta = DefaultIncomingBroadcastDelta + 3
 
+	// DefaultHeldForwardFailDelta defines the number of blocks before the
+	// expiry of an incoming htlc held by the htlc interceptor at which we
+	// cancel it back. Just like for exit hop htlcs, this prevents a held
+	// htlc from pushing us into the incoming broadcast window.
+	DefaultHeldForwardFailDelta = DefaultFinalCltvRejectDelta
+
 	// DefaultOutgoingBroadcastDelta defines the number of blocks before the
 	// expiry of an outgoing htlc at which we force close the channel. We
 	// are not in a hurry to force close, because there is nothing to claim
@@ -8,7 +8,10 @@ import (
 	"github.com/ltcsuite/lnd/kvdb"
 	"github.com/ltcsuite/lnd/kvdb/etcd"
 	"github.com/ltcsuite/lnd/kvdb/postgres"
+	"github.com/ltcsuite/lnd/kvdb/sqlbase"
+	"github.com/ltcsuite/lnd/kvdb/sqlite"
 	"github.com/ltcsuite/lnd/lnwallet/btcwallet"
+	"github.com/ltcsuite/lnd/sqldb"
 )
 
 const (
@@ -18,12 +21,23 @@ const (
 	towerClientDBName = "wtclient.db"
 	towerServerDBName = "watchtower.db"
 
+	// The sqlite database files use their own extension, so they can't be
+	// mistaken for the bolt files of the same namespace.
+	sqliteChannelDBName     = "channel.sqlite"
+	sqliteMacaroonDBName    = "macaroons.sqlite"
+	sqliteDecayedLogDbName  = "sphinxreplay.sqlite"
+	sqliteTowerClientDBName = "wtclient.sqlite"
+	sqliteTowerServerDBName = "watchtower.sqlite"
+	sqliteWalletDBName      = "wallet.sqlite"
+
 	BoltBackend                = "bolt"
 	EtcdBackend                = "etcd"
 	PostgresBackend            = "postgres"
+	SqliteBackend              = "sqlite"
 	DefaultBatchCommitInterval = 500 * time.Millisecond
 
 	defaultPostgresMaxConnections = 50
+	defaultSqliteBusyTimeout      = 5 * time.Second
 
 	// NSChannelDB is the namespace name that we use for the combined graph
 	// and channel state DB.
@@ -46,6 +60,10 @@ const (
 
 	// NSWalletDB is the namespace name that we use for the wallet DB.
 	NSWalletDB = "walletdb"
+
+	// NSNativeSQLDB is the name that we use for the native SQL tables,
+	// which live next to the kvdb namespaces in the postgres database.
+	NSNativeSQLDB = "nativesqldb"
 )
 
 // DB holds database configuration for LND.
@@ -60,7 +78,11 @@ type DB struct {
 
 	Postgres *postgres.Config `group:"postgres" namespace:"postgres" description:"Postgres settings."`
 
+	Sqlite *sqlite.Config `group:"sqlite" namespace:"sqlite" description:"Sqlite settings."`
+
 	NoGraphCache bool `long:"no-graph-cache" description:"Don't use the in-memory graph cache for path finding. Much slower but uses less RAM. Can only be used with a bolt database backend."`
+
+	UseNativeSQL bool `long:"use-native-sql" description:"Store invoices and payments in native SQL tables instead of the key-value store. Existing invoices and payments are migrated on startup. Can only be used with a postgres database backend."`
 }
 
 // DefaultDB creates and returns a new default DB config.
@@ -80,6 +102,9 @@ func DefaultDB() *DB {
 		Postgres: &postgres.Config{
 			MaxConnections: defaultPostgresMaxConnections,
 		},
+		Sqlite: &sqlite.Config{
+			BusyTimeout: defaultSqliteBusyTimeout,
+		},
 	}
 }
 
@@ -97,9 +122,12 @@ func (db *DB) Validate() error {
 			return fmt.Errorf("etcd host must be set")
 		}
 
+	case SqliteBackend:
+
 	default:
-		return fmt.Errorf("unknown backend, must be either '%v' or "+
-			"'%v'", BoltBackend, EtcdBackend)
+		return fmt.Errorf("unknown backend, must be one of '%v', "+
+			"'%v', '%v' or '%v'", BoltBackend, EtcdBackend,
+			PostgresBackend, SqliteBackend)
 	}
 
 	// The path finding uses a manual read transaction that's open for a
@@ -115,6 +143,11 @@ func (db *DB) Validate() error {
 			"backend '%v'", db.Backend)
 	}
 
+	if db.UseNativeSQL && db.Backend != PostgresBackend {
+		return fmt.Errorf("cannot use use-native-sql with database "+
+			"backend '%v'", db.Backend)
+	}
+
 	return nil
 }
 
@@ -137,7 +170,10 @@ func (db *DB) Init(ctx context.Context, dbPath string) error {
 		db.Etcd = cfg
 
 	case db.Backend == PostgresBackend:
-		postgres.Init(db.Postgres.MaxConnections)
+		sqlbase.Init(db.Postgres.MaxConnections)
+
+	case db.Backend == SqliteBackend:
+		sqlbase.Init(db.Sqlite.MaxConnections)
 	}
 
 	return nil
@@ -180,6 +216,10 @@ type DatabaseBackends struct {
 	// the underlying wallet database from.
 	WalletDB btcwallet.LoaderOption
 
+	// NativeSQLStore points to the native SQL database that holds the
+	// relational tables. This is nil unless native SQL is enabled.
+	NativeSQLStore *sqldb.DB
+
 	// Remote indicates whether the database backends are remote, possibly
 	// replicated instances or local bbolt backed databases.
 	Remote bool
@@ -366,6 +406,18 @@ func (db *DB) GetBackends(ctx context.Context, chanDBPath,
 		}
 		closeFuncs[NSWalletDB] = postgresWalletBackend.Close
 
+		var nativeSQLStore *sqldb.DB
+		if db.UseNativeSQL {
+			nativeSQLStore, err = sqldb.NewPostgresStore(
+				db.Postgres,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error opening native "+
+					"postgres store: %v", err)
+			}
+			closeFuncs[NSNativeSQLDB] = nativeSQLStore.Close
+		}
+
 		returnEarly = false
 		return &DatabaseBackends{
 			GraphDB:       postgresBackend,
@@ -383,7 +435,102 @@ func (db *DB) GetBackends(ctx context.Context, chanDBPath,
 			WalletDB: btcwallet.LoaderWithExternalWalletDB(
 				postgresWalletBackend,
 			),
-			Remote:     true,
+			NativeSQLStore: nativeSQLStore,
+			Remote:         true,
+			CloseFuncs:     closeFuncs,
+		}, nil
+
+	case SqliteBackend:
+		// Like with bolt, every namespace is kept in its own database
+		// file. That way the files are laid out just like the bolt
+		// ones and writes to one of them don't block the others.
+		openSqlite := func(dbPath, fileName, ns string) (kvdb.Backend,
+			error) {
+
+			backend, err := kvdb.Open(
+				kvdb.SqliteBackendName, ctx, db.Sqlite, dbPath,
+				fileName, ns,
+			)
+			if err != nil {
+				return nil, err
+			}
+			closeFuncs[ns] = backend.Close
+
+			return backend, nil
+		}
+
+		sqliteBackend, err := openSqlite(
+			chanDBPath, sqliteChannelDBName, NSChannelDB,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error opening sqlite graph "+
+				"DB: %v", err)
+		}
+
+		sqliteMacaroonBackend, err := openSqlite(
+			walletDBPath, sqliteMacaroonDBName, NSMacaroonDB,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error opening sqlite "+
+				"macaroon DB: %v", err)
+		}
+
+		sqliteDecayedLogBackend, err := openSqlite(
+			chanDBPath, sqliteDecayedLogDbName, NSDecayedLogDB,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error opening sqlite "+
+				"decayed log DB: %v", err)
+		}
+
+		// The tower client and server are optional, so we only create
+		// their database files if they're enabled.
+		var sqliteTowerClientBackend kvdb.Backend
+		if towerClientEnabled {
+			sqliteTowerClientBackend, err = openSqlite(
+				chanDBPath, sqliteTowerClientDBName,
+				NSTowerClientDB,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error opening sqlite "+
+					"tower client DB: %v", err)
+			}
+		}
+
+		var sqliteTowerServerBackend kvdb.Backend
+		if towerServerEnabled {
+			sqliteTowerServerBackend, err = openSqlite(
+				towerServerDBPath, sqliteTowerServerDBName,
+				NSTowerServerDB,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error opening sqlite "+
+					"tower server DB: %v", err)
+			}
+		}
+
+		sqliteWalletBackend, err := openSqlite(
+			walletDBPath, sqliteWalletDBName, NSWalletDB,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error opening sqlite wallet "+
+				"DB: %v", err)
+		}
+
+		returnEarly = false
+		return &DatabaseBackends{
+			GraphDB:       sqliteBackend,
+			ChanStateDB:   sqliteBackend,
+			HeightHintDB:  sqliteBackend,
+			MacaroonDB:    sqliteMacaroonBackend,
+			DecayedLogDB:  sqliteDecayedLogBackend,
+			TowerClientDB: sqliteTowerClientBackend,
+			TowerServerDB: sqliteTowerServerBackend,
+			// The wallet isn't kept in a bolt file either, so we
+			// hand the loader the already opened sqlite backend.
+			WalletDB: btcwallet.LoaderWithExternalWalletDB(
+				sqliteWalletBackend,
+			),
 			CloseFuncs: closeFuncs,
 		}, nil
 	}
@@ -0,0 +1,52 @@
+//go:build kvdb_sqlite
+// +build kvdb_sqlite
+
+package lncfg_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ltcsuite/lnd/lncfg"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDBSqliteBackends tests that the sqlite backend keeps every database in
+// its own file, next to where the bolt file would be.
+func TestDBSqliteBackends(t *testing.T) {
+	ctx := context.Background()
+	chanDBPath := t.TempDir()
+	walletDBPath := t.TempDir()
+	towerDBPath := t.TempDir()
+
+	cfg := lncfg.DefaultDB()
+	cfg.Backend = lncfg.SqliteBackend
+	require.NoError(t, cfg.Validate())
+	require.NoError(t, cfg.Init(ctx, chanDBPath))
+
+	dbs, err := cfg.GetBackends(
+		ctx, chanDBPath, walletDBPath, towerDBPath, true, false,
+	)
+	require.NoError(t, err)
+
+	require.False(t, dbs.Remote)
+	require.Equal(t, dbs.GraphDB, dbs.ChanStateDB)
+	require.NotNil(t, dbs.TowerClientDB)
+	require.Nil(t, dbs.TowerServerDB)
+
+	for _, file := range []string{
+		filepath.Join(chanDBPath, "channel.sqlite"),
+		filepath.Join(chanDBPath, "sphinxreplay.sqlite"),
+		filepath.Join(chanDBPath, "wtclient.sqlite"),
+		filepath.Join(walletDBPath, "macaroons.sqlite"),
+		filepath.Join(walletDBPath, "wallet.sqlite"),
+	} {
+		require.FileExists(t, file)
+	}
+	require.NoFileExists(t, filepath.Join(towerDBPath, "watchtower.sqlite"))
+
+	for _, closeFunc := range dbs.CloseFuncs {
+		require.NoError(t, closeFunc())
+	}
+}
@@ -0,0 +1,136 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ltcsuite/lnd/feepolicy"
+)
+
+const (
+	// DefaultFeePolicyInterval is the default time between two fee
+	// updates of the fee policy engine.
+	DefaultFeePolicyInterval = time.Hour
+
+	// DefaultFeePolicyVolumeWindow is the default period of time over
+	// which the forwarding volume of a channel is measured.
+	DefaultFeePolicyVolumeWindow = 24 * time.Hour
+
+	// DefaultFeePolicyMinUpdateInterval is the default minimum time
+	// between two fee updates of the same channel.
+	DefaultFeePolicyMinUpdateInterval = time.Hour
+
+	// DefaultFeePolicyMinChange is the default relative change of the
+	// fees of a channel below which they aren't updated.
+	DefaultFeePolicyMinChange = 0.05
+)
+
+// FeePolicy holds the configuration of the fee policy engine, which derives
+// the fees of our channels from their local balance and forwarding volume.
+type FeePolicy struct {
+	Active bool `long:"active" description:"Periodically update the fees of all channels according to the configured curves."`
+
+	Interval time.Duration `long:"interval" description:"The time between two fee updates."`
+
+	FeeRateCurveRaw []string `long:"feeratecurve" description:"A point of the curve that maps the ratio of a channel's capacity that is our local balance to its fee rate in parts per million, as ratio:ppm. Can be specified multiple times; fee rates between two points are interpolated linearly."`
+
+	BaseFeeCurveRaw []string `long:"basefeecurve" description:"A point of the curve that maps the ratio of a channel's capacity that is our local balance to its base fee in millilitoshis, as ratio:msat. Can be specified multiple times. If no points are set, base fees aren't changed."`
+
+	VolumeCurveRaw []string `long:"volumecurve" description:"A point of the curve that maps the amount forwarded out of a channel during the volume window, as a ratio of its capacity, to a factor the fee rate is multiplied with, as ratio:factor. Can be specified multiple times. If no points are set, the forwarding volume doesn't affect fees."`
+
+	VolumeWindow time.Duration `long:"volumewindow" description:"The period of time over which the forwarding volume of a channel is measured."`
+
+	MinUpdateInterval time.Duration `long:"minupdateinterval" description:"The minimum time between two fee updates of the same channel, which limits the number of channel updates that are broadcast."`
+
+	MinChange float64 `long:"minchange" description:"The relative change of the fee rate or base fee of a channel below which its fees aren't updated, to avoid broadcasting channel updates for insignificant changes."`
+
+	// Policy is the parsed policy, or nil if the engine isn't active.
+	Policy *feepolicy.Policy
+}
+
+// DefaultFeePolicy returns the default configuration of the fee policy
+// engine.
+func DefaultFeePolicy() *FeePolicy {
+	return &FeePolicy{
+		Interval:          DefaultFeePolicyInterval,
+		VolumeWindow:      DefaultFeePolicyVolumeWindow,
+		MinUpdateInterval: DefaultFeePolicyMinUpdateInterval,
+		MinChange:         DefaultFeePolicyMinChange,
+	}
+}
+
+// Validate checks the configuration and parses the curves of the fee policy
+// if the engine is active.
+//
+// NOTE: This is part of the Validator interface.
+func (f *FeePolicy) Validate() error {
+	f.Policy = nil
+
+	if !f.Active {
+		return nil
+	}
+
+	switch {
+	case f.Interval <= 0:
+		return fmt.Errorf("feepolicy.interval must be positive")
+
+	case f.VolumeWindow <= 0:
+		return fmt.Errorf("feepolicy.volumewindow must be positive")
+
+	case f.MinUpdateInterval < 0:
+		return fmt.Errorf("feepolicy.minupdateinterval must be " +
+			"non-negative")
+
+	case f.MinChange < 0:
+		return fmt.Errorf("feepolicy.minchange must be non-negative")
+
+	case len(f.FeeRateCurveRaw) == 0:
+		return fmt.Errorf("feepolicy.feeratecurve must be set")
+	}
+
+	feeRateCurve, err := parseFeeCurve("feeratecurve", f.FeeRateCurveRaw)
+	if err != nil {
+		return err
+	}
+	baseFeeCurve, err := parseFeeCurve("basefeecurve", f.BaseFeeCurveRaw)
+	if err != nil {
+		return err
+	}
+	volumeCurve, err := parseFeeCurve("volumecurve", f.VolumeCurveRaw)
+	if err != nil {
+		return err
+	}
+
+	f.Policy = &feepolicy.Policy{
+		FeeRateCurve:      feeRateCurve,
+		BaseFeeCurve:      baseFeeCurve,
+		VolumeCurve:       volumeCurve,
+		VolumeWindow:      f.VolumeWindow,
+		MinUpdateInterval: f.MinUpdateInterval,
+		MinChange:         f.MinChange,
+	}
+
+	return nil
+}
+
+// parseFeeCurve parses the points of the fee policy curve with the given
+// name. All points must be non-negative.
+func parseFeeCurve(name string, points []string) (feepolicy.Curve, error) {
+	curve, err := feepolicy.ParseCurve(points)
+	if err != nil {
+		return nil, fmt.Errorf("invalid feepolicy.%v: %v", name, err)
+	}
+
+	for _, point := range curve {
+		if point.X < 0 || point.Y < 0 {
+			return nil, fmt.Errorf("invalid feepolicy.%v: points "+
+				"must be non-negative", name)
+		}
+	}
+
+	return curve, nil
+}
+
+// Compile-time constraint to ensure FeePolicy implements the Validator
+// interface.
+var _ Validator = (*FeePolicy)(nil)
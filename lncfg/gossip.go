@@ -1,6 +1,7 @@
 package lncfg
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ltcsuite/lnd/discovery"
@@ -15,6 +16,10 @@ type Gossip struct {
 	MaxChannelUpdateBurst int `long:"max-channel-update-burst" description:"The maximum number of updates for a specific channel and direction that lnd will accept over the channel update interval."`
 
 	ChannelUpdateInterval time.Duration `long:"channel-update-interval" description:"The interval used to determine how often lnd should allow a burst of new updates for a specific channel and direction."`
+
+	PeerMsgRateLimit float64 `long:"peer-msg-rate-limit" description:"The number of channel and node announcements per second that lnd will accept from a single peer on average. Announcements exceeding the limit are dropped. Set to 0 to disable the limit."`
+
+	PeerMsgBurst int `long:"peer-msg-burst" description:"The number of channel and node announcements that lnd will accept from a single peer in a burst, before the peer-msg-rate-limit is enforced."`
 }
 
 // Parse the pubkeys for the pinned syncers.
@@ -30,5 +35,12 @@ func (g *Gossip) Parse() error {
 
 	g.PinnedSyncers = pinnedSyncers
 
+	if g.PeerMsgRateLimit < 0 {
+		return fmt.Errorf("peer-msg-rate-limit must be non-negative")
+	}
+	if g.PeerMsgRateLimit > 0 && g.PeerMsgBurst <= 0 {
+		return fmt.Errorf("peer-msg-burst must be positive")
+	}
+
 	return nil
 }
@@ -30,6 +30,36 @@ type ProtocolOptions struct {
 	//
 	// TODO: Move to experimental?
 	ScriptEnforcedLease bool `long:"script-enforced-lease" description:"enable support for script enforced lease commitments"`
+
+	// OptionScidAlias should be set if we want to signal the
+	// option-scid-alias feature bit. This allows scid aliases and the
+	// option-scid-alias channel-type.
+	OptionScidAlias bool `long:"option-scid-alias" description:"enable support for option_scid_alias channels"`
+
+	// OptionZeroConf should be set if we want to signal the zero-conf
+	// feature bit.
+	OptionZeroConf bool `long:"zero-conf" description:"enable support for zero-conf channels, must have option-scid-alias set also"`
+
+	// NoRouteBlindingOption should be set if we don't want to forward or
+	// receive payments over blinded routes.
+	NoRouteBlindingOption bool `long:"no-route-blinding" description:"disable support for forwarding and receiving payments over blinded routes"`
+
+	// NoOnionMessagesOption should be set if we don't want to send,
+	// forward or receive onion messages.
+	NoOnionMessagesOption bool `long:"no-onion-messages" description:"disable support for sending, forwarding and receiving onion messages"`
+
+	// OptionDualFund should be set if we want to signal the dual-fund
+	// feature bit, allowing us to open and accept dual funded channels.
+	OptionDualFund bool `long:"dual-fund" description:"enable support for dual funded channels, whose funding transaction is constructed interactively with the remote party"`
+
+	// OptionSplice should be set if we want to signal the splice feature
+	// bit, allowing us to resize existing channels without closing them.
+	OptionSplice bool `long:"splice" description:"enable support for splicing funds into and out of existing channels, requires dual-fund to be set also"`
+
+	// OptionSimpleClose should be set if we want to signal the simple
+	// close feature bit, allowing the fee of cooperative closing
+	// transactions to be bumped.
+	OptionSimpleClose bool `long:"simple-close" description:"enable support for the simple close protocol, in which each party pays the fee of its own cooperative closing transaction and can replace it with one that pays a higher fee"`
 }
 
 // Wumbo returns true if lnd should permit the creation and acceptance of wumbo
@@ -49,3 +79,40 @@ func (l *ProtocolOptions) NoAnchorCommitments() bool {
 func (l *ProtocolOptions) NoScriptEnforcementLease() bool {
 	return !l.ScriptEnforcedLease
 }
+
+// ScidAlias returns true if we have enabled the option-scid-alias feature bit.
+func (l *ProtocolOptions) ScidAlias() bool {
+	return l.OptionScidAlias
+}
+
+// ZeroConf returns true if we have enabled the zero-conf feature bit.
+func (l *ProtocolOptions) ZeroConf() bool {
+	return l.OptionZeroConf
+}
+
+// NoRouteBlinding returns true if forwarding and receiving payments over
+// blinded routes should be disabled.
+func (l *ProtocolOptions) NoRouteBlinding() bool {
+	return l.NoRouteBlindingOption
+}
+
+// NoOnionMessages returns true if sending, forwarding and receiving onion
+// messages should be disabled.
+func (l *ProtocolOptions) NoOnionMessages() bool {
+	return l.NoOnionMessagesOption
+}
+
+// DualFund returns true if we have enabled the dual-fund feature bit.
+func (l *ProtocolOptions) DualFund() bool {
+	return l.OptionDualFund
+}
+
+// Splice returns true if we have enabled the splice feature bit.
+func (l *ProtocolOptions) Splice() bool {
+	return l.OptionSplice
+}
+
+// SimpleClose returns true if we have enabled the simple close feature bit.
+func (l *ProtocolOptions) SimpleClose() bool {
+	return l.OptionSimpleClose
+}
@@ -0,0 +1,43 @@
+package lncfg
+
+import (
+	"fmt"
+
+	"github.com/ltcsuite/lnd/routing/route"
+)
+
+// RapidSync holds the configuration of the rapid graph sync mode, in which
+// compact, unsigned snapshots of the channel graph are served to and applied
+// from trusted nodes.
+type RapidSync struct {
+	// Serve indicates whether we create snapshots of our graph.
+	Serve bool `long:"serve" description:"Allow rapid sync snapshots of our channel graph to be created over RPC, so that they can be served to clients that trust this node."`
+
+	// TrustedSourcesRaw holds the hex-encoded public keys of the nodes
+	// whose snapshots we apply.
+	TrustedSourcesRaw []string `long:"trusted-source" description:"The hex-encoded pubkey of a node whose rapid sync snapshots are applied to our channel graph. As the records of a snapshot aren't signed individually, they are only checked to be signed by the node as a whole. Can be specified multiple times. If no trusted sources are set, snapshots aren't applied."`
+
+	// TrustedSources are the parsed public keys of TrustedSourcesRaw.
+	TrustedSources []route.Vertex
+}
+
+// Validate parses the public keys of the trusted sources.
+//
+// NOTE: This is part of the Validator interface.
+func (r *RapidSync) Validate() error {
+	r.TrustedSources = nil
+	for _, pubKeyStr := range r.TrustedSourcesRaw {
+		vertex, err := route.NewVertexFromStr(pubKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid rapid sync trusted source "+
+				"%v: %v", pubKeyStr, err)
+		}
+		r.TrustedSources = append(r.TrustedSources, vertex)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure RapidSync implements the Validator
+// interface.
+var _ Validator = (*RapidSync)(nil)
@@ -1,6 +1,10 @@
 package lncfg
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
+)
 
 // WtClient holds the configuration options for the daemon's watchtower client.
 type WtClient struct {
@@ -15,6 +19,38 @@ type WtClient struct {
 	// SweepFeeRate specifies the fee rate in sat/byte to be used when
 	// constructing justice transactions sent to the tower.
 	SweepFeeRate uint64 `long:"sweep-fee-rate" description:"Specifies the fee rate in sat/byte to be used when constructing justice transactions sent to the watchtower."`
+
+	// Reward determines whether the client negotiates reward sessions,
+	// paying towers a reward from the funds swept on our behalf.
+	Reward bool `long:"reward" description:"Whether the client should negotiate reward sessions, which pay watchtowers a reward from the funds swept by their justice transactions."`
+
+	// MaxRewardBase is the maximum fixed reward in litoshis the client
+	// agrees to pay a tower.
+	MaxRewardBase uint32 `long:"max-reward-base" description:"The maximum fixed reward in litoshis the client agrees to pay a watchtower for reward sessions."`
+
+	// MaxRewardRate is the maximum proportional reward the client agrees
+	// to pay a tower, in millionths of the swept funds.
+	MaxRewardRate uint32 `long:"max-reward-rate" description:"The maximum proportional reward the client agrees to pay a watchtower for reward sessions, in millionths of the swept funds. If zero, the default rate is used."`
+
+	// SessionCloseDelay is the number of blocks to wait after all channels
+	// of an exhausted session closed before deleting the session.
+	SessionCloseDelay uint32 `long:"session-close-delay" description:"The number of blocks to wait after all channels with backups in an exhausted session have closed, before deleting the session from the watchtower and the client. If zero, the default of 288 blocks is used."`
+
+	// ReplicationFactor is the number of distinct towers each revoked
+	// state is backed up to.
+	ReplicationFactor uint32 `long:"replication-factor" description:"The number of distinct watchtowers each revoked state is backed up to. If fewer watchtowers are available, states are backed up to all of them. If zero, states are backed up to a single watchtower."`
+
+	// TowerFailureThreshold is the number of consecutive failures after
+	// which a tower is considered unhealthy.
+	TowerFailureThreshold uint32 `long:"tower-failure-threshold" description:"The number of consecutive failures to negotiate sessions with or deliver backups to a watchtower, after which the watchtower is considered unhealthy and replaced by another one. If zero, the default of 3 failures is used."`
+
+	// Identify specifies whether the client identifies itself to towers
+	// with the node's public key when negotiating sessions.
+	Identify bool `long:"identify" description:"Whether the client should identify itself to watchtowers with the node's public key when negotiating sessions. This is required by hosted watchtowers that limit the resources of each client, but allows the watchtower to link all sessions to the node."`
+
+	// BackupHtlcs determines whether the client negotiates sessions that
+	// also back up the revoked HTLC outputs of channel states.
+	BackupHtlcs bool `long:"backup-htlcs" description:"Whether the client should negotiate sessions that also allow watchtowers to sweep the revoked HTLC outputs of breached channels. This requires larger backups, and watchtowers that support them."`
 }
 
 // Validate ensures the user has provided a valid configuration.
@@ -30,6 +66,11 @@ func (c *WtClient) Validate() error {
 			"`lncli wtclient -h` for more information.")
 	}
 
+	if c.MaxRewardRate >= wtpolicy.RewardScale {
+		return fmt.Errorf("wtclient.max-reward-rate must be below %d",
+			wtpolicy.RewardScale)
+	}
+
 	return nil
 }
 
@@ -494,6 +494,15 @@ func Main(cfg *Config, lisCfg ListenerCfg, implCfg *ImplementationCfg,
 	// Initialize the ChainedAcceptor.
 	chainedAcceptor := chanacceptor.NewChainedAcceptor()
 
+	// If a static acceptor policy is configured, we'll check all inbound
+	// channels against it alongside any RPC acceptors.
+	if cfg.AcceptorPolicy.Policy != nil {
+		policyAcceptor := chanacceptor.NewPolicyAcceptor(
+			cfg.AcceptorPolicy.Policy,
+		)
+		chainedAcceptor.AddAcceptor(policyAcceptor)
+	}
+
 	// Set up the core server which will listen for incoming peer
 	// connections.
 	server, err := newServer(
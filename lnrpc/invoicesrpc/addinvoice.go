@@ -67,6 +67,15 @@ type AddInvoiceConfig struct {
 	// GenAmpInvoiceFeatures returns a feature containing feature bits that
 	// should be advertised on freshly generated AMP invoices.
 	GenAmpInvoiceFeatures func() *lnwire.FeatureVector
+
+	// GetAlias allows the peer's alias SCID to be retrieved for private
+	// option_scid_alias channels.
+	GetAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)
+
+	// BestHeight returns the height of the best known block. It is used
+	// to bound the expiry of HTLCs sent over blinded paths, and may be
+	// nil if blinded paths are not supported by the caller.
+	BestHeight func() (uint32, error)
 }
 
 // AddInvoiceData contains the required data to create a new invoice.
@@ -120,6 +129,13 @@ type AddInvoiceData struct {
 	// RouteHints are optional route hints that can each be individually used
 	// to assist in reaching the invoice's destination.
 	RouteHints [][]zpay32.HopHint
+
+	// Blind signals whether the invoice should include blinded paths that
+	// hide our node from the sender.
+	//
+	// NOTE: This cannot be combined with Private or RouteHints, as route
+	// hints reveal our channels.
+	Blind bool
 }
 
 // paymentHashAndPreimage returns the payment hash and preimage for this invoice
@@ -252,6 +268,11 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 			maxInvoiceAmt)
 	}
 
+	if invoice.Blind && (invoice.Private || len(invoice.RouteHints) > 0) {
+		return nil, nil, fmt.Errorf("blinded invoices cannot include " +
+			"route hints")
+	}
+
 	amtMSat := invoice.Value
 
 	// We also create an encoded payment request which allows the
@@ -279,6 +300,7 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		options = append(options, zpay32.FallbackAddr(addr))
 	}
 
+	var expiry time.Duration
 	switch {
 
 	// If expiry is set, specify it. If it is not provided, no expiry time
@@ -298,18 +320,18 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 				float64(expSeconds), maxExpiry.Seconds())
 		}
 
-		expiry := time.Duration(invoice.Expiry) * time.Second
-		options = append(options, zpay32.Expiry(expiry))
+		expiry = time.Duration(invoice.Expiry) * time.Second
 
 	// If no custom expiry is provided, use the default MPP expiry.
 	case !invoice.Amp:
-		options = append(options, zpay32.Expiry(DefaultInvoiceExpiry))
+		expiry = DefaultInvoiceExpiry
 
 	// Otherwise, use the default AMP expiry.
 	default:
-		options = append(options, zpay32.Expiry(DefaultAMPInvoiceExpiry))
+		expiry = DefaultAMPInvoiceExpiry
 
 	}
+	options = append(options, zpay32.Expiry(expiry))
 
 	// If the description hash is set, then we add it do the list of options.
 	// If not, use the memo field as the payment request description.
@@ -325,6 +347,7 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 
 	// We'll use our current default CLTV value unless one was specified as
 	// an option on the command line when creating an invoice.
+	finalCltvDelta := uint64(cfg.DefaultCLTVExpiry)
 	switch {
 	case invoice.CltvExpiry > math.MaxUint16:
 		return nil, nil, fmt.Errorf("CLTV delta of %v is too large, max "+
@@ -338,13 +361,10 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 				routing.MinCLTVDelta, invoice.CltvExpiry)
 		}
 
-		options = append(options,
-			zpay32.CLTVExpiry(invoice.CltvExpiry))
-	default:
-		// TODO(roasbeef): assumes set delta between versions
-		defaultDelta := cfg.DefaultCLTVExpiry
-		options = append(options, zpay32.CLTVExpiry(uint64(defaultDelta)))
+		finalCltvDelta = invoice.CltvExpiry
 	}
+	// TODO(roasbeef): assumes set delta between versions
+	options = append(options, zpay32.CLTVExpiry(finalCltvDelta))
 
 	// We make sure that the given invoice routing hints number is within the
 	// valid range
@@ -416,6 +436,30 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 	}
 	options = append(options, zpay32.PaymentAddr(paymentAddr))
 
+	// If requested, add blinded paths to our node. Payments made over
+	// these paths identify the invoice using the payment address that we
+	// include as the path ID of our own hop.
+	if invoice.Blind {
+		blindedPaths, err := buildBlindedPaymentPaths(
+			cfg, &blindedPathParams{
+				amtMSat:        amtMSat,
+				paymentAddr:    paymentAddr,
+				finalCltvDelta: uint16(finalCltvDelta),
+				expiry:         expiry,
+			},
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to create blinded "+
+				"paths: %v", err)
+		}
+
+		for _, path := range blindedPaths {
+			options = append(
+				options, zpay32.WithBlindedPaymentPath(path),
+			)
+		}
+	}
+
 	// Create and encode the payment request as a bech32 (zpay32) string.
 	creationDate := time.Now()
 	payReq, err := zpay32.NewInvoice(
@@ -530,14 +574,39 @@ func chanCanBeHopHint(channel *channeldb.OpenChannel, cfg *AddInvoiceConfig) (
 	return remotePolicy, true
 }
 
+// hopHintScid returns the short channel ID that should be used in a hop hint
+// for the passed channel. Channels that negotiated an alias will use the
+// alias our peer gave us, since that is the SCID the peer will recognize when
+// the HTLC arrives. All other channels use their confirmed SCID.
+func hopHintScid(channel *channeldb.OpenChannel,
+	cfg *AddInvoiceConfig) (lnwire.ShortChannelID, bool) {
+
+	usesAlias := channel.IsZeroConf() || channel.IsOptionScidAlias() ||
+		channel.NegotiatedAliasFeature()
+	if !usesAlias {
+		return channel.ShortChanID(), true
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+	alias, err := cfg.GetAlias(chanID)
+	if err != nil {
+		log.Debugf("Skipping channel %v due to missing peer "+
+			"alias: %v", chanID, err)
+		return lnwire.ShortChannelID{}, false
+	}
+
+	return alias, true
+}
+
 // addHopHint creates a hop hint out of the passed channel and channel policy.
 // The new hop hint is appended to the passed slice.
 func addHopHint(hopHints *[]func(*zpay32.Invoice),
-	channel *channeldb.OpenChannel, chanPolicy *channeldb.ChannelEdgePolicy) {
+	channel *channeldb.OpenChannel, scid lnwire.ShortChannelID,
+	chanPolicy *channeldb.ChannelEdgePolicy) {
 
 	hopHint := zpay32.HopHint{
 		NodeID:      channel.IdentityPub,
-		ChannelID:   channel.ShortChanID().ToUint64(),
+		ChannelID:   scid.ToUint64(),
 		FeeBaseMSat: uint32(chanPolicy.FeeBaseMSat),
 		FeeProportionalMillionths: uint32(
 			chanPolicy.FeeProportionalMillionths,
@@ -577,9 +646,14 @@ func SelectHopHints(amtMSat lnwire.MilliSatoshi, cfg *AddInvoiceConfig,
 			continue
 		}
 
+		scid, ok := hopHintScid(channel, cfg)
+		if !ok {
+			continue
+		}
+
 		// Now that we now this channel use usable, add it as a hop
 		// hint and the indexes we'll use later.
-		addHopHint(&hopHints, channel, edgePolicy)
+		addHopHint(&hopHints, channel, scid, edgePolicy)
 
 		hopHintChans[channel.FundingOutpoint] = struct{}{}
 		totalHintBandwidth += channel.LocalCommitment.RemoteBalance
@@ -621,9 +695,14 @@ func SelectHopHints(amtMSat lnwire.MilliSatoshi, cfg *AddInvoiceConfig,
 			continue
 		}
 
+		scid, ok := hopHintScid(channel, cfg)
+		if !ok {
+			continue
+		}
+
 		// Include the route hint in our set of options that will be
 		// used when creating the invoice.
-		addHopHint(&hopHints, channel, remotePolicy)
+		addHopHint(&hopHints, channel, scid, remotePolicy)
 
 		// As we've just added a new hop hint, we'll accumulate it's
 		// available balance now to update our tally.
@@ -0,0 +1,292 @@
+package invoicesrpc
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/record"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/ltcsuite/lnd/zpay32"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+)
+
+const (
+	// maxBlindedPaths is the maximum number of blinded paths that we'll
+	// include in a single invoice.
+	maxBlindedPaths = 3
+
+	// blindedPathExpiryMargin is the number of blocks that we add to the
+	// maximum expiry of a blinded path, on top of the invoice expiry, to
+	// allow for blocks being found faster than expected.
+	blindedPathExpiryMargin = 144
+)
+
+// blindedPathParams holds the parameters of the invoice that a set of blinded
+// paths is built for.
+type blindedPathParams struct {
+	// amtMSat is the amount of the invoice, which may be zero.
+	amtMSat lnwire.MilliSatoshi
+
+	// paymentAddr is the payment address of the invoice. It is included
+	// as the path ID in our own hop of the blinded path, so that we can
+	// match payments made over the path to the invoice.
+	paymentAddr [32]byte
+
+	// finalCltvDelta is the final CLTV delta of the invoice.
+	finalCltvDelta uint16
+
+	// expiry is the duration for which the invoice is valid.
+	expiry time.Duration
+}
+
+// blindedPathHop holds the information required to include one of our peers
+// as the introduction node of a blinded path.
+type blindedPathHop struct {
+	peer    *btcec.PublicKey
+	scid    lnwire.ShortChannelID
+	policy  *channeldb.ChannelEdgePolicy
+	maxHTLC lnwire.MilliSatoshi
+}
+
+// buildBlindedPaymentPaths creates blinded paths to our node that can be
+// included in an invoice. Each path uses one of our public peers that
+// supports route blinding as its introduction node. If none of our peers are
+// eligible, a single path that uses our own node as the introduction node is
+// returned, which still allows the sender to pay us without using our node ID
+// as the onion's final hop.
+func buildBlindedPaymentPaths(cfg *AddInvoiceConfig,
+	params *blindedPathParams) ([]*zpay32.BlindedPaymentPath, error) {
+
+	if cfg.BestHeight == nil {
+		return nil, fmt.Errorf("blinded paths not supported")
+	}
+
+	bestHeight, err := cfg.BestHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	// The HTLCs sent over our paths must expire within a bounded number
+	// of blocks, so that a path can't be used to probe us long after the
+	// invoice expired.
+	expiryBlocks := uint32(
+		params.expiry / cfg.ChainParams.TargetTimePerBlock,
+	)
+	maxCltvExpiry := bestHeight + expiryBlocks + blindedPathExpiryMargin +
+		uint32(params.finalCltvDelta)
+
+	finalHopData, err := record.EncodeBlindedRouteData(
+		&record.BlindedRouteData{
+			PathID: params.paymentAddr[:],
+			Constraints: &record.PaymentConstraints{
+				MaxCltvExpiry: maxCltvExpiry,
+			},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceNode, err := cfg.Graph.SourceNode()
+	if err != nil {
+		return nil, err
+	}
+	ourPub, err := sourceNode.PubKey()
+	if err != nil {
+		return nil, err
+	}
+	finalHop := &blindedpath.HopInfo{
+		NodePub:   ourPub,
+		PlainText: finalHopData,
+	}
+
+	hops, err := selectBlindedPathHops(cfg, params.amtMSat)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []*zpay32.BlindedPaymentPath
+	for _, hop := range hops {
+		policy := hop.policy
+		cltvDelta := uint32(policy.TimeLockDelta) +
+			uint32(params.finalCltvDelta)
+		if cltvDelta > math.MaxUint16 {
+			continue
+		}
+
+		relayData, err := record.EncodeBlindedRouteData(
+			&record.BlindedRouteData{
+				ShortChannelID: &hop.scid,
+				RelayInfo: &record.PaymentRelayInfo{
+					CltvExpiryDelta: policy.TimeLockDelta,
+					FeeRate: uint32(
+						policy.FeeProportionalMillionths,
+					),
+					BaseFee: uint32(policy.FeeBaseMSat),
+				},
+				Constraints: &record.PaymentConstraints{
+					MaxCltvExpiry: maxCltvExpiry +
+						uint32(policy.TimeLockDelta),
+					HtlcMinimumMsat: policy.MinHTLC,
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		path, err := newBlindedPaymentPath(
+			[]*blindedpath.HopInfo{
+				{
+					NodePub:   hop.peer,
+					PlainText: relayData,
+				},
+				finalHop,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		path.FeeBaseMsat = uint32(policy.FeeBaseMSat)
+		path.FeeRate = uint32(policy.FeeProportionalMillionths)
+		path.CltvExpiryDelta = uint16(cltvDelta)
+		path.HTLCMinMsat = uint64(policy.MinHTLC)
+		path.HTLCMaxMsat = uint64(hop.maxHTLC)
+
+		paths = append(paths, path)
+	}
+
+	if len(paths) > 0 {
+		return paths, nil
+	}
+
+	// None of our peers can act as an introduction node, so we'll fall
+	// back to a path that only contains our own node.
+	path, err := newBlindedPaymentPath([]*blindedpath.HopInfo{finalHop})
+	if err != nil {
+		return nil, err
+	}
+	path.CltvExpiryDelta = params.finalCltvDelta
+	path.HTLCMaxMsat = math.MaxUint64
+
+	return []*zpay32.BlindedPaymentPath{path}, nil
+}
+
+// newBlindedPaymentPath blinds the passed hops using a fresh session key.
+func newBlindedPaymentPath(
+	hops []*blindedpath.HopInfo) (*zpay32.BlindedPaymentPath, error) {
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := blindedpath.BuildBlindedPath(sessionKey, hops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &zpay32.BlindedPaymentPath{
+		Features:    lnwire.EmptyFeatureVector(),
+		BlindedPath: path,
+	}, nil
+}
+
+// selectBlindedPathHops returns up to maxBlindedPaths of our peers that can
+// act as the introduction node of a blinded path to us. A peer is eligible if
+// it is publicly advertised, signals support for route blinding, and has
+// enough balance in an active channel with us to forward the payment.
+func selectBlindedPathHops(cfg *AddInvoiceConfig,
+	amtMSat lnwire.MilliSatoshi) ([]*blindedPathHop, error) {
+
+	openChannels, err := cfg.ChanDB.FetchAllChannels()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch all channels")
+	}
+
+	var (
+		hops      []*blindedPathHop
+		seenPeers = make(map[route.Vertex]struct{})
+	)
+	for _, channel := range openChannels {
+		if len(hops) >= maxBlindedPaths {
+			break
+		}
+
+		peer := route.NewVertex(channel.IdentityPub)
+		if _, ok := seenPeers[peer]; ok {
+			continue
+		}
+
+		if channel.LocalCommitment.RemoteBalance < amtMSat {
+			continue
+		}
+
+		chanID := lnwire.NewChanIDFromOutPoint(
+			&channel.FundingOutpoint,
+		)
+		if !cfg.IsChannelActive(chanID) {
+			continue
+		}
+
+		node, err := cfg.Graph.FetchLightningNode(peer)
+		if err != nil {
+			log.Debugf("Skipping peer %v as blinded path "+
+				"introduction node: %v", peer, err)
+			continue
+		}
+
+		if !node.HaveNodeAnnouncement || !node.Features.HasFeature(
+			lnwire.RouteBlindingOptional,
+		) {
+
+			continue
+		}
+
+		info, p1, p2, err := cfg.Graph.FetchChannelEdgesByID(
+			channel.ShortChanID().ToUint64(),
+		)
+		if err != nil {
+			log.Debugf("Unable to fetch the policies of channel "+
+				"%v: %v", chanID, err)
+			continue
+		}
+
+		// We need the policy for HTLCs that the peer forwards to us.
+		policy := p2
+		if bytes.Equal(peer[:], info.NodeKey1Bytes[:]) {
+			policy = p1
+		}
+		if policy == nil {
+			continue
+		}
+
+		scid, ok := hopHintScid(channel, cfg)
+		if !ok {
+			continue
+		}
+
+		// Older policies may not specify a maximum HTLC, in which case
+		// the channel's capacity is the upper bound.
+		maxHTLC := policy.MaxHTLC
+		if maxHTLC == 0 {
+			maxHTLC = lnwire.NewMSatFromSatoshis(channel.Capacity)
+		}
+
+		seenPeers[peer] = struct{}{}
+		hops = append(hops, &blindedPathHop{
+			peer:    channel.IdentityPub,
+			scid:    scid,
+			policy:  policy,
+			maxHTLC: maxHTLC,
+		})
+	}
+
+	return hops, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/macaroons"
 	"github.com/ltcsuite/lnd/netann"
+	"github.com/ltcsuite/lnd/offers"
 )
 
 // Config is the primary configuration struct for the invoices RPC server. It
@@ -60,4 +61,12 @@ type Config struct {
 	// GenAmpInvoiceFeatures returns a feature containing feature bits that
 	// should be advertised on freshly generated AMP invoices.
 	GenAmpInvoiceFeatures func() *lnwire.FeatureVector
+
+	// GetAlias returns the peer's alias SCID if it exists given the
+	// 32-byte ChannelID.
+	GetAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)
+
+	// OfferManager creates the BOLT 12 offers that we issue. It is nil if
+	// onion messages are disabled.
+	OfferManager *offers.Manager
 }
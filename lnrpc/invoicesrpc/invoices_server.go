@@ -5,10 +5,12 @@ package invoicesrpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -19,7 +21,9 @@ import (
 	"github.com/ltcsuite/lnd/channeldb"
 	"github.com/ltcsuite/lnd/lnrpc"
 	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/macaroons"
+	"github.com/ltcsuite/lnd/offers"
 )
 
 const (
@@ -66,6 +70,10 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/invoicesrpc.Invoices/AddOffer": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 	}
 
 	// DefaultInvoicesMacFilename is the default name of the invoices
@@ -328,6 +336,7 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 		Graph:                 s.cfg.GraphDB,
 		GenInvoiceFeatures:    s.cfg.GenInvoiceFeatures,
 		GenAmpInvoiceFeatures: s.cfg.GenAmpInvoiceFeatures,
+		GetAlias:              s.cfg.GetAlias,
 	}
 
 	hash, err := lntypes.MakeHash(invoice.Hash)
@@ -438,3 +447,45 @@ func (s *Server) LookupInvoiceV2(ctx context.Context,
 
 	return CreateRPCInvoice(&invoice, s.cfg.ChainParams)
 }
+
+// AddOffer creates a BOLT 12 offer, a static payment code that can be paid
+// repeatedly. Payers request an invoice for the offer from our node over
+// onion messages, which is answered with an invoice that can be paid over
+// blinded paths.
+func (s *Server) AddOffer(ctx context.Context,
+	req *AddOfferRequest) (*AddOfferResponse, error) {
+
+	if s.cfg.OfferManager == nil {
+		return nil, status.Error(codes.Unavailable, "offers require "+
+			"onion messages, which are disabled")
+	}
+
+	offer := &offers.Offer{
+		Amount:      lnwire.MilliSatoshi(req.AmountMsat),
+		Description: req.Description,
+		Issuer:      req.Issuer,
+		QuantityMax: req.QuantityMax,
+	}
+	if req.AbsoluteExpiry != 0 {
+		offer.AbsoluteExpiry = time.Unix(req.AbsoluteExpiry, 0)
+	}
+
+	encoded, err := s.cfg.OfferManager.CreateOffer(offer)
+	switch {
+	case errors.Is(err, offers.ErrNoDescription):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	id, err := offer.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddOfferResponse{
+		Offer:   encoded,
+		OfferId: id[:],
+	}, nil
+}
@@ -113,6 +113,14 @@ type LightningClient interface {
 	//workflow and is waiting for confirmations for the funding txn, or is in the
 	//process of closure, either initiated cooperatively or non-cooperatively.
 	PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error)
+	// lncli: `listinboundreservations`
+	//ListInboundReservations lists the channels that remote peers are currently
+	//negotiating to open with us, before the funding transaction is known.
+	ListInboundReservations(ctx context.Context, in *ListInboundReservationsRequest, opts ...grpc.CallOption) (*ListInboundReservationsResponse, error)
+	// lncli: `cancelinboundreservation`
+	//CancelInboundReservation cancels the reservation of a channel a remote peer
+	//is negotiating to open with us, and fails the funding flow with the peer.
+	CancelInboundReservation(ctx context.Context, in *CancelInboundReservationRequest, opts ...grpc.CallOption) (*CancelInboundReservationResponse, error)
 	// lncli: `listchannels`
 	//ListChannels returns a description of all the open channels that this node
 	//is a participant in.
@@ -174,7 +182,10 @@ type LightningClient interface {
 	//inactive peer. If a non-force close (cooperative closure) is requested,
 	//then the user can specify either a target number of blocks until the
 	//closure transaction is confirmed, or a manual fee rate. If neither are
-	//specified, then a default lax, block confirmation target is used.
+	//specified, then a default lax, block confirmation target is used. If both
+	//peers support the simple close protocol, calling CloseChannel again on a
+	//channel whose closing transaction is pending bumps its fee to the new fee
+	//rate.
 	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error)
 	// lncli: `abandonchannel`
 	//AbandonChannel removes all channel state from the database except for a
@@ -184,6 +195,13 @@ type LightningClient interface {
 	//never broadcast. Only available for non-externally funded channels in dev
 	//build.
 	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
+	// lncli: `splicechannel`
+	//SpliceChannel adds funds to or removes funds from an existing channel by
+	//negotiating a splice transaction with the channel peer, which spends the
+	//current funding output into a new one. The channel remains usable while
+	//the splice transaction confirms. The call returns once the splice
+	//transaction has been signed by both parties and broadcast.
+	SpliceChannel(ctx context.Context, in *SpliceChannelRequest, opts ...grpc.CallOption) (*SpliceChannelResponse, error)
 	// Deprecated: Do not use.
 	// lncli: `sendpayment`
 	//Deprecated, use routerrpc.SendPaymentV2. SendPayment dispatches a
@@ -268,6 +286,37 @@ type LightningClient interface {
 	//GetNodeMetrics returns node metrics calculated from the graph. Currently
 	//the only supported metric is betweenness centrality of individual nodes.
 	GetNodeMetrics(ctx context.Context, in *NodeMetricsRequest, opts ...grpc.CallOption) (*NodeMetricsResponse, error)
+	// lncli: `exportgraph`
+	//ExportGraph returns a snapshot of all announced channels, their routing
+	//policies and the announcements of their nodes, including the signatures
+	//of the announcers. The snapshot can be imported by another node with
+	//ImportGraph to bootstrap its graph without waiting for gossip.
+	ExportGraph(ctx context.Context, in *ExportGraphRequest, opts ...grpc.CallOption) (*ExportGraphResponse, error)
+	// lncli: `importgraph`
+	//ImportGraph verifies the signatures of all records in a graph snapshot
+	//obtained from ExportGraph, and adds the channels, policies and nodes that
+	//are new or more recent than the ones already known to the graph. The
+	//snapshot is rejected as a whole if any of its records are invalid.
+	ImportGraph(ctx context.Context, in *ImportGraphRequest, opts ...grpc.CallOption) (*ImportGraphResponse, error)
+	// lncli: `getrapidsync`
+	//GetRapidSyncSnapshot returns a compact snapshot of the announced channels
+	//and routing policies that were updated since the given time, for clients
+	//that trust this node to apply with ApplyRapidSyncSnapshot. The records of
+	//the snapshot don't carry their signatures, instead the snapshot as a whole
+	//is signed with the identity key of this node. Requires the node to be
+	//started with rapidsync.serve.
+	GetRapidSyncSnapshot(ctx context.Context, in *RapidSyncSnapshotRequest, opts ...grpc.CallOption) (*RapidSyncSnapshotResponse, error)
+	// lncli: `applyrapidsync`
+	//ApplyRapidSyncSnapshot checks that a snapshot obtained from
+	//GetRapidSyncSnapshot is signed by one of the trusted sources configured
+	//with rapidsync.trusted-source, and adds its channels and policies to the
+	//graph, unless signed announcements of them are known already.
+	ApplyRapidSyncSnapshot(ctx context.Context, in *ApplyRapidSyncSnapshotRequest, opts ...grpc.CallOption) (*ApplyRapidSyncSnapshotResponse, error)
+	// lncli: `gossipstats`
+	//GossipStats returns the number of gossip messages and bytes exchanged with
+	//each connected peer, along with the number of announcements that were
+	//dropped because the peer exceeded its gossip rate limit.
+	GossipStats(ctx context.Context, in *GossipStatsRequest, opts ...grpc.CallOption) (*GossipStatsResponse, error)
 	// lncli: `getchaninfo`
 	//GetChanInfo returns the latest authenticated network announcement for the
 	//given channel identified by its channel ID: an 8-byte integer which
@@ -410,6 +459,14 @@ type LightningClient interface {
 	//SubscribeCustomMessages subscribes to a stream of incoming custom peer
 	//messages.
 	SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error)
+	// lncli: `sendonion`
+	//SendOnionMessage sends an onion message over a path of nodes, a blinded
+	//path or a combination of both.
+	SendOnionMessage(ctx context.Context, in *SendOnionMessageRequest, opts ...grpc.CallOption) (*SendOnionMessageResponse, error)
+	// lncli: `subscribeonion`
+	//SubscribeOnionMessages subscribes to a stream of onion messages that are
+	//addressed to this node.
+	SubscribeOnionMessages(ctx context.Context, in *SubscribeOnionMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeOnionMessagesClient, error)
 }
 
 type lightningClient struct {
@@ -628,6 +685,24 @@ func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChanne
 	return out, nil
 }
 
+func (c *lightningClient) ListInboundReservations(ctx context.Context, in *ListInboundReservationsRequest, opts ...grpc.CallOption) (*ListInboundReservationsResponse, error) {
+	out := new(ListInboundReservationsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListInboundReservations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) CancelInboundReservation(ctx context.Context, in *CancelInboundReservationRequest, opts ...grpc.CallOption) (*CancelInboundReservationResponse, error) {
+	out := new(CancelInboundReservationResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/CancelInboundReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
 	out := new(ListChannelsResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListChannels", in, out, opts...)
@@ -809,6 +884,15 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 	return out, nil
 }
 
+func (c *lightningClient) SpliceChannel(ctx context.Context, in *SpliceChannelRequest, opts ...grpc.CallOption) (*SpliceChannelResponse, error) {
+	out := new(SpliceChannelResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SpliceChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[6], "/lnrpc.Lightning/SendPayment", opts...)
@@ -1004,6 +1088,51 @@ func (c *lightningClient) GetNodeMetrics(ctx context.Context, in *NodeMetricsReq
 	return out, nil
 }
 
+func (c *lightningClient) ExportGraph(ctx context.Context, in *ExportGraphRequest, opts ...grpc.CallOption) (*ExportGraphResponse, error) {
+	out := new(ExportGraphResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ImportGraph(ctx context.Context, in *ImportGraphRequest, opts ...grpc.CallOption) (*ImportGraphResponse, error) {
+	out := new(ImportGraphResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ImportGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetRapidSyncSnapshot(ctx context.Context, in *RapidSyncSnapshotRequest, opts ...grpc.CallOption) (*RapidSyncSnapshotResponse, error) {
+	out := new(RapidSyncSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetRapidSyncSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ApplyRapidSyncSnapshot(ctx context.Context, in *ApplyRapidSyncSnapshotRequest, opts ...grpc.CallOption) (*ApplyRapidSyncSnapshotResponse, error) {
+	out := new(ApplyRapidSyncSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ApplyRapidSyncSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GossipStats(ctx context.Context, in *GossipStatsRequest, opts ...grpc.CallOption) (*GossipStatsResponse, error) {
+	out := new(GossipStatsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GossipStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) GetChanInfo(ctx context.Context, in *ChanInfoRequest, opts ...grpc.CallOption) (*ChannelEdge, error) {
 	out := new(ChannelEdge)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetChanInfo", in, out, opts...)
@@ -1302,6 +1431,47 @@ func (x *lightningSubscribeCustomMessagesClient) Recv() (*CustomMessage, error)
 	return m, nil
 }
 
+func (c *lightningClient) SendOnionMessage(ctx context.Context, in *SendOnionMessageRequest, opts ...grpc.CallOption) (*SendOnionMessageResponse, error) {
+	out := new(SendOnionMessageResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendOnionMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeOnionMessages(ctx context.Context, in *SubscribeOnionMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeOnionMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[13], "/lnrpc.Lightning/SubscribeOnionMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeOnionMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeOnionMessagesClient interface {
+	Recv() (*OnionMessageUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeOnionMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeOnionMessagesClient) Recv() (*OnionMessageUpdate, error) {
+	m := new(OnionMessageUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // LightningServer is the server API for Lightning service.
 // All implementations must embed UnimplementedLightningServer
 // for forward compatibility
@@ -1401,6 +1571,14 @@ type LightningServer interface {
 	//workflow and is waiting for confirmations for the funding txn, or is in the
 	//process of closure, either initiated cooperatively or non-cooperatively.
 	PendingChannels(context.Context, *PendingChannelsRequest) (*PendingChannelsResponse, error)
+	// lncli: `listinboundreservations`
+	//ListInboundReservations lists the channels that remote peers are currently
+	//negotiating to open with us, before the funding transaction is known.
+	ListInboundReservations(context.Context, *ListInboundReservationsRequest) (*ListInboundReservationsResponse, error)
+	// lncli: `cancelinboundreservation`
+	//CancelInboundReservation cancels the reservation of a channel a remote peer
+	//is negotiating to open with us, and fails the funding flow with the peer.
+	CancelInboundReservation(context.Context, *CancelInboundReservationRequest) (*CancelInboundReservationResponse, error)
 	// lncli: `listchannels`
 	//ListChannels returns a description of all the open channels that this node
 	//is a participant in.
@@ -1462,7 +1640,10 @@ type LightningServer interface {
 	//inactive peer. If a non-force close (cooperative closure) is requested,
 	//then the user can specify either a target number of blocks until the
 	//closure transaction is confirmed, or a manual fee rate. If neither are
-	//specified, then a default lax, block confirmation target is used.
+	//specified, then a default lax, block confirmation target is used. If both
+	//peers support the simple close protocol, calling CloseChannel again on a
+	//channel whose closing transaction is pending bumps its fee to the new fee
+	//rate.
 	CloseChannel(*CloseChannelRequest, Lightning_CloseChannelServer) error
 	// lncli: `abandonchannel`
 	//AbandonChannel removes all channel state from the database except for a
@@ -1472,6 +1653,13 @@ type LightningServer interface {
 	//never broadcast. Only available for non-externally funded channels in dev
 	//build.
 	AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error)
+	// lncli: `splicechannel`
+	//SpliceChannel adds funds to or removes funds from an existing channel by
+	//negotiating a splice transaction with the channel peer, which spends the
+	//current funding output into a new one. The channel remains usable while
+	//the splice transaction confirms. The call returns once the splice
+	//transaction has been signed by both parties and broadcast.
+	SpliceChannel(context.Context, *SpliceChannelRequest) (*SpliceChannelResponse, error)
 	// Deprecated: Do not use.
 	// lncli: `sendpayment`
 	//Deprecated, use routerrpc.SendPaymentV2. SendPayment dispatches a
@@ -1556,6 +1744,37 @@ type LightningServer interface {
 	//GetNodeMetrics returns node metrics calculated from the graph. Currently
 	//the only supported metric is betweenness centrality of individual nodes.
 	GetNodeMetrics(context.Context, *NodeMetricsRequest) (*NodeMetricsResponse, error)
+	// lncli: `exportgraph`
+	//ExportGraph returns a snapshot of all announced channels, their routing
+	//policies and the announcements of their nodes, including the signatures
+	//of the announcers. The snapshot can be imported by another node with
+	//ImportGraph to bootstrap its graph without waiting for gossip.
+	ExportGraph(context.Context, *ExportGraphRequest) (*ExportGraphResponse, error)
+	// lncli: `importgraph`
+	//ImportGraph verifies the signatures of all records in a graph snapshot
+	//obtained from ExportGraph, and adds the channels, policies and nodes that
+	//are new or more recent than the ones already known to the graph. The
+	//snapshot is rejected as a whole if any of its records are invalid.
+	ImportGraph(context.Context, *ImportGraphRequest) (*ImportGraphResponse, error)
+	// lncli: `getrapidsync`
+	//GetRapidSyncSnapshot returns a compact snapshot of the announced channels
+	//and routing policies that were updated since the given time, for clients
+	//that trust this node to apply with ApplyRapidSyncSnapshot. The records of
+	//the snapshot don't carry their signatures, instead the snapshot as a whole
+	//is signed with the identity key of this node. Requires the node to be
+	//started with rapidsync.serve.
+	GetRapidSyncSnapshot(context.Context, *RapidSyncSnapshotRequest) (*RapidSyncSnapshotResponse, error)
+	// lncli: `applyrapidsync`
+	//ApplyRapidSyncSnapshot checks that a snapshot obtained from
+	//GetRapidSyncSnapshot is signed by one of the trusted sources configured
+	//with rapidsync.trusted-source, and adds its channels and policies to the
+	//graph, unless signed announcements of them are known already.
+	ApplyRapidSyncSnapshot(context.Context, *ApplyRapidSyncSnapshotRequest) (*ApplyRapidSyncSnapshotResponse, error)
+	// lncli: `gossipstats`
+	//GossipStats returns the number of gossip messages and bytes exchanged with
+	//each connected peer, along with the number of announcements that were
+	//dropped because the peer exceeded its gossip rate limit.
+	GossipStats(context.Context, *GossipStatsRequest) (*GossipStatsResponse, error)
 	// lncli: `getchaninfo`
 	//GetChanInfo returns the latest authenticated network announcement for the
 	//given channel identified by its channel ID: an 8-byte integer which
@@ -1698,6 +1917,14 @@ type LightningServer interface {
 	//SubscribeCustomMessages subscribes to a stream of incoming custom peer
 	//messages.
 	SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error
+	// lncli: `sendonion`
+	//SendOnionMessage sends an onion message over a path of nodes, a blinded
+	//path or a combination of both.
+	SendOnionMessage(context.Context, *SendOnionMessageRequest) (*SendOnionMessageResponse, error)
+	// lncli: `subscribeonion`
+	//SubscribeOnionMessages subscribes to a stream of onion messages that are
+	//addressed to this node.
+	SubscribeOnionMessages(*SubscribeOnionMessagesRequest, Lightning_SubscribeOnionMessagesServer) error
 	mustEmbedUnimplementedLightningServer()
 }
 
@@ -1759,6 +1986,12 @@ func (UnimplementedLightningServer) GetRecoveryInfo(context.Context, *GetRecover
 func (UnimplementedLightningServer) PendingChannels(context.Context, *PendingChannelsRequest) (*PendingChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PendingChannels not implemented")
 }
+func (UnimplementedLightningServer) ListInboundReservations(context.Context, *ListInboundReservationsRequest) (*ListInboundReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInboundReservations not implemented")
+}
+func (UnimplementedLightningServer) CancelInboundReservation(context.Context, *CancelInboundReservationRequest) (*CancelInboundReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInboundReservation not implemented")
+}
 func (UnimplementedLightningServer) ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
 }
@@ -1789,6 +2022,9 @@ func (UnimplementedLightningServer) CloseChannel(*CloseChannelRequest, Lightning
 func (UnimplementedLightningServer) AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AbandonChannel not implemented")
 }
+func (UnimplementedLightningServer) SpliceChannel(context.Context, *SpliceChannelRequest) (*SpliceChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpliceChannel not implemented")
+}
 func (UnimplementedLightningServer) SendPayment(Lightning_SendPaymentServer) error {
 	return status.Errorf(codes.Unimplemented, "method SendPayment not implemented")
 }
@@ -1831,6 +2067,21 @@ func (UnimplementedLightningServer) DescribeGraph(context.Context, *ChannelGraph
 func (UnimplementedLightningServer) GetNodeMetrics(context.Context, *NodeMetricsRequest) (*NodeMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNodeMetrics not implemented")
 }
+func (UnimplementedLightningServer) ExportGraph(context.Context, *ExportGraphRequest) (*ExportGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGraph not implemented")
+}
+func (UnimplementedLightningServer) ImportGraph(context.Context, *ImportGraphRequest) (*ImportGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGraph not implemented")
+}
+func (UnimplementedLightningServer) GetRapidSyncSnapshot(context.Context, *RapidSyncSnapshotRequest) (*RapidSyncSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRapidSyncSnapshot not implemented")
+}
+func (UnimplementedLightningServer) ApplyRapidSyncSnapshot(context.Context, *ApplyRapidSyncSnapshotRequest) (*ApplyRapidSyncSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyRapidSyncSnapshot not implemented")
+}
+func (UnimplementedLightningServer) GossipStats(context.Context, *GossipStatsRequest) (*GossipStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GossipStats not implemented")
+}
 func (UnimplementedLightningServer) GetChanInfo(context.Context, *ChanInfoRequest) (*ChannelEdge, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChanInfo not implemented")
 }
@@ -1900,6 +2151,12 @@ func (UnimplementedLightningServer) SendCustomMessage(context.Context, *SendCust
 func (UnimplementedLightningServer) SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeCustomMessages not implemented")
 }
+func (UnimplementedLightningServer) SendOnionMessage(context.Context, *SendOnionMessageRequest) (*SendOnionMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendOnionMessage not implemented")
+}
+func (UnimplementedLightningServer) SubscribeOnionMessages(*SubscribeOnionMessagesRequest, Lightning_SubscribeOnionMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOnionMessages not implemented")
+}
 func (UnimplementedLightningServer) mustEmbedUnimplementedLightningServer() {}
 
 // UnsafeLightningServer may be embedded to opt out of forward compatibility for this service.
@@ -2243,6 +2500,42 @@ func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ListInboundReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInboundReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListInboundReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListInboundReservations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListInboundReservations(ctx, req.(*ListInboundReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_CancelInboundReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInboundReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).CancelInboundReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/CancelInboundReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).CancelInboundReservation(ctx, req.(*CancelInboundReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListChannelsRequest)
 	if err := dec(in); err != nil {
@@ -2440,6 +2733,24 @@ func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SpliceChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpliceChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SpliceChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SpliceChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SpliceChannel(ctx, req.(*SpliceChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
 }
@@ -2711,6 +3022,96 @@ func _Lightning_GetNodeMetrics_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ExportGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ExportGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ExportGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ExportGraph(ctx, req.(*ExportGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ImportGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ImportGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ImportGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ImportGraph(ctx, req.(*ImportGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetRapidSyncSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RapidSyncSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetRapidSyncSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetRapidSyncSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetRapidSyncSnapshot(ctx, req.(*RapidSyncSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ApplyRapidSyncSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRapidSyncSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ApplyRapidSyncSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ApplyRapidSyncSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ApplyRapidSyncSnapshot(ctx, req.(*ApplyRapidSyncSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GossipStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GossipStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GossipStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GossipStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GossipStats(ctx, req.(*GossipStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_GetChanInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChanInfoRequest)
 	if err := dec(in); err != nil {
@@ -3142,6 +3543,45 @@ func (x *lightningSubscribeCustomMessagesServer) Send(m *CustomMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_SendOnionMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendOnionMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendOnionMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendOnionMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendOnionMessage(ctx, req.(*SendOnionMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeOnionMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeOnionMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeOnionMessages(m, &lightningSubscribeOnionMessagesServer{stream})
+}
+
+type Lightning_SubscribeOnionMessagesServer interface {
+	Send(*OnionMessageUpdate) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeOnionMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeOnionMessagesServer) Send(m *OnionMessageUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Lightning_ServiceDesc is the grpc.ServiceDesc for Lightning service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3213,6 +3653,14 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PendingChannels",
 			Handler:    _Lightning_PendingChannels_Handler,
 		},
+		{
+			MethodName: "ListInboundReservations",
+			Handler:    _Lightning_ListInboundReservations_Handler,
+		},
+		{
+			MethodName: "CancelInboundReservation",
+			Handler:    _Lightning_CancelInboundReservation_Handler,
+		},
 		{
 			MethodName: "ListChannels",
 			Handler:    _Lightning_ListChannels_Handler,
@@ -3237,6 +3685,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AbandonChannel",
 			Handler:    _Lightning_AbandonChannel_Handler,
 		},
+		{
+			MethodName: "SpliceChannel",
+			Handler:    _Lightning_SpliceChannel_Handler,
+		},
 		{
 			MethodName: "SendPaymentSync",
 			Handler:    _Lightning_SendPaymentSync_Handler,
@@ -3281,6 +3733,26 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNodeMetrics",
 			Handler:    _Lightning_GetNodeMetrics_Handler,
 		},
+		{
+			MethodName: "ExportGraph",
+			Handler:    _Lightning_ExportGraph_Handler,
+		},
+		{
+			MethodName: "ImportGraph",
+			Handler:    _Lightning_ImportGraph_Handler,
+		},
+		{
+			MethodName: "GetRapidSyncSnapshot",
+			Handler:    _Lightning_GetRapidSyncSnapshot_Handler,
+		},
+		{
+			MethodName: "ApplyRapidSyncSnapshot",
+			Handler:    _Lightning_ApplyRapidSyncSnapshot_Handler,
+		},
+		{
+			MethodName: "GossipStats",
+			Handler:    _Lightning_GossipStats_Handler,
+		},
 		{
 			MethodName: "GetChanInfo",
 			Handler:    _Lightning_GetChanInfo_Handler,
@@ -3357,6 +3829,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendCustomMessage",
 			Handler:    _Lightning_SendCustomMessage_Handler,
 		},
+		{
+			MethodName: "SendOnionMessage",
+			Handler:    _Lightning_SendOnionMessage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3428,6 +3904,11 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeCustomMessages_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeOnionMessages",
+			Handler:       _Lightning_SubscribeOnionMessages_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "lightning.proto",
 }
@@ -2,6 +2,8 @@ package routerrpc
 
 import (
 	"github.com/ltcsuite/lnd/macaroons"
+	"github.com/ltcsuite/lnd/offers"
+	"github.com/ltcsuite/lnd/rebalancer"
 	"github.com/ltcsuite/lnd/routing"
 )
 
@@ -37,6 +39,14 @@ type Config struct {
 	// RouterBackend contains shared logic between this sub server and the
 	// main rpc server.
 	RouterBackend *RouterBackend
+
+	// OfferManager fetches the invoices of the BOLT 12 offers that we pay.
+	// It is nil if onion messages are disabled.
+	OfferManager *offers.Manager
+
+	// Rebalancer is the built-in rebalancer that is controlled through
+	// this RPC server.
+	Rebalancer *rebalancer.Rebalancer
 }
 
 // DefaultConfig defines the config defaults.
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ltcsuite/lnd/channeldb"
 	"github.com/ltcsuite/lnd/htlcswitch"
+	"github.com/ltcsuite/lnd/lnrpc"
 	"github.com/ltcsuite/lnd/lntypes"
 	"github.com/ltcsuite/lnd/lnwire"
 )
@@ -193,8 +194,39 @@ func (r *forwardInterceptor) resolveFromClient(
 	switch in.Action {
 	case ResolveHoldForwardAction_RESUME:
 		return interceptedForward.Resume()
+	case ResolveHoldForwardAction_RESUME_MODIFIED:
+		mods, err := unmarshallResumeModifications(in)
+		if err == nil {
+			err = interceptedForward.ResumeModified(mods)
+		}
+
+		// If the modifications are invalid, the forward is still held,
+		// so the client can resolve it again.
+		if err != nil {
+			r.holdForwards[circuitKey] = interceptedForward
+			return err
+		}
+		return nil
 	case ResolveHoldForwardAction_FAIL:
-		return interceptedForward.Fail()
+		var err error
+		if in.FailureCode == lnrpc.Failure_RESERVED {
+			err = interceptedForward.Fail()
+		} else {
+			var code lnwire.FailCode
+			code, err = unmarshallFailureCode(in.FailureCode)
+			if err == nil {
+				err = interceptedForward.FailWithCode(code)
+			}
+		}
+
+		// If the failure code is unsupported or the forward could not
+		// be failed, keep holding it so the client can resolve it
+		// again.
+		if err != nil {
+			r.holdForwards[circuitKey] = interceptedForward
+			return err
+		}
+		return nil
 	case ResolveHoldForwardAction_SETTLE:
 		if in.Preimage == nil {
 			return ErrMissingPreimage
@@ -209,18 +241,71 @@ func (r *forwardInterceptor) resolveFromClient(
 	}
 }
 
-// onDisconnect removes all previousely held forwards from
-// the store. Before they are removed it ensure to resume as the default
-// behavior.
+// unmarshallResumeModifications parses the modifications of a forward that is
+// resumed with the RESUME_MODIFIED action.
+func unmarshallResumeModifications(in *ForwardHtlcInterceptResponse) (
+	htlcswitch.ResumeModifications, error) {
+
+	var mods htlcswitch.ResumeModifications
+	if in.OutgoingAmountMsat != 0 {
+		amt := lnwire.MilliSatoshi(in.OutgoingAmountMsat)
+		mods.OutgoingAmount = &amt
+	}
+	if in.OutgoingChanId != 0 {
+		chanID := lnwire.NewShortChanIDFromInt(in.OutgoingChanId)
+		mods.OutgoingChanID = &chanID
+	}
+	if len(in.OutgoingOnionBlob) != 0 {
+		if len(in.OutgoingOnionBlob) != lnwire.OnionPacketSize {
+			return mods, fmt.Errorf("onion blob must be %d bytes",
+				lnwire.OnionPacketSize)
+		}
+
+		var onionBlob [lnwire.OnionPacketSize]byte
+		copy(onionBlob[:], in.OutgoingOnionBlob)
+		mods.OnionBlob = &onionBlob
+	}
+
+	return mods, nil
+}
+
+// unmarshallFailureCode maps the rpc failure codes that an intercepted forward
+// can be failed with to their wire counterparts.
+func unmarshallFailureCode(code lnrpc.Failure_FailureCode) (lnwire.FailCode,
+	error) {
+
+	switch code {
+	case lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS:
+		return lnwire.CodeIncorrectOrUnknownPaymentDetails, nil
+	case lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE:
+		return lnwire.CodeTemporaryChannelFailure, nil
+	case lnrpc.Failure_REQUIRED_NODE_FEATURE_MISSING:
+		return lnwire.CodeRequiredNodeFeatureMissing, nil
+	case lnrpc.Failure_REQUIRED_CHANNEL_FEATURE_MISSING:
+		return lnwire.CodeRequiredChannelFeatureMissing, nil
+	case lnrpc.Failure_UNKNOWN_NEXT_PEER:
+		return lnwire.CodeUnknownNextPeer, nil
+	case lnrpc.Failure_TEMPORARY_NODE_FAILURE:
+		return lnwire.CodeTemporaryNodeFailure, nil
+	case lnrpc.Failure_PERMANENT_NODE_FAILURE:
+		return lnwire.CodePermanentNodeFailure, nil
+	case lnrpc.Failure_PERMANENT_CHANNEL_FAILURE:
+		return lnwire.CodePermanentChannelFailure, nil
+	default:
+		return 0, fmt.Errorf("unsupported failure code %v", code)
+	}
+}
+
+// onDisconnect removes all previousely held forwards from the store. The
+// switch takes care of the forwards that are still held, by either resuming
+// them or replaying them to the next interceptor.
 func (r *forwardInterceptor) onDisconnect() {
 	// Then close the channel so all go routine will exit.
 	close(r.quit)
 
-	log.Infof("RPC interceptor disconnected, resolving held packets")
-	for key, forward := range r.holdForwards {
-		if err := forward.Resume(); err != nil {
-			log.Errorf("failed to resume hold forward %v", err)
-		}
+	log.Infof("RPC interceptor disconnected, releasing %d held packets",
+		len(r.holdForwards))
+	for key := range r.holdForwards {
 		delete(r.holdForwards, key)
 	}
 	r.wg.Wait()
@@ -0,0 +1,101 @@
+package routerrpc
+
+import (
+	"testing"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/htlcswitch"
+	"github.com/ltcsuite/lnd/lnrpc"
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// mockForward is a mock htlcswitch.InterceptedForward that records how it was
+// resolved.
+type mockForward struct {
+	packet   htlcswitch.InterceptedPacket
+	resolved []string
+}
+
+func (m *mockForward) Packet() htlcswitch.InterceptedPacket {
+	return m.packet
+}
+
+func (m *mockForward) Resume() error {
+	m.resolved = append(m.resolved, "resume")
+	return nil
+}
+
+func (m *mockForward) ResumeModified(htlcswitch.ResumeModifications) error {
+	m.resolved = append(m.resolved, "resume_modified")
+	return nil
+}
+
+func (m *mockForward) Settle(lntypes.Preimage) error {
+	m.resolved = append(m.resolved, "settle")
+	return nil
+}
+
+func (m *mockForward) Fail() error {
+	m.resolved = append(m.resolved, "fail")
+	return nil
+}
+
+func (m *mockForward) FailWithCode(lnwire.FailCode) error {
+	m.resolved = append(m.resolved, "fail_with_code")
+	return nil
+}
+
+// TestResolveUnsupportedFailureCode asserts that a forward that is failed with
+// an unsupported failure code stays held, and can be resolved again by the
+// client afterwards.
+func TestResolveUnsupportedFailureCode(t *testing.T) {
+	t.Parallel()
+
+	circuitKey := channeldb.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(1),
+		HtlcID: 2,
+	}
+	forward := &mockForward{
+		packet: htlcswitch.InterceptedPacket{
+			IncomingCircuit: circuitKey,
+		},
+	}
+
+	interceptor := newForwardInterceptor(nil, nil)
+	interceptor.holdForwards[circuitKey] = forward
+
+	rpcKey := &CircuitKey{
+		ChanId: circuitKey.ChanID.ToUint64(),
+		HtlcId: circuitKey.HtlcID,
+	}
+
+	// Failing the forward with a code that can't be sent back to the
+	// sender must be rejected without releasing the forward.
+	err := interceptor.resolveFromClient(&ForwardHtlcInterceptResponse{
+		IncomingCircuitKey: rpcKey,
+		Action:             ResolveHoldForwardAction_FAIL,
+		FailureCode:        lnrpc.Failure_INVALID_ONION_HMAC,
+	})
+	require.Error(t, err)
+	require.Empty(t, forward.resolved)
+	require.Contains(t, interceptor.holdForwards, circuitKey)
+
+	// The client can still resolve the same forward.
+	err = interceptor.resolveFromClient(&ForwardHtlcInterceptResponse{
+		IncomingCircuitKey: rpcKey,
+		Action:             ResolveHoldForwardAction_FAIL,
+		FailureCode:        lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"fail_with_code"}, forward.resolved)
+	require.NotContains(t, interceptor.holdForwards, circuitKey)
+
+	// Once resolved, the forward is no longer known.
+	err = interceptor.resolveFromClient(&ForwardHtlcInterceptResponse{
+		IncomingCircuitKey: rpcKey,
+		Action:             ResolveHoldForwardAction_RESUME,
+	})
+	require.Equal(t, ErrFwdNotExists, err)
+}
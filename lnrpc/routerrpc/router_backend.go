@@ -15,6 +15,7 @@ import (
 	"github.com/ltcsuite/lnd/lnrpc"
 	"github.com/ltcsuite/lnd/lntypes"
 	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/offers"
 	"github.com/ltcsuite/lnd/record"
 	"github.com/ltcsuite/lnd/routing"
 	"github.com/ltcsuite/lnd/routing/route"
@@ -56,7 +57,8 @@ type RouterBackend struct {
 		amt lnwire.MilliSatoshi, restrictions *routing.RestrictParams,
 		destCustomRecords record.CustomSet,
 		routeHints map[route.Vertex][]*channeldb.CachedEdgePolicy,
-		finalExpiry uint16) (*route.Route, error)
+		finalExpiry uint16,
+		blindedPayment *routing.BlindedPayment) (*route.Route, error)
 
 	MissionControl MissionControl
 
@@ -325,7 +327,7 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	// the route.
 	route, err := r.FindRoute(
 		sourcePubKey, targetPubKey, amt, restrictions,
-		customRecords, routeHintEdges, finalCLTVDelta,
+		customRecords, routeHintEdges, finalCLTVDelta, nil,
 	)
 	if err != nil {
 		return nil, err
@@ -753,9 +755,22 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		copy(payIntent.Target[:], destKey)
 
 		payIntent.FinalCLTVDelta = uint16(payReq.MinFinalCLTVExpiry())
-		payIntent.RouteHints = append(
-			payIntent.RouteHints, payReq.RouteHints...,
-		)
+
+		// If the invoice hides the recipient behind a blinded path,
+		// we'll pay to the final hop of the path instead of the
+		// invoice's destination. Route hints only lead to the
+		// destination, so they're ignored in that case.
+		if len(payReq.BlindedPaymentPaths) > 0 {
+			payIntent.BlindedPayment =
+				routing.NewBlindedPaymentFromInvoice(
+					payReq.BlindedPaymentPaths[0],
+				)
+			payIntent.Target = payIntent.BlindedPayment.Target()
+		} else {
+			payIntent.RouteHints = append(
+				payIntent.RouteHints, payReq.RouteHints...,
+			)
+		}
 		payIntent.DestFeatures = payReq.Features
 		payIntent.PaymentAddr = payAddr
 		payIntent.PaymentRequest = []byte(rpcPayReq.PaymentRequest)
@@ -897,6 +912,89 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	return payIntent, nil
 }
 
+// extractIntentFromOfferInvoice creates the payment intent for an invoice that
+// was fetched for a BOLT 12 offer. The invoice is paid over its first blinded
+// path.
+func (r *RouterBackend) extractIntentFromOfferInvoice(invoice *offers.Invoice,
+	req *PayOfferRequest,
+	timeout time.Duration) (*routing.LightningPayment, error) {
+
+	if len(invoice.PaymentPaths) == 0 {
+		return nil, offers.ErrNoPaymentPaths
+	}
+
+	cltvLimit, err := ValidateCLTVLimit(0, r.MaxTotalTimelock)
+	if err != nil {
+		return nil, err
+	}
+
+	feeLimit, err := lnrpc.UnmarshallAmt(0, req.FeeLimitMsat)
+	if err != nil {
+		return nil, err
+	}
+
+	payReq, err := invoice.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	// The expiry delta of the blinded path includes the final delta of
+	// the recipient, which only equals the delta of the path if the
+	// recipient is the introduction node. Otherwise we assume the
+	// default, as the split between the hops of the path is only relevant
+	// for the hops themselves.
+	path := invoice.PaymentPaths[0]
+	finalCltvDelta := path.CltvExpiryDelta
+	if len(path.BlindedHops) > 1 &&
+		r.DefaultFinalCltvDelta < finalCltvDelta {
+
+		finalCltvDelta = r.DefaultFinalCltvDelta
+	}
+
+	// The blinded recipient must accept TLV payloads to be able to read
+	// its encrypted data.
+	features := lnwire.NewRawFeatureVector()
+	if invoice.Features != nil {
+		features = invoice.Features.Clone()
+	}
+	features.Set(lnwire.TLVOnionPayloadOptional)
+
+	payIntent := &routing.LightningPayment{
+		Amount:            invoice.Amount,
+		FeeLimit:          feeLimit,
+		CltvLimit:         cltvLimit,
+		FinalCLTVDelta:    finalCltvDelta,
+		PayAttemptTimeout: timeout,
+		DestFeatures: lnwire.NewFeatureVector(
+			features, lnwire.Features,
+		),
+		BlindedPayment: routing.NewBlindedPaymentFromInvoice(path),
+		PaymentRequest: []byte(payReq),
+
+		// Without a payment address, the payment can't be split.
+		MaxParts: 1,
+	}
+	payIntent.Target = payIntent.BlindedPayment.Target()
+
+	err = payIntent.SetPaymentHash(invoice.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = routing.ValidateCLTVLimit(
+		payIntent.CltvLimit, payIntent.FinalCLTVDelta, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if payIntent.Target == r.SelfNode {
+		return nil, errors.New("self-payments not allowed")
+	}
+
+	return payIntent, nil
+}
+
 // unmarshallRouteHints unmarshalls a list of route hints.
 func unmarshallRouteHints(rpcRouteHints []*lnrpc.RouteHint) (
 	[][]zpay32.HopHint, error) {
@@ -1376,6 +1474,7 @@ func (r *RouterBackend) MarshallPayment(payment *channeldb.MPPayment) (
 		Htlcs:           htlcs,
 		PaymentIndex:    payment.SequenceNum,
 		FailureReason:   failureReason,
+		Label:           payment.Info.Label,
 	}, nil
 }
 
@@ -127,7 +127,8 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 		amt lnwire.MilliSatoshi, restrictions *routing.RestrictParams,
 		_ record.CustomSet,
 		routeHints map[route.Vertex][]*channeldb.CachedEdgePolicy,
-		finalExpiry uint16) (*route.Route, error) {
+		finalExpiry uint16,
+		_ *routing.BlindedPayment) (*route.Route, error) {
 
 		if int64(amt) != amtSat*1000 {
 			t.Fatal("unexpected amount")
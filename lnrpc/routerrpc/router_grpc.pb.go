@@ -104,6 +104,25 @@ type RouterClient interface {
 	//channel to stay disabled until a subsequent manual request of either
 	//"enable" or "auto".
 	UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error)
+	//
+	//PayOffer pays a BOLT 12 offer. It requests an invoice from the issuer of
+	//the offer over onion messages and pays it over the blinded paths that the
+	//invoice contains. The call returns a stream of payment updates.
+	PayOffer(ctx context.Context, in *PayOfferRequest, opts ...grpc.CallOption) (Router_PayOfferClient, error)
+	//
+	//StartRebalancer starts the built-in rebalancer. It periodically moves
+	//local balance between the given channels through circular payments, so
+	//that their local balance approaches the target ratio of their capacity.
+	//Successful rebalances are recorded in the payments database with the
+	//label "rebalance".
+	StartRebalancer(ctx context.Context, in *StartRebalancerRequest, opts ...grpc.CallOption) (*StartRebalancerResponse, error)
+	//
+	//StopRebalancer stops the built-in rebalancer.
+	StopRebalancer(ctx context.Context, in *StopRebalancerRequest, opts ...grpc.CallOption) (*StopRebalancerResponse, error)
+	//
+	//RebalancerStatus returns the parameters of the built-in rebalancer along
+	//with its most recent rebalance attempts.
+	RebalancerStatus(ctx context.Context, in *RebalancerStatusRequest, opts ...grpc.CallOption) (*RebalancerStatusResponse, error)
 }
 
 type routerClient struct {
@@ -407,6 +426,65 @@ func (c *routerClient) UpdateChanStatus(ctx context.Context, in *UpdateChanStatu
 	return out, nil
 }
 
+func (c *routerClient) PayOffer(ctx context.Context, in *PayOfferRequest, opts ...grpc.CallOption) (Router_PayOfferClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[6], "/routerrpc.Router/PayOffer", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerPayOfferClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_PayOfferClient interface {
+	Recv() (*lnrpc.Payment, error)
+	grpc.ClientStream
+}
+
+type routerPayOfferClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerPayOfferClient) Recv() (*lnrpc.Payment, error) {
+	m := new(lnrpc.Payment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) StartRebalancer(ctx context.Context, in *StartRebalancerRequest, opts ...grpc.CallOption) (*StartRebalancerResponse, error) {
+	out := new(StartRebalancerResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/StartRebalancer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) StopRebalancer(ctx context.Context, in *StopRebalancerRequest, opts ...grpc.CallOption) (*StopRebalancerResponse, error) {
+	out := new(StopRebalancerResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/StopRebalancer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) RebalancerStatus(ctx context.Context, in *RebalancerStatusRequest, opts ...grpc.CallOption) (*RebalancerStatusResponse, error) {
+	out := new(RebalancerStatusResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/RebalancerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RouterServer is the server API for Router service.
 // All implementations must embed UnimplementedRouterServer
 // for forward compatibility
@@ -496,6 +574,25 @@ type RouterServer interface {
 	//channel to stay disabled until a subsequent manual request of either
 	//"enable" or "auto".
 	UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error)
+	//
+	//PayOffer pays a BOLT 12 offer. It requests an invoice from the issuer of
+	//the offer over onion messages and pays it over the blinded paths that the
+	//invoice contains. The call returns a stream of payment updates.
+	PayOffer(*PayOfferRequest, Router_PayOfferServer) error
+	//
+	//StartRebalancer starts the built-in rebalancer. It periodically moves
+	//local balance between the given channels through circular payments, so
+	//that their local balance approaches the target ratio of their capacity.
+	//Successful rebalances are recorded in the payments database with the
+	//label "rebalance".
+	StartRebalancer(context.Context, *StartRebalancerRequest) (*StartRebalancerResponse, error)
+	//
+	//StopRebalancer stops the built-in rebalancer.
+	StopRebalancer(context.Context, *StopRebalancerRequest) (*StopRebalancerResponse, error)
+	//
+	//RebalancerStatus returns the parameters of the built-in rebalancer along
+	//with its most recent rebalance attempts.
+	RebalancerStatus(context.Context, *RebalancerStatusRequest) (*RebalancerStatusResponse, error)
 	mustEmbedUnimplementedRouterServer()
 }
 
@@ -554,6 +651,18 @@ func (UnimplementedRouterServer) HtlcInterceptor(Router_HtlcInterceptorServer) e
 func (UnimplementedRouterServer) UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChanStatus not implemented")
 }
+func (UnimplementedRouterServer) PayOffer(*PayOfferRequest, Router_PayOfferServer) error {
+	return status.Errorf(codes.Unimplemented, "method PayOffer not implemented")
+}
+func (UnimplementedRouterServer) StartRebalancer(context.Context, *StartRebalancerRequest) (*StartRebalancerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRebalancer not implemented")
+}
+func (UnimplementedRouterServer) StopRebalancer(context.Context, *StopRebalancerRequest) (*StopRebalancerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRebalancer not implemented")
+}
+func (UnimplementedRouterServer) RebalancerStatus(context.Context, *RebalancerStatusRequest) (*RebalancerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalancerStatus not implemented")
+}
 func (UnimplementedRouterServer) mustEmbedUnimplementedRouterServer() {}
 
 // UnsafeRouterServer may be embedded to opt out of forward compatibility for this service.
@@ -896,6 +1005,81 @@ func _Router_UpdateChanStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_PayOffer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PayOfferRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).PayOffer(m, &routerPayOfferServer{stream})
+}
+
+type Router_PayOfferServer interface {
+	Send(*lnrpc.Payment) error
+	grpc.ServerStream
+}
+
+type routerPayOfferServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerPayOfferServer) Send(m *lnrpc.Payment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Router_StartRebalancer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRebalancerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).StartRebalancer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/StartRebalancer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).StartRebalancer(ctx, req.(*StartRebalancerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_StopRebalancer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopRebalancerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).StopRebalancer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/StopRebalancer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).StopRebalancer(ctx, req.(*StopRebalancerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_RebalancerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalancerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).RebalancerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/RebalancerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).RebalancerStatus(ctx, req.(*RebalancerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Router_ServiceDesc is the grpc.ServiceDesc for Router service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -947,6 +1131,18 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChanStatus",
 			Handler:    _Router_UpdateChanStatus_Handler,
 		},
+		{
+			MethodName: "StartRebalancer",
+			Handler:    _Router_StartRebalancer_Handler,
+		},
+		{
+			MethodName: "StopRebalancer",
+			Handler:    _Router_StopRebalancer_Handler,
+		},
+		{
+			MethodName: "RebalancerStatus",
+			Handler:    _Router_RebalancerStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -980,6 +1176,11 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "PayOffer",
+			Handler:       _Router_PayOffer_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "routerrpc/router.proto",
 }
@@ -16,6 +16,8 @@ import (
 	"github.com/ltcsuite/lnd/lntypes"
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/macaroons"
+	"github.com/ltcsuite/lnd/offers"
+	"github.com/ltcsuite/lnd/rebalancer"
 	"github.com/ltcsuite/lnd/routing"
 	"github.com/ltcsuite/lnd/routing/route"
 	"github.com/ltcsuite/ltcd/ltcutil"
@@ -61,6 +63,10 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/PayOffer": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/routerrpc.Router/SendToRouteV2": {{
 			Entity: "offchain",
 			Action: "write",
@@ -125,6 +131,18 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/StartRebalancer": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/StopRebalancer": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/RebalancerStatus": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -315,7 +333,53 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 		return err
 	}
 
-	err = s.cfg.Router.SendPaymentAsync(payment)
+	return s.sendPayment(payment, stream, req.NoInflightUpdates)
+}
+
+// PayOffer pays a BOLT 12 offer. It requests an invoice from the issuer of
+// the offer over onion messages and pays it over the blinded paths that the
+// invoice contains. The call returns a stream of payment updates.
+func (s *Server) PayOffer(req *PayOfferRequest,
+	stream Router_PayOfferServer) error {
+
+	if s.cfg.OfferManager == nil {
+		return status.Error(codes.Unavailable, "paying offers "+
+			"requires onion messages, which are disabled")
+	}
+
+	offer, err := offers.DecodeOffer(req.Offer)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.TimeoutSeconds <= 0 {
+		return errors.New("timeout_seconds must be specified")
+	}
+	timeout := time.Second * time.Duration(req.TimeoutSeconds)
+
+	invoice, err := s.cfg.OfferManager.FetchInvoice(
+		offer, lnwire.MilliSatoshi(req.AmtMsat), req.Quantity,
+		req.PayerNote, timeout,
+	)
+	if err != nil {
+		return err
+	}
+
+	payment, err := s.cfg.RouterBackend.extractIntentFromOfferInvoice(
+		invoice, req, timeout,
+	)
+	if err != nil {
+		return err
+	}
+
+	return s.sendPayment(payment, stream, req.NoInflightUpdates)
+}
+
+// sendPayment dispatches the passed payment and streams its updates.
+func (s *Server) sendPayment(payment *routing.LightningPayment,
+	stream Router_SendPaymentV2Server, noInflightUpdates bool) error {
+
+	err := s.cfg.Router.SendPaymentAsync(payment)
 	if err != nil {
 		// Transform user errors to grpc code.
 		if err == channeldb.ErrPaymentInFlight ||
@@ -335,7 +399,7 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 		return err
 	}
 
-	return s.trackPayment(payment.Identifier(), stream, req.NoInflightUpdates)
+	return s.trackPayment(payment.Identifier(), stream, noInflightUpdates)
 }
 
 // EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
@@ -369,7 +433,7 @@ func (s *Server) EstimateRouteFee(ctx context.Context,
 			FeeLimit:          feeLimit,
 			CltvLimit:         s.cfg.RouterBackend.MaxTotalTimelock,
 			ProbabilitySource: mc.GetProbability,
-		}, nil, nil, s.cfg.RouterBackend.DefaultFinalCltvDelta,
+		}, nil, nil, s.cfg.RouterBackend.DefaultFinalCltvDelta, nil,
 	)
 	if err != nil {
 		return nil, err
@@ -934,3 +998,102 @@ func (s *Server) UpdateChanStatus(ctx context.Context,
 	}
 	return &UpdateChanStatusResponse{}, nil
 }
+
+// StartRebalancer starts the built-in rebalancer with the given targets.
+func (s *Server) StartRebalancer(_ context.Context,
+	req *StartRebalancerRequest) (*StartRebalancerResponse, error) {
+
+	if s.cfg.Rebalancer == nil {
+		return nil, errors.New("rebalancer not available")
+	}
+
+	params := &rebalancer.Params{
+		Targets:   make(map[lnwire.ShortChannelID]float64),
+		Tolerance: req.Tolerance,
+		MaxFeePPM: req.MaxFeePpm,
+		MaxAmount: ltcutil.Amount(req.MaxAmtSat),
+		Interval:  time.Duration(req.IntervalSeconds) * time.Second,
+	}
+	for _, target := range req.Targets {
+		chanID := lnwire.NewShortChanIDFromInt(target.ChanId)
+		if _, ok := params.Targets[chanID]; ok {
+			return nil, fmt.Errorf("duplicate target for "+
+				"channel %v", chanID)
+		}
+		params.Targets[chanID] = target.LocalRatio
+	}
+
+	if err := s.cfg.Rebalancer.Start(params); err != nil {
+		return nil, err
+	}
+
+	return &StartRebalancerResponse{}, nil
+}
+
+// StopRebalancer stops the built-in rebalancer.
+func (s *Server) StopRebalancer(_ context.Context,
+	_ *StopRebalancerRequest) (*StopRebalancerResponse, error) {
+
+	if s.cfg.Rebalancer == nil {
+		return nil, errors.New("rebalancer not available")
+	}
+
+	s.cfg.Rebalancer.Stop()
+
+	return &StopRebalancerResponse{}, nil
+}
+
+// RebalancerStatus returns the parameters of the built-in rebalancer and its
+// most recent rebalance attempts.
+func (s *Server) RebalancerStatus(_ context.Context,
+	_ *RebalancerStatusRequest) (*RebalancerStatusResponse, error) {
+
+	if s.cfg.Rebalancer == nil {
+		return nil, errors.New("rebalancer not available")
+	}
+
+	state := s.cfg.Rebalancer.Status()
+
+	resp := &RebalancerStatusResponse{
+		Active:       state.Active,
+		TotalAmtMsat: int64(state.TotalAmount),
+		TotalFeeMsat: int64(state.TotalFees),
+	}
+
+	if params := state.Params; params != nil {
+		resp.Params = &StartRebalancerRequest{
+			Tolerance:       params.Tolerance,
+			MaxFeePpm:       params.MaxFeePPM,
+			MaxAmtSat:       int64(params.MaxAmount),
+			IntervalSeconds: uint32(params.Interval / time.Second),
+		}
+		for chanID, ratio := range params.Targets {
+			resp.Params.Targets = append(
+				resp.Params.Targets, &RebalanceTarget{
+					ChanId:     chanID.ToUint64(),
+					LocalRatio: ratio,
+				},
+			)
+		}
+	}
+
+	for _, result := range state.Results {
+		rpcResult := &RebalanceResult{
+			TimestampNs:    result.Timestamp.UnixNano(),
+			OutgoingChanId: result.OutgoingChanID.ToUint64(),
+			IncomingChanId: result.IncomingChanID.ToUint64(),
+			AmtMsat:        int64(result.Amount),
+			FeeMsat:        int64(result.Fee),
+		}
+		if result.PaymentHash != lntypes.ZeroHash {
+			rpcResult.PaymentHash = result.PaymentHash[:]
+		}
+		if result.Err != nil {
+			rpcResult.Error = result.Err.Error()
+		}
+
+		resp.Results = append(resp.Results, rpcResult)
+	}
+
+	return resp, nil
+}
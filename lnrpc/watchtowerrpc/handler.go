@@ -10,6 +10,9 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/ltcsuite/lnd/lnrpc"
+	"github.com/ltcsuite/lnd/watchtower/blob"
+	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 )
@@ -29,6 +32,18 @@ var (
 			Entity: "info",
 			Action: "read",
 		}},
+		"/watchtowerrpc.Watchtower/ListRewards": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/watchtowerrpc.Watchtower/ListSessions": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/watchtowerrpc.Watchtower/RevokeSessions": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// ErrTowerNotActive signals that RPC calls cannot be processed because
@@ -174,6 +189,134 @@ func (c *Handler) GetInfo(ctx context.Context,
 	}, nil
 }
 
+// ListRewards returns the rewards the watchtower claimed with the justice
+// transactions it published for reward sessions.
+func (c *Handler) ListRewards(ctx context.Context,
+	req *ListRewardsRequest) (*ListRewardsResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	records, err := c.cfg.Tower.ListRewards()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListRewardsResponse{}
+	for _, record := range records {
+		resp.Rewards = append(resp.Rewards, &Reward{
+			SessionId:   record.SessionID[:],
+			BreachTxid:  record.BreachTxID.String(),
+			JusticeTxid: record.JusticeTxID.String(),
+			AmountSat:   int64(record.Amount),
+			Timestamp:   record.Timestamp.Unix(),
+		})
+		resp.TotalAmountSat += int64(record.Amount)
+	}
+
+	return resp, nil
+}
+
+// ListSessions returns the sessions negotiated with the watchtower, optionally
+// only those of a single client.
+func (c *Handler) ListSessions(ctx context.Context,
+	req *ListSessionsRequest) (*ListSessionsResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	var clientKey *btcec.PublicKey
+	if len(req.ClientKey) > 0 {
+		var err error
+		clientKey, err = btcec.ParsePubKey(req.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client key: %v", err)
+		}
+	}
+
+	sessions, err := c.cfg.Tower.ListSessions(clientKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListSessionsResponse{}
+	for _, session := range sessions {
+		var clientKey []byte
+		if session.ClientKey != nil {
+			clientKey = session.ClientKey.SerializeCompressed()
+		}
+
+		blobType := session.Policy.BlobType
+		resp.Sessions = append(resp.Sessions, &Session{
+			Id:           session.ID[:],
+			ClientKey:    clientKey,
+			BlobType:     uint32(blobType),
+			MaxUpdates:   uint32(session.Policy.MaxUpdates),
+			NumUpdates:   uint32(session.LastApplied),
+			StorageBytes: session.StorageBytes(),
+			Reward:       blobType.Has(blob.FlagReward),
+		})
+	}
+
+	return resp, nil
+}
+
+// RevokeSessions deletes a session or all sessions of a client from the
+// watchtower.
+func (c *Handler) RevokeSessions(ctx context.Context,
+	req *RevokeSessionsRequest) (*RevokeSessionsResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	var ids []wtdb.SessionID
+	switch {
+	case len(req.SessionId) > 0 && len(req.ClientKey) > 0:
+		return nil, errors.New("only one of session id and client " +
+			"key can be set")
+
+	case len(req.SessionId) > 0:
+		if len(req.SessionId) != wtdb.SessionIDSize {
+			return nil, fmt.Errorf("invalid session id length %d",
+				len(req.SessionId))
+		}
+
+		var id wtdb.SessionID
+		copy(id[:], req.SessionId)
+		ids = append(ids, id)
+
+	case len(req.ClientKey) > 0:
+		clientKey, err := btcec.ParsePubKey(req.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client key: %v", err)
+		}
+
+		sessions, err := c.cfg.Tower.ListSessions(clientKey)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, session := range sessions {
+			ids = append(ids, session.ID)
+		}
+
+	default:
+		return nil, errors.New("session id or client key must be set")
+	}
+
+	numRevoked, err := c.cfg.Tower.RevokeSessions(ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RevokeSessionsResponse{
+		NumRevoked: uint32(numRevoked),
+	}, nil
+}
+
 // isActive returns nil if the tower backend is initialized, and the Handler can
 // proccess RPC requests.
 func (c *Handler) isActive() error {
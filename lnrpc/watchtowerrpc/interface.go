@@ -3,6 +3,7 @@ package watchtowerrpc
 import (
 	"net"
 
+	"github.com/ltcsuite/lnd/watchtower/wtdb"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 )
 
@@ -20,4 +21,15 @@ type WatchtowerBackend interface {
 	// ExternalIPs returns the addresses where the watchtower can be reached
 	// by clients externally.
 	ExternalIPs() []net.Addr
+
+	// ListRewards returns the records of the watchtower's reward ledger.
+	ListRewards() ([]*wtdb.RewardRecord, error)
+
+	// ListSessions returns the sessions negotiated with the watchtower, or
+	// only those of the given client if the client key isn't nil.
+	ListSessions(clientKey *btcec.PublicKey) ([]*wtdb.SessionInfo, error)
+
+	// RevokeSessions deletes the given sessions from the watchtower, and
+	// returns the number of sessions that existed.
+	RevokeSessions(ids ...wtdb.SessionID) (int, error)
 }
@@ -281,6 +281,13 @@ func (c *WatchtowerClient) ListTowers(ctx context.Context,
 		towers[tower.Tower.ID] = tower
 	}
 	for _, tower := range legacyTowers {
+		// Both clients track the health of a tower they share
+		// separately, so we combine them.
+		if anchorTower, ok := towers[tower.Tower.ID]; ok {
+			tower.Health = mergeTowerHealth(
+				anchorTower.Health, tower.Health,
+			)
+		}
 		towers[tower.Tower.ID] = tower
 	}
 
@@ -293,6 +300,29 @@ func (c *WatchtowerClient) ListTowers(ctx context.Context,
 	return &ListTowersResponse{Towers: rpcTowers}, nil
 }
 
+// mergeTowerHealth combines the health of a tower as observed by two clients.
+// The backlogs of both clients add up, while the tower's last ack and failure
+// streak are taken from the client that observed the most recent ack and the
+// longest streak, as both clients observe the same tower.
+func mergeTowerHealth(a, b wtclient.TowerHealth) wtclient.TowerHealth {
+	lastAck := a.LastAck
+	if b.LastAck.After(lastAck) {
+		lastAck = b.LastAck
+	}
+
+	failures := a.ConsecutiveFailures
+	if b.ConsecutiveFailures > failures {
+		failures = b.ConsecutiveFailures
+	}
+
+	return wtclient.TowerHealth{
+		NumPendingBackups:   a.NumPendingBackups + b.NumPendingBackups,
+		LastAck:             lastAck,
+		ConsecutiveFailures: failures,
+		Healthy:             a.Healthy && b.Healthy,
+	}
+}
+
 // GetTowerInfo retrieves information for a registered watchtower.
 func (c *WatchtowerClient) GetTowerInfo(ctx context.Context,
 	req *GetTowerInfoRequest) (*Tower, error) {
@@ -410,11 +440,20 @@ func marshallTower(tower *wtclient.RegisteredTower, includeSessions bool) *Tower
 		}
 	}
 
+	var lastAck uint64
+	if !tower.Health.LastAck.IsZero() {
+		lastAck = uint64(tower.Health.LastAck.Unix())
+	}
+
 	return &Tower{
 		Pubkey:                 tower.IdentityKey.SerializeCompressed(),
 		Addresses:              rpcAddrs,
 		ActiveSessionCandidate: tower.ActiveSessionCandidate,
 		NumSessions:            uint32(len(tower.Sessions)),
 		Sessions:               rpcSessions,
+		NumPendingBackups:      tower.Health.NumPendingBackups,
+		LastAckTimestamp:       lastAck,
+		Healthy:                tower.Health.Healthy,
+		NumConsecutiveFailures: tower.Health.ConsecutiveFailures,
 	}
 }
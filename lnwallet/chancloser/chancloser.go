@@ -35,6 +35,22 @@ var (
 	// shutdown script previously set for that party.
 	ErrUpfrontShutdownScriptMismatch = fmt.Errorf("shutdown script does not " +
 		"match upfront shutdown script")
+
+	// ErrFeeBumpUnsupported is returned when the fee of a closing
+	// transaction is attempted to be bumped for a channel that doesn't use
+	// the simple close protocol.
+	ErrFeeBumpUnsupported = fmt.Errorf("closing fee can only be bumped " +
+		"with the simple close protocol")
+
+	// ErrCloseFeeTooLow is returned when a fee bump doesn't increase the
+	// fee of our last proposed closing transaction, so it couldn't
+	// replace that transaction.
+	ErrCloseFeeTooLow = fmt.Errorf("closing fee must be higher than the " +
+		"fee of the last proposed closing transaction")
+
+	// ErrCloseSuperseded is sent to a close request once a later request
+	// bumped the fee of the closing transaction.
+	ErrCloseSuperseded = fmt.Errorf("close request superseded by fee bump")
 )
 
 // closeState represents all the possible states the channel closer state
@@ -66,6 +82,16 @@ const (
 	// causes a shift into the closeFinished state.
 	closeFeeNegotiation
 
+	// closeSimpleNegotiation is the state that both parties enter after
+	// they've sent and received a shutdown message, if they negotiated the
+	// simple close protocol. In this state, either party can send a
+	// ClosingComplete message for a closing transaction that it pays the
+	// fee for, to which the other party responds with a ClosingSig
+	// message, after which both broadcast the transaction. As the closing
+	// transaction can be replaced by one with a higher fee until it
+	// confirms, the state machine never leaves this state.
+	closeSimpleNegotiation
+
 	// closeFinished is the final state of the state machine. In this state, a
 	// side has accepted a fee offer and has broadcast the valid closing
 	// transaction to the network. During this phase, the closing transaction
@@ -89,6 +115,12 @@ type ChanCloseCfg struct {
 	// Disconnect will disconnect from the remote peer in this close.
 	Disconnect func() error
 
+	// SimpleClose is true if both parties signal support for the simple
+	// close protocol, in which each party pays the fee of the closing
+	// transactions it proposes, and can replace them with ones that pay a
+	// higher fee.
+	SimpleClose bool
+
 	// Quit is a channel that should be sent upon in the occasion the state
 	// machine should cease all progress and shutdown.
 	Quit chan struct{}
@@ -116,8 +148,18 @@ type ChanCloser struct {
 
 	// closingTx is the final, fully signed closing transaction. This will only
 	// be populated once the state machine shifts to the closeFinished state.
+	// With the simple close protocol, this is the last closing transaction
+	// that was broadcast.
 	closingTx *wire.MsgTx
 
+	// idealFeePerKw is the fee rate that we use for the closing
+	// transactions that we propose with the simple close protocol.
+	idealFeePerKw chainfee.SatPerKWeight
+
+	// lastClosingComplete is the last ClosingComplete message that we sent
+	// with the simple close protocol.
+	lastClosingComplete *lnwire.ClosingComplete
+
 	// idealFeeSat is the ideal fee that the state machine should initially
 	// offer when starting negotiation. This will be used as a baseline.
 	idealFeeSat ltcutil.Amount
@@ -193,6 +235,7 @@ func NewChanCloser(cfg ChanCloseCfg, deliveryScript []byte,
 		cfg:                 cfg,
 		negotiationHeight:   negotiationHeight,
 		idealFeeSat:         idealFeeSat,
+		idealFeePerKw:       idealFeePerKw,
 		localDeliveryScript: deliveryScript,
 		priorFeeOffers:      make(map[ltcutil.Amount]*lnwire.ClosingSigned),
 		locallyInitiated:    locallyInitiated,
@@ -265,8 +308,13 @@ func (c *ChanCloser) ShutdownChan() (*lnwire.Shutdown, error) {
 // closeFinished state.
 func (c *ChanCloser) ClosingTx() (*wire.MsgTx, error) {
 	// If the state machine hasn't finished closing the channel, then we'll
-	// return an error as we haven't yet computed the closing tx.
-	if c.state != closeFinished {
+	// return an error as we haven't yet computed the closing tx. With the
+	// simple close protocol, we return the last closing tx that was
+	// broadcast instead.
+	switch {
+	case c.state == closeFinished:
+	case c.state == closeSimpleNegotiation && c.closingTx != nil:
+	default:
 		return nil, ErrChanCloseNotFinished
 	}
 
@@ -292,6 +340,12 @@ func (c *ChanCloser) NegotiationHeight() uint32 {
 	return c.negotiationHeight
 }
 
+// SimpleClose returns true if the closing transaction is negotiated with the
+// simple close protocol.
+func (c *ChanCloser) SimpleClose() bool {
+	return c.cfg.SimpleClose
+}
+
 // maybeMatchScript attempts to match the script provided in our peer's
 // shutdown message with the upfront shutdown script we have on record. If no
 // upfront shutdown script was set, we do not need to enforce option upfront
@@ -395,6 +449,14 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 		msgsToSend := make([]lnwire.Message, 0, 2)
 		msgsToSend = append(msgsToSend, localShutdown)
 
+		// With the simple close protocol, it's up to the initiator of
+		// the shutdown to propose the first closing transaction, which
+		// it pays the fee for.
+		if c.cfg.SimpleClose {
+			c.state = closeSimpleNegotiation
+			return msgsToSend, false, nil
+		}
+
 		// After the other party receives this message, we'll actually start
 		// the final stage of the closure process: fee negotiation. So we'll
 		// update our internal state to reflect this, so we can handle the next
@@ -439,6 +501,24 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 		// record their preferred delivery closing script.
 		c.remoteDeliveryScript = shutdownMsg.Address
 
+		// With the simple close protocol, we'll propose the first
+		// closing transaction regardless of who opened the channel,
+		// as we pay the fee for it.
+		if c.cfg.SimpleClose {
+			c.state = closeSimpleNegotiation
+
+			chancloserLog.Infof("ChannelPoint(%v): shutdown "+
+				"response received, proposing closing tx",
+				c.chanPoint)
+
+			closingComplete, err := c.proposeClosingComplete()
+			if err != nil {
+				return nil, false, err
+			}
+
+			return []lnwire.Message{closingComplete}, false, nil
+		}
+
 		// At this point, we can now start the fee negotiation state, by
 		// constructing and sending our initial signature for what we think the
 		// closing transaction should look like.
@@ -529,28 +609,9 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 		}
 		c.closingTx = closeTx
 
-		// Before publishing the closing tx, we persist it to the database,
-		// such that it can be republished if something goes wrong.
-		err = c.cfg.Channel.MarkCoopBroadcasted(closeTx, c.locallyInitiated)
-		if err != nil {
-			return nil, false, err
-		}
-
-		// With the closing transaction crafted, we'll now broadcast it to the
-		// network.
-		chancloserLog.Infof("Broadcasting cooperative close tx: %v",
-			newLogClosure(func() string {
-				return spew.Sdump(closeTx)
-			}),
-		)
-
-		// Create a close channel label.
-		chanID := c.cfg.Channel.ShortChanID()
-		closeLabel := labels.MakeLabel(
-			labels.LabelTypeChannelClose, &chanID,
-		)
-
-		if err := c.cfg.BroadcastTx(closeTx, closeLabel); err != nil {
+		// With the closing transaction crafted, we'll now persist and
+		// broadcast it.
+		if err := c.publishClosingTx(closeTx); err != nil {
 			return nil, false, err
 		}
 
@@ -562,6 +623,34 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 		matchingOffer := c.priorFeeOffers[remoteProposedFee]
 		return []lnwire.Message{matchingOffer}, true, nil
 
+	// With the simple close protocol, the remote party either proposes a
+	// closing transaction that it pays the fee for, or signs the last one
+	// that we proposed. In both cases, the second return value indicates
+	// that a new closing transaction was broadcast.
+	case closeSimpleNegotiation:
+		switch msg := msg.(type) {
+		case *lnwire.ClosingComplete:
+			closingSig, err := c.handleClosingComplete(msg)
+			if err != nil {
+				return nil, false, err
+			}
+
+			return []lnwire.Message{closingSig}, true, nil
+
+		case *lnwire.ClosingSig:
+			broadcast, err := c.handleClosingSig(msg)
+			if err != nil {
+				return nil, false, err
+			}
+
+			return nil, broadcast, nil
+
+		default:
+			return nil, false, fmt.Errorf("expected "+
+				"lnwire.ClosingComplete or lnwire.ClosingSig, "+
+				"instead have %v", spew.Sdump(msg))
+		}
+
 	// If we received a message while in the closeFinished state, then this
 	// should only be the remote party echoing the last ClosingSigned message
 	// that we agreed on.
@@ -581,6 +670,33 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 	}
 }
 
+// publishClosingTx persists the passed fully signed closing transaction and
+// broadcasts it to the network.
+func (c *ChanCloser) publishClosingTx(closeTx *wire.MsgTx) error {
+	// Before publishing the closing tx, we persist it to the database,
+	// such that it can be republished if something goes wrong.
+	err := c.cfg.Channel.MarkCoopBroadcasted(closeTx, c.locallyInitiated)
+	if err != nil {
+		return err
+	}
+
+	// With the closing transaction crafted, we'll now broadcast it to the
+	// network.
+	chancloserLog.Infof("Broadcasting cooperative close tx: %v",
+		newLogClosure(func() string {
+			return spew.Sdump(closeTx)
+		}),
+	)
+
+	// Create a close channel label.
+	chanID := c.cfg.Channel.ShortChanID()
+	closeLabel := labels.MakeLabel(
+		labels.LabelTypeChannelClose, &chanID,
+	)
+
+	return c.cfg.BroadcastTx(closeTx, closeLabel)
+}
+
 // proposeCloseSigned attempts to propose a new signature for the closing
 // transaction for a channel based on the prior fee negotiations and our current
 // compromise fee.
@@ -0,0 +1,357 @@
+package chancloser
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ltcsuite/lnd/htlcswitch"
+	"github.com/ltcsuite/lnd/input"
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+// BumpFee proposes a new closing transaction that pays a fee according to the
+// target fee rate of the passed close request. As the fee is higher than that
+// of the closing transaction we proposed before, it replaces that transaction
+// once the remote party signed it. The passed request then replaces the close
+// request of the state machine, and the ClosingComplete message to send to the
+// remote party is returned.
+//
+// NOTE: This is only possible with the simple close protocol, once both
+// parties exchanged their shutdown messages.
+func (c *ChanCloser) BumpFee(
+	req *htlcswitch.ChanClose) (*lnwire.ClosingComplete, error) {
+
+	if !c.cfg.SimpleClose {
+		return nil, ErrFeeBumpUnsupported
+	}
+
+	if c.state != closeSimpleNegotiation {
+		return nil, fmt.Errorf("unable to bump closing fee before "+
+			"shutdown completed: %w", ErrInvalidState)
+	}
+
+	// The delivery script was fixed by our shutdown message, so it can't
+	// be changed by a fee bump.
+	if len(req.DeliveryScript) != 0 &&
+		!bytes.Equal(req.DeliveryScript, c.localDeliveryScript) {
+
+		return nil, fmt.Errorf("delivery script can't be changed " +
+			"after shutdown")
+	}
+
+	prevFeePerKw := c.idealFeePerKw
+	c.idealFeePerKw = req.TargetFeePerKw
+
+	closingComplete, err := c.proposeClosingComplete()
+	if err != nil {
+		c.idealFeePerKw = prevFeePerKw
+		return nil, err
+	}
+
+	c.closeReq = req
+
+	return closingComplete, nil
+}
+
+// closeTxWeight returns the weight of a closing transaction that pays to both
+// parties, which is the upper bound for all variants of the transaction.
+func (c *ChanCloser) closeTxWeight() int64 {
+	var weightEstimate input.TxWeightEstimator
+	weightEstimate.AddWitnessInput(input.MultiSigWitnessSize)
+	weightEstimate.AddTxOutput(&wire.TxOut{
+		PkScript: c.localDeliveryScript,
+	})
+	weightEstimate.AddTxOutput(&wire.TxOut{
+		PkScript: c.remoteDeliveryScript,
+	})
+
+	return int64(weightEstimate.Weight())
+}
+
+// simpleCloseTx returns the description of a simple closing transaction with
+// the passed parameters.
+func (c *ChanCloser) simpleCloseTx(localIsCloser bool, fee ltcutil.Amount,
+	lockTime uint32,
+	outputs lnwallet.SimpleCloseOutputs) *lnwallet.SimpleCloseTx {
+
+	return &lnwallet.SimpleCloseTx{
+		LocalIsCloser:        localIsCloser,
+		Fee:                  fee,
+		LockTime:             lockTime,
+		Outputs:              outputs,
+		LocalDeliveryScript:  c.localDeliveryScript,
+		RemoteDeliveryScript: c.remoteDeliveryScript,
+	}
+}
+
+// proposeClosingComplete creates a ClosingComplete message for a closing
+// transaction that we pay the fee for at our ideal fee rate. It carries our
+// signatures for all variants of the transaction that are valid given the
+// balances of both parties.
+func (c *ChanCloser) proposeClosingComplete() (*lnwire.ClosingComplete,
+	error) {
+
+	fee := c.idealFeePerKw.FeeForWeight(c.closeTxWeight())
+
+	// A new proposal must pay a higher fee than the last one, as it
+	// couldn't replace the prior closing transaction otherwise.
+	if c.lastClosingComplete != nil &&
+		fee <= c.lastClosingComplete.FeeSatoshis {
+
+		return nil, fmt.Errorf("%w: fee=%v, last_fee=%v",
+			ErrCloseFeeTooLow, fee,
+			c.lastClosingComplete.FeeSatoshis)
+	}
+
+	ourBalance, theirBalance, err := c.cfg.Channel.SimpleCloseBalances(
+		true, fee,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Based on the balances after paying the fee, we'll determine which
+	// variants of the closing transaction we sign. If neither output is
+	// dust, we'll also sign the variant without the remote party's
+	// output, in case they consider their output to be dust.
+	chanState := c.cfg.Channel.State()
+	var variants []lnwallet.SimpleCloseOutputs
+	switch {
+	case ourBalance < chanState.LocalChanCfg.DustLimit:
+		variants = []lnwallet.SimpleCloseOutputs{
+			lnwallet.NoCloserOutput,
+		}
+
+	case theirBalance < chanState.RemoteChanCfg.DustLimit:
+		variants = []lnwallet.SimpleCloseOutputs{
+			lnwallet.CloserNoCloseeOutput,
+		}
+
+	default:
+		variants = []lnwallet.SimpleCloseOutputs{
+			lnwallet.CloserAndCloseeOutputs,
+			lnwallet.CloserNoCloseeOutput,
+		}
+	}
+
+	closingComplete := &lnwire.ClosingComplete{
+		ChannelID:   c.cid,
+		FeeSatoshis: fee,
+		LockTime:    c.negotiationHeight,
+	}
+	for _, outputs := range variants {
+		params := c.simpleCloseTx(
+			true, fee, c.negotiationHeight, outputs,
+		)
+		rawSig, _, err := c.cfg.Channel.CreateSimpleCloseProposal(
+			params,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sig, err := lnwire.NewSigFromSignature(rawSig)
+		if err != nil {
+			return nil, err
+		}
+
+		switch outputs {
+		case lnwallet.CloserAndCloseeOutputs:
+			closingComplete.CloserAndClosee = &sig
+		case lnwallet.CloserNoCloseeOutput:
+			closingComplete.CloserNoClosee = &sig
+		case lnwallet.NoCloserOutput:
+			closingComplete.NoCloser = &sig
+		}
+	}
+
+	chancloserLog.Infof("ChannelPoint(%v): proposing closing tx with fee "+
+		"of %v sat", c.chanPoint, int64(fee))
+
+	c.lastClosingComplete = closingComplete
+
+	return closingComplete, nil
+}
+
+// handleClosingComplete signs the closing transaction proposed by the remote
+// party, which pays the fee for it, and broadcasts it. The ClosingSig message
+// for the remote party is returned.
+func (c *ChanCloser) handleClosingComplete(
+	msg *lnwire.ClosingComplete) (*lnwire.ClosingSig, error) {
+
+	ourBalance, _, err := c.cfg.Channel.SimpleCloseBalances(
+		false, msg.FeeSatoshis,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll sign the variant of the transaction that includes our output,
+	// unless our output is dust.
+	var (
+		outputs   lnwallet.SimpleCloseOutputs
+		remoteSig *lnwire.Sig
+	)
+	ourDust := c.cfg.Channel.State().LocalChanCfg.DustLimit
+	switch {
+	case ourBalance < ourDust && msg.CloserNoClosee != nil:
+		outputs = lnwallet.CloserNoCloseeOutput
+		remoteSig = msg.CloserNoClosee
+
+	case ourBalance < ourDust:
+		return nil, fmt.Errorf("closing_complete is missing " +
+			"signature for closing tx without our dust output")
+
+	case msg.CloserAndClosee != nil:
+		outputs = lnwallet.CloserAndCloseeOutputs
+		remoteSig = msg.CloserAndClosee
+
+	case msg.NoCloser != nil:
+		outputs = lnwallet.NoCloserOutput
+		remoteSig = msg.NoCloser
+
+	default:
+		return nil, fmt.Errorf("closing_complete is missing " +
+			"signature for closing tx with our output")
+	}
+
+	chancloserLog.Infof("ChannelPoint(%v): signing closing tx with fee of "+
+		"%v sat paid by remote party, outputs=%v", c.chanPoint,
+		int64(msg.FeeSatoshis), outputs)
+
+	params := c.simpleCloseTx(false, msg.FeeSatoshis, msg.LockTime, outputs)
+	localSig, _, err := c.cfg.Channel.CreateSimpleCloseProposal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	closeTx, err := c.completeSimpleClose(params, localSig, remoteSig)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := lnwire.NewSigFromSignature(localSig)
+	if err != nil {
+		return nil, err
+	}
+
+	closingSig := &lnwire.ClosingSig{
+		ChannelID:   c.cid,
+		FeeSatoshis: msg.FeeSatoshis,
+		LockTime:    msg.LockTime,
+	}
+	switch outputs {
+	case lnwallet.CloserAndCloseeOutputs:
+		closingSig.CloserAndClosee = &sig
+	case lnwallet.CloserNoCloseeOutput:
+		closingSig.CloserNoClosee = &sig
+	case lnwallet.NoCloserOutput:
+		closingSig.NoCloser = &sig
+	}
+
+	// The remote party is able to broadcast the transaction as soon as it
+	// receives our signature, so we'll only send it once the transaction
+	// was persisted and broadcast by us.
+	if err := c.publishClosingTx(closeTx); err != nil {
+		return nil, err
+	}
+
+	return closingSig, nil
+}
+
+// handleClosingSig completes the closing transaction that we proposed last
+// with the signature of the remote party and broadcasts it. If the signature
+// is for an earlier closing transaction that was replaced already, it is
+// ignored and false is returned.
+func (c *ChanCloser) handleClosingSig(msg *lnwire.ClosingSig) (bool, error) {
+	proposal := c.lastClosingComplete
+	if proposal == nil {
+		return false, fmt.Errorf("received closing_sig without " +
+			"proposing a closing tx")
+	}
+
+	if msg.FeeSatoshis != proposal.FeeSatoshis ||
+		msg.LockTime != proposal.LockTime {
+
+		chancloserLog.Infof("ChannelPoint(%v): ignoring closing_sig "+
+			"for replaced closing tx with fee of %v sat",
+			c.chanPoint, int64(msg.FeeSatoshis))
+
+		return false, nil
+	}
+
+	// The remote party must sign exactly one of the variants that we
+	// signed.
+	var (
+		outputs   lnwallet.SimpleCloseOutputs
+		remoteSig *lnwire.Sig
+		numSigs   int
+	)
+	if msg.CloserAndClosee != nil && proposal.CloserAndClosee != nil {
+		outputs = lnwallet.CloserAndCloseeOutputs
+		remoteSig = msg.CloserAndClosee
+		numSigs++
+	}
+	if msg.CloserNoClosee != nil && proposal.CloserNoClosee != nil {
+		outputs = lnwallet.CloserNoCloseeOutput
+		remoteSig = msg.CloserNoClosee
+		numSigs++
+	}
+	if msg.NoCloser != nil && proposal.NoCloser != nil {
+		outputs = lnwallet.NoCloserOutput
+		remoteSig = msg.NoCloser
+		numSigs++
+	}
+	if numSigs != 1 {
+		return false, fmt.Errorf("closing_sig must contain exactly " +
+			"one signature for a proposed closing tx")
+	}
+
+	params := c.simpleCloseTx(
+		true, proposal.FeeSatoshis, proposal.LockTime, outputs,
+	)
+	localSig, _, err := c.cfg.Channel.CreateSimpleCloseProposal(params)
+	if err != nil {
+		return false, err
+	}
+
+	closeTx, err := c.completeSimpleClose(params, localSig, remoteSig)
+	if err != nil {
+		return false, err
+	}
+
+	chancloserLog.Infof("ChannelPoint(%v): closing tx with fee of %v sat "+
+		"signed by remote party", c.chanPoint,
+		int64(proposal.FeeSatoshis))
+
+	if err := c.publishClosingTx(closeTx); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// completeSimpleClose completes the described closing transaction with the
+// signature of the remote party, and records it as the last closing
+// transaction.
+func (c *ChanCloser) completeSimpleClose(params *lnwallet.SimpleCloseTx,
+	localSig input.Signature, remoteSig *lnwire.Sig) (*wire.MsgTx, error) {
+
+	sig, err := remoteSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	closeTx, err := c.cfg.Channel.CompleteSimpleCooperativeClose(
+		params, localSig, sig,
+	)
+	if err != nil {
+		return nil, err
+	}
+	c.closingTx = closeTx
+
+	return closeTx, nil
+}
@@ -0,0 +1,174 @@
+package chancloser
+
+import (
+	"testing"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/htlcswitch"
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/lnwallet/chainfee"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// newSimpleClosers creates a closing state machine for both parties of a test
+// channel that negotiate the simple close protocol. Bob, who didn't open the
+// channel, initiates the close. The transactions broadcast by both parties
+// are sent on the returned channel.
+func newSimpleClosers(t *testing.T, feeRate chainfee.SatPerKWeight) (
+	*ChanCloser, *ChanCloser, chan *wire.MsgTx) {
+
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels(
+		channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	broadcasts := make(chan *wire.MsgTx, 2)
+	newCfg := func(channel *lnwallet.LightningChannel) ChanCloseCfg {
+		return ChanCloseCfg{
+			Channel: channel,
+			BroadcastTx: func(tx *wire.MsgTx, _ string) error {
+				broadcasts <- tx
+				return nil
+			},
+			DisableChannel: func(wire.OutPoint) error {
+				return nil
+			},
+			Disconnect: func() error {
+				return nil
+			},
+			SimpleClose: true,
+		}
+	}
+
+	alice := NewChanCloser(
+		newCfg(aliceChannel), randDeliveryAddress(t), feeRate, 100,
+		nil, false,
+	)
+	bob := NewChanCloser(
+		newCfg(bobChannel), randDeliveryAddress(t), feeRate, 100,
+		&htlcswitch.ChanClose{}, true,
+	)
+
+	return alice, bob, broadcasts
+}
+
+// deliverMsg delivers the passed message to the given state machine, and
+// returns its response, which is expected to consist of a single message.
+func deliverMsg(t *testing.T, to *ChanCloser, msg lnwire.Message,
+	expectBroadcast bool) lnwire.Message {
+
+	msgs, broadcast, err := to.ProcessCloseMsg(msg)
+	require.NoError(t, err)
+	require.Equal(t, expectBroadcast, broadcast)
+
+	if len(msgs) == 0 {
+		return nil
+	}
+	require.Len(t, msgs, 1)
+
+	return msgs[0]
+}
+
+// TestSimpleCloseFeeBump tests the negotiation of a closing transaction with
+// the simple close protocol, and that the closer is able to replace the
+// closing transaction with one paying a higher fee.
+func TestSimpleCloseFeeBump(t *testing.T) {
+	t.Parallel()
+
+	const feeRate = chainfee.SatPerKWeight(1000)
+	alice, bob, broadcasts := newSimpleClosers(t, feeRate)
+
+	// Bob initiates the shutdown, to which Alice responds with her own
+	// shutdown message. Only then Bob proposes a closing transaction.
+	shutdown, err := bob.ShutdownChan()
+	require.NoError(t, err)
+
+	aliceShutdown := deliverMsg(t, alice, shutdown, false)
+	require.IsType(t, &lnwire.Shutdown{}, aliceShutdown)
+
+	// Bumping the fee isn't possible before the shutdown completed.
+	_, err = bob.BumpFee(&htlcswitch.ChanClose{
+		TargetFeePerKw: feeRate * 2,
+	})
+	require.ErrorIs(t, err, ErrInvalidState)
+
+	closingComplete := deliverMsg(t, bob, aliceShutdown, false)
+	require.IsType(t, &lnwire.ClosingComplete{}, closingComplete)
+
+	// assertClosingTx exchanges the passed closing_complete message, and
+	// asserts that both parties broadcast the same transaction, which
+	// pays the expected fee.
+	assertClosingTx := func(msg lnwire.Message) *wire.MsgTx {
+		closingComplete := msg.(*lnwire.ClosingComplete)
+
+		closingSig := deliverMsg(t, alice, closingComplete, true)
+		require.IsType(t, &lnwire.ClosingSig{}, closingSig)
+		require.NotNil(
+			t, closingSig.(*lnwire.ClosingSig).CloserAndClosee,
+		)
+
+		require.Nil(t, deliverMsg(t, bob, closingSig, true))
+
+		aliceTx := <-broadcasts
+		bobTx := <-broadcasts
+		require.Equal(t, aliceTx.TxHash(), bobTx.TxHash())
+
+		bobClosingTx, err := bob.ClosingTx()
+		require.NoError(t, err)
+		require.Equal(t, bobTx.TxHash(), bobClosingTx.TxHash())
+
+		var outputTotal int64
+		for _, txOut := range bobTx.TxOut {
+			outputTotal += txOut.Value
+		}
+		capacity := bob.Channel().Capacity
+		require.EqualValues(
+			t, closingComplete.FeeSatoshis,
+			int64(capacity)-outputTotal,
+		)
+
+		return bobTx
+	}
+	firstTx := assertClosingTx(closingComplete)
+
+	// A fee bump that doesn't increase the fee is rejected.
+	_, err = bob.BumpFee(&htlcswitch.ChanClose{TargetFeePerKw: feeRate})
+	require.ErrorIs(t, err, ErrCloseFeeTooLow)
+
+	// Bob now bumps the fee of the closing transaction, which results in
+	// a transaction that spends the same funding output.
+	bumpReq := &htlcswitch.ChanClose{TargetFeePerKw: feeRate * 2}
+	bumpMsg, err := bob.BumpFee(bumpReq)
+	require.NoError(t, err)
+	require.Equal(t, bumpReq, bob.CloseRequest())
+	require.Greater(
+		t, bumpMsg.FeeSatoshis,
+		closingComplete.(*lnwire.ClosingComplete).FeeSatoshis,
+	)
+
+	secondTx := assertClosingTx(bumpMsg)
+	require.NotEqual(t, firstTx.TxHash(), secondTx.TxHash())
+	require.Equal(
+		t, firstTx.TxIn[0].PreviousOutPoint,
+		secondTx.TxIn[0].PreviousOutPoint,
+	)
+	require.Equal(
+		t, uint32(lnwallet.SimpleCloseSequence),
+		secondTx.TxIn[0].Sequence,
+	)
+}
+
+// TestBumpFeeUnsupported tests that the fee of a closing transaction can't be
+// bumped without the simple close protocol.
+func TestBumpFeeUnsupported(t *testing.T) {
+	t.Parallel()
+
+	alice, _, _ := newSimpleClosers(t, 1000)
+	alice.cfg.SimpleClose = false
+
+	_, err := alice.BumpFee(&htlcswitch.ChanClose{TargetFeePerKw: 2000})
+	require.ErrorIs(t, err, ErrFeeBumpUnsupported)
+}
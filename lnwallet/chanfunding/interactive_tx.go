@@ -0,0 +1,788 @@
+package chanfunding
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ltcsuite/lnd/input"
+	"github.com/ltcsuite/lnd/lnwallet/chainfee"
+	"github.com/ltcsuite/ltcd/blockchain"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/txscript"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+const (
+	// MaxInteractiveTxInputs is the maximum number of inputs that an
+	// interactively constructed transaction may have.
+	MaxInteractiveTxInputs = 252
+
+	// MaxInteractiveTxOutputs is the maximum number of outputs that an
+	// interactively constructed transaction may have.
+	MaxInteractiveTxOutputs = 252
+
+	// maxInteractiveTxAdditions is the maximum number of inputs and
+	// outputs that the remote party may add during a single negotiation,
+	// including the ones it removed again.
+	maxInteractiveTxAdditions = 4096
+)
+
+var (
+	// ErrInvalidSerialID is returned when the remote party uses a serial
+	// ID with the parity of the local party.
+	ErrInvalidSerialID = errors.New("serial id has wrong parity")
+
+	// ErrDuplicateSerialID is returned when a serial ID is used for more
+	// than one input or output.
+	ErrDuplicateSerialID = errors.New("duplicate serial id")
+
+	// ErrUnknownSerialID is returned when the remote party removes an
+	// input or output that it didn't add before.
+	ErrUnknownSerialID = errors.New("unknown serial id")
+
+	// ErrDuplicateInput is returned when an outpoint is spent more than
+	// once by the transaction.
+	ErrDuplicateInput = errors.New("duplicate input")
+
+	// ErrNonWitnessInput is returned when an input doesn't spend a witness
+	// output, which would make the transaction malleable.
+	ErrNonWitnessInput = errors.New("input does not spend a witness " +
+		"output")
+
+	// ErrTooManyAdditions is returned when the remote party adds more
+	// inputs and outputs than we allow during a single negotiation.
+	ErrTooManyAdditions = errors.New("too many inputs and outputs added")
+
+	// ErrNegotiationComplete is returned when the transaction is modified
+	// after the negotiation was completed.
+	ErrNegotiationComplete = errors.New("interactive tx negotiation " +
+		"already complete")
+
+	// ErrNegotiationIncomplete is returned when the transaction is
+	// requested before the negotiation was completed.
+	ErrNegotiationIncomplete = errors.New("interactive tx negotiation " +
+		"not complete")
+
+	// ErrMissingFundingOutput is returned when the negotiated transaction
+	// doesn't contain the funding output exactly once.
+	ErrMissingFundingOutput = errors.New("funding output missing")
+
+	// ErrInsufficientContribution is returned when the remote party
+	// doesn't contribute enough funds to pay for its share of the funding
+	// output and the fees of its inputs and outputs.
+	ErrInsufficientContribution = errors.New("insufficient contribution " +
+		"by remote party")
+
+	// ErrInvalidSharedInput is returned when the shared input of a splice
+	// is added by the wrong party, doesn't match the funding output of
+	// the channel, or is missing from the negotiated transaction.
+	ErrInvalidSharedInput = errors.New("invalid shared input")
+)
+
+// SharedInput is the current funding output of a channel, which is spent by
+// the transaction that splices the channel.
+type SharedInput struct {
+	// OutPoint is the funding outpoint of the channel.
+	OutPoint wire.OutPoint
+
+	// TxOut is the funding output of the channel.
+	TxOut *wire.TxOut
+}
+
+// InteractiveInput is an input of an interactively constructed transaction.
+type InteractiveInput struct {
+	// SerialID is the serial ID of the input, which determines its
+	// position within the transaction.
+	SerialID uint64
+
+	// PrevTx is the transaction that created the spent output.
+	PrevTx *wire.MsgTx
+
+	// PrevTxOut is the index of the spent output within PrevTx.
+	PrevTxOut uint32
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// Local indicates whether the input was added by us.
+	Local bool
+
+	// Shared is set instead of PrevTx if the input spends the current
+	// funding output of a channel that is being spliced.
+	Shared *SharedInput
+}
+
+// OutPoint returns the outpoint that is spent by the input.
+func (i *InteractiveInput) OutPoint() wire.OutPoint {
+	if i.Shared != nil {
+		return i.Shared.OutPoint
+	}
+
+	return wire.OutPoint{
+		Hash:  i.PrevTx.TxHash(),
+		Index: i.PrevTxOut,
+	}
+}
+
+// PrevOutput returns the output that is spent by the input.
+func (i *InteractiveInput) PrevOutput() *wire.TxOut {
+	if i.Shared != nil {
+		return i.Shared.TxOut
+	}
+
+	return i.PrevTx.TxOut[i.PrevTxOut]
+}
+
+// InteractiveOutput is an output of an interactively constructed
+// transaction.
+type InteractiveOutput struct {
+	// SerialID is the serial ID of the output, which determines its
+	// position within the transaction.
+	SerialID uint64
+
+	// TxOut is the output itself.
+	TxOut *wire.TxOut
+
+	// Local indicates whether the output was added by us.
+	Local bool
+}
+
+// InteractiveTxConfig holds the parameters that both parties agreed upon
+// before they start to construct a transaction interactively.
+type InteractiveTxConfig struct {
+	// Initiator indicates whether we are the initiator of the
+	// negotiation. The initiator uses even serial IDs, adds the funding
+	// output and pays for the common fields of the transaction.
+	Initiator bool
+
+	// FundingOutput is the shared output of the transaction. It must be
+	// added by the initiator, and is paid for by both parties according
+	// to their funding amounts.
+	FundingOutput *wire.TxOut
+
+	// SharedInput is the current funding output of the channel if the
+	// transaction splices an existing channel. It must be added by the
+	// initiator, who also pays for its weight. Its value is already part
+	// of the funding output, so it doesn't count towards the
+	// contribution of either party.
+	SharedInput *SharedInput
+
+	// LocalFundingAmt is the amount that we contribute to the funding
+	// output. For a splice, this may be negative if we remove funds from
+	// the channel.
+	LocalFundingAmt ltcutil.Amount
+
+	// RemoteFundingAmt is the amount that the remote party contributes to
+	// the funding output. For a splice, this may be negative if the remote
+	// party removes funds from the channel.
+	RemoteFundingAmt ltcutil.Amount
+
+	// FeeRate is the fee rate of the transaction that both parties agreed
+	// upon.
+	FeeRate chainfee.SatPerKWeight
+
+	// LockTime is the lock time of the transaction.
+	LockTime uint32
+
+	// DustLimit is the minimum value of any output that is added to the
+	// transaction.
+	DustLimit ltcutil.Amount
+}
+
+// InteractiveTx is the state machine of the interactive transaction
+// construction protocol. Both parties take turns to add or remove inputs and
+// outputs, until both signal that they have nothing left to add by sending
+// tx_complete in succession. Every input and output is identified by a
+// serial ID, which also determines its position in the final transaction.
+//
+// NOTE: This struct is not thread safe, the caller is expected to serialize
+// all calls.
+type InteractiveTx struct {
+	cfg InteractiveTxConfig
+
+	inputs  map[uint64]*InteractiveInput
+	outputs map[uint64]*InteractiveOutput
+
+	nextSerialID uint64
+
+	numRemoteAdditions int
+
+	sentComplete     bool
+	receivedComplete bool
+}
+
+// NewInteractiveTx creates a new state machine for the interactive
+// construction of a transaction.
+func NewInteractiveTx(cfg InteractiveTxConfig) *InteractiveTx {
+	// The initiator uses even serial IDs, the responder odd ones.
+	var nextSerialID uint64
+	if !cfg.Initiator {
+		nextSerialID = 1
+	}
+
+	return &InteractiveTx{
+		cfg:          cfg,
+		inputs:       make(map[uint64]*InteractiveInput),
+		outputs:      make(map[uint64]*InteractiveOutput),
+		nextSerialID: nextSerialID,
+	}
+}
+
+// AddLocalInput adds one of our inputs to the transaction and returns the
+// serial ID that was assigned to it.
+func (t *InteractiveTx) AddLocalInput(prevTx *wire.MsgTx, prevTxOut,
+	sequence uint32) (uint64, error) {
+
+	input := &InteractiveInput{
+		SerialID:  t.nextSerialID,
+		PrevTx:    prevTx,
+		PrevTxOut: prevTxOut,
+		Sequence:  sequence,
+		Local:     true,
+	}
+	if err := t.addInput(input); err != nil {
+		return 0, err
+	}
+
+	t.nextSerialID += 2
+	t.receivedComplete = false
+
+	return input.SerialID, nil
+}
+
+// AddSharedInput adds the shared input of a splice to the transaction and
+// returns the serial ID that was assigned to it. Only the initiator may add
+// the shared input.
+func (t *InteractiveTx) AddSharedInput(sequence uint32) (uint64, error) {
+	if t.cfg.SharedInput == nil || !t.cfg.Initiator {
+		return 0, ErrInvalidSharedInput
+	}
+
+	input := &InteractiveInput{
+		SerialID: t.nextSerialID,
+		Sequence: sequence,
+		Local:    true,
+		Shared:   t.cfg.SharedInput,
+	}
+	if err := t.addInput(input); err != nil {
+		return 0, err
+	}
+
+	t.nextSerialID += 2
+	t.receivedComplete = false
+
+	return input.SerialID, nil
+}
+
+// AddLocalOutput adds one of our outputs to the transaction and returns the
+// serial ID that was assigned to it.
+func (t *InteractiveTx) AddLocalOutput(txOut *wire.TxOut) (uint64, error) {
+	output := &InteractiveOutput{
+		SerialID: t.nextSerialID,
+		TxOut:    txOut,
+		Local:    true,
+	}
+	if err := t.addOutput(output); err != nil {
+		return 0, err
+	}
+
+	t.nextSerialID += 2
+	t.receivedComplete = false
+
+	return output.SerialID, nil
+}
+
+// ReceiveAddInput processes an input that was added by the remote party.
+func (t *InteractiveTx) ReceiveAddInput(serialID uint64, prevTx []byte,
+	prevTxOut, sequence uint32) error {
+
+	if err := t.checkRemoteSerialID(serialID); err != nil {
+		return err
+	}
+
+	var tx wire.MsgTx
+	if err := tx.Deserialize(bytes.NewReader(prevTx)); err != nil {
+		return fmt.Errorf("invalid prev tx: %w", err)
+	}
+
+	err := t.addInput(&InteractiveInput{
+		SerialID:  serialID,
+		PrevTx:    &tx,
+		PrevTxOut: prevTxOut,
+		Sequence:  sequence,
+	})
+	if err != nil {
+		return err
+	}
+
+	t.receivedRemoteUpdate()
+
+	return nil
+}
+
+// ReceiveAddSharedInput processes the shared input of a splice, which was
+// added by the remote party. The input is identified by the txid of the
+// channel's current funding transaction instead of the full transaction.
+func (t *InteractiveTx) ReceiveAddSharedInput(serialID uint64,
+	prevTxid chainhash.Hash, prevTxOut, sequence uint32) error {
+
+	if err := t.checkRemoteSerialID(serialID); err != nil {
+		return err
+	}
+
+	// Only the initiator may add the shared input, and it must spend the
+	// current funding output of the channel.
+	shared := t.cfg.SharedInput
+	outPoint := wire.OutPoint{Hash: prevTxid, Index: prevTxOut}
+	if shared == nil || t.cfg.Initiator || shared.OutPoint != outPoint {
+		return fmt.Errorf("%w: %v", ErrInvalidSharedInput, outPoint)
+	}
+
+	err := t.addInput(&InteractiveInput{
+		SerialID: serialID,
+		Sequence: sequence,
+		Shared:   shared,
+	})
+	if err != nil {
+		return err
+	}
+
+	t.receivedRemoteUpdate()
+
+	return nil
+}
+
+// ReceiveAddOutput processes an output that was added by the remote party.
+func (t *InteractiveTx) ReceiveAddOutput(serialID uint64,
+	amt ltcutil.Amount, pkScript []byte) error {
+
+	if err := t.checkRemoteSerialID(serialID); err != nil {
+		return err
+	}
+
+	err := t.addOutput(&InteractiveOutput{
+		SerialID: serialID,
+		TxOut:    wire.NewTxOut(int64(amt), pkScript),
+	})
+	if err != nil {
+		return err
+	}
+
+	t.receivedRemoteUpdate()
+
+	return nil
+}
+
+// ReceiveRemoveInput processes the removal of an input by the remote party.
+func (t *InteractiveTx) ReceiveRemoveInput(serialID uint64) error {
+	if t.IsComplete() {
+		return ErrNegotiationComplete
+	}
+
+	input, ok := t.inputs[serialID]
+	if !ok || input.Local {
+		return fmt.Errorf("%w: input %d", ErrUnknownSerialID, serialID)
+	}
+
+	delete(t.inputs, serialID)
+	t.receivedRemoteUpdate()
+
+	return nil
+}
+
+// ReceiveRemoveOutput processes the removal of an output by the remote
+// party.
+func (t *InteractiveTx) ReceiveRemoveOutput(serialID uint64) error {
+	if t.IsComplete() {
+		return ErrNegotiationComplete
+	}
+
+	output, ok := t.outputs[serialID]
+	if !ok || output.Local {
+		return fmt.Errorf("%w: output %d", ErrUnknownSerialID, serialID)
+	}
+
+	delete(t.outputs, serialID)
+	t.receivedRemoteUpdate()
+
+	return nil
+}
+
+// SendComplete records that we signaled that we have nothing left to add to
+// the transaction. If the remote party did the same right before, the
+// transaction is validated and the negotiation is complete.
+func (t *InteractiveTx) SendComplete() error {
+	if t.IsComplete() {
+		return ErrNegotiationComplete
+	}
+
+	t.sentComplete = true
+	if t.receivedComplete {
+		return t.validate()
+	}
+
+	return nil
+}
+
+// ReceiveComplete records that the remote party signaled that it has nothing
+// left to add to the transaction. If we did the same right before, the
+// transaction is validated and the negotiation is complete.
+func (t *InteractiveTx) ReceiveComplete() error {
+	if t.IsComplete() {
+		return ErrNegotiationComplete
+	}
+
+	t.receivedComplete = true
+	if t.sentComplete {
+		return t.validate()
+	}
+
+	return nil
+}
+
+// IsComplete returns true if both parties signaled in succession that they
+// have nothing left to add to the transaction.
+func (t *InteractiveTx) IsComplete() bool {
+	return t.sentComplete && t.receivedComplete
+}
+
+// Inputs returns the inputs of the transaction ordered by their serial ID.
+func (t *InteractiveTx) Inputs() []*InteractiveInput {
+	inputs := make([]*InteractiveInput, 0, len(t.inputs))
+	for _, input := range t.inputs {
+		inputs = append(inputs, input)
+	}
+
+	sort.Slice(inputs, func(i, j int) bool {
+		return inputs[i].SerialID < inputs[j].SerialID
+	})
+
+	return inputs
+}
+
+// Outputs returns the outputs of the transaction ordered by their serial ID.
+func (t *InteractiveTx) Outputs() []*InteractiveOutput {
+	outputs := make([]*InteractiveOutput, 0, len(t.outputs))
+	for _, output := range t.outputs {
+		outputs = append(outputs, output)
+	}
+
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].SerialID < outputs[j].SerialID
+	})
+
+	return outputs
+}
+
+// Tx returns the unsigned transaction that was negotiated.
+func (t *InteractiveTx) Tx() (*wire.MsgTx, error) {
+	if !t.IsComplete() {
+		return nil, ErrNegotiationIncomplete
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.LockTime = t.cfg.LockTime
+	for _, input := range t.Inputs() {
+		txIn := wire.NewTxIn(&wire.OutPoint{}, nil, nil)
+		txIn.PreviousOutPoint = input.OutPoint()
+		txIn.Sequence = input.Sequence
+		tx.AddTxIn(txIn)
+	}
+	for _, output := range t.Outputs() {
+		tx.AddTxOut(output.TxOut)
+	}
+
+	return tx, nil
+}
+
+// SharedInputIndex returns the index of the shared input of a splice within
+// the negotiated transaction.
+func (t *InteractiveTx) SharedInputIndex() (int, error) {
+	for i, input := range t.Inputs() {
+		if input.Shared != nil {
+			return i, nil
+		}
+	}
+
+	return 0, ErrInvalidSharedInput
+}
+
+// SendSignaturesFirst returns true if we must send our tx_signatures before
+// the remote party. The party that contributed the lower amount of inputs
+// sends its signatures first, on ties the initiator does. The shared input of
+// a splice doesn't count towards either party.
+func (t *InteractiveTx) SendSignaturesFirst() bool {
+	var localIn, remoteIn int64
+	for _, input := range t.inputs {
+		switch {
+		case input.Shared != nil:
+			continue
+
+		case input.Local:
+			localIn += input.PrevOutput().Value
+
+		default:
+			remoteIn += input.PrevOutput().Value
+		}
+	}
+
+	if localIn == remoteIn {
+		return t.cfg.Initiator
+	}
+
+	return localIn < remoteIn
+}
+
+// AddRemoteWitnesses adds the witnesses that the remote party sent for its
+// inputs to the passed transaction, and verifies them. The witnesses must be
+// ordered by the serial ID of the inputs. The shared input of a splice is
+// signed by both parties, so its witness is never part of the passed ones.
+func (t *InteractiveTx) AddRemoteWitnesses(tx *wire.MsgTx,
+	witnesses []wire.TxWitness) error {
+
+	inputs := t.Inputs()
+	if len(tx.TxIn) != len(inputs) {
+		return fmt.Errorf("transaction has %d inputs, expected %d",
+			len(tx.TxIn), len(inputs))
+	}
+
+	var remoteIdx []int
+	for i, input := range inputs {
+		if !input.Local && input.Shared == nil {
+			remoteIdx = append(remoteIdx, i)
+		}
+	}
+	if len(witnesses) != len(remoteIdx) {
+		return fmt.Errorf("received %d witnesses for %d inputs",
+			len(witnesses), len(remoteIdx))
+	}
+
+	for i, idx := range remoteIdx {
+		tx.TxIn[idx].Witness = witnesses[i]
+	}
+
+	// Now that all witnesses are attached, we can verify the ones of the
+	// remote party.
+	hashCache := txscript.NewTxSigHashes(tx)
+	for _, idx := range remoteIdx {
+		prevOut := inputs[idx].PrevOutput()
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, tx, idx, txscript.StandardVerifyFlags,
+			nil, hashCache, prevOut.Value,
+		)
+		if err != nil {
+			return fmt.Errorf("cannot create script engine: %w",
+				err)
+		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("invalid witness for input %v: %w",
+				tx.TxIn[idx].PreviousOutPoint, err)
+		}
+	}
+
+	return nil
+}
+
+// checkRemoteSerialID checks that the passed serial ID may be used by the
+// remote party for a new input or output.
+func (t *InteractiveTx) checkRemoteSerialID(serialID uint64) error {
+	if t.IsComplete() {
+		return ErrNegotiationComplete
+	}
+
+	// The remote party must use the parity that we don't use.
+	if serialID%2 == t.nextSerialID%2 {
+		return fmt.Errorf("%w: %d", ErrInvalidSerialID, serialID)
+	}
+
+	t.numRemoteAdditions++
+	if t.numRemoteAdditions > maxInteractiveTxAdditions {
+		return ErrTooManyAdditions
+	}
+
+	return nil
+}
+
+// receivedRemoteUpdate resets the completion state of the negotiation after
+// the remote party modified the transaction, which requires both parties to
+// signal completion again.
+func (t *InteractiveTx) receivedRemoteUpdate() {
+	t.sentComplete = false
+	t.receivedComplete = false
+}
+
+// addInput adds the passed input to the transaction after checking that it
+// is valid.
+func (t *InteractiveTx) addInput(input *InteractiveInput) error {
+	if t.IsComplete() {
+		return ErrNegotiationComplete
+	}
+
+	if _, ok := t.inputs[input.SerialID]; ok {
+		return fmt.Errorf("%w: input %d", ErrDuplicateSerialID,
+			input.SerialID)
+	}
+
+	if input.Shared == nil &&
+		int(input.PrevTxOut) >= len(input.PrevTx.TxOut) {
+
+		return fmt.Errorf("prev tx %v has no output %d",
+			input.PrevTx.TxHash(), input.PrevTxOut)
+	}
+
+	// Only inputs that spend witness outputs are allowed, as the txid of
+	// the transaction must not change once it was negotiated.
+	if !txscript.IsWitnessProgram(input.PrevOutput().PkScript) {
+		return fmt.Errorf("%w: %v", ErrNonWitnessInput,
+			input.OutPoint())
+	}
+
+	outPoint := input.OutPoint()
+	for _, other := range t.inputs {
+		if other.OutPoint() == outPoint {
+			return fmt.Errorf("%w: %v", ErrDuplicateInput, outPoint)
+		}
+	}
+
+	t.inputs[input.SerialID] = input
+
+	return nil
+}
+
+// addOutput adds the passed output to the transaction after checking that it
+// is valid.
+func (t *InteractiveTx) addOutput(output *InteractiveOutput) error {
+	if t.IsComplete() {
+		return ErrNegotiationComplete
+	}
+
+	if _, ok := t.outputs[output.SerialID]; ok {
+		return fmt.Errorf("%w: output %d", ErrDuplicateSerialID,
+			output.SerialID)
+	}
+
+	if ltcutil.Amount(output.TxOut.Value) < t.cfg.DustLimit {
+		return fmt.Errorf("output value %v is below dust limit %v",
+			ltcutil.Amount(output.TxOut.Value), t.cfg.DustLimit)
+	}
+
+	t.outputs[output.SerialID] = output
+
+	return nil
+}
+
+// ContributionWeight estimates the weight of an interactively constructed
+// transaction that a party pays for, given the number of inputs and the
+// outputs it adds. The initiator also pays for the common fields of the
+// transaction, the funding output and, for a splice, the shared input. As the
+// exact witnesses of the inputs aren't known during the negotiation, they are
+// assumed to be the cheapest common input type, which is a key spend of a
+// p2wkh output.
+func ContributionWeight(initiator, splice bool, numInputs int,
+	outputs []*wire.TxOut, fundingOutput *wire.TxOut) int64 {
+
+	var weight int64
+	if initiator {
+		weight += (input.BaseTxSize+1+1)*blockchain.WitnessScaleFactor +
+			input.WitnessHeaderSize
+		weight += int64(fundingOutput.SerializeSize()) *
+			blockchain.WitnessScaleFactor
+
+		if splice {
+			weight += input.InputSize*
+				blockchain.WitnessScaleFactor +
+				input.MultiSigWitnessSize
+		}
+	}
+
+	weight += int64(numInputs) * (input.InputSize*
+		blockchain.WitnessScaleFactor + input.P2WKHWitnessSize)
+
+	for _, output := range outputs {
+		weight += int64(output.SerializeSize()) *
+			blockchain.WitnessScaleFactor
+	}
+
+	return weight
+}
+
+// validate checks the final transaction once both parties completed the
+// negotiation. Apart from the limits of the transaction, it ensures that the
+// funding output and the shared input of a splice are present and that the
+// remote party pays for its share of the funding output, as well as for the
+// fees of its inputs and outputs.
+func (t *InteractiveTx) validate() error {
+	if len(t.inputs) > MaxInteractiveTxInputs {
+		return fmt.Errorf("transaction has %d inputs, at most %d are "+
+			"allowed", len(t.inputs), MaxInteractiveTxInputs)
+	}
+	if len(t.outputs) > MaxInteractiveTxOutputs {
+		return fmt.Errorf("transaction has %d outputs, at most %d are "+
+			"allowed", len(t.outputs), MaxInteractiveTxOutputs)
+	}
+
+	var (
+		remoteIn       int64
+		remoteOut      int64
+		numRemoteIns   int
+		remoteOutputs  []*wire.TxOut
+		fundingOutputs int
+		sharedInputs   int
+	)
+	for _, txIn := range t.inputs {
+		switch {
+		case txIn.Shared != nil:
+			sharedInputs++
+
+		case !txIn.Local:
+			remoteIn += txIn.PrevOutput().Value
+			numRemoteIns++
+		}
+	}
+
+	// The funding output is paid for by both parties using their funding
+	// amounts, while the initiator pays for its weight.
+	fundingOutput := t.cfg.FundingOutput
+	for _, output := range t.outputs {
+		isFunding := output.TxOut.Value == fundingOutput.Value &&
+			bytes.Equal(output.TxOut.PkScript, fundingOutput.PkScript)
+
+		switch {
+		case isFunding:
+			fundingOutputs++
+
+		case !output.Local:
+			remoteOut += output.TxOut.Value
+			remoteOutputs = append(remoteOutputs, output.TxOut)
+		}
+	}
+
+	if fundingOutputs != 1 {
+		return ErrMissingFundingOutput
+	}
+
+	splice := t.cfg.SharedInput != nil
+	if (splice && sharedInputs != 1) || (!splice && sharedInputs != 0) {
+		return ErrInvalidSharedInput
+	}
+
+	// The remote party pays for the weight of its own inputs and outputs.
+	// If it is the initiator, it also pays for the common fields of the
+	// transaction, the funding output and the shared input.
+	remoteWeight := ContributionWeight(
+		!t.cfg.Initiator, splice, numRemoteIns, remoteOutputs,
+		fundingOutput,
+	)
+	fee := t.cfg.FeeRate.FeeForWeight(remoteWeight)
+	required := int64(t.cfg.RemoteFundingAmt) + remoteOut + int64(fee)
+	if remoteIn < required {
+		return fmt.Errorf("%w: inputs of %v, require %v",
+			ErrInsufficientContribution, ltcutil.Amount(remoteIn),
+			ltcutil.Amount(required))
+	}
+
+	return nil
+}
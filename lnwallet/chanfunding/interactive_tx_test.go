@@ -0,0 +1,392 @@
+package chanfunding
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ltcsuite/lnd/lnwallet/chainfee"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/txscript"
+	"github.com/ltcsuite/ltcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testFundingOutput = wire.NewTxOut(
+		1_000_000, append([]byte{0x00, 0x20}, make([]byte, 32)...),
+	)
+)
+
+// newPrevTx returns a transaction with a single output of the given value
+// and script that can be spent by an interactive tx.
+func newPrevTx(value int64, pkScript []byte) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: uint32(value)}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(value, pkScript))
+
+	return tx
+}
+
+// serializeTx is a helper that returns the serialization of the passed
+// transaction.
+func serializeTx(t *testing.T, tx *wire.MsgTx) []byte {
+	t.Helper()
+
+	var b bytes.Buffer
+	require.NoError(t, tx.Serialize(&b))
+
+	return b.Bytes()
+}
+
+// newTestInteractiveTxs returns the state machines of the initiator and the
+// responder of a negotiation, with each party contributing half of the
+// funding output.
+func newTestInteractiveTxs() (*InteractiveTx, *InteractiveTx) {
+	cfg := InteractiveTxConfig{
+		FundingOutput:    testFundingOutput,
+		LocalFundingAmt:  500_000,
+		RemoteFundingAmt: 500_000,
+		FeeRate:          chainfee.SatPerKWeight(1000),
+		LockTime:         100,
+		DustLimit:        546,
+	}
+
+	initiatorCfg := cfg
+	initiatorCfg.Initiator = true
+
+	return NewInteractiveTx(initiatorCfg), NewInteractiveTx(cfg)
+}
+
+// TestInteractiveTxNegotiation tests that both parties of a negotiation end
+// up with the same transaction, ordered by the serial IDs of its inputs and
+// outputs.
+func TestInteractiveTxNegotiation(t *testing.T) {
+	t.Parallel()
+
+	initiator, responder := newTestInteractiveTxs()
+
+	// The initiator adds the funding output and an input.
+	serialID, err := initiator.AddLocalOutput(testFundingOutput)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, serialID)
+	require.NoError(t, responder.ReceiveAddOutput(
+		serialID, ltcutil.Amount(testFundingOutput.Value),
+		testFundingOutput.PkScript,
+	))
+
+	initiatorPrevTx := newPrevTx(600_000, p2wkhScript)
+	serialID, err = initiator.AddLocalInput(initiatorPrevTx, 0, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, serialID)
+
+	// The responder adds its own input in between, which is placed between
+	// the funding output and the input of the initiator.
+	responderPrevTx := newPrevTx(650_000, p2wkhScript)
+	responderSerialID, err := responder.AddLocalInput(responderPrevTx, 0, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, responderSerialID)
+	require.NoError(t, initiator.ReceiveAddInput(
+		responderSerialID, serializeTx(t, responderPrevTx), 0, 2,
+	))
+
+	require.NoError(t, responder.ReceiveAddInput(
+		serialID, serializeTx(t, initiatorPrevTx), 0, 1,
+	))
+
+	// A removed input must not be part of the final transaction.
+	extraPrevTx := newPrevTx(700_000, p2wkhScript)
+	require.NoError(t, initiator.ReceiveAddInput(
+		3, serializeTx(t, extraPrevTx), 0, 0,
+	))
+	require.NoError(t, initiator.ReceiveRemoveInput(3))
+
+	// The transaction isn't available until both parties sent tx_complete.
+	require.NoError(t, initiator.SendComplete())
+	require.NoError(t, responder.ReceiveComplete())
+	require.False(t, initiator.IsComplete())
+	_, err = initiator.Tx()
+	require.ErrorIs(t, err, ErrNegotiationIncomplete)
+
+	require.NoError(t, responder.SendComplete())
+	require.NoError(t, initiator.ReceiveComplete())
+	require.True(t, initiator.IsComplete())
+	require.True(t, responder.IsComplete())
+
+	initiatorTx, err := initiator.Tx()
+	require.NoError(t, err)
+	responderTx, err := responder.Tx()
+	require.NoError(t, err)
+	require.Equal(t, initiatorTx.TxHash(), responderTx.TxHash())
+
+	require.EqualValues(t, 100, initiatorTx.LockTime)
+	require.Len(t, initiatorTx.TxIn, 2)
+	require.Equal(
+		t, responderPrevTx.TxHash(),
+		initiatorTx.TxIn[0].PreviousOutPoint.Hash,
+	)
+	require.EqualValues(t, 2, initiatorTx.TxIn[0].Sequence)
+	require.Len(t, initiatorTx.TxOut, 1)
+
+	// The initiator contributed the lower input amount, so it sends its
+	// signatures first.
+	require.True(t, initiator.SendSignaturesFirst())
+	require.False(t, responder.SendSignaturesFirst())
+
+	// Once complete, the transaction can't be modified anymore.
+	_, err = initiator.AddLocalOutput(wire.NewTxOut(1000, p2wkhScript))
+	require.ErrorIs(t, err, ErrNegotiationComplete)
+}
+
+// TestInteractiveTxInvalidUpdates tests that invalid updates of the remote
+// party are rejected.
+func TestInteractiveTxInvalidUpdates(t *testing.T) {
+	t.Parallel()
+
+	initiator, _ := newTestInteractiveTxs()
+
+	prevTx := newPrevTx(600_000, p2wkhScript)
+	rawPrevTx := serializeTx(t, prevTx)
+
+	// The responder must use odd serial IDs.
+	err := initiator.ReceiveAddInput(2, rawPrevTx, 0, 0)
+	require.ErrorIs(t, err, ErrInvalidSerialID)
+
+	// Inputs must spend witness outputs.
+	err = initiator.ReceiveAddInput(
+		1, serializeTx(t, newPrevTx(600_000, p2khScript)), 0, 0,
+	)
+	require.ErrorIs(t, err, ErrNonWitnessInput)
+
+	// Neither serial IDs nor outpoints may be used twice.
+	require.NoError(t, initiator.ReceiveAddInput(1, rawPrevTx, 0, 0))
+	err = initiator.ReceiveAddInput(1, rawPrevTx, 0, 0)
+	require.ErrorIs(t, err, ErrDuplicateSerialID)
+	err = initiator.ReceiveAddInput(3, rawPrevTx, 0, 0)
+	require.ErrorIs(t, err, ErrDuplicateInput)
+
+	// Dust outputs are rejected.
+	err = initiator.ReceiveAddOutput(5, 100, p2wkhScript)
+	require.Error(t, err)
+
+	// Only inputs and outputs of the remote party may be removed by it.
+	serialID, err := initiator.AddLocalOutput(testFundingOutput)
+	require.NoError(t, err)
+	err = initiator.ReceiveRemoveOutput(serialID)
+	require.ErrorIs(t, err, ErrUnknownSerialID)
+	err = initiator.ReceiveRemoveInput(7)
+	require.ErrorIs(t, err, ErrUnknownSerialID)
+}
+
+// TestInteractiveTxValidation tests that a completed negotiation is rejected
+// if the funding output is missing, or if the remote party doesn't pay for
+// its contribution.
+func TestInteractiveTxValidation(t *testing.T) {
+	t.Parallel()
+
+	// Without the funding output, the negotiation can't complete.
+	initiator, responder := newTestInteractiveTxs()
+	require.NoError(t, initiator.SendComplete())
+	require.ErrorIs(
+		t, initiator.ReceiveComplete(), ErrMissingFundingOutput,
+	)
+
+	// The responder's input only covers its share of the funding output,
+	// but not the fee of the input itself.
+	require.NoError(t, responder.ReceiveAddOutput(
+		0, ltcutil.Amount(testFundingOutput.Value),
+		testFundingOutput.PkScript,
+	))
+	_, err := responder.AddLocalInput(
+		newPrevTx(500_000, p2wkhScript), 0, 0,
+	)
+	require.NoError(t, err)
+
+	initiator, _ = newTestInteractiveTxs()
+	_, err = initiator.AddLocalOutput(testFundingOutput)
+	require.NoError(t, err)
+	require.NoError(t, initiator.ReceiveAddInput(
+		1, serializeTx(t, newPrevTx(500_000, p2wkhScript)), 0, 0,
+	))
+	require.NoError(t, initiator.SendComplete())
+	require.ErrorIs(
+		t, initiator.ReceiveComplete(), ErrInsufficientContribution,
+	)
+
+	// The responder in turn expects the initiator to pay for the common
+	// fields and the funding output as well.
+	require.NoError(t, responder.ReceiveAddInput(
+		2, serializeTx(t, newPrevTx(500_100, p2wkhScript)), 0, 0,
+	))
+	require.NoError(t, responder.SendComplete())
+	require.ErrorIs(
+		t, responder.ReceiveComplete(), ErrInsufficientContribution,
+	)
+}
+
+// TestInteractiveTxRemoteWitnesses tests that the witnesses of the remote
+// party are added to the transaction and verified.
+func TestInteractiveTxRemoteWitnesses(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pkHash := ltcutil.Hash160(privKey.PubKey().SerializeCompressed())
+	pkScript, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(pkHash).Script()
+	require.NoError(t, err)
+
+	initiator, responder := newTestInteractiveTxs()
+
+	_, err = initiator.AddLocalOutput(testFundingOutput)
+	require.NoError(t, err)
+	require.NoError(t, responder.ReceiveAddOutput(
+		0, ltcutil.Amount(testFundingOutput.Value),
+		testFundingOutput.PkScript,
+	))
+
+	initiatorPrevTx := newPrevTx(600_000, p2wkhScript)
+	serialID, err := initiator.AddLocalInput(initiatorPrevTx, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, responder.ReceiveAddInput(
+		serialID, serializeTx(t, initiatorPrevTx), 0, 0,
+	))
+
+	prevTx := newPrevTx(1_100_000, pkScript)
+	serialID, err = responder.AddLocalInput(prevTx, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, initiator.ReceiveAddInput(
+		serialID, serializeTx(t, prevTx), 0, 0,
+	))
+
+	require.NoError(t, responder.SendComplete())
+	require.NoError(t, initiator.ReceiveComplete())
+	require.NoError(t, initiator.SendComplete())
+	require.NoError(t, responder.ReceiveComplete())
+
+	// The initiator contributed less, so it sends its signatures first.
+	require.True(t, initiator.SendSignaturesFirst())
+
+	tx, err := responder.Tx()
+	require.NoError(t, err)
+
+	witness, err := txscript.WitnessSignature(
+		tx, txscript.NewTxSigHashes(tx), 0, prevTx.TxOut[0].Value,
+		pkScript, txscript.SigHashAll, privKey, true,
+	)
+	require.NoError(t, err)
+
+	// A witness that doesn't match the input is rejected.
+	tx, err = initiator.Tx()
+	require.NoError(t, err)
+	invalidWitness := wire.TxWitness{witness[0], witness[0]}
+	err = initiator.AddRemoteWitnesses(
+		tx, []wire.TxWitness{invalidWitness},
+	)
+	require.Error(t, err)
+
+	tx, err = initiator.Tx()
+	require.NoError(t, err)
+	require.NoError(t, initiator.AddRemoteWitnesses(
+		tx, []wire.TxWitness{witness},
+	))
+	require.Equal(t, witness, tx.TxIn[0].Witness)
+}
+
+// TestInteractiveTxSplice tests the negotiation of a splice transaction, in
+// which the initiator adds the shared input and funds to the channel, while
+// the responder splices funds out of it.
+func TestInteractiveTxSplice(t *testing.T) {
+	t.Parallel()
+
+	shared := &SharedInput{
+		OutPoint: wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1},
+		TxOut:    testFundingOutput,
+	}
+	newFundingOutput := wire.NewTxOut(
+		testFundingOutput.Value+200_000-100_000,
+		testFundingOutput.PkScript,
+	)
+
+	cfg := InteractiveTxConfig{
+		SharedInput:      shared,
+		FundingOutput:    newFundingOutput,
+		LocalFundingAmt:  -100_000,
+		RemoteFundingAmt: 200_000,
+		FeeRate:          chainfee.SatPerKWeight(1000),
+		DustLimit:        546,
+	}
+	responder := NewInteractiveTx(cfg)
+
+	cfg.Initiator = true
+	cfg.LocalFundingAmt, cfg.RemoteFundingAmt = 200_000, -100_000
+	initiator := NewInteractiveTx(cfg)
+
+	// Only the initiator may add the shared input, and it must spend the
+	// current funding output.
+	_, err := responder.AddSharedInput(0)
+	require.ErrorIs(t, err, ErrInvalidSharedInput)
+
+	serialID, err := initiator.AddSharedInput(0xfffffffd)
+	require.NoError(t, err)
+	err = responder.ReceiveAddSharedInput(
+		serialID, chainhash.Hash{2}, 1, 0xfffffffd,
+	)
+	require.ErrorIs(t, err, ErrInvalidSharedInput)
+	require.NoError(t, responder.ReceiveAddSharedInput(
+		serialID, shared.OutPoint.Hash, 1, 0xfffffffd,
+	))
+
+	serialID, err = initiator.AddLocalOutput(newFundingOutput)
+	require.NoError(t, err)
+	require.NoError(t, responder.ReceiveAddOutput(
+		serialID, ltcutil.Amount(newFundingOutput.Value),
+		newFundingOutput.PkScript,
+	))
+
+	prevTx := newPrevTx(300_000, p2wkhScript)
+	serialID, err = initiator.AddLocalInput(prevTx, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, responder.ReceiveAddInput(
+		serialID, serializeTx(t, prevTx), 0, 0,
+	))
+
+	// The responder pays the fee for its output from the funds it
+	// removes from the channel.
+	spliceOut := wire.NewTxOut(0, p2wkhScript)
+	weight := ContributionWeight(
+		false, true, 0, []*wire.TxOut{spliceOut}, newFundingOutput,
+	)
+	fee := cfg.FeeRate.FeeForWeight(weight)
+	spliceOut.Value = int64(100_000 - fee)
+
+	serialID, err = responder.AddLocalOutput(spliceOut)
+	require.NoError(t, err)
+	require.NoError(t, initiator.ReceiveAddOutput(
+		serialID, ltcutil.Amount(spliceOut.Value), spliceOut.PkScript,
+	))
+
+	require.NoError(t, responder.SendComplete())
+	require.NoError(t, initiator.ReceiveComplete())
+	require.NoError(t, initiator.SendComplete())
+	require.NoError(t, responder.ReceiveComplete())
+
+	initiatorTx, err := initiator.Tx()
+	require.NoError(t, err)
+	responderTx, err := responder.Tx()
+	require.NoError(t, err)
+	require.Equal(t, initiatorTx.TxHash(), responderTx.TxHash())
+	require.Equal(t, shared.OutPoint, initiatorTx.TxIn[0].PreviousOutPoint)
+
+	idx, err := responder.SharedInputIndex()
+	require.NoError(t, err)
+	require.Zero(t, idx)
+
+	// The shared input doesn't count towards the initiator's inputs, so
+	// the responder without any inputs of its own sends its signatures
+	// first, and it doesn't send any witnesses.
+	require.True(t, responder.SendSignaturesFirst())
+	require.NoError(t, initiator.AddRemoteWitnesses(initiatorTx, nil))
+}
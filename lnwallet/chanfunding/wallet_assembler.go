@@ -105,8 +105,63 @@ func (f *FullIntent) CompileFundingTx(extraInputs []*wire.TxIn,
 		fundingTx, fundingOutput.PkScript,
 	)
 
-	// Next, sign all inputs that are ours, collecting the signatures in
-	// order of the inputs.
+	// Next, sign all inputs that are ours.
+	if err := f.SignInputs(fundingTx); err != nil {
+		return nil, err
+	}
+
+	// Finally, we'll populate the chanPoint now that we've fully
+	// constructed the funding transaction.
+	f.chanPoint = &wire.OutPoint{
+		Hash:  fundingTx.TxHash(),
+		Index: multiSigIndex,
+	}
+
+	return fundingTx, nil
+}
+
+// SetRemoteFundingAmt sets the amount that the remote party contributes to
+// the funding output. This is only known after the intent was created when
+// the remote party contributes funds to a dual funded channel.
+func (f *FullIntent) SetRemoteFundingAmt(amt ltcutil.Amount) {
+	f.remoteFundingAmt = amt
+}
+
+// CompileInteractiveTx is the counterpart of CompileFundingTx for funding
+// transactions that were constructed interactively with the remote party. In
+// contrast to CompileFundingTx, the order of the inputs and outputs is already
+// fixed by the negotiation, so the passed transaction is only signed. Only our
+// own inputs are signed, the witnesses of the remote party are added once we
+// receive them.
+func (f *FullIntent) CompileInteractiveTx(fundingTx *wire.MsgTx) error {
+	_, fundingOutput, err := f.FundingOutput()
+	if err != nil {
+		return err
+	}
+
+	found, multiSigIndex := input.FindScriptOutputIndex(
+		fundingTx, fundingOutput.PkScript,
+	)
+	if !found {
+		return fmt.Errorf("funding output not found in interactive tx")
+	}
+
+	if err := f.SignInputs(fundingTx); err != nil {
+		return err
+	}
+
+	f.chanPoint = &wire.OutPoint{
+		Hash:  fundingTx.TxHash(),
+		Index: multiSigIndex,
+	}
+
+	return nil
+}
+
+// SignInputs signs all inputs of the passed transaction that are known by
+// the backing CoinSource, populating their witness and sigScript.
+func (f *FullIntent) SignInputs(fundingTx *wire.MsgTx) error {
+	// We'll collect the signatures in order of the inputs.
 	signDesc := input.SignDescriptor{
 		HashType:  txscript.SigHashAll,
 		SigHashes: txscript.NewTxSigHashes(fundingTx),
@@ -136,21 +191,14 @@ func (f *FullIntent) CompileFundingTx(extraInputs []*wire.TxIn,
 			fundingTx, &signDesc,
 		)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		txIn.SignatureScript = inputScript.SigScript
 		txIn.Witness = inputScript.Witness
 	}
 
-	// Finally, we'll populate the chanPoint now that we've fully
-	// constructed the funding transaction.
-	f.chanPoint = &wire.OutPoint{
-		Hash:  fundingTx.TxHash(),
-		Index: multiSigIndex,
-	}
-
-	return fundingTx, nil
+	return nil
 }
 
 // Inputs returns all inputs to the final funding transaction that we
@@ -327,6 +327,12 @@ type PaymentDescriptor struct {
 	// NOTE: Populated only on add payment descriptor entry types.
 	OnionBlob []byte
 
+	// BlindingPoint is the route blinding point that was sent along with
+	// the HTLC, which is set if the HTLC is part of a blinded route.
+	//
+	// NOTE: Populated only on add payment descriptor entry types.
+	BlindingPoint *btcec.PublicKey
+
 	// ShaOnionBlob is a sha of the onion blob.
 	//
 	// NOTE: Populated only in payment descriptor with MalformedFail type.
@@ -405,6 +411,7 @@ func PayDescsFromRemoteLogUpdates(chanID lnwire.ShortChannelID, height uint64,
 					Height: height,
 					Index:  uint16(i),
 				},
+				BlindingPoint: wireMsg.BlindingPoint,
 			}
 			pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
 			copy(pd.OnionBlob[:], wireMsg.OnionBlob[:])
@@ -544,6 +551,15 @@ type commitment struct {
 	// view.
 	outgoingHTLCIndex map[int32]*PaymentDescriptor
 	incomingHTLCIndex map[int32]*PaymentDescriptor
+
+	// splice is the version of this commitment that spends the funding
+	// output of the pending splice of the channel, if there is one.
+	splice *commitment
+
+	// spliceSigs are the signatures we sent the remote party for the
+	// splice version of this commitment. This is only set for commitments
+	// within the remote commitment chain.
+	spliceSigs *lnwire.SpliceSigs
 }
 
 // locateOutputIndex is a small helper function to locate the output index of a
@@ -728,6 +744,7 @@ func (c *commitment) toDiskCommit(ourCommit bool) *channeldb.ChannelCommitment {
 			HtlcIndex:     htlc.HtlcIndex,
 			LogIndex:      htlc.LogIndex,
 			Incoming:      false,
+			BlindingPoint: htlc.BlindingPoint,
 		}
 		h.OnionBlob = make([]byte, len(htlc.OnionBlob))
 		copy(h.OnionBlob[:], htlc.OnionBlob)
@@ -753,6 +770,7 @@ func (c *commitment) toDiskCommit(ourCommit bool) *channeldb.ChannelCommitment {
 			HtlcIndex:     htlc.HtlcIndex,
 			LogIndex:      htlc.LogIndex,
 			Incoming:      true,
+			BlindingPoint: htlc.BlindingPoint,
 		}
 		h.OnionBlob = make([]byte, len(htlc.OnionBlob))
 		copy(h.OnionBlob[:], htlc.OnionBlob)
@@ -764,6 +782,10 @@ func (c *commitment) toDiskCommit(ourCommit bool) *channeldb.ChannelCommitment {
 		commit.Htlcs = append(commit.Htlcs, h)
 	}
 
+	if c.splice != nil {
+		commit.SpliceCommitment = c.splice.toDiskCommit(ourCommit)
+	}
+
 	return commit
 }
 
@@ -844,6 +866,7 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate chainfee.SatPerKWeight,
 		HtlcIndex:          htlc.HtlcIndex,
 		LogIndex:           htlc.LogIndex,
 		OnionBlob:          htlc.OnionBlob,
+		BlindingPoint:      htlc.BlindingPoint,
 		localOutputIndex:   localOutputIndex,
 		remoteOutputIndex:  remoteOutputIndex,
 		ourPkScript:        ourP2WSH,
@@ -961,6 +984,18 @@ func (lc *LightningChannel) diskCommitToMemCommit(isLocal bool,
 		commit.dustLimit = lc.channelState.RemoteChanCfg.DustLimit
 	}
 
+	// If the channel has a pending splice, then we'll also restore the
+	// version of the commitment that spends its funding output.
+	if diskCommit.SpliceCommitment != nil {
+		commit.splice, err = lc.diskCommitToMemCommit(
+			isLocal, diskCommit.SpliceCommitment, localCommitPoint,
+			remoteCommitPoint,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return commit, nil
 }
 
@@ -1279,6 +1314,22 @@ type LightningChannel struct {
 
 	commitBuilder *CommitmentBuilder
 
+	// splice is the pending splice of the channel, if any. As long as it
+	// is set, every new commitment also has a version that spends the
+	// funding output of the splice transaction.
+	splice *channeldb.ChannelSplice
+
+	// spliceBuilder creates the splice versions of new commitments.
+	spliceBuilder *CommitmentBuilder
+
+	// spliceSignDesc is the sign descriptor capable of signing commitment
+	// transactions that spend the funding output of the pending splice.
+	spliceSignDesc *input.SignDescriptor
+
+	// spliceProposal holds the splice versions of the current commitments
+	// while a new splice is being negotiated.
+	spliceProposal *spliceProposal
+
 	// [local|remote]Log is a (mostly) append-only log storing all the HTLC
 	// updates to this channel. The log is walked backwards as HTLC updates
 	// are applied in order to re-construct a commitment transaction from a
@@ -1355,6 +1406,17 @@ func NewLightningChannel(signer input.Signer,
 		return nil, err
 	}
 
+	// Finally, if the channel has a pending splice, which is the case if
+	// its commitments have splice versions, then we'll fetch it as new
+	// commitments need to be signed for its funding output as well.
+	if localCommit.SpliceCommitment != nil {
+		splice, err := state.FetchSplice()
+		if err != nil {
+			return nil, err
+		}
+		lc.setSplice(splice)
+	}
+
 	return lc, nil
 }
 
@@ -1433,6 +1495,7 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 			HtlcIndex:             wireMsg.ID,
 			LogIndex:              logUpdate.LogIndex,
 			addCommitHeightRemote: commitHeight,
+			BlindingPoint:         wireMsg.BlindingPoint,
 		}
 		pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
 		copy(pd.OnionBlob[:], wireMsg.OnionBlob[:])
@@ -1632,6 +1695,7 @@ func (lc *LightningChannel) remoteLogUpdateToPayDesc(logUpdate *channeldb.LogUpd
 			HtlcIndex:            wireMsg.ID,
 			LogIndex:             logUpdate.LogIndex,
 			addCommitHeightLocal: commitHeight,
+			BlindingPoint:        wireMsg.BlindingPoint,
 		}
 		pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
 		copy(pd.OnionBlob, wireMsg.OnionBlob[:])
@@ -1794,6 +1858,8 @@ func (lc *LightningChannel) restoreCommitState(
 		if err != nil {
 			return err
 		}
+		pendingRemoteCommit.spliceSigs = pendingRemoteCommitDiff.
+			CommitSig.SpliceSigs
 		lc.remoteCommitChain.addCommitment(pendingRemoteCommit)
 
 		lc.log.Debugf("pending remote commitment: %v",
@@ -2667,11 +2733,23 @@ func (lc *LightningChannel) fetchCommitmentView(remoteChain bool,
 		return nil, err
 	}
 
+	// If there's a pending splice, then we'll also need the version of
+	// the commitment that spends its funding output.
+	if lc.splice != nil {
+		c.splice, err = lc.fetchSpliceCommitmentView(
+			c, ourBalance, theirBalance, filteredHTLCView, keyRing,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
 func fundingTxIn(chanState *channeldb.OpenChannel) wire.TxIn {
-	return *wire.NewTxIn(&chanState.FundingOutpoint, nil, nil)
+	fundingOutpoint := chanState.ActiveFundingOutpoint()
+	return *wire.NewTxIn(&fundingOutpoint, nil, nil)
 }
 
 // evaluateHTLCView processes all update entries in both HTLC update logs,
@@ -3117,8 +3195,8 @@ func genRemoteHtlcSigJobs(keyRing *CommitmentKeyRing,
 // new commitment to the remote party. The commit diff returned contains all
 // information necessary for retransmission.
 func (lc *LightningChannel) createCommitDiff(
-	newCommit *commitment, commitSig lnwire.Sig,
-	htlcSigs []lnwire.Sig) (*channeldb.CommitDiff, error) {
+	newCommit *commitment, commitSig lnwire.Sig, htlcSigs []lnwire.Sig,
+	spliceSigs *lnwire.SpliceSigs) (*channeldb.CommitDiff, error) {
 
 	// First, we need to convert the funding outpoint into the ID that's
 	// used on the wire to identify this channel. We'll use this shortly
@@ -3164,11 +3242,12 @@ func (lc *LightningChannel) createCommitDiff(
 		switch pd.EntryType {
 		case Add:
 			htlc := &lnwire.UpdateAddHTLC{
-				ChanID:      chanID,
-				ID:          pd.HtlcIndex,
-				Amount:      pd.Amount,
-				Expiry:      pd.Timeout,
-				PaymentHash: pd.RHash,
+				ChanID:        chanID,
+				ID:            pd.HtlcIndex,
+				Amount:        pd.Amount,
+				Expiry:        pd.Timeout,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: pd.BlindingPoint,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -3246,8 +3325,9 @@ func (lc *LightningChannel) createCommitDiff(
 			ChanID: lnwire.NewChanIDFromOutPoint(
 				&lc.channelState.FundingOutpoint,
 			),
-			CommitSig: commitSig,
-			HtlcSigs:  htlcSigs,
+			CommitSig:  commitSig,
+			HtlcSigs:   htlcSigs,
+			SpliceSigs: spliceSigs,
 		},
 		LogUpdates:        logUpdates,
 		OpenedCircuitKeys: openCircuitKeys,
@@ -3301,11 +3381,12 @@ func (lc *LightningChannel) getUnsignedAckedUpdates() []channeldb.LogUpdate {
 		switch pd.EntryType {
 		case Add:
 			htlc := &lnwire.UpdateAddHTLC{
-				ChanID:      chanID,
-				ID:          pd.HtlcIndex,
-				Amount:      pd.Amount,
-				Expiry:      pd.Timeout,
-				PaymentHash: pd.RHash,
+				ChanID:        chanID,
+				ID:            pd.HtlcIndex,
+				Amount:        pd.Amount,
+				Expiry:        pd.Timeout,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: pd.BlindingPoint,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -3497,6 +3578,76 @@ func (lc *LightningChannel) validateCommitmentSanity(theirLogCounter,
 	return nil
 }
 
+// signCommitView signs the given commitment view of the remote party using the
+// passed sign descriptor for the funding output it spends. The signature for
+// the commitment transaction is returned along with the signatures for its
+// HTLC's, sorted according to the BIP 69 order of the HTLC's on the
+// commitment transaction.
+func (lc *LightningChannel) signCommitView(view *commitment,
+	keyRing *CommitmentKeyRing, signDesc *input.SignDescriptor) (lnwire.Sig,
+	[]lnwire.Sig, error) {
+
+	var sig lnwire.Sig
+
+	// If there are any HTLC's, we'll need to generate signatures of each
+	// of them for the remote party's commitment state. We do so in two
+	// phases: first we generate and submit the set of signature jobs to
+	// the worker pool.
+	var leaseExpiry uint32
+	if lc.channelState.ChanType.HasLeaseExpiration() {
+		leaseExpiry = lc.channelState.ThawHeight
+	}
+	sigBatch, cancelChan, err := genRemoteHtlcSigJobs(
+		keyRing, lc.channelState.ChanType, !lc.channelState.IsInitiator,
+		leaseExpiry, &lc.channelState.LocalChanCfg,
+		&lc.channelState.RemoteChanCfg, view,
+	)
+	if err != nil {
+		return sig, nil, err
+	}
+	lc.sigPool.SubmitSignBatch(sigBatch)
+
+	// While the jobs are being carried out, we'll Sign their version of
+	// the new commitment transaction while we're waiting for the rest of
+	// the HTLC signatures to be processed.
+	signDesc.SigHashes = txscript.NewTxSigHashes(view.txn)
+	rawSig, err := lc.Signer.SignOutputRaw(view.txn, signDesc)
+	if err != nil {
+		close(cancelChan)
+		return sig, nil, err
+	}
+	sig, err = lnwire.NewSigFromSignature(rawSig)
+	if err != nil {
+		close(cancelChan)
+		return sig, nil, err
+	}
+
+	// We'll need to send over the signatures to the remote party in the
+	// order as they appear on the commitment transaction after BIP 69
+	// sorting.
+	sort.Slice(sigBatch, func(i, j int) bool {
+		return sigBatch[i].OutputIndex < sigBatch[j].OutputIndex
+	})
+
+	// With the jobs sorted, we'll now iterate through all the responses to
+	// gather each of the signatures in order.
+	htlcSigs := make([]lnwire.Sig, 0, len(sigBatch))
+	for _, htlcSigJob := range sigBatch {
+		jobResp := <-htlcSigJob.Resp
+
+		// If an error occurred, then we'll cancel any other active
+		// jobs.
+		if jobResp.Err != nil {
+			close(cancelChan)
+			return sig, nil, jobResp.Err
+		}
+
+		htlcSigs = append(htlcSigs, jobResp.Sig)
+	}
+
+	return sig, htlcSigs, nil
+}
+
 // SignNextCommitment signs a new commitment which includes any previous
 // unsettled HTLCs, any new HTLCs, and any modifications to prior HTLCs
 // committed in previous commitment updates. Signing a new commitment
@@ -3588,66 +3739,39 @@ func (lc *LightningChannel) SignNextCommitment() (lnwire.Sig, []lnwire.Sig, []ch
 		}),
 	)
 
-	// With the commitment view constructed, if there are any HTLC's, we'll
-	// need to generate signatures of each of them for the remote party's
-	// commitment state. We do so in two phases: first we generate and
-	// submit the set of signature jobs to the worker pool.
-	var leaseExpiry uint32
-	if lc.channelState.ChanType.HasLeaseExpiration() {
-		leaseExpiry = lc.channelState.ThawHeight
-	}
-	sigBatch, cancelChan, err := genRemoteHtlcSigJobs(
-		keyRing, lc.channelState.ChanType, !lc.channelState.IsInitiator,
-		leaseExpiry, &lc.channelState.LocalChanCfg,
-		&lc.channelState.RemoteChanCfg, newCommitView,
+	// With the commitment view constructed, we'll sign it along with any
+	// HTLC's for the remote party's commitment state.
+	sig, htlcSigs, err = lc.signCommitView(
+		newCommitView, keyRing, lc.signDesc,
 	)
 	if err != nil {
 		return sig, htlcSigs, nil, err
 	}
-	lc.sigPool.SubmitSignBatch(sigBatch)
 
-	// While the jobs are being carried out, we'll Sign their version of
-	// the new commitment transaction while we're waiting for the rest of
-	// the HTLC signatures to be processed.
-	lc.signDesc.SigHashes = txscript.NewTxSigHashes(newCommitView.txn)
-	rawSig, err := lc.Signer.SignOutputRaw(newCommitView.txn, lc.signDesc)
-	if err != nil {
-		close(cancelChan)
-		return sig, htlcSigs, nil, err
-	}
-	sig, err = lnwire.NewSigFromSignature(rawSig)
-	if err != nil {
-		close(cancelChan)
-		return sig, htlcSigs, nil, err
-	}
-
-	// We'll need to send over the signatures to the remote party in the
-	// order as they appear on the commitment transaction after BIP 69
-	// sorting.
-	sort.Slice(sigBatch, func(i, j int) bool {
-		return sigBatch[i].OutputIndex < sigBatch[j].OutputIndex
-	})
-
-	// With the jobs sorted, we'll now iterate through all the responses to
-	// gather each of the signatures in order.
-	htlcSigs = make([]lnwire.Sig, 0, len(sigBatch))
-	for _, htlcSigJob := range sigBatch {
-		jobResp := <-htlcSigJob.Resp
-
-		// If an error occurred, then we'll cancel any other active
-		// jobs.
-		if jobResp.Err != nil {
-			close(cancelChan)
-			return sig, htlcSigs, nil, jobResp.Err
+	// If there's a pending splice, then the remote party also needs our
+	// signatures for the version of the commitment that spends its
+	// funding output.
+	if newCommitView.splice != nil {
+		spliceSig, spliceHtlcSigs, err := lc.signCommitView(
+			newCommitView.splice, keyRing, lc.spliceSignDesc,
+		)
+		if err != nil {
+			return sig, htlcSigs, nil, err
 		}
 
-		htlcSigs = append(htlcSigs, jobResp.Sig)
+		newCommitView.spliceSigs = &lnwire.SpliceSigs{
+			FundingTxid: lc.splice.FundingOutpoint.Hash,
+			CommitSig:   spliceSig,
+			HtlcSigs:    spliceHtlcSigs,
+		}
 	}
 
 	// As we're about to proposer a new commitment state for the remote
 	// party, we'll write this pending state to disk before we exit, so we
 	// can retransmit it if necessary.
-	commitDiff, err := lc.createCommitDiff(newCommitView, sig, htlcSigs)
+	commitDiff, err := lc.createCommitDiff(
+		newCommitView, sig, htlcSigs, newCommitView.spliceSigs,
+	)
 	if err != nil {
 		return sig, htlcSigs, nil, err
 	}
@@ -3832,8 +3956,9 @@ func (lc *LightningChannel) ProcessChanSyncMsg(
 					ChanID: lnwire.NewChanIDFromOutPoint(
 						&lc.channelState.FundingOutpoint,
 					),
-					CommitSig: commitSig,
-					HtlcSigs:  htlcSigs,
+					CommitSig:  commitSig,
+					HtlcSigs:   htlcSigs,
+					SpliceSigs: lc.PendingSpliceSigs(),
 				})
 
 			// If we get a failure due to not knowing their next
@@ -4303,6 +4428,109 @@ func (i *InvalidHtlcSigError) Error() string {
 // error interface.
 var _ error = (*InvalidCommitSigError)(nil)
 
+// validateCommitSigs verifies the signatures the remote party sent for the
+// given view of our local commitment, using the passed sign descriptor for
+// the funding output it spends. As a side effect, the HTLC signatures are
+// stored within the HTLC's of the view.
+func (lc *LightningChannel) validateCommitSigs(view *commitment,
+	keyRing *CommitmentKeyRing, commitSig lnwire.Sig, htlcSigs []lnwire.Sig,
+	signDesc *input.SignDescriptor) error {
+
+	// Construct the sighash of the commitment transaction corresponding to
+	// this newly proposed state update.
+	localCommitTx := view.txn
+	multiSigScript := signDesc.WitnessScript
+	hashCache := txscript.NewTxSigHashes(localCommitTx)
+	sigHash, err := txscript.CalcWitnessSigHash(
+		multiSigScript, hashCache, txscript.SigHashAll,
+		localCommitTx, 0, signDesc.Output.Value,
+	)
+	if err != nil {
+		// TODO(roasbeef): fetchview has already mutated the HTLCs...
+		//  * need to either roll-back, or make pure
+		return err
+	}
+
+	// As an optimization, we'll generate a series of jobs for the worker
+	// pool to verify each of the HTLc signatures presented. Once
+	// generated, we'll submit these jobs to the worker pool.
+	var leaseExpiry uint32
+	if lc.channelState.ChanType.HasLeaseExpiration() {
+		leaseExpiry = lc.channelState.ThawHeight
+	}
+	verifyJobs, err := genHtlcSigValidationJobs(
+		view, keyRing, htlcSigs,
+		lc.channelState.ChanType, lc.channelState.IsInitiator,
+		leaseExpiry, &lc.channelState.LocalChanCfg,
+		&lc.channelState.RemoteChanCfg,
+	)
+	if err != nil {
+		return err
+	}
+
+	cancelChan := make(chan struct{})
+	verifyResps := lc.sigPool.SubmitVerifyBatch(verifyJobs, cancelChan)
+
+	// While the HTLC verification jobs are proceeding asynchronously,
+	// we'll ensure that the newly constructed commitment state has a valid
+	// signature.
+	verifyKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey
+	cSig, err := commitSig.ToSignature()
+	if err != nil {
+		return err
+	}
+	if !cSig.Verify(sigHash, verifyKey) {
+		close(cancelChan)
+
+		// If we fail to validate their commitment signature, we'll
+		// generate a special error to send over the protocol. We'll
+		// include the exact signature and commitment we failed to
+		// verify against in order to aide debugging.
+		var txBytes bytes.Buffer
+		localCommitTx.Serialize(&txBytes)
+		return &InvalidCommitSigError{
+			commitHeight: view.height,
+			commitSig:    commitSig.ToSignatureBytes(),
+			sigHash:      sigHash,
+			commitTx:     txBytes.Bytes(),
+		}
+	}
+
+	// With the primary commitment transaction validated, we'll check each
+	// of the HTLC validation jobs.
+	for i := 0; i < len(verifyJobs); i++ {
+		// In the case that a single signature is invalid, we'll exit
+		// early and cancel all the outstanding verification jobs.
+		htlcErr := <-verifyResps
+		if htlcErr != nil {
+			close(cancelChan)
+
+			sig, err := lnwire.NewSigFromSignature(
+				htlcErr.Sig,
+			)
+			if err != nil {
+				return err
+			}
+			sigHash, err := htlcErr.SigHash()
+			if err != nil {
+				return err
+			}
+
+			var txBytes bytes.Buffer
+			localCommitTx.Serialize(&txBytes)
+			return &InvalidHtlcSigError{
+				commitHeight: view.height,
+				htlcSig:      sig.ToSignatureBytes(),
+				htlcIndex:    htlcErr.HtlcIndex,
+				sigHash:      sigHash,
+				commitTx:     txBytes.Bytes(),
+			}
+		}
+	}
+
+	return nil
+}
+
 // ReceiveNewCommitment process a signature for a new commitment state sent by
 // the remote party. This method should be called in response to the
 // remote party initiating a new change, or when the remote party sends a
@@ -4317,6 +4545,41 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 	lc.Lock()
 	defer lc.Unlock()
 
+	return lc.receiveNewCommitment(commitSig, htlcSigs, nil)
+}
+
+// ReceiveCommitSig processes a CommitSig message sent by the remote party,
+// see ReceiveNewCommitment. In addition to the signatures for the new
+// commitment, the message may carry the signatures for the version of the
+// commitment that spends the funding output of a pending splice.
+func (lc *LightningChannel) ReceiveCommitSig(msg *lnwire.CommitSig) error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	commitSig, htlcSigs, spliceSigs := msg.CommitSig, msg.HtlcSigs,
+		msg.SpliceSigs
+
+	// If the remote party signed this commitment before it learnt that
+	// the last splice was locked in, then its splice signatures are the
+	// ones for the funding output the channel now spends.
+	activeFundingTxid := lc.channelState.ActiveFundingOutpoint().Hash
+	if lc.splice == nil && spliceSigs != nil &&
+		spliceSigs.FundingTxid == activeFundingTxid {
+
+		commitSig, htlcSigs = spliceSigs.CommitSig, spliceSigs.HtlcSigs
+		spliceSigs = nil
+	}
+
+	return lc.receiveNewCommitment(commitSig, htlcSigs, spliceSigs)
+}
+
+// receiveNewCommitment is the internal version of ReceiveNewCommitment that
+// also verifies the signatures for the splice version of the new commitment
+// if the channel has a pending splice. It expects the caller to hold the
+// channel's mutex.
+func (lc *LightningChannel) receiveNewCommitment(commitSig lnwire.Sig,
+	htlcSigs []lnwire.Sig, spliceSigs *lnwire.SpliceSigs) error {
+
 	// Check for empty commit sig. Because of a previously existing bug, it
 	// is possible that we receive an empty commit sig from nodes running an
 	// older version. This is a relaxation of the spec, but it is still
@@ -4329,6 +4592,14 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 		lc.log.Warnf("empty commit sig message received")
 	}
 
+	// If there's a pending splice, then they must have also signed the
+	// version of the commitment that spends its funding output.
+	if lc.splice != nil && (spliceSigs == nil ||
+		spliceSigs.FundingTxid != lc.splice.FundingOutpoint.Hash) {
+
+		return ErrMissingSpliceSigs
+	}
+
 	// Determine the last update on the local log that has been locked in.
 	localACKedIndex := lc.remoteCommitChain.tail().ourMessageIndex
 	localHtlcIndex := lc.remoteCommitChain.tail().ourHtlcIndex
@@ -4384,96 +4655,26 @@ func (lc *LightningChannel) ReceiveNewCommitment(commitSig lnwire.Sig,
 		}),
 	)
 
-	// Construct the sighash of the commitment transaction corresponding to
-	// this newly proposed state update.
-	localCommitTx := localCommitmentView.txn
-	multiSigScript := lc.signDesc.WitnessScript
-	hashCache := txscript.NewTxSigHashes(localCommitTx)
-	sigHash, err := txscript.CalcWitnessSigHash(
-		multiSigScript, hashCache, txscript.SigHashAll,
-		localCommitTx, 0, int64(lc.channelState.Capacity),
+	// Before adding the new commitment to our local commitment chain,
+	// we'll verify the signatures the remote party sent for it.
+	err = lc.validateCommitSigs(
+		localCommitmentView, keyRing, commitSig, htlcSigs, lc.signDesc,
 	)
 	if err != nil {
-		// TODO(roasbeef): fetchview has already mutated the HTLCs...
-		//  * need to either roll-back, or make pure
 		return err
 	}
 
-	// As an optimization, we'll generate a series of jobs for the worker
-	// pool to verify each of the HTLc signatures presented. Once
-	// generated, we'll submit these jobs to the worker pool.
-	var leaseExpiry uint32
-	if lc.channelState.ChanType.HasLeaseExpiration() {
-		leaseExpiry = lc.channelState.ThawHeight
-	}
-	verifyJobs, err := genHtlcSigValidationJobs(
-		localCommitmentView, keyRing, htlcSigs,
-		lc.channelState.ChanType, lc.channelState.IsInitiator,
-		leaseExpiry, &lc.channelState.LocalChanCfg,
-		&lc.channelState.RemoteChanCfg,
-	)
-	if err != nil {
-		return err
-	}
-
-	cancelChan := make(chan struct{})
-	verifyResps := lc.sigPool.SubmitVerifyBatch(verifyJobs, cancelChan)
-
-	// While the HTLC verification jobs are proceeding asynchronously,
-	// we'll ensure that the newly constructed commitment state has a valid
-	// signature.
-	verifyKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey
-	cSig, err := commitSig.ToSignature()
-	if err != nil {
-		return err
-	}
-	if !cSig.Verify(sigHash, verifyKey) {
-		close(cancelChan)
-
-		// If we fail to validate their commitment signature, we'll
-		// generate a special error to send over the protocol. We'll
-		// include the exact signature and commitment we failed to
-		// verify against in order to aide debugging.
-		var txBytes bytes.Buffer
-		localCommitTx.Serialize(&txBytes)
-		return &InvalidCommitSigError{
-			commitHeight: nextHeight,
-			commitSig:    commitSig.ToSignatureBytes(),
-			sigHash:      sigHash,
-			commitTx:     txBytes.Bytes(),
-		}
-	}
-
-	// With the primary commitment transaction validated, we'll check each
-	// of the HTLC validation jobs.
-	for i := 0; i < len(verifyJobs); i++ {
-		// In the case that a single signature is invalid, we'll exit
-		// early and cancel all the outstanding verification jobs.
-		htlcErr := <-verifyResps
-		if htlcErr != nil {
-			close(cancelChan)
-
-			sig, err := lnwire.NewSigFromSignature(
-				htlcErr.Sig,
-			)
-			if err != nil {
-				return err
-			}
-			sigHash, err := htlcErr.SigHash()
-			if err != nil {
-				return err
-			}
-
-			var txBytes bytes.Buffer
-			localCommitTx.Serialize(&txBytes)
-			return &InvalidHtlcSigError{
-				commitHeight: nextHeight,
-				htlcSig:      sig.ToSignatureBytes(),
-				htlcIndex:    htlcErr.HtlcIndex,
-				sigHash:      sigHash,
-				commitTx:     txBytes.Bytes(),
-			}
+	// If there's a pending splice, then we'll also verify their
+	// signatures for the splice version of the commitment.
+	if spliceView := localCommitmentView.splice; spliceView != nil {
+		err := lc.validateCommitSigs(
+			spliceView, keyRing, spliceSigs.CommitSig,
+			spliceSigs.HtlcSigs, lc.spliceSignDesc,
+		)
+		if err != nil {
+			return err
 		}
+		spliceView.sig = spliceSigs.CommitSig.ToSignatureBytes()
 	}
 
 	// The signature checks out, so we can now add the new commitment to
@@ -4818,11 +5019,12 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 		switch pd.EntryType {
 		case Add:
 			htlc := &lnwire.UpdateAddHTLC{
-				ChanID:      chanID,
-				ID:          pd.HtlcIndex,
-				Amount:      pd.Amount,
-				Expiry:      pd.Timeout,
-				PaymentHash: pd.RHash,
+				ChanID:        chanID,
+				ID:            pd.HtlcIndex,
+				Amount:        pd.Amount,
+				Expiry:        pd.Timeout,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: pd.BlindingPoint,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -5110,6 +5312,7 @@ func (lc *LightningChannel) htlcAddDescriptor(htlc *lnwire.UpdateAddHTLC,
 		LogIndex:       lc.localUpdateLog.logIndex,
 		HtlcIndex:      lc.localUpdateLog.htlcCounter,
 		OnionBlob:      htlc.OnionBlob[:],
+		BlindingPoint:  htlc.BlindingPoint,
 		OpenCircuitKey: openKey,
 	}
 }
@@ -5159,13 +5362,14 @@ func (lc *LightningChannel) ReceiveHTLC(htlc *lnwire.UpdateAddHTLC) (uint64, err
 	}
 
 	pd := &PaymentDescriptor{
-		EntryType: Add,
-		RHash:     PaymentHash(htlc.PaymentHash),
-		Timeout:   htlc.Expiry,
-		Amount:    htlc.Amount,
-		LogIndex:  lc.remoteUpdateLog.logIndex,
-		HtlcIndex: lc.remoteUpdateLog.htlcCounter,
-		OnionBlob: htlc.OnionBlob[:],
+		EntryType:     Add,
+		RHash:         PaymentHash(htlc.PaymentHash),
+		Timeout:       htlc.Expiry,
+		Amount:        htlc.Amount,
+		LogIndex:      lc.remoteUpdateLog.logIndex,
+		HtlcIndex:     lc.remoteUpdateLog.htlcCounter,
+		OnionBlob:     htlc.OnionBlob[:],
+		BlindingPoint: htlc.BlindingPoint,
 	}
 
 	localACKedIndex := lc.remoteCommitChain.tail().ourMessageIndex
@@ -5479,6 +5683,19 @@ func (lc *LightningChannel) getSignedCommitTx() (*wire.MsgTx, error) {
 	// Fetch the current commitment transaction, along with their signature
 	// for the transaction.
 	localCommit := lc.channelState.LocalCommitment
+	signDesc := lc.signDesc
+
+	// Once the transaction of a pending splice confirmed, the funding
+	// output of the channel is spent, so only the splice version of our
+	// commitment can be broadcast.
+	spliceConfirmed, err := lc.spliceConfirmed()
+	if err != nil {
+		return nil, err
+	}
+	if spliceConfirmed {
+		localCommit = *localCommit.SpliceCommitment
+		signDesc = lc.spliceSignDesc
+	}
 	commitTx := localCommit.CommitTx.Copy()
 
 	theirSig, err := ecdsa.ParseDERSignature(localCommit.CommitSig)
@@ -5488,8 +5705,8 @@ func (lc *LightningChannel) getSignedCommitTx() (*wire.MsgTx, error) {
 
 	// With this, we then generate the full witness so the caller can
 	// broadcast a fully signed transaction.
-	lc.signDesc.SigHashes = txscript.NewTxSigHashes(commitTx)
-	ourSig, err := lc.Signer.SignOutputRaw(commitTx, lc.signDesc)
+	signDesc.SigHashes = txscript.NewTxSigHashes(commitTx)
+	ourSig, err := lc.Signer.SignOutputRaw(commitTx, signDesc)
 	if err != nil {
 		return nil, err
 	}
@@ -5502,7 +5719,7 @@ func (lc *LightningChannel) getSignedCommitTx() (*wire.MsgTx, error) {
 		SerializeCompressed()
 
 	commitTx.TxIn[0].Witness = input.SpendMultiSig(
-		lc.signDesc.WitnessScript, ourKey,
+		signDesc.WitnessScript, ourKey,
 		ourSig, theirKey, theirSig,
 	)
 
@@ -6372,7 +6589,15 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel,
 	// outgoing HTLC's that we'll need to claim as well. If this is after
 	// recovery there is not much we can do with HTLCs, so we'll always
 	// use what we have in our latest state when extracting resolutions.
+	// If the commitment spends the funding output of a splice, then the
+	// HTLC's are located within its splice version.
 	localCommit := chanState.LocalCommitment
+	spliceCommit := localCommit.SpliceCommitment
+	if spliceCommit != nil &&
+		spendsSameOutput(spliceCommit.CommitTx, commitTx) {
+
+		localCommit = *spliceCommit
+	}
 	htlcResolutions, err := extractHtlcResolutions(
 		chainfee.SatPerKWeight(localCommit.FeePerKw), true, signer,
 		localCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
@@ -6424,6 +6649,13 @@ func (lc *LightningChannel) CreateCloseProposal(proposedFee ltcutil.Amount,
 		return nil, nil, 0, ErrChanClosing
 	}
 
+	// The channel can't be closed cooperatively while a splice is
+	// pending, as the closing transaction would spend a funding output
+	// that may be double spent by the splice transaction.
+	if lc.splice != nil {
+		return nil, nil, 0, ErrSplicePending
+	}
+
 	// Get the final balances after subtracting the proposed fee, taking
 	// care not to persist the adjusted balance, as the feeRate may change
 	// during the channel closing process.
@@ -6488,6 +6720,12 @@ func (lc *LightningChannel) CompleteCooperativeClose(
 		return nil, 0, ErrChanClosing
 	}
 
+	// We also won't complete a cooperative close while a splice is
+	// pending.
+	if lc.splice != nil {
+		return nil, 0, ErrSplicePending
+	}
+
 	// Get the final balances after subtracting the proposed fee.
 	ourBalance, theirBalance, err := CoopCloseBalance(
 		lc.channelState.ChanType, lc.channelState.IsInitiator,
@@ -6506,12 +6744,35 @@ func (lc *LightningChannel) CompleteCooperativeClose(
 		localDeliveryScript, remoteDeliveryScript,
 	)
 
+	// Attach the witness for the funding input, which also verifies the
+	// signature of the remote party.
+	err = lc.attachCloseWitness(closeTx, localSig, remoteSig)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// As the transaction is sane, and the scripts are valid we'll mark the
+	// channel now as closed as the closure transaction should get into the
+	// chain in a timely manner and possibly be re-broadcast by the wallet.
+	lc.status = channelClosed
+
+	return closeTx, ourBalance, nil
+}
+
+// attachCloseWitness sets the witness of the funding input of the passed
+// cooperative closing transaction, and validates the resulting transaction to
+// ensure that the remote party supplied a valid signature.
+//
+// NOTE: This method MUST be called with the channel's lock held.
+func (lc *LightningChannel) attachCloseWitness(closeTx *wire.MsgTx,
+	localSig, remoteSig input.Signature) error {
+
 	// Ensure that the transaction doesn't explicitly validate any
 	// consensus rules such as being too big, or having any value with a
 	// negative output.
 	tx := ltcutil.NewTx(closeTx)
 	if err := blockchain.CheckTransactionSanity(tx); err != nil {
-		return nil, 0, err
+		return err
 	}
 	hashCache := txscript.NewTxSigHashes(closeTx)
 
@@ -6533,18 +6794,10 @@ func (lc *LightningChannel) CompleteCooperativeClose(
 	vm, err := txscript.NewEngine(prevOut.PkScript, closeTx, 0,
 		txscript.StandardVerifyFlags, nil, hashCache, prevOut.Value)
 	if err != nil {
-		return nil, 0, err
-	}
-	if err := vm.Execute(); err != nil {
-		return nil, 0, err
+		return err
 	}
 
-	// As the transaction is sane, and the scripts are valid we'll mark the
-	// channel now as closed as the closure transaction should get into the
-	// chain in a timely manner and possibly be re-broadcast by the wallet.
-	lc.status = channelClosed
-
-	return closeTx, ourBalance, nil
+	return vm.Execute()
 }
 
 // AnchorResolutions is a set of anchor resolutions that's being used when
@@ -6718,12 +6971,25 @@ func (lc *LightningChannel) availableBalance() (lnwire.MilliSatoshi, int64) {
 		htlcView, true,
 	)
 
-	// Return which ever balance is lowest.
-	if ourRemoteCommitBalance < ourLocalCommitBalance {
-		return ourRemoteCommitBalance, commitWeight
+	// Use which ever balance is lowest.
+	balance := ourLocalCommitBalance
+	if ourRemoteCommitBalance < balance {
+		balance = ourRemoteCommitBalance
+	}
+
+	// If we're splicing funds out of the channel, then they're no longer
+	// available once the splice transaction confirms.
+	if lc.splice != nil && lc.splice.LocalContribution < 0 {
+		spliceOut := lnwire.NewMSatFromSatoshis(
+			-lc.splice.LocalContribution,
+		)
+		if spliceOut > balance {
+			spliceOut = balance
+		}
+		balance -= spliceOut
 	}
 
-	return ourLocalCommitBalance, commitWeight
+	return balance, commitWeight
 }
 
 // availableCommitmentBalance attempts to calculate the balance we have
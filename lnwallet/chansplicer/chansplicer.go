@@ -0,0 +1,799 @@
+package chansplicer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/labels"
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/lnwallet/chainfee"
+	"github.com/ltcsuite/lnd/lnwallet/chanfunding"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+const (
+	// spliceSequence is the sequence number of the inputs that we add to
+	// a splice transaction. The interactive transaction protocol requires
+	// all inputs to signal replaceability.
+	spliceSequence = wire.MaxTxInSequenceNum - 2
+)
+
+var (
+	// ErrInvalidState is returned when the splice state machine receives
+	// a message that it doesn't expect in its current state.
+	ErrInvalidState = errors.New("invalid splice state")
+
+	// ErrZeroContribution is returned when a splice is requested that
+	// neither adds funds to nor removes funds from the channel.
+	ErrZeroContribution = errors.New("splice contribution must not be " +
+		"zero")
+)
+
+// spliceState represents all the possible states the splice state machine can
+// be in. Each message will either advance to the next state, or remain at the
+// current state. Once the state machine reaches the spliceFinished state, the
+// splice transaction was signed by both parties.
+type spliceState uint8
+
+const (
+	// spliceIdle is the initial state of the state machine. If the state
+	// machine receives a splice_init message in this state, then it's the
+	// responder of the splice.
+	spliceIdle spliceState = iota
+
+	// spliceInitSent is the state of the initiator after it sent its
+	// splice_init message, while it waits for the splice_ack of the remote
+	// party.
+	spliceInitSent
+
+	// spliceTxNegotiation is the state in which both parties take turns
+	// adding their inputs and outputs to the splice transaction.
+	spliceTxNegotiation
+
+	// spliceCommitSigs is the state in which both parties exchange their
+	// signatures for the versions of the current commitments that spend
+	// the funding output of the splice transaction.
+	spliceCommitSigs
+
+	// spliceTxSigs is the state in which both parties exchange their
+	// signatures for the splice transaction. The splice is pending from
+	// this state on.
+	spliceTxSigs
+
+	// spliceFinished is the final state of the state machine. The splice
+	// transaction is fully signed and was broadcast.
+	spliceFinished
+)
+
+// ChanSplice is a request to splice funds into or out of a channel.
+type ChanSplice struct {
+	// ChanPoint is the channel that should be spliced.
+	ChanPoint *wire.OutPoint
+
+	// Contribution is the amount that we add to the channel. A negative
+	// amount is removed from our balance and paid to the wallet.
+	Contribution ltcutil.Amount
+
+	// FeeRate is the fee rate of the splice transaction.
+	FeeRate chainfee.SatPerKWeight
+
+	// Done receives the fully signed splice transaction once it was
+	// broadcast.
+	Done chan *wire.MsgTx
+
+	// Err receives any error that aborts the splice.
+	Err chan error
+}
+
+// ChanSpliceCfg holds all the items that a ChanSplicer requires to carry out
+// its duties.
+type ChanSpliceCfg struct {
+	// Channel is the channel that should be spliced.
+	Channel *lnwallet.LightningChannel
+
+	// ProvisionSplice provisions our contribution to the splice
+	// transaction, given the amount we contribute, the fee rate, whether
+	// we're the initiator, and the funding output of the splice.
+	ProvisionSplice func(ltcutil.Amount, chainfee.SatPerKWeight, bool,
+		*wire.TxOut) (*chanfunding.FullIntent, error)
+
+	// FetchTx returns the wallet transaction with the given hash. The
+	// remote party needs the full previous transaction of our inputs.
+	FetchTx func(chainhash.Hash) (*wire.MsgTx, error)
+
+	// BroadcastTx broadcasts the passed transaction to the network.
+	BroadcastTx func(*wire.MsgTx, string) error
+}
+
+// ChanSplicer is a state machine that handles the negotiation of a splice.
+// This includes the exchange of the splice_init and splice_ack messages, the
+// interactive construction of the splice transaction, the signatures for the
+// splice versions of the current commitments, and finally the signatures for
+// the splice transaction. The channel must not be used to forward HTLC's
+// until the negotiation is finished.
+type ChanSplicer struct {
+	// state is the current state of the state machine.
+	state spliceState
+
+	// cfg holds the configuration for this ChanSplicer instance.
+	cfg ChanSpliceCfg
+
+	// cid is the full channel ID of the target channel.
+	cid lnwire.ChannelID
+
+	// req is the splice request. This will only be populated if we're
+	// the initiator of the splice.
+	req *ChanSplice
+
+	// localContribution and remoteContribution are the amounts that both
+	// parties add to the channel.
+	localContribution  ltcutil.Amount
+	remoteContribution ltcutil.Amount
+
+	// feeRate and lockTime are the fee rate and lock time of the splice
+	// transaction as proposed by the initiator.
+	feeRate  chainfee.SatPerKWeight
+	lockTime uint32
+
+	// intent holds our inputs and outputs of the splice transaction. It's
+	// nil if we don't contribute to the splice.
+	intent *chanfunding.FullIntent
+
+	// tx is the state machine of the interactive construction of the
+	// splice transaction.
+	tx *chanfunding.InteractiveTx
+
+	// sharedInputPending is true if we're the initiator and still have
+	// to add the shared input to the splice transaction.
+	sharedInputPending bool
+
+	// pendingInputs and pendingOutputs are our inputs and outputs that we
+	// haven't sent to the remote party yet. The inputs are identified by
+	// their outpoint and the transaction that created it.
+	pendingInputs  []*pendingInput
+	pendingOutputs []*wire.TxOut
+
+	// spliceTx is the negotiated splice transaction.
+	spliceTx *wire.MsgTx
+
+	// sentTxSigs is true once we sent our signatures for the splice
+	// transaction. From then on, the remote party is able to broadcast
+	// it, so the splice can no longer be abandoned.
+	sentTxSigs bool
+}
+
+// pendingInput is one of our wallet inputs that we add to the splice
+// transaction.
+type pendingInput struct {
+	// prevTx is the transaction that created the output that we spend.
+	prevTx *wire.MsgTx
+
+	// prevTxOut is the index of the spent output within prevTx.
+	prevTxOut uint32
+}
+
+// NewChanSplicer creates a new splice state machine for the channel of the
+// passed config. The request should only be populated if we're the initiator
+// of the splice.
+func NewChanSplicer(cfg ChanSpliceCfg, req *ChanSplice) *ChanSplicer {
+	cid := lnwire.NewChanIDFromOutPoint(cfg.Channel.ChannelPoint())
+
+	return &ChanSplicer{
+		state: spliceIdle,
+		cfg:   cfg,
+		cid:   cid,
+		req:   req,
+	}
+}
+
+// Channel returns the channel that is being spliced.
+func (c *ChanSplicer) Channel() *lnwallet.LightningChannel {
+	return c.cfg.Channel
+}
+
+// SpliceRequest returns the splice request of the state machine. It's nil if
+// the remote party initiated the splice.
+func (c *ChanSplicer) SpliceRequest() *ChanSplice {
+	return c.req
+}
+
+// SpliceTx returns the fully signed splice transaction. It's only available
+// once the negotiation is finished.
+func (c *ChanSplicer) SpliceTx() (*wire.MsgTx, error) {
+	if c.state != spliceFinished {
+		return nil, fmt.Errorf("splice negotiation not finished")
+	}
+
+	return c.spliceTx, nil
+}
+
+// InitSplice is the first method that's to be called by the initiator of a
+// splice. Our contribution to the splice transaction is provisioned, and the
+// splice_init message to send to the remote party is returned.
+func (c *ChanSplicer) InitSplice(lockTime uint32) (*lnwire.SpliceInit,
+	error) {
+
+	if c.state != spliceIdle || c.req == nil {
+		return nil, ErrInvalidState
+	}
+
+	contribution := c.req.Contribution
+	if contribution == 0 {
+		return nil, ErrZeroContribution
+	}
+
+	if contribution < 0 {
+		balance := c.cfg.Channel.AvailableBalance().ToSatoshis()
+		if -contribution > balance {
+			return nil, lnwallet.ErrSpliceBalanceTooLow
+		}
+	}
+
+	if c.cfg.Channel.PendingSplice() != nil {
+		return nil, lnwallet.ErrSplicePending
+	}
+
+	capacity := c.cfg.Channel.Capacity + contribution
+	intent, err := c.cfg.ProvisionSplice(
+		contribution, c.req.FeeRate, true,
+		c.cfg.Channel.SpliceFundingOutput(capacity),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to provision splice: %w", err)
+	}
+
+	c.intent = intent
+	c.localContribution = contribution
+	c.feeRate = c.req.FeeRate
+	c.lockTime = lockTime
+	c.state = spliceInitSent
+
+	chansplicerLog.Infof("ChannelPoint(%v): initiating splice with "+
+		"contribution of %v", c.cfg.Channel.ChannelPoint(),
+		contribution)
+
+	return &lnwire.SpliceInit{
+		ChanID:               c.cid,
+		FundingContribution:  contribution,
+		FundingFeePerKWeight: uint32(c.feeRate),
+		LockTime:             lockTime,
+	}, nil
+}
+
+// ProcessSpliceMsg processes the next message of the remote party, returning
+// the messages that should be sent in response. The returned boolean is true
+// once the splice transaction is fully signed and was broadcast.
+func (c *ChanSplicer) ProcessSpliceMsg(msg lnwire.Message) ([]lnwire.Message,
+	bool, error) {
+
+	switch msg := msg.(type) {
+	case *lnwire.SpliceInit:
+		if c.state != spliceIdle || c.req != nil {
+			return nil, false, ErrInvalidState
+		}
+
+		ack, err := c.handleSpliceInit(msg)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return []lnwire.Message{ack}, false, nil
+
+	case *lnwire.SpliceAck:
+		if c.state != spliceInitSent {
+			return nil, false, ErrInvalidState
+		}
+
+		err := c.checkRemoteContribution(msg.FundingContribution)
+		if err != nil {
+			return nil, false, err
+		}
+		c.remoteContribution = msg.FundingContribution
+
+		if err := c.initSpliceTx(true); err != nil {
+			return nil, false, err
+		}
+
+		// As the initiator, it's our turn to send the first message
+		// of the interactive construction of the splice transaction.
+		msgs, err := c.sendNextTxUpdate()
+		return msgs, false, err
+
+	case *lnwire.TxAddInput, *lnwire.TxAddOutput, *lnwire.TxRemoveInput,
+		*lnwire.TxRemoveOutput, *lnwire.TxComplete:
+
+		if c.state != spliceTxNegotiation {
+			return nil, false, ErrInvalidState
+		}
+
+		msgs, err := c.handleInteractiveTxMsg(msg)
+		return msgs, false, err
+
+	case *lnwire.CommitSig:
+		if c.state != spliceCommitSigs {
+			return nil, false, ErrInvalidState
+		}
+
+		msgs, err := c.handleCommitSig(msg)
+		return msgs, false, err
+
+	case *lnwire.TxSignatures:
+		if c.state != spliceTxSigs {
+			return nil, false, ErrInvalidState
+		}
+
+		msgs, err := c.handleTxSignatures(msg)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return msgs, true, nil
+
+	case *lnwire.TxAbort:
+		return nil, false, fmt.Errorf("splice aborted by remote "+
+			"party: %v", msg.Data)
+
+	default:
+		return nil, false, fmt.Errorf("%w: unexpected %v", ErrInvalidState,
+			msg.MsgType())
+	}
+}
+
+// Abort abandons the splice negotiation. As long as we didn't send our
+// signatures for the splice transaction, the splice is removed from the
+// channel, and the coins we selected for it are released. Otherwise, the
+// remote party is able to broadcast the splice transaction, so the splice
+// stays pending.
+func (c *ChanSplicer) Abort() error {
+	if c.sentTxSigs || c.state == spliceFinished {
+		return nil
+	}
+
+	if c.intent != nil {
+		c.intent.Cancel()
+	}
+
+	c.cfg.Channel.CancelSpliceProposal()
+	if c.cfg.Channel.PendingSplice() == nil {
+		return nil
+	}
+
+	return c.cfg.Channel.AbandonSplice()
+}
+
+// handleSpliceInit processes the splice_init message of the initiator. We
+// don't contribute any funds to a splice that was initiated by the remote
+// party.
+func (c *ChanSplicer) handleSpliceInit(
+	msg *lnwire.SpliceInit) (*lnwire.SpliceAck, error) {
+
+	if c.cfg.Channel.PendingSplice() != nil {
+		return nil, lnwallet.ErrSplicePending
+	}
+
+	if msg.FundingContribution == 0 {
+		return nil, ErrZeroContribution
+	}
+	if err := c.checkRemoteContribution(msg.FundingContribution); err != nil {
+		return nil, err
+	}
+
+	feeRate := chainfee.SatPerKWeight(msg.FundingFeePerKWeight)
+	if feeRate < chainfee.FeePerKwFloor {
+		return nil, fmt.Errorf("splice fee rate of %v is below the "+
+			"minimum of %v", feeRate, chainfee.FeePerKwFloor)
+	}
+
+	c.remoteContribution = msg.FundingContribution
+	c.feeRate = feeRate
+	c.lockTime = msg.LockTime
+
+	if err := c.initSpliceTx(false); err != nil {
+		return nil, err
+	}
+
+	chansplicerLog.Infof("ChannelPoint(%v): accepting splice with remote "+
+		"contribution of %v", c.cfg.Channel.ChannelPoint(),
+		msg.FundingContribution)
+
+	return &lnwire.SpliceAck{
+		ChanID:              c.cid,
+		FundingContribution: c.localContribution,
+	}, nil
+}
+
+// checkRemoteContribution makes sure that the remote party is able to cover
+// the funds it wants to remove from the channel.
+func (c *ChanSplicer) checkRemoteContribution(
+	contribution ltcutil.Amount) error {
+
+	if contribution >= 0 {
+		return nil
+	}
+
+	snapshot := c.cfg.Channel.StateSnapshot()
+	if -contribution > snapshot.RemoteBalance.ToSatoshis() {
+		return lnwallet.ErrSpliceBalanceTooLow
+	}
+
+	return nil
+}
+
+// initSpliceTx sets up the state machine for the interactive construction of
+// the splice transaction, and queues our own inputs and outputs to be sent to
+// the remote party.
+func (c *ChanSplicer) initSpliceTx(initiator bool) error {
+	channel := c.cfg.Channel
+	capacity := channel.Capacity + c.localContribution +
+		c.remoteContribution
+	fundingOutput := channel.SpliceFundingOutput(capacity)
+
+	c.tx = chanfunding.NewInteractiveTx(chanfunding.InteractiveTxConfig{
+		Initiator:        initiator,
+		FundingOutput:    fundingOutput,
+		SharedInput:      channel.SpliceSharedInput(),
+		LocalFundingAmt:  c.localContribution,
+		RemoteFundingAmt: c.remoteContribution,
+		FeeRate:          c.feeRate,
+		LockTime:         c.lockTime,
+		DustLimit:        channel.State().LocalChanCfg.DustLimit,
+	})
+
+	// It's up to the initiator to add the shared input and the funding
+	// output.
+	if initiator {
+		c.sharedInputPending = true
+		c.pendingOutputs = append(c.pendingOutputs, fundingOutput)
+	}
+
+	if c.intent != nil {
+		for _, coin := range c.intent.InputCoins {
+			prevTx, err := c.cfg.FetchTx(coin.OutPoint.Hash)
+			if err != nil {
+				return fmt.Errorf("unable to fetch previous tx "+
+					"of input %v: %v", coin.OutPoint, err)
+			}
+
+			c.pendingInputs = append(c.pendingInputs, &pendingInput{
+				prevTx:    prevTx,
+				prevTxOut: coin.OutPoint.Index,
+			})
+		}
+		c.pendingOutputs = append(
+			c.pendingOutputs, c.intent.ChangeOutputs...,
+		)
+	}
+
+	c.state = spliceTxNegotiation
+
+	return nil
+}
+
+// sendNextTxUpdate returns the next of our queued inputs and outputs, or
+// tx_complete once there are none left. If this completes the negotiation,
+// our signature for the splice version of the remote party's commitment is
+// returned as well.
+func (c *ChanSplicer) sendNextTxUpdate() ([]lnwire.Message, error) {
+	var (
+		msg lnwire.Message
+		err error
+	)
+	switch {
+	case c.sharedInputPending:
+		c.sharedInputPending = false
+
+		sharedInput := c.cfg.Channel.SpliceSharedInput().OutPoint
+
+		var serialID uint64
+		serialID, err = c.tx.AddSharedInput(spliceSequence)
+		msg = &lnwire.TxAddInput{
+			ChanID:          c.cid,
+			SerialID:        serialID,
+			PrevTxOut:       sharedInput.Index,
+			Sequence:        spliceSequence,
+			SharedInputTxid: &sharedInput.Hash,
+		}
+
+	case len(c.pendingInputs) > 0:
+		in := c.pendingInputs[0]
+		c.pendingInputs = c.pendingInputs[1:]
+
+		var prevTx bytes.Buffer
+		if err := in.prevTx.Serialize(&prevTx); err != nil {
+			return nil, err
+		}
+
+		var serialID uint64
+		serialID, err = c.tx.AddLocalInput(
+			in.prevTx, in.prevTxOut, spliceSequence,
+		)
+		msg = &lnwire.TxAddInput{
+			ChanID:    c.cid,
+			SerialID:  serialID,
+			PrevTx:    prevTx.Bytes(),
+			PrevTxOut: in.prevTxOut,
+			Sequence:  spliceSequence,
+		}
+
+	case len(c.pendingOutputs) > 0:
+		output := c.pendingOutputs[0]
+		c.pendingOutputs = c.pendingOutputs[1:]
+
+		var serialID uint64
+		serialID, err = c.tx.AddLocalOutput(output)
+		msg = &lnwire.TxAddOutput{
+			ChanID:   c.cid,
+			SerialID: serialID,
+			Amount:   ltcutil.Amount(output.Value),
+			PkScript: output.PkScript,
+		}
+
+	default:
+		err = c.tx.SendComplete()
+		msg = &lnwire.TxComplete{
+			ChanID: c.cid,
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to update splice tx: %w", err)
+	}
+
+	msgs := []lnwire.Message{msg}
+	if !c.tx.IsComplete() {
+		return msgs, nil
+	}
+
+	commitSig, err := c.completeSpliceTx()
+	if err != nil {
+		return nil, err
+	}
+
+	return append(msgs, commitSig), nil
+}
+
+// handleInteractiveTxMsg processes an update of the splice transaction that
+// was sent by the remote party. Unless this completes the negotiation, we
+// respond with our own next update.
+func (c *ChanSplicer) handleInteractiveTxMsg(
+	msg lnwire.Message) ([]lnwire.Message, error) {
+
+	var err error
+	switch msg := msg.(type) {
+	case *lnwire.TxAddInput:
+		if msg.SharedInputTxid != nil {
+			err = c.tx.ReceiveAddSharedInput(
+				msg.SerialID, *msg.SharedInputTxid,
+				msg.PrevTxOut, msg.Sequence,
+			)
+			break
+		}
+
+		err = c.tx.ReceiveAddInput(
+			msg.SerialID, msg.PrevTx, msg.PrevTxOut, msg.Sequence,
+		)
+
+	case *lnwire.TxAddOutput:
+		err = c.tx.ReceiveAddOutput(
+			msg.SerialID, msg.Amount, msg.PkScript,
+		)
+
+	case *lnwire.TxRemoveInput:
+		err = c.tx.ReceiveRemoveInput(msg.SerialID)
+
+	case *lnwire.TxRemoveOutput:
+		err = c.tx.ReceiveRemoveOutput(msg.SerialID)
+
+	case *lnwire.TxComplete:
+		err = c.tx.ReceiveComplete()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v: %w", msg.MsgType(), err)
+	}
+
+	if !c.tx.IsComplete() {
+		return c.sendNextTxUpdate()
+	}
+
+	commitSig, err := c.completeSpliceTx()
+	if err != nil {
+		return nil, err
+	}
+
+	return []lnwire.Message{commitSig}, nil
+}
+
+// completeSpliceTx creates the splice versions of the current commitments of
+// the channel for the negotiated splice transaction, and returns our
+// signature for the remote party's version.
+func (c *ChanSplicer) completeSpliceTx() (*lnwire.CommitSig, error) {
+	spliceTx, err := c.tx.Tx()
+	if err != nil {
+		return nil, err
+	}
+
+	channel := c.cfg.Channel
+	capacity := channel.Capacity + c.localContribution +
+		c.remoteContribution
+	fundingOutput := channel.SpliceFundingOutput(capacity)
+
+	fundingIndex := -1
+	for i, txOut := range spliceTx.TxOut {
+		if txOut.Value == fundingOutput.Value &&
+			bytes.Equal(txOut.PkScript, fundingOutput.PkScript) {
+
+			fundingIndex = i
+			break
+		}
+	}
+	if fundingIndex < 0 {
+		return nil, chanfunding.ErrMissingFundingOutput
+	}
+
+	splice := &channeldb.ChannelSplice{
+		FundingOutpoint: wire.OutPoint{
+			Hash:  spliceTx.TxHash(),
+			Index: uint32(fundingIndex),
+		},
+		FundingTx:          spliceTx,
+		Capacity:           capacity,
+		LocalContribution:  c.localContribution,
+		RemoteContribution: c.remoteContribution,
+	}
+	sig, err := channel.SignSpliceCommitment(splice)
+	if err != nil {
+		return nil, err
+	}
+
+	c.spliceTx = spliceTx
+	c.state = spliceCommitSigs
+
+	chansplicerLog.Infof("ChannelPoint(%v): negotiated splice "+
+		"transaction %v", channel.ChannelPoint(), spliceTx.TxHash())
+
+	return &lnwire.CommitSig{
+		ChanID:    c.cid,
+		CommitSig: sig,
+		HtlcSigs:  []lnwire.Sig{},
+	}, nil
+}
+
+// handleCommitSig processes the remote party's signature for the splice
+// version of our current commitment. Once it's verified, the splice is
+// pending, and we send our signatures for the splice transaction if it's our
+// turn to do so.
+func (c *ChanSplicer) handleCommitSig(
+	msg *lnwire.CommitSig) ([]lnwire.Message, error) {
+
+	if len(msg.HtlcSigs) != 0 {
+		return nil, fmt.Errorf("splice commitment with %d htlc sigs",
+			len(msg.HtlcSigs))
+	}
+
+	err := c.cfg.Channel.ReceiveSpliceCommitSig(msg.CommitSig)
+	if err != nil {
+		return nil, err
+	}
+	c.state = spliceTxSigs
+
+	if !c.tx.SendSignaturesFirst() {
+		return nil, nil
+	}
+
+	txSigs, err := c.signSpliceTx()
+	if err != nil {
+		return nil, err
+	}
+
+	return []lnwire.Message{txSigs}, nil
+}
+
+// signSpliceTx signs our inputs of the splice transaction as well as the
+// shared input, and returns the signatures to send to the remote party.
+func (c *ChanSplicer) signSpliceTx() (*lnwire.TxSignatures, error) {
+	spliceTx := c.spliceTx
+	if c.intent != nil && len(c.intent.InputCoins) > 0 {
+		if err := c.intent.SignInputs(spliceTx); err != nil {
+			return nil, err
+		}
+	}
+
+	// The witnesses are sent in the order of the serial IDs of our
+	// inputs, which is the order of the inputs in the transaction. The
+	// shared input is signed by both parties, so its signature is sent
+	// separately.
+	witnesses := make([]wire.TxWitness, 0)
+	for i, in := range c.tx.Inputs() {
+		if in.Local && in.Shared == nil {
+			witnesses = append(witnesses, spliceTx.TxIn[i].Witness)
+		}
+	}
+
+	sharedIndex, err := c.tx.SharedInputIndex()
+	if err != nil {
+		return nil, err
+	}
+	sharedSig, err := c.cfg.Channel.SignSpliceFundingInput(
+		spliceTx, sharedIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.sentTxSigs = true
+
+	return &lnwire.TxSignatures{
+		ChanID:         c.cid,
+		TxHash:         spliceTx.TxHash(),
+		Witnesses:      witnesses,
+		SharedInputSig: &sharedSig,
+	}, nil
+}
+
+// handleTxSignatures processes the remote party's signatures for the splice
+// transaction. With those, the splice transaction is complete and is
+// broadcast.
+func (c *ChanSplicer) handleTxSignatures(
+	msg *lnwire.TxSignatures) ([]lnwire.Message, error) {
+
+	spliceTx := c.spliceTx
+	if msg.TxHash != spliceTx.TxHash() {
+		return nil, fmt.Errorf("tx signatures for unknown tx %v",
+			msg.TxHash)
+	}
+	if msg.SharedInputSig == nil {
+		return nil, fmt.Errorf("tx signatures without signature for " +
+			"shared input")
+	}
+
+	err := c.tx.AddRemoteWitnesses(spliceTx, msg.Witnesses)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []lnwire.Message
+	if !c.sentTxSigs {
+		txSigs, err := c.signSpliceTx()
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, txSigs)
+	}
+
+	sharedIndex, err := c.tx.SharedInputIndex()
+	if err != nil {
+		return nil, err
+	}
+	err = c.cfg.Channel.AddSpliceFundingWitness(
+		spliceTx, sharedIndex, *msg.SharedInputSig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cfg.Channel.UpdateSpliceTx(spliceTx); err != nil {
+		return nil, err
+	}
+	c.state = spliceFinished
+
+	chanPoint := c.cfg.Channel.ChannelPoint()
+	chansplicerLog.Infof("ChannelPoint(%v): broadcasting splice tx: %v",
+		chanPoint, newLogClosure(func() string {
+			return spew.Sdump(spliceTx)
+		}))
+
+	// The remote party may broadcast the transaction as well, so a
+	// failure to do so isn't fatal.
+	label := labels.MakeLabel(labels.LabelTypeChannelSplice, nil)
+	if err := c.cfg.BroadcastTx(spliceTx, label); err != nil {
+		chansplicerLog.Errorf("ChannelPoint(%v): unable to broadcast "+
+			"splice tx: %v", chanPoint, err)
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,212 @@
+package chansplicer
+
+import (
+	"testing"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/lnwallet/chainfee"
+	"github.com/ltcsuite/lnd/lnwallet/chanfunding"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// testFeeRate is the fee rate of the splice transactions in the tests.
+const testFeeRate = chainfee.SatPerKWeight(1000)
+
+// spliceOutScript is the script that the funds spliced out in the tests are
+// paid to.
+var spliceOutScript = append([]byte{0x00, 0x14}, make([]byte, 20)...)
+
+// newTestSplicers creates a splice state machine for both parties of a test
+// channel. Alice initiates the splice with the given contribution, which must
+// be negative as the tests don't have any wallet inputs. The transactions
+// broadcast by both parties are sent on the returned channel.
+func newTestSplicers(t *testing.T, contribution ltcutil.Amount) (*ChanSplicer,
+	*ChanSplicer, chan *wire.MsgTx) {
+
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels(
+		channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	broadcasts := make(chan *wire.MsgTx, 2)
+	newCfg := func(channel *lnwallet.LightningChannel) ChanSpliceCfg {
+		return ChanSpliceCfg{
+			Channel: channel,
+			ProvisionSplice: func(amt ltcutil.Amount,
+				feeRate chainfee.SatPerKWeight, initiator bool,
+				fundingOutput *wire.TxOut) (
+				*chanfunding.FullIntent, error) {
+
+				spliceOut := &wire.TxOut{PkScript: spliceOutScript}
+				weight := chanfunding.ContributionWeight(
+					initiator, true, 0,
+					[]*wire.TxOut{spliceOut}, fundingOutput,
+				)
+				fee := feeRate.FeeForWeight(weight)
+				spliceOut.Value = int64(-amt - fee)
+
+				return &chanfunding.FullIntent{
+					ChangeOutputs: []*wire.TxOut{spliceOut},
+				}, nil
+			},
+			FetchTx: func(chainhash.Hash) (*wire.MsgTx, error) {
+				t.Fatalf("unexpected fetch of wallet tx")
+				return nil, nil
+			},
+			BroadcastTx: func(tx *wire.MsgTx, _ string) error {
+				broadcasts <- tx
+				return nil
+			},
+		}
+	}
+
+	req := &ChanSplice{
+		ChanPoint:    aliceChannel.ChannelPoint(),
+		Contribution: contribution,
+		FeeRate:      testFeeRate,
+	}
+	alice := NewChanSplicer(newCfg(aliceChannel), req)
+	bob := NewChanSplicer(newCfg(bobChannel), nil)
+
+	return alice, bob, broadcasts
+}
+
+// exchangeMsgs delivers the passed messages of one party to the other one,
+// and keeps delivering their responses until both parties have nothing left
+// to send. The number of parties that finished the negotiation is returned.
+func exchangeMsgs(t *testing.T, from, to *ChanSplicer,
+	msgs []lnwire.Message) int {
+
+	var finished int
+	for len(msgs) > 0 {
+		var next []lnwire.Message
+		for _, msg := range msgs {
+			resp, done, err := to.ProcessSpliceMsg(msg)
+			require.NoError(t, err, "unable to process %v",
+				msg.MsgType())
+
+			if done {
+				finished++
+			}
+			next = append(next, resp...)
+		}
+
+		msgs = next
+		from, to = to, from
+	}
+
+	return finished
+}
+
+// TestChanSplicerSpliceOut tests the negotiation of a splice that removes
+// funds from a channel, from the splice_init message until both parties
+// broadcast the fully signed splice transaction.
+func TestChanSplicerSpliceOut(t *testing.T) {
+	t.Parallel()
+
+	const spliceOut = ltcutil.Amount(ltcutil.SatoshiPerBitcoin / 2)
+	alice, bob, broadcasts := newTestSplicers(t, -spliceOut)
+
+	aliceChannel := alice.Channel()
+	capacity := aliceChannel.Capacity
+
+	spliceInit, err := alice.InitSplice(100)
+	require.NoError(t, err)
+	require.Equal(t, -spliceOut, spliceInit.FundingContribution)
+
+	// A second splice_init can't be processed by the initiator.
+	_, _, err = alice.ProcessSpliceMsg(spliceInit)
+	require.ErrorIs(t, err, ErrInvalidState)
+
+	finished := exchangeMsgs(
+		t, alice, bob, []lnwire.Message{spliceInit},
+	)
+	require.Equal(t, 2, finished)
+
+	// Both parties now have a pending splice for the same transaction,
+	// which reduces the capacity of the channel.
+	aliceSplice := aliceChannel.PendingSplice()
+	bobSplice := bob.Channel().PendingSplice()
+	require.NotNil(t, aliceSplice)
+	require.NotNil(t, bobSplice)
+	require.Equal(t, aliceSplice.FundingOutpoint, bobSplice.FundingOutpoint)
+	require.Equal(t, capacity-spliceOut, aliceSplice.Capacity)
+	require.Equal(t, -spliceOut, aliceSplice.LocalContribution)
+	require.Equal(t, -spliceOut, bobSplice.RemoteContribution)
+
+	// Both parties broadcast the same, fully signed transaction, which
+	// spends the current funding output.
+	spliceTx, err := alice.SpliceTx()
+	require.NoError(t, err)
+	require.Equal(t, spliceTx.TxHash(), aliceSplice.FundingOutpoint.Hash)
+	require.Len(t, spliceTx.TxIn, 1)
+	require.Equal(
+		t, *aliceChannel.ChannelPoint(),
+		spliceTx.TxIn[0].PreviousOutPoint,
+	)
+	require.NotEmpty(t, spliceTx.TxIn[0].Witness)
+
+	for i := 0; i < 2; i++ {
+		tx := <-broadcasts
+		require.Equal(t, spliceTx.TxHash(), tx.TxHash())
+		require.NotEmpty(t, tx.TxIn[0].Witness)
+	}
+}
+
+// TestChanSplicerAbort tests that a splice which was aborted before the
+// signatures for the splice transaction were exchanged is removed from the
+// channel.
+func TestChanSplicerAbort(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, _ := newTestSplicers(t, -ltcutil.SatoshiPerBitcoin/2)
+
+	// A splice that doesn't change the capacity is rejected.
+	_, _, err := bob.ProcessSpliceMsg(&lnwire.SpliceInit{
+		FundingFeePerKWeight: uint32(testFeeRate),
+	})
+	require.ErrorIs(t, err, ErrZeroContribution)
+
+	spliceInit, err := alice.InitSplice(100)
+	require.NoError(t, err)
+
+	// Deliver all messages up to Alice's signature for the splice version
+	// of Bob's commitment. Bob holds on to his signatures for the splice
+	// transaction, as Alice doesn't contribute any inputs and has to send
+	// hers first.
+	msgs := []lnwire.Message{spliceInit}
+	from, to := alice, bob
+	for {
+		var next []lnwire.Message
+		for _, msg := range msgs {
+			resp, _, err := to.ProcessSpliceMsg(msg)
+			require.NoError(t, err)
+			next = append(next, resp...)
+		}
+
+		if to.Channel().PendingSplice() != nil {
+			break
+		}
+
+		msgs = next
+		from, to = to, from
+	}
+	require.Equal(t, bob, to)
+	require.False(t, bob.sentTxSigs)
+	require.Nil(t, alice.Channel().PendingSplice())
+
+	// Bob aborts the splice before Alice received his signature for her
+	// commitment, so the splice is removed from his channel.
+	require.NoError(t, bob.Abort())
+	require.Nil(t, bob.Channel().PendingSplice())
+	require.NoError(t, alice.Abort())
+
+	_, _, err = alice.ProcessSpliceMsg(&lnwire.TxAbort{})
+	require.Error(t, err)
+}
@@ -475,6 +475,13 @@ type CommitmentBuilder struct {
 	// obfuscator is a 48-bit state hint that's used to obfuscate the
 	// current state number on the commitment transactions.
 	obfuscator [StateHintSize]byte
+
+	// fundingOutpoint is the funding output spent by the commitment
+	// transactions.
+	fundingOutpoint wire.OutPoint
+
+	// capacity is the value of the funding output.
+	capacity ltcutil.Amount
 }
 
 // NewCommitmentBuilder creates a new CommitmentBuilder from chanState.
@@ -485,11 +492,31 @@ func NewCommitmentBuilder(chanState *channeldb.OpenChannel) *CommitmentBuilder {
 	}
 
 	return &CommitmentBuilder{
-		chanState:  chanState,
-		obfuscator: createStateHintObfuscator(chanState),
+		chanState:       chanState,
+		obfuscator:      createStateHintObfuscator(chanState),
+		fundingOutpoint: chanState.ActiveFundingOutpoint(),
+		capacity:        chanState.Capacity,
 	}
 }
 
+// newSpliceCommitmentBuilder creates a CommitmentBuilder for the commitment
+// transactions that spend the funding output of the given splice.
+func newSpliceCommitmentBuilder(chanState *channeldb.OpenChannel,
+	splice *channeldb.ChannelSplice) *CommitmentBuilder {
+
+	cb := NewCommitmentBuilder(chanState)
+	cb.fundingOutpoint = splice.FundingOutpoint
+	cb.capacity = splice.Capacity
+
+	return cb
+}
+
+// fundingTxIn returns the funding input of the commitment transactions
+// created by the builder.
+func (cb *CommitmentBuilder) fundingTxIn() wire.TxIn {
+	return *wire.NewTxIn(&cb.fundingOutpoint, nil, nil)
+}
+
 // createStateHintObfuscator derives and assigns the state hint obfuscator for
 // the channel, which is used to encode the commitment height in the sequence
 // number of commitment transaction inputs.
@@ -615,14 +642,14 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 	}
 	if isOurs {
 		commitTx, err = CreateCommitTx(
-			cb.chanState.ChanType, fundingTxIn(cb.chanState), keyRing,
+			cb.chanState.ChanType, cb.fundingTxIn(), keyRing,
 			&cb.chanState.LocalChanCfg, &cb.chanState.RemoteChanCfg,
 			ourBalance.ToSatoshis(), theirBalance.ToSatoshis(),
 			numHTLCs, cb.chanState.IsInitiator, leaseExpiry,
 		)
 	} else {
 		commitTx, err = CreateCommitTx(
-			cb.chanState.ChanType, fundingTxIn(cb.chanState), keyRing,
+			cb.chanState.ChanType, cb.fundingTxIn(), keyRing,
 			&cb.chanState.RemoteChanCfg, &cb.chanState.LocalChanCfg,
 			theirBalance.ToSatoshis(), ourBalance.ToSatoshis(),
 			numHTLCs, !cb.chanState.IsInitiator, leaseExpiry,
@@ -703,11 +730,11 @@ func (cb *CommitmentBuilder) createUnsignedCommitmentTx(ourBalance,
 	for _, txOut := range commitTx.TxOut {
 		totalOut += ltcutil.Amount(txOut.Value)
 	}
-	if totalOut+commitFee > cb.chanState.Capacity {
+	if totalOut+commitFee > cb.capacity {
 		return nil, fmt.Errorf("height=%v, for ChannelPoint(%v) "+
 			"attempts to consume %v while channel capacity is %v",
 			height, cb.chanState.FundingOutpoint,
-			totalOut+commitFee, cb.chanState.Capacity)
+			totalOut+commitFee, cb.capacity)
 	}
 
 	return &unsignedCommitmentTx{
@@ -2,6 +2,7 @@ package lnwallet
 
 import (
 	"errors"
+	"fmt"
 	"net"
 	"sync"
 
@@ -547,6 +548,27 @@ func (r *ChannelReservation) OurContribution() *ChannelContribution {
 	return r.ourContribution
 }
 
+// SecondCommitmentPoint returns the commitment point that we'll use for our
+// second commitment transaction. The dual funding protocol sends it along
+// with the first commitment point, as there's no funding_locked message that
+// would otherwise carry it.
+func (r *ChannelReservation) SecondCommitmentPoint() (*btcec.PublicKey, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	producer := r.partialState.RevocationProducer
+	if producer == nil {
+		return nil, fmt.Errorf("revocation producer not initialized")
+	}
+
+	secondPreimage, err := producer.AtIndex(1)
+	if err != nil {
+		return nil, err
+	}
+
+	return input.ComputeCommitmentPoint(secondPreimage[:]), nil
+}
+
 // ProcessContribution verifies the counterparty's contribution to the pending
 // payment channel. As a result of this incoming message, lnwallet is able to
 // build the funding transaction, and both commitment transactions. Once this
@@ -566,6 +588,96 @@ func (r *ChannelReservation) ProcessContribution(theirContribution *ChannelContr
 	return <-errChan
 }
 
+// ProcessInteractiveTx verifies the counterparty's contribution to a dual
+// funded channel, whose funding transaction was constructed interactively by
+// both parties. In contrast to ProcessContribution, the funding transaction
+// isn't assembled by the wallet, instead the passed transaction is signed as
+// is. Once this method returns, the signatures of our inputs are part of the
+// funding transaction, and our signature for the counterparty's version of the
+// commitment transaction is available via .OurSignatures().
+func (r *ChannelReservation) ProcessInteractiveTx(
+	theirContribution *ChannelContribution, fundingTx *wire.MsgTx) error {
+
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addContributionMsg{
+		pendingFundingID: r.reservationID,
+		contribution:     theirContribution,
+		interactiveTx:    fundingTx,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
+// SetRemoteFundingAmt sets the amount that the counterparty contributes to a
+// dual funded channel that we initiated, updating the capacity of the channel
+// and the initial balances of both parties accordingly.
+func (r *ChannelReservation) SetRemoteFundingAmt(amt ltcutil.Amount) error {
+	r.Lock()
+	defer r.Unlock()
+
+	intent, ok := r.fundingIntent.(*chanfunding.FullIntent)
+	if !ok {
+		return fmt.Errorf("dual funding requires a wallet funding " +
+			"intent")
+	}
+
+	err := r.setFundingAmts(intent.LocalFundingAmt(), amt)
+	if err != nil {
+		return err
+	}
+	intent.SetRemoteFundingAmt(amt)
+
+	return nil
+}
+
+// setFundingAmts sets the amounts that both parties contribute to a dual
+// funded channel. As only the initiator pays for the commitment transaction,
+// its fee is deducted from the initiator's balance.
+//
+// NOTE: The mutex of the reservation MUST be held when calling this method.
+func (r *ChannelReservation) setFundingAmts(localAmt,
+	remoteAmt ltcutil.Amount) error {
+
+	chanState := r.partialState
+	commitFee := chanState.LocalCommitment.CommitFee
+
+	feeMSat := lnwire.NewMSatFromSatoshis(commitFee)
+	if chanState.ChanType.HasAnchors() {
+		feeMSat += 2 * lnwire.NewMSatFromSatoshis(anchorSize)
+	}
+
+	ourBalance := lnwire.NewMSatFromSatoshis(localAmt)
+	theirBalance := lnwire.NewMSatFromSatoshis(remoteAmt)
+
+	defaultDust := r.wallet.Cfg.DefaultConstraints.DustLimit
+	funderBalance := &theirBalance
+	if chanState.IsInitiator {
+		funderBalance = &ourBalance
+	}
+	if *funderBalance <= feeMSat ||
+		(*funderBalance-feeMSat).ToSatoshis() <= 2*defaultDust {
+
+		return ErrFunderBalanceDust(
+			int64(commitFee), int64(funderBalance.ToSatoshis()),
+			int64(2*defaultDust),
+		)
+	}
+	*funderBalance -= feeMSat
+
+	r.ourContribution.FundingAmount = ourBalance.ToSatoshis()
+	r.theirContribution.FundingAmount = theirBalance.ToSatoshis()
+
+	chanState.Capacity = localAmt + remoteAmt
+	chanState.LocalCommitment.LocalBalance = ourBalance
+	chanState.LocalCommitment.RemoteBalance = theirBalance
+	chanState.RemoteCommitment.LocalBalance = ourBalance
+	chanState.RemoteCommitment.RemoteBalance = theirBalance
+
+	return nil
+}
+
 // IsPsbt returns true if there is a PSBT funding intent mapped to this
 // reservation.
 func (r *ChannelReservation) IsPsbt() bool {
@@ -769,6 +881,23 @@ func (r *ChannelReservation) LeaseExpiry() uint32 {
 	return r.partialState.ThawHeight
 }
 
+// IsInitiator returns true if we initiated the channel of this reservation.
+func (r *ChannelReservation) IsInitiator() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.partialState.IsInitiator
+}
+
+// IsZeroConf returns if the reservation's underlying channel type is
+// zero-conf.
+func (r *ChannelReservation) IsZeroConf() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.partialState.IsZeroConf()
+}
+
 // Cancel abandons this channel reservation. This method should be called in
 // the scenario that communications with the counterparty break down. Upon
 // cancellation, all resources previously reserved for this pending payment
@@ -0,0 +1,282 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ltcsuite/lnd/input"
+	"github.com/ltcsuite/ltcd/blockchain"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/ltcutil/txsort"
+	"github.com/ltcsuite/ltcd/txscript"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+// SimpleCloseSequence is the sequence of the funding input of a closing
+// transaction negotiated with the simple close protocol. As opposed to the
+// final sequence used by the legacy closing transactions, it signals opt-in
+// replaceability, so the transaction can be replaced by a version that pays
+// a higher fee.
+const SimpleCloseSequence = wire.MaxTxInSequenceNum - 2
+
+var (
+	// ErrCloserBalanceTooLow is returned when the closer of a simple close
+	// can't pay the fee of the closing transaction out of its balance.
+	ErrCloserBalanceTooLow = errors.New("closer balance too low to pay " +
+		"closing fee")
+
+	// ErrCloseOutputDust is returned when an output of a simple closing
+	// transaction would fall below the dust limit of its owner.
+	ErrCloseOutputDust = errors.New("closing transaction output below " +
+		"dust limit")
+)
+
+// SimpleCloseOutputs denotes which of the parties' outputs are included in a
+// closing transaction negotiated with the simple close protocol.
+type SimpleCloseOutputs uint8
+
+const (
+	// CloserAndCloseeOutputs denotes a closing transaction that pays to
+	// both parties.
+	CloserAndCloseeOutputs SimpleCloseOutputs = iota
+
+	// CloserNoCloseeOutput denotes a closing transaction that omits the
+	// output of the closee, which is below its dust limit.
+	CloserNoCloseeOutput
+
+	// NoCloserOutput denotes a closing transaction that omits the output
+	// of the closer, whose remaining balance after paying the fee is below
+	// its dust limit.
+	NoCloserOutput
+)
+
+// String returns a human readable description of the closing transaction
+// outputs.
+func (s SimpleCloseOutputs) String() string {
+	switch s {
+	case CloserAndCloseeOutputs:
+		return "closer_and_closee_outputs"
+	case CloserNoCloseeOutput:
+		return "closer_no_closee_output"
+	case NoCloserOutput:
+		return "no_closer_output"
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(s))
+	}
+}
+
+// SimpleCloseTx describes a closing transaction negotiated with the simple
+// close protocol. The closer pays the entire fee of the transaction out of its
+// own balance, and the balance of the closee is paid out in full.
+type SimpleCloseTx struct {
+	// LocalIsCloser is true if we are the closer, which pays the fee of
+	// the transaction.
+	LocalIsCloser bool
+
+	// Fee is the fee that the closer pays for the transaction.
+	Fee ltcutil.Amount
+
+	// LockTime is the lock time of the transaction.
+	LockTime uint32
+
+	// Outputs denotes which of the parties' outputs the transaction
+	// includes.
+	Outputs SimpleCloseOutputs
+
+	// LocalDeliveryScript is the script that our balance is paid to.
+	LocalDeliveryScript []byte
+
+	// RemoteDeliveryScript is the script that the balance of the remote
+	// party is paid to.
+	RemoteDeliveryScript []byte
+}
+
+// SimpleCloseBalances returns our and the remote party's balance in a closing
+// transaction negotiated with the simple close protocol, given the fee that
+// the closer pays. ErrCloserBalanceTooLow is returned if the closer can't
+// afford the fee.
+func (lc *LightningChannel) SimpleCloseBalances(localIsCloser bool,
+	fee ltcutil.Amount) (ltcutil.Amount, ltcutil.Amount, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.simpleCloseBalances(localIsCloser, fee)
+}
+
+// simpleCloseBalances returns our and the remote party's balance in a simple
+// closing transaction.
+//
+// NOTE: This method MUST be called with the channel's lock held.
+func (lc *LightningChannel) simpleCloseBalances(localIsCloser bool,
+	fee ltcutil.Amount) (ltcutil.Amount, ltcutil.Amount, error) {
+
+	// We start out with the balances of a closing transaction without any
+	// fee, which returns the commitment fee to the channel initiator.
+	ourBalance, theirBalance, err := CoopCloseBalance(
+		lc.channelState.ChanType, lc.channelState.IsInitiator, 0,
+		lc.channelState.LocalCommitment,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// The closer then pays the entire fee of the closing transaction,
+	// regardless of which party opened the channel.
+	if localIsCloser {
+		ourBalance -= fee
+	} else {
+		theirBalance -= fee
+	}
+
+	if fee < 0 || ourBalance < 0 || theirBalance < 0 {
+		return 0, 0, ErrCloserBalanceTooLow
+	}
+
+	return ourBalance, theirBalance, nil
+}
+
+// createSimpleCloseTx creates the unsigned closing transaction described by
+// the passed parameters.
+//
+// NOTE: This method MUST be called with the channel's lock held.
+func (lc *LightningChannel) createSimpleCloseTx(
+	params *SimpleCloseTx) (*wire.MsgTx, error) {
+
+	// The closing transaction would spend a funding output that may be
+	// double spent by a pending splice transaction.
+	if lc.splice != nil {
+		return nil, ErrSplicePending
+	}
+
+	ourBalance, theirBalance, err := lc.simpleCloseBalances(
+		params.LocalIsCloser, params.Fee,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Depending on the variant of the transaction, we'll omit either the
+	// output of the closer or the closee.
+	includeLocal, includeRemote := true, true
+	switch params.Outputs {
+	case CloserAndCloseeOutputs:
+
+	case CloserNoCloseeOutput:
+		includeLocal = params.LocalIsCloser
+		includeRemote = !params.LocalIsCloser
+
+	case NoCloserOutput:
+		includeLocal = !params.LocalIsCloser
+		includeRemote = params.LocalIsCloser
+
+	default:
+		return nil, fmt.Errorf("unknown closing transaction "+
+			"outputs: %v", params.Outputs)
+	}
+
+	// The funding input signals replaceability, so that the closer can
+	// replace the transaction with one that pays a higher fee.
+	txIn := fundingTxIn(lc.channelState)
+	txIn.Sequence = SimpleCloseSequence
+
+	closeTx := wire.NewMsgTx(2)
+	closeTx.LockTime = params.LockTime
+	closeTx.AddTxIn(&txIn)
+
+	// All outputs that are included must be above the dust limit of
+	// their owner.
+	if includeLocal {
+		if ourBalance < lc.channelState.LocalChanCfg.DustLimit {
+			return nil, ErrCloseOutputDust
+		}
+
+		closeTx.AddTxOut(&wire.TxOut{
+			PkScript: params.LocalDeliveryScript,
+			Value:    int64(ourBalance),
+		})
+	}
+	if includeRemote {
+		if theirBalance < lc.channelState.RemoteChanCfg.DustLimit {
+			return nil, ErrCloseOutputDust
+		}
+
+		closeTx.AddTxOut(&wire.TxOut{
+			PkScript: params.RemoteDeliveryScript,
+			Value:    int64(theirBalance),
+		})
+	}
+
+	txsort.InPlaceSort(closeTx)
+
+	// Ensure that the transaction doesn't explicitly violate any
+	// consensus rules such as being too big, or having any value with a
+	// negative output.
+	tx := ltcutil.NewTx(closeTx)
+	if err := blockchain.CheckTransactionSanity(tx); err != nil {
+		return nil, err
+	}
+
+	return closeTx, nil
+}
+
+// CreateSimpleCloseProposal creates and signs the closing transaction
+// described by the passed parameters. Our signature is returned along with
+// the unsigned transaction.
+//
+// NOTE: As opposed to CreateCloseProposal, this may also be called after a
+// prior version of the closing transaction was completed, as the transaction
+// can be replaced by one paying a higher fee until it confirms.
+func (lc *LightningChannel) CreateSimpleCloseProposal(
+	params *SimpleCloseTx) (input.Signature, *wire.MsgTx, error) {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	closeTx, err := lc.createSimpleCloseTx(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	lc.signDesc.SigHashes = txscript.NewTxSigHashes(closeTx)
+	sig, err := lc.Signer.SignOutputRaw(closeTx, lc.signDesc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Indicate in the channel status that a channel closure has been
+	// initiated, unless a closing transaction was already completed.
+	if lc.status != channelClosed {
+		lc.status = channelClosing
+	}
+
+	return sig, closeTx, nil
+}
+
+// CompleteSimpleCooperativeClose completes the closing transaction described
+// by the passed parameters with both parties' signatures. The fully signed
+// transaction is returned once the remote party's signature was verified.
+//
+// NOTE: The passed local and remote sigs are expected to be fully complete
+// signatures including the proper sighash byte.
+func (lc *LightningChannel) CompleteSimpleCooperativeClose(
+	params *SimpleCloseTx, localSig,
+	remoteSig input.Signature) (*wire.MsgTx, error) {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	closeTx, err := lc.createSimpleCloseTx(params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lc.attachCloseWitness(closeTx, localSig, remoteSig)
+	if err != nil {
+		return nil, err
+	}
+
+	lc.status = channelClosed
+
+	return closeTx, nil
+}
@@ -0,0 +1,141 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSimpleCooperativeClose tests that a closing transaction negotiated with
+// the simple close protocol is paid for entirely by the closer, and that it
+// can be replaced by one with a higher fee after it was completed.
+func TestSimpleCooperativeClose(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(
+		channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+	defer cleanUp()
+
+	aliceDeliveryScript := bobsPrivKey[:]
+	bobDeliveryScript := testHdSeed[:]
+
+	aliceBalance, bobBalance, err := aliceChannel.SimpleCloseBalances(
+		false, 0,
+	)
+	require.NoError(t, err)
+
+	// Bob, who didn't open the channel, is the closer. His close must be
+	// paid out of his own balance rather than that of the initiator.
+	closeWithFee := func(fee ltcutil.Amount) ltcutil.Amount {
+		bobParams := &SimpleCloseTx{
+			LocalIsCloser:        true,
+			Fee:                  fee,
+			LockTime:             100,
+			Outputs:              CloserAndCloseeOutputs,
+			LocalDeliveryScript:  bobDeliveryScript,
+			RemoteDeliveryScript: aliceDeliveryScript,
+		}
+		bobSig, _, err := bobChannel.CreateSimpleCloseProposal(
+			bobParams,
+		)
+		require.NoError(t, err)
+
+		aliceParams := &SimpleCloseTx{
+			LocalIsCloser:        false,
+			Fee:                  fee,
+			LockTime:             100,
+			Outputs:              CloserAndCloseeOutputs,
+			LocalDeliveryScript:  aliceDeliveryScript,
+			RemoteDeliveryScript: bobDeliveryScript,
+		}
+		aliceSig, _, err := aliceChannel.CreateSimpleCloseProposal(
+			aliceParams,
+		)
+		require.NoError(t, err)
+
+		aliceCloseTx, err := aliceChannel.
+			CompleteSimpleCooperativeClose(
+				aliceParams, aliceSig, bobSig,
+			)
+		require.NoError(t, err)
+
+		bobCloseTx, err := bobChannel.CompleteSimpleCooperativeClose(
+			bobParams, bobSig, aliceSig,
+		)
+		require.NoError(t, err)
+		require.Equal(t, aliceCloseTx.TxHash(), bobCloseTx.TxHash())
+
+		// The transaction signals replaceability and uses the lock
+		// time of the closer.
+		require.Equal(
+			t, uint32(SimpleCloseSequence),
+			aliceCloseTx.TxIn[0].Sequence,
+		)
+		require.Equal(t, uint32(100), aliceCloseTx.LockTime)
+
+		// Alice's output is paid out in full, while Bob pays the fee.
+		require.Len(t, aliceCloseTx.TxOut, 2)
+		var outputTotal ltcutil.Amount
+		for _, txOut := range aliceCloseTx.TxOut {
+			amt := ltcutil.Amount(txOut.Value)
+			outputTotal += amt
+
+			switch string(txOut.PkScript) {
+			case string(aliceDeliveryScript):
+				require.Equal(t, aliceBalance, amt)
+			case string(bobDeliveryScript):
+				require.Equal(t, bobBalance-fee, amt)
+			default:
+				t.Fatalf("unexpected output script: %x",
+					txOut.PkScript)
+			}
+		}
+
+		return aliceChannel.Capacity - outputTotal
+	}
+
+	// Bob first closes with a low fee, and then replaces the transaction
+	// with a version that pays a higher fee.
+	require.Equal(t, ltcutil.Amount(1000), closeWithFee(1000))
+	require.Equal(t, ltcutil.Amount(5000), closeWithFee(5000))
+
+	// Bob can't pay a fee that exceeds his balance.
+	_, _, err = bobChannel.CreateSimpleCloseProposal(&SimpleCloseTx{
+		LocalIsCloser:        true,
+		Fee:                  bobBalance + 1,
+		Outputs:              NoCloserOutput,
+		LocalDeliveryScript:  bobDeliveryScript,
+		RemoteDeliveryScript: aliceDeliveryScript,
+	})
+	require.ErrorIs(t, err, ErrCloserBalanceTooLow)
+
+	// If Bob's output is omitted, Alice still receives her full balance.
+	bobSig, _, err := bobChannel.CreateSimpleCloseProposal(&SimpleCloseTx{
+		LocalIsCloser:        true,
+		Fee:                  bobBalance,
+		Outputs:              NoCloserOutput,
+		LocalDeliveryScript:  bobDeliveryScript,
+		RemoteDeliveryScript: aliceDeliveryScript,
+	})
+	require.NoError(t, err)
+
+	aliceParams := &SimpleCloseTx{
+		Fee:                  bobBalance,
+		Outputs:              NoCloserOutput,
+		LocalDeliveryScript:  aliceDeliveryScript,
+		RemoteDeliveryScript: bobDeliveryScript,
+	}
+	aliceSig, _, err := aliceChannel.CreateSimpleCloseProposal(aliceParams)
+	require.NoError(t, err)
+
+	closeTx, err := aliceChannel.CompleteSimpleCooperativeClose(
+		aliceParams, aliceSig, bobSig,
+	)
+	require.NoError(t, err)
+	require.Len(t, closeTx.TxOut, 1)
+	require.Equal(t, int64(aliceBalance), closeTx.TxOut[0].Value)
+}
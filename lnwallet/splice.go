@@ -0,0 +1,585 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/input"
+	"github.com/ltcsuite/lnd/lnwallet/chanfunding"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/txscript"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+var (
+	// ErrSplicePending is returned when an operation that isn't allowed
+	// while the transaction of a splice is unconfirmed is attempted, such
+	// as starting another splice or closing the channel cooperatively.
+	ErrSplicePending = errors.New("channel has a pending splice")
+
+	// ErrNoSpliceProposal is returned when the signature for the splice
+	// version of our commitment is received before a splice was proposed.
+	ErrNoSpliceProposal = errors.New("no splice is being negotiated")
+
+	// ErrMissingSpliceSigs is returned when the remote party doesn't send
+	// the signatures for the splice version of a new commitment while a
+	// splice is pending.
+	ErrMissingSpliceSigs = errors.New("commitment is missing signatures " +
+		"for the pending splice")
+
+	// ErrSpliceBalanceTooLow is returned when a party's balance can't
+	// cover the funds it splices out of the channel.
+	ErrSpliceBalanceTooLow = errors.New("balance too low to cover " +
+		"splice out")
+)
+
+// spliceProposal holds the splice versions of the current commitments of the
+// channel while a new splice is being negotiated. Once the remote party's
+// signature for our version is verified, the splice becomes pending.
+type spliceProposal struct {
+	// splice is the splice being negotiated.
+	splice *channeldb.ChannelSplice
+
+	// builder creates the commitments spending the splice's funding
+	// output.
+	builder *CommitmentBuilder
+
+	// signDesc is the sign descriptor for the splice's funding output.
+	signDesc *input.SignDescriptor
+
+	// localCommit and remoteCommit are the splice versions of the current
+	// local and remote commitments.
+	localCommit  *commitment
+	remoteCommit *commitment
+
+	// localKeyRing is the key ring of our current commitment.
+	localKeyRing *CommitmentKeyRing
+}
+
+// newSpliceSignDesc returns a copy of the given funding sign descriptor for
+// the funding output of the passed splice.
+func newSpliceSignDesc(signDesc *input.SignDescriptor,
+	splice *channeldb.ChannelSplice) *input.SignDescriptor {
+
+	return &input.SignDescriptor{
+		KeyDesc:       signDesc.KeyDesc,
+		WitnessScript: signDesc.WitnessScript,
+		Output: &wire.TxOut{
+			PkScript: signDesc.Output.PkScript,
+			Value:    int64(splice.Capacity),
+		},
+		HashType:   txscript.SigHashAll,
+		InputIndex: 0,
+	}
+}
+
+// setSplice marks the passed splice as the pending splice of the channel.
+func (lc *LightningChannel) setSplice(splice *channeldb.ChannelSplice) {
+	lc.splice = splice
+	lc.spliceBuilder = newSpliceCommitmentBuilder(lc.channelState, splice)
+	lc.spliceSignDesc = newSpliceSignDesc(lc.signDesc, splice)
+}
+
+// applySpliceContribution returns the balance that results from adding the
+// given splice contribution, which is negative for funds spliced out, to the
+// passed balance.
+func applySpliceContribution(balance lnwire.MilliSatoshi,
+	contribution ltcutil.Amount) (lnwire.MilliSatoshi, error) {
+
+	if contribution >= 0 {
+		return balance + lnwire.NewMSatFromSatoshis(contribution), nil
+	}
+
+	spliceOut := lnwire.NewMSatFromSatoshis(-contribution)
+	if spliceOut > balance {
+		return 0, ErrSpliceBalanceTooLow
+	}
+
+	return balance - spliceOut, nil
+}
+
+// newSpliceCommitment creates the version of the passed commitment that spends
+// the funding output of the given splice. The passed balances are the ones of
+// the commitment before subtracting the commitment fee.
+func (lc *LightningChannel) newSpliceCommitment(view *commitment,
+	builder *CommitmentBuilder, splice *channeldb.ChannelSplice,
+	ourBalance, theirBalance lnwire.MilliSatoshi,
+	filteredHTLCView *htlcView,
+	keyRing *CommitmentKeyRing) (*commitment, error) {
+
+	ourBalance, err := applySpliceContribution(
+		ourBalance, splice.LocalContribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+	theirBalance, err = applySpliceContribution(
+		theirBalance, splice.RemoteContribution,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	commitTx, err := builder.createUnsignedCommitmentTx(
+		ourBalance, theirBalance, view.isOurs, view.feePerKw,
+		view.height, filteredHTLCView, keyRing,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &commitment{
+		ourBalance:        commitTx.ourBalance,
+		theirBalance:      commitTx.theirBalance,
+		txn:               commitTx.txn,
+		fee:               commitTx.fee,
+		ourMessageIndex:   view.ourMessageIndex,
+		ourHtlcIndex:      view.ourHtlcIndex,
+		theirMessageIndex: view.theirMessageIndex,
+		theirHtlcIndex:    view.theirHtlcIndex,
+		height:            view.height,
+		feePerKw:          view.feePerKw,
+		dustLimit:         view.dustLimit,
+		isOurs:            view.isOurs,
+	}
+
+	// The HTLC's are copied over as well, since their output indexes may
+	// differ from the ones within the original commitment.
+	c.outgoingHTLCs = make([]PaymentDescriptor, len(view.outgoingHTLCs))
+	copy(c.outgoingHTLCs, view.outgoingHTLCs)
+	c.incomingHTLCs = make([]PaymentDescriptor, len(view.incomingHTLCs))
+	copy(c.incomingHTLCs, view.incomingHTLCs)
+
+	err = c.populateHtlcIndexes(lc.channelState.ChanType, commitTx.cltvs)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// fetchSpliceCommitmentView creates the splice version of a new commitment
+// view for the pending splice of the channel.
+func (lc *LightningChannel) fetchSpliceCommitmentView(view *commitment,
+	ourBalance, theirBalance lnwire.MilliSatoshi,
+	filteredHTLCView *htlcView,
+	keyRing *CommitmentKeyRing) (*commitment, error) {
+
+	return lc.newSpliceCommitment(
+		view, lc.spliceBuilder, lc.splice, ourBalance, theirBalance,
+		filteredHTLCView, keyRing,
+	)
+}
+
+// spliceVersionOf creates the splice version of a current commitment of the
+// channel, which must not carry any HTLC's.
+func (lc *LightningChannel) spliceVersionOf(c *commitment,
+	builder *CommitmentBuilder, splice *channeldb.ChannelSplice,
+	keyRing *CommitmentKeyRing) (*commitment, error) {
+
+	// The balances of the commitment don't include the commitment fee
+	// anymore, so we'll add it back to the balance of the initiator.
+	ourBalance, theirBalance := c.ourBalance, c.theirBalance
+	commitFee := lnwire.NewMSatFromSatoshis(c.fee)
+	if lc.channelState.IsInitiator {
+		ourBalance += commitFee
+	} else {
+		theirBalance += commitFee
+	}
+
+	return lc.newSpliceCommitment(
+		c, builder, splice, ourBalance, theirBalance,
+		&htlcView{feePerKw: c.feePerKw}, keyRing,
+	)
+}
+
+// SignSpliceCommitment creates the versions of the current commitments of the
+// channel that spend the funding output of the given splice, and returns our
+// signature for the remote party's version. The splice must be complete
+// except for the signatures of its transaction, and the channel must be clean
+// so neither commitment carries any HTLC's.
+func (lc *LightningChannel) SignSpliceCommitment(
+	splice *channeldb.ChannelSplice) (lnwire.Sig, error) {
+
+	var sig lnwire.Sig
+
+	if !lc.IsChannelClean() {
+		return sig, fmt.Errorf("channel %v must be clean to be spliced",
+			lc.channelState.FundingOutpoint)
+	}
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	if lc.splice != nil {
+		return sig, ErrSplicePending
+	}
+
+	// We'll need the key rings of both current commitments to create
+	// their splice versions.
+	localCommitSecret, err := lc.channelState.RevocationProducer.AtIndex(
+		lc.currentHeight,
+	)
+	if err != nil {
+		return sig, err
+	}
+	localKeyRing := DeriveCommitmentKeys(
+		input.ComputeCommitmentPoint(localCommitSecret[:]), true,
+		lc.channelState.ChanType, &lc.channelState.LocalChanCfg,
+		&lc.channelState.RemoteChanCfg,
+	)
+	remoteKeyRing := DeriveCommitmentKeys(
+		lc.channelState.RemoteCurrentRevocation, false,
+		lc.channelState.ChanType, &lc.channelState.LocalChanCfg,
+		&lc.channelState.RemoteChanCfg,
+	)
+
+	builder := newSpliceCommitmentBuilder(lc.channelState, splice)
+	localCommit, err := lc.spliceVersionOf(
+		lc.localCommitChain.tail(), builder, splice, localKeyRing,
+	)
+	if err != nil {
+		return sig, err
+	}
+	remoteCommit, err := lc.spliceVersionOf(
+		lc.remoteCommitChain.tail(), builder, splice, remoteKeyRing,
+	)
+	if err != nil {
+		return sig, err
+	}
+
+	signDesc := newSpliceSignDesc(lc.signDesc, splice)
+	sig, _, err = lc.signCommitView(remoteCommit, remoteKeyRing, signDesc)
+	if err != nil {
+		return sig, err
+	}
+	remoteCommit.sig = sig.ToSignatureBytes()
+
+	lc.spliceProposal = &spliceProposal{
+		splice:       splice,
+		builder:      builder,
+		signDesc:     signDesc,
+		localCommit:  localCommit,
+		remoteCommit: remoteCommit,
+		localKeyRing: localKeyRing,
+	}
+
+	return sig, nil
+}
+
+// ReceiveSpliceCommitSig verifies the remote party's signature for the splice
+// version of our current commitment created by SignSpliceCommitment. If it's
+// valid, the splice is written to disk and becomes the pending splice of the
+// channel. From then on, every new commitment is also signed for the funding
+// output of the splice.
+func (lc *LightningChannel) ReceiveSpliceCommitSig(sig lnwire.Sig) error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	proposal := lc.spliceProposal
+	if proposal == nil {
+		return ErrNoSpliceProposal
+	}
+
+	err := lc.validateCommitSigs(
+		proposal.localCommit, proposal.localKeyRing, sig, nil,
+		proposal.signDesc,
+	)
+	if err != nil {
+		return err
+	}
+	proposal.localCommit.sig = sig.ToSignatureBytes()
+
+	splice := proposal.splice
+	splice.LocalCommitment = *proposal.localCommit.toDiskCommit(true)
+	splice.RemoteCommitment = *proposal.remoteCommit.toDiskCommit(false)
+	if err := lc.channelState.PutSplice(splice); err != nil {
+		return err
+	}
+
+	lc.localCommitChain.tail().splice = proposal.localCommit
+	lc.remoteCommitChain.tail().splice = proposal.remoteCommit
+
+	lc.splice = splice
+	lc.spliceBuilder = proposal.builder
+	lc.spliceSignDesc = proposal.signDesc
+	lc.spliceProposal = nil
+
+	return nil
+}
+
+// CancelSpliceProposal discards the splice that is being negotiated, if any.
+// It has no effect once the splice is pending.
+func (lc *LightningChannel) CancelSpliceProposal() {
+	lc.Lock()
+	lc.spliceProposal = nil
+	lc.Unlock()
+}
+
+// AbandonSplice discards the pending splice of the channel, after which new
+// commitments are no longer signed for its funding output. This must only be
+// done as long as the remote party can't have received our signature for the
+// splice transaction.
+func (lc *LightningChannel) AbandonSplice() error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	lc.spliceProposal = nil
+	if lc.splice == nil {
+		return channeldb.ErrNoSplice
+	}
+
+	if err := lc.channelState.AbandonSplice(); err != nil {
+		return err
+	}
+
+	chains := []*commitmentChain{lc.localCommitChain, lc.remoteCommitChain}
+	for _, chain := range chains {
+		for e := chain.commitments.Front(); e != nil; e = e.Next() {
+			c := e.Value.(*commitment)
+			c.splice = nil
+			c.spliceSigs = nil
+		}
+	}
+
+	lc.splice = nil
+	lc.spliceBuilder = nil
+	lc.spliceSignDesc = nil
+
+	return nil
+}
+
+// SpliceSharedInput returns the current funding output of the channel, which
+// is spent by the transaction of a new splice.
+func (lc *LightningChannel) SpliceSharedInput() *chanfunding.SharedInput {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return &chanfunding.SharedInput{
+		OutPoint: lc.channelState.ActiveFundingOutpoint(),
+		TxOut: &wire.TxOut{
+			PkScript: lc.signDesc.Output.PkScript,
+			Value:    lc.signDesc.Output.Value,
+		},
+	}
+}
+
+// SpliceFundingOutput returns the funding output that a splice resulting in
+// the given capacity creates. It's locked to the same multi-sig keys as the
+// current funding output.
+func (lc *LightningChannel) SpliceFundingOutput(
+	capacity ltcutil.Amount) *wire.TxOut {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return &wire.TxOut{
+		PkScript: lc.signDesc.Output.PkScript,
+		Value:    int64(capacity),
+	}
+}
+
+// PendingSplice returns the pending splice of the channel, or nil if there is
+// none.
+func (lc *LightningChannel) PendingSplice() *channeldb.ChannelSplice {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.splice
+}
+
+// PendingSpliceSigs returns the signatures we sent for the splice version of
+// the latest commitment we extended to the remote party. They must be sent
+// within the same CommitSig message as the signatures returned by
+// SignNextCommitment. If there's no pending splice, nil is returned.
+func (lc *LightningChannel) PendingSpliceSigs() *lnwire.SpliceSigs {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.remoteCommitChain.tip().spliceSigs
+}
+
+// signSpliceFundingInput signs the input of the splice transaction that spends
+// the current funding output of the channel.
+func (lc *LightningChannel) signSpliceFundingInput(spliceTx *wire.MsgTx,
+	inputIndex int) (input.Signature, error) {
+
+	signDesc := *lc.signDesc
+	signDesc.InputIndex = inputIndex
+	signDesc.SigHashes = txscript.NewTxSigHashes(spliceTx)
+
+	return lc.Signer.SignOutputRaw(spliceTx, &signDesc)
+}
+
+// SignSpliceFundingInput returns our signature for the input of the splice
+// transaction that spends the current funding output of the channel.
+func (lc *LightningChannel) SignSpliceFundingInput(spliceTx *wire.MsgTx,
+	inputIndex int) (lnwire.Sig, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	sig, err := lc.signSpliceFundingInput(spliceTx, inputIndex)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	return lnwire.NewSigFromSignature(sig)
+}
+
+// AddSpliceFundingWitness completes the input of the splice transaction that
+// spends the current funding output of the channel with our signature and the
+// passed signature of the remote party. The input is verified before the
+// witness is set.
+func (lc *LightningChannel) AddSpliceFundingWitness(spliceTx *wire.MsgTx,
+	inputIndex int, remoteSig lnwire.Sig) error {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	ourSig, err := lc.signSpliceFundingInput(spliceTx, inputIndex)
+	if err != nil {
+		return err
+	}
+	theirSig, err := remoteSig.ToSignature()
+	if err != nil {
+		return err
+	}
+
+	ourKey := lc.channelState.LocalChanCfg.MultiSigKey.PubKey.
+		SerializeCompressed()
+	theirKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey.
+		SerializeCompressed()
+	witness := input.SpendMultiSig(
+		lc.signDesc.WitnessScript, ourKey, ourSig, theirKey, theirSig,
+	)
+
+	// Make sure the remote party's signature is valid before we accept
+	// it.
+	signedTx := spliceTx.Copy()
+	signedTx.TxIn[inputIndex].Witness = witness
+	prevOut := lc.signDesc.Output
+	vm, err := txscript.NewEngine(
+		prevOut.PkScript, signedTx, inputIndex,
+		txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(signedTx), prevOut.Value,
+	)
+	if err != nil {
+		return err
+	}
+	if err := vm.Execute(); err != nil {
+		return fmt.Errorf("invalid signature for shared input: %v",
+			err)
+	}
+
+	spliceTx.TxIn[inputIndex].Witness = witness
+
+	return nil
+}
+
+// UpdateSpliceTx replaces the transaction of the pending splice with its fully
+// signed version.
+func (lc *LightningChannel) UpdateSpliceTx(spliceTx *wire.MsgTx) error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	if lc.splice == nil {
+		return channeldb.ErrNoSplice
+	}
+
+	if err := lc.channelState.UpdateSpliceTx(spliceTx); err != nil {
+		return err
+	}
+	lc.splice.FundingTx = spliceTx
+
+	return nil
+}
+
+// MarkSpliceConfirmed records that the transaction of the pending splice
+// confirmed at the given height.
+func (lc *LightningChannel) MarkSpliceConfirmed(height uint32) error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	if lc.splice == nil {
+		return channeldb.ErrNoSplice
+	}
+
+	if err := lc.channelState.MarkSpliceConfirmed(height); err != nil {
+		return err
+	}
+	lc.splice.ConfirmHeight = height
+
+	return nil
+}
+
+// spliceConfirmed returns whether the channel has a pending splice whose
+// transaction confirmed. As the confirmation may have been recorded by
+// another subsystem, this is read from disk.
+func (lc *LightningChannel) spliceConfirmed() (bool, error) {
+	if lc.splice == nil {
+		return false, nil
+	}
+
+	splice, err := lc.channelState.FetchSplice()
+	if err != nil {
+		return false, err
+	}
+
+	return splice.IsConfirmed(), nil
+}
+
+// CompleteSplice completes the pending splice once both parties sent their
+// splice_locked message. The funding output of the splice becomes the
+// funding output of the channel, and the splice versions of all unrevoked
+// commitments replace the original ones.
+func (lc *LightningChannel) CompleteSplice() error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	if lc.splice == nil {
+		return channeldb.ErrNoSplice
+	}
+
+	chains := []*commitmentChain{lc.localCommitChain, lc.remoteCommitChain}
+	for _, chain := range chains {
+		for e := chain.commitments.Front(); e != nil; e = e.Next() {
+			if e.Value.(*commitment).splice == nil {
+				return fmt.Errorf("commitment without splice " +
+					"version")
+			}
+		}
+	}
+
+	if err := lc.channelState.CompleteSplice(); err != nil {
+		return err
+	}
+
+	// The signatures we sent for the splice versions of the remote
+	// party's commitments are kept, as it may not have completed the
+	// splice yet when it receives them.
+	for _, chain := range chains {
+		for e := chain.commitments.Front(); e != nil; e = e.Next() {
+			c := e.Value.(*commitment)
+			c.splice.spliceSigs = c.spliceSigs
+			e.Value = c.splice
+		}
+	}
+
+	lc.Capacity = lc.channelState.Capacity
+	lc.commitBuilder = lc.spliceBuilder
+	lc.signDesc = lc.spliceSignDesc
+	lc.splice = nil
+	lc.spliceBuilder = nil
+	lc.spliceSignDesc = nil
+
+	return nil
+}
+
+// spendsSameOutput returns whether the first inputs of the passed transactions
+// spend the same output.
+func spendsSameOutput(a, b *wire.MsgTx) bool {
+	return a.TxIn[0].PreviousOutPoint == b.TxIn[0].PreviousOutPoint
+}
@@ -0,0 +1,297 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestSplice creates the splice transaction for a splice of the passed
+// channel to which Alice adds and Bob removes the given amounts, and returns
+// the splice from the point of view of both parties.
+func createTestSplice(aliceChannel *LightningChannel, spliceIn,
+	spliceOut ltcutil.Amount) (*channeldb.ChannelSplice,
+	*channeldb.ChannelSplice) {
+
+	capacity := aliceChannel.Capacity + spliceIn - spliceOut
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: aliceChannel.channelState.FundingOutpoint,
+	})
+	spliceTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{
+			Hash: chainhash.Hash{1},
+		},
+	})
+	spliceTx.AddTxOut(&wire.TxOut{
+		PkScript: aliceChannel.signDesc.Output.PkScript,
+		Value:    int64(capacity),
+	})
+
+	fundingOutpoint := wire.OutPoint{
+		Hash: spliceTx.TxHash(),
+	}
+	aliceSplice := &channeldb.ChannelSplice{
+		FundingOutpoint:    fundingOutpoint,
+		FundingTx:          spliceTx,
+		Capacity:           capacity,
+		LocalContribution:  spliceIn,
+		RemoteContribution: -spliceOut,
+	}
+	bobSplice := &channeldb.ChannelSplice{
+		FundingOutpoint:    fundingOutpoint,
+		FundingTx:          spliceTx.Copy(),
+		Capacity:           capacity,
+		LocalContribution:  -spliceOut,
+		RemoteContribution: spliceIn,
+	}
+
+	return aliceSplice, bobSplice
+}
+
+// TestChannelSplice tests that a channel keeps operating while a splice is
+// pending by signing every new commitment for both funding outputs, and that
+// the splice versions of the commitments become the main ones once the splice
+// is completed.
+func TestChannelSplice(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(
+		channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+	defer cleanUp()
+
+	const (
+		spliceIn  = ltcutil.Amount(ltcutil.SatoshiPerBitcoin)
+		spliceOut = ltcutil.Amount(ltcutil.SatoshiPerBitcoin / 2)
+	)
+	aliceSplice, bobSplice := createTestSplice(
+		aliceChannel, spliceIn, spliceOut,
+	)
+
+	// Before the splice is negotiated, the remote party's signature for
+	// it can't be accepted.
+	err = aliceChannel.ReceiveSpliceCommitSig(lnwire.Sig{})
+	require.ErrorIs(t, err, ErrNoSpliceProposal)
+
+	// Both parties sign the splice versions of their current commitments.
+	aliceSig, err := aliceChannel.SignSpliceCommitment(aliceSplice)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignSpliceCommitment(bobSplice)
+	require.NoError(t, err)
+
+	// An invalid signature must be rejected.
+	err = aliceChannel.ReceiveSpliceCommitSig(aliceSig)
+	require.Error(t, err)
+
+	require.NoError(t, aliceChannel.ReceiveSpliceCommitSig(bobSig))
+	require.NoError(t, bobChannel.ReceiveSpliceCommitSig(aliceSig))
+
+	// A second splice can't be started while this one is pending, and
+	// the channel can't be closed cooperatively either.
+	_, err = aliceChannel.SignSpliceCommitment(aliceSplice)
+	require.ErrorIs(t, err, ErrSplicePending)
+	_, _, _, err = aliceChannel.CreateCloseProposal(
+		1000, testHdSeed[:], testHdSeed[:],
+	)
+	require.ErrorIs(t, err, ErrSplicePending)
+
+	// Bob signs the input spending the current funding output, which
+	// completes the splice transaction on Alice's side.
+	spliceTx := aliceSplice.FundingTx
+	bobInputSig, err := bobChannel.SignSpliceFundingInput(spliceTx, 0)
+	require.NoError(t, err)
+	err = aliceChannel.AddSpliceFundingWitness(spliceTx, 0, aliceSig)
+	require.Error(t, err)
+	err = aliceChannel.AddSpliceFundingWitness(spliceTx, 0, bobInputSig)
+	require.NoError(t, err)
+	require.NotEmpty(t, spliceTx.TxIn[0].Witness)
+	require.NoError(t, aliceChannel.UpdateSpliceTx(spliceTx))
+
+	// The channel keeps operating while the splice is pending. Alice
+	// sends an HTLC to Bob, which is now included within both versions of
+	// the commitments.
+	htlcAmt := lnwire.NewMSatFromSatoshis(100_000)
+	htlc, preimage := createHTLC(0, htlcAmt)
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+
+	// Bob must reject Alice's commitment if she doesn't sign its splice
+	// version.
+	sig, htlcSigs, _, err := aliceChannel.SignNextCommitment()
+	require.NoError(t, err)
+	err = bobChannel.ReceiveNewCommitment(sig, htlcSigs)
+	require.ErrorIs(t, err, ErrMissingSpliceSigs)
+
+	// After a restart, Alice still has a pending splice.
+	aliceChannel, err = restartChannel(aliceChannel)
+	require.NoError(t, err)
+	require.NotNil(t, aliceChannel.PendingSplice())
+
+	err = bobChannel.ReceiveCommitSig(&lnwire.CommitSig{
+		CommitSig:  sig,
+		HtlcSigs:   htlcSigs,
+		SpliceSigs: aliceChannel.PendingSpliceSigs(),
+	})
+	require.NoError(t, err)
+	bobRevocation, _, err := bobChannel.RevokeCurrentCommitment()
+	require.NoError(t, err)
+	_, _, _, _, err = aliceChannel.ReceiveRevocation(bobRevocation)
+	require.NoError(t, err)
+
+	bobSig, htlcSigs, _, err = bobChannel.SignNextCommitment()
+	require.NoError(t, err)
+	err = aliceChannel.ReceiveCommitSig(&lnwire.CommitSig{
+		CommitSig:  bobSig,
+		HtlcSigs:   htlcSigs,
+		SpliceSigs: bobChannel.PendingSpliceSigs(),
+	})
+	require.NoError(t, err)
+	aliceRevocation, _, err := aliceChannel.RevokeCurrentCommitment()
+	require.NoError(t, err)
+	_, _, _, _, err = bobChannel.ReceiveRevocation(aliceRevocation)
+	require.NoError(t, err)
+
+	// The splice version of Alice's commitment includes the HTLC, and
+	// the contributions of both parties.
+	aliceCommit := aliceChannel.localCommitChain.tail()
+	spliceCommit := aliceCommit.splice
+	require.NotNil(t, spliceCommit)
+	require.Len(t, spliceCommit.outgoingHTLCs, 1)
+	require.Equal(
+		t, aliceCommit.ourBalance+lnwire.NewMSatFromSatoshis(spliceIn),
+		spliceCommit.ourBalance,
+	)
+	require.Equal(
+		t, aliceCommit.theirBalance-lnwire.NewMSatFromSatoshis(spliceOut),
+		spliceCommit.theirBalance,
+	)
+	require.Equal(
+		t, aliceSplice.FundingOutpoint,
+		spliceCommit.txn.TxIn[0].PreviousOutPoint,
+	)
+
+	// Bob settles the HTLC.
+	err = bobChannel.SettleHTLC(preimage, 0, nil, nil, nil)
+	require.NoError(t, err)
+	err = aliceChannel.ReceiveHTLCSettle(preimage, 0)
+	require.NoError(t, err)
+	require.NoError(t, ForceStateTransition(bobChannel, aliceChannel))
+
+	// Once the splice transaction confirmed, only the splice version of
+	// the commitment can be broadcast.
+	require.NoError(t, aliceChannel.MarkSpliceConfirmed(100))
+	require.NoError(t, bobChannel.MarkSpliceConfirmed(100))
+
+	commitTx, err := aliceChannel.getSignedCommitTx()
+	require.NoError(t, err)
+	require.Equal(
+		t, aliceSplice.FundingOutpoint,
+		commitTx.TxIn[0].PreviousOutPoint,
+	)
+
+	// Alice completes the splice before Bob, who signs a new commitment
+	// in the meantime. He still sends his signatures for both funding
+	// outputs, of which Alice must use the ones for the splice.
+	require.NoError(t, aliceChannel.CompleteSplice())
+	require.Nil(t, aliceChannel.PendingSplice())
+	require.Equal(
+		t, aliceSplice.FundingOutpoint,
+		aliceChannel.channelState.ActiveFundingOutpoint(),
+	)
+	require.Equal(t, aliceSplice.Capacity, aliceChannel.Capacity)
+
+	htlc, _ = createHTLC(0, htlcAmt)
+	_, err = bobChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = aliceChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+
+	bobSig, htlcSigs, _, err = bobChannel.SignNextCommitment()
+	require.NoError(t, err)
+	err = aliceChannel.ReceiveCommitSig(&lnwire.CommitSig{
+		CommitSig:  bobSig,
+		HtlcSigs:   htlcSigs,
+		SpliceSigs: bobChannel.PendingSpliceSigs(),
+	})
+	require.NoError(t, err)
+	aliceRevocation, _, err = aliceChannel.RevokeCurrentCommitment()
+	require.NoError(t, err)
+	_, _, _, _, err = bobChannel.ReceiveRevocation(aliceRevocation)
+	require.NoError(t, err)
+
+	// Bob keeps the signatures he sent for the splice version of Alice's
+	// latest commitment, in case they need to be retransmitted.
+	require.NoError(t, bobChannel.CompleteSplice())
+	spliceSigs := bobChannel.PendingSpliceSigs()
+	require.NotNil(t, spliceSigs)
+	require.Equal(
+		t, aliceSplice.FundingOutpoint.Hash, spliceSigs.FundingTxid,
+	)
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+	require.Nil(t, bobChannel.PendingSpliceSigs())
+	assertNoChanSyncNeeded(t, aliceChannel, bobChannel)
+
+	// Finally, a force close of either party spends the new funding
+	// output.
+	aliceChannel, err = restartChannel(aliceChannel)
+	require.NoError(t, err)
+	closeSummary, err := aliceChannel.ForceClose()
+	require.NoError(t, err)
+	require.Equal(
+		t, aliceSplice.FundingOutpoint,
+		closeSummary.CloseTx.TxIn[0].PreviousOutPoint,
+	)
+	require.Len(t, closeSummary.HtlcResolutions.IncomingHTLCs, 1)
+}
+
+// TestAbandonChannelSplice tests that a channel returns to signing commitments
+// for its current funding output only once a pending splice is abandoned.
+func TestAbandonChannelSplice(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(
+		channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+	defer cleanUp()
+
+	require.ErrorIs(t, aliceChannel.AbandonSplice(), channeldb.ErrNoSplice)
+
+	aliceSplice, bobSplice := createTestSplice(
+		aliceChannel, ltcutil.SatoshiPerBitcoin, 0,
+	)
+	aliceSig, err := aliceChannel.SignSpliceCommitment(aliceSplice)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignSpliceCommitment(bobSplice)
+	require.NoError(t, err)
+	require.NoError(t, aliceChannel.ReceiveSpliceCommitSig(bobSig))
+	require.NoError(t, bobChannel.ReceiveSpliceCommitSig(aliceSig))
+
+	require.NoError(t, aliceChannel.AbandonSplice())
+	require.NoError(t, bobChannel.AbandonSplice())
+	require.Nil(t, aliceChannel.PendingSplice())
+
+	// New commitments no longer carry signatures for the splice, which
+	// also holds after a restart.
+	aliceChannel, err = restartChannel(aliceChannel)
+	require.NoError(t, err)
+	require.Nil(t, aliceChannel.PendingSplice())
+
+	htlc, _ := createHTLC(0, lnwire.NewMSatFromSatoshis(100_000))
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+	require.Nil(t, aliceChannel.PendingSpliceSigs())
+}
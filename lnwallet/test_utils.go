@@ -506,7 +506,12 @@ func ForceStateTransition(chanA, chanB *LightningChannel) error {
 	if err != nil {
 		return err
 	}
-	if err = chanB.ReceiveNewCommitment(aliceSig, aliceHtlcSigs); err != nil {
+	err = chanB.ReceiveCommitSig(&lnwire.CommitSig{
+		CommitSig:  aliceSig,
+		HtlcSigs:   aliceHtlcSigs,
+		SpliceSigs: chanA.PendingSpliceSigs(),
+	})
+	if err != nil {
 		return err
 	}
 
@@ -522,7 +527,12 @@ func ForceStateTransition(chanA, chanB *LightningChannel) error {
 	if _, _, _, _, err := chanA.ReceiveRevocation(bobRevocation); err != nil {
 		return err
 	}
-	if err := chanA.ReceiveNewCommitment(bobSig, bobHtlcSigs); err != nil {
+	err = chanA.ReceiveCommitSig(&lnwire.CommitSig{
+		CommitSig:  bobSig,
+		HtlcSigs:   bobHtlcSigs,
+		SpliceSigs: chanB.PendingSpliceSigs(),
+	})
+	if err != nil {
 		return err
 	}
 
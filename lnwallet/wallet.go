@@ -153,6 +153,25 @@ type InitFundingReserveMsg struct {
 	// used.
 	ChanFunder chanfunding.Assembler
 
+	// ZeroConf is a boolean that is true if a zero-conf channel was
+	// negotiated.
+	ZeroConf bool
+
+	// OptionScidAlias is a boolean that is true if an option-scid-alias
+	// channel type was explicitly negotiated.
+	OptionScidAlias bool
+
+	// ScidAliasFeature is true if the option-scid-alias feature bit was
+	// negotiated.
+	ScidAliasFeature bool
+
+	// DualFund indicates that both parties may contribute funds to the
+	// channel, and that the funding transaction is constructed
+	// interactively. The initiator of a dual funded channel doesn't know
+	// the remote contribution yet and sets RemoteFundingAmt to zero, so a
+	// non-zero RemoteFundingAmt marks us as the responder.
+	DualFund bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -191,6 +210,11 @@ type addContributionMsg struct {
 	// TODO(roasbeef): Should also carry SPV proofs in we're in SPV mode
 	contribution *ChannelContribution
 
+	// interactiveTx is the unsigned funding transaction of a dual funded
+	// channel that was constructed interactively with the counterparty.
+	// If set, it's signed as is instead of assembling a new one.
+	interactiveTx *wire.MsgTx
+
 	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
 	err chan error
 }
@@ -843,10 +867,20 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 	// created plus the remote contribution.
 	capacity := localFundingAmt + remoteFundingAmt
 
+	// The initiator of a dual funded channel pays the commitment fee just
+	// like the initiator of a single funded one. If we're the responder
+	// and contribute funds as well, we'll therefore create the reservation
+	// as if we didn't, and add our contribution afterwards.
+	dualFundResponder := req.DualFund && remoteFundingAmt != 0
+	reservationCapacity, reservationLocalAmt := capacity, localFundingAmt
+	if dualFundResponder {
+		reservationCapacity, reservationLocalAmt = remoteFundingAmt, 0
+	}
+
 	id := atomic.AddUint64(&l.nextFundingID, 1)
 	reservation, err := NewChannelReservation(
-		capacity, localFundingAmt, req.CommitFeePerKw, l, id,
-		req.PushMSat, l.Cfg.NetParams.GenesisHash, req.Flags,
+		reservationCapacity, reservationLocalAmt, req.CommitFeePerKw, l,
+		id, req.PushMSat, l.Cfg.NetParams.GenesisHash, req.Flags,
 		req.CommitType, req.ChanFunder, req.PendingChanID,
 		thawHeight,
 	)
@@ -858,6 +892,31 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 		return
 	}
 
+	if dualFundResponder {
+		err := reservation.setFundingAmts(
+			localFundingAmt, remoteFundingAmt,
+		)
+		if err != nil {
+			fundingIntent.Cancel()
+
+			req.err <- err
+			req.resp <- nil
+			return
+		}
+	}
+
+	// Record any additional channel type bits that were negotiated on top
+	// of the base commitment type.
+	if req.ZeroConf {
+		reservation.partialState.ChanType |= channeldb.ZeroConfBit
+	}
+	if req.OptionScidAlias {
+		reservation.partialState.ChanType |= channeldb.ScidAliasChanBit
+	}
+	if req.ScidAliasFeature {
+		reservation.partialState.ChanType |= channeldb.ScidAliasFeatureBit
+	}
+
 	err = l.initOurContribution(
 		reservation, fundingIntent, req.NodeAddr, req.NodeID, keyRing,
 	)
@@ -1347,6 +1406,15 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	// At this point, we can now construct our channel point. Depending on
 	// which type of intent we obtained from our chanfunding.Assembler,
 	// we'll carry out a distinct set of steps.
+	// A funding transaction that was constructed interactively can only
+	// be signed by the wallet itself.
+	_, isFullIntent := pendingReservation.fundingIntent.(*chanfunding.FullIntent)
+	if req.interactiveTx != nil && !isFullIntent {
+		req.err <- fmt.Errorf("interactive funding tx requires a " +
+			"wallet funding intent")
+		return
+	}
+
 	switch fundingIntent := pendingReservation.fundingIntent.(type) {
 	// The transaction was created outside of the wallet and might already
 	// be published. Nothing left to do other than using the correct
@@ -1402,11 +1470,17 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 
 		// With our keys bound, we can now construct+sign the final
 		// funding transaction and also obtain the chanPoint that
-		// creates the channel.
-		fundingTx, err := fundingIntent.CompileFundingTx(
-			theirContribution.Inputs,
-			theirContribution.ChangeOutputs,
-		)
+		// creates the channel. If the transaction was already
+		// constructed interactively, we only need to sign it.
+		fundingTx := req.interactiveTx
+		if fundingTx != nil {
+			err = fundingIntent.CompileInteractiveTx(fundingTx)
+		} else {
+			fundingTx, err = fundingIntent.CompileFundingTx(
+				theirContribution.Inputs,
+				theirContribution.ChangeOutputs,
+			)
+		}
 		if err != nil {
 			req.err <- fmt.Errorf("unable to construct funding "+
 				"tx: %v", err)
@@ -1556,12 +1630,24 @@ func (l *LightningWallet) handleChanPointReady(req *continueContributionMsg) {
 	// obfuscator then use it to encode the current state number within
 	// both commitment transactions.
 	var stateObfuscator [StateHintSize]byte
-	if chanState.ChanType.IsSingleFunder() {
+	switch {
+	// The obfuscator of a single funder channel is derived from the
+	// payment base point of the initiator first. We're usually the
+	// initiator at this point, except for the responder of a dual funded
+	// channel.
+	case chanState.ChanType.IsSingleFunder() && chanState.IsInitiator:
 		stateObfuscator = DeriveStateHintObfuscator(
 			ourContribution.PaymentBasePoint.PubKey,
 			theirContribution.PaymentBasePoint.PubKey,
 		)
-	} else {
+
+	case chanState.ChanType.IsSingleFunder():
+		stateObfuscator = DeriveStateHintObfuscator(
+			theirContribution.PaymentBasePoint.PubKey,
+			ourContribution.PaymentBasePoint.PubKey,
+		)
+
+	default:
 		ourSer := ourContribution.PaymentBasePoint.PubKey.SerializeCompressed()
 		theirSer := theirContribution.PaymentBasePoint.PubKey.SerializeCompressed()
 		switch bytes.Compare(ourSer, theirSer) {
@@ -2072,6 +2158,92 @@ func (l *LightningWallet) WithCoinSelectLock(f func() error) error {
 	return f()
 }
 
+// ProvisionSplice provisions our contribution to the transaction of a splice
+// of a channel whose funding output is passed in. If we add funds to the
+// channel, coins of the wallet are selected and locked to cover them and our
+// share of the fees, and the returned intent carries the selected inputs along
+// with our change. If we remove funds from the channel, the intent instead
+// carries a single output paying them back to the wallet, minus our share of
+// the fees.
+//
+// NOTE: To release the selected coins, the Cancel() method of the returned
+// intent MUST be called if the splice fails.
+func (l *LightningWallet) ProvisionSplice(contribution ltcutil.Amount,
+	feeRate chainfee.SatPerKWeight, initiator bool,
+	fundingOutput *wire.TxOut) (*chanfunding.FullIntent, error) {
+
+	dustLimit := DustLimitForSize(input.P2WSHSize)
+	assembler := chanfunding.NewWalletAssembler(chanfunding.WalletConfig{
+		CoinSource:       &CoinSource{l},
+		CoinSelectLocker: l,
+		CoinLocker:       l,
+		Signer:           l.Cfg.Signer,
+		DustLimit:        dustLimit,
+	})
+
+	// The coin selection accounts for the fees of a regular funding
+	// transaction. As the initiator, we'll also have to pay for the
+	// shared input that spends the current funding output.
+	localAmt := contribution
+	if contribution > 0 && initiator {
+		sharedInputWeight := input.InputSize*
+			blockchain.WitnessScaleFactor +
+			input.MultiSigWitnessSize
+		localAmt += feeRate.FeeForWeight(int64(sharedInputWeight))
+	}
+	if contribution < 0 {
+		localAmt = 0
+	}
+
+	intent, err := assembler.ProvisionChannel(&chanfunding.Request{
+		LocalAmt: localAmt,
+		MinConfs: 1,
+		FeeRate:  feeRate,
+		ChangeAddr: func() (ltcutil.Address, error) {
+			return l.NewAddress(
+				WitnessPubKey, true, DefaultAccountName,
+			)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	fullIntent := intent.(*chanfunding.FullIntent)
+
+	if contribution >= 0 {
+		return fullIntent, nil
+	}
+
+	addr, err := l.NewAddress(WitnessPubKey, true, DefaultAccountName)
+	if err != nil {
+		fullIntent.Cancel()
+		return nil, err
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		fullIntent.Cancel()
+		return nil, err
+	}
+
+	// The funds that are spliced out pay for the weight of their output,
+	// as well as for the common fields of the transaction if we're the
+	// initiator.
+	spliceOut := &wire.TxOut{PkScript: pkScript}
+	weight := chanfunding.ContributionWeight(
+		initiator, true, 0, []*wire.TxOut{spliceOut}, fundingOutput,
+	)
+	amt := -contribution - feeRate.FeeForWeight(weight)
+	if amt < dustLimit {
+		fullIntent.Cancel()
+		return nil, fmt.Errorf("splice out amount of %v is below "+
+			"dust limit %v after fees", amt, dustLimit)
+	}
+	spliceOut.Value = int64(amt)
+	fullIntent.ChangeOutputs = []*wire.TxOut{spliceOut}
+
+	return fullIntent, nil
+}
+
 // DeriveStateHintObfuscator derives the bytes to be used for obfuscating the
 // state hints from the root to be used for a new channel. The obfuscator is
 // generated via the following computation:
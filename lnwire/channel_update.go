@@ -3,11 +3,15 @@ package lnwire
 import (
 	"bytes"
 	"fmt"
+	"hash/crc32"
 	"io"
 
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 )
 
+// crc32cTable is the table used to compute the checksums of channel updates.
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
 // ChanUpdateMsgFlags is a bitfield that signals whether optional fields are
 // present in the ChannelUpdate.
 type ChanUpdateMsgFlags uint8
@@ -279,3 +283,25 @@ func (a *ChannelUpdate) DataToSign() ([]byte, error) {
 
 	return buf.Bytes(), nil
 }
+
+// Checksum returns the CRC32C checksum of the channel update, which covers all
+// of its fields except for the signature and the timestamp. Two updates with
+// the same checksum can be assumed to announce the same policy. It's used to
+// avoid requesting updates during gossip queries that only refresh the
+// timestamp of a policy we already know.
+func (a *ChannelUpdate) Checksum() (uint32, error) {
+	data, err := a.DataToSign()
+	if err != nil {
+		return 0, err
+	}
+
+	// The timestamp directly follows the chain hash and short channel ID
+	// in the signed data.
+	const timestampOffset = chainhash.HashSize + 8
+	checksum := crc32.Update(0, crc32cTable, data[:timestampOffset])
+	checksum = crc32.Update(
+		checksum, crc32cTable, data[timestampOffset+4:],
+	)
+
+	return checksum, nil
+}
@@ -0,0 +1,38 @@
+package lnwire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelUpdateChecksum tests that the checksum of a channel update
+// doesn't cover its timestamp and signature, but all of its other fields.
+func TestChannelUpdateChecksum(t *testing.T) {
+	t.Parallel()
+
+	update := &ChannelUpdate{
+		ShortChannelID:  NewShortChanIDFromInt(1),
+		Timestamp:       100,
+		TimeLockDelta:   40,
+		HtlcMinimumMsat: 1000,
+		BaseFee:         1,
+		FeeRate:         10,
+	}
+	checksum, err := update.Checksum()
+	require.NoError(t, err)
+
+	// Refreshing the timestamp and signature of the update shouldn't
+	// change its checksum.
+	update.Timestamp = 200
+	update.Signature[0] = 1
+	refreshed, err := update.Checksum()
+	require.NoError(t, err)
+	require.Equal(t, checksum, refreshed)
+
+	// Changing the policy should.
+	update.FeeRate = 20
+	changed, err := update.Checksum()
+	require.NoError(t, err)
+	require.NotEqual(t, checksum, changed)
+}
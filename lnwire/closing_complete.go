@@ -0,0 +1,195 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/ltcutil"
+)
+
+const (
+	// CloserNoCloseeRecordType is the type of the TLV record that carries
+	// the signature for the closing transaction that only pays to the
+	// closer.
+	CloserNoCloseeRecordType tlv.Type = 1
+
+	// NoCloserRecordType is the type of the TLV record that carries the
+	// signature for the closing transaction that only pays to the closee.
+	NoCloserRecordType tlv.Type = 2
+
+	// CloserAndCloseeRecordType is the type of the TLV record that carries
+	// the signature for the closing transaction that pays to both parties.
+	CloserAndCloseeRecordType tlv.Type = 3
+)
+
+// closingSigRecord is a tlv.RecordProducer for the signature of one of the
+// variants of a closing transaction.
+type closingSigRecord struct {
+	recordType tlv.Type
+	sig        *Sig
+}
+
+// Record returns a TLV record that can be used to encode/decode the signature
+// to/from a TLV stream.
+func (c *closingSigRecord) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(c.recordType, (*[64]byte)(c.sig))
+}
+
+// ClosingSigs holds the signatures for the different variants of a closing
+// transaction negotiated with the simple close protocol. The variants differ
+// in which of the parties' outputs they include, as an output that falls
+// below the dust limit is omitted from the transaction. Only the signatures of
+// the variants that are valid for the current balances are set.
+type ClosingSigs struct {
+	// CloserNoClosee is the signature for the closing transaction that
+	// omits the output of the closee.
+	CloserNoClosee *Sig
+
+	// NoCloser is the signature for the closing transaction that omits
+	// the output of the closer.
+	NoCloser *Sig
+
+	// CloserAndClosee is the signature for the closing transaction that
+	// pays to both parties.
+	CloserAndClosee *Sig
+}
+
+// encode packs all set signatures into the passed extra data.
+func (c *ClosingSigs) encode(extraData *ExtraOpaqueData) error {
+	var records []tlv.RecordProducer
+	if c.CloserNoClosee != nil {
+		records = append(records, &closingSigRecord{
+			CloserNoCloseeRecordType, c.CloserNoClosee,
+		})
+	}
+	if c.NoCloser != nil {
+		records = append(records, &closingSigRecord{
+			NoCloserRecordType, c.NoCloser,
+		})
+	}
+	if c.CloserAndClosee != nil {
+		records = append(records, &closingSigRecord{
+			CloserAndCloseeRecordType, c.CloserAndClosee,
+		})
+	}
+
+	// We'll only replace the extra data if there's at least one
+	// signature, so messages without any keep their raw extra data.
+	if len(records) == 0 {
+		return nil
+	}
+
+	return EncodeMessageExtraData(extraData, records...)
+}
+
+// decode reads the signatures out of the TLV stream of the passed extra data.
+func (c *ClosingSigs) decode(extraData *ExtraOpaqueData) error {
+	var closerNoClosee, noCloser, closerAndClosee Sig
+	typeMap, err := extraData.ExtractRecords(
+		&closingSigRecord{CloserNoCloseeRecordType, &closerNoClosee},
+		&closingSigRecord{NoCloserRecordType, &noCloser},
+		&closingSigRecord{CloserAndCloseeRecordType, &closerAndClosee},
+	)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set the signatures whose TLV type was included in the
+	// stream.
+	if val, ok := typeMap[CloserNoCloseeRecordType]; ok && val == nil {
+		c.CloserNoClosee = &closerNoClosee
+	}
+	if val, ok := typeMap[NoCloserRecordType]; ok && val == nil {
+		c.NoCloser = &noCloser
+	}
+	if val, ok := typeMap[CloserAndCloseeRecordType]; ok && val == nil {
+		c.CloserAndClosee = &closerAndClosee
+	}
+
+	return nil
+}
+
+// ClosingComplete is sent by either party of a channel once both have sent a
+// shutdown message, if both signal support for the simple close protocol. The
+// sender of the message is the closer, which pays the entire fee of the
+// closing transaction out of its own output. The closee responds with a
+// ClosingSig message for one of the variants of the closing transaction. The
+// closer may send a new ClosingComplete message with a higher fee at any time
+// to replace a closing transaction that doesn't confirm.
+type ClosingComplete struct {
+	// ChannelID serves to identify which channel is to be closed.
+	ChannelID ChannelID
+
+	// FeeSatoshis is the fee in satoshis that the closer pays for the
+	// closing transaction.
+	FeeSatoshis ltcutil.Amount
+
+	// LockTime is the lock time of the closing transaction.
+	LockTime uint32
+
+	// ClosingSigs holds the closer's signatures for the variants of the
+	// closing transaction. These are encoded as TLV records.
+	ClosingSigs
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure ClosingComplete implements the lnwire.Message
+// interface.
+var _ Message = (*ClosingComplete)(nil)
+
+// Encode serializes the target ClosingComplete into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *ClosingComplete) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, c.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, c.FeeSatoshis); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, c.LockTime); err != nil {
+		return err
+	}
+
+	if err := c.ClosingSigs.encode(&c.ExtraData); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, c.ExtraData)
+}
+
+// Decode deserializes the serialized ClosingComplete stored in the passed
+// io.Reader into the target ClosingComplete using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *ClosingComplete) Decode(r io.Reader, pver uint32) error {
+	err := ReadElements(r,
+		&c.ChannelID,
+		&c.FeeSatoshis,
+		&c.LockTime,
+		&c.ExtraData,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.ClosingSigs.decode(&c.ExtraData)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a ClosingComplete on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (c *ClosingComplete) MsgType() MessageType {
+	return MsgClosingComplete
+}
@@ -0,0 +1,91 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/ltcsuite/ltcd/ltcutil"
+)
+
+// ClosingSig is sent by the closee in response to a ClosingComplete message.
+// It carries the closee's signature for exactly one of the variants of the
+// closing transaction that the closer signed, after which both parties are
+// able to broadcast that transaction.
+type ClosingSig struct {
+	// ChannelID serves to identify which channel is to be closed.
+	ChannelID ChannelID
+
+	// FeeSatoshis is the fee of the closing transaction, which must match
+	// the fee of the ClosingComplete message that is responded to.
+	FeeSatoshis ltcutil.Amount
+
+	// LockTime is the lock time of the closing transaction, which must
+	// match the lock time of the ClosingComplete message that is
+	// responded to.
+	LockTime uint32
+
+	// ClosingSigs holds the closee's signature for the chosen variant of
+	// the closing transaction. These are encoded as TLV records.
+	ClosingSigs
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure ClosingSig implements the lnwire.Message
+// interface.
+var _ Message = (*ClosingSig)(nil)
+
+// Encode serializes the target ClosingSig into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *ClosingSig) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, c.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, c.FeeSatoshis); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, c.LockTime); err != nil {
+		return err
+	}
+
+	if err := c.ClosingSigs.encode(&c.ExtraData); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, c.ExtraData)
+}
+
+// Decode deserializes the serialized ClosingSig stored in the passed
+// io.Reader into the target ClosingSig using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *ClosingSig) Decode(r io.Reader, pver uint32) error {
+	err := ReadElements(r,
+		&c.ChannelID,
+		&c.FeeSatoshis,
+		&c.LockTime,
+		&c.ExtraData,
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.ClosingSigs.decode(&c.ExtraData)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a ClosingSig on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (c *ClosingSig) MsgType() MessageType {
+	return MsgClosingSig
+}
@@ -2,9 +2,106 @@ package lnwire
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+)
+
+const (
+	// SpliceSigsRecordType is the type of the TLV record used to
+	// communicate the signatures for the splice version of a commitment
+	// within the CommitSig message.
+	SpliceSigsRecordType tlv.Type = 1
 )
 
+// SpliceSigs holds the signatures for the version of a commitment
+// transaction that spends the funding output of a pending splice. While a
+// splice transaction is unconfirmed, every new commitment must be signed for
+// both the current and the new funding output.
+type SpliceSigs struct {
+	// FundingTxid is the txid of the splice transaction whose funding
+	// output is spent by the signed commitment.
+	FundingTxid chainhash.Hash
+
+	// CommitSig is the signature for the commitment transaction.
+	CommitSig Sig
+
+	// HtlcSigs are the signatures for the second level HTLC transactions
+	// of the commitment, in the same order as the ones of the CommitSig
+	// message.
+	HtlcSigs []Sig
+}
+
+// Record returns a TLV record that can be used to encode/decode the splice
+// signatures to/from a TLV stream.
+func (s *SpliceSigs) Record() tlv.Record {
+	return tlv.MakeDynamicRecord(
+		SpliceSigsRecordType, s, s.size, encodeSpliceSigs,
+		decodeSpliceSigs,
+	)
+}
+
+// size returns the encoded size of the splice signatures.
+func (s *SpliceSigs) size() uint64 {
+	return uint64(32 + 64 + 64*len(s.HtlcSigs))
+}
+
+// encodeSpliceSigs is a tlv.Encoder for the SpliceSigs type.
+func encodeSpliceSigs(w io.Writer, val interface{}, buf *[8]byte) error {
+	if s, ok := val.(*SpliceSigs); ok {
+		if _, err := w.Write(s.FundingTxid[:]); err != nil {
+			return err
+		}
+		if _, err := w.Write(s.CommitSig[:]); err != nil {
+			return err
+		}
+		for _, sig := range s.HtlcSigs {
+			if _, err := w.Write(sig[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.SpliceSigs")
+}
+
+// decodeSpliceSigs is a tlv.Decoder for the SpliceSigs type.
+func decodeSpliceSigs(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	s, ok := val.(*SpliceSigs)
+	if !ok {
+		return tlv.NewTypeForDecodingErr(val, "lnwire.SpliceSigs", l, l)
+	}
+
+	// The record consists of the txid and the commitment signature,
+	// followed by any number of HTLC signatures.
+	if l < 32+64 || (l-32-64)%64 != 0 {
+		return fmt.Errorf("invalid splice sigs length: %d", l)
+	}
+
+	if _, err := io.ReadFull(r, s.FundingTxid[:]); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(r, s.CommitSig[:]); err != nil {
+		return err
+	}
+
+	numHtlcSigs := (l - 32 - 64) / 64
+	s.HtlcSigs = make([]Sig, numHtlcSigs)
+	for i := range s.HtlcSigs {
+		if _, err := io.ReadFull(r, s.HtlcSigs[i][:]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // CommitSig is sent by either side to stage any pending HTLC's in the
 // receiver's pending set into a new commitment state. Implicitly, the new
 // commitment transaction constructed which has been signed by CommitSig
@@ -36,6 +133,10 @@ type CommitSig struct {
 	// transaction should be signed.
 	HtlcSigs []Sig
 
+	// SpliceSigs holds the signatures for the splice version of the new
+	// commitment if the channel has a pending splice.
+	SpliceSigs *SpliceSigs
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -58,12 +159,35 @@ var _ Message = (*CommitSig)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *CommitSig) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		&c.ChanID,
 		&c.CommitSig,
 		&c.HtlcSigs,
-		&c.ExtraData,
 	)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	var spliceSigs SpliceSigs
+	typeMap, err := tlvRecords.ExtractRecords(&spliceSigs)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set SpliceSigs if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[SpliceSigsRecordType]; ok && val == nil {
+		c.SpliceSigs = &spliceSigs
+	}
+
+	c.ExtraData = tlvRecords
+
+	return nil
 }
 
 // Encode serializes the target CommitSig into the passed io.Writer
@@ -83,6 +207,14 @@ func (c *CommitSig) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
+	// We'll only encode the SpliceSigs in a TLV segment if they exist.
+	if c.SpliceSigs != nil {
+		err := EncodeMessageExtraData(&c.ExtraData, c.SpliceSigs)
+		if err != nil {
+			return err
+		}
+	}
+
 	return WriteBytes(w, c.ExtraData)
 }
 
@@ -129,6 +129,25 @@ const (
 	// transactions, which also imply anchor commitments.
 	AnchorsZeroFeeHtlcTxOptional FeatureBit = 23
 
+	// RouteBlindingRequired is a required feature bit that signals that
+	// the node requires support for forwarding and receiving payments over
+	// blinded routes.
+	RouteBlindingRequired FeatureBit = 24
+
+	// RouteBlindingOptional is an optional feature bit that signals that
+	// the node supports forwarding and receiving payments over blinded
+	// routes.
+	RouteBlindingOptional FeatureBit = 25
+
+	// DualFundRequired is a required feature bit that signals that the
+	// node requires support for dual funded channels, whose funding
+	// transaction is constructed interactively by both parties.
+	DualFundRequired FeatureBit = 28
+
+	// DualFundOptional is an optional feature bit that signals that the
+	// node supports opening and accepting dual funded channels.
+	DualFundOptional FeatureBit = 29
+
 	// AMPRequired is a required feature bit that signals that the receiver
 	// of a payment supports accepts spontaneous payments, i.e.
 	// sender-generated preimages according to BOLT XX.
@@ -139,6 +158,15 @@ const (
 	// sender-generated preimages according to BOLT XX.
 	AMPOptional FeatureBit = 31
 
+	// OnionMessagesRequired is a required feature bit that signals that
+	// the node requires its peers to send, forward and receive onion
+	// messages.
+	OnionMessagesRequired FeatureBit = 38
+
+	// OnionMessagesOptional is an optional feature bit that signals that
+	// the node is able to send, forward and receive onion messages.
+	OnionMessagesOptional FeatureBit = 39
+
 	// ExplicitChannelTypeRequired is a required bit that denotes that a
 	// connection established with this node is to use explicit channel
 	// commitment types for negotiation instead of the existing implicit
@@ -155,6 +183,44 @@ const (
 	// TODO: Decide on actual feature bit value.
 	ExplicitChannelTypeOptional = 45
 
+	// ScidAliasRequired is a required feature bit that signals that the
+	// node requires understanding of ShortChannelID aliases in the TLV
+	// segment of the funding_locked message.
+	ScidAliasRequired FeatureBit = 46
+
+	// ScidAliasOptional is an optional feature bit that signals that the
+	// node understands ShortChannelID aliases in the TLV segment of the
+	// funding_locked message.
+	ScidAliasOptional FeatureBit = 47
+
+	// ZeroConfRequired is a required feature bit that signals that the
+	// node requires understanding of the zero-conf channel_type, allowing
+	// a channel to be used before its funding transaction confirms.
+	ZeroConfRequired FeatureBit = 50
+
+	// ZeroConfOptional is an optional feature bit that signals that the
+	// node understands the zero-conf channel type.
+	ZeroConfOptional FeatureBit = 51
+
+	// SimpleCloseRequired is a required feature bit that signals that the
+	// node requires the simple close protocol, in which each party pays
+	// the fee of its own closing transaction and can replace it with one
+	// that pays a higher fee.
+	SimpleCloseRequired FeatureBit = 60
+
+	// SimpleCloseOptional is an optional feature bit that signals that the
+	// node supports the simple close protocol.
+	SimpleCloseOptional FeatureBit = 61
+
+	// SpliceRequired is a required feature bit that signals that the node
+	// requires support for splicing, which allows funds to be added to or
+	// removed from an existing channel without closing it.
+	SpliceRequired FeatureBit = 62
+
+	// SpliceOptional is an optional feature bit that signals that the node
+	// supports splicing funds into and out of existing channels.
+	SpliceOptional FeatureBit = 63
+
 	// ScriptEnforcedLeaseOptional is an optional feature bit that signals
 	// that the node requires channels having zero-fee second-level HTLC
 	// transactions, which also imply anchor commitments, along with an
@@ -222,6 +288,20 @@ var Features = map[FeatureBit]string{
 	ExplicitChannelTypeRequired:   "explicit-commitment-type",
 	ScriptEnforcedLeaseRequired:   "script-enforced-lease",
 	ScriptEnforcedLeaseOptional:   "script-enforced-lease",
+	ScidAliasRequired:             "scid-alias",
+	ScidAliasOptional:             "scid-alias",
+	ZeroConfRequired:              "zero-conf",
+	ZeroConfOptional:              "zero-conf",
+	RouteBlindingRequired:         "route-blinding",
+	RouteBlindingOptional:         "route-blinding",
+	OnionMessagesRequired:         "onion-messages",
+	OnionMessagesOptional:         "onion-messages",
+	DualFundRequired:              "dual-fund",
+	DualFundOptional:              "dual-fund",
+	SimpleCloseRequired:           "simple-close",
+	SimpleCloseOptional:           "simple-close",
+	SpliceRequired:                "splice",
+	SpliceOptional:                "splice",
 }
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
@@ -70,6 +70,29 @@ func randRawKey() ([33]byte, error) {
 	return n, nil
 }
 
+// randClosingSigs returns a set of closing signatures in which each of the
+// signatures is set with a chance of 1/2.
+func randClosingSigs(t *testing.T, r *rand.Rand) ClosingSigs {
+	randSig := func() *Sig {
+		if r.Intn(2) == 0 {
+			return nil
+		}
+
+		var sig Sig
+		if _, err := r.Read(sig[:]); err != nil {
+			t.Fatalf("unable to generate sig: %v", err)
+		}
+
+		return &sig
+	}
+
+	return ClosingSigs{
+		CloserNoClosee:  randSig(),
+		NoCloser:        randSig(),
+		CloserAndClosee: randSig(),
+	}
+}
+
 func randDeliveryAddress(r *rand.Rand) (DeliveryAddress, error) {
 	// Generate size minimum one. Empty scripts should be tested specifically.
 	size := r.Intn(deliveryAddressMaxSize) + 1
@@ -459,6 +482,186 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(req)
 		},
+		MsgOpenChannel2: func(v []reflect.Value, r *rand.Rand) {
+			req := OpenChannel2{
+				FundingFeePerKWeight: uint32(r.Int63()),
+				CommitFeePerKWeight:  uint32(r.Int63()),
+				FundingAmount:        ltcutil.Amount(r.Int63()),
+				DustLimit:            ltcutil.Amount(r.Int63()),
+				MaxValueInFlight:     MilliSatoshi(r.Int63()),
+				HtlcMinimum:          MilliSatoshi(r.Int31()),
+				CsvDelay:             uint16(r.Int31()),
+				MaxAcceptedHTLCs:     uint16(r.Int31()),
+				LockTime:             uint32(r.Int63()),
+				ChannelFlags:         FundingFlag(uint8(r.Int31())),
+			}
+
+			if _, err := r.Read(req.ChainHash[:]); err != nil {
+				t.Fatalf("unable to generate chain hash: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.PendingChannelID[:]); err != nil {
+				t.Fatalf("unable to generate pending chan id: %v", err)
+				return
+			}
+
+			keys := []**btcec.PublicKey{
+				&req.FundingKey, &req.RevocationPoint,
+				&req.PaymentPoint, &req.DelayedPaymentPoint,
+				&req.HtlcPoint, &req.FirstCommitmentPoint,
+				&req.SecondCommitmentPoint,
+			}
+			for _, key := range keys {
+				var err error
+				*key, err = randPubKey()
+				if err != nil {
+					t.Fatalf("unable to generate key: %v", err)
+					return
+				}
+			}
+
+			// 1/2 chance empty TLV records.
+			if r.Intn(2) == 0 {
+				var err error
+				req.UpfrontShutdownScript, err = randDeliveryAddress(r)
+				if err != nil {
+					t.Fatalf("unable to generate delivery address: %v", err)
+					return
+				}
+
+				req.ChannelType = new(ChannelType)
+				*req.ChannelType = ChannelType(*randRawFeatureVector(r))
+			} else {
+				req.ExtraData = make([]byte, 0)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgAcceptChannel2: func(v []reflect.Value, r *rand.Rand) {
+			req := AcceptChannel2{
+				FundingAmount:    ltcutil.Amount(r.Int63()),
+				DustLimit:        ltcutil.Amount(r.Int63()),
+				MaxValueInFlight: MilliSatoshi(r.Int63()),
+				HtlcMinimum:      MilliSatoshi(r.Int31()),
+				MinAcceptDepth:   uint32(r.Int31()),
+				CsvDelay:         uint16(r.Int31()),
+				MaxAcceptedHTLCs: uint16(r.Int31()),
+			}
+
+			if _, err := r.Read(req.PendingChannelID[:]); err != nil {
+				t.Fatalf("unable to generate pending chan id: %v", err)
+				return
+			}
+
+			keys := []**btcec.PublicKey{
+				&req.FundingKey, &req.RevocationPoint,
+				&req.PaymentPoint, &req.DelayedPaymentPoint,
+				&req.HtlcPoint, &req.FirstCommitmentPoint,
+				&req.SecondCommitmentPoint,
+			}
+			for _, key := range keys {
+				var err error
+				*key, err = randPubKey()
+				if err != nil {
+					t.Fatalf("unable to generate key: %v", err)
+					return
+				}
+			}
+
+			// 1/2 chance empty TLV records.
+			if r.Intn(2) == 0 {
+				var err error
+				req.UpfrontShutdownScript, err = randDeliveryAddress(r)
+				if err != nil {
+					t.Fatalf("unable to generate delivery address: %v", err)
+					return
+				}
+
+				req.ChannelType = new(ChannelType)
+				*req.ChannelType = ChannelType(*randRawFeatureVector(r))
+			} else {
+				req.ExtraData = make([]byte, 0)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxSignatures: func(v []reflect.Value, r *rand.Rand) {
+			req := TxSignatures{
+				Witnesses: make([]wire.TxWitness, r.Intn(10)),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.TxHash[:]); err != nil {
+				t.Fatalf("unable to generate tx hash: %v", err)
+				return
+			}
+
+			for i := range req.Witnesses {
+				req.Witnesses[i] = make(wire.TxWitness, r.Intn(5))
+				for j := range req.Witnesses[i] {
+					item := make([]byte, r.Intn(100))
+					if _, err := r.Read(item); err != nil {
+						t.Fatalf("unable to generate "+
+							"witness: %v", err)
+						return
+					}
+					req.Witnesses[i][j] = item
+				}
+			}
+
+			// 1/2 chance of a shared input signature.
+			if r.Intn(2) == 0 {
+				sig, err := NewSigFromSignature(testSig)
+				if err != nil {
+					t.Fatalf("unable to parse sig: %v", err)
+					return
+				}
+				req.SharedInputSig = &sig
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAddInput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddInput{
+				SerialID:  r.Uint64(),
+				PrevTxOut: r.Uint32(),
+				Sequence:  r.Uint32(),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			// The input either spends the shared funding output of
+			// a splice, or includes its previous transaction.
+			if r.Intn(2) == 0 {
+				req.PrevTx = make([]byte, 0)
+				req.SharedInputTxid = &chainhash.Hash{}
+				_, err := r.Read(req.SharedInputTxid[:])
+				if err != nil {
+					t.Fatalf("unable to generate txid: %v",
+						err)
+					return
+				}
+			} else {
+				req.PrevTx = make([]byte, 1+r.Intn(200))
+				if _, err := r.Read(req.PrevTx); err != nil {
+					t.Fatalf("unable to generate "+
+						"prev tx: %v", err)
+					return
+				}
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgFundingCreated: func(v []reflect.Value, r *rand.Rand) {
 			req := FundingCreated{
 				ExtraData: make([]byte, 0),
@@ -520,6 +723,50 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			req := NewFundingLocked(ChannelID(c), pubKey)
 
+			// Half the time we'll include an alias SCID.
+			if r.Int31()%2 == 0 {
+				alias := NewShortChanIDFromInt(uint64(r.Int63()))
+				req.AliasScid = &alias
+			}
+
+			v[0] = reflect.ValueOf(*req)
+		},
+		MsgUpdateAddHTLC: func(v []reflect.Value, r *rand.Rand) {
+			req := &UpdateAddHTLC{
+				ID:        r.Uint64(),
+				Amount:    MilliSatoshi(r.Uint64()),
+				Expiry:    r.Uint32(),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.PaymentHash[:]); err != nil {
+				t.Fatalf("unable to generate payment hash: %v",
+					err)
+				return
+			}
+
+			if _, err := r.Read(req.OnionBlob[:]); err != nil {
+				t.Fatalf("unable to generate onion blob: %v", err)
+				return
+			}
+
+			// Half the time we'll include a blinding point.
+			if r.Int31()%2 == 0 {
+				pubKey, err := randPubKey()
+				if err != nil {
+					t.Fatalf("unable to generate key: %v",
+						err)
+					return
+				}
+
+				req.BlindingPoint = pubKey
+			}
+
 			v[0] = reflect.ValueOf(*req)
 		},
 		MsgClosingSigned: func(v []reflect.Value, r *rand.Rand) {
@@ -541,6 +788,36 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(req)
 		},
+		MsgClosingComplete: func(v []reflect.Value, r *rand.Rand) {
+			req := ClosingComplete{
+				FeeSatoshis: ltcutil.Amount(r.Int63()),
+				LockTime:    r.Uint32(),
+				ClosingSigs: randClosingSigs(t, r),
+				ExtraData:   make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgClosingSig: func(v []reflect.Value, r *rand.Rand) {
+			req := ClosingSig{
+				FeeSatoshis: ltcutil.Amount(r.Int63()),
+				LockTime:    r.Uint32(),
+				ClosingSigs: randClosingSigs(t, r),
+				ExtraData:   make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgCommitSig: func(v []reflect.Value, r *rand.Rand) {
 			req := NewCommitSig()
 			if _, err := r.Read(req.ChanID[:]); err != nil {
@@ -570,6 +847,22 @@ func TestLightningWireProtocol(t *testing.T) {
 				}
 			}
 
+			// 1/2 chance of splice signatures, as long as they fit
+			// into the message alongside the regular signatures.
+			if r.Intn(2) == 0 && numSigs <= 483 {
+				req.SpliceSigs = &SpliceSigs{
+					CommitSig: req.CommitSig,
+					HtlcSigs:  make([]Sig, numSigs),
+				}
+				_, err := r.Read(req.SpliceSigs.FundingTxid[:])
+				if err != nil {
+					t.Fatalf("unable to generate txid: %v",
+						err)
+					return
+				}
+				copy(req.SpliceSigs.HtlcSigs, req.HtlcSigs)
+			}
+
 			v[0] = reflect.ValueOf(*req)
 		},
 		MsgRevokeAndAck: func(v []reflect.Value, r *rand.Rand) {
@@ -837,6 +1130,37 @@ func TestLightningWireProtocol(t *testing.T) {
 					NewShortChanIDFromInt(uint64(r.Int63())))
 			}
 
+			// Include query flags for all channels half of the
+			// time.
+			if r.Int31()%2 == 0 {
+				req.QueryFlags = make([]QueryFlag, numChanIDs)
+				maxFlag := int64(QueryFlagAll) + 1
+				for i := range req.QueryFlags {
+					req.QueryFlags[i] = QueryFlag(
+						r.Int63n(maxFlag),
+					)
+				}
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgQueryChannelRange: func(v []reflect.Value, r *rand.Rand) {
+			req := QueryChannelRange{
+				FirstBlockHeight: uint32(r.Int31()),
+				NumBlocks:        uint32(r.Int31()),
+				ExtraData:        make([]byte, 0),
+			}
+
+			if _, err := rand.Read(req.ChainHash[:]); err != nil {
+				t.Fatalf("unable to read chain hash: %v", err)
+				return
+			}
+
+			if r.Int31()%2 == 0 {
+				queryOptions := QueryOptions(r.Int63n(4))
+				req.QueryOptions = &queryOptions
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgReplyChannelRange: func(v []reflect.Value, r *rand.Rand) {
@@ -861,12 +1185,39 @@ func TestLightningWireProtocol(t *testing.T) {
 				req.EncodingType = EncodingSortedPlain
 			}
 
-			numChanIDs := rand.Int31n(5000)
+			// Include the timestamps and checksums of all channels
+			// half of the time, which requires fewer channels to
+			// fit into a message.
+			withChanInfo := r.Int31()%2 == 0
+			maxChanIDs := int32(5000)
+			if withChanInfo {
+				maxChanIDs = 2500
+			}
+
+			numChanIDs := rand.Int31n(maxChanIDs)
 			for i := int32(0); i < numChanIDs; i++ {
 				req.ShortChanIDs = append(req.ShortChanIDs,
 					NewShortChanIDFromInt(uint64(r.Int63())))
 			}
 
+			if withChanInfo {
+				req.Timestamps = make(
+					[]ChanUpdateTimestamps, numChanIDs,
+				)
+				req.Checksums = make(
+					[]ChanUpdateChecksums, numChanIDs,
+				)
+				for i := range req.Timestamps {
+					ts := &req.Timestamps[i]
+					ts.Timestamp1 = r.Uint32()
+					ts.Timestamp2 = r.Uint32()
+
+					cs := &req.Checksums[i]
+					cs.Checksum1 = r.Uint32()
+					cs.Checksum2 = r.Uint32()
+				}
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgPing: func(v []reflect.Value, r *rand.Rand) {
@@ -883,6 +1234,26 @@ func TestLightningWireProtocol(t *testing.T) {
 				PaddingBytes: paddingBytes,
 			}
 
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgOnionMessage: func(v []reflect.Value, r *rand.Rand) {
+			pubKey, err := randPubKey()
+			if err != nil {
+				t.Fatalf("unable to generate key: %v", err)
+				return
+			}
+
+			req := OnionMessage{
+				BlindingPoint: pubKey,
+				OnionBlob:     make([]byte, OnionPacketSize),
+				ExtraData:     make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.OnionBlob); err != nil {
+				t.Fatalf("unable to generate onion blob: %v", err)
+				return
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 	}
@@ -962,6 +1333,18 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgClosingComplete,
+			scenario: func(m ClosingComplete) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgClosingSig,
+			scenario: func(m ClosingSig) bool {
+				return mainScenario(&m)
+			},
+		},
 		{
 			msgType: MsgUpdateAddHTLC,
 			scenario: func(m UpdateAddHTLC) bool {
@@ -1065,6 +1448,84 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgOnionMessage,
+			scenario: func(m OnionMessage) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgOpenChannel2,
+			scenario: func(m OpenChannel2) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgAcceptChannel2,
+			scenario: func(m AcceptChannel2) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddInput,
+			scenario: func(m TxAddInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddOutput,
+			scenario: func(m TxAddOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxRemoveInput,
+			scenario: func(m TxRemoveInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxRemoveOutput,
+			scenario: func(m TxRemoveOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxComplete,
+			scenario: func(m TxComplete) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxSignatures,
+			scenario: func(m TxSignatures) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAbort,
+			scenario: func(m TxAbort) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceInit,
+			scenario: func(m SpliceInit) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceAck,
+			scenario: func(m SpliceAck) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceLocked,
+			scenario: func(m SpliceLocked) bool {
+				return mainScenario(&m)
+			},
+		},
 	}
 	for _, test := range tests {
 		var config *quick.Config
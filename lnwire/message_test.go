@@ -16,7 +16,9 @@ import (
 	"github.com/ltcsuite/lnd/tor"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/btcec/v2/ecdsa"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/wire"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -271,6 +273,8 @@ func makeAllMessages(t testing.TB, r *rand.Rand) []lnwire.Message {
 	msgAll = append(msgAll, newMsgFundingLocked(t, r))
 	msgAll = append(msgAll, newMsgShutdown(t, r))
 	msgAll = append(msgAll, newMsgClosingSigned(t, r))
+	msgAll = append(msgAll, newMsgClosingComplete(t, r))
+	msgAll = append(msgAll, newMsgClosingSig(t, r))
 	msgAll = append(msgAll, newMsgUpdateAddHTLC(t, r))
 	msgAll = append(msgAll, newMsgUpdateFulfillHTLC(t, r))
 	msgAll = append(msgAll, newMsgUpdateFailHTLC(t, r))
@@ -288,6 +292,19 @@ func makeAllMessages(t testing.TB, r *rand.Rand) []lnwire.Message {
 	msgAll = append(msgAll, newMsgQueryChannelRange(t, r))
 	msgAll = append(msgAll, newMsgReplyChannelRange(t, r))
 	msgAll = append(msgAll, newMsgGossipTimestampRange(t, r))
+	msgAll = append(msgAll, newMsgOnionMessage(t, r))
+	msgAll = append(msgAll, newMsgOpenChannel2(t, r))
+	msgAll = append(msgAll, newMsgAcceptChannel2(t, r))
+	msgAll = append(msgAll, newMsgTxAddInput(t, r))
+	msgAll = append(msgAll, newMsgTxAddOutput(t, r))
+	msgAll = append(msgAll, newMsgTxRemoveInput(t, r))
+	msgAll = append(msgAll, newMsgTxRemoveOutput(t, r))
+	msgAll = append(msgAll, newMsgTxComplete(t, r))
+	msgAll = append(msgAll, newMsgTxSignatures(t, r))
+	msgAll = append(msgAll, newMsgTxAbort(t, r))
+	msgAll = append(msgAll, newMsgSpliceInit(t, r))
+	msgAll = append(msgAll, newMsgSpliceAck(t, r))
+	msgAll = append(msgAll, newMsgSpliceLocked(t, r))
 	msgAll = append(msgAll, newMsgQueryShortChanIDsZlib(t, r))
 	msgAll = append(msgAll, newMsgReplyChannelRangeZlib(t, r))
 
@@ -439,7 +456,16 @@ func newMsgFundingLocked(t testing.TB, r io.Reader) *lnwire.FundingLocked {
 
 	pubKey := randPubKey(t)
 
+	var scid [8]byte
+	_, err = r.Read(scid[:])
+	require.NoError(t, err, "unable to generate alias scid")
+
+	// The alias is encoded as a TLV record, which replaces the extra data
+	// when the message is written.
+	alias := lnwire.NewShortChanIDFromInt(binary.BigEndian.Uint64(scid[:]))
+
 	msg := lnwire.NewFundingLocked(lnwire.ChannelID(c), pubKey)
+	msg.AliasScid = &alias
 	msg.ExtraData = createExtraData(t, r)
 
 	return msg
@@ -474,14 +500,58 @@ func newMsgClosingSigned(t testing.TB, r *rand.Rand) *lnwire.ClosingSigned {
 	return msg
 }
 
+func newMsgClosingComplete(t testing.TB,
+	r *rand.Rand) *lnwire.ClosingComplete {
+
+	t.Helper()
+
+	// The signatures are encoded as TLV records, which replace the extra
+	// data when the message is written.
+	sig := lnwire.Sig(testNodeSig)
+	msg := &lnwire.ClosingComplete{
+		FeeSatoshis: ltcutil.Amount(r.Int63()),
+		LockTime:    r.Uint32(),
+		ClosingSigs: lnwire.ClosingSigs{
+			CloserNoClosee:  &sig,
+			CloserAndClosee: &sig,
+		},
+		ExtraData: createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChannelID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	return msg
+}
+
+func newMsgClosingSig(t testing.TB, r *rand.Rand) *lnwire.ClosingSig {
+	t.Helper()
+
+	sig := lnwire.Sig(testNodeSig)
+	msg := &lnwire.ClosingSig{
+		FeeSatoshis: ltcutil.Amount(r.Int63()),
+		LockTime:    r.Uint32(),
+		ClosingSigs: lnwire.ClosingSigs{
+			CloserAndClosee: &sig,
+		},
+		ExtraData: createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChannelID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	return msg
+}
+
 func newMsgUpdateAddHTLC(t testing.TB, r *rand.Rand) *lnwire.UpdateAddHTLC {
 	t.Helper()
 
 	msg := &lnwire.UpdateAddHTLC{
-		ID:        r.Uint64(),
-		Amount:    lnwire.MilliSatoshi(r.Int63()),
-		Expiry:    r.Uint32(),
-		ExtraData: createExtraData(t, r),
+		ID:            r.Uint64(),
+		Amount:        lnwire.MilliSatoshi(r.Int63()),
+		Expiry:        r.Uint32(),
+		BlindingPoint: randPubKey(t),
+		ExtraData:     createExtraData(t, r),
 	}
 
 	_, err := r.Read(msg.ChanID[:])
@@ -529,6 +599,52 @@ func newMsgUpdateFailHTLC(t testing.TB, r *rand.Rand) *lnwire.UpdateFailHTLC {
 	return msg
 }
 
+func newMsgSpliceInit(t testing.TB, r *rand.Rand) *lnwire.SpliceInit {
+	t.Helper()
+
+	msg := &lnwire.SpliceInit{
+		FundingContribution:  ltcutil.Amount(r.Int63() - r.Int63()),
+		FundingFeePerKWeight: uint32(r.Int31()),
+		LockTime:             uint32(r.Int31()),
+		ExtraData:            createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChanID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	return msg
+}
+
+func newMsgSpliceAck(t testing.TB, r *rand.Rand) *lnwire.SpliceAck {
+	t.Helper()
+
+	msg := &lnwire.SpliceAck{
+		FundingContribution: ltcutil.Amount(r.Int63() - r.Int63()),
+		ExtraData:           createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChanID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	return msg
+}
+
+func newMsgSpliceLocked(t testing.TB, r io.Reader) *lnwire.SpliceLocked {
+	t.Helper()
+
+	msg := &lnwire.SpliceLocked{
+		ExtraData: createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChanID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	_, err = r.Read(msg.SpliceTxID[:])
+	require.NoError(t, err, "unable to generate splice txid")
+
+	return msg
+}
+
 func newMsgCommitSig(t testing.TB, r io.Reader) *lnwire.CommitSig {
 	t.Helper()
 
@@ -545,6 +661,15 @@ func newMsgCommitSig(t testing.TB, r io.Reader) *lnwire.CommitSig {
 		msg.HtlcSigs[i] = testNodeSig
 	}
 
+	// The splice signatures are encoded as a TLV record, which replaces
+	// the extra data when the message is written.
+	msg.SpliceSigs = &lnwire.SpliceSigs{
+		CommitSig: testNodeSig,
+		HtlcSigs:  msg.HtlcSigs,
+	}
+	_, err = r.Read(msg.SpliceSigs.FundingTxid[:])
+	require.NoError(t, err, "unable to generate funding txid")
+
 	return msg
 }
 
@@ -858,6 +983,221 @@ func newMsgGossipTimestampRange(t testing.TB,
 	return msg
 }
 
+func newMsgOnionMessage(t testing.TB, r *rand.Rand) *lnwire.OnionMessage {
+	t.Helper()
+
+	msg := &lnwire.OnionMessage{
+		BlindingPoint: randPubKey(t),
+		OnionBlob:     make([]byte, lnwire.OnionPacketSize),
+		ExtraData:     createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.OnionBlob)
+	require.NoError(t, err, "unable to generate onion blob")
+
+	return msg
+}
+
+func newMsgOpenChannel2(t testing.TB, r *rand.Rand) *lnwire.OpenChannel2 {
+	t.Helper()
+
+	msg := &lnwire.OpenChannel2{
+		FundingFeePerKWeight:  uint32(r.Int31()),
+		CommitFeePerKWeight:   uint32(r.Int31()),
+		FundingAmount:         ltcutil.Amount(r.Int63()),
+		DustLimit:             ltcutil.Amount(r.Int63()),
+		MaxValueInFlight:      lnwire.MilliSatoshi(r.Int63()),
+		HtlcMinimum:           lnwire.MilliSatoshi(r.Int63()),
+		CsvDelay:              uint16(r.Intn(1 << 16)),
+		MaxAcceptedHTLCs:      uint16(r.Intn(1 << 16)),
+		LockTime:              uint32(r.Int31()),
+		FundingKey:            randPubKey(t),
+		RevocationPoint:       randPubKey(t),
+		PaymentPoint:          randPubKey(t),
+		DelayedPaymentPoint:   randPubKey(t),
+		HtlcPoint:             randPubKey(t),
+		FirstCommitmentPoint:  randPubKey(t),
+		SecondCommitmentPoint: randPubKey(t),
+		ChannelFlags:          lnwire.FundingFlag(uint8(r.Intn(1 << 8))),
+		UpfrontShutdownScript: randDeliveryAddress(t, r),
+		ExtraData:             createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChainHash[:])
+	require.NoError(t, err, "unable to read bytes for ChainHash")
+
+	_, err = r.Read(msg.PendingChannelID[:])
+	require.NoError(t, err, "unable to read bytes for PendingChannelID")
+
+	return msg
+}
+
+func newMsgAcceptChannel2(t testing.TB,
+	r *rand.Rand) *lnwire.AcceptChannel2 {
+
+	t.Helper()
+
+	msg := &lnwire.AcceptChannel2{
+		FundingAmount:         ltcutil.Amount(r.Int63()),
+		DustLimit:             ltcutil.Amount(r.Int63()),
+		MaxValueInFlight:      lnwire.MilliSatoshi(r.Int63()),
+		HtlcMinimum:           lnwire.MilliSatoshi(r.Int63()),
+		MinAcceptDepth:        uint32(r.Int31()),
+		CsvDelay:              uint16(r.Intn(1 << 16)),
+		MaxAcceptedHTLCs:      uint16(r.Intn(1 << 16)),
+		FundingKey:            randPubKey(t),
+		RevocationPoint:       randPubKey(t),
+		PaymentPoint:          randPubKey(t),
+		DelayedPaymentPoint:   randPubKey(t),
+		HtlcPoint:             randPubKey(t),
+		FirstCommitmentPoint:  randPubKey(t),
+		SecondCommitmentPoint: randPubKey(t),
+		UpfrontShutdownScript: randDeliveryAddress(t, r),
+		ExtraData:             createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.PendingChannelID[:])
+	require.NoError(t, err, "unable to generate pending chan id")
+
+	return msg
+}
+
+func newMsgTxAddInput(t testing.TB, r *rand.Rand) *lnwire.TxAddInput {
+	t.Helper()
+
+	msg := &lnwire.TxAddInput{
+		SerialID:  r.Uint64(),
+		PrevTx:    make([]byte, 200),
+		PrevTxOut: uint32(r.Int31()),
+		Sequence:  uint32(r.Int31()),
+		ExtraData: createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChanID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	_, err = r.Read(msg.PrevTx)
+	require.NoError(t, err, "unable to generate prev tx")
+
+	// The shared input is encoded as a TLV record, which replaces the
+	// extra data when the message is written.
+	msg.SharedInputTxid = &chainhash.Hash{}
+	_, err = r.Read(msg.SharedInputTxid[:])
+	require.NoError(t, err, "unable to generate shared input txid")
+
+	return msg
+}
+
+func newMsgTxAddOutput(t testing.TB, r *rand.Rand) *lnwire.TxAddOutput {
+	t.Helper()
+
+	msg := &lnwire.TxAddOutput{
+		SerialID:  r.Uint64(),
+		Amount:    ltcutil.Amount(r.Int63()),
+		PkScript:  make([]byte, 34),
+		ExtraData: createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChanID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	_, err = r.Read(msg.PkScript)
+	require.NoError(t, err, "unable to generate pk script")
+
+	return msg
+}
+
+func newMsgTxRemoveInput(t testing.TB,
+	r *rand.Rand) *lnwire.TxRemoveInput {
+
+	t.Helper()
+
+	msg := &lnwire.TxRemoveInput{
+		SerialID:  r.Uint64(),
+		ExtraData: createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChanID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	return msg
+}
+
+func newMsgTxRemoveOutput(t testing.TB,
+	r *rand.Rand) *lnwire.TxRemoveOutput {
+
+	t.Helper()
+
+	msg := &lnwire.TxRemoveOutput{
+		SerialID:  r.Uint64(),
+		ExtraData: createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChanID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	return msg
+}
+
+func newMsgTxComplete(t testing.TB, r io.Reader) *lnwire.TxComplete {
+	t.Helper()
+
+	msg := &lnwire.TxComplete{
+		ExtraData: createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChanID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	return msg
+}
+
+func newMsgTxSignatures(t testing.TB, r io.Reader) *lnwire.TxSignatures {
+	t.Helper()
+
+	msg := &lnwire.TxSignatures{
+		Witnesses: make([]wire.TxWitness, testNumSigs),
+		ExtraData: createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChanID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	_, err = r.Read(msg.TxHash[:])
+	require.NoError(t, err, "unable to generate tx hash")
+
+	for i := range msg.Witnesses {
+		sig := make([]byte, 72)
+		_, err := r.Read(sig)
+		require.NoError(t, err, "unable to generate witness")
+
+		key := randRawKey(t)
+		msg.Witnesses[i] = wire.TxWitness{sig, key[:]}
+	}
+
+	// The shared input signature is encoded as a TLV record, which
+	// replaces the extra data when the message is written.
+	sharedSig := testNodeSig
+	msg.SharedInputSig = &sharedSig
+
+	return msg
+}
+
+func newMsgTxAbort(t testing.TB, r io.Reader) *lnwire.TxAbort {
+	t.Helper()
+
+	msg := &lnwire.TxAbort{
+		ExtraData: createExtraData(t, r),
+	}
+
+	_, err := r.Read(msg.ChanID[:])
+	require.NoError(t, err, "unable to generate chan id")
+
+	msg.Data = createExtraData(t, r)
+
+	return msg
+}
+
 func randRawKey(t testing.TB) [33]byte {
 	t.Helper()
 
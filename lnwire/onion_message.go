@@ -0,0 +1,90 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/ltcsuite/ltcd/btcec/v2"
+)
+
+// OnionMessage is sent to relay a message through the network without
+// requiring a payment to be made. The message is wrapped in a sphinx onion
+// that is routed over a blinded route, so that every hop only learns which
+// node it should pass the message on to.
+type OnionMessage struct {
+	// BlindingPoint is the route blinding ephemeral public key that the
+	// receiving node uses to derive the key required to process the
+	// onion, and to decrypt its blinded route data.
+	BlindingPoint *btcec.PublicKey
+
+	// OnionBlob is the raw serialized sphinx packet that contains the
+	// message.
+	OnionBlob []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// NewOnionMessage creates a new onion message using the passed blinding point
+// and serialized onion packet.
+func NewOnionMessage(blindingPoint *btcec.PublicKey,
+	onionBlob []byte) *OnionMessage {
+
+	return &OnionMessage{
+		BlindingPoint: blindingPoint,
+		OnionBlob:     onionBlob,
+	}
+}
+
+// A compile time check to ensure OnionMessage implements the lnwire.Message
+// interface.
+var _ Message = (*OnionMessage)(nil)
+
+// Decode deserializes a serialized OnionMessage message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) Decode(r io.Reader, pver uint32) error {
+	if err := ReadElement(r, &o.BlindingPoint); err != nil {
+		return err
+	}
+
+	var onionLen [2]byte
+	if _, err := io.ReadFull(r, onionLen[:]); err != nil {
+		return err
+	}
+
+	o.OnionBlob = make([]byte, binary.BigEndian.Uint16(onionLen[:]))
+	if _, err := io.ReadFull(r, o.OnionBlob); err != nil {
+		return err
+	}
+
+	return o.ExtraData.Decode(r)
+}
+
+// Encode serializes the target OnionMessage into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WritePublicKey(w, o.BlindingPoint); err != nil {
+		return err
+	}
+
+	if err := writeDataWithLength(w, o.OnionBlob); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, o.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) MsgType() MessageType {
+	return MsgOnionMessage
+}
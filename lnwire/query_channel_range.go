@@ -27,6 +27,10 @@ type QueryChannelRange struct {
 	// channel ID's should be sent for.
 	NumBlocks uint32
 
+	// QueryOptions is an optional set of additional information about
+	// each channel that the responder should include in its replies.
+	QueryOptions *QueryOptions
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -47,12 +51,29 @@ var _ Message = (*QueryChannelRange)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (q *QueryChannelRange) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		q.ChainHash[:],
 		&q.FirstBlockHeight,
 		&q.NumBlocks,
 		&q.ExtraData,
 	)
+	if err != nil {
+		return err
+	}
+
+	var queryOptions QueryOptions
+	typeMap, err := q.ExtraData.ExtractRecords(&queryOptions)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set the query options if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[QueryOptionsRecordType]; ok && val == nil {
+		q.QueryOptions = &queryOptions
+	}
+
+	return nil
 }
 
 // Encode serializes the target QueryChannelRange into the passed io.Writer
@@ -72,6 +93,14 @@ func (q *QueryChannelRange) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
+	// We'll only encode the query options in a TLV segment if they exist.
+	if q.QueryOptions != nil {
+		err := EncodeMessageExtraData(&q.ExtraData, q.QueryOptions)
+		if err != nil {
+			return err
+		}
+	}
+
 	return WriteBytes(w, q.ExtraData)
 }
 
@@ -0,0 +1,209 @@
+package lnwire
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/ltcsuite/lnd/tlv"
+)
+
+const (
+	// QueryOptionsRecordType is the type of the TLV record used within a
+	// QueryChannelRange message to request additional information about
+	// each channel in the replies.
+	QueryOptionsRecordType tlv.Type = 1
+)
+
+// QueryOptions is a bit field of the additional information about each
+// channel that should be included within the ReplyChannelRange messages sent
+// in response to a QueryChannelRange message.
+type QueryOptions uint64
+
+const (
+	// QueryOptionTimestamps requests the timestamps of the latest channel
+	// updates of both directions of each channel.
+	QueryOptionTimestamps QueryOptions = 1 << 0
+
+	// QueryOptionChecksums requests the checksums of the latest channel
+	// updates of both directions of each channel.
+	QueryOptionChecksums QueryOptions = 1 << 1
+)
+
+// Contains returns true if all bits of the passed options are set.
+func (q QueryOptions) Contains(options QueryOptions) bool {
+	return q&options == options
+}
+
+// Record returns a TLV record that can be used to encode/decode the query
+// options to/from a TLV stream.
+func (q *QueryOptions) Record() tlv.Record {
+	return tlv.MakeDynamicRecord(
+		QueryOptionsRecordType, q, q.size, encodeQueryOptions,
+		decodeQueryOptions,
+	)
+}
+
+// size returns the encoded size of the query options.
+func (q *QueryOptions) size() uint64 {
+	return tlv.VarIntSize(uint64(*q))
+}
+
+// encodeQueryOptions is a tlv.Encoder for the QueryOptions type.
+func encodeQueryOptions(w io.Writer, val interface{}, buf *[8]byte) error {
+	if q, ok := val.(*QueryOptions); ok {
+		return tlv.WriteVarInt(w, uint64(*q), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.QueryOptions")
+}
+
+// decodeQueryOptions is a tlv.Decoder for the QueryOptions type.
+func decodeQueryOptions(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	q, ok := val.(*QueryOptions)
+	if !ok {
+		return tlv.NewTypeForDecodingErr(
+			val, "lnwire.QueryOptions", l, l,
+		)
+	}
+
+	options, err := tlv.ReadVarInt(io.LimitReader(r, int64(l)), buf)
+	if err != nil {
+		return err
+	}
+	*q = QueryOptions(options)
+
+	return nil
+}
+
+// encodeWithEncoding prefixes the passed payload with the encoding type, and
+// compresses it using zlib if requested. This is how the arrays of the gossip
+// query TLV records that follow the short channel IDs are encoded.
+func encodeWithEncoding(encodingType ShortChanIDEncoding,
+	payload []byte) ([]byte, error) {
+
+	switch encodingType {
+	case EncodingSortedPlain:
+		return append([]byte{byte(encodingType)}, payload...), nil
+
+	case EncodingSortedZlib:
+		var b bytes.Buffer
+		b.WriteByte(byte(encodingType))
+
+		// Just like for the short channel IDs, we don't write a zlib
+		// header if there's nothing to compress.
+		if len(payload) == 0 {
+			return b.Bytes(), nil
+		}
+
+		zlibWriter := zlib.NewWriter(&b)
+		if _, err := zlibWriter.Write(payload); err != nil {
+			return nil, fmt.Errorf("unable to compress payload: %v",
+				err)
+		}
+		if err := zlibWriter.Close(); err != nil {
+			return nil, fmt.Errorf("unable to finalize "+
+				"compression: %v", err)
+		}
+
+		return b.Bytes(), nil
+
+	default:
+		return nil, ErrUnknownShortChanIDEncoding(encodingType)
+	}
+}
+
+// decodeWithEncoding reverses encodeWithEncoding, returning the encoding type
+// and the decompressed payload.
+func decodeWithEncoding(data []byte) (ShortChanIDEncoding, []byte, error) {
+	if len(data) == 0 {
+		return 0, nil, fmt.Errorf("missing encoding type")
+	}
+
+	encodingType := ShortChanIDEncoding(data[0])
+	payload := data[1:]
+
+	switch encodingType {
+	case EncodingSortedPlain:
+		return encodingType, payload, nil
+
+	case EncodingSortedZlib:
+		if len(payload) == 0 {
+			return encodingType, nil, nil
+		}
+
+		// We'll bound the memory allocated during decompression in
+		// the same way as for the short channel IDs.
+		zlibDecodeMtx.Lock()
+		defer zlibDecodeMtx.Unlock()
+
+		zlibReader, err := zlib.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return 0, nil, fmt.Errorf("unable to create zlib "+
+				"reader: %v", err)
+		}
+		decompressed, err := ioutil.ReadAll(
+			io.LimitReader(zlibReader, maxZlibBufSize),
+		)
+		if err != nil {
+			return 0, nil, fmt.Errorf("unable to decompress "+
+				"payload: %v", err)
+		}
+
+		return encodingType, decompressed, nil
+
+	default:
+		return 0, nil, ErrUnknownShortChanIDEncoding(encodingType)
+	}
+}
+
+// encodedArrayRecord is a tlv.RecordProducer for the raw bytes of one of the
+// arrays of per channel information that are appended to the gossip query
+// messages.
+type encodedArrayRecord struct {
+	typ  tlv.Type
+	data *[]byte
+}
+
+// Record returns a TLV record that can be used to encode/decode the raw bytes
+// of the array to/from a TLV stream.
+func (e *encodedArrayRecord) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(e.typ, e.data)
+}
+
+// chanIDSorter sorts a set of short channel IDs in ascending order, while
+// applying the same swaps to the arrays of per channel information that
+// belong to them.
+type chanIDSorter struct {
+	shortChanIDs []ShortChannelID
+	swap         func(i, j int)
+}
+
+// Len returns the number of short channel IDs.
+//
+// NOTE: Part of the sort.Interface interface.
+func (s *chanIDSorter) Len() int {
+	return len(s.shortChanIDs)
+}
+
+// Less returns true if the short channel ID at index i is smaller than the
+// one at index j.
+//
+// NOTE: Part of the sort.Interface interface.
+func (s *chanIDSorter) Less(i, j int) bool {
+	return s.shortChanIDs[i].ToUint64() < s.shortChanIDs[j].ToUint64()
+}
+
+// Swap swaps the short channel IDs at the given indexes, along with their
+// per channel information.
+//
+// NOTE: Part of the sort.Interface interface.
+func (s *chanIDSorter) Swap(i, j int) {
+	s.shortChanIDs[i], s.shortChanIDs[j] = s.shortChanIDs[j],
+		s.shortChanIDs[i]
+	s.swap(i, j)
+}
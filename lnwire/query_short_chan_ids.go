@@ -8,6 +8,7 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/ltcsuite/lnd/tlv"
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 )
 
@@ -35,6 +36,44 @@ const (
 	maxZlibBufSize = 67413630
 )
 
+const (
+	// QueryFlagsRecordType is the type of the TLV record used within a
+	// QueryShortChanIDs message to specify which of the messages of each
+	// channel are requested.
+	QueryFlagsRecordType tlv.Type = 1
+)
+
+// QueryFlag is a bit field of the messages of a channel that are requested by
+// a QueryShortChanIDs message.
+type QueryFlag uint64
+
+const (
+	// QueryFlagChanAnn requests the channel announcement.
+	QueryFlagChanAnn QueryFlag = 1 << 0
+
+	// QueryFlagChanUpdate1 requests the channel update of the first node.
+	QueryFlagChanUpdate1 QueryFlag = 1 << 1
+
+	// QueryFlagChanUpdate2 requests the channel update of the second node.
+	QueryFlagChanUpdate2 QueryFlag = 1 << 2
+
+	// QueryFlagNodeAnn1 requests the node announcement of the first node.
+	QueryFlagNodeAnn1 QueryFlag = 1 << 3
+
+	// QueryFlagNodeAnn2 requests the node announcement of the second node.
+	QueryFlagNodeAnn2 QueryFlag = 1 << 4
+
+	// QueryFlagAll requests all messages of a channel, which is the same
+	// as not specifying any query flags at all.
+	QueryFlagAll = QueryFlagChanAnn | QueryFlagChanUpdate1 |
+		QueryFlagChanUpdate2 | QueryFlagNodeAnn1 | QueryFlagNodeAnn2
+)
+
+// Contains returns true if all bits of the passed flag are set.
+func (q QueryFlag) Contains(flag QueryFlag) bool {
+	return q&flag == flag
+}
+
 // ErrUnsortedSIDs is returned when decoding a QueryShortChannelID request whose
 // items were not sorted.
 type ErrUnsortedSIDs struct {
@@ -81,6 +120,11 @@ type QueryShortChanIDs struct {
 	// ShortChanIDs is a slice of decoded short channel ID's.
 	ShortChanIDs []ShortChannelID
 
+	// QueryFlags optionally specifies which messages are requested for
+	// each of the short channel IDs, in the same order. If it isn't set,
+	// all messages of the channels are requested.
+	QueryFlags []QueryFlag
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -123,7 +167,52 @@ func (q *QueryShortChanIDs) Decode(r io.Reader, pver uint32) error {
 		return err
 	}
 
-	return q.ExtraData.Decode(r)
+	if err := q.ExtraData.Decode(r); err != nil {
+		return err
+	}
+
+	var queryFlags []byte
+	typeMap, err := q.ExtraData.ExtractRecords(
+		&encodedArrayRecord{QueryFlagsRecordType, &queryFlags},
+	)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set the query flags if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[QueryFlagsRecordType]; ok && val == nil {
+		q.QueryFlags, err = decodeQueryFlags(queryFlags)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeQueryFlags decodes the raw bytes of the query flags TLV record.
+func decodeQueryFlags(data []byte) ([]QueryFlag, error) {
+	_, payload, err := decodeWithEncoding(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		queryFlags = make([]QueryFlag, 0)
+		r          = bytes.NewReader(payload)
+		buf        [8]byte
+	)
+	for r.Len() > 0 {
+		flag, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse query flag: %v",
+				err)
+		}
+		queryFlags = append(queryFlags, QueryFlag(flag))
+	}
+
+	return queryFlags, nil
 }
 
 // decodeShortChanIDs decodes a set of short channel ID's that have been
@@ -297,6 +386,24 @@ func (q *QueryShortChanIDs) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
+	// The query flags need to be sorted along with the short channel IDs
+	// they belong to, as the order of the IDs is changed during their
+	// encoding.
+	if !q.noSort && q.QueryFlags != nil {
+		sort.Sort(&chanIDSorter{
+			shortChanIDs: q.ShortChanIDs,
+			swap: func(i, j int) {
+				if len(q.QueryFlags) <= i ||
+					len(q.QueryFlags) <= j {
+
+					return
+				}
+				q.QueryFlags[i], q.QueryFlags[j] =
+					q.QueryFlags[j], q.QueryFlags[i]
+			},
+		})
+	}
+
 	// Base on our encoding type, we'll write out the set of short channel
 	// ID's.
 	err := encodeShortChanIDs(w, q.EncodingType, q.ShortChanIDs, q.noSort)
@@ -304,6 +411,35 @@ func (q *QueryShortChanIDs) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
+	// We'll only encode the query flags in a TLV segment if they exist.
+	// They're encoded in the same way as the short channel IDs.
+	if q.QueryFlags != nil {
+		var (
+			payload bytes.Buffer
+			buf     [8]byte
+		)
+		for _, flag := range q.QueryFlags {
+			err := tlv.WriteVarInt(&payload, uint64(flag), &buf)
+			if err != nil {
+				return err
+			}
+		}
+
+		queryFlags, err := encodeWithEncoding(
+			q.EncodingType, payload.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		err = EncodeMessageExtraData(&q.ExtraData, &encodedArrayRecord{
+			QueryFlagsRecordType, &queryFlags,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	return WriteBytes(w, q.ExtraData)
 }
 
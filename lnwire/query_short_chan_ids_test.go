@@ -3,6 +3,8 @@ package lnwire
 import (
 	"bytes"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 type unsortedSidTest struct {
@@ -119,3 +121,39 @@ func TestQueryShortChanIDsZero(t *testing.T) {
 		})
 	}
 }
+
+// TestQueryShortChanIDsQueryFlags tests that the query flags of a
+// QueryShortChanIDs message are sorted along with the short channel IDs they
+// belong to, and survive an encoding round trip.
+func TestQueryShortChanIDsQueryFlags(t *testing.T) {
+	t.Parallel()
+
+	for _, encType := range []ShortChanIDEncoding{
+		EncodingSortedPlain, EncodingSortedZlib,
+	} {
+		req := &QueryShortChanIDs{
+			EncodingType: encType,
+			ShortChanIDs: []ShortChannelID{
+				NewShortChanIDFromInt(20),
+				NewShortChanIDFromInt(10),
+			},
+			QueryFlags: []QueryFlag{
+				QueryFlagChanUpdate2, QueryFlagAll,
+			},
+		}
+
+		var b bytes.Buffer
+		require.NoError(t, req.Encode(&b, 0))
+
+		var req2 QueryShortChanIDs
+		require.NoError(t, req2.Decode(bytes.NewReader(b.Bytes()), 0))
+
+		require.Equal(t, []ShortChannelID{
+			NewShortChanIDFromInt(10),
+			NewShortChanIDFromInt(20),
+		}, req2.ShortChanIDs)
+		require.Equal(t, []QueryFlag{
+			QueryFlagAll, QueryFlagChanUpdate2,
+		}, req2.QueryFlags)
+	}
+}
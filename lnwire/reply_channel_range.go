@@ -2,12 +2,50 @@ package lnwire
 
 import (
 	"bytes"
+	"encoding/binary"
+	"fmt"
 	"io"
 	"math"
+	"sort"
 
+	"github.com/ltcsuite/lnd/tlv"
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 )
 
+const (
+	// ChanUpdateTimestampsRecordType is the type of the TLV record used to
+	// communicate the timestamps of the channel updates of each channel
+	// within a ReplyChannelRange message.
+	ChanUpdateTimestampsRecordType tlv.Type = 1
+
+	// ChanUpdateChecksumsRecordType is the type of the TLV record used to
+	// communicate the checksums of the channel updates of each channel
+	// within a ReplyChannelRange message.
+	ChanUpdateChecksumsRecordType tlv.Type = 3
+)
+
+// ChanUpdateTimestamps holds the timestamps of the latest channel updates of
+// both directions of a channel. A timestamp is zero if no update is known for
+// the direction.
+type ChanUpdateTimestamps struct {
+	// Timestamp1 is the timestamp of the update of the first node.
+	Timestamp1 uint32
+
+	// Timestamp2 is the timestamp of the update of the second node.
+	Timestamp2 uint32
+}
+
+// ChanUpdateChecksums holds the checksums of the latest channel updates of
+// both directions of a channel, as computed by ChannelUpdate.Checksum. A
+// checksum is zero if no update is known for the direction.
+type ChanUpdateChecksums struct {
+	// Checksum1 is the checksum of the update of the first node.
+	Checksum1 uint32
+
+	// Checksum2 is the checksum of the update of the second node.
+	Checksum2 uint32
+}
+
 // ReplyChannelRange is the response to the QueryChannelRange message. It
 // includes the original query, and the next streaming chunk of encoded short
 // channel ID's as the response. We'll also include a byte that indicates if
@@ -38,6 +76,16 @@ type ReplyChannelRange struct {
 	// ShortChanIDs is a slice of decoded short channel ID's.
 	ShortChanIDs []ShortChannelID
 
+	// Timestamps optionally holds the timestamps of the channel updates of
+	// each of the short channel IDs, in the same order. It's included if
+	// it was requested through the QueryOptions of the query.
+	Timestamps []ChanUpdateTimestamps
+
+	// Checksums optionally holds the checksums of the channel updates of
+	// each of the short channel IDs, in the same order. It's included if
+	// it was requested through the QueryOptions of the query.
+	Checksums []ChanUpdateChecksums
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -79,7 +127,39 @@ func (c *ReplyChannelRange) Decode(r io.Reader, pver uint32) error {
 		return err
 	}
 
-	return c.ExtraData.Decode(r)
+	if err := c.ExtraData.Decode(r); err != nil {
+		return err
+	}
+
+	var timestamps, checksums []byte
+	typeMap, err := c.ExtraData.ExtractRecords(
+		&encodedArrayRecord{
+			ChanUpdateTimestampsRecordType, &timestamps,
+		},
+		&encodedArrayRecord{ChanUpdateChecksumsRecordType, &checksums},
+	)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set the timestamps and checksums if the corresponding TLV
+	// type was included in the stream.
+	val, ok := typeMap[ChanUpdateTimestampsRecordType]
+	if ok && val == nil {
+		c.Timestamps, err = decodeChanUpdateTimestamps(timestamps)
+		if err != nil {
+			return err
+		}
+	}
+	val, ok = typeMap[ChanUpdateChecksumsRecordType]
+	if ok && val == nil {
+		c.Checksums, err = decodeChanUpdateChecksums(checksums)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // Encode serializes the target ReplyChannelRange into the passed io.Writer
@@ -103,14 +183,130 @@ func (c *ReplyChannelRange) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
+	// The timestamps and checksums need to be sorted along with the short
+	// channel IDs they belong to, as the order of the IDs is changed
+	// during their encoding.
+	if !c.noSort && (c.Timestamps != nil || c.Checksums != nil) {
+		sort.Sort(&chanIDSorter{
+			shortChanIDs: c.ShortChanIDs,
+			swap:         c.swapChanInfo,
+		})
+	}
+
 	err := encodeShortChanIDs(w, c.EncodingType, c.ShortChanIDs, c.noSort)
 	if err != nil {
 		return err
 	}
 
+	if err := c.encodeChanInfo(); err != nil {
+		return err
+	}
+
 	return WriteBytes(w, c.ExtraData)
 }
 
+// swapChanInfo swaps the timestamps and checksums at the given indexes, if
+// they exist for both.
+func (c *ReplyChannelRange) swapChanInfo(i, j int) {
+	if len(c.Timestamps) > i && len(c.Timestamps) > j {
+		c.Timestamps[i], c.Timestamps[j] = c.Timestamps[j],
+			c.Timestamps[i]
+	}
+	if len(c.Checksums) > i && len(c.Checksums) > j {
+		c.Checksums[i], c.Checksums[j] = c.Checksums[j], c.Checksums[i]
+	}
+}
+
+// encodeChanInfo packs the timestamps and checksums into the extra data of
+// the message, if any of them is set.
+func (c *ReplyChannelRange) encodeChanInfo() error {
+	var records []tlv.RecordProducer
+	if c.Timestamps != nil {
+		payload := make([]byte, 0, len(c.Timestamps)*8)
+		for _, timestamps := range c.Timestamps {
+			payload = appendUint32(payload, timestamps.Timestamp1)
+			payload = appendUint32(payload, timestamps.Timestamp2)
+		}
+
+		// The timestamps are encoded in the same way as the short
+		// channel IDs they belong to.
+		timestamps, err := encodeWithEncoding(c.EncodingType, payload)
+		if err != nil {
+			return err
+		}
+		records = append(records, &encodedArrayRecord{
+			ChanUpdateTimestampsRecordType, &timestamps,
+		})
+	}
+	if c.Checksums != nil {
+		checksums := make([]byte, 0, len(c.Checksums)*8)
+		for _, checksum := range c.Checksums {
+			checksums = appendUint32(checksums, checksum.Checksum1)
+			checksums = appendUint32(checksums, checksum.Checksum2)
+		}
+		records = append(records, &encodedArrayRecord{
+			ChanUpdateChecksumsRecordType, &checksums,
+		})
+	}
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	return EncodeMessageExtraData(&c.ExtraData, records...)
+}
+
+// appendUint32 appends the big endian encoding of the passed integer to the
+// byte slice.
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+// decodeChanUpdateTimestamps decodes the raw bytes of the timestamps TLV
+// record.
+func decodeChanUpdateTimestamps(data []byte) ([]ChanUpdateTimestamps,
+	error) {
+
+	_, payload, err := decodeWithEncoding(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload)%8 != 0 {
+		return nil, fmt.Errorf("whole number of channel update "+
+			"timestamps cannot be encoded in len=%v", len(payload))
+	}
+
+	timestamps := make([]ChanUpdateTimestamps, len(payload)/8)
+	for i := range timestamps {
+		timestamps[i] = ChanUpdateTimestamps{
+			Timestamp1: binary.BigEndian.Uint32(payload[i*8:]),
+			Timestamp2: binary.BigEndian.Uint32(payload[i*8+4:]),
+		}
+	}
+
+	return timestamps, nil
+}
+
+// decodeChanUpdateChecksums decodes the raw bytes of the checksums TLV record.
+func decodeChanUpdateChecksums(data []byte) ([]ChanUpdateChecksums, error) {
+	if len(data)%8 != 0 {
+		return nil, fmt.Errorf("whole number of channel update "+
+			"checksums cannot be encoded in len=%v", len(data))
+	}
+
+	checksums := make([]ChanUpdateChecksums, len(data)/8)
+	for i := range checksums {
+		checksums[i] = ChanUpdateChecksums{
+			Checksum1: binary.BigEndian.Uint32(data[i*8:]),
+			Checksum2: binary.BigEndian.Uint32(data[i*8+4:]),
+		}
+	}
+
+	return checksums, nil
+}
+
 // MsgType returns the integer uniquely identifying this message type on the
 // wire.
 //
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/stretchr/testify/require"
 )
 
 // TestReplyChannelRangeUnsorted tests that decoding a ReplyChannelRange request
@@ -104,3 +105,55 @@ func TestReplyChannelRangeEmpty(t *testing.T) {
 		})
 	}
 }
+
+// TestReplyChannelRangeChanInfo tests that the timestamps and checksums of a
+// ReplyChannelRange are sorted along with the short channel IDs they belong
+// to, and survive an encoding round trip.
+func TestReplyChannelRangeChanInfo(t *testing.T) {
+	t.Parallel()
+
+	for _, encType := range []ShortChanIDEncoding{
+		EncodingSortedPlain, EncodingSortedZlib,
+	} {
+		req := &ReplyChannelRange{
+			EncodingType: encType,
+			ShortChanIDs: []ShortChannelID{
+				NewShortChanIDFromInt(3),
+				NewShortChanIDFromInt(1),
+				NewShortChanIDFromInt(2),
+			},
+			Timestamps: []ChanUpdateTimestamps{
+				{Timestamp1: 30, Timestamp2: 31},
+				{Timestamp1: 10, Timestamp2: 11},
+				{Timestamp1: 20, Timestamp2: 0},
+			},
+			Checksums: []ChanUpdateChecksums{
+				{Checksum1: 300, Checksum2: 301},
+				{Checksum1: 100, Checksum2: 101},
+				{Checksum1: 200, Checksum2: 0},
+			},
+		}
+
+		var b bytes.Buffer
+		require.NoError(t, req.Encode(&b, 0))
+
+		var req2 ReplyChannelRange
+		require.NoError(t, req2.Decode(bytes.NewReader(b.Bytes()), 0))
+
+		require.Equal(t, []ShortChannelID{
+			NewShortChanIDFromInt(1),
+			NewShortChanIDFromInt(2),
+			NewShortChanIDFromInt(3),
+		}, req2.ShortChanIDs)
+		require.Equal(t, []ChanUpdateTimestamps{
+			{Timestamp1: 10, Timestamp2: 11},
+			{Timestamp1: 20, Timestamp2: 0},
+			{Timestamp1: 30, Timestamp2: 31},
+		}, req2.Timestamps)
+		require.Equal(t, []ChanUpdateChecksums{
+			{Checksum1: 100, Checksum2: 101},
+			{Checksum1: 200, Checksum2: 0},
+			{Checksum1: 300, Checksum2: 301},
+		}, req2.Checksums)
+	}
+}
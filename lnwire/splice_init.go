@@ -0,0 +1,97 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/ltcsuite/ltcd/ltcutil"
+)
+
+// SpliceInit is sent by the initiator of a splice to propose that the funding
+// output of an existing channel is replaced by a new one. The new funding
+// transaction spends the current funding output and is constructed
+// interactively, after which the channel continues to operate on both funding
+// outputs until the splice transaction is locked in.
+type SpliceInit struct {
+	// ChanID is the channel that should be spliced.
+	ChanID ChannelID
+
+	// FundingContribution is the amount that the initiator adds to the
+	// channel. A negative amount denotes funds that are spliced out of the
+	// initiator's balance.
+	FundingContribution ltcutil.Amount
+
+	// FundingFeePerKWeight is the fee rate in sat/kw that is used for the
+	// splice transaction.
+	FundingFeePerKWeight uint32
+
+	// LockTime is the lock time of the splice transaction.
+	LockTime uint32
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceInit implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceInit)(nil)
+
+// Encode serializes the target SpliceInit into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, s.ChanID); err != nil {
+		return err
+	}
+
+	// The contribution is a signed integer that is written as its two's
+	// complement.
+	if err := WriteSatoshi(w, s.FundingContribution); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, s.FundingFeePerKWeight); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, s.LockTime); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes the serialized SpliceInit stored in the passed
+// io.Reader into the target SpliceInit using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r,
+		&s.ChanID,
+		&s.FundingContribution,
+		&s.FundingFeePerKWeight,
+		&s.LockTime,
+		&s.ExtraData,
+	)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a SpliceInit on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MsgType() MessageType {
+	return MsgSpliceInit
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (s *SpliceInit) TargetChanID() ChannelID {
+	return s.ChanID
+}
@@ -0,0 +1,163 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+)
+
+const (
+	// SharedInputTxidRecordType is the type of the TLV record used to
+	// mark the input of a TxAddInput message as the current funding
+	// output of a channel that is being spliced.
+	SharedInputTxidRecordType tlv.Type = 0
+)
+
+// SharedInputTxid is the txid of the current funding transaction of a channel
+// that is spent by a splice transaction. As both parties know the funding
+// transaction, it replaces the full previous transaction of the input.
+type SharedInputTxid chainhash.Hash
+
+// Record returns a TLV record that can be used to encode/decode the shared
+// input txid to/from a TLV stream.
+func (s *SharedInputTxid) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(
+		SharedInputTxidRecordType, (*[32]byte)(s),
+	)
+}
+
+// TxAddInput is sent by either party during the interactive construction of
+// a transaction to add one of its inputs to the transaction. The input is
+// identified by the full transaction that created the spent output, which
+// allows the receiver to verify its amount and script.
+type TxAddInput struct {
+	// ChanID is the channel that the transaction is constructed for.
+	ChanID ChannelID
+
+	// SerialID identifies the input within the negotiation. The inputs of
+	// the final transaction are ordered by their serial ID. The initiator
+	// of the negotiation must use even serial IDs and the responder odd
+	// ones.
+	SerialID uint64
+
+	// PrevTx is the serialized transaction that created the output that
+	// is spent by the input. It is empty if SharedInputTxid is set.
+	PrevTx []byte
+
+	// PrevTxOut is the index of the spent output within PrevTx.
+	PrevTxOut uint32
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// SharedInputTxid is set if the input spends the current funding
+	// output of the channel during a splice, in which case it holds the
+	// txid of the funding transaction.
+	SharedInputTxid *chainhash.Hash
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddInput)(nil)
+
+// Encode serializes the target TxAddInput into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	if err := writeDataWithLength(w, t.PrevTx); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.PrevTxOut); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.Sequence); err != nil {
+		return err
+	}
+
+	// We'll only encode the SharedInputTxid in a TLV segment if it
+	// exists.
+	if t.SharedInputTxid != nil {
+		sharedInput := SharedInputTxid(*t.SharedInputTxid)
+		err := EncodeMessageExtraData(&t.ExtraData, &sharedInput)
+		if err != nil {
+			return err
+		}
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes the serialized TxAddInput stored in the passed
+// io.Reader into the target TxAddInput using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Decode(r io.Reader, pver uint32) error {
+	if err := ReadElements(r, &t.ChanID, &t.SerialID); err != nil {
+		return err
+	}
+
+	var err error
+	t.PrevTx, err = readDataWithLength(r)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	err = ReadElements(r, &t.PrevTxOut, &t.Sequence, &tlvRecords)
+	if err != nil {
+		return err
+	}
+
+	var sharedInput SharedInputTxid
+	typeMap, err := tlvRecords.ExtractRecords(&sharedInput)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set SharedInputTxid if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[SharedInputTxidRecordType]; ok && val == nil {
+		txid := chainhash.Hash(sharedInput)
+		t.SharedInputTxid = &txid
+	}
+
+	t.ExtraData = tlvRecords
+
+	return nil
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a TxAddInput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MsgType() MessageType {
+	return MsgTxAddInput
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxAddInput) TargetChanID() ChannelID {
+	return t.ChanID
+}
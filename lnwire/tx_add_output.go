@@ -0,0 +1,96 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/ltcsuite/ltcd/ltcutil"
+)
+
+// TxAddOutput is sent by either party during the interactive construction of
+// a transaction to add an output to the transaction.
+type TxAddOutput struct {
+	// ChanID is the channel that the transaction is constructed for.
+	ChanID ChannelID
+
+	// SerialID identifies the output within the negotiation. The outputs
+	// of the final transaction are ordered by their serial ID. The
+	// initiator of the negotiation must use even serial IDs and the
+	// responder odd ones.
+	SerialID uint64
+
+	// Amount is the value of the output.
+	Amount ltcutil.Amount
+
+	// PkScript is the script of the output.
+	PkScript []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddOutput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddOutput)(nil)
+
+// Encode serializes the target TxAddOutput into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, t.Amount); err != nil {
+		return err
+	}
+
+	if err := writeDataWithLength(w, t.PkScript); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes the serialized TxAddOutput stored in the passed
+// io.Reader into the target TxAddOutput using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Decode(r io.Reader, pver uint32) error {
+	err := ReadElements(r, &t.ChanID, &t.SerialID, &t.Amount)
+	if err != nil {
+		return err
+	}
+
+	t.PkScript, err = readDataWithLength(r)
+	if err != nil {
+		return err
+	}
+
+	return t.ExtraData.Decode(r)
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a TxAddOutput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) MsgType() MessageType {
+	return MsgTxAddOutput
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxAddOutput) TargetChanID() ChannelID {
+	return t.ChanID
+}
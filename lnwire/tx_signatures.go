@@ -0,0 +1,209 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/wire"
+)
+
+const (
+	// SharedInputSigRecordType is the type of the TLV record used to
+	// communicate the signature for the shared funding input of a splice
+	// transaction within the TxSignatures message.
+	SharedInputSigRecordType tlv.Type = 0
+)
+
+// SharedInputSig is the signature of the sender for the current funding
+// output of a channel, which is spent by a splice transaction. The witness
+// of that input requires signatures of both parties, so it can't be sent
+// along with the witnesses of the sender's own inputs.
+type SharedInputSig Sig
+
+// Record returns a TLV record that can be used to encode/decode the shared
+// input signature to/from a TLV stream.
+func (s *SharedInputSig) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(
+		SharedInputSigRecordType, (*[64]byte)(s),
+	)
+}
+
+// TxSignatures is sent by either party once the interactive construction of
+// a transaction is complete and the commitment signatures of the channel have
+// been exchanged. It carries the witnesses of all inputs that the sender
+// added to the transaction.
+type TxSignatures struct {
+	// ChanID is the channel that the transaction is constructed for.
+	ChanID ChannelID
+
+	// TxHash is the txid of the constructed transaction.
+	TxHash chainhash.Hash
+
+	// Witnesses are the witnesses of the inputs that the sender added to
+	// the transaction, ordered by their serial ID.
+	Witnesses []wire.TxWitness
+
+	// SharedInputSig is the sender's signature for the shared funding
+	// input if the transaction is a splice transaction.
+	SharedInputSig *Sig
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxSignatures implements the lnwire.Message
+// interface.
+var _ Message = (*TxSignatures)(nil)
+
+// Encode serializes the target TxSignatures into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, t.TxHash[:]); err != nil {
+		return err
+	}
+
+	if err := WriteUint16(w, uint16(len(t.Witnesses))); err != nil {
+		return err
+	}
+
+	// Each witness is written as its regular transaction serialization,
+	// prefixed with its length.
+	for _, witness := range t.Witnesses {
+		var b bytes.Buffer
+		err := wire.WriteVarInt(&b, 0, uint64(len(witness)))
+		if err != nil {
+			return err
+		}
+
+		for _, item := range witness {
+			if err := wire.WriteVarBytes(&b, 0, item); err != nil {
+				return err
+			}
+		}
+
+		if err := writeDataWithLength(w, b.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	// We'll only encode the SharedInputSig in a TLV segment if it exists.
+	if t.SharedInputSig != nil {
+		sharedSig := SharedInputSig(*t.SharedInputSig)
+		err := EncodeMessageExtraData(&t.ExtraData, &sharedSig)
+		if err != nil {
+			return err
+		}
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes the serialized TxSignatures stored in the passed
+// io.Reader into the target TxSignatures using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Decode(r io.Reader, pver uint32) error {
+	err := ReadElements(r, &t.ChanID, t.TxHash[:])
+	if err != nil {
+		return err
+	}
+
+	var numWitnesses [2]byte
+	if _, err := io.ReadFull(r, numWitnesses[:]); err != nil {
+		return err
+	}
+
+	t.Witnesses = make(
+		[]wire.TxWitness, binary.BigEndian.Uint16(numWitnesses[:]),
+	)
+	for i := range t.Witnesses {
+		data, err := readDataWithLength(r)
+		if err != nil {
+			return err
+		}
+
+		t.Witnesses[i], err = decodeWitness(data)
+		if err != nil {
+			return err
+		}
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := tlvRecords.Decode(r); err != nil {
+		return err
+	}
+
+	var sharedSig SharedInputSig
+	typeMap, err := tlvRecords.ExtractRecords(&sharedSig)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set SharedInputSig if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[SharedInputSigRecordType]; ok && val == nil {
+		sig := Sig(sharedSig)
+		t.SharedInputSig = &sig
+	}
+
+	t.ExtraData = tlvRecords
+
+	return nil
+}
+
+// decodeWitness parses a witness stack in its transaction serialization.
+func decodeWitness(data []byte) (wire.TxWitness, error) {
+	r := bytes.NewReader(data)
+
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each item of the witness takes up at least one byte, so we can
+	// reject witnesses that claim to have more items early.
+	if numItems > uint64(len(data)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	witness := make(wire.TxWitness, numItems)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(
+			r, 0, MaxSliceLength, "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return witness, nil
+}
+
+// MsgType returns the MessageType code which uniquely identifies this message
+// as a TxSignatures on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MsgType() MessageType {
+	return MsgTxSignatures
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxSignatures) TargetChanID() ChannelID {
+	return t.ChanID
+}
@@ -3,13 +3,38 @@ package lnwire
 import (
 	"bytes"
 	"io"
+
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+)
+
+const (
+	// OnionPacketSize is the size of the serialized Sphinx onion packet
+	// included in each UpdateAddHTLC message. The breakdown of the onion
+	// packet is as follows: 1-byte version, 33-byte ephemeral public key
+	// (for ECDH), 1300-bytes of per-hop data, and a 32-byte HMAC over the
+	// entire packet.
+	OnionPacketSize = 1366
+
+	// BlindingPointRecordType is the type of the TLV record used to
+	// communicate the route blinding point of an HTLC within the
+	// UpdateAddHTLC message.
+	BlindingPointRecordType tlv.Type = 0
 )
 
-// OnionPacketSize is the size of the serialized Sphinx onion packet included
-// in each UpdateAddHTLC message. The breakdown of the onion packet is as
-// follows: 1-byte version, 33-byte ephemeral public key (for ECDH), 1300-bytes
-// of per-hop data, and a 32-byte HMAC over the entire packet.
-const OnionPacketSize = 1366
+// BlindingPoint is the ephemeral public key used by a node within a blinded
+// route to derive the shared secret that unlocks its blinded route data. It is
+// sent to every node in a blinded route except the introduction node, which
+// receives it within its onion payload instead.
+type BlindingPoint struct {
+	*btcec.PublicKey
+}
+
+// Record returns a TLV record that can be used to encode/decode the blinding
+// point to/from a TLV stream.
+func (b *BlindingPoint) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(BlindingPointRecordType, &b.PublicKey)
+}
 
 // UpdateAddHTLC is the message sent by Alice to Bob when she wishes to add an
 // HTLC to his remote commitment transaction. In addition to information
@@ -54,6 +79,11 @@ type UpdateAddHTLC struct {
 	// used in the subsequent UpdateAddHTLC message.
 	OnionBlob [OnionPacketSize]byte
 
+	// BlindingPoint is the route blinding ephemeral key that should be
+	// used by the receiving node to process the HTLC if it is part of a
+	// blinded route. This is nil for regular HTLCs.
+	BlindingPoint *btcec.PublicKey
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -74,15 +104,41 @@ var _ Message = (*UpdateAddHTLC)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	// Read all the mandatory fields in the message.
+	err := ReadElements(r,
 		&c.ChanID,
 		&c.ID,
 		&c.Amount,
 		c.PaymentHash[:],
 		&c.Expiry,
 		c.OnionBlob[:],
-		&c.ExtraData,
 	)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	// Next we'll parse out the set of known records. For now, this is just
+	// the BlindingPointRecordType.
+	var blindingPoint BlindingPoint
+	typeMap, err := tlvRecords.ExtractRecords(&blindingPoint)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set BlindingPoint if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[BlindingPointRecordType]; ok && val == nil {
+		c.BlindingPoint = blindingPoint.PublicKey
+	}
+
+	c.ExtraData = tlvRecords
+
+	return nil
 }
 
 // Encode serializes the target UpdateAddHTLC into the passed io.Writer
@@ -114,6 +170,17 @@ func (c *UpdateAddHTLC) Encode(w *bytes.Buffer, pver uint32) error {
 		return err
 	}
 
+	// We'll only encode the BlindingPoint in a TLV segment if it exists.
+	if c.BlindingPoint != nil {
+		recordProducers := []tlv.RecordProducer{
+			&BlindingPoint{c.BlindingPoint},
+		}
+		err := EncodeMessageExtraData(&c.ExtraData, recordProducers...)
+		if err != nil {
+			return err
+		}
+	}
+
 	return WriteBytes(w, c.ExtraData)
 }
 
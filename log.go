@@ -15,6 +15,8 @@ import (
 	"github.com/ltcsuite/lnd/cluster"
 	"github.com/ltcsuite/lnd/contractcourt"
 	"github.com/ltcsuite/lnd/discovery"
+	"github.com/ltcsuite/lnd/discovery/rapidsync"
+	"github.com/ltcsuite/lnd/feepolicy"
 	"github.com/ltcsuite/lnd/funding"
 	"github.com/ltcsuite/lnd/healthcheck"
 	"github.com/ltcsuite/lnd/htlcswitch"
@@ -30,15 +32,20 @@ import (
 	"github.com/ltcsuite/lnd/lnwallet/btcwallet"
 	"github.com/ltcsuite/lnd/lnwallet/chancloser"
 	"github.com/ltcsuite/lnd/lnwallet/chanfunding"
+	"github.com/ltcsuite/lnd/lnwallet/chansplicer"
 	"github.com/ltcsuite/lnd/lnwallet/rpcwallet"
 	"github.com/ltcsuite/lnd/monitoring"
 	"github.com/ltcsuite/lnd/netann"
+	"github.com/ltcsuite/lnd/offers"
+	"github.com/ltcsuite/lnd/onionmessage"
 	"github.com/ltcsuite/lnd/peer"
 	"github.com/ltcsuite/lnd/peernotifier"
+	"github.com/ltcsuite/lnd/rebalancer"
 	"github.com/ltcsuite/lnd/routing"
 	"github.com/ltcsuite/lnd/routing/localchans"
 	"github.com/ltcsuite/lnd/rpcperms"
 	"github.com/ltcsuite/lnd/signal"
+	"github.com/ltcsuite/lnd/sqldb"
 	"github.com/ltcsuite/lnd/sweep"
 	"github.com/ltcsuite/lnd/tor"
 	"github.com/ltcsuite/lnd/watchtower"
@@ -141,6 +148,7 @@ func SetupLoggers(root *build.RotatingLogWriter, interceptor signal.Interceptor)
 	AddSubLogger(root, "WLKT", interceptor, walletrpc.UseLogger)
 	AddSubLogger(root, "ARPC", interceptor, autopilotrpc.UseLogger)
 	AddSubLogger(root, "INVC", interceptor, invoices.UseLogger)
+	AddSubLogger(root, "SQLD", interceptor, sqldb.UseLogger)
 	AddSubLogger(root, "NANN", interceptor, netann.UseLogger)
 	AddSubLogger(root, "WTWR", interceptor, watchtower.UseLogger)
 	AddSubLogger(root, "NTFR", interceptor, chainrpc.UseLogger)
@@ -153,10 +161,13 @@ func SetupLoggers(root *build.RotatingLogWriter, interceptor signal.Interceptor)
 	AddSubLogger(root, "CHFD", interceptor, chanfunding.UseLogger)
 	AddSubLogger(root, "PEER", interceptor, peer.UseLogger)
 	AddSubLogger(root, "CHCL", interceptor, chancloser.UseLogger)
+	AddSubLogger(root, "CHSP", interceptor, chansplicer.UseLogger)
 
 	AddSubLogger(root, routing.Subsystem, interceptor, routing.UseLogger, localchans.UseLogger)
 	AddSubLogger(root, routerrpc.Subsystem, interceptor, routerrpc.UseLogger)
 	AddSubLogger(root, chanfitness.Subsystem, interceptor, chanfitness.UseLogger)
+	AddSubLogger(root, rebalancer.Subsystem, interceptor, rebalancer.UseLogger)
+	AddSubLogger(root, feepolicy.Subsystem, interceptor, feepolicy.UseLogger)
 	AddSubLogger(root, verrpc.Subsystem, interceptor, verrpc.UseLogger)
 	AddSubLogger(root, healthcheck.Subsystem, interceptor, healthcheck.UseLogger)
 	AddSubLogger(root, chainreg.Subsystem, interceptor, chainreg.UseLogger)
@@ -167,6 +178,9 @@ func SetupLoggers(root *build.RotatingLogWriter, interceptor signal.Interceptor)
 	AddSubLogger(root, tor.Subsystem, interceptor, tor.UseLogger)
 	AddSubLogger(root, btcwallet.Subsystem, interceptor, btcwallet.UseLogger)
 	AddSubLogger(root, rpcwallet.Subsystem, interceptor, rpcwallet.UseLogger)
+	AddSubLogger(root, onionmessage.Subsystem, interceptor, onionmessage.UseLogger)
+	AddSubLogger(root, offers.Subsystem, interceptor, offers.UseLogger)
+	AddSubLogger(root, rapidsync.Subsystem, interceptor, rapidsync.UseLogger)
 }
 
 // AddSubLogger is a helper method to conveniently create and register the
@@ -8,6 +8,7 @@ COVER_PKG = $$(go list -deps -tags="$(DEV_TAGS)" ./... | grep '$(PKG)' | grep -v
 NUM_ITEST_TRANCHES = 4
 ITEST_PARALLELISM = $(NUM_ITEST_TRANCHES)
 POSTGRES_START_DELAY = 5
+ITEST_CGO_ENABLED = 0
 
 # If rpc option is set also add all extra RPC tags to DEV_TAGS
 ifneq ($(with-rpc),)
@@ -63,6 +64,13 @@ ifeq ($(dbbackend),postgres)
 DEV_TAGS += kvdb_postgres
 endif
 
+# The sqlite driver is a cgo wrapper around the sqlite C library, so the itest
+# lnd binary needs to be built with cgo for that backend.
+ifeq ($(dbbackend),sqlite)
+DEV_TAGS += kvdb_sqlite
+ITEST_CGO_ENABLED = 1
+endif
+
 ifneq ($(tags),)
 DEV_TAGS += ${tags}
 endif
@@ -0,0 +1,304 @@
+package offers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/btcec/v2/schnorr"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil/bech32"
+)
+
+const (
+	// OfferHRP is the human readable part of an encoded offer.
+	OfferHRP = "lno"
+
+	// InvoiceRequestHRP is the human readable part of an encoded invoice
+	// request.
+	InvoiceRequestHRP = "lnr"
+
+	// InvoiceHRP is the human readable part of an encoded invoice.
+	InvoiceHRP = "lni"
+
+	// charset is the bech32 character set used to encode the TLV stream
+	// of offers, invoice requests and invoices.
+	charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+)
+
+var (
+	// ErrInvalidEncoding is returned when a string can't be decoded as a
+	// BOLT 12 offer, invoice request or invoice.
+	ErrInvalidEncoding = errors.New("invalid bolt 12 encoding")
+
+	// ErrUnknownEvenType is returned when a TLV stream contains an even
+	// type that we don't understand.
+	ErrUnknownEvenType = errors.New("unknown even type")
+)
+
+// encodeString encodes the passed TLV stream as a bech32 string with the
+// given human readable part. Unlike BOLT 11 invoices, BOLT 12 strings don't
+// carry a checksum, as they are signed instead.
+func encodeString(hrp string, data []byte) (string, error) {
+	converted, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, c := range converted {
+		b.WriteByte(charset[c])
+	}
+
+	return b.String(), nil
+}
+
+// decodeString decodes a bech32 string without a checksum, returning its
+// human readable part and the TLV stream it encodes. Strings that were split
+// into several parts joined by a '+' followed by optional whitespace are
+// accepted as well.
+func decodeString(s string) (string, []byte, error) {
+	s, err := joinParts(s)
+	if err != nil {
+		return "", nil, err
+	}
+
+	lower := strings.ToLower(s)
+	if s != lower && s != strings.ToUpper(s) {
+		return "", nil, fmt.Errorf("%w: mixed case", ErrInvalidEncoding)
+	}
+	s = lower
+
+	sep := strings.LastIndexByte(s, '1')
+	if sep < 1 || sep == len(s)-1 {
+		return "", nil, fmt.Errorf("%w: invalid separator position",
+			ErrInvalidEncoding)
+	}
+
+	data := make([]byte, 0, len(s)-sep-1)
+	for _, c := range s[sep+1:] {
+		idx := strings.IndexRune(charset, c)
+		if idx < 0 {
+			return "", nil, fmt.Errorf("%w: invalid character %q",
+				ErrInvalidEncoding, c)
+		}
+
+		data = append(data, byte(idx))
+	}
+
+	converted, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %v", ErrInvalidEncoding, err)
+	}
+
+	return s[:sep], converted, nil
+}
+
+// joinParts joins a string that was split into several parts using '+'
+// followed by optional whitespace.
+func joinParts(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "+") || strings.HasSuffix(s, "+") {
+		return "", fmt.Errorf("%w: leading or trailing '+'",
+			ErrInvalidEncoding)
+	}
+
+	parts := strings.Split(s, "+")
+	for i, part := range parts {
+		if i > 0 {
+			part = strings.TrimLeftFunc(part, unicode.IsSpace)
+		}
+
+		if part == "" {
+			return "", fmt.Errorf("%w: empty part",
+				ErrInvalidEncoding)
+		}
+
+		parts[i] = part
+	}
+
+	return strings.Join(parts, ""), nil
+}
+
+// encodeRecords serializes the passed records as a TLV stream.
+func encodeRecords(records map[uint64][]byte) ([]byte, error) {
+	stream, err := tlv.NewStream(tlv.MapToRecords(records)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeRecords parses a TLV stream into its records. The types of the
+// stream must be strictly increasing.
+func decodeRecords(data []byte) (tlv.TypeMap, error) {
+	stream, err := tlv.NewStream()
+	if err != nil {
+		return nil, err
+	}
+
+	return stream.DecodeWithParsedTypes(bytes.NewReader(data))
+}
+
+// takeUnknownRecords removes all records with a type in the range [min, max]
+// from the passed records and returns them. An error is returned if any of
+// them has an even type, as those must be understood.
+func takeUnknownRecords(records tlv.TypeMap, min,
+	max tlv.Type) (map[uint64][]byte, error) {
+
+	var unknown map[uint64][]byte
+	for recordType, value := range records {
+		if recordType < min || recordType > max {
+			continue
+		}
+
+		if recordType%2 == 0 {
+			return nil, fmt.Errorf("%w: %d", ErrUnknownEvenType,
+				recordType)
+		}
+
+		if unknown == nil {
+			unknown = make(map[uint64][]byte)
+		}
+		unknown[uint64(recordType)] = value
+		delete(records, recordType)
+	}
+
+	return unknown, nil
+}
+
+// encodeTU64 returns the truncated encoding of the passed integer.
+func encodeTU64(v uint64) []byte {
+	var (
+		b   bytes.Buffer
+		buf [8]byte
+	)
+
+	// Writing to a bytes.Buffer can't fail.
+	_ = tlv.ETUint64T(&b, v, &buf)
+
+	return b.Bytes()
+}
+
+// decodeTU64 parses a truncated integer.
+func decodeTU64(value []byte) (uint64, error) {
+	var (
+		v   uint64
+		buf [8]byte
+	)
+
+	err := tlv.DTUint64(
+		bytes.NewReader(value), &v, &buf, uint64(len(value)),
+	)
+
+	return v, err
+}
+
+// decodePubKey parses a compressed public key.
+func decodePubKey(value []byte) (*btcec.PublicKey, error) {
+	if len(value) != btcec.PubKeyBytesLenCompressed {
+		return nil, fmt.Errorf("invalid public key length %d",
+			len(value))
+	}
+
+	return btcec.ParsePubKey(value)
+}
+
+// decodeSignature parses a BIP 340 signature.
+func decodeSignature(value []byte) (*schnorr.Signature, error) {
+	return schnorr.ParseSignature(value)
+}
+
+// encodeChains serializes a list of chain hashes.
+func encodeChains(chains []chainhash.Hash) []byte {
+	b := make([]byte, 0, len(chains)*chainhash.HashSize)
+	for _, chain := range chains {
+		b = append(b, chain[:]...)
+	}
+
+	return b
+}
+
+// decodeChains parses a list of chain hashes.
+func decodeChains(value []byte) ([]chainhash.Hash, error) {
+	if len(value)%chainhash.HashSize != 0 {
+		return nil, fmt.Errorf("invalid chains length %d", len(value))
+	}
+
+	chains := make([]chainhash.Hash, len(value)/chainhash.HashSize)
+	for i := range chains {
+		copy(chains[i][:], value[i*chainhash.HashSize:])
+	}
+
+	return chains, nil
+}
+
+// encodeFeatures serializes a feature vector without a length prefix.
+func encodeFeatures(features *lnwire.RawFeatureVector) ([]byte, error) {
+	var b bytes.Buffer
+	if err := features.EncodeBase256(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeFeatures parses a feature vector that was serialized without a
+// length prefix.
+func decodeFeatures(value []byte) (*lnwire.RawFeatureVector, error) {
+	features := lnwire.NewRawFeatureVector()
+	err := features.DecodeBase256(bytes.NewReader(value), len(value))
+	if err != nil {
+		return nil, err
+	}
+
+	return features, nil
+}
+
+// encodePaths serializes a list of blinded paths.
+func encodePaths(paths []*blindedpath.BlindedPath) ([]byte, error) {
+	var b bytes.Buffer
+	for _, path := range paths {
+		if err := path.Encode(&b); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodePaths parses a list of blinded paths.
+func decodePaths(value []byte) ([]*blindedpath.BlindedPath, error) {
+	var (
+		r     = bytes.NewReader(value)
+		paths []*blindedpath.BlindedPath
+	)
+	for r.Len() > 0 {
+		path, err := blindedpath.DecodeBlindedPath(r)
+		if err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	if len(paths) == 0 {
+		return nil, blindedpath.ErrNoHops
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,420 @@
+package offers
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/lnd/zpay32"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/btcec/v2/schnorr"
+)
+
+const (
+	invoicePathsType          tlv.Type = 160
+	invoiceBlindedPayType     tlv.Type = 162
+	invoiceCreatedAtType      tlv.Type = 164
+	invoiceRelativeExpiryType tlv.Type = 166
+	invoicePaymentHashType    tlv.Type = 168
+	invoiceAmountType         tlv.Type = 170
+	invoiceFeaturesType       tlv.Type = 174
+	invoiceNodeIDType         tlv.Type = 176
+
+	// minInvoiceType and maxInvoiceType bound the range of types that are
+	// used for the fields of an invoice.
+	minInvoiceType tlv.Type = 160
+	maxInvoiceType tlv.Type = 239
+
+	// invoiceName is the message name that is used in the signature tag
+	// of invoices.
+	invoiceName = "invoice"
+
+	// DefaultInvoiceExpiry is the expiry of an invoice that does not
+	// specify a relative expiry.
+	DefaultInvoiceExpiry = 2 * time.Hour
+)
+
+var (
+	// ErrNoPaymentPaths is returned when an invoice does not contain any
+	// blinded paths to pay to.
+	ErrNoPaymentPaths = errors.New("invoice requires payment paths")
+
+	// ErrNoNodeID is returned when an invoice does not contain the key
+	// that it was signed with.
+	ErrNoNodeID = errors.New("invoice requires a node id")
+)
+
+// Invoice is an invoice that the issuer of an offer created in response to
+// an invoice request.
+type Invoice struct {
+	// InvoiceRequest is the invoice request that the invoice was created
+	// for. Its records are mirrored in the invoice, apart from its
+	// signature.
+	InvoiceRequest *InvoiceRequest
+
+	// PaymentPaths is the set of blinded paths that the invoice can be
+	// paid over, along with their aggregate relay parameters.
+	PaymentPaths []*zpay32.BlindedPaymentPath
+
+	// CreatedAt is the time at which the invoice was created.
+	CreatedAt time.Time
+
+	// RelativeExpiry is the duration after its creation during which the
+	// invoice can be paid. If it is zero, DefaultInvoiceExpiry applies.
+	RelativeExpiry time.Duration
+
+	// PaymentHash is the hash of the preimage that is revealed once the
+	// invoice is paid.
+	PaymentHash lntypes.Hash
+
+	// Amount is the amount that must be paid.
+	Amount lnwire.MilliSatoshi
+
+	// Features is the set of features that the invoice requires.
+	Features *lnwire.RawFeatureVector
+
+	// NodeID is the key that the invoice is signed with.
+	NodeID *btcec.PublicKey
+
+	// Signature is the signature of the invoice using the node ID.
+	Signature *schnorr.Signature
+
+	// unknownRecords holds the unknown odd records of the invoice.
+	unknownRecords map[uint64][]byte
+}
+
+// Sign signs the invoice using the passed signer, which must sign with the
+// private key of the node ID.
+func (i *Invoice) Sign(sign SignFunc) error {
+	digest, err := i.signatureDigest()
+	if err != nil {
+		return err
+	}
+
+	i.Signature, err = sign(digest)
+
+	return err
+}
+
+// Verify checks that the invoice is well formed and was signed by its node
+// ID.
+func (i *Invoice) Verify() error {
+	switch {
+	case i.InvoiceRequest == nil:
+		return fmt.Errorf("%w: no invoice request", ErrInvalidEncoding)
+
+	case len(i.PaymentPaths) == 0:
+		return ErrNoPaymentPaths
+
+	case i.NodeID == nil:
+		return ErrNoNodeID
+
+	case i.Signature == nil:
+		return ErrInvalidSignature
+	}
+
+	digest, err := i.signatureDigest()
+	if err != nil {
+		return err
+	}
+
+	if !i.Signature.Verify(digest[:], i.NodeID) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Expiry returns the time after which the invoice can no longer be paid.
+func (i *Invoice) Expiry() time.Time {
+	expiry := i.RelativeExpiry
+	if expiry == 0 {
+		expiry = DefaultInvoiceExpiry
+	}
+
+	return i.CreatedAt.Add(expiry)
+}
+
+// Serialize returns the TLV stream of the invoice.
+func (i *Invoice) Serialize() ([]byte, error) {
+	records := make(map[uint64][]byte)
+	if err := i.encodeRecords(records); err != nil {
+		return nil, err
+	}
+
+	if i.Signature != nil {
+		records[uint64(signatureType)] = i.Signature.Serialize()
+	}
+
+	return encodeRecords(records)
+}
+
+// Encode returns the bech32 encoded invoice string.
+func (i *Invoice) Encode() (string, error) {
+	data, err := i.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeString(InvoiceHRP, data)
+}
+
+// DeserializeInvoice parses the TLV stream of an invoice.
+func DeserializeInvoice(data []byte) (*Invoice, error) {
+	records, err := decodeRecords(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// The invoice request that the invoice mirrors is not signed, so we
+	// need to take the invoice's signature before parsing it.
+	var signature *schnorr.Signature
+	if value, ok := records[signatureType]; ok {
+		signature, err = decodeSignature(value)
+		if err != nil {
+			return nil, err
+		}
+		delete(records, signatureType)
+	}
+
+	req, err := decodeInvoiceRequestRecords(records)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := decodeInvoiceRecords(records)
+	if err != nil {
+		return nil, err
+	}
+	invoice.InvoiceRequest = req
+	invoice.Signature = signature
+
+	if len(records) > 0 {
+		return nil, fmt.Errorf("%w: %d records outside of the invoice "+
+			"range", ErrInvalidEncoding, len(records))
+	}
+
+	return invoice, nil
+}
+
+// DecodeInvoice parses a bech32 encoded invoice string.
+func DecodeInvoice(s string) (*Invoice, error) {
+	hrp, data, err := decodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if hrp != InvoiceHRP {
+		return nil, fmt.Errorf("%w: unexpected prefix %v",
+			ErrInvalidEncoding, hrp)
+	}
+
+	return DeserializeInvoice(data)
+}
+
+// signatureDigest returns the digest that the issuer signs.
+func (i *Invoice) signatureDigest() ([32]byte, error) {
+	records := make(map[uint64][]byte)
+	if err := i.encodeRecords(records); err != nil {
+		return [32]byte{}, err
+	}
+
+	return signatureDigest(invoiceName, records)
+}
+
+// encodeRecords adds the records of the invoice, including the mirrored
+// records of its invoice request, to the passed map. The signature is not
+// included.
+func (i *Invoice) encodeRecords(records map[uint64][]byte) error {
+	if i.InvoiceRequest != nil {
+		err := i.InvoiceRequest.encodeRecords(records)
+		if err != nil {
+			return err
+		}
+	}
+
+	for recordType, value := range i.unknownRecords {
+		records[recordType] = value
+	}
+
+	if len(i.PaymentPaths) > 0 {
+		var paths, payInfo bytes.Buffer
+		for _, path := range i.PaymentPaths {
+			if err := path.BlindedPath.Encode(&paths); err != nil {
+				return err
+			}
+
+			if err := encodePayInfo(&payInfo, path); err != nil {
+				return err
+			}
+		}
+
+		records[uint64(invoicePathsType)] = paths.Bytes()
+		records[uint64(invoiceBlindedPayType)] = payInfo.Bytes()
+	}
+
+	records[uint64(invoiceCreatedAtType)] = encodeTU64(
+		uint64(i.CreatedAt.Unix()),
+	)
+
+	if i.RelativeExpiry > 0 {
+		records[uint64(invoiceRelativeExpiryType)] = encodeTU64(
+			uint64(i.RelativeExpiry / time.Second),
+		)
+	}
+
+	records[uint64(invoicePaymentHashType)] = i.PaymentHash[:]
+	records[uint64(invoiceAmountType)] = encodeTU64(uint64(i.Amount))
+
+	if i.Features != nil && !i.Features.IsEmpty() {
+		features, err := encodeFeatures(i.Features)
+		if err != nil {
+			return err
+		}
+		records[uint64(invoiceFeaturesType)] = features
+	}
+
+	if i.NodeID != nil {
+		records[uint64(invoiceNodeIDType)] =
+			i.NodeID.SerializeCompressed()
+	}
+
+	return nil
+}
+
+// decodeInvoiceRecords parses the invoice records of the passed TLV records
+// and removes them from it.
+func decodeInvoiceRecords(records tlv.TypeMap) (*Invoice, error) {
+	var (
+		invoice = &Invoice{}
+		payInfo []byte
+		err     error
+	)
+
+	for recordType, value := range records {
+		switch recordType {
+		case invoicePathsType:
+			paths, err := decodePaths(value)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, path := range paths {
+				invoice.PaymentPaths = append(
+					invoice.PaymentPaths,
+					&zpay32.BlindedPaymentPath{
+						BlindedPath: path,
+					},
+				)
+			}
+
+		case invoiceBlindedPayType:
+			payInfo = value
+
+		case invoiceCreatedAtType:
+			var createdAt uint64
+			createdAt, err = decodeTU64(value)
+			invoice.CreatedAt = time.Unix(int64(createdAt), 0)
+
+		case invoiceRelativeExpiryType:
+			var expiry uint64
+			expiry, err = decodeTU64(value)
+			invoice.RelativeExpiry = time.Duration(expiry) *
+				time.Second
+
+		case invoicePaymentHashType:
+			invoice.PaymentHash, err = lntypes.MakeHash(value)
+
+		case invoiceAmountType:
+			var amt uint64
+			amt, err = decodeTU64(value)
+			invoice.Amount = lnwire.MilliSatoshi(amt)
+
+		case invoiceFeaturesType:
+			invoice.Features, err = decodeFeatures(value)
+
+		case invoiceNodeIDType:
+			invoice.NodeID, err = decodePubKey(value)
+
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid invoice record %d: %w",
+				recordType, err)
+		}
+
+		delete(records, recordType)
+	}
+
+	// Every blinded path must be accompanied by its relay parameters.
+	r := bytes.NewReader(payInfo)
+	for _, path := range invoice.PaymentPaths {
+		if err := decodePayInfo(r, path); err != nil {
+			return nil, fmt.Errorf("invalid blinded payinfo: %w",
+				err)
+		}
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%w: more blinded payinfo than paths",
+			ErrInvalidEncoding)
+	}
+
+	invoice.unknownRecords, err = takeUnknownRecords(
+		records, minInvoiceType, maxInvoiceType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// encodePayInfo serializes the relay parameters of a blinded payment path.
+func encodePayInfo(w io.Writer, path *zpay32.BlindedPaymentPath) error {
+	var relayInfo [26]byte
+	binary.BigEndian.PutUint32(relayInfo[:4], path.FeeBaseMsat)
+	binary.BigEndian.PutUint32(relayInfo[4:8], path.FeeRate)
+	binary.BigEndian.PutUint16(relayInfo[8:10], path.CltvExpiryDelta)
+	binary.BigEndian.PutUint64(relayInfo[10:18], path.HTLCMinMsat)
+	binary.BigEndian.PutUint64(relayInfo[18:26], path.HTLCMaxMsat)
+	if _, err := w.Write(relayInfo[:]); err != nil {
+		return err
+	}
+
+	features := lnwire.NewRawFeatureVector()
+	if path.Features != nil {
+		features = path.Features.RawFeatureVector
+	}
+
+	return features.Encode(w)
+}
+
+// decodePayInfo parses the relay parameters of a blinded payment path.
+func decodePayInfo(r io.Reader, path *zpay32.BlindedPaymentPath) error {
+	var relayInfo [26]byte
+	if _, err := io.ReadFull(r, relayInfo[:]); err != nil {
+		return err
+	}
+
+	path.FeeBaseMsat = binary.BigEndian.Uint32(relayInfo[:4])
+	path.FeeRate = binary.BigEndian.Uint32(relayInfo[4:8])
+	path.CltvExpiryDelta = binary.BigEndian.Uint16(relayInfo[8:10])
+	path.HTLCMinMsat = binary.BigEndian.Uint64(relayInfo[10:18])
+	path.HTLCMaxMsat = binary.BigEndian.Uint64(relayInfo[18:26])
+
+	features := lnwire.NewRawFeatureVector()
+	if err := features.Decode(r); err != nil {
+		return err
+	}
+	path.Features = lnwire.NewFeatureVector(features, lnwire.Features)
+
+	return nil
+}
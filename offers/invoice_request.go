@@ -0,0 +1,314 @@
+package offers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/btcec/v2/schnorr"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+)
+
+const (
+	invReqMetadataType  tlv.Type = 0
+	invReqChainType     tlv.Type = 80
+	invReqAmountType    tlv.Type = 82
+	invReqFeaturesType  tlv.Type = 84
+	invReqQuantityType  tlv.Type = 86
+	invReqPayerIDType   tlv.Type = 88
+	invReqPayerNoteType tlv.Type = 89
+
+	// minInvReqType and maxInvReqType bound the range of types that are
+	// used for the fields of an invoice request, apart from its metadata.
+	minInvReqType tlv.Type = 80
+	maxInvReqType tlv.Type = 159
+
+	// invoiceRequestName is the message name that is used in the
+	// signature tag of invoice requests.
+	invoiceRequestName = "invoice_request"
+)
+
+var (
+	// ErrInvalidSignature is returned when the signature of an invoice
+	// request or invoice is missing or invalid.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrNoPayerID is returned when an invoice request does not contain
+	// the key of the payer.
+	ErrNoPayerID = errors.New("invoice request requires a payer id")
+
+	// ErrNoMetadata is returned when an invoice request does not contain
+	// the metadata of the payer.
+	ErrNoMetadata = errors.New("invoice request requires metadata")
+)
+
+// InvoiceRequest is a request for an invoice for an offer, which the payer
+// sends to the issuer of the offer.
+type InvoiceRequest struct {
+	// Offer is the offer that an invoice is requested for.
+	Offer *Offer
+
+	// Metadata is random data chosen by the payer, which ensures that
+	// the request is unique.
+	Metadata []byte
+
+	// Chain is the chain that the payer wants to pay on. If it is nil,
+	// the payer pays on mainnet.
+	Chain *chainhash.Hash
+
+	// Amount is the amount that the payer intends to pay. It must be set
+	// if the offer does not specify an amount.
+	Amount lnwire.MilliSatoshi
+
+	// Features is the set of features that the invoice request requires.
+	Features *lnwire.RawFeatureVector
+
+	// Quantity is the number of items that the payer requests.
+	Quantity uint64
+
+	// PayerID is a transient key of the payer, which the request is
+	// signed with.
+	PayerID *btcec.PublicKey
+
+	// PayerNote is an optional note from the payer to the issuer.
+	PayerNote string
+
+	// Signature is the signature of the request using the payer's key.
+	Signature *schnorr.Signature
+
+	// unknownRecords holds the unknown odd records of the invoice request,
+	// which are retained so that it can be mirrored in an invoice.
+	unknownRecords map[uint64][]byte
+}
+
+// Sign signs the invoice request using the passed signer, which must sign
+// with the private key of the payer ID.
+func (r *InvoiceRequest) Sign(sign SignFunc) error {
+	digest, err := r.signatureDigest()
+	if err != nil {
+		return err
+	}
+
+	r.Signature, err = sign(digest)
+
+	return err
+}
+
+// Verify checks that the invoice request is well formed and was signed by
+// the payer.
+func (r *InvoiceRequest) Verify() error {
+	switch {
+	case r.Offer == nil:
+		return fmt.Errorf("%w: no offer", ErrInvalidEncoding)
+
+	case len(r.Metadata) == 0:
+		return ErrNoMetadata
+
+	case r.PayerID == nil:
+		return ErrNoPayerID
+
+	case r.Signature == nil:
+		return ErrInvalidSignature
+	}
+
+	digest, err := r.signatureDigest()
+	if err != nil {
+		return err
+	}
+
+	if !r.Signature.Verify(digest[:], r.PayerID) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Serialize returns the TLV stream of the invoice request.
+func (r *InvoiceRequest) Serialize() ([]byte, error) {
+	records := make(map[uint64][]byte)
+	if err := r.encodeRecords(records); err != nil {
+		return nil, err
+	}
+
+	if r.Signature != nil {
+		records[uint64(signatureType)] = r.Signature.Serialize()
+	}
+
+	return encodeRecords(records)
+}
+
+// Encode returns the bech32 encoded invoice request string.
+func (r *InvoiceRequest) Encode() (string, error) {
+	data, err := r.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeString(InvoiceRequestHRP, data)
+}
+
+// DeserializeInvoiceRequest parses the TLV stream of an invoice request.
+func DeserializeInvoiceRequest(data []byte) (*InvoiceRequest, error) {
+	records, err := decodeRecords(data)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := decodeInvoiceRequestRecords(records)
+	if err != nil {
+		return nil, err
+	}
+
+	if value, ok := records[signatureType]; ok {
+		req.Signature, err = decodeSignature(value)
+		if err != nil {
+			return nil, err
+		}
+		delete(records, signatureType)
+	}
+
+	if len(records) > 0 {
+		return nil, fmt.Errorf("%w: %d records outside of the invoice "+
+			"request range", ErrInvalidEncoding, len(records))
+	}
+
+	return req, nil
+}
+
+// DecodeInvoiceRequest parses a bech32 encoded invoice request string.
+func DecodeInvoiceRequest(s string) (*InvoiceRequest, error) {
+	hrp, data, err := decodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if hrp != InvoiceRequestHRP {
+		return nil, fmt.Errorf("%w: unexpected prefix %v",
+			ErrInvalidEncoding, hrp)
+	}
+
+	return DeserializeInvoiceRequest(data)
+}
+
+// signatureDigest returns the digest that the payer signs.
+func (r *InvoiceRequest) signatureDigest() ([32]byte, error) {
+	records := make(map[uint64][]byte)
+	if err := r.encodeRecords(records); err != nil {
+		return [32]byte{}, err
+	}
+
+	return signatureDigest(invoiceRequestName, records)
+}
+
+// encodeRecords adds the records of the invoice request, including the ones
+// of its offer, to the passed map. The signature is not included.
+func (r *InvoiceRequest) encodeRecords(records map[uint64][]byte) error {
+	if r.Offer != nil {
+		if err := r.Offer.encodeRecords(records); err != nil {
+			return err
+		}
+	}
+
+	for recordType, value := range r.unknownRecords {
+		records[recordType] = value
+	}
+
+	if len(r.Metadata) > 0 {
+		records[uint64(invReqMetadataType)] = r.Metadata
+	}
+
+	if r.Chain != nil {
+		records[uint64(invReqChainType)] = r.Chain[:]
+	}
+
+	if r.Amount > 0 {
+		records[uint64(invReqAmountType)] = encodeTU64(uint64(r.Amount))
+	}
+
+	if r.Features != nil && !r.Features.IsEmpty() {
+		features, err := encodeFeatures(r.Features)
+		if err != nil {
+			return err
+		}
+		records[uint64(invReqFeaturesType)] = features
+	}
+
+	if r.Quantity > 0 {
+		records[uint64(invReqQuantityType)] = encodeTU64(r.Quantity)
+	}
+
+	if r.PayerID != nil {
+		records[uint64(invReqPayerIDType)] =
+			r.PayerID.SerializeCompressed()
+	}
+
+	if r.PayerNote != "" {
+		records[uint64(invReqPayerNoteType)] = []byte(r.PayerNote)
+	}
+
+	return nil
+}
+
+// decodeInvoiceRequestRecords parses the invoice request records of the
+// passed TLV records, including the ones of its offer, and removes them from
+// it.
+func decodeInvoiceRequestRecords(records tlv.TypeMap) (*InvoiceRequest,
+	error) {
+
+	offer, err := decodeOfferRecords(records)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &InvoiceRequest{
+		Offer: offer,
+	}
+
+	for recordType, value := range records {
+		switch recordType {
+		case invReqMetadataType:
+			req.Metadata = value
+
+		case invReqChainType:
+			req.Chain, err = chainhash.NewHash(value)
+
+		case invReqAmountType:
+			var amt uint64
+			amt, err = decodeTU64(value)
+			req.Amount = lnwire.MilliSatoshi(amt)
+
+		case invReqFeaturesType:
+			req.Features, err = decodeFeatures(value)
+
+		case invReqQuantityType:
+			req.Quantity, err = decodeTU64(value)
+
+		case invReqPayerIDType:
+			req.PayerID, err = decodePubKey(value)
+
+		case invReqPayerNoteType:
+			req.PayerNote = string(value)
+
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid invoice request "+
+				"record %d: %w", recordType, err)
+		}
+
+		delete(records, recordType)
+	}
+
+	req.unknownRecords, err = takeUnknownRecords(
+		records, minInvReqType, maxInvReqType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
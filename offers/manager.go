@@ -0,0 +1,647 @@
+package offers
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/clock"
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/onionmessage"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/subscribe"
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/lnd/zpay32"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/chaincfg"
+)
+
+const (
+	// invoiceRequestRecordType is the onion message record that carries
+	// an invoice request.
+	invoiceRequestRecordType tlv.Type = 64
+
+	// invoiceRecordType is the onion message record that carries an
+	// invoice.
+	invoiceRecordType tlv.Type = 66
+
+	// invoiceErrorRecordType is the onion message record that carries an
+	// error in response to an invoice request.
+	invoiceErrorRecordType tlv.Type = 68
+
+	// invoiceErrorMessageType is the type of the error message within an
+	// invoice error.
+	invoiceErrorMessageType tlv.Type = 5
+
+	// metadataSize is the size of the random metadata that we include in
+	// our invoice requests.
+	metadataSize = 32
+
+	// pathIDSize is the size of the random path ID of the reply paths
+	// that we include in our invoice requests.
+	pathIDSize = 16
+)
+
+var (
+	// ErrUnsupportedChain is returned when an offer can't be paid on our
+	// chain.
+	ErrUnsupportedChain = errors.New("offer does not support our chain")
+
+	// ErrOfferExpired is returned when an offer has expired.
+	ErrOfferExpired = errors.New("offer expired")
+
+	// ErrInvalidQuantity is returned when the requested quantity is not
+	// allowed by the offer.
+	ErrInvalidQuantity = errors.New("invalid quantity")
+
+	// ErrAmountRequired is returned when neither the offer nor the payer
+	// specifies an amount.
+	ErrAmountRequired = errors.New("amount required")
+
+	// ErrAmountTooLow is returned when the payer chose an amount below
+	// the one expected by the offer.
+	ErrAmountTooLow = errors.New("amount below offer amount")
+
+	// ErrUnknownOffer is returned when an invoice is requested for an
+	// offer that we didn't create.
+	ErrUnknownOffer = errors.New("unknown offer")
+
+	// ErrOfferDisabled is returned when an invoice is requested for an
+	// offer that was disabled.
+	ErrOfferDisabled = errors.New("offer disabled")
+
+	// ErrInvoiceTimeout is returned when the issuer of an offer does not
+	// respond to our invoice request in time.
+	ErrInvoiceTimeout = errors.New("timeout waiting for invoice")
+
+	// ErrInvoiceMismatch is returned when an invoice that we received
+	// does not match the request that we sent.
+	ErrInvoiceMismatch = errors.New("invoice does not match request")
+
+	// ErrManagerShuttingDown is returned when the manager is stopped
+	// while we wait for an invoice.
+	ErrManagerShuttingDown = errors.New("offer manager shutting down")
+)
+
+// OfferDB is the database that stores the offers that we created.
+type OfferDB interface {
+	// AddOffer persists a newly created offer.
+	AddOffer(offer *channeldb.Offer) error
+
+	// LookupOffer returns the offer with the given ID.
+	LookupOffer(id [32]byte) (*channeldb.Offer, error)
+}
+
+// Config holds the configuration of the offer manager.
+type Config struct {
+	// NodeKey is our node's public key. It is the issuer ID of the offers
+	// that we create, and the key that our invoices are signed with.
+	NodeKey *btcec.PublicKey
+
+	// ChainParams are the parameters of the chain that we operate on.
+	ChainParams *chaincfg.Params
+
+	// DB stores the offers that we created.
+	DB OfferDB
+
+	// AddInvoice adds an invoice with the given amount, memo and expiry
+	// to the invoice registry. It returns the payment hash of the invoice
+	// and the blinded paths that it can be paid over.
+	AddInvoice func(amt lnwire.MilliSatoshi, memo string,
+		expiry time.Duration) (lntypes.Hash,
+		[]*zpay32.BlindedPaymentPath, error)
+
+	// SignInvoice signs the digest of an invoice with our node key.
+	SignInvoice SignFunc
+
+	// SendMessage sends an onion message.
+	SendMessage func(msg *onionmessage.OutgoingMessage) error
+
+	// SubscribeMessages subscribes to the onion messages addressed to
+	// our node.
+	SubscribeMessages func() (*subscribe.Client, error)
+
+	// NewReplyPath creates a blinded path to our node with the given path
+	// ID, over which the issuer of an offer can send us an invoice.
+	NewReplyPath func(pathID []byte) (*blindedpath.BlindedPath, error)
+
+	// Clock is the clock used to create invoices and check expiries.
+	Clock clock.Clock
+}
+
+// invoiceResponse is the response of an issuer to one of our invoice
+// requests.
+type invoiceResponse struct {
+	invoice *Invoice
+	err     error
+}
+
+// Manager creates offers and answers the invoice requests that we receive
+// for them. It also fetches invoices for the offers of other nodes, so that
+// they can be paid.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// pending maps the path IDs of the reply paths of our outstanding
+	// invoice requests to the channel that their response is delivered
+	// on.
+	pending    map[string]chan *invoiceResponse
+	pendingMtx sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager creates a new offer manager.
+func NewManager(cfg *Config) *Manager {
+	return &Manager{
+		cfg:     cfg,
+		pending: make(map[string]chan *invoiceResponse),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start subscribes to incoming onion messages and starts handling them.
+func (m *Manager) Start() error {
+	var startErr error
+	m.started.Do(func() {
+		log.Info("Offer manager starting")
+
+		client, err := m.cfg.SubscribeMessages()
+		if err != nil {
+			startErr = err
+			return
+		}
+
+		m.wg.Add(1)
+		go m.messageHandler(client)
+	})
+
+	return startErr
+}
+
+// Stop stops handling onion messages.
+func (m *Manager) Stop() error {
+	m.stopped.Do(func() {
+		log.Info("Offer manager shutting down")
+
+		close(m.quit)
+		m.wg.Wait()
+	})
+
+	return nil
+}
+
+// CreateOffer completes the passed offer with our node key and chain, stores
+// it and returns its encoding.
+func (m *Manager) CreateOffer(offer *Offer) (string, error) {
+	if offer.IssuerID == nil {
+		offer.IssuerID = m.cfg.NodeKey
+	}
+
+	// Offers without chains can only be paid on mainnet.
+	if !offer.SupportsChain(m.cfg.ChainParams) {
+		offer.Chains = append(
+			offer.Chains, *m.cfg.ChainParams.GenesisHash,
+		)
+	}
+
+	encoded, err := offer.Encode()
+	if err != nil {
+		return "", err
+	}
+
+	id, err := offer.ID()
+	if err != nil {
+		return "", err
+	}
+
+	err = m.cfg.DB.AddOffer(&channeldb.Offer{
+		ID:           id,
+		Encoded:      []byte(encoded),
+		CreationDate: m.cfg.Clock.Now(),
+		State:        channeldb.OfferStateActive,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	log.Debugf("Created offer %x: %v", id, encoded)
+
+	return encoded, nil
+}
+
+// FetchInvoice requests an invoice for the given quantity of the offer from
+// its issuer. If the offer does not specify an amount, amt must be set. The
+// issuer must either be one of our peers, or the introduction node of the
+// offer's first blinded path must be.
+func (m *Manager) FetchInvoice(offer *Offer, amt lnwire.MilliSatoshi,
+	quantity uint64, payerNote string,
+	timeout time.Duration) (*Invoice, error) {
+
+	if err := offer.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := m.checkOffer(offer, quantity); err != nil {
+		return nil, err
+	}
+
+	amt, err := invoiceAmount(offer, amt, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	payerKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]byte, metadataSize)
+	if _, err := rand.Read(metadata); err != nil {
+		return nil, err
+	}
+
+	req := &InvoiceRequest{
+		Offer:     offer,
+		Metadata:  metadata,
+		Amount:    amt,
+		Quantity:  quantity,
+		PayerID:   payerKey.PubKey(),
+		PayerNote: payerNote,
+	}
+	if len(offer.Chains) > 0 {
+		req.Chain = m.cfg.ChainParams.GenesisHash
+	}
+
+	if err := req.Sign(NewKeySigner(payerKey)); err != nil {
+		return nil, err
+	}
+
+	reqBytes, err := req.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	pathID := make([]byte, pathIDSize)
+	if _, err := rand.Read(pathID); err != nil {
+		return nil, err
+	}
+
+	replyPath, err := m.cfg.NewReplyPath(pathID)
+	if err != nil {
+		return nil, err
+	}
+
+	respChan := make(chan *invoiceResponse, 1)
+	m.pendingMtx.Lock()
+	m.pending[string(pathID)] = respChan
+	m.pendingMtx.Unlock()
+
+	defer func() {
+		m.pendingMtx.Lock()
+		delete(m.pending, string(pathID))
+		m.pendingMtx.Unlock()
+	}()
+
+	msg := &onionmessage.OutgoingMessage{
+		ReplyPath: replyPath,
+		FinalHopRecords: map[tlv.Type][]byte{
+			invoiceRequestRecordType: reqBytes,
+		},
+	}
+	if len(offer.Paths) > 0 {
+		msg.BlindedDestination = offer.Paths[0]
+	} else {
+		msg.Path = []*btcec.PublicKey{offer.IssuerID}
+	}
+
+	if err := m.cfg.SendMessage(msg); err != nil {
+		return nil, fmt.Errorf("unable to send invoice request: %w",
+			err)
+	}
+
+	var resp *invoiceResponse
+	select {
+	case resp = <-respChan:
+	case <-time.After(timeout):
+		return nil, ErrInvoiceTimeout
+	case <-m.quit:
+		return nil, ErrManagerShuttingDown
+	}
+
+	if resp.err != nil {
+		return nil, resp.err
+	}
+
+	if err := m.checkInvoice(req, resp.invoice); err != nil {
+		return nil, err
+	}
+
+	return resp.invoice, nil
+}
+
+// checkOffer checks that the offer can be used on our chain, hasn't expired
+// and allows the requested quantity.
+func (m *Manager) checkOffer(offer *Offer, quantity uint64) error {
+	switch {
+	case !offer.SupportsChain(m.cfg.ChainParams):
+		return ErrUnsupportedChain
+
+	case offer.Expired(m.cfg.Clock.Now()):
+		return ErrOfferExpired
+
+	case quantity > offer.QuantityMax:
+		return fmt.Errorf("%w: %d exceeds maximum of %d",
+			ErrInvalidQuantity, quantity, offer.QuantityMax)
+	}
+
+	return nil
+}
+
+// checkInvoice checks that the invoice we received was created for the given
+// invoice request by the issuer of the offer.
+func (m *Manager) checkInvoice(req *InvoiceRequest, invoice *Invoice) error {
+	if err := invoice.Verify(); err != nil {
+		return err
+	}
+
+	// The invoice must mirror our request exactly, which is the case if
+	// both commit to the same records.
+	reqDigest, err := req.signatureDigest()
+	if err != nil {
+		return err
+	}
+	mirroredDigest, err := invoice.InvoiceRequest.signatureDigest()
+	if err != nil {
+		return err
+	}
+	if reqDigest != mirroredDigest {
+		return fmt.Errorf("%w: invoice request not mirrored",
+			ErrInvoiceMismatch)
+	}
+
+	// Offers without an issuer ID are signed using the key of the final
+	// hop of the blinded path that we sent our request to.
+	issuerKey := req.Offer.IssuerID
+	if issuerKey == nil {
+		hops := req.Offer.Paths[0].BlindedHops
+		issuerKey = hops[len(hops)-1].BlindedNodePub
+	}
+	if !invoice.NodeID.IsEqual(issuerKey) {
+		return fmt.Errorf("%w: invoice not signed by issuer",
+			ErrInvoiceMismatch)
+	}
+
+	if invoice.Amount != req.Amount {
+		return fmt.Errorf("%w: amount %v, expected %v",
+			ErrInvoiceMismatch, invoice.Amount, req.Amount)
+	}
+
+	if m.cfg.Clock.Now().After(invoice.Expiry()) {
+		return fmt.Errorf("%w: invoice expired", ErrInvoiceMismatch)
+	}
+
+	return nil
+}
+
+// messageHandler handles the onion messages addressed to our node that are
+// related to offers.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) messageHandler(client *subscribe.Client) {
+	defer m.wg.Done()
+	defer client.Cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			msg, ok := update.(*onionmessage.ReceivedMessage)
+			if !ok {
+				continue
+			}
+
+			records := msg.FinalHopRecords
+			if data, ok := records[invoiceRequestRecordType]; ok {
+				m.handleInvoiceRequest(msg, data)
+			}
+
+			if data, ok := records[invoiceRecordType]; ok {
+				m.handleInvoice(msg.PathID, data)
+			}
+
+			if data, ok := records[invoiceErrorRecordType]; ok {
+				m.handleInvoiceError(msg.PathID, data)
+			}
+
+		case <-client.Quit():
+			return
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// handleInvoiceRequest answers an invoice request for one of our offers with
+// an invoice, or with an invoice error if we can't create one.
+func (m *Manager) handleInvoiceRequest(msg *onionmessage.ReceivedMessage,
+	data []byte) {
+
+	if msg.ReplyPath == nil {
+		log.Debugf("Ignoring invoice request without reply path")
+		return
+	}
+
+	var records map[tlv.Type][]byte
+	invoice, err := m.createInvoice(data)
+	if err == nil {
+		var invoiceBytes []byte
+		invoiceBytes, err = invoice.Serialize()
+		records = map[tlv.Type][]byte{
+			invoiceRecordType: invoiceBytes,
+		}
+	}
+
+	if err != nil {
+		log.Debugf("Unable to create invoice for invoice request: %v",
+			err)
+
+		errBytes, encodeErr := encodeRecords(map[uint64][]byte{
+			uint64(invoiceErrorMessageType): []byte(err.Error()),
+		})
+		if encodeErr != nil {
+			log.Errorf("Unable to encode invoice error: %v",
+				encodeErr)
+			return
+		}
+
+		records = map[tlv.Type][]byte{
+			invoiceErrorRecordType: errBytes,
+		}
+	}
+
+	err = m.cfg.SendMessage(&onionmessage.OutgoingMessage{
+		BlindedDestination: msg.ReplyPath,
+		FinalHopRecords:    records,
+	})
+	if err != nil {
+		log.Debugf("Unable to reply to invoice request: %v", err)
+	}
+}
+
+// createInvoice creates an invoice for the passed invoice request.
+func (m *Manager) createInvoice(data []byte) (*Invoice, error) {
+	req, err := DeserializeInvoiceRequest(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.Verify(); err != nil {
+		return nil, err
+	}
+
+	offerID, err := req.Offer.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	storedOffer, err := m.cfg.DB.LookupOffer(offerID)
+	switch {
+	case errors.Is(err, channeldb.ErrOfferNotFound),
+		errors.Is(err, channeldb.ErrNoOffersCreated):
+
+		return nil, ErrUnknownOffer
+
+	case err != nil:
+		return nil, err
+	}
+
+	if storedOffer.State != channeldb.OfferStateActive {
+		return nil, ErrOfferDisabled
+	}
+
+	if err := m.checkOffer(req.Offer, req.Quantity); err != nil {
+		return nil, err
+	}
+
+	// Requests for offers on chains other than mainnet must specify the
+	// chain explicitly.
+	chain := *chaincfg.MainNetParams.GenesisHash
+	if req.Chain != nil {
+		chain = *req.Chain
+	}
+	if chain != *m.cfg.ChainParams.GenesisHash {
+		return nil, ErrUnsupportedChain
+	}
+
+	amt, err := invoiceAmount(req.Offer, req.Amount, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentHash, paths, err := m.cfg.AddInvoice(
+		amt, req.Offer.Description, DefaultInvoiceExpiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The invoice mirrors the request without its signature.
+	mirroredReq := *req
+	mirroredReq.Signature = nil
+
+	invoice := &Invoice{
+		InvoiceRequest: &mirroredReq,
+		PaymentPaths:   paths,
+		CreatedAt:      m.cfg.Clock.Now(),
+		PaymentHash:    paymentHash,
+		Amount:         amt,
+		NodeID:         m.cfg.NodeKey,
+	}
+	if err := invoice.Sign(m.cfg.SignInvoice); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Created invoice %v for offer %x", paymentHash, offerID)
+
+	return invoice, nil
+}
+
+// handleInvoice delivers an invoice that we received to the invoice request
+// that it answers.
+func (m *Manager) handleInvoice(pathID []byte, data []byte) {
+	invoice, err := DeserializeInvoice(data)
+	m.deliverResponse(pathID, &invoiceResponse{
+		invoice: invoice,
+		err:     err,
+	})
+}
+
+// handleInvoiceError delivers an invoice error that we received to the
+// invoice request that it answers.
+func (m *Manager) handleInvoiceError(pathID []byte, data []byte) {
+	records, err := decodeRecords(data)
+	if err != nil {
+		log.Debugf("Unable to decode invoice error: %v", err)
+		return
+	}
+
+	m.deliverResponse(pathID, &invoiceResponse{
+		err: fmt.Errorf("invoice request failed: %s",
+			records[invoiceErrorMessageType]),
+	})
+}
+
+// deliverResponse hands the response to the pending invoice request with the
+// given reply path ID, if there is one.
+func (m *Manager) deliverResponse(pathID []byte, resp *invoiceResponse) {
+	m.pendingMtx.Lock()
+	respChan, ok := m.pending[string(pathID)]
+	m.pendingMtx.Unlock()
+
+	if !ok {
+		log.Debugf("Ignoring response for unknown path id %x", pathID)
+		return
+	}
+
+	// Only the first response is delivered.
+	select {
+	case respChan <- resp:
+	default:
+	}
+}
+
+// invoiceAmount returns the amount that must be paid for the given quantity
+// of the offer. If the offer specifies an amount, amt may be zero, and
+// otherwise must not be below it.
+func invoiceAmount(offer *Offer, amt lnwire.MilliSatoshi,
+	quantity uint64) (lnwire.MilliSatoshi, error) {
+
+	items := quantity
+	if items == 0 {
+		items = 1
+	}
+	expected := offer.Amount * lnwire.MilliSatoshi(items)
+
+	switch {
+	case amt == 0 && expected == 0:
+		return 0, ErrAmountRequired
+
+	case amt == 0:
+		return expected, nil
+
+	case amt < expected:
+		return 0, fmt.Errorf("%w: %v < %v", ErrAmountTooLow, amt,
+			expected)
+	}
+
+	return amt, nil
+}
@@ -0,0 +1,240 @@
+package offers
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/clock"
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/onionmessage"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/subscribe"
+	"github.com/ltcsuite/lnd/zpay32"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+// mockOfferDB is an in-memory OfferDB.
+type mockOfferDB struct {
+	offers map[[32]byte]*channeldb.Offer
+	sync.Mutex
+}
+
+func (d *mockOfferDB) AddOffer(offer *channeldb.Offer) error {
+	d.Lock()
+	defer d.Unlock()
+
+	d.offers[offer.ID] = offer
+
+	return nil
+}
+
+func (d *mockOfferDB) LookupOffer(id [32]byte) (*channeldb.Offer, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	offer, ok := d.offers[id]
+	if !ok {
+		return nil, channeldb.ErrOfferNotFound
+	}
+
+	return offer, nil
+}
+
+// mockNetwork delivers onion messages between the managers of the test
+// nodes. Reply paths are resolved to their path IDs by their introduction
+// point.
+type mockNetwork struct {
+	nodes   map[[33]byte]*subscribe.Server
+	pathIDs map[*blindedpath.BlindedPath][]byte
+	sync.Mutex
+}
+
+func (n *mockNetwork) sendMessage(from *btcec.PublicKey,
+	msg *onionmessage.OutgoingMessage) error {
+
+	n.Lock()
+	defer n.Unlock()
+
+	var (
+		dest   *btcec.PublicKey
+		pathID []byte
+	)
+	if msg.BlindedDestination != nil {
+		dest = msg.BlindedDestination.IntroductionPoint
+		pathID = n.pathIDs[msg.BlindedDestination]
+	} else {
+		dest = msg.Path[len(msg.Path)-1]
+	}
+
+	var peer [33]byte
+	copy(peer[:], from.SerializeCompressed())
+
+	var destKey [33]byte
+	copy(destKey[:], dest.SerializeCompressed())
+
+	return n.nodes[destKey].SendUpdate(&onionmessage.ReceivedMessage{
+		Peer:            peer,
+		PathID:          pathID,
+		ReplyPath:       msg.ReplyPath,
+		FinalHopRecords: msg.FinalHopRecords,
+	})
+}
+
+func (n *mockNetwork) newReplyPath(node *btcec.PublicKey,
+	pathID []byte) (*blindedpath.BlindedPath, error) {
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := blindedpath.BuildBlindedPath(
+		sessionKey, []*blindedpath.HopInfo{{NodePub: node}},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	n.Lock()
+	n.pathIDs[path] = pathID
+	n.Unlock()
+
+	return path, nil
+}
+
+// addNode creates a manager for a new node of the network.
+func (n *mockNetwork) addNode(t *testing.T, clock clock.Clock,
+	addInvoice func(lnwire.MilliSatoshi, string, time.Duration) (
+		lntypes.Hash, []*zpay32.BlindedPaymentPath, error)) *Manager {
+
+	t.Helper()
+
+	nodeKey := newTestKey(t)
+
+	server := subscribe.NewServer()
+	require.NoError(t, server.Start())
+	t.Cleanup(func() {
+		require.NoError(t, server.Stop())
+	})
+
+	var key [33]byte
+	copy(key[:], nodeKey.PubKey().SerializeCompressed())
+
+	n.Lock()
+	n.nodes[key] = server
+	n.Unlock()
+
+	manager := NewManager(&Config{
+		NodeKey:     nodeKey.PubKey(),
+		ChainParams: &chaincfg.TestNet4Params,
+		DB: &mockOfferDB{
+			offers: make(map[[32]byte]*channeldb.Offer),
+		},
+		AddInvoice:  addInvoice,
+		SignInvoice: NewKeySigner(nodeKey),
+		SendMessage: func(msg *onionmessage.OutgoingMessage) error {
+			return n.sendMessage(nodeKey.PubKey(), msg)
+		},
+		SubscribeMessages: server.Subscribe,
+		NewReplyPath: func(pathID []byte) (*blindedpath.BlindedPath,
+			error) {
+
+			return n.newReplyPath(nodeKey.PubKey(), pathID)
+		},
+		Clock: clock,
+	})
+	require.NoError(t, manager.Start())
+	t.Cleanup(func() {
+		require.NoError(t, manager.Stop())
+	})
+
+	return manager
+}
+
+// TestManagerFetchInvoice tests that an invoice can be fetched for an offer
+// created by another node, and that invalid requests are answered with an
+// invoice error.
+func TestManagerFetchInvoice(t *testing.T) {
+	t.Parallel()
+
+	network := &mockNetwork{
+		nodes:   make(map[[33]byte]*subscribe.Server),
+		pathIDs: make(map[*blindedpath.BlindedPath][]byte),
+	}
+	testClock := clock.NewTestClock(time.Unix(1_600_000_000, 0))
+
+	paymentPath := &zpay32.BlindedPaymentPath{
+		CltvExpiryDelta: 80,
+		HTLCMaxMsat:     1_000_000,
+		Features: lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(), lnwire.Features,
+		),
+		BlindedPath: newTestPath(t, 1),
+	}
+
+	var (
+		invoiceAmt  lnwire.MilliSatoshi
+		invoiceMemo string
+	)
+	issuer := network.addNode(t, testClock,
+		func(amt lnwire.MilliSatoshi, memo string,
+			_ time.Duration) (lntypes.Hash,
+			[]*zpay32.BlindedPaymentPath, error) {
+
+			invoiceAmt = amt
+			invoiceMemo = memo
+
+			return lntypes.Hash{1}, []*zpay32.BlindedPaymentPath{
+				paymentPath,
+			}, nil
+		},
+	)
+	payer := network.addNode(t, testClock, nil)
+
+	offerString, err := issuer.CreateOffer(&Offer{
+		Amount:      1000,
+		Description: "coffee",
+		QuantityMax: 5,
+	})
+	require.NoError(t, err)
+
+	offer, err := DecodeOffer(offerString)
+	require.NoError(t, err)
+	require.True(t, offer.IssuerID.IsEqual(issuer.cfg.NodeKey))
+	require.True(t, offer.SupportsChain(&chaincfg.TestNet4Params))
+
+	// Requesting three items without an amount results in an invoice for
+	// three times the offer amount.
+	invoice, err := payer.FetchInvoice(offer, 0, 3, "thanks", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, lnwire.MilliSatoshi(3000), invoice.Amount)
+	require.Equal(t, lntypes.Hash{1}, invoice.PaymentHash)
+	require.Equal(t, "thanks", invoice.InvoiceRequest.PayerNote)
+	require.Len(t, invoice.PaymentPaths, 1)
+	require.Equal(t, lnwire.MilliSatoshi(3000), invoiceAmt)
+	require.Equal(t, "coffee", invoiceMemo)
+
+	// The payer refuses to request more than the offer allows.
+	_, err = payer.FetchInvoice(offer, 0, 6, "", time.Second)
+	require.ErrorIs(t, err, ErrInvalidQuantity)
+
+	_, err = payer.FetchInvoice(offer, 500, 0, "", time.Second)
+	require.ErrorIs(t, err, ErrAmountTooLow)
+
+	// An offer that the issuer doesn't know is answered with an error.
+	unknownOffer := *offer
+	unknownOffer.Description = "tea"
+	_, err = payer.FetchInvoice(&unknownOffer, 0, 0, "", time.Second)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), ErrUnknownOffer.Error())
+
+	// Expired offers can't be paid.
+	offer.AbsoluteExpiry = testClock.Now().Add(-time.Second)
+	_, err = payer.FetchInvoice(offer, 0, 0, "", time.Second)
+	require.ErrorIs(t, err, ErrOfferExpired)
+}
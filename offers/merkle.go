@@ -0,0 +1,149 @@
+package offers
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/btcec/v2/schnorr"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+)
+
+const (
+	// signatureType is the type of the signature record of invoice
+	// requests and invoices.
+	signatureType tlv.Type = 240
+
+	// minSignatureType and maxSignatureType bound the range of types that
+	// are reserved for signatures. Records in this range are not part of
+	// the merkle tree that is signed.
+	minSignatureType tlv.Type = 240
+	maxSignatureType tlv.Type = 1000
+
+	// signatureTagPrefix is prepended to the message and field name to
+	// form the tag that is used to hash the merkle root for signing.
+	signatureTagPrefix = "lightning"
+
+	// signatureField is the name of the signature field, which is part of
+	// the signature tag.
+	signatureField = "signature"
+)
+
+var (
+	leafTag   = []byte("LnLeaf")
+	nonceTag  = []byte("LnNonce")
+	branchTag = []byte("LnBranch")
+)
+
+// SignFunc signs the passed digest, which commits to all records of an
+// invoice request or invoice.
+type SignFunc func(digest [32]byte) (*schnorr.Signature, error)
+
+// NewKeySigner returns a SignFunc that signs with the passed private key.
+func NewKeySigner(key *btcec.PrivateKey) SignFunc {
+	return func(digest [32]byte) (*schnorr.Signature, error) {
+		return schnorr.Sign(key, digest[:])
+	}
+}
+
+// merkleRoot computes the merkle root of the passed TLV records as defined in
+// BOLT 12. Every record contributes a leaf committing to the record itself,
+// and a nonce leaf that is derived from the first record of the stream. The
+// signature records are excluded from the tree.
+func merkleRoot(records map[uint64][]byte) (chainhash.Hash, error) {
+	types := make([]uint64, 0, len(records))
+	for recordType := range records {
+		if recordType >= uint64(minSignatureType) &&
+			recordType <= uint64(maxSignatureType) {
+
+			continue
+		}
+
+		types = append(types, recordType)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+
+	if len(types) == 0 {
+		return chainhash.Hash{}, ErrInvalidEncoding
+	}
+
+	var (
+		buf      [8]byte
+		firstTLV []byte
+		leaves   = make([]chainhash.Hash, 0, len(types))
+	)
+	for i, recordType := range types {
+		var typeBytes bytes.Buffer
+		err := tlv.WriteVarInt(&typeBytes, recordType, &buf)
+		if err != nil {
+			return chainhash.Hash{}, err
+		}
+
+		value := records[recordType]
+		var record bytes.Buffer
+		record.Write(typeBytes.Bytes())
+		err = tlv.WriteVarInt(&record, uint64(len(value)), &buf)
+		if err != nil {
+			return chainhash.Hash{}, err
+		}
+		record.Write(value)
+
+		if i == 0 {
+			firstTLV = make([]byte, 0, len(nonceTag)+record.Len())
+			firstTLV = append(firstTLV, nonceTag...)
+			firstTLV = append(firstTLV, record.Bytes()...)
+		}
+
+		leaf := chainhash.TaggedHash(leafTag, record.Bytes())
+		nonce := chainhash.TaggedHash(firstTLV, typeBytes.Bytes())
+		leaves = append(leaves, *branchHash(leaf, nonce))
+	}
+
+	// Combine the nodes of each level pairwise until only the root is
+	// left. A node without a sibling is carried over to the next level,
+	// so that the tree is deepest for the lowest-order records.
+	for len(leaves) > 1 {
+		next := make([]chainhash.Hash, 0, (len(leaves)+1)/2)
+		for i := 0; i < len(leaves); i += 2 {
+			if i+1 == len(leaves) {
+				next = append(next, leaves[i])
+				continue
+			}
+
+			branch := branchHash(&leaves[i], &leaves[i+1])
+			next = append(next, *branch)
+		}
+
+		leaves = next
+	}
+
+	return leaves[0], nil
+}
+
+// branchHash returns the inner node of the merkle tree that commits to the
+// two passed nodes, which are sorted first.
+func branchHash(a, b *chainhash.Hash) *chainhash.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+
+	return chainhash.TaggedHash(branchTag, a[:], b[:])
+}
+
+// signatureDigest returns the digest that is signed for the passed records
+// of the message with the given name.
+func signatureDigest(messageName string,
+	records map[uint64][]byte) ([32]byte, error) {
+
+	root, err := merkleRoot(records)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	tag := signatureTagPrefix + messageName + signatureField
+
+	return *chainhash.TaggedHash([]byte(tag), root[:]), nil
+}
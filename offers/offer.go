@@ -0,0 +1,316 @@
+package offers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/chaincfg"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+)
+
+const (
+	offerChainsType         tlv.Type = 2
+	offerMetadataType       tlv.Type = 4
+	offerAmountType         tlv.Type = 8
+	offerDescriptionType    tlv.Type = 10
+	offerFeaturesType       tlv.Type = 12
+	offerAbsoluteExpiryType tlv.Type = 14
+	offerPathsType          tlv.Type = 16
+	offerIssuerType         tlv.Type = 18
+	offerQuantityMaxType    tlv.Type = 20
+	offerIssuerIDType       tlv.Type = 22
+
+	// minOfferType and maxOfferType bound the range of types that are
+	// used for the fields of an offer.
+	minOfferType tlv.Type = 1
+	maxOfferType tlv.Type = 79
+)
+
+var (
+	// ErrNoDescription is returned when an offer with an amount does not
+	// have a description.
+	ErrNoDescription = errors.New("offer with an amount requires a " +
+		"description")
+
+	// ErrNoIssuer is returned when an offer has neither an issuer ID nor
+	// blinded paths, which means that it can't be reached.
+	ErrNoIssuer = errors.New("offer requires an issuer id or blinded " +
+		"paths")
+)
+
+// Offer is a BOLT 12 offer, a static payment code from which invoices can be
+// requested from its issuer.
+type Offer struct {
+	// Chains is the set of chains that the offer can be paid on. If it is
+	// empty, the offer can only be paid on mainnet.
+	Chains []chainhash.Hash
+
+	// Metadata is opaque data that the issuer included in the offer.
+	Metadata []byte
+
+	// Amount is the amount expected per item. If it is zero, the payer
+	// chooses the amount.
+	Amount lnwire.MilliSatoshi
+
+	// Description is a description of the purpose of the payment.
+	Description string
+
+	// Features is the set of features that the offer requires.
+	Features *lnwire.RawFeatureVector
+
+	// AbsoluteExpiry is the time after which the offer can no longer be
+	// used. If it is zero, the offer doesn't expire.
+	AbsoluteExpiry time.Time
+
+	// Paths is an optional set of blinded paths that lead to the issuer.
+	Paths []*blindedpath.BlindedPath
+
+	// Issuer describes the issuer of the offer.
+	Issuer string
+
+	// QuantityMax is the maximum number of items that can be requested
+	// at once. If it is zero, a quantity must not be requested.
+	QuantityMax uint64
+
+	// IssuerID is the public key of the issuer that invoices for the offer
+	// are signed with. If it is nil, invoices are signed with the key of
+	// the final hop of the blinded path that the invoice request was sent
+	// over.
+	IssuerID *btcec.PublicKey
+
+	// unknownRecords holds the unknown odd records of the offer, which are
+	// retained so that the offer can be mirrored in an invoice request.
+	unknownRecords map[uint64][]byte
+}
+
+// Validate checks that the offer is well formed.
+func (o *Offer) Validate() error {
+	if o.Amount > 0 && o.Description == "" {
+		return ErrNoDescription
+	}
+
+	if o.IssuerID == nil && len(o.Paths) == 0 {
+		return ErrNoIssuer
+	}
+
+	return nil
+}
+
+// SupportsChain returns true if the offer can be paid on the given chain.
+func (o *Offer) SupportsChain(params *chaincfg.Params) bool {
+	if len(o.Chains) == 0 {
+		mainnet := chaincfg.MainNetParams.GenesisHash
+		return *params.GenesisHash == *mainnet
+	}
+
+	for _, chain := range o.Chains {
+		if chain == *params.GenesisHash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Expired returns true if the offer expired at the given time.
+func (o *Offer) Expired(now time.Time) bool {
+	return !o.AbsoluteExpiry.IsZero() && now.After(o.AbsoluteExpiry)
+}
+
+// ID returns the merkle root of the offer's records, which uniquely
+// identifies it.
+func (o *Offer) ID() ([32]byte, error) {
+	records := make(map[uint64][]byte)
+	if err := o.encodeRecords(records); err != nil {
+		return [32]byte{}, err
+	}
+
+	return merkleRoot(records)
+}
+
+// Encode returns the bech32 encoded offer string.
+func (o *Offer) Encode() (string, error) {
+	if err := o.Validate(); err != nil {
+		return "", err
+	}
+
+	records := make(map[uint64][]byte)
+	if err := o.encodeRecords(records); err != nil {
+		return "", err
+	}
+
+	data, err := encodeRecords(records)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeString(OfferHRP, data)
+}
+
+// DecodeOffer parses a bech32 encoded offer string.
+func DecodeOffer(s string) (*Offer, error) {
+	hrp, data, err := decodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if hrp != OfferHRP {
+		return nil, fmt.Errorf("%w: unexpected prefix %v",
+			ErrInvalidEncoding, hrp)
+	}
+
+	records, err := decodeRecords(data)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := decodeOfferRecords(records)
+	if err != nil {
+		return nil, err
+	}
+
+	// All records of an offer must be in the offer range.
+	if len(records) > 0 {
+		return nil, fmt.Errorf("%w: %d records outside of the offer "+
+			"range", ErrInvalidEncoding, len(records))
+	}
+
+	if err := offer.Validate(); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// encodeRecords adds the records of the offer to the passed map.
+func (o *Offer) encodeRecords(records map[uint64][]byte) error {
+	for recordType, value := range o.unknownRecords {
+		records[recordType] = value
+	}
+
+	if len(o.Chains) > 0 {
+		records[uint64(offerChainsType)] = encodeChains(o.Chains)
+	}
+
+	if len(o.Metadata) > 0 {
+		records[uint64(offerMetadataType)] = o.Metadata
+	}
+
+	if o.Amount > 0 {
+		records[uint64(offerAmountType)] = encodeTU64(uint64(o.Amount))
+	}
+
+	if o.Description != "" {
+		records[uint64(offerDescriptionType)] = []byte(o.Description)
+	}
+
+	if o.Features != nil && !o.Features.IsEmpty() {
+		features, err := encodeFeatures(o.Features)
+		if err != nil {
+			return err
+		}
+		records[uint64(offerFeaturesType)] = features
+	}
+
+	if !o.AbsoluteExpiry.IsZero() {
+		records[uint64(offerAbsoluteExpiryType)] = encodeTU64(
+			uint64(o.AbsoluteExpiry.Unix()),
+		)
+	}
+
+	if len(o.Paths) > 0 {
+		paths, err := encodePaths(o.Paths)
+		if err != nil {
+			return err
+		}
+		records[uint64(offerPathsType)] = paths
+	}
+
+	if o.Issuer != "" {
+		records[uint64(offerIssuerType)] = []byte(o.Issuer)
+	}
+
+	if o.QuantityMax > 0 {
+		records[uint64(offerQuantityMaxType)] = encodeTU64(
+			o.QuantityMax,
+		)
+	}
+
+	if o.IssuerID != nil {
+		records[uint64(offerIssuerIDType)] =
+			o.IssuerID.SerializeCompressed()
+	}
+
+	return nil
+}
+
+// decodeOfferRecords parses the offer records of the passed TLV records and
+// removes them from it.
+func decodeOfferRecords(records tlv.TypeMap) (*Offer, error) {
+	var (
+		offer = &Offer{}
+		err   error
+	)
+
+	for recordType, value := range records {
+		switch recordType {
+		case offerChainsType:
+			offer.Chains, err = decodeChains(value)
+
+		case offerMetadataType:
+			offer.Metadata = value
+
+		case offerAmountType:
+			var amt uint64
+			amt, err = decodeTU64(value)
+			offer.Amount = lnwire.MilliSatoshi(amt)
+
+		case offerDescriptionType:
+			offer.Description = string(value)
+
+		case offerFeaturesType:
+			offer.Features, err = decodeFeatures(value)
+
+		case offerAbsoluteExpiryType:
+			var expiry uint64
+			expiry, err = decodeTU64(value)
+			offer.AbsoluteExpiry = time.Unix(int64(expiry), 0)
+
+		case offerPathsType:
+			offer.Paths, err = decodePaths(value)
+
+		case offerIssuerType:
+			offer.Issuer = string(value)
+
+		case offerQuantityMaxType:
+			offer.QuantityMax, err = decodeTU64(value)
+
+		case offerIssuerIDType:
+			offer.IssuerID, err = decodePubKey(value)
+
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid offer record %d: %w",
+				recordType, err)
+		}
+
+		delete(records, recordType)
+	}
+
+	offer.unknownRecords, err = takeUnknownRecords(
+		records, minOfferType, maxOfferType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
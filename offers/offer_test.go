@@ -0,0 +1,261 @@
+package offers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/zpay32"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/chaincfg"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T) *btcec.PrivateKey {
+	t.Helper()
+
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return key
+}
+
+func newTestPath(t *testing.T, hops int) *blindedpath.BlindedPath {
+	t.Helper()
+
+	hopInfo := make([]*blindedpath.HopInfo, hops)
+	for i := range hopInfo {
+		hopInfo[i] = &blindedpath.HopInfo{
+			NodePub:   newTestKey(t).PubKey(),
+			PlainText: []byte{byte(i), 1, 2, 3},
+		}
+	}
+
+	path, err := blindedpath.BuildBlindedPath(newTestKey(t), hopInfo)
+	require.NoError(t, err)
+
+	return path
+}
+
+func newTestOffer(t *testing.T) *Offer {
+	t.Helper()
+
+	features := lnwire.NewRawFeatureVector()
+	features.Set(lnwire.FeatureBit(101))
+
+	return &Offer{
+		Chains: []chainhash.Hash{
+			*chaincfg.TestNet4Params.GenesisHash,
+		},
+		Metadata:       []byte{1, 2, 3},
+		Amount:         50_000,
+		Description:    "coffee",
+		Features:       features,
+		AbsoluteExpiry: time.Unix(1_700_000_000, 0),
+		Paths:          []*blindedpath.BlindedPath{newTestPath(t, 2)},
+		Issuer:         "shop",
+		QuantityMax:    10,
+		IssuerID:       newTestKey(t).PubKey(),
+		unknownRecords: map[uint64][]byte{33: {0xaa}},
+	}
+}
+
+// TestOfferEncoding tests that offers survive an encoding round trip and
+// that malformed offers are rejected.
+func TestOfferEncoding(t *testing.T) {
+	t.Parallel()
+
+	offer := newTestOffer(t)
+
+	encoded, err := offer.Encode()
+	require.NoError(t, err)
+	require.True(t, len(encoded) > len(OfferHRP))
+	require.Equal(t, OfferHRP+"1", encoded[:len(OfferHRP)+1])
+
+	decoded, err := DecodeOffer(encoded)
+	require.NoError(t, err)
+	require.Equal(t, offer, decoded)
+
+	// The ID commits to all records of the offer.
+	id, err := offer.ID()
+	require.NoError(t, err)
+	decodedID, err := decoded.ID()
+	require.NoError(t, err)
+	require.Equal(t, id, decodedID)
+
+	// Offers may be split into several parts, and may be upper case.
+	split := encoded[:20] + "+\n  " + encoded[20:]
+	decoded, err = DecodeOffer(split)
+	require.NoError(t, err)
+	require.Equal(t, offer, decoded)
+
+	_, err = DecodeOffer(encoded + "+")
+	require.ErrorIs(t, err, ErrInvalidEncoding)
+
+	upper := []byte(encoded)
+	for i := range upper {
+		if upper[i] >= 'a' && upper[i] <= 'z' {
+			upper[i] -= 'a' - 'A'
+		}
+	}
+	decoded, err = DecodeOffer(string(upper))
+	require.NoError(t, err)
+	require.Equal(t, offer, decoded)
+
+	// Mixed case is rejected.
+	mixed := string(upper[:5]) + encoded[5:]
+	_, err = DecodeOffer(mixed)
+	require.ErrorIs(t, err, ErrInvalidEncoding)
+
+	// An invoice request prefix is rejected.
+	_, err = DecodeOffer(InvoiceRequestHRP + encoded[len(OfferHRP):])
+	require.ErrorIs(t, err, ErrInvalidEncoding)
+
+	// Unknown even records must be rejected, while unknown odd records
+	// are retained.
+	offer.unknownRecords = map[uint64][]byte{34: {0xaa}}
+	encoded, err = offer.Encode()
+	require.NoError(t, err)
+	_, err = DecodeOffer(encoded)
+	require.ErrorIs(t, err, ErrUnknownEvenType)
+
+	// Records outside of the offer range are rejected.
+	offer.unknownRecords = map[uint64][]byte{81: {0xaa}}
+	encoded, err = offer.Encode()
+	require.NoError(t, err)
+	_, err = DecodeOffer(encoded)
+	require.ErrorIs(t, err, ErrInvalidEncoding)
+
+	// An offer without an issuer can't be encoded.
+	offer.unknownRecords = nil
+	offer.IssuerID = nil
+	offer.Paths = nil
+	_, err = offer.Encode()
+	require.ErrorIs(t, err, ErrNoIssuer)
+}
+
+// TestOfferChains tests that offers without chains are only valid on
+// mainnet.
+func TestOfferChains(t *testing.T) {
+	t.Parallel()
+
+	offer := &Offer{}
+	require.True(t, offer.SupportsChain(&chaincfg.MainNetParams))
+	require.False(t, offer.SupportsChain(&chaincfg.TestNet4Params))
+
+	offer.Chains = []chainhash.Hash{*chaincfg.TestNet4Params.GenesisHash}
+	require.False(t, offer.SupportsChain(&chaincfg.MainNetParams))
+	require.True(t, offer.SupportsChain(&chaincfg.TestNet4Params))
+}
+
+// TestInvoiceRequestSignature tests that invoice requests survive an encoding
+// round trip and that their signature commits to all of their records.
+func TestInvoiceRequestSignature(t *testing.T) {
+	t.Parallel()
+
+	payerKey := newTestKey(t)
+	req := &InvoiceRequest{
+		Offer:     newTestOffer(t),
+		Metadata:  []byte{4, 5, 6},
+		Chain:     chaincfg.TestNet4Params.GenesisHash,
+		Amount:    100_000,
+		Quantity:  2,
+		PayerID:   payerKey.PubKey(),
+		PayerNote: "thanks",
+	}
+
+	// Unsigned requests are invalid.
+	require.ErrorIs(t, req.Verify(), ErrInvalidSignature)
+
+	require.NoError(t, req.Sign(NewKeySigner(payerKey)))
+	require.NoError(t, req.Verify())
+
+	encoded, err := req.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodeInvoiceRequest(encoded)
+	require.NoError(t, err)
+	require.NoError(t, decoded.Verify())
+	require.Equal(t, req.Offer, decoded.Offer)
+	require.Equal(t, req.PayerNote, decoded.PayerNote)
+	require.Equal(t, req.Amount, decoded.Amount)
+
+	// Changing any of the records invalidates the signature.
+	decoded.Quantity = 3
+	require.ErrorIs(t, decoded.Verify(), ErrInvalidSignature)
+
+	decoded.Quantity = req.Quantity
+	decoded.Offer.Description = "tea"
+	require.ErrorIs(t, decoded.Verify(), ErrInvalidSignature)
+
+	// Signing with a key other than the payer ID is detected.
+	req.Signature = nil
+	require.NoError(t, req.Sign(NewKeySigner(newTestKey(t))))
+	require.ErrorIs(t, req.Verify(), ErrInvalidSignature)
+}
+
+// TestInvoiceEncoding tests that invoices survive an encoding round trip,
+// including the mirrored invoice request and the payment paths.
+func TestInvoiceEncoding(t *testing.T) {
+	t.Parallel()
+
+	payerKey := newTestKey(t)
+	nodeKey := newTestKey(t)
+
+	req := &InvoiceRequest{
+		Offer:    newTestOffer(t),
+		Metadata: []byte{4, 5, 6},
+		PayerID:  payerKey.PubKey(),
+	}
+
+	features := lnwire.NewRawFeatureVector()
+	features.Set(lnwire.FeatureBit(25))
+
+	invoice := &Invoice{
+		InvoiceRequest: req,
+		PaymentPaths: []*zpay32.BlindedPaymentPath{{
+			FeeBaseMsat:     1000,
+			FeeRate:         10,
+			CltvExpiryDelta: 80,
+			HTLCMinMsat:     1,
+			HTLCMaxMsat:     1_000_000,
+			Features: lnwire.NewFeatureVector(
+				lnwire.NewRawFeatureVector(), lnwire.Features,
+			),
+			BlindedPath: newTestPath(t, 3),
+		}},
+		CreatedAt:      time.Unix(1_600_000_000, 0),
+		RelativeExpiry: time.Hour,
+		PaymentHash:    lntypes.Hash{1, 2, 3},
+		Amount:         50_000,
+		Features:       features,
+		NodeID:         nodeKey.PubKey(),
+	}
+
+	require.ErrorIs(t, invoice.Verify(), ErrInvalidSignature)
+	require.NoError(t, invoice.Sign(NewKeySigner(nodeKey)))
+	require.NoError(t, invoice.Verify())
+	require.Equal(t, time.Unix(1_600_003_600, 0), invoice.Expiry())
+
+	encoded, err := invoice.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodeInvoice(encoded)
+	require.NoError(t, err)
+	require.NoError(t, decoded.Verify())
+	require.Equal(t, invoice, decoded)
+
+	// An invoice without an explicit expiry uses the default one.
+	invoice.RelativeExpiry = 0
+	require.Equal(
+		t, invoice.CreatedAt.Add(DefaultInvoiceExpiry),
+		invoice.Expiry(),
+	)
+
+	// Changing the amount invalidates the signature.
+	decoded.Amount++
+	require.ErrorIs(t, decoded.Verify(), ErrInvalidSignature)
+}
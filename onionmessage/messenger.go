@@ -0,0 +1,487 @@
+package onionmessage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	sphinx "github.com/ltcsuite/lightning-onion"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/record"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/subscribe"
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+)
+
+var (
+	// ErrNoDestination is returned when an attempt is made to send an
+	// onion message without specifying where it should be sent to.
+	ErrNoDestination = errors.New("onion message requires a path or " +
+		"blinded destination")
+
+	// ErrUnsupportedPacketSize is returned when we receive an onion
+	// message with an onion packet of a size that we can't process.
+	ErrUnsupportedPacketSize = errors.New("unsupported onion message " +
+		"packet size")
+
+	// ErrNoNextHop is returned when the blinded route data of a hop that
+	// should forward an onion message doesn't tell it where to forward
+	// the message to.
+	ErrNoNextHop = errors.New("no next hop for onion message")
+)
+
+// Config houses the parameters and dependencies of the Messenger.
+type Config struct {
+	// NodeKey is our node's key. It is used to derive the key that
+	// incoming onions are encrypted to and to decrypt the blinded route
+	// data that they carry.
+	NodeKey sphinx.SingleKeyECDH
+
+	// OnionRouter is the sphinx router used to process incoming onions.
+	// It must have been created with NodeKey.
+	OnionRouter *sphinx.Router
+
+	// SendToPeer sends a message to the peer with the given public key.
+	// An error is returned if the peer is not connected.
+	SendToPeer func(peer [33]byte, msg lnwire.Message) error
+
+	// FetchChannelPeer returns the public key of the peer that we have
+	// the given channel with. It is used to forward messages whose next
+	// hop is identified by a channel instead of its node ID.
+	FetchChannelPeer func(scid lnwire.ShortChannelID) (*btcec.PublicKey,
+		error)
+}
+
+// ReceivedMessage is an onion message that was addressed to our node. It is
+// delivered to all subscribers of the Messenger.
+type ReceivedMessage struct {
+	// Peer is the peer that delivered the message to us. This is not
+	// necessarily the sender of the message.
+	Peer [33]byte
+
+	// PathID is the path ID that we included in our own blinded route
+	// data when creating the blinded path that the message was sent
+	// over. It is nil if the sender created the path to us.
+	PathID []byte
+
+	// ReplyPath is an optional blinded path that can be used to reply to
+	// the sender of the message.
+	ReplyPath *blindedpath.BlindedPath
+
+	// FinalHopRecords holds the contents of the message.
+	FinalHopRecords map[tlv.Type][]byte
+}
+
+// OutgoingMessage describes an onion message that should be sent.
+type OutgoingMessage struct {
+	// Path is the list of nodes that the message is routed through,
+	// starting with one of our peers. If BlindedDestination is not set,
+	// the final node in the path is the recipient of the message.
+	// Otherwise, the path leads to the introduction node of the blinded
+	// destination and may be empty if it is one of our peers.
+	Path []*btcec.PublicKey
+
+	// BlindedDestination is an optional blinded path to the recipient of
+	// the message.
+	BlindedDestination *blindedpath.BlindedPath
+
+	// ReplyPath is an optional blinded path that the recipient can use to
+	// reply to the message.
+	ReplyPath *blindedpath.BlindedPath
+
+	// FinalHopRecords holds the contents of the message. All records must
+	// have a type of at least MinFinalHopType.
+	FinalHopRecords map[tlv.Type][]byte
+}
+
+// Messenger sends, forwards and receives onion messages. Onion messages are
+// always routed over blinded paths, so every hop only learns which node it
+// should pass the message on to, and the recipient doesn't learn who sent the
+// message.
+type Messenger struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// ourPubKey is the serialized public key of our node.
+	ourPubKey [33]byte
+
+	ntfnServer *subscribe.Server
+}
+
+// New creates a new onion messenger from the given config.
+func New(cfg *Config) *Messenger {
+	var ourPubKey [33]byte
+	copy(ourPubKey[:], cfg.NodeKey.PubKey().SerializeCompressed())
+
+	return &Messenger{
+		cfg:        cfg,
+		ourPubKey:  ourPubKey,
+		ntfnServer: subscribe.NewServer(),
+	}
+}
+
+// Start starts the Messenger's subscription server.
+func (m *Messenger) Start() error {
+	var err error
+
+	m.started.Do(func() {
+		log.Info("Onion messenger starting")
+		err = m.ntfnServer.Start()
+	})
+
+	return err
+}
+
+// Stop signals the Messenger for a graceful shutdown.
+func (m *Messenger) Stop() error {
+	var err error
+
+	m.stopped.Do(func() {
+		log.Info("Onion messenger shutting down")
+		err = m.ntfnServer.Stop()
+	})
+
+	return err
+}
+
+// SubscribeMessages returns a subscribe.Client that will receive a
+// ReceivedMessage for every onion message that is addressed to our node.
+func (m *Messenger) SubscribeMessages() (*subscribe.Client, error) {
+	return m.ntfnServer.Subscribe()
+}
+
+// NewReplyPath creates a blinded path to our own node that can be included
+// in an outgoing message, so that the recipient is able to reply to it. The
+// path ID is returned with any message received over the path.
+func (m *Messenger) NewReplyPath(
+	pathID []byte) (*blindedpath.BlindedPath, error) {
+
+	plainText, err := record.EncodeBlindedRouteData(
+		&record.BlindedRouteData{
+			PathID: pathID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return blindedpath.BuildBlindedPath(sessionKey, []*blindedpath.HopInfo{
+		{
+			NodePub:   m.cfg.NodeKey.PubKey(),
+			PlainText: plainText,
+		},
+	})
+}
+
+// SendMessage wraps the passed message in an onion and sends it to the first
+// hop of its route.
+func (m *Messenger) SendMessage(msg *OutgoingMessage) error {
+	if len(msg.Path) == 0 && msg.BlindedDestination == nil {
+		return ErrNoDestination
+	}
+
+	var (
+		blindingPoint *btcec.PublicKey
+		firstHop      *btcec.PublicKey
+		blindedHops   []*blindedpath.BlindedHop
+	)
+
+	// The nodes in the path are known to us, so we'll blind them
+	// ourselves. The final node is handed the blinding point of the
+	// blinded destination, so that it can pass the message on to its
+	// introduction node.
+	if len(msg.Path) > 0 {
+		path, err := blindPath(msg.Path, msg.BlindedDestination)
+		if err != nil {
+			return err
+		}
+
+		blindingPoint = path.BlindingPoint
+		firstHop = path.IntroductionPoint
+		blindedHops = path.BlindedHops
+	} else {
+		blindingPoint = msg.BlindedDestination.BlindingPoint
+		firstHop = msg.BlindedDestination.IntroductionPoint
+	}
+
+	if msg.BlindedDestination != nil {
+		blindedHops = append(
+			blindedHops, msg.BlindedDestination.BlindedHops...,
+		)
+	}
+
+	onionBlob, err := createOnion(
+		blindedHops, msg.ReplyPath, msg.FinalHopRecords,
+	)
+	if err != nil {
+		return err
+	}
+
+	var peer [33]byte
+	copy(peer[:], firstHop.SerializeCompressed())
+
+	return m.sendToPeer(
+		peer, lnwire.NewOnionMessage(blindingPoint, onionBlob),
+	)
+}
+
+// HandleOnionMessage processes an onion message that we received from the
+// given peer. If we are the final hop of the message, it is delivered to our
+// subscribers, otherwise it is forwarded to the next hop.
+func (m *Messenger) HandleOnionMessage(peer [33]byte,
+	msg *lnwire.OnionMessage) error {
+
+	// The sphinx router is only able to process onions of the same size
+	// as the ones used for payments.
+	if len(msg.OnionBlob) != lnwire.OnionPacketSize {
+		return fmt.Errorf("%w: %d bytes", ErrUnsupportedPacketSize,
+			len(msg.OnionBlob))
+	}
+
+	onionPkt := &sphinx.OnionPacket{}
+	if err := onionPkt.Decode(bytes.NewReader(msg.OnionBlob)); err != nil {
+		return err
+	}
+
+	// The onion was encrypted to our blinded node ID, so we'll tweak its
+	// ephemeral key to allow the sphinx router to process it with our
+	// real node key.
+	ogEphemeralKey := onionPkt.EphemeralKey
+	tweakedKey, err := blindedpath.TweakOnionKey(
+		m.cfg.NodeKey, msg.BlindingPoint, ogEphemeralKey,
+	)
+	if err != nil {
+		return err
+	}
+	onionPkt.EphemeralKey = tweakedKey
+
+	// Onion messages don't commit to any associated data. As they don't
+	// move any funds, we also don't need to protect against replays, so
+	// we skip the router's replay log.
+	packet, err := m.cfg.OnionRouter.ReconstructOnionPacket(onionPkt, nil)
+	if err != nil {
+		return err
+	}
+
+	if packet.Payload.Type != sphinx.PayloadTLV {
+		return fmt.Errorf("onion message has legacy payload")
+	}
+
+	payload, err := DecodePayload(
+		bytes.NewReader(packet.Payload.Payload),
+	)
+	if err != nil {
+		return err
+	}
+
+	plainText, err := blindedpath.DecryptBlindedHopData(
+		m.cfg.NodeKey, msg.BlindingPoint, payload.EncryptedData,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decrypt blinded route data: %w",
+			err)
+	}
+
+	routeData, err := record.DecodeBlindedRouteData(
+		bytes.NewReader(plainText),
+	)
+	if err != nil {
+		return err
+	}
+
+	if packet.Action == sphinx.ExitNode {
+		log.Debugf("Received onion message from peer %x", peer)
+
+		return m.ntfnServer.SendUpdate(&ReceivedMessage{
+			Peer:            peer,
+			PathID:          routeData.PathID,
+			ReplyPath:       payload.ReplyPath,
+			FinalHopRecords: payload.FinalHopRecords,
+		})
+	}
+
+	nextHop, err := m.nextHop(routeData)
+	if err != nil {
+		return err
+	}
+
+	// The recipient may instruct us to hand a specific blinding point to
+	// the next hop, otherwise we derive it from our own.
+	nextBlinding := routeData.NextBlindingOverride
+	if nextBlinding == nil {
+		nextBlinding, err = blindedpath.NextBlindingPoint(
+			m.cfg.NodeKey, msg.BlindingPoint,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The sphinx router derived the ephemeral key of the next onion from
+	// the tweaked key, whereas the sender derived it from the original
+	// one, so we'll need to correct it before passing the onion on.
+	sharedSecret, err := m.cfg.NodeKey.ECDH(tweakedKey)
+	if err != nil {
+		return err
+	}
+	nextPkt := packet.NextPacket
+	nextPkt.EphemeralKey = blindedpath.NextEphemeral(
+		ogEphemeralKey, sharedSecret,
+	)
+
+	var b bytes.Buffer
+	if err := nextPkt.Encode(&b); err != nil {
+		return err
+	}
+
+	log.Debugf("Forwarding onion message from peer %x to %x", peer,
+		nextHop)
+
+	return m.sendToPeer(nextHop, lnwire.NewOnionMessage(
+		nextBlinding, b.Bytes(),
+	))
+}
+
+// nextHop returns the node that a message should be forwarded to, based on
+// the blinded route data that was provided to us.
+func (m *Messenger) nextHop(
+	routeData *record.BlindedRouteData) ([33]byte, error) {
+
+	var nextHop [33]byte
+
+	switch {
+	case routeData.NextNodeID != nil:
+		copy(nextHop[:], routeData.NextNodeID.SerializeCompressed())
+
+	case routeData.ShortChannelID != nil && m.cfg.FetchChannelPeer != nil:
+		peer, err := m.cfg.FetchChannelPeer(*routeData.ShortChannelID)
+		if err != nil {
+			return nextHop, fmt.Errorf("unable to find peer for "+
+				"channel %v: %w", routeData.ShortChannelID, err)
+		}
+		copy(nextHop[:], peer.SerializeCompressed())
+
+	default:
+		return nextHop, ErrNoNextHop
+	}
+
+	return nextHop, nil
+}
+
+// sendToPeer sends an onion message to the given peer. If the peer is our own
+// node, which is the case if we are the introduction node of a blinded path
+// that we send a message over, the message is processed directly.
+func (m *Messenger) sendToPeer(peer [33]byte,
+	msg *lnwire.OnionMessage) error {
+
+	if peer == m.ourPubKey {
+		return m.HandleOnionMessage(peer, msg)
+	}
+
+	return m.cfg.SendToPeer(peer, msg)
+}
+
+// blindPath creates a blinded path over the given nodes that instructs each
+// node to pass a message on to the next one. If a blinded destination is
+// given, the final node is told to pass the message on to its introduction
+// node.
+func blindPath(nodes []*btcec.PublicKey,
+	dest *blindedpath.BlindedPath) (*blindedpath.BlindedPath, error) {
+
+	hops := make([]*blindedpath.HopInfo, len(nodes))
+	for i, node := range nodes {
+		var routeData record.BlindedRouteData
+		switch {
+		case i < len(nodes)-1:
+			routeData.NextNodeID = nodes[i+1]
+
+		case dest != nil:
+			routeData.NextNodeID = dest.IntroductionPoint
+			routeData.NextBlindingOverride = dest.BlindingPoint
+		}
+
+		plainText, err := record.EncodeBlindedRouteData(&routeData)
+		if err != nil {
+			return nil, err
+		}
+
+		hops[i] = &blindedpath.HopInfo{
+			NodePub:   node,
+			PlainText: plainText,
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return blindedpath.BuildBlindedPath(sessionKey, hops)
+}
+
+// createOnion creates a serialized onion packet that routes a message over
+// the given blinded hops. The reply path and contents of the message are
+// included in the payload of the final hop.
+func createOnion(hops []*blindedpath.BlindedHop,
+	replyPath *blindedpath.BlindedPath,
+	finalHopRecords map[tlv.Type][]byte) ([]byte, error) {
+
+	if len(hops) > sphinx.NumMaxHops {
+		return nil, fmt.Errorf("onion message route of %d hops "+
+			"exceeds maximum of %d", len(hops), sphinx.NumMaxHops)
+	}
+
+	var path sphinx.PaymentPath
+	for i, hop := range hops {
+		payload := &Payload{
+			EncryptedData: hop.CipherText,
+		}
+		if i == len(hops)-1 {
+			payload.ReplyPath = replyPath
+			payload.FinalHopRecords = finalHopRecords
+		}
+
+		var b bytes.Buffer
+		if err := payload.Encode(&b); err != nil {
+			return nil, err
+		}
+
+		hopPayload, err := sphinx.NewHopPayload(nil, b.Bytes())
+		if err != nil {
+			return nil, err
+		}
+
+		path[i] = sphinx.OnionHop{
+			NodePub:    *hop.BlindedNodePub,
+			HopPayload: hopPayload,
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	onionPkt, err := sphinx.NewOnionPacket(
+		&path, sessionKey, nil, sphinx.DeterministicPacketFiller,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := onionPkt.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,148 @@
+package onionmessage
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sphinx "github.com/ltcsuite/lightning-onion"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+// testNetwork is a set of messengers that deliver onion messages to each
+// other directly.
+type testNetwork struct {
+	messengers map[[33]byte]*Messenger
+}
+
+// addNode creates a new messenger and adds it to the network.
+func (n *testNetwork) addNode(t *testing.T) *Messenger {
+	t.Helper()
+
+	priv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	nodeKey := &sphinx.PrivKeyECDH{PrivKey: priv}
+	messenger := New(&Config{
+		NodeKey: nodeKey,
+		OnionRouter: sphinx.NewRouter(
+			nodeKey, &chaincfg.MainNetParams,
+			sphinx.NewMemoryReplayLog(),
+		),
+		SendToPeer: func(peer [33]byte, msg lnwire.Message) error {
+			target, ok := n.messengers[peer]
+			if !ok {
+				return fmt.Errorf("unknown peer %x", peer)
+			}
+
+			return target.HandleOnionMessage(
+				messengerKey(priv.PubKey()),
+				msg.(*lnwire.OnionMessage),
+			)
+		},
+	})
+	require.NoError(t, messenger.Start())
+	t.Cleanup(func() {
+		require.NoError(t, messenger.Stop())
+	})
+
+	n.messengers[messengerKey(priv.PubKey())] = messenger
+
+	return messenger
+}
+
+func messengerKey(pubKey *btcec.PublicKey) [33]byte {
+	var key [33]byte
+	copy(key[:], pubKey.SerializeCompressed())
+
+	return key
+}
+
+// TestOnionMessageRouting tests that onion messages are routed to their
+// recipient, both over a path that is blinded by the sender and over a
+// blinded path created by the recipient.
+func TestOnionMessageRouting(t *testing.T) {
+	t.Parallel()
+
+	network := &testNetwork{
+		messengers: make(map[[33]byte]*Messenger),
+	}
+	alice := network.addNode(t)
+	bob := network.addNode(t)
+	carol := network.addNode(t)
+
+	client, err := carol.SubscribeMessages()
+	require.NoError(t, err)
+	defer client.Cancel()
+
+	records := map[tlv.Type][]byte{
+		64: []byte("hello"),
+	}
+
+	waitForMessage := func() *ReceivedMessage {
+		select {
+		case update := <-client.Updates():
+			return update.(*ReceivedMessage)
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("message not received")
+			return nil
+		}
+	}
+
+	// Alice sends a message to Carol via Bob, and asks Carol to reply
+	// over a blinded path to Alice.
+	replyPath, err := alice.NewReplyPath([]byte{1})
+	require.NoError(t, err)
+
+	err = alice.SendMessage(&OutgoingMessage{
+		Path: []*btcec.PublicKey{
+			bob.cfg.NodeKey.PubKey(), carol.cfg.NodeKey.PubKey(),
+		},
+		ReplyPath:       replyPath,
+		FinalHopRecords: records,
+	})
+	require.NoError(t, err)
+
+	msg := waitForMessage()
+	require.Equal(t, bob.ourPubKey, msg.Peer)
+	require.Nil(t, msg.PathID)
+	require.Equal(t, records, msg.FinalHopRecords)
+	require.Equal(t, replyPath, msg.ReplyPath)
+
+	// Carol creates a blinded path to herself, which Alice then sends a
+	// message over by routing it through Bob to the introduction node.
+	carolPath, err := carol.NewReplyPath([]byte{2})
+	require.NoError(t, err)
+
+	err = alice.SendMessage(&OutgoingMessage{
+		Path:               []*btcec.PublicKey{bob.cfg.NodeKey.PubKey()},
+		BlindedDestination: carolPath,
+		FinalHopRecords:    records,
+	})
+	require.NoError(t, err)
+
+	msg = waitForMessage()
+	require.Equal(t, bob.ourPubKey, msg.Peer)
+	require.Equal(t, []byte{2}, msg.PathID)
+	require.Equal(t, records, msg.FinalHopRecords)
+
+	// Bob can send a message to the blinded path directly, as Carol is
+	// his peer.
+	err = bob.SendMessage(&OutgoingMessage{
+		BlindedDestination: carolPath,
+		FinalHopRecords:    records,
+	})
+	require.NoError(t, err)
+
+	msg = waitForMessage()
+	require.Equal(t, []byte{2}, msg.PathID)
+
+	// A message needs a destination.
+	err = alice.SendMessage(&OutgoingMessage{})
+	require.ErrorIs(t, err, ErrNoDestination)
+}
@@ -0,0 +1,160 @@
+package onionmessage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/tlv"
+)
+
+const (
+	// replyPathType is the type used for the blinded path that the
+	// recipient of a message can use to reply to the sender.
+	replyPathType tlv.Type = 2
+
+	// encryptedDataType is the type used for the blinded route data that
+	// the creator of a blinded path encrypted for the hop.
+	encryptedDataType tlv.Type = 4
+
+	// MinFinalHopType is the lowest type that can be used for the contents
+	// of a message that is addressed to its final hop. Types below this
+	// value are reserved for the onion message protocol itself.
+	MinFinalHopType tlv.Type = 64
+)
+
+var (
+	// ErrNoEncryptedData is returned when an onion message payload does
+	// not contain any encrypted data. As onion messages are always routed
+	// over blinded paths, every hop must receive encrypted data.
+	ErrNoEncryptedData = errors.New("onion message payload has no " +
+		"encrypted data")
+
+	// ErrInvalidFinalHopType is returned when an attempt is made to add a
+	// record with a reserved type to the contents of a message.
+	ErrInvalidFinalHopType = errors.New("final hop records must have a " +
+		"type of at least 64")
+)
+
+// Payload is the per-hop payload of an onion message.
+type Payload struct {
+	// EncryptedData is the blinded route data that was encrypted for the
+	// hop by the creator of the blinded path.
+	EncryptedData []byte
+
+	// ReplyPath is an optional blinded path that the recipient of the
+	// message can use to reply to the sender. It is only set for the final
+	// hop.
+	ReplyPath *blindedpath.BlindedPath
+
+	// FinalHopRecords holds the contents of the message. It is only set
+	// for the final hop.
+	FinalHopRecords map[tlv.Type][]byte
+}
+
+// Encode serializes the payload as a TLV stream.
+func (p *Payload) Encode(w io.Writer) error {
+	var records []tlv.Record
+
+	if p.ReplyPath != nil {
+		var replyPath bytes.Buffer
+		if err := p.ReplyPath.Encode(&replyPath); err != nil {
+			return err
+		}
+		replyPathBytes := replyPath.Bytes()
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			replyPathType, &replyPathBytes,
+		))
+	}
+
+	if len(p.EncryptedData) > 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			encryptedDataType, &p.EncryptedData,
+		))
+	}
+
+	for recordType, value := range p.FinalHopRecords {
+		if recordType < MinFinalHopType {
+			return fmt.Errorf("%w: %d", ErrInvalidFinalHopType,
+				recordType)
+		}
+
+		records = append(records, tlv.MakeStaticRecord(
+			recordType, nil, uint64(len(value)),
+			tlv.StubEncoder(value), nil,
+		))
+	}
+
+	tlv.SortRecords(records)
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// DecodePayload parses an onion message payload from the passed reader. Any
+// records with a type of at least MinFinalHopType are returned as the contents
+// of the message, while unknown even types below it result in an error.
+func DecodePayload(r io.Reader) (*Payload, error) {
+	var (
+		payload        Payload
+		replyPathBytes []byte
+	)
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(replyPathType, &replyPathBytes),
+		tlv.MakePrimitiveRecord(
+			encryptedDataType, &payload.EncryptedData,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for recordType, value := range parsedTypes {
+		switch {
+		case recordType == replyPathType,
+			recordType == encryptedDataType:
+
+			continue
+
+		case recordType >= MinFinalHopType:
+			if payload.FinalHopRecords == nil {
+				payload.FinalHopRecords = make(
+					map[tlv.Type][]byte,
+				)
+			}
+			payload.FinalHopRecords[recordType] = value
+
+		case recordType%2 == 0:
+			return nil, fmt.Errorf("unknown even type %d in onion "+
+				"message payload", recordType)
+		}
+	}
+
+	if _, ok := parsedTypes[replyPathType]; ok {
+		payload.ReplyPath, err = blindedpath.DecodeBlindedPath(
+			bytes.NewReader(replyPathBytes),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reply path: %w", err)
+		}
+	}
+
+	if len(payload.EncryptedData) == 0 {
+		return nil, ErrNoEncryptedData
+	}
+
+	return &payload, nil
+}
@@ -0,0 +1,93 @@
+package onionmessage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPayloadEncoding tests that onion message payloads can be serialized and
+// parsed again, and that invalid payloads are rejected.
+func TestPayloadEncoding(t *testing.T) {
+	t.Parallel()
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	nodeKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	replyPath, err := blindedpath.BuildBlindedPath(
+		sessionKey, []*blindedpath.HopInfo{
+			{
+				NodePub:   nodeKey.PubKey(),
+				PlainText: []byte{1, 2, 3},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	payload := &Payload{
+		EncryptedData: []byte{4, 5, 6},
+		ReplyPath:     replyPath,
+		FinalHopRecords: map[tlv.Type][]byte{
+			64: {7},
+			67: {8, 9},
+		},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, payload.Encode(&b))
+
+	decoded, err := DecodePayload(&b)
+	require.NoError(t, err)
+	require.Equal(t, payload, decoded)
+
+	// Records with a type reserved for the onion message protocol can't
+	// be used for the contents of a message.
+	payload.FinalHopRecords[62] = []byte{1}
+	require.ErrorIs(t, payload.Encode(&b), ErrInvalidFinalHopType)
+
+	// Every hop must receive encrypted data.
+	b.Reset()
+	payload = &Payload{
+		FinalHopRecords: map[tlv.Type][]byte{
+			64: {7},
+		},
+	}
+	require.NoError(t, payload.Encode(&b))
+
+	_, err = DecodePayload(&b)
+	require.ErrorIs(t, err, ErrNoEncryptedData)
+
+	// Unknown even types below the final hop range are rejected, while
+	// unknown odd types are ignored.
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(encryptedDataType, &[]byte{1}),
+		tlv.MakePrimitiveRecord(11, &[]byte{2}),
+	)
+	require.NoError(t, err)
+
+	b.Reset()
+	require.NoError(t, stream.Encode(&b))
+
+	decoded, err = DecodePayload(&b)
+	require.NoError(t, err)
+	require.Nil(t, decoded.FinalHopRecords)
+
+	stream, err = tlv.NewStream(
+		tlv.MakePrimitiveRecord(encryptedDataType, &[]byte{1}),
+		tlv.MakePrimitiveRecord(10, &[]byte{2}),
+	)
+	require.NoError(t, err)
+
+	b.Reset()
+	require.NoError(t, stream.Encode(&b))
+
+	_, err = DecodePayload(&b)
+	require.Error(t, err)
+}
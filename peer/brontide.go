@@ -28,6 +28,7 @@ import (
 	"github.com/ltcsuite/lnd/lnwallet"
 	"github.com/ltcsuite/lnd/lnwallet/chainfee"
 	"github.com/ltcsuite/lnd/lnwallet/chancloser"
+	"github.com/ltcsuite/lnd/lnwallet/chansplicer"
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/netann"
 	"github.com/ltcsuite/lnd/pool"
@@ -106,6 +107,22 @@ type closeMsg struct {
 	msg lnwire.Message
 }
 
+// coopCloseSpend is sent to the channelManager once the funding output of a
+// channel that is cooperatively closed with the simple close protocol was
+// spent by one of the negotiated closing transactions.
+type coopCloseSpend struct {
+	cid         lnwire.ChannelID
+	closingTxid chainhash.Hash
+}
+
+// spliceMsg is a wrapper struct around any wire messages that deal with the
+// negotiation of a splice. This struct includes the raw channel ID targeted
+// along with the original message.
+type spliceMsg struct {
+	cid lnwire.ChannelID
+	msg lnwire.Message
+}
+
 // PendingUpdate describes the pending state of a closing channel.
 type PendingUpdate struct {
 	Txid        []byte
@@ -280,6 +297,11 @@ type Config struct {
 	// FundingManager is an implementation of the funding.Controller interface.
 	FundingManager funding.Controller
 
+	// FetchTx fetches a wallet transaction by its hash. It's used to
+	// retrieve the previous transactions of the inputs that we add to a
+	// splice transaction.
+	FetchTx func(chainhash.Hash) (*wire.MsgTx, error)
+
 	// Hodl is used when creating ChannelLinks to specify HodlFlags as
 	// breakpoints in dev builds.
 	Hodl *hodl.Config
@@ -326,6 +348,10 @@ type Config struct {
 	// from the peer.
 	HandleCustomMessage func(peer [33]byte, msg *lnwire.Custom) error
 
+	// HandleOnionMessage is called whenever an onion message is received
+	// from the peer.
+	HandleOnionMessage func(peer [33]byte, msg *lnwire.OnionMessage) error
+
 	// PongBuf is a slice we'll reuse instead of allocating memory on the
 	// heap. Since only reads will occur and no writes, there is no need
 	// for any synchronization primitives. As a result, it's safe to share
@@ -435,6 +461,51 @@ type Brontide struct {
 	// well as lnwire.ClosingSigned messages.
 	chanCloseMsgs chan *closeMsg
 
+	// coopCloseSpends is a channel over which the spends of the funding
+	// outputs of channels that are closed with the simple close protocol
+	// are sent.
+	coopCloseSpends chan *coopCloseSpend
+
+	// watchedCoopCloses is the set of channels closed with the simple
+	// close protocol whose funding output is being watched for a spend by
+	// one of the closing transactions. It's only accessed by the
+	// channelManager goroutine.
+	watchedCoopCloses map[lnwire.ChannelID]struct{}
+
+	// activeSpliceMtx protects access to the activeChanSplices and
+	// staleMsgStreams maps, as they're used by the readHandler to direct
+	// the messages of channels that are being spliced.
+	activeSpliceMtx sync.RWMutex
+
+	// activeChanSplices is a map that keeps track of all the active splice
+	// negotiations. Any splice related messages are directed to one of
+	// these state machines. Once the splice transaction is signed or the
+	// negotiation failed, the state machine will be deleted from the map.
+	activeChanSplices map[lnwire.ChannelID]*chansplicer.ChanSplicer
+
+	// staleMsgStreams is the set of channels whose link was removed to
+	// splice the channel. Their message streams still deliver messages to
+	// the removed link, so they need to be replaced.
+	staleMsgStreams map[lnwire.ChannelID]struct{}
+
+	// localSpliceChanReqs is a channel in which any local requests to
+	// splice a particular channel are sent over.
+	localSpliceChanReqs chan *chansplicer.ChanSplice
+
+	// chanSpliceMsgs is a channel that any message related to the
+	// negotiation of a splice is sent over.
+	chanSpliceMsgs chan *spliceMsg
+
+	// spliceLockedMtx protects access to the sentSpliceLocked and
+	// remoteSpliceLocked maps.
+	spliceLockedMtx sync.Mutex
+
+	// sentSpliceLocked and remoteSpliceLocked track the splice
+	// transactions for which we sent and received a splice_locked message
+	// during this connection, indexed by the channel that was spliced.
+	sentSpliceLocked   map[lnwire.ChannelID]chainhash.Hash
+	remoteSpliceLocked map[lnwire.ChannelID]chainhash.Hash
+
 	// remoteFeatures is the feature vector received from the peer during
 	// the connection handshake.
 	remoteFeatures *lnwire.FeatureVector
@@ -469,9 +540,20 @@ func NewBrontide(cfg Config) *Brontide {
 		localCloseChanReqs: make(chan *htlcswitch.ChanClose),
 		linkFailures:       make(chan linkFailureReport),
 		chanCloseMsgs:      make(chan *closeMsg),
+		coopCloseSpends:    make(chan *coopCloseSpend),
+		watchedCoopCloses:  make(map[lnwire.ChannelID]struct{}),
 		resentChanSyncMsg:  make(map[lnwire.ChannelID]struct{}),
 		queueQuit:          make(chan struct{}),
 		quit:               make(chan struct{}),
+
+		activeChanSplices: make(
+			map[lnwire.ChannelID]*chansplicer.ChanSplicer,
+		),
+		staleMsgStreams:     make(map[lnwire.ChannelID]struct{}),
+		localSpliceChanReqs: make(chan *chansplicer.ChanSplice),
+		chanSpliceMsgs:      make(chan *spliceMsg),
+		sentSpliceLocked:    make(map[lnwire.ChannelID]chainhash.Hash),
+		remoteSpliceLocked:  make(map[lnwire.ChannelID]chainhash.Hash),
 	}
 
 	return p
@@ -702,47 +784,11 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		// Before we register this new link with the HTLC Switch, we'll
 		// need to fetch its current link-layer forwarding policy from
 		// the database.
-		graph := p.cfg.ChannelGraph
-		info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(chanPoint)
-		if err != nil && err != channeldb.ErrEdgeNotFound {
+		forwardingPolicy, err := p.fetchForwardingPolicy(chanPoint)
+		if err != nil {
 			return nil, err
 		}
 
-		// We'll filter out our policy from the directional channel
-		// edges based whom the edge connects to. If it doesn't connect
-		// to us, then we know that we were the one that advertised the
-		// policy.
-		//
-		// TODO(roasbeef): can add helper method to get policy for
-		// particular channel.
-		var selfPolicy *channeldb.ChannelEdgePolicy
-		if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
-			p.cfg.ServerPubKey[:]) {
-
-			selfPolicy = p1
-		} else {
-			selfPolicy = p2
-		}
-
-		// If we don't yet have an advertised routing policy, then
-		// we'll use the current default, otherwise we'll translate the
-		// routing policy into a forwarding policy.
-		var forwardingPolicy *htlcswitch.ForwardingPolicy
-		if selfPolicy != nil {
-			forwardingPolicy = &htlcswitch.ForwardingPolicy{
-				MinHTLCOut:    selfPolicy.MinHTLC,
-				MaxHTLC:       selfPolicy.MaxHTLC,
-				BaseFee:       selfPolicy.FeeBaseMSat,
-				FeeRate:       selfPolicy.FeeProportionalMillionths,
-				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
-			}
-		} else {
-			peerLog.Warnf("Unable to find our forwarding policy "+
-				"for channel %v, using default values",
-				chanPoint)
-			forwardingPolicy = &p.cfg.RoutingPolicy
-		}
-
 		peerLog.Tracef("Using link policy of: %v",
 			spew.Sdump(forwardingPolicy))
 
@@ -780,11 +826,60 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		p.activeChanMtx.Lock()
 		p.activeChannels[chanID] = lnChan
 		p.activeChanMtx.Unlock()
+
+		// If the channel has a pending splice, we'll wait for its
+		// transaction to confirm, so both parties can lock it in.
+		if lnChan.PendingSplice() != nil {
+			p.wg.Add(1)
+			go p.waitForSpliceLock(lnChan)
+		}
 	}
 
 	return msgs, nil
 }
 
+// fetchForwardingPolicy fetches the current link-layer forwarding policy of
+// the given channel from the database.
+func (p *Brontide) fetchForwardingPolicy(chanPoint *wire.OutPoint) (
+	*htlcswitch.ForwardingPolicy, error) {
+
+	graph := p.cfg.ChannelGraph
+	info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(chanPoint)
+	if err != nil && err != channeldb.ErrEdgeNotFound {
+		return nil, err
+	}
+
+	// We'll filter out our policy from the directional channel edges based
+	// whom the edge connects to. If it doesn't connect to us, then we know
+	// that we were the one that advertised the policy.
+	var selfPolicy *channeldb.ChannelEdgePolicy
+	if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
+		p.cfg.ServerPubKey[:]) {
+
+		selfPolicy = p1
+	} else {
+		selfPolicy = p2
+	}
+
+	// If we don't yet have an advertised routing policy, then we'll use
+	// the current default, otherwise we'll translate the routing policy
+	// into a forwarding policy.
+	if selfPolicy == nil {
+		peerLog.Warnf("Unable to find our forwarding policy for "+
+			"channel %v, using default values", chanPoint)
+
+		return &p.cfg.RoutingPolicy, nil
+	}
+
+	return &htlcswitch.ForwardingPolicy{
+		MinHTLCOut:    selfPolicy.MinHTLC,
+		MaxHTLC:       selfPolicy.MaxHTLC,
+		BaseFee:       selfPolicy.FeeBaseMSat,
+		FeeRate:       selfPolicy.FeeProportionalMillionths,
+		TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
+	}, nil
+}
+
 // addLink creates and adds a new ChannelLink from the specified channel.
 func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 	lnChan *lnwallet.LightningChannel,
@@ -1406,10 +1501,70 @@ out:
 			*lnwire.AcceptChannel,
 			*lnwire.FundingCreated,
 			*lnwire.FundingSigned,
-			*lnwire.FundingLocked:
+			*lnwire.FundingLocked,
+			*lnwire.OpenChannel2,
+			*lnwire.AcceptChannel2:
 
 			p.cfg.FundingManager.ProcessFundingMsg(msg, p)
 
+		// The interactive transaction construction is used both to
+		// open dual funded channels and to splice active channels.
+		case *lnwire.TxAddInput,
+			*lnwire.TxAddOutput,
+			*lnwire.TxRemoveInput,
+			*lnwire.TxRemoveOutput,
+			*lnwire.TxComplete,
+			*lnwire.TxSignatures,
+			*lnwire.TxAbort:
+
+			cid := msg.(LinkUpdater).TargetChanID()
+			if !p.isSplicing(cid) {
+				p.cfg.FundingManager.ProcessFundingMsg(msg, p)
+				break
+			}
+
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{cid, msg}:
+			case <-p.quit:
+				break out
+			}
+
+		// The first commitment signature of a dual funded channel is
+		// exchanged while the channel is still pending, so it's
+		// handled by the funding manager instead of a link. The same
+		// goes for the signatures for the splice versions of the
+		// commitments of a channel that is being spliced.
+		case *lnwire.CommitSig:
+			if p.cfg.FundingManager.IsPendingChannel(msg.ChanID, p) {
+				p.cfg.FundingManager.ProcessFundingMsg(msg, p)
+				break
+			}
+
+			if p.isSplicing(msg.ChanID) {
+				select {
+				case p.chanSpliceMsgs <- &spliceMsg{
+					msg.ChanID, msg,
+				}:
+				case <-p.quit:
+					break out
+				}
+				break
+			}
+
+			targetChan = msg.ChanID
+			isLinkUpdate = p.isActiveChannel(targetChan)
+
+		case *lnwire.SpliceInit, *lnwire.SpliceAck:
+			cid := msg.(LinkUpdater).TargetChanID()
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{cid, msg}:
+			case <-p.quit:
+				break out
+			}
+
+		case *lnwire.SpliceLocked:
+			p.handleSpliceLocked(msg)
+
 		case *lnwire.Shutdown:
 			select {
 			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
@@ -1422,6 +1577,18 @@ out:
 			case <-p.quit:
 				break out
 			}
+		case *lnwire.ClosingComplete:
+			select {
+			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.ClosingSig:
+			select {
+			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
 
 		case *lnwire.Error:
 			targetChan = msg.ChanID
@@ -1469,6 +1636,14 @@ out:
 				peerLog.Errorf("peer: %v, %v", p, err)
 			}
 
+		case *lnwire.OnionMessage:
+			err := p.handleOnionMessage(msg)
+			if err != nil {
+				p.storeError(err)
+				peerLog.Debugf("peer: %v, unable to handle onion "+
+					"message: %v", p, err)
+			}
+
 		default:
 			// If the message we received is unknown to us, store
 			// the type to track the failure.
@@ -1480,6 +1655,14 @@ out:
 		}
 
 		if isLinkUpdate {
+			// The link of a channel is replaced when the channel
+			// is spliced, so a stream that delivers the messages
+			// to the old link must be discarded. It's stopped once
+			// the readHandler exits.
+			if p.popStaleMsgStream(targetChan) {
+				delete(p.activeMsgStreams, targetChan)
+			}
+
 			// If this is a channel update, then we need to feed it
 			// into the channel's in-order message stream.
 			chanStream, ok := p.activeMsgStreams[targetChan]
@@ -1517,6 +1700,16 @@ func (p *Brontide) handleCustomMessage(msg *lnwire.Custom) error {
 	return p.cfg.HandleCustomMessage(p.PubKey(), msg)
 }
 
+// handleOnionMessage hands the given onion message to the onion messenger if
+// a handler is registered.
+func (p *Brontide) handleOnionMessage(msg *lnwire.OnionMessage) error {
+	if p.cfg.HandleOnionMessage == nil {
+		return fmt.Errorf("no onion message handler")
+	}
+
+	return p.cfg.HandleOnionMessage(p.PubKey(), msg)
+}
+
 // isActiveChannel returns true if the provided channel id is active, otherwise
 // returns false.
 func (p *Brontide) isActiveChannel(chanID lnwire.ChannelID) bool {
@@ -1526,6 +1719,27 @@ func (p *Brontide) isActiveChannel(chanID lnwire.ChannelID) bool {
 	return ok
 }
 
+// isSplicing returns true if a splice of the provided channel is being
+// negotiated.
+func (p *Brontide) isSplicing(chanID lnwire.ChannelID) bool {
+	p.activeSpliceMtx.RLock()
+	_, ok := p.activeChanSplices[chanID]
+	p.activeSpliceMtx.RUnlock()
+	return ok
+}
+
+// popStaleMsgStream returns true if the link of the provided channel was
+// removed to splice the channel since the last call, in which case its
+// message stream needs to be replaced.
+func (p *Brontide) popStaleMsgStream(chanID lnwire.ChannelID) bool {
+	p.activeSpliceMtx.Lock()
+	defer p.activeSpliceMtx.Unlock()
+
+	_, ok := p.staleMsgStreams[chanID]
+	delete(p.staleMsgStreams, chanID)
+	return ok
+}
+
 // storeError stores an error in our peer's buffer of recent errors with the
 // current timestamp. Errors are only stored if we have at least one active
 // channel with the peer to mitigate a dos vector where a peer costlessly
@@ -1583,6 +1797,15 @@ func (p *Brontide) handleError(msg *lnwire.Error) bool {
 		p.cfg.FundingManager.ProcessFundingMsg(msg, p)
 		return false
 
+	// If the channel is being spliced, then the error aborts the splice
+	// negotiation.
+	case p.isSplicing(msg.ChanID):
+		select {
+		case p.chanSpliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+		case <-p.quit:
+		}
+		return false
+
 	// If not we hand the error to the channel link for this channel.
 	case p.isActiveChannel(msg.ChanID):
 		return true
@@ -1624,6 +1847,43 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("chan_id=%v, next_point=%x",
 			msg.ChanID, msg.NextPerCommitmentPoint.SerializeCompressed())
 
+	case *lnwire.OpenChannel2:
+		return fmt.Sprintf("temp_chan_id=%x, chain=%v, csv=%v, amt=%v, "+
+			"funding_feerate=%v, locktime=%v, flags=%v",
+			msg.PendingChannelID[:], msg.ChainHash, msg.CsvDelay,
+			msg.FundingAmount, msg.FundingFeePerKWeight, msg.LockTime,
+			msg.ChannelFlags)
+
+	case *lnwire.AcceptChannel2:
+		return fmt.Sprintf("temp_chan_id=%x, amt=%v, csv=%v, "+
+			"num_confs=%v", msg.PendingChannelID[:],
+			msg.FundingAmount, msg.CsvDelay, msg.MinAcceptDepth)
+
+	case *lnwire.TxAddInput:
+		return fmt.Sprintf("chan_id=%v, serial_id=%v, prev_tx_out=%v",
+			msg.ChanID, msg.SerialID, msg.PrevTxOut)
+
+	case *lnwire.TxAddOutput:
+		return fmt.Sprintf("chan_id=%v, serial_id=%v, amt=%v",
+			msg.ChanID, msg.SerialID, msg.Amount)
+
+	case *lnwire.TxSignatures:
+		return fmt.Sprintf("chan_id=%v, txid=%v, num_witnesses=%v",
+			msg.ChanID, msg.TxHash, len(msg.Witnesses))
+
+	case *lnwire.SpliceInit:
+		return fmt.Sprintf("chan_id=%v, contribution=%v, feerate=%v, "+
+			"locktime=%v", msg.ChanID, msg.FundingContribution,
+			msg.FundingFeePerKWeight, msg.LockTime)
+
+	case *lnwire.SpliceAck:
+		return fmt.Sprintf("chan_id=%v, contribution=%v", msg.ChanID,
+			msg.FundingContribution)
+
+	case *lnwire.SpliceLocked:
+		return fmt.Sprintf("chan_id=%v, splice_txid=%v", msg.ChanID,
+			msg.SpliceTxID)
+
 	case *lnwire.Shutdown:
 		return fmt.Sprintf("chan_id=%v, script=%x", msg.ChannelID,
 			msg.Address[:])
@@ -1632,6 +1892,14 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("chan_id=%v, fee_sat=%v", msg.ChannelID,
 			msg.FeeSatoshis)
 
+	case *lnwire.ClosingComplete:
+		return fmt.Sprintf("chan_id=%v, fee_sat=%v, locktime=%v",
+			msg.ChannelID, msg.FeeSatoshis, msg.LockTime)
+
+	case *lnwire.ClosingSig:
+		return fmt.Sprintf("chan_id=%v, fee_sat=%v, locktime=%v",
+			msg.ChannelID, msg.FeeSatoshis, msg.LockTime)
+
 	case *lnwire.UpdateAddHTLC:
 		return fmt.Sprintf("chan_id=%v, id=%v, amt=%v, expiry=%v, hash=%x",
 			msg.ChanID, msg.ID, msg.Amount, msg.Expiry, msg.PaymentHash[:])
@@ -1719,6 +1987,11 @@ func messageSummary(msg lnwire.Message) string {
 
 	case *lnwire.Custom:
 		return fmt.Sprintf("type=%d", msg.Type)
+
+	case *lnwire.OnionMessage:
+		return fmt.Sprintf("blinding_point=%x, onion_len=%d",
+			msg.BlindingPoint.SerializeCompressed(),
+			len(msg.OnionBlob))
 	}
 
 	return ""
@@ -2302,6 +2575,24 @@ out:
 		case closeMsg := <-p.chanCloseMsgs:
 			p.handleCloseMsg(closeMsg)
 
+		// One of the closing transactions of a channel that was closed
+		// with the simple close protocol confirmed, so the closure is
+		// now final.
+		case spend := <-p.coopCloseSpends:
+			p.handleCoopCloseSpend(spend)
+
+		// We've just received a local request to splice an active
+		// channel, which kicks off the splice negotiation with the
+		// remote peer.
+		case req := <-p.localSpliceChanReqs:
+			p.handleLocalSpliceReq(req)
+
+		// We've received a new splice related message from the remote
+		// peer, we'll use this message to advance the splicer state
+		// machine.
+		case spliceMsg := <-p.chanSpliceMsgs:
+			p.handleSpliceMsg(spliceMsg)
+
 		// The channel reannounce delay has elapsed, broadcast the
 		// reenabled channel updates to the network. This should only
 		// fire once, so we set the reenableTimeout channel to nil to
@@ -2321,6 +2612,10 @@ out:
 			reenableTimeout = nil
 
 		case <-p.quit:
+			// Any splice that is still being negotiated can't be
+			// finished anymore, so we'll abort them.
+			p.abortChanSplices()
+
 			// As, we've been signalled to exit, we'll reset all
 			// our active channel back to their default state.
 			p.activeChanMtx.Lock()
@@ -2466,7 +2761,8 @@ func (p *Brontide) fetchActiveChanCloser(chanID lnwire.ChannelID) (
 				Disconnect: func() error {
 					return p.cfg.DisconnectPeer(p.IdentityKey())
 				},
-				Quit: p.quit,
+				Quit:        p.quit,
+				SimpleClose: p.simpleCloseNegotiated(),
 			},
 			deliveryScript,
 			feePerKw,
@@ -2536,6 +2832,13 @@ func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	// out this channel on-chain, so we execute the cooperative channel
 	// closure workflow.
 	case contractcourt.CloseRegular:
+		// If the channel is being closed already, then this request
+		// bumps the fee of the closing transaction.
+		if chanCloser, ok := p.activeChanCloses[chanID]; ok {
+			p.bumpCloseFee(chanCloser, req)
+			return
+		}
+
 		// First, we'll choose a delivery address that we'll use to send the
 		// funds to in the case of a successful negotiation.
 
@@ -2591,7 +2894,8 @@ func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 				Disconnect: func() error {
 					return p.cfg.DisconnectPeer(p.IdentityKey())
 				},
-				Quit: p.quit,
+				Quit:        p.quit,
+				SimpleClose: p.simpleCloseNegotiated(),
 			},
 			deliveryScript,
 			req.TargetFeePerKw,
@@ -2628,6 +2932,45 @@ func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	}
 }
 
+// simpleCloseNegotiated returns true if both we and the remote peer support
+// the simple close protocol, in which case it's used to negotiate cooperative
+// closes.
+func (p *Brontide) simpleCloseNegotiated() bool {
+	if p.LocalFeatures() == nil || p.remoteFeatures == nil {
+		return false
+	}
+
+	return p.LocalFeatures().HasFeature(lnwire.SimpleCloseOptional) &&
+		p.remoteFeatures.HasFeature(lnwire.SimpleCloseOptional)
+}
+
+// bumpCloseFee handles a local request to close a channel that is already
+// being closed cooperatively, by proposing a closing transaction that pays a
+// higher fee. The passed request replaces the prior close request, which is
+// notified that it was superseded.
+func (p *Brontide) bumpCloseFee(chanCloser *chancloser.ChanCloser,
+	req *htlcswitch.ChanClose) {
+
+	prevReq := chanCloser.CloseRequest()
+
+	closingComplete, err := chanCloser.BumpFee(req)
+	if err != nil {
+		peerLog.Errorf("Unable to bump closing fee of "+
+			"ChannelPoint(%v): %v", req.ChanPoint, err)
+		req.Err <- err
+		return
+	}
+
+	peerLog.Infof("Bumping closing fee of ChannelPoint(%v) to %v sat",
+		req.ChanPoint, int64(closingComplete.FeeSatoshis))
+
+	if prevReq != nil {
+		prevReq.Err <- chancloser.ErrCloseSuperseded
+	}
+
+	p.queueMsg(closingComplete, nil)
+}
+
 // linkFailureReport is sent to the channelManager whenever a link reports a
 // link failure, and is forced to exit. The report houses the necessary
 // information to clean up the channel state, send back the error message, and
@@ -3163,6 +3506,14 @@ func (p *Brontide) handleCloseMsg(msg *closeMsg) {
 		err := fmt.Errorf("unable to process close msg: %v", err)
 		peerLog.Error(err)
 
+		// Once a closing transaction negotiated with the simple close
+		// protocol was broadcast, the channel can't be returned to its
+		// normal state anymore, as that transaction may still confirm.
+		// The negotiation can still continue with a new proposal.
+		if _, err := chanCloser.ClosingTx(); err == nil {
+			return
+		}
+
 		// As the negotiations failed, we'll reset the channel state machine to
 		// ensure we act to on-chain events as normal.
 		chanCloser.Channel().ResetState()
@@ -3186,12 +3537,132 @@ func (p *Brontide) handleCloseMsg(msg *closeMsg) {
 		return
 	}
 
+	// With the simple close protocol, the closing transaction may still be
+	// replaced, so we'll wait for any of them to confirm.
+	if chanCloser.SimpleClose() {
+		p.watchSimpleClose(msg.cid, chanCloser)
+		return
+	}
+
 	// Otherwise, we've agreed on a closing fee! In this case, we'll wrap up
 	// the channel closure by notifying relevant sub-systems and launching a
 	// goroutine to wait for close tx conf.
 	p.finalizeChanClosure(chanCloser)
 }
 
+// watchSimpleClose is called whenever a closing transaction negotiated with
+// the simple close protocol was broadcast. As opposed to finalizeChanClosure,
+// the channel isn't wiped yet, since the remote peer may still replace the
+// closing transaction. Instead, the funding output is watched for a spend by
+// any of the closing transactions.
+func (p *Brontide) watchSimpleClose(chanID lnwire.ChannelID,
+	chanCloser *chancloser.ChanCloser) {
+
+	closingTx, err := chanCloser.ClosingTx()
+	if err != nil {
+		peerLog.Errorf("Unable to fetch closing tx: %v", err)
+		return
+	}
+	closingTxid := closingTx.TxHash()
+
+	// If this is a locally requested shutdown, update the caller with a
+	// new event detailing the current pending state of this request.
+	closeReq := chanCloser.CloseRequest()
+	if closeReq != nil {
+		closeReq.Updates <- &PendingUpdate{
+			Txid: closingTxid[:],
+		}
+	}
+
+	if _, ok := p.watchedCoopCloses[chanID]; ok {
+		return
+	}
+
+	chanState := chanCloser.Channel().State()
+	localKey := chanState.LocalChanCfg.MultiSigKey.PubKey
+	remoteKey := chanState.RemoteChanCfg.MultiSigKey.PubKey
+	fundingScript, _, err := input.GenFundingPkScript(
+		localKey.SerializeCompressed(), remoteKey.SerializeCompressed(),
+		int64(chanState.Capacity),
+	)
+	if err != nil {
+		peerLog.Errorf("Unable to generate funding script: %v", err)
+		return
+	}
+
+	chanPoint := chanState.FundingOutpoint
+	spendNtfn, err := p.cfg.ChainNotifier.RegisterSpendNtfn(
+		&chanPoint, fundingScript, chanCloser.NegotiationHeight(),
+	)
+	if err != nil {
+		peerLog.Errorf("Unable to register spend notification for "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		if closeReq != nil {
+			closeReq.Err <- err
+		}
+		return
+	}
+	p.watchedCoopCloses[chanID] = struct{}{}
+
+	peerLog.Infof("Waiting for any closing tx of ChannelPoint(%v) to "+
+		"confirm", chanPoint)
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer spendNtfn.Cancel()
+
+		var spend *chainntnfs.SpendDetail
+		select {
+		case spend = <-spendNtfn.Spend:
+		case <-p.quit:
+			return
+		}
+
+		// In the case that the ChainNotifier is shutting down, the
+		// notification channel will be closed, generating a nil
+		// receive.
+		if spend == nil {
+			return
+		}
+
+		select {
+		case p.coopCloseSpends <- &coopCloseSpend{
+			cid:         chanID,
+			closingTxid: *spend.SpenderTxHash,
+		}:
+		case <-p.quit:
+		}
+	}()
+}
+
+// handleCoopCloseSpend wraps up the closure of a channel that was closed with
+// the simple close protocol, once one of its closing transactions confirmed.
+func (p *Brontide) handleCoopCloseSpend(spend *coopCloseSpend) {
+	delete(p.watchedCoopCloses, spend.cid)
+
+	chanCloser, ok := p.activeChanCloses[spend.cid]
+	if !ok {
+		return
+	}
+	delete(p.activeChanCloses, spend.cid)
+
+	chanPoint := chanCloser.Channel().ChannelPoint()
+	peerLog.Infof("ChannelPoint(%v) is now closed by tx %v", chanPoint,
+		spend.closingTxid)
+
+	p.WipeChannel(chanPoint)
+
+	// Respond to the local subsystem which last requested the channel
+	// closure.
+	if closeReq := chanCloser.CloseRequest(); closeReq != nil {
+		closeReq.Updates <- &ChannelCloseUpdate{
+			ClosingTxid: spend.closingTxid[:],
+			Success:     true,
+		}
+	}
+}
+
 // HandleLocalCloseChanReqs accepts a *htlcswitch.ChanClose and passes it onto
 // the channelManager goroutine, which will shut down the link and possibly
 // close the channel.
@@ -3206,6 +3677,482 @@ func (p *Brontide) HandleLocalCloseChanReqs(req *htlcswitch.ChanClose) {
 	}
 }
 
+// HandleLocalSpliceChanReqs accepts a *chansplicer.ChanSplice and passes it
+// onto the channelManager goroutine, which will shut down the link and kick off
+// the splice negotiation.
+func (p *Brontide) HandleLocalSpliceChanReqs(req *chansplicer.ChanSplice) {
+	select {
+	case p.localSpliceChanReqs <- req:
+		peerLog.Infof("Local splice channel request delivered to "+
+			"peer: %x", p.PubKey())
+	case <-p.quit:
+		peerLog.Infof("Unable to deliver local splice channel request "+
+			"to peer %x", p.PubKey())
+		req.Err <- lnpeer.ErrPeerExiting
+	}
+}
+
+// newChanSplicer creates a splice state machine for the given channel. The
+// request should only be populated if we're the initiator of the splice.
+func (p *Brontide) newChanSplicer(channel *lnwallet.LightningChannel,
+	req *chansplicer.ChanSplice) *chansplicer.ChanSplicer {
+
+	return chansplicer.NewChanSplicer(chansplicer.ChanSpliceCfg{
+		Channel:         channel,
+		ProvisionSplice: p.cfg.Wallet.ProvisionSplice,
+		FetchTx:         p.cfg.FetchTx,
+		BroadcastTx:     p.cfg.Wallet.PublishTransaction,
+	}, req)
+}
+
+// startChanSplice shuts down the link of the channel of the passed splice
+// state machine, which handles all splice related messages of the channel from
+// now on.
+func (p *Brontide) startChanSplice(splicer *chansplicer.ChanSplicer) error {
+	chanID := lnwire.NewChanIDFromOutPoint(splicer.Channel().ChannelPoint())
+
+	// The channel must not be updated while the splice is negotiated, so
+	// we'll optimistically try a link shutdown, which fails if the channel
+	// has any pending updates.
+	if err := p.tryLinkShutdown(chanID); err != nil {
+		return err
+	}
+
+	p.activeSpliceMtx.Lock()
+	p.activeChanSplices[chanID] = splicer
+	p.staleMsgStreams[chanID] = struct{}{}
+	p.activeSpliceMtx.Unlock()
+
+	return nil
+}
+
+// endChanSplice removes the splice state machine of a channel once the
+// negotiation is over. The passed messages are sent to the remote peer, and a
+// new link is added for the channel, so it can be used to forward HTLCs again.
+func (p *Brontide) endChanSplice(splicer *chansplicer.ChanSplicer,
+	msgs []lnwire.Message) {
+
+	channel := splicer.Channel()
+	chanPoint := channel.ChannelPoint()
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	// The splicer is removed before any messages are sent, as the remote
+	// peer resumes to update the channel once it receives the last
+	// message of the negotiation. These updates must be directed to the
+	// new link.
+	p.activeSpliceMtx.Lock()
+	delete(p.activeChanSplices, chanID)
+	p.activeSpliceMtx.Unlock()
+
+	for _, msg := range msgs {
+		p.queueMsg(msg, nil)
+	}
+
+	forwardingPolicy, err := p.fetchForwardingPolicy(chanPoint)
+	if err != nil {
+		peerLog.Errorf("Unable to fetch forwarding policy of "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return
+	}
+
+	chainEvents, err := p.cfg.ChainArb.SubscribeChannelEvents(*chanPoint)
+	if err != nil {
+		peerLog.Errorf("Unable to subscribe to chain events of "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return
+	}
+
+	// The channel states were already synchronized during this
+	// connection, so we don't need to send a reestablish message.
+	err = p.addLink(
+		chanPoint, channel, forwardingPolicy, chainEvents, false,
+	)
+	if err != nil {
+		peerLog.Errorf("Unable to add link of spliced "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+	}
+}
+
+// handleLocalSpliceReq kicks off the negotiation of a splice that was
+// requested by a local subsystem.
+func (p *Brontide) handleLocalSpliceReq(req *chansplicer.ChanSplice) {
+	chanID := lnwire.NewChanIDFromOutPoint(req.ChanPoint)
+
+	p.activeChanMtx.RLock()
+	channel, ok := p.activeChannels[chanID]
+	p.activeChanMtx.RUnlock()
+
+	if !ok || channel == nil {
+		err := fmt.Errorf("unable to splice channel, ChannelID(%v) is "+
+			"unknown", chanID)
+		peerLog.Errorf(err.Error())
+		req.Err <- err
+		return
+	}
+
+	if !p.remoteFeatures.HasFeature(lnwire.SpliceOptional) {
+		req.Err <- fmt.Errorf("peer %x doesn't support splicing",
+			p.PubKey())
+		return
+	}
+
+	if p.isSplicing(chanID) {
+		req.Err <- fmt.Errorf("splice of ChannelPoint(%v) already in "+
+			"progress", req.ChanPoint)
+		return
+	}
+
+	// The splice transaction is locked to the current height, which also
+	// serves as the height hint once we wait for its confirmation.
+	_, bestHeight, err := p.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		peerLog.Errorf(err.Error())
+		req.Err <- err
+		return
+	}
+
+	splicer := p.newChanSplicer(channel, req)
+	if err := p.startChanSplice(splicer); err != nil {
+		peerLog.Errorf("failed link shutdown: %v", err)
+		req.Err <- err
+		return
+	}
+
+	spliceInit, err := splicer.InitSplice(uint32(bestHeight))
+	if err != nil {
+		p.failChanSplice(splicer, err, false)
+		return
+	}
+
+	p.queueMsg(spliceInit, nil)
+}
+
+// acceptChanSplice creates the splice state machine for a splice of the given
+// channel that was initiated by the remote peer.
+func (p *Brontide) acceptChanSplice(
+	chanID lnwire.ChannelID) (*chansplicer.ChanSplicer, error) {
+
+	if !p.LocalFeatures().HasFeature(lnwire.SpliceOptional) {
+		return nil, errors.New("splicing isn't supported")
+	}
+
+	p.activeChanMtx.RLock()
+	channel, ok := p.activeChannels[chanID]
+	p.activeChanMtx.RUnlock()
+
+	if !ok || channel == nil {
+		return nil, ErrChannelNotFound
+	}
+
+	splicer := p.newChanSplicer(channel, nil)
+	if err := p.startChanSplice(splicer); err != nil {
+		return nil, err
+	}
+
+	return splicer, nil
+}
+
+// handleSpliceMsg is called when a new splice related message is received
+// from the remote peer. We'll use this message to advance the splicer state
+// machine of the channel.
+func (p *Brontide) handleSpliceMsg(msg *spliceMsg) {
+	p.activeSpliceMtx.RLock()
+	splicer, ok := p.activeChanSplices[msg.cid]
+	p.activeSpliceMtx.RUnlock()
+
+	// If there's no splice negotiation for the channel yet, then the
+	// remote peer initiates a new splice.
+	if !ok {
+		if _, isInit := msg.msg.(*lnwire.SpliceInit); !isInit {
+			peerLog.Debugf("Ignoring %v for ChannelID(%v) without "+
+				"active splice", msg.msg.MsgType(), msg.cid)
+			return
+		}
+
+		var err error
+		splicer, err = p.acceptChanSplice(msg.cid)
+		if err != nil {
+			peerLog.Errorf("Unable to accept splice of "+
+				"ChannelID(%v): %v", msg.cid, err)
+
+			p.queueMsg(&lnwire.TxAbort{
+				ChanID: msg.cid,
+				Data:   lnwire.ErrorData(err.Error()),
+			}, nil)
+			return
+		}
+	}
+
+	// An error sent by the remote peer aborts the negotiation.
+	if errMsg, isErr := msg.msg.(*lnwire.Error); isErr {
+		p.failChanSplice(splicer, errMsg, false)
+		return
+	}
+
+	msgs, spliceFin, err := splicer.ProcessSpliceMsg(msg.msg)
+	if err != nil {
+		// We don't need to notify the remote peer if it aborted the
+		// negotiation itself.
+		_, aborted := msg.msg.(*lnwire.TxAbort)
+		p.failChanSplice(splicer, err, !aborted)
+		return
+	}
+
+	// If the splice transaction isn't fully signed yet, then we'll send
+	// our next messages and continue the negotiation.
+	if !spliceFin {
+		for _, msg := range msgs {
+			p.queueMsg(msg, nil)
+		}
+		return
+	}
+
+	p.finalizeChanSplice(splicer, msgs)
+}
+
+// failChanSplice aborts the negotiation of a splice that failed with the given
+// error. Unless the remote peer aborted the negotiation itself, it's notified
+// with a tx_abort message.
+func (p *Brontide) failChanSplice(splicer *chansplicer.ChanSplicer,
+	spliceErr error, notifyRemote bool) {
+
+	channel := splicer.Channel()
+	chanPoint := channel.ChannelPoint()
+
+	peerLog.Errorf("Splice of ChannelPoint(%v) failed: %v", chanPoint,
+		spliceErr)
+
+	if err := splicer.Abort(); err != nil {
+		peerLog.Errorf("Unable to abort splice of ChannelPoint(%v): "+
+			"%v", chanPoint, err)
+	}
+
+	var msgs []lnwire.Message
+	if notifyRemote {
+		msgs = append(msgs, &lnwire.TxAbort{
+			ChanID: lnwire.NewChanIDFromOutPoint(chanPoint),
+			Data:   lnwire.ErrorData(spliceErr.Error()),
+		})
+	}
+	p.endChanSplice(splicer, msgs)
+
+	// If we already sent our signatures for the splice transaction, then
+	// the remote peer is able to broadcast it, so the splice stays
+	// pending.
+	if channel.PendingSplice() != nil {
+		p.wg.Add(1)
+		go p.waitForSpliceLock(channel)
+	}
+
+	if req := splicer.SpliceRequest(); req != nil {
+		req.Err <- spliceErr
+	}
+}
+
+// finalizeChanSplice performs the final steps once the splice transaction is
+// fully signed and was broadcast. The channel is used to forward HTLCs again,
+// while we wait for the splice transaction to confirm.
+func (p *Brontide) finalizeChanSplice(splicer *chansplicer.ChanSplicer,
+	msgs []lnwire.Message) {
+
+	spliceTx, err := splicer.SpliceTx()
+	if err != nil {
+		p.failChanSplice(splicer, err, true)
+		return
+	}
+
+	p.endChanSplice(splicer, msgs)
+
+	channel := splicer.Channel()
+	peerLog.Infof("Splice of ChannelPoint(%v) signed, waiting for splice "+
+		"tx %v to confirm", channel.ChannelPoint(), spliceTx.TxHash())
+
+	p.wg.Add(1)
+	go p.waitForSpliceLock(channel)
+
+	if req := splicer.SpliceRequest(); req != nil {
+		req.Done <- spliceTx
+	}
+}
+
+// abortChanSplices aborts all splices that are still being negotiated.
+func (p *Brontide) abortChanSplices() {
+	p.activeSpliceMtx.Lock()
+	defer p.activeSpliceMtx.Unlock()
+
+	for chanID, splicer := range p.activeChanSplices {
+		if err := splicer.Abort(); err != nil {
+			peerLog.Errorf("Unable to abort splice of "+
+				"ChannelID(%v): %v", chanID, err)
+		}
+
+		if req := splicer.SpliceRequest(); req != nil {
+			req.Err <- lnpeer.ErrPeerExiting
+		}
+
+		delete(p.activeChanSplices, chanID)
+	}
+}
+
+// waitForSpliceLock waits for the transaction of the pending splice of the
+// given channel to reach the depth that's required to lock it in, and then
+// sends our splice_locked message to the remote peer.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (p *Brontide) waitForSpliceLock(channel *lnwallet.LightningChannel) {
+	defer p.wg.Done()
+
+	splice := channel.PendingSplice()
+	if splice == nil {
+		return
+	}
+
+	chanPoint := channel.ChannelPoint()
+	spliceTxid := splice.FundingOutpoint.Hash
+	fundingOutput := splice.FundingTx.TxOut[splice.FundingOutpoint.Index]
+
+	// The splice requires the same number of confirmations as the funding
+	// transaction of the channel. As the splice transaction is locked to
+	// the height at which it was negotiated, the lock time serves as the
+	// height hint.
+	numConfs := uint32(channel.State().NumConfsRequired)
+	if numConfs == 0 {
+		numConfs = 1
+	}
+	confNtfn, err := p.cfg.ChainNotifier.RegisterConfirmationsNtfn(
+		&spliceTxid, fundingOutput.PkScript, numConfs,
+		splice.FundingTx.LockTime,
+	)
+	if err != nil {
+		peerLog.Errorf("Unable to register for confirmation of splice "+
+			"tx %v of ChannelPoint(%v): %v", spliceTxid, chanPoint,
+			err)
+		return
+	}
+	defer confNtfn.Cancel()
+
+	var confHeight uint32
+	select {
+	case conf, ok := <-confNtfn.Confirmed:
+		if !ok {
+			return
+		}
+		confHeight = conf.BlockHeight
+
+	case <-p.quit:
+		return
+	}
+
+	// The chain watcher of the channel may have already recorded the
+	// confirmation, in which case this is a noop.
+	err = channel.MarkSpliceConfirmed(confHeight)
+	if errors.Is(err, channeldb.ErrNoSplice) {
+		return
+	}
+	if err != nil {
+		peerLog.Errorf("Unable to mark splice of ChannelPoint(%v) "+
+			"confirmed: %v", chanPoint, err)
+		return
+	}
+
+	peerLog.Infof("Splice tx %v of ChannelPoint(%v) confirmed at height "+
+		"%v", spliceTxid, chanPoint, confHeight)
+
+	p.sendSpliceLocked(channel, spliceTxid)
+}
+
+// sendSpliceLocked sends our splice_locked message for the given splice
+// transaction, and completes the splice if the remote peer locked it in
+// already.
+func (p *Brontide) sendSpliceLocked(channel *lnwallet.LightningChannel,
+	spliceTxid chainhash.Hash) {
+
+	chanID := lnwire.NewChanIDFromOutPoint(channel.ChannelPoint())
+
+	// Our splice_locked message is queued before it's recorded as sent,
+	// so it reaches the remote peer before any commitment signatures
+	// that are created once the splice is complete.
+	err := p.SendMessage(false, &lnwire.SpliceLocked{
+		ChanID:     chanID,
+		SpliceTxID: spliceTxid,
+	})
+	if err != nil {
+		peerLog.Errorf("Unable to send splice_locked for "+
+			"ChannelID(%v): %v", chanID, err)
+		return
+	}
+
+	p.spliceLockedMtx.Lock()
+	p.sentSpliceLocked[chanID] = spliceTxid
+	remoteTxid, remoteLocked := p.remoteSpliceLocked[chanID]
+	p.spliceLockedMtx.Unlock()
+
+	if remoteLocked && remoteTxid == spliceTxid {
+		p.completeSplice(channel)
+	}
+}
+
+// handleSpliceLocked processes the splice_locked message of the remote peer.
+// If we locked in the splice as well, then the splice is complete.
+//
+// NOTE: This method should only be called from within the readHandler.
+func (p *Brontide) handleSpliceLocked(msg *lnwire.SpliceLocked) {
+	p.activeChanMtx.RLock()
+	channel := p.activeChannels[msg.ChanID]
+	p.activeChanMtx.RUnlock()
+
+	if channel == nil {
+		peerLog.Debugf("Ignoring splice_locked for unknown "+
+			"ChannelID(%v)", msg.ChanID)
+		return
+	}
+
+	p.spliceLockedMtx.Lock()
+	p.remoteSpliceLocked[msg.ChanID] = msg.SpliceTxID
+	sentTxid, sent := p.sentSpliceLocked[msg.ChanID]
+	p.spliceLockedMtx.Unlock()
+
+	splice := channel.PendingSplice()
+	switch {
+	// If we completed the splice during a previous connection, then the
+	// remote peer may not have received our splice_locked message, so
+	// we'll send it again.
+	case splice == nil:
+		activeOutpoint := channel.State().ActiveFundingOutpoint()
+		if !sent && activeOutpoint.Hash == msg.SpliceTxID {
+			p.sendSpliceLocked(channel, msg.SpliceTxID)
+		}
+
+	case splice.FundingOutpoint.Hash != msg.SpliceTxID:
+		peerLog.Warnf("Received splice_locked for unknown splice tx "+
+			"%v of ChannelID(%v)", msg.SpliceTxID, msg.ChanID)
+
+	case sent && sentTxid == msg.SpliceTxID:
+		p.completeSplice(channel)
+	}
+}
+
+// completeSplice completes the pending splice of the given channel once both
+// parties locked it in. From then on, the channel uses the funding output of
+// the splice.
+func (p *Brontide) completeSplice(channel *lnwallet.LightningChannel) {
+	chanPoint := channel.ChannelPoint()
+
+	// The splice may have been completed concurrently.
+	err := channel.CompleteSplice()
+	if errors.Is(err, channeldb.ErrNoSplice) {
+		return
+	}
+	if err != nil {
+		peerLog.Errorf("Unable to complete splice of "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return
+	}
+
+	peerLog.Infof("Splice of ChannelPoint(%v) locked in, using funding "+
+		"output %v", chanPoint, channel.State().ActiveFundingOutpoint())
+}
+
 // NetAddress returns the network of the remote peer as an lnwire.NetAddress.
 func (p *Brontide) NetAddress() *lnwire.NetAddress {
 	return p.cfg.Addr
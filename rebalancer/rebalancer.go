@@ -0,0 +1,506 @@
+package rebalancer
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/record"
+	"github.com/ltcsuite/lnd/routing"
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/ltcsuite/ltcd/ltcutil"
+)
+
+const (
+	// Label is the label that the payments made by the rebalancer are
+	// recorded with in the payments database.
+	Label = "rebalance"
+
+	// maxResults is the number of recent rebalance attempts we keep
+	// track of.
+	maxResults = 50
+
+	// invoiceExpiry is the expiry of the invoices we create to receive
+	// our own rebalance payments.
+	invoiceExpiry = 10 * time.Minute
+)
+
+var (
+	// ErrActive is returned when the rebalancer is started while it's
+	// already active.
+	ErrActive = errors.New("rebalancer already active")
+
+	// ErrNoTargets is returned when the rebalancer is started without
+	// any target balance ratios.
+	ErrNoTargets = errors.New("no channel targets set")
+)
+
+// Channel describes the balance of one of our channels.
+type Channel struct {
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Peer is the identity key of the channel peer.
+	Peer route.Vertex
+
+	// Capacity is the capacity of the channel.
+	Capacity ltcutil.Amount
+
+	// LocalBalance is our balance in the channel.
+	LocalBalance ltcutil.Amount
+}
+
+// Config contains the dependencies of the rebalancer.
+type Config struct {
+	// Self is our own node.
+	Self route.Vertex
+
+	// FetchChannels returns the balances of our active channels.
+	FetchChannels func() ([]Channel, error)
+
+	// FindRoute finds a route through the channel graph, like
+	// routing.ChannelRouter.FindRoute.
+	FindRoute func(source, target route.Vertex, amt lnwire.MilliSatoshi,
+		restrictions *routing.RestrictParams,
+		destCustomRecords record.CustomSet,
+		routeHints map[route.Vertex][]*channeldb.CachedEdgePolicy,
+		finalExpiry uint16,
+		blindedPayment *routing.BlindedPayment) (*route.Route, error)
+
+	// ProbabilitySource returns the success probability of traversing
+	// the channel from one node to another, as estimated by mission
+	// control.
+	ProbabilitySource func(route.Vertex, route.Vertex,
+		lnwire.MilliSatoshi) float64
+
+	// SendToRoute sends a payment along the given route, and records it
+	// with the given label.
+	SendToRoute func(lntypes.Hash, *route.Route,
+		string) (*channeldb.HTLCAttempt, error)
+
+	// AddInvoice adds the invoice we pay to ourselves.
+	AddInvoice func(*channeldb.Invoice, lntypes.Hash) (uint64, error)
+
+	// CancelInvoice cancels the invoice of a failed rebalance.
+	CancelInvoice func(lntypes.Hash) error
+
+	// InvoiceFeatures returns the features of our invoices.
+	InvoiceFeatures func() *lnwire.FeatureVector
+
+	// FinalCltvDelta is the final CLTV delta of our invoices.
+	FinalCltvDelta uint16
+
+	// CltvLimit is the maximum time lock of the routes we use.
+	CltvLimit uint32
+}
+
+// Params are the parameters that the rebalancer is started with.
+type Params struct {
+	// Targets maps the channels the rebalancer manages to the ratio of
+	// their capacity that our local balance should be at.
+	Targets map[lnwire.ShortChannelID]float64
+
+	// Tolerance is the deviation from the target ratio below which a
+	// channel isn't rebalanced.
+	Tolerance float64
+
+	// MaxFeePPM is the maximum fee we pay for a rebalance, in parts per
+	// million of the rebalanced amount.
+	MaxFeePPM uint64
+
+	// MaxAmount is the largest amount that is rebalanced at once.
+	MaxAmount ltcutil.Amount
+
+	// Interval is the time between two rebalance attempts.
+	Interval time.Duration
+}
+
+// validate checks the parameters for sanity.
+func (p *Params) validate() error {
+	if len(p.Targets) == 0 {
+		return ErrNoTargets
+	}
+
+	for chanID, ratio := range p.Targets {
+		if ratio < 0 || ratio > 1 {
+			return fmt.Errorf("target ratio %v of channel %v must "+
+				"be within [0, 1]", ratio, chanID)
+		}
+	}
+
+	switch {
+	case p.Tolerance < 0 || p.Tolerance >= 1:
+		return fmt.Errorf("tolerance must be within [0, 1)")
+
+	case p.MaxAmount <= 0:
+		return fmt.Errorf("max amount must be positive")
+
+	case p.Interval <= 0:
+		return fmt.Errorf("interval must be positive")
+	}
+
+	return nil
+}
+
+// Result is the outcome of a single rebalance attempt.
+type Result struct {
+	// Timestamp is the time the rebalance was attempted.
+	Timestamp time.Time
+
+	// OutgoingChanID is the channel we moved local balance out of.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// IncomingChanID is the channel we moved local balance into.
+	IncomingChanID lnwire.ShortChannelID
+
+	// Amount is the amount that was rebalanced.
+	Amount lnwire.MilliSatoshi
+
+	// Fee is the fee paid for the rebalance. It's zero if the rebalance
+	// failed.
+	Fee lnwire.MilliSatoshi
+
+	// PaymentHash is the hash of the rebalance payment. It's zero if the
+	// rebalance failed before an invoice was created.
+	PaymentHash lntypes.Hash
+
+	// Err is the reason the rebalance failed, if it did.
+	Err error
+}
+
+// Status describes the state of the rebalancer.
+type Status struct {
+	// Active is true if the rebalancer is running.
+	Active bool
+
+	// Params are the parameters the rebalancer was last started with.
+	Params *Params
+
+	// Results are the most recent rebalance attempts, the latest one
+	// first.
+	Results []Result
+
+	// TotalAmount is the total amount that was rebalanced since startup.
+	TotalAmount lnwire.MilliSatoshi
+
+	// TotalFees is the total fee paid for rebalancing since startup.
+	TotalFees lnwire.MilliSatoshi
+}
+
+// Rebalancer periodically moves local balance between our channels through
+// circular payments, so that the local balance of each channel it manages
+// approaches its target ratio. Each attempt moves balance out of the channel
+// that exceeds its target the most into the channel that falls short of it
+// the most.
+type Rebalancer struct {
+	cfg *Config
+
+	mu          sync.Mutex
+	params      *Params
+	quit        chan struct{}
+	results     []Result
+	totalAmount lnwire.MilliSatoshi
+	totalFees   lnwire.MilliSatoshi
+
+	wg sync.WaitGroup
+}
+
+// New creates a new, inactive rebalancer.
+func New(cfg *Config) *Rebalancer {
+	return &Rebalancer{
+		cfg: cfg,
+	}
+}
+
+// Start starts rebalancing our channels with the given parameters.
+func (r *Rebalancer) Start(params *Params) error {
+	if err := params.validate(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.quit != nil {
+		return ErrActive
+	}
+
+	log.Infof("Starting rebalancer for %d channels", len(params.Targets))
+
+	r.params = params
+	r.quit = make(chan struct{})
+
+	r.wg.Add(1)
+	go r.rebalanceLoop(params, r.quit)
+
+	return nil
+}
+
+// Stop stops the rebalancer if it's active, and waits for an ongoing
+// rebalance attempt to finish.
+func (r *Rebalancer) Stop() {
+	r.mu.Lock()
+	if r.quit == nil {
+		r.mu.Unlock()
+		return
+	}
+
+	log.Infof("Stopping rebalancer")
+
+	close(r.quit)
+	r.quit = nil
+	r.mu.Unlock()
+
+	r.wg.Wait()
+}
+
+// Status returns the current state of the rebalancer.
+func (r *Rebalancer) Status() *Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	results := make([]Result, len(r.results))
+	for i, result := range r.results {
+		results[len(r.results)-1-i] = result
+	}
+
+	return &Status{
+		Active:      r.quit != nil,
+		Params:      r.params,
+		Results:     results,
+		TotalAmount: r.totalAmount,
+		TotalFees:   r.totalFees,
+	}
+}
+
+// rebalanceLoop attempts a rebalance every interval until the rebalancer is
+// stopped.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *Rebalancer) rebalanceLoop(params *Params, quit chan struct{}) {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(params.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			result, ok := r.rebalanceOnce(params)
+			if !ok {
+				continue
+			}
+			r.recordResult(result)
+
+		case <-quit:
+			return
+		}
+	}
+}
+
+// recordResult adds the result of a rebalance attempt to our history.
+func (r *Rebalancer) recordResult(result *Result) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.results = append(r.results, *result)
+	if len(r.results) > maxResults {
+		r.results = r.results[len(r.results)-maxResults:]
+	}
+
+	if result.Err == nil {
+		r.totalAmount += result.Amount
+		r.totalFees += result.Fee
+	}
+}
+
+// rebalanceOnce selects the pair of channels that is furthest from its
+// targets, and moves balance between them. False is returned if no channel
+// needs to be rebalanced.
+func (r *Rebalancer) rebalanceOnce(params *Params) (*Result, bool) {
+	channels, err := r.cfg.FetchChannels()
+	if err != nil {
+		log.Errorf("Unable to fetch channels: %v", err)
+		return nil, false
+	}
+
+	source, sink, amt, ok := selectChannels(channels, params)
+	if !ok {
+		log.Debugf("No channels need to be rebalanced")
+		return nil, false
+	}
+
+	log.Debugf("Rebalancing %v from channel %v to channel %v", amt,
+		source.ChanID, sink.ChanID)
+
+	result := r.rebalance(
+		source, sink, lnwire.NewMSatFromSatoshis(amt),
+		params.MaxFeePPM,
+	)
+	if result.Err != nil {
+		log.Infof("Rebalance from channel %v to channel %v failed: %v",
+			source.ChanID, sink.ChanID, result.Err)
+	} else {
+		log.Infof("Rebalanced %v from channel %v to channel %v for a "+
+			"fee of %v", result.Amount, source.ChanID, sink.ChanID,
+			result.Fee)
+	}
+
+	return result, true
+}
+
+// selectChannels returns the channel whose local balance exceeds its target
+// the most, the channel whose local balance falls short of its target the
+// most, and the amount to move between them. False is returned if there's no
+// such pair of channels.
+func selectChannels(channels []Channel, params *Params) (*Channel, *Channel,
+	ltcutil.Amount, bool) {
+
+	var (
+		source, sink    *Channel
+		excess, deficit ltcutil.Amount
+	)
+	for i := range channels {
+		c := &channels[i]
+
+		ratio, ok := params.Targets[c.ChanID]
+		if !ok || c.Capacity == 0 {
+			continue
+		}
+
+		target := ltcutil.Amount(float64(c.Capacity) * ratio)
+		tolerance := ltcutil.Amount(
+			float64(c.Capacity) * params.Tolerance,
+		)
+
+		switch {
+		case c.LocalBalance > target+tolerance &&
+			c.LocalBalance-target > excess:
+
+			source = c
+			excess = c.LocalBalance - target
+
+		case c.LocalBalance < target-tolerance &&
+			target-c.LocalBalance > deficit:
+
+			sink = c
+			deficit = target - c.LocalBalance
+		}
+	}
+
+	if source == nil || sink == nil {
+		return nil, nil, 0, false
+	}
+
+	amt := excess
+	if deficit < amt {
+		amt = deficit
+	}
+	if params.MaxAmount < amt {
+		amt = params.MaxAmount
+	}
+
+	return source, sink, amt, true
+}
+
+// rebalance moves the given amount of local balance from the source to the
+// sink channel, by paying an invoice of our own along a route that leaves
+// through the source and returns through the sink channel.
+func (r *Rebalancer) rebalance(source, sink *Channel, amt lnwire.MilliSatoshi,
+	maxFeePPM uint64) *Result {
+
+	result := &Result{
+		Timestamp:      time.Now(),
+		OutgoingChanID: source.ChanID,
+		IncomingChanID: sink.ChanID,
+		Amount:         amt,
+	}
+
+	var (
+		preimage    lntypes.Preimage
+		paymentAddr [32]byte
+	)
+	if _, err := rand.Read(preimage[:]); err != nil {
+		result.Err = err
+		return result
+	}
+	if _, err := rand.Read(paymentAddr[:]); err != nil {
+		result.Err = err
+		return result
+	}
+	hash := preimage.Hash()
+
+	features := r.cfg.InvoiceFeatures()
+	invoice := &channeldb.Invoice{
+		CreationDate: time.Now(),
+		Memo:         []byte(Label),
+		Terms: channeldb.ContractTerm{
+			FinalCltvDelta:  int32(r.cfg.FinalCltvDelta),
+			Expiry:          invoiceExpiry,
+			Value:           amt,
+			PaymentPreimage: &preimage,
+			PaymentAddr:     paymentAddr,
+			Features:        features,
+		},
+	}
+	if _, err := r.cfg.AddInvoice(invoice, hash); err != nil {
+		result.Err = fmt.Errorf("unable to add invoice: %v", err)
+		return result
+	}
+	result.PaymentHash = hash
+
+	// If the rebalance fails, we cancel the invoice as it won't be paid
+	// anymore.
+	fail := func(err error) *Result {
+		if err := r.cfg.CancelInvoice(hash); err != nil {
+			log.Warnf("Unable to cancel rebalance invoice %v: %v",
+				hash, err)
+		}
+		result.Err = err
+
+		return result
+	}
+
+	// The route is pinned to the source channel on the way out and to the
+	// sink channel on the way back, as the peers may have parallel
+	// channels with us.
+	restrictions := &routing.RestrictParams{
+		ProbabilitySource:  r.cfg.ProbabilitySource,
+		FeeLimit:           amt * lnwire.MilliSatoshi(maxFeePPM) / 1e6,
+		OutgoingChannelIDs: []uint64{source.ChanID.ToUint64()},
+		IncomingChannelIDs: []uint64{sink.ChanID.ToUint64()},
+		CltvLimit:          r.cfg.CltvLimit,
+		DestFeatures:       features,
+		PaymentAddr:        &paymentAddr,
+	}
+	rt, err := r.cfg.FindRoute(
+		r.cfg.Self, r.cfg.Self, amt, restrictions, nil, nil,
+		r.cfg.FinalCltvDelta, nil,
+	)
+	if err != nil {
+		return fail(fmt.Errorf("unable to find route: %v", err))
+	}
+
+	attempt, err := r.cfg.SendToRoute(hash, rt, Label)
+	switch {
+	case err != nil:
+		return fail(fmt.Errorf("unable to send payment: %v", err))
+
+	case attempt.Failure != nil && attempt.Failure.Message != nil:
+		return fail(fmt.Errorf("payment failed: %v",
+			attempt.Failure.Message))
+
+	case attempt.Failure != nil:
+		return fail(fmt.Errorf("payment failed with reason %v",
+			attempt.Failure.Reason))
+	}
+
+	result.Fee = rt.TotalFees()
+
+	return result
+}
@@ -0,0 +1,336 @@
+package rebalancer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/record"
+	"github.com/ltcsuite/lnd/routing"
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	self  = route.Vertex{1}
+	peer1 = route.Vertex{2}
+	peer2 = route.Vertex{3}
+
+	chan1 = lnwire.NewShortChanIDFromInt(1)
+	chan2 = lnwire.NewShortChanIDFromInt(2)
+	chan3 = lnwire.NewShortChanIDFromInt(3)
+)
+
+// newChannel returns a channel with a capacity of 1M litoshis and the given
+// local balance.
+func newChannel(chanID lnwire.ShortChannelID,
+	localBalance ltcutil.Amount) Channel {
+
+	return Channel{
+		ChanID:       chanID,
+		Capacity:     1e6,
+		LocalBalance: localBalance,
+	}
+}
+
+// TestSelectChannels tests that the channels furthest from their targets are
+// selected for rebalancing.
+func TestSelectChannels(t *testing.T) {
+	t.Parallel()
+
+	params := &Params{
+		Targets: map[lnwire.ShortChannelID]float64{
+			chan1: 0.5,
+			chan2: 0.5,
+			chan3: 0.5,
+		},
+		Tolerance: 0.1,
+		MaxAmount: 300_000,
+	}
+
+	tests := []struct {
+		name     string
+		channels []Channel
+		source   lnwire.ShortChannelID
+		sink     lnwire.ShortChannelID
+		amt      int64
+		ok       bool
+	}{
+		{
+			name: "balanced",
+			channels: []Channel{
+				newChannel(chan1, 550e3),
+				newChannel(chan2, 450e3),
+			},
+		},
+		{
+			name: "no sink",
+			channels: []Channel{
+				newChannel(chan1, 900e3),
+				newChannel(chan2, 500e3),
+			},
+		},
+		{
+			name: "limited by deficit",
+			channels: []Channel{
+				newChannel(chan1, 900e3),
+				newChannel(chan2, 300e3),
+			},
+			source: chan1,
+			sink:   chan2,
+			amt:    200_000,
+			ok:     true,
+		},
+		{
+			name: "limited by max amount",
+			channels: []Channel{
+				newChannel(chan1, 1e6),
+				newChannel(chan2, 0),
+			},
+			source: chan1,
+			sink:   chan2,
+			amt:    300_000,
+			ok:     true,
+		},
+		{
+			name: "largest deviation",
+			channels: []Channel{
+				newChannel(chan1, 700e3),
+				newChannel(chan2, 0),
+				newChannel(chan3, 800e3),
+			},
+			source: chan3,
+			sink:   chan2,
+			amt:    300_000,
+			ok:     true,
+		},
+		{
+			name: "channel without target",
+			channels: []Channel{
+				newChannel(chan1, 1e6),
+				newChannel(lnwire.NewShortChanIDFromInt(4), 0),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			source, sink, amt, ok := selectChannels(
+				test.channels, params,
+			)
+			require.Equal(t, test.ok, ok)
+			if !ok {
+				return
+			}
+
+			require.Equal(t, test.source, source.ChanID)
+			require.Equal(t, test.sink, sink.ChanID)
+			require.EqualValues(t, test.amt, amt)
+		})
+	}
+}
+
+// mockNode mocks the dependencies of the rebalancer.
+type mockNode struct {
+	routeErr     error
+	sendFailure  *channeldb.HTLCFailInfo
+	invoices     map[lntypes.Hash]*channeldb.Invoice
+	canceled     map[lntypes.Hash]struct{}
+	restrictions *routing.RestrictParams
+	label        string
+}
+
+func newMockNode() *mockNode {
+	return &mockNode{
+		invoices: make(map[lntypes.Hash]*channeldb.Invoice),
+		canceled: make(map[lntypes.Hash]struct{}),
+	}
+}
+
+func (m *mockNode) config() *Config {
+	return &Config{
+		Self: self,
+		FetchChannels: func() ([]Channel, error) {
+			return []Channel{{
+				ChanID:       chan1,
+				Peer:         peer1,
+				Capacity:     1e6,
+				LocalBalance: 1e6,
+			}, {
+				ChanID:   chan2,
+				Peer:     peer2,
+				Capacity: 1e6,
+			}}, nil
+		},
+		FindRoute: func(source, target route.Vertex,
+			amt lnwire.MilliSatoshi,
+			restrictions *routing.RestrictParams,
+			_ record.CustomSet,
+			_ map[route.Vertex][]*channeldb.CachedEdgePolicy,
+			_ uint16, _ *routing.BlindedPayment) (*route.Route,
+			error) {
+
+			m.restrictions = restrictions
+			if m.routeErr != nil {
+				return nil, m.routeErr
+			}
+
+			return &route.Route{
+				TotalAmount:  amt + 1000,
+				SourcePubKey: self,
+				Hops: []*route.Hop{{
+					PubKeyBytes:  peer1,
+					ChannelID:    chan1.ToUint64(),
+					AmtToForward: amt + 1000,
+				}, {
+					PubKeyBytes:  peer2,
+					ChannelID:    3,
+					AmtToForward: amt,
+				}, {
+					PubKeyBytes:  self,
+					ChannelID:    chan2.ToUint64(),
+					AmtToForward: amt,
+				}},
+			}, nil
+		},
+		SendToRoute: func(hash lntypes.Hash, rt *route.Route,
+			label string) (*channeldb.HTLCAttempt, error) {
+
+			m.label = label
+
+			return &channeldb.HTLCAttempt{
+				Failure: m.sendFailure,
+			}, nil
+		},
+		AddInvoice: func(invoice *channeldb.Invoice,
+			hash lntypes.Hash) (uint64, error) {
+
+			m.invoices[hash] = invoice
+			return 0, nil
+		},
+		CancelInvoice: func(hash lntypes.Hash) error {
+			m.canceled[hash] = struct{}{}
+			return nil
+		},
+		InvoiceFeatures: lnwire.EmptyFeatureVector,
+		FinalCltvDelta:  40,
+		CltvLimit:       2016,
+	}
+}
+
+// TestRebalance tests that a rebalance pays an invoice of our own through
+// the selected channels, and that failed rebalances are recorded.
+func TestRebalance(t *testing.T) {
+	t.Parallel()
+
+	params := &Params{
+		Targets: map[lnwire.ShortChannelID]float64{
+			chan1: 0.5,
+			chan2: 0.5,
+		},
+		MaxFeePPM: 2000,
+		MaxAmount: 100_000,
+		Interval:  time.Hour,
+	}
+
+	node := newMockNode()
+	r := New(node.config())
+
+	result, ok := r.rebalanceOnce(params)
+	require.True(t, ok)
+	require.NoError(t, result.Err)
+	require.Equal(t, chan1, result.OutgoingChanID)
+	require.Equal(t, chan2, result.IncomingChanID)
+	require.Equal(t, lnwire.MilliSatoshi(100_000_000), result.Amount)
+	require.Equal(t, lnwire.MilliSatoshi(1000), result.Fee)
+	require.Equal(t, Label, node.label)
+
+	// The route must leave through the source channel, return through the
+	// sink channel and respect the fee budget.
+	require.Equal(
+		t, []uint64{chan1.ToUint64()},
+		node.restrictions.OutgoingChannelIDs,
+	)
+	require.Equal(
+		t, []uint64{chan2.ToUint64()},
+		node.restrictions.IncomingChannelIDs,
+	)
+	require.Equal(
+		t, lnwire.MilliSatoshi(200_000), node.restrictions.FeeLimit,
+	)
+
+	invoice := node.invoices[result.PaymentHash]
+	require.NotNil(t, invoice)
+	require.Equal(t, result.Amount, invoice.Terms.Value)
+	require.Equal(
+		t, result.PaymentHash, invoice.Terms.PaymentPreimage.Hash(),
+	)
+	require.Empty(t, node.canceled)
+
+	r.recordResult(result)
+
+	// Failed payments cancel the invoice.
+	node.sendFailure = &channeldb.HTLCFailInfo{
+		Message: &lnwire.FailTemporaryChannelFailure{},
+	}
+	result, ok = r.rebalanceOnce(params)
+	require.True(t, ok)
+	require.Error(t, result.Err)
+	require.Contains(t, node.canceled, result.PaymentHash)
+	r.recordResult(result)
+
+	// Only the successful rebalance counts towards the totals, and the
+	// latest result is listed first.
+	status := r.Status()
+	require.False(t, status.Active)
+	require.Len(t, status.Results, 2)
+	require.Error(t, status.Results[0].Err)
+	require.NoError(t, status.Results[1].Err)
+	require.Equal(t, lnwire.MilliSatoshi(100_000_000), status.TotalAmount)
+	require.Equal(t, lnwire.MilliSatoshi(1000), status.TotalFees)
+
+	// A route that can't be found also fails the rebalance.
+	node.routeErr = errors.New("no route")
+	result, ok = r.rebalanceOnce(params)
+	require.True(t, ok)
+	require.Error(t, result.Err)
+}
+
+// TestStartStop tests starting and stopping the rebalancer.
+func TestStartStop(t *testing.T) {
+	t.Parallel()
+
+	r := New(newMockNode().config())
+
+	// The rebalancer can't be started without targets.
+	require.ErrorIs(t, r.Start(&Params{}), ErrNoTargets)
+
+	params := &Params{
+		Targets: map[lnwire.ShortChannelID]float64{
+			chan1: 0.5,
+		},
+		MaxAmount: 100_000,
+		Interval:  time.Hour,
+	}
+	require.NoError(t, r.Start(params))
+	require.ErrorIs(t, r.Start(params), ErrActive)
+
+	status := r.Status()
+	require.True(t, status.Active)
+	require.Equal(t, params, status.Params)
+
+	r.Stop()
+	require.False(t, r.Status().Active)
+
+	// Stopping an inactive rebalancer is a no-op, and it can be started
+	// again.
+	r.Stop()
+	require.NoError(t, r.Start(params))
+	r.Stop()
+}
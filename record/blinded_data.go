@@ -0,0 +1,336 @@
+package record
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/tlv"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+)
+
+const (
+	// paddingType is the type used for padding that the recipient of a
+	// payment may add to blinded route data so that all hops in the route
+	// have data of the same length.
+	paddingType tlv.Type = 1
+
+	// shortChannelIDType is the type used for the channel that an HTLC
+	// should be forwarded over within a blinded route.
+	shortChannelIDType tlv.Type = 2
+
+	// nextNodeIDType is the type used for the node ID of the next hop in a
+	// blinded route.
+	nextNodeIDType tlv.Type = 4
+
+	// pathIDType is the type used for the identifier the recipient of a
+	// payment includes in its own blinded route data, allowing it to
+	// authenticate payments made over the route.
+	pathIDType tlv.Type = 6
+
+	// nextBlindingOverrideType is the type used for a blinding point that
+	// replaces the blinding point that would otherwise be derived for the
+	// next hop in a blinded route.
+	nextBlindingOverrideType tlv.Type = 8
+
+	// paymentRelayType is the type used for the fee and cltv parameters
+	// that a forwarding node in a blinded route should apply.
+	paymentRelayType tlv.Type = 10
+
+	// paymentConstraintsType is the type used for the constraints that an
+	// HTLC must satisfy to be processed by a node in a blinded route.
+	paymentConstraintsType tlv.Type = 12
+)
+
+// BlindedRouteData contains the information that the recipient of a payment
+// encrypts for each hop in a blinded route. It provides forwarding nodes with
+// the next hop and the relay parameters to use, and the recipient with the
+// means to identify payments made over the route.
+type BlindedRouteData struct {
+	// Padding is optional padding used to make the encrypted data of all
+	// hops in a route the same length.
+	Padding []byte
+
+	// ShortChannelID is the channel that the HTLC should be forwarded
+	// over. This is only set for forwarding nodes.
+	ShortChannelID *lnwire.ShortChannelID
+
+	// NextNodeID is the node ID of the next hop. It can be used by
+	// forwarding nodes in place of the ShortChannelID.
+	NextNodeID *btcec.PublicKey
+
+	// PathID is an identifier that the recipient includes in its own data
+	// to be able to authenticate payments made over the route.
+	PathID []byte
+
+	// NextBlindingOverride is a blinding point that should be sent to the
+	// next hop instead of the one derived from the current blinding point.
+	NextBlindingOverride *btcec.PublicKey
+
+	// RelayInfo holds the fee and cltv parameters that a forwarding node
+	// should apply when forwarding the HTLC.
+	RelayInfo *PaymentRelayInfo
+
+	// Constraints holds the constraints that an incoming HTLC must satisfy
+	// to be accepted by the hop.
+	Constraints *PaymentConstraints
+}
+
+// PaymentRelayInfo describes the fee and cltv parameters that a forwarding
+// node in a blinded route should apply to an HTLC. As forwarding nodes are
+// not told the amount and expiry of the outgoing HTLC, they derive them from
+// the incoming HTLC using these values.
+type PaymentRelayInfo struct {
+	// CltvExpiryDelta is the expiry delta that the forwarding node should
+	// subtract from the incoming HTLC's expiry.
+	CltvExpiryDelta uint16
+
+	// FeeRate is the proportional fee, in parts per million, that the
+	// forwarding node charges.
+	FeeRate uint32
+
+	// BaseFee is the base fee, in milli-satoshis, that the forwarding node
+	// charges.
+	BaseFee uint32
+}
+
+// PaymentConstraints describes the constraints that an HTLC must satisfy to be
+// processed by a node in a blinded route. They prevent the route from being
+// used for payments that the recipient didn't intend to receive over it.
+type PaymentConstraints struct {
+	// MaxCltvExpiry is the maximum expiry that an HTLC processed by the
+	// node may have.
+	MaxCltvExpiry uint32
+
+	// HtlcMinimumMsat is the minimum amount that an HTLC processed by the
+	// node must carry.
+	HtlcMinimumMsat lnwire.MilliSatoshi
+}
+
+// EncodeBlindedRouteData encodes the passed blinded route data as a TLV
+// stream, ready to be encrypted for the hop it is intended for.
+func EncodeBlindedRouteData(data *BlindedRouteData) ([]byte, error) {
+	var records []tlv.Record
+
+	if len(data.Padding) > 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			paddingType, &data.Padding,
+		))
+	}
+
+	if data.ShortChannelID != nil {
+		records = append(records, tlv.MakeStaticRecord(
+			shortChannelIDType, data.ShortChannelID, 8,
+			lnwire.EShortChannelID, lnwire.DShortChannelID,
+		))
+	}
+
+	if data.NextNodeID != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			nextNodeIDType, &data.NextNodeID,
+		))
+	}
+
+	if len(data.PathID) > 0 {
+		records = append(records, tlv.MakePrimitiveRecord(
+			pathIDType, &data.PathID,
+		))
+	}
+
+	if data.NextBlindingOverride != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			nextBlindingOverrideType, &data.NextBlindingOverride,
+		))
+	}
+
+	if data.RelayInfo != nil {
+		records = append(records, data.RelayInfo.record())
+	}
+
+	if data.Constraints != nil {
+		records = append(records, data.Constraints.record())
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeBlindedRouteData decodes decrypted blinded route data from the passed
+// reader. An error is returned if the data contains an unknown even type, as
+// we are then unable to follow the recipient's instructions.
+func DecodeBlindedRouteData(r io.Reader) (*BlindedRouteData, error) {
+	var (
+		data        BlindedRouteData
+		scid        lnwire.ShortChannelID
+		nextNodeID  *btcec.PublicKey
+		override    *btcec.PublicKey
+		relayInfo   PaymentRelayInfo
+		constraints PaymentConstraints
+	)
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(paddingType, &data.Padding),
+		tlv.MakeStaticRecord(
+			shortChannelIDType, &scid, 8, lnwire.EShortChannelID,
+			lnwire.DShortChannelID,
+		),
+		tlv.MakePrimitiveRecord(nextNodeIDType, &nextNodeID),
+		tlv.MakePrimitiveRecord(pathIDType, &data.PathID),
+		tlv.MakePrimitiveRecord(nextBlindingOverrideType, &override),
+		relayInfo.record(),
+		constraints.record(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for t, parseResult := range parsedTypes {
+		if parseResult != nil && t%2 == 0 {
+			return nil, fmt.Errorf("unknown even type %d in blinded "+
+				"route data", t)
+		}
+	}
+
+	if _, ok := parsedTypes[shortChannelIDType]; ok {
+		data.ShortChannelID = &scid
+	}
+
+	if _, ok := parsedTypes[nextNodeIDType]; ok {
+		data.NextNodeID = nextNodeID
+	}
+
+	if _, ok := parsedTypes[nextBlindingOverrideType]; ok {
+		data.NextBlindingOverride = override
+	}
+
+	if _, ok := parsedTypes[paymentRelayType]; ok {
+		data.RelayInfo = &relayInfo
+	}
+
+	if _, ok := parsedTypes[paymentConstraintsType]; ok {
+		data.Constraints = &constraints
+	}
+
+	return &data, nil
+}
+
+// record returns a tlv.Record that can be used to encode or decode the payment
+// relay info.
+func (p *PaymentRelayInfo) record() tlv.Record {
+	// The cltv delta and fee rate are fixed size, followed by the
+	// truncated base fee.
+	size := func() uint64 {
+		return 2 + 4 + tlv.SizeTUint32(p.BaseFee)
+	}
+
+	return tlv.MakeDynamicRecord(
+		paymentRelayType, p, size, encodePaymentRelay,
+		decodePaymentRelay,
+	)
+}
+
+// encodePaymentRelay writes the payment relay info to the provided io.Writer.
+func encodePaymentRelay(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*PaymentRelayInfo); ok {
+		if err := tlv.EUint16T(w, v.CltvExpiryDelta, buf); err != nil {
+			return err
+		}
+
+		if err := tlv.EUint32T(w, v.FeeRate, buf); err != nil {
+			return err
+		}
+
+		return tlv.ETUint32T(w, v.BaseFee, buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "PaymentRelayInfo")
+}
+
+// decodePaymentRelay reads the payment relay info from the provided
+// io.Reader.
+func decodePaymentRelay(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*PaymentRelayInfo); ok && 6 <= l && l <= 10 {
+		err := tlv.DUint16(r, &v.CltvExpiryDelta, buf, 2)
+		if err != nil {
+			return err
+		}
+
+		if err := tlv.DUint32(r, &v.FeeRate, buf, 4); err != nil {
+			return err
+		}
+
+		return tlv.DTUint32(r, &v.BaseFee, buf, l-6)
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "PaymentRelayInfo", l, 10)
+}
+
+// record returns a tlv.Record that can be used to encode or decode the payment
+// constraints.
+func (p *PaymentConstraints) record() tlv.Record {
+	// The max cltv expiry is fixed size, followed by the truncated htlc
+	// minimum.
+	size := func() uint64 {
+		return 4 + tlv.SizeTUint64(uint64(p.HtlcMinimumMsat))
+	}
+
+	return tlv.MakeDynamicRecord(
+		paymentConstraintsType, p, size, encodePaymentConstraints,
+		decodePaymentConstraints,
+	)
+}
+
+// encodePaymentConstraints writes the payment constraints to the provided
+// io.Writer.
+func encodePaymentConstraints(w io.Writer, val interface{},
+	buf *[8]byte) error {
+
+	if v, ok := val.(*PaymentConstraints); ok {
+		if err := tlv.EUint32T(w, v.MaxCltvExpiry, buf); err != nil {
+			return err
+		}
+
+		return tlv.ETUint64T(w, uint64(v.HtlcMinimumMsat), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "PaymentConstraints")
+}
+
+// decodePaymentConstraints reads the payment constraints from the provided
+// io.Reader.
+func decodePaymentConstraints(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*PaymentConstraints); ok && 4 <= l && l <= 12 {
+		if err := tlv.DUint32(r, &v.MaxCltvExpiry, buf, 4); err != nil {
+			return err
+		}
+
+		var htlcMin uint64
+		if err := tlv.DTUint64(r, &htlcMin, buf, l-4); err != nil {
+			return err
+		}
+		v.HtlcMinimumMsat = lnwire.MilliSatoshi(htlcMin)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "PaymentConstraints", l, 12)
+}
@@ -0,0 +1,111 @@
+package record_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/record"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+var pubkeyHex = "02eec7245d6b7d2ccb30380bfbe2a3648cd7a942653f5aa340edcea1f" +
+	"283686619"
+
+func pubKey(t *testing.T) *btcec.PublicKey {
+	t.Helper()
+
+	pkBytes, err := hex.DecodeString(pubkeyHex)
+	require.NoError(t, err)
+
+	pk, err := btcec.ParsePubKey(pkBytes)
+	require.NoError(t, err)
+
+	return pk
+}
+
+// TestBlindedRouteDataEncodeDecode tests encoding and decoding of the data
+// that a recipient encrypts for each hop in a blinded route.
+func TestBlindedRouteDataEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	scid := lnwire.NewShortChanIDFromInt(1234)
+
+	tests := []struct {
+		name string
+		data *record.BlindedRouteData
+	}{
+		{
+			name: "forwarding hop",
+			data: &record.BlindedRouteData{
+				ShortChannelID: &scid,
+				RelayInfo: &record.PaymentRelayInfo{
+					CltvExpiryDelta: 144,
+					FeeRate:         1000,
+					BaseFee:         1,
+				},
+				Constraints: &record.PaymentConstraints{
+					MaxCltvExpiry:   1000,
+					HtlcMinimumMsat: 1,
+				},
+			},
+		},
+		{
+			name: "forwarding hop with zero base fee",
+			data: &record.BlindedRouteData{
+				NextNodeID: pubKey(t),
+				RelayInfo: &record.PaymentRelayInfo{
+					CltvExpiryDelta: 40,
+				},
+				NextBlindingOverride: pubKey(t),
+			},
+		},
+		{
+			name: "final hop",
+			data: &record.BlindedRouteData{
+				Padding: []byte{0, 0, 0},
+				PathID:  []byte{1, 2, 3},
+				Constraints: &record.PaymentConstraints{
+					MaxCltvExpiry: 500,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			encoded, err := record.EncodeBlindedRouteData(test.data)
+			require.NoError(t, err)
+
+			decoded, err := record.DecodeBlindedRouteData(
+				bytes.NewReader(encoded),
+			)
+			require.NoError(t, err)
+			require.Equal(t, test.data, decoded)
+		})
+	}
+}
+
+// TestBlindedRouteDataUnknownEven tests that blinded route data containing an
+// unknown even type is rejected.
+func TestBlindedRouteDataUnknownEven(t *testing.T) {
+	t.Parallel()
+
+	// A type 16 record of length 1, which we don't know about.
+	_, err := record.DecodeBlindedRouteData(
+		bytes.NewReader([]byte{16, 1, 0}),
+	)
+	require.Error(t, err)
+
+	// A type 17 record is odd, so it can safely be ignored.
+	_, err = record.DecodeBlindedRouteData(
+		bytes.NewReader([]byte{17, 1, 0}),
+	)
+	require.NoError(t, err)
+}
@@ -0,0 +1,242 @@
+package blindedpath
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	sphinx "github.com/ltcsuite/lightning-onion"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// blindedNodeIDKey is the HMAC key used to derive the blinding factor
+	// that is applied to a hop's node ID in a blinded route.
+	blindedNodeIDKey = "blinded_node_id"
+
+	// rhoKey is the HMAC key used to derive the key that encrypts the data
+	// for a hop in a blinded route.
+	rhoKey = "rho"
+)
+
+var (
+	// ErrNoHops is returned when an attempt is made to build a blinded
+	// path without any hops.
+	ErrNoHops = errors.New("blinded path requires at least one hop")
+
+	// zeroNonce is the nonce used to encrypt the data for each hop. The
+	// encryption key is unique per hop and route, so a fixed nonce is
+	// safe to use.
+	zeroNonce [chacha20poly1305.NonceSize]byte
+)
+
+// HopInfo describes a node in a route that is to be blinded, along with the
+// plaintext data that should be encrypted for it.
+type HopInfo struct {
+	// NodePub is the real public key of the node.
+	NodePub *btcec.PublicKey
+
+	// PlainText is the data that the node should be able to decrypt once
+	// it receives an HTLC over the blinded route.
+	PlainText []byte
+}
+
+// BlindedHop is a single hop in a blinded path.
+type BlindedHop struct {
+	// BlindedNodePub is the blinded public key of the node. Senders
+	// encrypt the onion payload for the hop to this key.
+	BlindedNodePub *btcec.PublicKey
+
+	// CipherText is the encrypted data that the sender must include in
+	// the onion payload for the hop.
+	CipherText []byte
+}
+
+// BlindedPath is a route to a recipient in which the identities of all nodes
+// except for the introduction node are hidden from the sender.
+type BlindedPath struct {
+	// IntroductionPoint is the real public key of the first node in the
+	// path.
+	IntroductionPoint *btcec.PublicKey
+
+	// BlindingPoint is the ephemeral key that the introduction node uses
+	// to decrypt its data.
+	BlindingPoint *btcec.PublicKey
+
+	// BlindedHops holds the blinded node IDs and encrypted data of all
+	// hops in the path, starting with the introduction node.
+	BlindedHops []*BlindedHop
+}
+
+// BuildBlindedPath blinds the passed route using the provided session key. The
+// first hop in the route will act as the introduction point of the path.
+func BuildBlindedPath(sessionKey *btcec.PrivateKey,
+	hops []*HopInfo) (*BlindedPath, error) {
+
+	if len(hops) == 0 {
+		return nil, ErrNoHops
+	}
+
+	path := &BlindedPath{
+		IntroductionPoint: hops[0].NodePub,
+		BlindingPoint:     sessionKey.PubKey(),
+		BlindedHops:       make([]*BlindedHop, len(hops)),
+	}
+
+	ephemPriv := sessionKey
+	for i, hop := range hops {
+		ephemECDH := &sphinx.PrivKeyECDH{PrivKey: ephemPriv}
+		sharedSecret, err := ephemECDH.ECDH(hop.NodePub)
+		if err != nil {
+			return nil, err
+		}
+
+		cipherText, err := encryptHopData(sharedSecret, hop.PlainText)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encrypt data for hop "+
+				"%d: %w", i, err)
+		}
+
+		path.BlindedHops[i] = &BlindedHop{
+			BlindedNodePub: blindPubKey(
+				blindingFactor(sharedSecret), hop.NodePub,
+			),
+			CipherText: cipherText,
+		}
+
+		// Derive the ephemeral key for the next hop by applying the
+		// same blinding factor that the hop will apply to the
+		// ephemeral public key it receives.
+		factor := nextEphemeralFactor(ephemPriv.PubKey(), sharedSecret)
+		factor.Mul(&ephemPriv.Key)
+		ephemPriv = secp.NewPrivateKey(&factor)
+	}
+
+	return path, nil
+}
+
+// DecryptBlindedHopData decrypts the data that the recipient of a payment
+// encrypted for our node, using the blinding point that was provided to us.
+func DecryptBlindedHopData(nodeKey sphinx.SingleKeyECDH,
+	blindingPoint *btcec.PublicKey, cipherText []byte) ([]byte, error) {
+
+	sharedSecret, err := nodeKey.ECDH(blindingPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	rho := hmac256(rhoKey, sharedSecret[:])
+	aead, err := chacha20poly1305.New(rho[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Open(nil, zeroNonce[:], cipherText, nil)
+}
+
+// NextBlindingPoint derives the blinding point that should be passed on to
+// the next hop in a blinded route from the blinding point that we received.
+func NextBlindingPoint(nodeKey sphinx.SingleKeyECDH,
+	blindingPoint *btcec.PublicKey) (*btcec.PublicKey, error) {
+
+	sharedSecret, err := nodeKey.ECDH(blindingPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return NextEphemeral(blindingPoint, sharedSecret), nil
+}
+
+// NextEphemeral derives the ephemeral key that the next hop in a route
+// receives from the ephemeral key of the current hop and the secret it shares
+// with the current hop. The same derivation is used for both onion ephemeral
+// keys and blinding points.
+func NextEphemeral(ephemPub *btcec.PublicKey,
+	sharedSecret [32]byte) *btcec.PublicKey {
+
+	factor := nextEphemeralFactor(ephemPub, sharedSecret)
+
+	return blindPubKey(factor, ephemPub)
+}
+
+// TweakOnionKey applies the blinding factor of our blinded node ID to the
+// ephemeral key of an onion packet that was received over a blinded route.
+// Performing ECDH between our node key and the tweaked key produces the same
+// shared secret that the sender derived with our blinded node ID, which allows
+// the onion to be processed as though it was addressed to our real node ID.
+func TweakOnionKey(nodeKey sphinx.SingleKeyECDH, blindingPoint,
+	onionKey *btcec.PublicKey) (*btcec.PublicKey, error) {
+
+	sharedSecret, err := nodeKey.ECDH(blindingPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return blindPubKey(blindingFactor(sharedSecret), onionKey), nil
+}
+
+// encryptHopData encrypts the data for a hop in a blinded route using a key
+// derived from the secret shared with that hop.
+func encryptHopData(sharedSecret [32]byte, plainText []byte) ([]byte, error) {
+	rho := hmac256(rhoKey, sharedSecret[:])
+	aead, err := chacha20poly1305.New(rho[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nil, zeroNonce[:], plainText, nil), nil
+}
+
+// blindingFactor returns the factor that is applied to a node's public key to
+// produce its blinded node ID.
+func blindingFactor(sharedSecret [32]byte) btcec.ModNScalar {
+	var factor btcec.ModNScalar
+	factor.SetBytes(hmac256(blindedNodeIDKey, sharedSecret[:]))
+
+	return factor
+}
+
+// nextEphemeralFactor returns the factor that is applied to an ephemeral key
+// to derive the ephemeral key of the next hop: sha256(E || ss).
+func nextEphemeralFactor(ephemPub *btcec.PublicKey,
+	sharedSecret [32]byte) btcec.ModNScalar {
+
+	h := sha256.New()
+	h.Write(ephemPub.SerializeCompressed())
+	h.Write(sharedSecret[:])
+
+	var (
+		digest [32]byte
+		factor btcec.ModNScalar
+	)
+	copy(digest[:], h.Sum(nil))
+	factor.SetBytes(&digest)
+
+	return factor
+}
+
+// blindPubKey multiplies the passed public key by the given factor.
+func blindPubKey(factor btcec.ModNScalar,
+	pubKey *btcec.PublicKey) *btcec.PublicKey {
+
+	var point, result btcec.JacobianPoint
+	pubKey.AsJacobian(&point)
+	btcec.ScalarMultNonConst(&factor, &point, &result)
+	result.ToAffine()
+
+	return btcec.NewPublicKey(&result.X, &result.Y)
+}
+
+// hmac256 computes the HMAC-SHA256 of the message using the given key.
+func hmac256(key string, msg []byte) *[32]byte {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(msg)
+
+	var digest [32]byte
+	copy(digest[:], mac.Sum(nil))
+
+	return &digest
+}
@@ -0,0 +1,139 @@
+package blindedpath
+
+import (
+	"bytes"
+	"testing"
+
+	sphinx "github.com/ltcsuite/lightning-onion"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlindedPath tests that each node in a blinded path is able to decrypt
+// its own data, derive the blinding point for the next hop and process onions
+// that were encrypted to its blinded node ID.
+func TestBlindedPath(t *testing.T) {
+	t.Parallel()
+
+	const numHops = 3
+
+	var (
+		nodeKeys = make([]*sphinx.PrivKeyECDH, numHops)
+		hops     = make([]*HopInfo, numHops)
+	)
+	for i := 0; i < numHops; i++ {
+		priv, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		nodeKeys[i] = &sphinx.PrivKeyECDH{PrivKey: priv}
+		hops[i] = &HopInfo{
+			NodePub:   priv.PubKey(),
+			PlainText: []byte{byte(i), 1, 2, 3},
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	path, err := BuildBlindedPath(sessionKey, hops)
+	require.NoError(t, err)
+	require.Len(t, path.BlindedHops, numHops)
+	require.True(t, path.IntroductionPoint.IsEqual(hops[0].NodePub))
+
+	blindingPoint := path.BlindingPoint
+	for i, nodeKey := range nodeKeys {
+		blindedHop := path.BlindedHops[i]
+
+		plainText, err := DecryptBlindedHopData(
+			nodeKey, blindingPoint, blindedHop.CipherText,
+		)
+		require.NoError(t, err)
+		require.Equal(t, hops[i].PlainText, plainText)
+
+		// A sender encrypts the onion for this hop to its blinded node
+		// ID. Tweaking the onion's ephemeral key must allow the node
+		// to arrive at the same shared secret using its real key.
+		onionPriv, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		senderECDH := &sphinx.PrivKeyECDH{PrivKey: onionPriv}
+		senderSecret, err := senderECDH.ECDH(blindedHop.BlindedNodePub)
+		require.NoError(t, err)
+
+		tweaked, err := TweakOnionKey(
+			nodeKey, blindingPoint, onionPriv.PubKey(),
+		)
+		require.NoError(t, err)
+
+		nodeSecret, err := nodeKey.ECDH(tweaked)
+		require.NoError(t, err)
+		require.Equal(t, senderSecret, nodeSecret)
+
+		blindingPoint, err = NextBlindingPoint(nodeKey, blindingPoint)
+		require.NoError(t, err)
+	}
+}
+
+// TestDecryptBlindedHopDataWrongNode tests that a node that the data was not
+// encrypted for is unable to decrypt it.
+func TestDecryptBlindedHopDataWrongNode(t *testing.T) {
+	t.Parallel()
+
+	nodePriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	otherPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	path, err := BuildBlindedPath(sessionKey, []*HopInfo{{
+		NodePub:   nodePriv.PubKey(),
+		PlainText: []byte{1, 2, 3},
+	}})
+	require.NoError(t, err)
+
+	_, err = DecryptBlindedHopData(
+		&sphinx.PrivKeyECDH{PrivKey: otherPriv}, path.BlindingPoint,
+		path.BlindedHops[0].CipherText,
+	)
+	require.Error(t, err)
+
+	_, err = BuildBlindedPath(sessionKey, nil)
+	require.ErrorIs(t, err, ErrNoHops)
+}
+
+// TestBlindedPathEncoding tests that a blinded path can be serialized and
+// parsed again.
+func TestBlindedPathEncoding(t *testing.T) {
+	t.Parallel()
+
+	hops := make([]*HopInfo, 2)
+	for i := range hops {
+		priv, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		hops[i] = &HopInfo{
+			NodePub:   priv.PubKey(),
+			PlainText: []byte{byte(i)},
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	path, err := BuildBlindedPath(sessionKey, hops)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, path.Encode(&b))
+
+	decoded, err := DecodeBlindedPath(&b)
+	require.NoError(t, err)
+	require.Equal(t, path, decoded)
+
+	// A path without any hops can't be encoded.
+	path.BlindedHops = nil
+	require.ErrorIs(t, path.Encode(&b), ErrNoHops)
+}
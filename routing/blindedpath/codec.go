@@ -0,0 +1,134 @@
+package blindedpath
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ltcsuite/ltcd/btcec/v2"
+)
+
+const (
+	// MaxNumHops is the maximum number of hops that can be encoded in a
+	// single blinded path.
+	MaxNumHops = 255
+)
+
+// ErrTooManyHops is returned when an attempt is made to encode a blinded
+// path with more hops than its encoding allows.
+var ErrTooManyHops = errors.New("blinded path has too many hops")
+
+// Encode serializes the blinded path to the passed writer. The introduction
+// point and blinding point are written first, followed by a single byte hop
+// count and the blinded node ID and length prefixed encrypted data of each
+// hop.
+func (p *BlindedPath) Encode(w io.Writer) error {
+	switch {
+	case len(p.BlindedHops) == 0:
+		return ErrNoHops
+
+	case len(p.BlindedHops) > MaxNumHops:
+		return fmt.Errorf("%w: %d", ErrTooManyHops, len(p.BlindedHops))
+	}
+
+	_, err := w.Write(p.IntroductionPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(p.BlindingPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write([]byte{uint8(len(p.BlindedHops))})
+	if err != nil {
+		return err
+	}
+
+	for _, hop := range p.BlindedHops {
+		_, err := w.Write(hop.BlindedNodePub.SerializeCompressed())
+		if err != nil {
+			return err
+		}
+
+		var cipherTextLen [2]byte
+		binary.BigEndian.PutUint16(
+			cipherTextLen[:], uint16(len(hop.CipherText)),
+		)
+		if _, err := w.Write(cipherTextLen[:]); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(hop.CipherText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeBlindedPath parses a blinded path that was serialized using Encode
+// from the passed reader.
+func DecodeBlindedPath(r io.Reader) (*BlindedPath, error) {
+	var (
+		path = &BlindedPath{}
+		err  error
+	)
+
+	path.IntroductionPoint, err = readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	path.BlindingPoint, err = readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var numHops [1]byte
+	if _, err := io.ReadFull(r, numHops[:]); err != nil {
+		return nil, err
+	}
+	if numHops[0] == 0 {
+		return nil, ErrNoHops
+	}
+
+	path.BlindedHops = make([]*BlindedHop, numHops[0])
+	for i := range path.BlindedHops {
+		nodePub, err := readPubKey(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var cipherTextLen [2]byte
+		if _, err := io.ReadFull(r, cipherTextLen[:]); err != nil {
+			return nil, err
+		}
+
+		cipherText := make(
+			[]byte, binary.BigEndian.Uint16(cipherTextLen[:]),
+		)
+		if _, err := io.ReadFull(r, cipherText); err != nil {
+			return nil, err
+		}
+
+		path.BlindedHops[i] = &BlindedHop{
+			BlindedNodePub: nodePub,
+			CipherText:     cipherText,
+		}
+	}
+
+	return path, nil
+}
+
+// readPubKey reads a compressed public key from the passed reader.
+func readPubKey(r io.Reader) (*btcec.PublicKey, error) {
+	var pubKey [btcec.PubKeyBytesLenCompressed]byte
+	if _, err := io.ReadFull(r, pubKey[:]); err != nil {
+		return nil, err
+	}
+
+	return btcec.ParsePubKey(pubKey[:])
+}
@@ -0,0 +1,216 @@
+package routing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/ltcsuite/lnd/zpay32"
+)
+
+var (
+	// ErrNoBlindedPath is returned when a blinded payment does not
+	// contain a blinded path.
+	ErrNoBlindedPath = errors.New("blinded path required")
+
+	// ErrInsufficientBlindedHops is returned when a blinded path does not
+	// contain any hops.
+	ErrInsufficientBlindedHops = errors.New("blinded path requires at " +
+		"least one hop")
+
+	// ErrBlindedPathCltvDelta is returned when the expiry delta of a
+	// blinded path does not cover the final CLTV delta of the payment.
+	ErrBlindedPathCltvDelta = errors.New("blinded path cltv delta below " +
+		"final cltv delta")
+)
+
+// BlindedPayment describes a payment to a blinded path, along with the
+// aggregate relay parameters of the path that the sender pays for.
+type BlindedPayment struct {
+	// BlindedPath is the blinded route to the recipient.
+	BlindedPath *blindedpath.BlindedPath
+
+	// BaseFee is the total base fee charged by the hops in the path.
+	BaseFee uint32
+
+	// ProportionalFee is the total proportional fee, in millionths,
+	// charged by the hops in the path.
+	ProportionalFee uint32
+
+	// CltvExpiryDelta is the total expiry delta of the path, including
+	// the final CLTV delta of the recipient.
+	CltvExpiryDelta uint16
+
+	// HtlcMinimum is the minimum amount that the path can carry.
+	HtlcMinimum uint64
+
+	// HtlcMaximum is the maximum amount that the path can carry.
+	HtlcMaximum uint64
+}
+
+// NewBlindedPaymentFromInvoice creates a blinded payment from a blinded path
+// that was included in an invoice.
+func NewBlindedPaymentFromInvoice(
+	path *zpay32.BlindedPaymentPath) *BlindedPayment {
+
+	return &BlindedPayment{
+		BlindedPath:     path.BlindedPath,
+		BaseFee:         path.FeeBaseMsat,
+		ProportionalFee: path.FeeRate,
+		CltvExpiryDelta: path.CltvExpiryDelta,
+		HtlcMinimum:     path.HTLCMinMsat,
+		HtlcMaximum:     path.HTLCMaxMsat,
+	}
+}
+
+// Validate performs sanity checks on the blinded payment.
+func (b *BlindedPayment) Validate(finalCltvDelta uint16) error {
+	if b.BlindedPath == nil {
+		return ErrNoBlindedPath
+	}
+
+	if len(b.BlindedPath.BlindedHops) == 0 {
+		return ErrInsufficientBlindedHops
+	}
+
+	if b.CltvExpiryDelta < finalCltvDelta {
+		return fmt.Errorf("%w: %v < %v", ErrBlindedPathCltvDelta,
+			b.CltvExpiryDelta, finalCltvDelta)
+	}
+
+	return nil
+}
+
+// Target returns the vertex that path finding should search for when paying
+// to the blinded path. This is the blinded node ID of the final hop, unless
+// the recipient is the introduction node, in which case we send to its real
+// node ID.
+func (b *BlindedPayment) Target() route.Vertex {
+	hops := b.BlindedPath.BlindedHops
+	if len(hops) == 1 {
+		return route.NewVertex(b.BlindedPath.IntroductionPoint)
+	}
+
+	return route.NewVertex(hops[len(hops)-1].BlindedNodePub)
+}
+
+// toRouteHints converts the blinded path into a set of edges that path
+// finding can use to reach the final hop of the path from its introduction
+// node. The aggregate fees and expiry delta of the path are charged on the
+// edge leaving the introduction node, while all other edges are free. The
+// edges have a zero channel ID, as hops within a blinded route are told
+// which channel to forward over in their encrypted data.
+func (b *BlindedPayment) toRouteHints(finalCltvDelta uint16) (
+	map[route.Vertex][]*channeldb.CachedEdgePolicy, error) {
+
+	if err := b.Validate(finalCltvDelta); err != nil {
+		return nil, err
+	}
+
+	hints := make(map[route.Vertex][]*channeldb.CachedEdgePolicy)
+
+	hops := b.BlindedPath.BlindedHops
+	if len(hops) == 1 {
+		return hints, nil
+	}
+
+	// All hops in the path must accept TLV payloads to be able to receive
+	// their encrypted data.
+	features := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.TLVOnionPayloadOptional),
+		lnwire.Features,
+	)
+
+	maxHTLC := lnwire.MilliSatoshi(b.HtlcMaximum)
+	fromNode := route.NewVertex(b.BlindedPath.IntroductionPoint)
+	for i := 1; i < len(hops); i++ {
+		toNode := route.NewVertex(hops[i].BlindedNodePub)
+
+		edge := &channeldb.CachedEdgePolicy{
+			ToNodePubKey: func() route.Vertex {
+				return toNode
+			},
+			ToNodeFeatures: features,
+			MaxHTLC:        maxHTLC,
+		}
+
+		if i == 1 {
+			edge.FeeBaseMSat = lnwire.MilliSatoshi(b.BaseFee)
+			edge.FeeProportionalMillionths = lnwire.MilliSatoshi(
+				b.ProportionalFee,
+			)
+			edge.TimeLockDelta = b.CltvExpiryDelta - finalCltvDelta
+			edge.MinHTLC = lnwire.MilliSatoshi(b.HtlcMinimum)
+		}
+
+		hints[fromNode] = append(hints[fromNode], edge)
+		fromNode = toNode
+	}
+
+	return hints, nil
+}
+
+// addBlindedHops populates the blinded hops at the end of the passed route
+// with the encrypted data that the recipient provided for each of them. The
+// introduction node is given the blinding point of the path, and the final
+// hop is given the total amount of the payment in place of an MPP record.
+func (b *BlindedPayment) addBlindedHops(hops []*route.Hop,
+	totalAmt lnwire.MilliSatoshi) error {
+
+	blindedHops := b.BlindedPath.BlindedHops
+	if len(hops) < len(blindedHops) {
+		return fmt.Errorf("route of %d hops can't contain blinded "+
+			"path of %d hops", len(hops), len(blindedHops))
+	}
+
+	offset := len(hops) - len(blindedHops)
+	for i, blindedHop := range blindedHops {
+		hop := hops[offset+i]
+
+		// The introduction node is addressed using its real node ID,
+		// while all other hops are addressed by their blinded one.
+		expectedPub := route.NewVertex(blindedHop.BlindedNodePub)
+		if i == 0 {
+			expectedPub = route.NewVertex(
+				b.BlindedPath.IntroductionPoint,
+			)
+		}
+		if hop.PubKeyBytes != expectedPub {
+			return fmt.Errorf("route hop %d does not match blinded "+
+				"hop %d", offset+i, i)
+		}
+
+		hop.EncryptedData = blindedHop.CipherText
+		hop.LegacyPayload = false
+
+		if i == 0 {
+			hop.BlindingPoint = b.BlindedPath.BlindingPoint
+		}
+
+		if i == len(blindedHops)-1 {
+			hop.TotalAmtMsat = totalAmt
+			hop.MPP = nil
+		}
+	}
+
+	return nil
+}
+
+// mergeRouteHints returns a new set of edges that contains the edges of both
+// of the passed sets.
+func mergeRouteHints(a, b map[route.Vertex][]*channeldb.CachedEdgePolicy) (
+	merged map[route.Vertex][]*channeldb.CachedEdgePolicy) {
+
+	merged = make(map[route.Vertex][]*channeldb.CachedEdgePolicy)
+	for vertex, edges := range a {
+		merged[vertex] = append(merged[vertex], edges...)
+	}
+	for vertex, edges := range b {
+		merged[vertex] = append(merged[vertex], edges...)
+	}
+
+	return merged
+}
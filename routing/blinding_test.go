@@ -0,0 +1,129 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/record"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/lnd/routing/route"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func randPubKey(t *testing.T) *btcec.PublicKey {
+	t.Helper()
+
+	priv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return priv.PubKey()
+}
+
+// TestBlindedPaymentRouteHints tests the conversion of a blinded path into
+// edges that path finding can use, and the population of the blinded hops of
+// a route found over those edges.
+func TestBlindedPaymentRouteHints(t *testing.T) {
+	t.Parallel()
+
+	var (
+		introPub    = randPubKey(t)
+		blindedPub1 = randPubKey(t)
+		blindedPub2 = randPubKey(t)
+		blindingPub = randPubKey(t)
+	)
+
+	payment := &BlindedPayment{
+		BlindedPath: &blindedpath.BlindedPath{
+			IntroductionPoint: introPub,
+			BlindingPoint:     blindingPub,
+			BlindedHops: []*blindedpath.BlindedHop{
+				{
+					BlindedNodePub: randPubKey(t),
+					CipherText:     []byte{1},
+				},
+				{
+					BlindedNodePub: blindedPub1,
+					CipherText:     []byte{2},
+				},
+				{
+					BlindedNodePub: blindedPub2,
+					CipherText:     []byte{3},
+				},
+			},
+		},
+		BaseFee:         100,
+		ProportionalFee: 1000,
+		CltvExpiryDelta: 80,
+		HtlcMinimum:     1000,
+		HtlcMaximum:     100000,
+	}
+
+	// A final CLTV delta that exceeds the delta of the path is invalid.
+	_, err := payment.toRouteHints(81)
+	require.ErrorIs(t, err, ErrBlindedPathCltvDelta)
+
+	hints, err := payment.toRouteHints(40)
+	require.NoError(t, err)
+	require.Len(t, hints, 2)
+
+	var (
+		introVertex    = route.NewVertex(introPub)
+		blindedVertex1 = route.NewVertex(blindedPub1)
+		blindedVertex2 = route.NewVertex(blindedPub2)
+	)
+	require.Equal(t, blindedVertex2, payment.Target())
+
+	// The edge leaving the introduction node charges the aggregate
+	// parameters of the path.
+	require.Len(t, hints[introVertex], 1)
+	introEdge := hints[introVertex][0]
+	require.Equal(t, blindedVertex1, introEdge.ToNodePubKey())
+	require.Equal(t, lnwire.MilliSatoshi(100), introEdge.FeeBaseMSat)
+	require.Equal(
+		t, lnwire.MilliSatoshi(1000),
+		introEdge.FeeProportionalMillionths,
+	)
+	require.Equal(t, uint16(40), introEdge.TimeLockDelta)
+	require.Equal(t, lnwire.MilliSatoshi(1000), introEdge.MinHTLC)
+	require.Equal(t, lnwire.MilliSatoshi(100000), introEdge.MaxHTLC)
+
+	// All other edges are free.
+	require.Len(t, hints[blindedVertex1], 1)
+	blindedEdge := hints[blindedVertex1][0]
+	require.Equal(t, blindedVertex2, blindedEdge.ToNodePubKey())
+	require.Zero(t, blindedEdge.FeeBaseMSat)
+	require.Zero(t, blindedEdge.FeeProportionalMillionths)
+	require.Zero(t, blindedEdge.TimeLockDelta)
+
+	// Populate a route that reaches the recipient through the path.
+	hops := []*route.Hop{
+		{PubKeyBytes: route.Vertex{1}, LegacyPayload: true},
+		{PubKeyBytes: introVertex},
+		{PubKeyBytes: blindedVertex1},
+		{
+			PubKeyBytes: blindedVertex2,
+			MPP:         record.NewMPP(5000, [32]byte{1}),
+		},
+	}
+	err = payment.addBlindedHops(hops, 5000)
+	require.NoError(t, err)
+
+	require.True(t, hops[0].LegacyPayload)
+	require.Nil(t, hops[0].EncryptedData)
+
+	require.Equal(t, []byte{1}, hops[1].EncryptedData)
+	require.Equal(t, blindingPub, hops[1].BlindingPoint)
+
+	require.Equal(t, []byte{2}, hops[2].EncryptedData)
+	require.Nil(t, hops[2].BlindingPoint)
+
+	require.Equal(t, []byte{3}, hops[3].EncryptedData)
+	require.Equal(t, lnwire.MilliSatoshi(5000), hops[3].TotalAmtMsat)
+	require.Nil(t, hops[3].MPP)
+
+	// A route that doesn't end in the blinded path can't be populated.
+	hops[1].PubKeyBytes = route.Vertex{2}
+	err = payment.addBlindedHops(hops, 5000)
+	require.Error(t, err)
+}
@@ -59,6 +59,59 @@ type ControlTower interface {
 		error)
 }
 
+// PaymentDB is the persistent store of outgoing payments and their HTLC
+// attempts, which backs the control tower and the payment listing RPCs.
+type PaymentDB interface {
+	// InitPayment checks or records the given PaymentCreationInfo,
+	// making sure it does not already exist as an in-flight payment.
+	InitPayment(lntypes.Hash, *channeldb.PaymentCreationInfo) error
+
+	// RegisterAttempt atomically records the provided HTLCAttemptInfo.
+	RegisterAttempt(lntypes.Hash, *channeldb.HTLCAttemptInfo) (
+		*channeldb.MPPayment, error)
+
+	// SettleAttempt marks the given attempt settled with the preimage.
+	SettleAttempt(lntypes.Hash, uint64, *channeldb.HTLCSettleInfo) (
+		*channeldb.MPPayment, error)
+
+	// FailAttempt marks the given payment attempt failed.
+	FailAttempt(lntypes.Hash, uint64, *channeldb.HTLCFailInfo) (
+		*channeldb.MPPayment, error)
+
+	// Fail transitions a payment into the Failed state, and records the
+	// reason the payment failed.
+	Fail(lntypes.Hash, channeldb.FailureReason) (*channeldb.MPPayment,
+		error)
+
+	// FetchPayment returns the payment with the given payment hash.
+	FetchPayment(lntypes.Hash) (*channeldb.MPPayment, error)
+
+	// FetchInFlightPayments returns all payments with status InFlight.
+	FetchInFlightPayments() ([]*channeldb.MPPayment, error)
+
+	// FetchPayments returns all sent payments, ordered by their sequence
+	// number.
+	FetchPayments() ([]*channeldb.MPPayment, error)
+
+	// QueryPayments returns the subset of payments that is selected by
+	// the payments query.
+	QueryPayments(channeldb.PaymentsQuery) (channeldb.PaymentsResponse,
+		error)
+
+	// DeletePayment deletes the payment with the given payment hash. If
+	// failedHtlcsOnly is set, only its failed HTLC attempts are deleted.
+	DeletePayment(paymentHash lntypes.Hash, failedHtlcsOnly bool) error
+
+	// DeletePayments deletes all completed and failed payments, or only
+	// the failed ones if failedOnly is set. If failedHtlcsOnly is set, only
+	// the failed HTLC attempts of the payments are deleted.
+	DeletePayments(failedOnly, failedHtlcsOnly bool) error
+}
+
+// A compile time check to ensure that the kvdb payment store fully implements
+// the PaymentDB interface.
+var _ PaymentDB = (*channeldb.PaymentControl)(nil)
+
 // ControlTowerSubscriber contains the state for a payment update subscriber.
 type ControlTowerSubscriber struct {
 	// Updates is the channel over which *channeldb.MPPayment updates can be
@@ -95,7 +148,7 @@ func (s *ControlTowerSubscriber) Close() {
 // controlTower is persistent implementation of ControlTower to restrict
 // double payment sending.
 type controlTower struct {
-	db *channeldb.PaymentControl
+	db PaymentDB
 
 	subscribers    map[lntypes.Hash][]*ControlTowerSubscriber
 	subscribersMtx sync.Mutex
@@ -107,7 +160,7 @@ type controlTower struct {
 }
 
 // NewControlTower creates a new instance of the controlTower.
-func NewControlTower(db *channeldb.PaymentControl) ControlTower {
+func NewControlTower(db PaymentDB) ControlTower {
 	return &controlTower{
 		db:          db,
 		subscribers: make(map[lntypes.Hash][]*ControlTowerSubscriber),
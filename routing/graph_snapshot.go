@@ -0,0 +1,139 @@
+package routing
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/netann"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+)
+
+// SnapshotValidator verifies the records of a graph snapshot by recreating the
+// announcements they were received in, and checking their signatures the same
+// way the gossiper does.
+type SnapshotValidator struct {
+	chainHash chainhash.Hash
+}
+
+// A compile-time check to ensure SnapshotValidator implements the
+// channeldb.GraphSnapshotValidator interface.
+var _ channeldb.GraphSnapshotValidator = (*SnapshotValidator)(nil)
+
+// NewSnapshotValidator creates a validator for graph snapshots that only
+// accepts channels of the chain with the given genesis hash.
+func NewSnapshotValidator(chainHash chainhash.Hash) *SnapshotValidator {
+	return &SnapshotValidator{
+		chainHash: chainHash,
+	}
+}
+
+// ValidateChannel checks that the channel belongs to our chain, and that its
+// authentication proof is valid.
+//
+// NOTE: This is part of the channeldb.GraphSnapshotValidator interface.
+func (v *SnapshotValidator) ValidateChannel(
+	info *channeldb.ChannelEdgeInfo) error {
+
+	if info.ChainHash != v.chainHash {
+		return fmt.Errorf("channel belongs to chain %v", info.ChainHash)
+	}
+
+	if info.AuthProof == nil {
+		return fmt.Errorf("channel has no authentication proof")
+	}
+
+	chanAnn, _, _, err := netann.CreateChanAnnouncement(
+		info.AuthProof, info, nil, nil,
+	)
+	if err != nil {
+		return err
+	}
+
+	return ValidateChannelAnn(chanAnn)
+}
+
+// ValidatePolicy checks that the policy was signed by the node it originates
+// from.
+//
+// NOTE: This is part of the channeldb.GraphSnapshotValidator interface.
+func (v *SnapshotValidator) ValidatePolicy(info *channeldb.ChannelEdgeInfo,
+	policy *channeldb.ChannelEdgePolicy) error {
+
+	update, err := netann.ChannelUpdateFromEdge(info, policy)
+	if err != nil {
+		return err
+	}
+
+	// The direction bit tells us which of the two nodes of the channel
+	// signed the update.
+	var pubKey *btcec.PublicKey
+	if update.ChannelFlags&lnwire.ChanUpdateDirection == 0 {
+		pubKey, err = info.NodeKey1()
+	} else {
+		pubKey, err = info.NodeKey2()
+	}
+	if err != nil {
+		return err
+	}
+
+	return ValidateChannelUpdateAnn(pubKey, info.Capacity, update)
+}
+
+// ValidateNode checks the signature of the node announcement.
+//
+// NOTE: This is part of the channeldb.GraphSnapshotValidator interface.
+func (v *SnapshotValidator) ValidateNode(node *channeldb.LightningNode) error {
+	nodeAnn, err := node.NodeAnnouncement(true)
+	if err != nil {
+		return err
+	}
+
+	return ValidateNodeAnn(nodeAnn)
+}
+
+// ImportGraphSnapshot adds the channels, policies and nodes of a graph
+// snapshot that are new to us to the graph, after verifying their signatures.
+// The funding outputs of the added channels are watched for spends from then
+// on.
+func (r *ChannelRouter) ImportGraphSnapshot(snapshot io.Reader,
+	chainHash chainhash.Hash) (*channeldb.GraphSnapshotStats, error) {
+
+	stats, edgePoints, err := r.cfg.Graph.ImportSnapshot(
+		snapshot, NewSnapshotValidator(chainHash),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Imported graph snapshot with %d new channels, %d policies "+
+		"and %d nodes", stats.NumChannels, stats.NumPolicies,
+		stats.NumNodes)
+
+	if err := r.WatchChannels(edgePoints); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// WatchChannels adds the funding outputs of channels that were written to the
+// graph directly, rather than through the router, to the chain filter, so
+// that the channels are pruned from the graph once they're closed.
+func (r *ChannelRouter) WatchChannels(edgePoints []channeldb.EdgePoint) error {
+	if len(edgePoints) == 0 {
+		return nil
+	}
+
+	err := r.cfg.ChainView.UpdateFilter(
+		edgePoints, atomic.LoadUint32(&r.bestHeight),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update chain view: %v", err)
+	}
+
+	return nil
+}
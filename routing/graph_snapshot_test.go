@@ -0,0 +1,156 @@
+package routing
+
+import (
+	"bytes"
+	"image/color"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/btcec/v2/ecdsa"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// signSnapshotData signs the double hash of the given data with the key.
+func signSnapshotData(t *testing.T, key *btcec.PrivateKey, data []byte,
+	err error) []byte {
+
+	require.NoError(t, err)
+
+	return ecdsa.Sign(key, chainhash.DoubleHashB(data)).Serialize()
+}
+
+// TestSnapshotValidator asserts that the snapshot validator accepts records
+// with valid signatures, and rejects them once they've been tampered with.
+func TestSnapshotValidator(t *testing.T) {
+	t.Parallel()
+
+	chainHash := chainhash.Hash{1, 2, 3}
+
+	var keys [4]*btcec.PrivateKey
+	for i := range keys {
+		var err error
+		keys[i], err = btcec.NewPrivateKey()
+		require.NoError(t, err)
+	}
+	nodeKey1, nodeKey2, btcKey1, btcKey2 := keys[0], keys[1], keys[2],
+		keys[3]
+
+	// We'll first create a channel announcement signed by all four keys,
+	// and store it the same way the gossiper does.
+	chanAnn := &lnwire.ChannelAnnouncement{
+		ShortChannelID: lnwire.NewShortChanIDFromInt(1234),
+		ChainHash:      chainHash,
+		Features:       lnwire.NewRawFeatureVector(),
+	}
+	copy(chanAnn.NodeID1[:], nodeKey1.PubKey().SerializeCompressed())
+	copy(chanAnn.NodeID2[:], nodeKey2.PubKey().SerializeCompressed())
+	copy(chanAnn.BitcoinKey1[:], btcKey1.PubKey().SerializeCompressed())
+	copy(chanAnn.BitcoinKey2[:], btcKey2.PubKey().SerializeCompressed())
+
+	chanData, err := chanAnn.DataToSign()
+	info := &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanAnn.ShortChannelID.ToUint64(),
+		ChainHash:        chainHash,
+		NodeKey1Bytes:    chanAnn.NodeID1,
+		NodeKey2Bytes:    chanAnn.NodeID2,
+		BitcoinKey1Bytes: chanAnn.BitcoinKey1,
+		BitcoinKey2Bytes: chanAnn.BitcoinKey2,
+		AuthProof: &channeldb.ChannelAuthProof{
+			NodeSig1Bytes: signSnapshotData(
+				t, nodeKey1, chanData, err,
+			),
+			NodeSig2Bytes: signSnapshotData(
+				t, nodeKey2, chanData, err,
+			),
+			BitcoinSig1Bytes: signSnapshotData(
+				t, btcKey1, chanData, err,
+			),
+			BitcoinSig2Bytes: signSnapshotData(
+				t, btcKey2, chanData, err,
+			),
+		},
+		ChannelPoint: wire.OutPoint{Index: 1},
+		Capacity:     100000,
+	}
+
+	var features bytes.Buffer
+	require.NoError(t, chanAnn.Features.Encode(&features))
+	info.Features = features.Bytes()
+
+	// Next, we'll create the policy of the second node.
+	update := &lnwire.ChannelUpdate{
+		ChainHash:       chainHash,
+		ShortChannelID:  chanAnn.ShortChannelID,
+		Timestamp:       uint32(time.Now().Unix()),
+		ChannelFlags:    lnwire.ChanUpdateDirection,
+		TimeLockDelta:   40,
+		HtlcMinimumMsat: 1000,
+		BaseFee:         1000,
+		FeeRate:         1,
+	}
+	updateData, err := update.DataToSign()
+	policy := &channeldb.ChannelEdgePolicy{
+		SigBytes:      signSnapshotData(t, nodeKey2, updateData, err),
+		ChannelID:     info.ChannelID,
+		LastUpdate:    time.Unix(int64(update.Timestamp), 0),
+		ChannelFlags:  update.ChannelFlags,
+		TimeLockDelta: update.TimeLockDelta,
+		MinHTLC:       update.HtlcMinimumMsat,
+		FeeBaseMSat:   lnwire.MilliSatoshi(update.BaseFee),
+		FeeProportionalMillionths: lnwire.MilliSatoshi(
+			update.FeeRate,
+		),
+	}
+
+	// Finally, we'll create the announcement of the first node.
+	node := &channeldb.LightningNode{
+		HaveNodeAnnouncement: true,
+		LastUpdate:           time.Unix(time.Now().Unix(), 0),
+		Color:                color.RGBA{1, 2, 3, 0},
+		Alias:                "node1",
+		Features: lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(), lnwire.Features,
+		),
+		Addresses: []net.Addr{
+			&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9735},
+		},
+		PubKeyBytes: chanAnn.NodeID1,
+	}
+	nodeAnn, err := node.NodeAnnouncement(false)
+	require.NoError(t, err)
+	nodeData, err := nodeAnn.DataToSign()
+	node.AuthSigBytes = signSnapshotData(t, nodeKey1, nodeData, err)
+
+	validator := NewSnapshotValidator(chainHash)
+	require.NoError(t, validator.ValidateChannel(info))
+	require.NoError(t, validator.ValidatePolicy(info, policy))
+	require.NoError(t, validator.ValidateNode(node))
+
+	// A validator for another chain should reject the channel.
+	otherValidator := NewSnapshotValidator(chainhash.Hash{4, 5, 6})
+	require.Error(t, otherValidator.ValidateChannel(info))
+
+	// Changing any of the signed fields should invalidate the
+	// signatures.
+	info.BitcoinKey1Bytes, info.BitcoinKey2Bytes = info.BitcoinKey2Bytes,
+		info.BitcoinKey1Bytes
+	require.Error(t, validator.ValidateChannel(info))
+
+	policy.FeeBaseMSat++
+	require.Error(t, validator.ValidatePolicy(info, policy))
+	policy.FeeBaseMSat--
+
+	// The policy is only valid if it's signed by the node of its
+	// direction.
+	policy.ChannelFlags = 0
+	require.Error(t, validator.ValidatePolicy(info, policy))
+
+	node.Alias = "node2"
+	require.Error(t, validator.ValidateNode(node))
+}
@@ -89,6 +89,10 @@ type finalHopParams struct {
 	cltvDelta   uint16
 	records     record.CustomSet
 	paymentAddr *[32]byte
+
+	// blindedPayment is set if the final hops of the route are a blinded
+	// path that was provided by the recipient.
+	blindedPayment *BlindedPayment
 }
 
 // newRoute constructs a route using the provided path and final hop constraints.
@@ -193,10 +197,14 @@ func newRoute(sourceVertex route.Vertex,
 					"payment addr")
 			}
 
-			// Otherwise attach the mpp record if it exists.
+			// Otherwise attach the mpp record if it exists. The
+			// recipient of a blinded payment learns the payment
+			// address from its encrypted data instead.
 			// TODO(halseth): move this to payment life cycle,
 			// where AMP options are set.
-			if finalHop.paymentAddr != nil {
+			if finalHop.paymentAddr != nil &&
+				finalHop.blindedPayment == nil {
+
 				mpp = record.NewMPP(
 					finalHop.totalAmt,
 					*finalHop.paymentAddr,
@@ -242,6 +250,17 @@ func newRoute(sourceVertex route.Vertex,
 		nextIncomingAmount = amtToForward + fee
 	}
 
+	// If the route ends in a blinded path, attach the data that the
+	// recipient provided for each of the blinded hops.
+	if finalHop.blindedPayment != nil {
+		err := finalHop.blindedPayment.addBlindedHops(
+			hops, finalHop.totalAmt,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// With the base routing data expressed as hops, build the full route
 	newRoute, err := route.NewRouteFromHops(
 		nextIncomingAmount, totalTimeLock, route.Vertex(sourceVertex),
@@ -312,6 +331,10 @@ type RestrictParams struct {
 	// is reached. If nil, any node may be used.
 	LastHop *route.Vertex
 
+	// IncomingChannelIDs is the list of channels that are allowed for the
+	// final hop into the target. If nil, any channel may be used.
+	IncomingChannelIDs []uint64
+
 	// CltvLimit is the maximum time lock of the route excluding the final
 	// ctlv. After path finding is complete, the caller needs to increase
 	// all cltv expiry heights with the required final cltv delta.
@@ -483,6 +506,15 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		}
 	}
 
+	// Set up incoming channel map for quicker access.
+	var incomingChanMap map[uint64]struct{}
+	if len(r.IncomingChannelIDs) > 0 {
+		incomingChanMap = make(map[uint64]struct{})
+		for _, inChan := range r.IncomingChannelIDs {
+			incomingChanMap[inChan] = struct{}{}
+		}
+	}
+
 	// If we are routing from ourselves, check that we have enough local
 	// balance available.
 	self := g.graph.sourceNode()
@@ -806,8 +838,13 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 		pivot := partialPath.node
 
-		// Create unified policies for all incoming connections.
+		// Create unified policies for all incoming connections. The
+		// incoming channel restriction only applies to the connections
+		// into the target.
 		u := newUnifiedPolicies(self, pivot, outgoingChanMap)
+		if pivot == target {
+			u.inChanRestr = incomingChanMap
+		}
 
 		err := u.addGraphPolicies(g.graph)
 		if err != nil {
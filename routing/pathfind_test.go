@@ -827,6 +827,9 @@ func TestPathFinding(t *testing.T) {
 	}, {
 		name: "restrict last hop",
 		fn:   runRestrictLastHop,
+	}, {
+		name: "restrict incoming channel",
+		fn:   runRestrictIncomingChannel,
 	}, {
 		name: "CLTV limit",
 		fn:   runCltvLimit,
@@ -2299,7 +2302,7 @@ func TestPathFindSpecExample(t *testing.T) {
 	const amt lnwire.MilliSatoshi = 4999999
 	route, err := ctx.router.FindRoute(
 		bobNode.PubKeyBytes, carol, amt, noRestrictions, nil, nil,
-		MinCLTVDelta,
+		MinCLTVDelta, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to find route: %v", err)
@@ -2355,7 +2358,7 @@ func TestPathFindSpecExample(t *testing.T) {
 	// We'll now request a route from A -> B -> C.
 	route, err = ctx.router.FindRoute(
 		source.PubKeyBytes, carol, amt, noRestrictions, nil, nil,
-		MinCLTVDelta,
+		MinCLTVDelta, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to find routes: %v", err)
@@ -2586,6 +2589,59 @@ func runRestrictLastHop(t *testing.T, useCache bool) {
 	}
 }
 
+// runRestrictIncomingChannel asserts that an incoming channel restriction is
+// obeyed by the path finding algorithm, also when the last hop has parallel
+// channels with the target.
+func runRestrictIncomingChannel(t *testing.T, useCache bool) {
+	// Set up a test graph with three possible paths from source to target.
+	// The path via channel 1 and 2 is the lowest cost path, and b has two
+	// parallel channels with target of which channel 5 is the most
+	// expensive one.
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "a", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+		}, 2),
+		symmetricTestChannel("source", "b", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+		}, 4),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 1600,
+		}, 5),
+	}
+
+	ctx := newPathFindingTestContext(t, useCache, testChannels, "source")
+	defer ctx.cleanup()
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := ctx.keyFromAlias("target")
+
+	// Find the best path given the restriction to use channel 5 into the
+	// target. This should force pathfinding to not take the lowest cost
+	// option, nor the cheaper parallel channel.
+	ctx.restrictParams.IncomingChannelIDs = []uint64{5}
+	path, err := ctx.findPath(target, paymentAmt)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if len(path) != 2 {
+		t.Fatalf("expected 2 hops, got %v", len(path))
+	}
+	if path[0].ChannelID != 3 || path[1].ChannelID != 5 {
+		t.Fatalf("expected route to pass through channels 3 and 5, "+
+			"but channels %v and %v were selected instead",
+			path[0].ChannelID, path[1].ChannelID)
+	}
+}
+
 // runCltvLimit asserts that a cltv limit is obeyed by the path finding
 // algorithm.
 func runCltvLimit(t *testing.T, useCache bool) {
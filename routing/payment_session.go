@@ -202,6 +202,19 @@ func newPaymentSession(p *LightningPayment,
 		return nil, err
 	}
 
+	// If we're paying to a blinded path, add the edges that lead from its
+	// introduction node to the recipient.
+	if p.BlindedPayment != nil {
+		blindedEdges, err := p.BlindedPayment.toRouteHints(
+			p.FinalCLTVDelta,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		edges = mergeRouteHints(edges, blindedEdges)
+	}
+
 	logPrefix := fmt.Sprintf("PaymentSession(%x):", p.Identifier())
 
 	return &paymentSession{
@@ -388,6 +401,8 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 				cltvDelta:   finalCltvDelta,
 				records:     p.payment.DestCustomRecords,
 				paymentAddr: p.payment.PaymentAddr,
+
+				blindedPayment: p.payment.BlindedPayment,
 			},
 		)
 		if err != nil {
@@ -327,6 +327,14 @@ func (i *interpretedResult) processPaymentOutcomeIntermediate(
 	case *lnwire.InvalidOnionPayload:
 		reportNode()
 
+	// An invalid blinding failure is reported by the introduction node of
+	// a blinded route, and masks any failure that occurred within the
+	// blinded portion of the route. As we can't tell which of the blinded
+	// hops is at fault, we'll penalize the pair leading into the reporting
+	// node's outgoing channel.
+	case *lnwire.FailInvalidBlinding:
+		reportOutgoing()
+
 	// If the next hop in the route wasn't known or offline, we'll only
 	// penalize the channel set which we attempted to route over. This is
 	// conservative, and it can handle faulty channels between nodes
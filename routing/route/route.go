@@ -127,6 +127,21 @@ type Hop struct {
 	// understand the new TLV payload, so we must instead use the legacy
 	// payload.
 	LegacyPayload bool
+
+	// EncryptedData is the data that the recipient encrypted for this hop
+	// if it is part of a blinded route. Blinded hops receive their
+	// forwarding instructions in this data, so the clear text amount,
+	// expiry and next channel are omitted for all but the final hop.
+	EncryptedData []byte
+
+	// BlindingPoint is the ephemeral key that the introduction node of a
+	// blinded route uses to decrypt its data. This field should only be
+	// set for the introduction node.
+	BlindingPoint *btcec.PublicKey
+
+	// TotalAmtMsat is the total amount of a payment to a blinded route.
+	// This field should only be set for the final hop of a blinded route.
+	TotalAmtMsat lnwire.MilliSatoshi
 }
 
 // Copy returns a deep copy of the Hop.
@@ -143,6 +158,11 @@ func (h *Hop) Copy() *Hop {
 		c.AMP = &a
 	}
 
+	if h.BlindingPoint != nil {
+		b := *h.BlindingPoint
+		c.BlindingPoint = &b
+	}
+
 	return &c
 }
 
@@ -165,23 +185,47 @@ func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64) error {
 	// required routing fields, as well as these optional values.
 	var records []tlv.Record
 
-	// Every hop must have an amount to forward and CLTV expiry.
+	// Every hop must have an amount to forward and CLTV expiry, except
+	// for intermediate hops in a blinded route, which derive them from
+	// their encrypted data.
 	amt := uint64(h.AmtToForward)
-	records = append(records,
-		record.NewAmtToFwdRecord(&amt),
-		record.NewLockTimeRecord(&h.OutgoingTimeLock),
-	)
+	if !h.isBlindedIntermediate() {
+		records = append(records,
+			record.NewAmtToFwdRecord(&amt),
+			record.NewLockTimeRecord(&h.OutgoingTimeLock),
+		)
+	}
 
 	// BOLT 04 says the next_hop_id should be omitted for the final hop,
-	// but present for all others.
+	// but present for all others. Hops in a blinded route are told which
+	// channel to use in their encrypted data.
 	//
 	// TODO(conner): test using hop.Exit once available
-	if nextChanID != 0 {
+	if nextChanID != 0 && len(h.EncryptedData) == 0 {
 		records = append(records,
 			record.NewNextHopIDRecord(&nextChanID),
 		)
 	}
 
+	if len(h.EncryptedData) > 0 {
+		records = append(records,
+			record.NewEncryptedDataRecord(&h.EncryptedData),
+		)
+	}
+
+	if h.BlindingPoint != nil {
+		records = append(records,
+			record.NewBlindingPointRecord(&h.BlindingPoint),
+		)
+	}
+
+	totalAmt := uint64(h.TotalAmtMsat)
+	if h.TotalAmtMsat != 0 {
+		records = append(records,
+			record.NewTotalAmtMsatBlindedRecord(&totalAmt),
+		)
+	}
+
 	// If an MPP record is destined for this hop, ensure that we only ever
 	// attach it to the final hop. Otherwise the route was constructed
 	// incorrectly.
@@ -221,6 +265,12 @@ func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64) error {
 	return tlvStream.Encode(w)
 }
 
+// isBlindedIntermediate returns true if the hop is part of a blinded route,
+// but is not its final hop.
+func (h *Hop) isBlindedIntermediate() bool {
+	return len(h.EncryptedData) > 0 && h.TotalAmtMsat == 0
+}
+
 // Size returns the total size this hop's payload would take up in the onion
 // packet.
 func (h *Hop) PayloadSize(nextChanID uint64) uint64 {
@@ -235,20 +285,46 @@ func (h *Hop) PayloadSize(nextChanID uint64) uint64 {
 			tlv.VarIntSize(length) + length
 	}
 
-	// Add amount size.
-	addRecord(record.AmtOnionType, tlv.SizeTUint64(uint64(h.AmtToForward)))
-
-	// Add lock time size.
-	addRecord(
-		record.LockTimeOnionType,
-		tlv.SizeTUint64(uint64(h.OutgoingTimeLock)),
-	)
+	// Add amount and lock time size, unless this is an intermediate hop
+	// in a blinded route.
+	if !h.isBlindedIntermediate() {
+		addRecord(
+			record.AmtOnionType,
+			tlv.SizeTUint64(uint64(h.AmtToForward)),
+		)
+		addRecord(
+			record.LockTimeOnionType,
+			tlv.SizeTUint64(uint64(h.OutgoingTimeLock)),
+		)
+	}
 
 	// Add next hop if present.
-	if nextChanID != 0 {
+	if nextChanID != 0 && len(h.EncryptedData) == 0 {
 		addRecord(record.NextHopOnionType, 8)
 	}
 
+	// Add the blinded route fields if present.
+	if len(h.EncryptedData) > 0 {
+		addRecord(
+			record.EncryptedDataOnionType,
+			uint64(len(h.EncryptedData)),
+		)
+	}
+
+	if h.BlindingPoint != nil {
+		addRecord(
+			record.BlindingPointOnionType,
+			btcec.PubKeyBytesLenCompressed,
+		)
+	}
+
+	if h.TotalAmtMsat != 0 {
+		addRecord(
+			record.TotalAmtMsatBlindedType,
+			tlv.SizeTUint64(uint64(h.TotalAmtMsat)),
+		)
+	}
+
 	// Add mpp if present.
 	if h.MPP != nil {
 		addRecord(record.MPPOnionType, h.MPP.PayloadSize())
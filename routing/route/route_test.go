@@ -213,3 +213,60 @@ func TestPayloadSize(t *testing.T) {
 		}
 	}
 }
+
+// TestBlindedPayloadSize tests the payload size calculation for hops within a
+// blinded route, which omit the clear text forwarding fields.
+func TestBlindedPayloadSize(t *testing.T) {
+	blindingPoint, err := btcec.ParsePubKey(testPubKeyBytes[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hops := []*Hop{
+		{
+			PubKeyBytes:      testPubKeyBytes,
+			AmtToForward:     1200,
+			OutgoingTimeLock: 700000,
+			ChannelID:        63584534844,
+		},
+		{
+			PubKeyBytes:      testPubKeyBytes,
+			AmtToForward:     1100,
+			OutgoingTimeLock: 600000,
+			ChannelID:        3432483437438,
+			EncryptedData:    []byte{1, 2, 3, 4, 5},
+			BlindingPoint:    blindingPoint,
+		},
+		{
+			PubKeyBytes:      testPubKeyBytes,
+			AmtToForward:     1000,
+			OutgoingTimeLock: 500000,
+			EncryptedData:    []byte{6, 7, 8},
+			TotalAmtMsat:     2000,
+		},
+	}
+
+	rt := Route{
+		Hops: hops,
+	}
+	path, err := rt.ToSphinxPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, onionHop := range path[:path.TrueRouteLength()] {
+		hop := hops[i]
+		var nextChan uint64
+		if i < len(hops)-1 {
+			nextChan = hops[i+1].ChannelID
+		}
+
+		expected := uint64(onionHop.HopPayload.NumBytes())
+		actual := hop.PayloadSize(nextChan)
+		if expected != actual {
+			t.Fatalf("unexpected payload size at hop %v: "+
+				"expected %v, got %v",
+				i, expected, actual)
+		}
+	}
+}
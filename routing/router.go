@@ -13,6 +13,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	sphinx "github.com/ltcsuite/lightning-onion"
+	"github.com/ltcsuite/lnd/aliasmgr"
 	"github.com/ltcsuite/lnd/amp"
 	"github.com/ltcsuite/lnd/batch"
 	"github.com/ltcsuite/lnd/chainntnfs"
@@ -1469,10 +1470,56 @@ func (r *ChannelRouter) processUpdate(msg interface{},
 			break
 		}
 
+		// Edges of our own zero-conf and option-scid-alias channels use
+		// an alias as their channel ID, which doesn't point to a
+		// funding output on chain. As these are never announced, we
+		// add them straight away and only watch the funding outpoint
+		// for the channel's closure.
+		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
+		if aliasmgr.IsAlias(channelID) && msg.AuthProof == nil {
+			if err := r.cfg.Graph.AddChannelEdge(msg, op...); err != nil {
+				return fmt.Errorf("unable to add edge: %v", err)
+			}
+			log.Tracef("New alias channel discovered! Link "+
+				"connects %x and %x with ChannelPoint(%v): "+
+				"chan_id=%v", msg.NodeKey1Bytes,
+				msg.NodeKey2Bytes, msg.ChannelPoint,
+				msg.ChannelID)
+			r.stats.incNumEdgesDiscovered()
+
+			witnessScript, err := input.GenMultiSigScript(
+				msg.BitcoinKey1Bytes[:], msg.BitcoinKey2Bytes[:],
+			)
+			if err != nil {
+				return err
+			}
+			fundingPkScript, err := input.WitnessScriptHash(
+				witnessScript,
+			)
+			if err != nil {
+				return err
+			}
+
+			filterUpdate := []channeldb.EdgePoint{
+				{
+					FundingPkScript: fundingPkScript,
+					OutPoint:        msg.ChannelPoint,
+				},
+			}
+			err = r.cfg.ChainView.UpdateFilter(
+				filterUpdate, atomic.LoadUint32(&r.bestHeight),
+			)
+			if err != nil {
+				return errors.Errorf("unable to update chain "+
+					"view: %v", err)
+			}
+
+			break
+		}
+
 		// Before we can add the channel to the channel graph, we need
 		// to obtain the full funding outpoint that's encoded within
 		// the channel ID.
-		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
 		fundingTx, err := r.fetchFundingTx(&channelID)
 		if err != nil {
 			// In order to ensure we don't erroneously mark a
@@ -1730,12 +1777,25 @@ type routingMsg struct {
 
 // FindRoute attempts to query the ChannelRouter for the optimum path to a
 // particular target destination to which it is able to send `amt` after
-// factoring in channel capacities and cumulative fees along the route.
+// factoring in channel capacities and cumulative fees along the route. If a
+// blinded payment is provided, the route will end in its blinded path and the
+// target is replaced by the final hop of the path.
 func (r *ChannelRouter) FindRoute(source, target route.Vertex,
 	amt lnwire.MilliSatoshi, restrictions *RestrictParams,
 	destCustomRecords record.CustomSet,
 	routeHints map[route.Vertex][]*channeldb.CachedEdgePolicy,
-	finalExpiry uint16) (*route.Route, error) {
+	finalExpiry uint16, blindedPayment *BlindedPayment) (*route.Route,
+	error) {
+
+	if blindedPayment != nil {
+		blindedEdges, err := blindedPayment.toRouteHints(finalExpiry)
+		if err != nil {
+			return nil, err
+		}
+
+		target = blindedPayment.Target()
+		routeHints = mergeRouteHints(routeHints, blindedEdges)
+	}
 
 	log.Debugf("Searching for path to %v, sending %v", target, amt)
 
@@ -1777,10 +1837,12 @@ func (r *ChannelRouter) FindRoute(source, target route.Vertex,
 	route, err := newRoute(
 		source, path, uint32(currentHeight),
 		finalHopParams{
-			amt:       amt,
-			totalAmt:  amt,
-			cltvDelta: finalExpiry,
-			records:   destCustomRecords,
+			amt:            amt,
+			totalAmt:       amt,
+			cltvDelta:      finalExpiry,
+			records:        destCustomRecords,
+			paymentAddr:    restrictions.PaymentAddr,
+			blindedPayment: blindedPayment,
 		},
 	)
 	if err != nil {
@@ -1960,6 +2022,11 @@ type LightningPayment struct {
 	//
 	// NOTE: This field is _optional_.
 	MaxShardAmt *lnwire.MilliSatoshi
+
+	// BlindedPayment is an optional blinded path to the recipient. If it
+	// is set, the Target must be the target of the blinded payment and
+	// the payment is made to the final hop of the blinded path.
+	BlindedPayment *BlindedPayment
 }
 
 // AMPOptions houses information that must be known in order to send an AMP
@@ -2139,6 +2206,14 @@ func (r *ChannelRouter) preparePayment(payment *LightningPayment) (
 func (r *ChannelRouter) SendToRoute(htlcHash lntypes.Hash, rt *route.Route) (
 	*channeldb.HTLCAttempt, error) {
 
+	return r.SendToRouteWithLabel(htlcHash, rt, "")
+}
+
+// SendToRouteWithLabel behaves like SendToRoute, but records the payment with
+// the given label in the database.
+func (r *ChannelRouter) SendToRouteWithLabel(htlcHash lntypes.Hash,
+	rt *route.Route, label string) (*channeldb.HTLCAttempt, error) {
+
 	// Calculate amount paid to receiver.
 	amt := rt.ReceiverAmt()
 
@@ -2168,6 +2243,7 @@ func (r *ChannelRouter) SendToRoute(htlcHash lntypes.Hash, rt *route.Route) (
 		Value:             amt,
 		CreationTime:      r.cfg.Clock.Now(),
 		PaymentRequest:    nil,
+		Label:             label,
 	}
 
 	err := r.cfg.Control.InitPayment(paymentIdentifier, info)
@@ -270,7 +270,7 @@ func TestFindRoutesWithFeeLimit(t *testing.T) {
 	route, err := ctx.router.FindRoute(
 		ctx.router.selfNode.PubKeyBytes,
 		target, paymentAmt, restrictions, nil, nil,
-		MinCLTVDelta,
+		MinCLTVDelta, nil,
 	)
 	require.NoError(t, err, "unable to find any routes")
 
@@ -289,6 +289,39 @@ func TestFindRoutesWithFeeLimit(t *testing.T) {
 	)
 }
 
+// TestFindRoutePaymentAddr asserts that the payment address passed in the
+// restrictions is attached to the final hop of the route as an MPP record.
+func TestFindRoutePaymentAddr(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp := createTestCtxFromFile(
+		t, startingBlockHeight, basicGraphFilePath,
+	)
+	defer cleanUp()
+
+	paymentAddr := [32]byte{1, 2, 3}
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	restrictions := &RestrictParams{
+		FeeLimit:          noFeeLimit,
+		ProbabilitySource: noProbabilitySource,
+		CltvLimit:         math.MaxUint32,
+		DestFeatures:      tlvPayAddrFeatures,
+		PaymentAddr:       &paymentAddr,
+	}
+
+	route, err := ctx.router.FindRoute(
+		ctx.router.selfNode.PubKeyBytes, ctx.aliases["sophon"],
+		paymentAmt, restrictions, nil, nil, MinCLTVDelta, nil,
+	)
+	require.NoError(t, err, "unable to find route")
+
+	finalHop := route.Hops[len(route.Hops)-1]
+	require.NotNil(t, finalHop.MPP, "final hop has no mpp record")
+	require.Equal(t, paymentAddr, finalHop.MPP.PaymentAddr())
+	require.Equal(t, paymentAmt, finalHop.MPP.TotalMsat())
+}
+
 // TestSendPaymentRouteFailureFallback tests that when sending a payment, if
 // one of the target routes is seen as unavailable, then the next route in the
 // queue is used instead. This process should continue until either a payment
@@ -1527,7 +1560,7 @@ func TestAddEdgeUnknownVertexes(t *testing.T) {
 	_, err = ctx.router.FindRoute(
 		ctx.router.selfNode.PubKeyBytes,
 		targetPubKeyBytes, paymentAmt, noRestrictions, nil, nil,
-		MinCLTVDelta,
+		MinCLTVDelta, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to find any routes: %v", err)
@@ -1570,7 +1603,7 @@ func TestAddEdgeUnknownVertexes(t *testing.T) {
 	_, err = ctx.router.FindRoute(
 		ctx.router.selfNode.PubKeyBytes,
 		targetPubKeyBytes, paymentAmt, noRestrictions, nil, nil,
-		MinCLTVDelta,
+		MinCLTVDelta, nil,
 	)
 	if err != nil {
 		t.Fatalf("unable to find any routes: %v", err)
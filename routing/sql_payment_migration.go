@@ -0,0 +1,91 @@
+package routing
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/sqldb"
+)
+
+// paymentsKVMigration is the name that the migration of the payments from the
+// kvdb backend is recorded under.
+const paymentsKVMigration = "payments"
+
+// MigratePaymentsToSQL copies all payments of the passed kvdb payment store,
+// including their HTLC attempts and any legacy duplicate payments, to the
+// native SQL payment store, unless that happened already. The sequence numbers
+// of the payments are retained, so that clients can continue to paginate from
+// the offsets they know of.
+func MigratePaymentsToSQL(kvStore PaymentDB, sqlStore *SQLPaymentStore) error {
+	ctx := context.Background()
+	done, err := sqlStore.db.KVMigrationDone(ctx, paymentsKVMigration)
+	if err != nil {
+		return err
+	}
+	if done {
+		return nil
+	}
+
+	// The payments are returned in the order of their sequence numbers,
+	// along with the duplicate payments that old versions of lnd made.
+	payments, err := kvStore.FetchPayments()
+	if err != nil {
+		return err
+	}
+
+	// For hashes that were paid more than once, the duplicates are all
+	// payments but the one that's returned when fetching the hash.
+	hashCount := make(map[lntypes.Hash]int)
+	for _, payment := range payments {
+		hashCount[payment.Info.PaymentIdentifier]++
+	}
+	mainSequenceNums := make(map[lntypes.Hash]uint64)
+	for hash, count := range hashCount {
+		if count == 1 {
+			continue
+		}
+
+		payment, err := kvStore.FetchPayment(hash)
+		if err != nil {
+			return err
+		}
+		mainSequenceNums[hash] = payment.SequenceNum
+	}
+
+	log.Infof("Migrating %d payments to the native SQL payment store",
+		len(payments))
+
+	return sqlStore.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		var lastSequenceNum uint64
+		for _, payment := range payments {
+			hash := payment.Info.PaymentIdentifier
+			mainSequenceNum, ok := mainSequenceNums[hash]
+			isDuplicate := ok &&
+				mainSequenceNum != payment.SequenceNum
+
+			_, err := insertPayment(
+				ctx, tx, hash, payment, isDuplicate,
+			)
+			if err != nil {
+				return err
+			}
+
+			if payment.SequenceNum > lastSequenceNum {
+				lastSequenceNum = payment.SequenceNum
+			}
+		}
+
+		// New payments are sequenced after the migrated ones.
+		_, err := tx.ExecContext(ctx, `
+			UPDATE payment_sequences SET current_value = $1
+			WHERE name = $2`, int64(lastSequenceNum),
+			paymentSequence,
+		)
+		if err != nil {
+			return err
+		}
+
+		return sqldb.MarkKVMigrationDone(ctx, tx, paymentsKVMigration)
+	})
+}
@@ -0,0 +1,959 @@
+package routing
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/sqldb"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+)
+
+const (
+	// paymentSequence is the name of the sequence that the sequence
+	// numbers of the payments are assigned from.
+	paymentSequence = "sequence_num"
+
+	// paymentColumns are the columns of the payments table, in the order
+	// that fetchPayment scans them.
+	paymentColumns = "payment_hash, is_duplicate, sequence_num, " +
+		"amount_msat, payment_request, status, failure_reason, " +
+		"created_at, label"
+
+	// attemptColumns are the columns of the payment_htlc_attempts table,
+	// in the order that scanAttempt expects them.
+	attemptColumns = "attempt_id, session_key, route, hash, " +
+		"attempt_time, settle_preimage, settle_time, fail_reason, " +
+		"fail_time, fail_message, fail_source_index"
+)
+
+// SQLPaymentStore is a payment store that keeps the payments and their HTLC
+// attempts within the relational tables of a native SQL database. As opposed
+// to the kvdb payment store, the status, creation time and destination of the
+// payments are indexed, so that they can be queried without a full scan.
+type SQLPaymentStore struct {
+	db *sqldb.DB
+}
+
+// A compile time check to ensure SQLPaymentStore implements the PaymentDB
+// interface.
+var _ PaymentDB = (*SQLPaymentStore)(nil)
+
+// NewSQLPaymentStore creates a new payment store that is backed by the passed
+// native SQL database.
+func NewSQLPaymentStore(db *sqldb.DB) *SQLPaymentStore {
+	return &SQLPaymentStore{
+		db: db,
+	}
+}
+
+// InitPayment checks or records the given PaymentCreationInfo with the DB,
+// making sure it does not already exist as an in-flight payment. A failed
+// payment is reset, so that it can be retried.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) InitPayment(paymentHash lntypes.Hash,
+	info *channeldb.PaymentCreationInfo) error {
+
+	ctx := context.Background()
+	return s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		id, err := queryPaymentID(ctx, tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		// If the payment is known already, we'll only allow it to be
+		// retried if it failed.
+		if id != 0 {
+			var status int16
+			err := tx.QueryRowContext(
+				ctx, "SELECT status FROM payments "+
+					"WHERE id = $1", id,
+			).Scan(&status)
+			if err != nil {
+				return err
+			}
+
+			switch channeldb.PaymentStatus(status) {
+			// We allow retrying failed payments.
+			case channeldb.StatusFailed:
+
+			case channeldb.StatusInFlight:
+				return channeldb.ErrPaymentInFlight
+
+			case channeldb.StatusSucceeded:
+				return channeldb.ErrAlreadyPaid
+
+			default:
+				return channeldb.ErrUnknownPaymentStatus
+			}
+		}
+
+		// The payment obtains a new sequence number, also when it's
+		// retried, so that it's sorted by the time of its last
+		// initialization.
+		sequenceNum, err := nextPaymentSequence(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		payment := &channeldb.MPPayment{
+			SequenceNum: sequenceNum,
+			Info:        info,
+			Status:      channeldb.StatusInFlight,
+		}
+		if id == 0 {
+			_, err := insertPayment(
+				ctx, tx, paymentHash, payment, false,
+			)
+
+			return err
+		}
+
+		// We'll delete the lingering HTLCs and failure reason of the
+		// previous attempt to make the payment.
+		_, err = tx.ExecContext(
+			ctx, "DELETE FROM payment_htlc_attempts "+
+				"WHERE payment_id = $1", id,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE payments SET sequence_num = $1, amount_msat = $2,
+				payment_request = $3, destination = NULL,
+				status = $4, failure_reason = NULL,
+				created_at = $5, label = $6
+			WHERE id = $7`, int64(sequenceNum), int64(info.Value),
+			payReqBytes(info.PaymentRequest),
+			int16(channeldb.StatusInFlight),
+			info.CreationTime.UTC(), info.Label, id,
+		)
+
+		return err
+	})
+}
+
+// RegisterAttempt atomically records the provided HTLCAttemptInfo to the DB.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) RegisterAttempt(paymentHash lntypes.Hash,
+	attempt *channeldb.HTLCAttemptInfo) (*channeldb.MPPayment, error) {
+
+	ctx := context.Background()
+	var payment *channeldb.MPPayment
+	err := s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		id, p, err := fetchPaymentByHash(ctx, tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		if err := channeldb.ValidateAttempt(p, attempt); err != nil {
+			return err
+		}
+
+		err = insertAttempt(ctx, tx, id, &channeldb.HTLCAttempt{
+			HTLCAttemptInfo: *attempt,
+		})
+		if err != nil {
+			return err
+		}
+
+		// The destination of the payment is taken from the route of
+		// its first attempt.
+		finalHop := attempt.Route.FinalHop()
+		if finalHop != nil {
+			_, err := tx.ExecContext(ctx, `
+				UPDATE payments SET destination = $1
+				WHERE id = $2 AND destination IS NULL`,
+				finalHop.PubKeyBytes[:], id,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		payment, err = updatePaymentStatus(ctx, tx, id)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// SettleAttempt marks the given attempt settled with the preimage. If this is
+// a multi shard payment, this might implicitly mean that the full payment
+// succeeded.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) SettleAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, settleInfo *channeldb.HTLCSettleInfo) (
+	*channeldb.MPPayment, error) {
+
+	return s.updateAttempt(paymentHash, attemptID, `
+		UPDATE payment_htlc_attempts SET settle_preimage = $1,
+			settle_time = $2
+		WHERE payment_id = $3 AND attempt_id = $4`,
+		settleInfo.Preimage[:], sqldb.NullTime(settleInfo.SettleTime),
+	)
+}
+
+// FailAttempt marks the given payment attempt failed.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) FailAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, failInfo *channeldb.HTLCFailInfo) (
+	*channeldb.MPPayment, error) {
+
+	message, err := failureMessageBytes(failInfo.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.updateAttempt(paymentHash, attemptID, `
+		UPDATE payment_htlc_attempts SET fail_reason = $1,
+			fail_time = $2, fail_message = $3,
+			fail_source_index = $4
+		WHERE payment_id = $5 AND attempt_id = $6`,
+		int16(failInfo.Reason), sqldb.NullTime(failInfo.FailTime),
+		message, int64(failInfo.FailureSourceIndex),
+	)
+}
+
+// updateAttempt records the outcome of an in-flight HTLC attempt with the
+// passed update statement, whose last two arguments are the payment and
+// attempt ID, and returns the updated payment.
+func (s *SQLPaymentStore) updateAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, update string, args ...interface{}) (
+	*channeldb.MPPayment, error) {
+
+	ctx := context.Background()
+	var payment *channeldb.MPPayment
+	err := s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		id, p, err := fetchPaymentByHash(ctx, tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		err = channeldb.ValidateAttemptUpdate(p, attemptID)
+		if err != nil {
+			return err
+		}
+
+		args := append(args, id, int64(attemptID))
+		if _, err := tx.ExecContext(ctx, update, args...); err != nil {
+			return err
+		}
+
+		payment, err = updatePaymentStatus(ctx, tx, id)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// Fail transitions a payment into the Failed state, and records the reason the
+// payment failed. After invoking this method, InitPayment should return nil on
+// its next call for this payment hash, allowing the switch to make a
+// subsequent payment.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) Fail(paymentHash lntypes.Hash,
+	reason channeldb.FailureReason) (*channeldb.MPPayment, error) {
+
+	ctx := context.Background()
+	var payment *channeldb.MPPayment
+	err := s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		id, err := queryPaymentID(ctx, tx, paymentHash)
+		if err != nil {
+			return err
+		}
+		if id == 0 {
+			return channeldb.ErrPaymentNotInitiated
+		}
+
+		_, err = tx.ExecContext(
+			ctx, "UPDATE payments SET failure_reason = $1 "+
+				"WHERE id = $2", int16(reason), id,
+		)
+		if err != nil {
+			return err
+		}
+
+		payment, err = updatePaymentStatus(ctx, tx, id)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// FetchPayment returns information about a payment from the database.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) FetchPayment(paymentHash lntypes.Hash) (
+	*channeldb.MPPayment, error) {
+
+	ctx := context.Background()
+	var payment *channeldb.MPPayment
+	err := s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		var err error
+		_, payment, err = fetchPaymentByHash(ctx, tx, paymentHash)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// FetchInFlightPayments returns all payments with status InFlight.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) FetchInFlightPayments() ([]*channeldb.MPPayment,
+	error) {
+
+	return s.fetchPayments(
+		"SELECT id FROM payments WHERE status = $1 AND "+
+			"NOT is_duplicate ORDER BY payment_hash",
+		int16(channeldb.StatusInFlight),
+	)
+}
+
+// FetchPayments returns all sent payments found in the DB, ordered by their
+// sequence number.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) FetchPayments() ([]*channeldb.MPPayment, error) {
+	return s.fetchPayments("SELECT id FROM payments ORDER BY sequence_num")
+}
+
+// fetchPayments returns the payments that are selected by the passed query,
+// in the order of the query.
+func (s *SQLPaymentStore) fetchPayments(query string,
+	args ...interface{}) ([]*channeldb.MPPayment, error) {
+
+	ctx := context.Background()
+	var payments []*channeldb.MPPayment
+	err := s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		payments = nil
+
+		ids, err := queryPaymentIDs(ctx, tx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			payment, err := fetchPayment(ctx, tx, id)
+			if err != nil {
+				return err
+			}
+
+			payments = append(payments, payment)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+// QueryPayments is a query to the payments database which is restricted to a
+// subset of payments by the payments query, containing an offset index and a
+// maximum number of returned payments.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) QueryPayments(query channeldb.PaymentsQuery) (
+	channeldb.PaymentsResponse, error) {
+
+	var resp channeldb.PaymentsResponse
+
+	// The index offset is exclusive, and points to the end of the
+	// sequence numbers for reversed queries if it's zero.
+	var (
+		conditions []string
+		args       []interface{}
+		order      = "ASC"
+	)
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(
+			conditions, fmt.Sprintf(condition, len(args)),
+		)
+	}
+	switch {
+	case !query.Reversed:
+		addCondition("sequence_num > $%d", int64(query.IndexOffset))
+
+	case query.IndexOffset != 0:
+		addCondition("sequence_num < $%d", int64(query.IndexOffset))
+		order = "DESC"
+
+	default:
+		order = "DESC"
+	}
+
+	// To keep compatibility with the old API, we only return
+	// non-succeeded payments if requested.
+	if !query.IncludeIncomplete {
+		addCondition("status = $%d", int16(channeldb.StatusSucceeded))
+	}
+
+	// The creation date range is inclusive on both ends, at the
+	// granularity of seconds.
+	if query.CreationDateStart != 0 {
+		addCondition(
+			"created_at >= $%d",
+			time.Unix(query.CreationDateStart, 0).UTC(),
+		)
+	}
+	if query.CreationDateEnd != 0 {
+		addCondition(
+			"created_at < $%d",
+			time.Unix(query.CreationDateEnd+1, 0).UTC(),
+		)
+	}
+
+	limit := query.MaxPayments
+	if limit > math.MaxInt64 {
+		limit = math.MaxInt64
+	}
+
+	sqlQuery := "SELECT id FROM payments"
+	if len(conditions) != 0 {
+		sqlQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	sqlQuery += fmt.Sprintf(
+		" ORDER BY sequence_num %s LIMIT %d", order, limit,
+	)
+
+	payments, err := s.fetchPayments(sqlQuery, args...)
+	if err != nil {
+		return resp, err
+	}
+	resp.Payments = payments
+
+	// Need to swap the payments slice order if reversed order.
+	if query.Reversed {
+		for l, r := 0, len(resp.Payments)-1; l < r; l, r = l+1, r-1 {
+			resp.Payments[l], resp.Payments[r] =
+				resp.Payments[r], resp.Payments[l]
+		}
+	}
+
+	// Set the first and last index of the returned payments so that the
+	// caller can resume from this point later on.
+	if len(resp.Payments) > 0 {
+		resp.FirstIndexOffset = resp.Payments[0].SequenceNum
+		resp.LastIndexOffset =
+			resp.Payments[len(resp.Payments)-1].SequenceNum
+	}
+
+	return resp, nil
+}
+
+// DeletePayment deletes a payment from the DB given its payment hash, along
+// with any legacy duplicate payments to the same hash. If failedHtlcsOnly is
+// set, only failed HTLC attempts of the payment will be deleted.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) DeletePayment(paymentHash lntypes.Hash,
+	failedHtlcsOnly bool) error {
+
+	ctx := context.Background()
+	return s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		id, payment, err := fetchPaymentByHash(ctx, tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		// If the status is InFlight, we cannot safely delete the
+		// payment information, so we return an error.
+		if payment.Status == channeldb.StatusInFlight {
+			return fmt.Errorf("payment '%v' has status InFlight "+
+				"and therefore cannot be deleted",
+				paymentHash.String())
+		}
+
+		if failedHtlcsOnly {
+			_, err := tx.ExecContext(ctx, `
+				DELETE FROM payment_htlc_attempts
+				WHERE payment_id = $1 AND
+					fail_reason IS NOT NULL`, id,
+			)
+
+			return err
+		}
+
+		_, err = tx.ExecContext(
+			ctx, "DELETE FROM payments WHERE payment_hash = $1",
+			paymentHash[:],
+		)
+
+		return err
+	})
+}
+
+// DeletePayments deletes all completed and failed payments from the DB. If
+// failedOnly is set, only failed payments will be considered for deletion. If
+// failedHtlsOnly is set, the payment itself won't be deleted, only failed HTLC
+// attempts.
+//
+// NOTE: This is part of the PaymentDB interface.
+func (s *SQLPaymentStore) DeletePayments(failedOnly,
+	failedHtlcsOnly bool) error {
+
+	// In-flight payments can't be deleted safely, and neither can their
+	// HTLCs. Legacy duplicate payments are deleted along with the payment
+	// that has the same hash.
+	selectPayments := fmt.Sprintf(
+		"SELECT %%s FROM payments WHERE NOT is_duplicate AND "+
+			"status != %d", channeldb.StatusInFlight,
+	)
+	if failedOnly {
+		selectPayments += fmt.Sprintf(
+			" AND status = %d", channeldb.StatusFailed,
+		)
+	}
+
+	ctx := context.Background()
+	return s.db.ExecTx(ctx, func(tx *sql.Tx) error {
+		if failedHtlcsOnly {
+			_, err := tx.ExecContext(ctx, fmt.Sprintf(`
+				DELETE FROM payment_htlc_attempts
+				WHERE fail_reason IS NOT NULL AND
+					payment_id IN (%s)`,
+				fmt.Sprintf(selectPayments, "id"),
+			))
+
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, fmt.Sprintf(
+			"DELETE FROM payments WHERE payment_hash IN (%s)",
+			fmt.Sprintf(selectPayments, "payment_hash"),
+		))
+
+		return err
+	})
+}
+
+// nextPaymentSequence returns the next sequence number to store for a new
+// payment.
+func nextPaymentSequence(ctx context.Context, tx *sql.Tx) (uint64, error) {
+	var value int64
+	err := tx.QueryRowContext(ctx, `
+		UPDATE payment_sequences SET current_value = current_value + 1
+		WHERE name = $1 RETURNING current_value`, paymentSequence,
+	).Scan(&value)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(value), nil
+}
+
+// queryPaymentID returns the ID of the payment with the given hash, or zero if
+// there is no such payment. Legacy duplicate payments aren't considered.
+func queryPaymentID(ctx context.Context, tx *sql.Tx,
+	paymentHash lntypes.Hash) (int64, error) {
+
+	var id int64
+	err := tx.QueryRowContext(
+		ctx, "SELECT id FROM payments WHERE payment_hash = $1 AND "+
+			"NOT is_duplicate", paymentHash[:],
+	).Scan(&id)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+
+	return id, err
+}
+
+// queryPaymentIDs returns the payment IDs that are selected by the passed
+// query, in the order of the query.
+func queryPaymentIDs(ctx context.Context, tx *sql.Tx, query string,
+	args ...interface{}) ([]int64, error) {
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// fetchPaymentByHash returns the ID and the payment with the given hash. If
+// there is no such payment, ErrPaymentNotInitiated is returned.
+func fetchPaymentByHash(ctx context.Context, tx *sql.Tx,
+	paymentHash lntypes.Hash) (int64, *channeldb.MPPayment, error) {
+
+	id, err := queryPaymentID(ctx, tx, paymentHash)
+	if err != nil {
+		return 0, nil, err
+	}
+	if id == 0 {
+		return 0, nil, channeldb.ErrPaymentNotInitiated
+	}
+
+	payment, err := fetchPayment(ctx, tx, id)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return id, payment, nil
+}
+
+// fetchPayment returns the payment with the given ID, including its HTLC
+// attempts.
+func fetchPayment(ctx context.Context, tx *sql.Tx, id int64) (
+	*channeldb.MPPayment, error) {
+
+	var (
+		hash          []byte
+		isDuplicate   bool
+		sequenceNum   int64
+		amt           int64
+		status        int16
+		failureReason sql.NullInt16
+		createdAt     time.Time
+		info          channeldb.PaymentCreationInfo
+	)
+	err := tx.QueryRowContext(
+		ctx, "SELECT "+paymentColumns+" FROM payments WHERE id = $1",
+		id,
+	).Scan(
+		&hash, &isDuplicate, &sequenceNum, &amt, &info.PaymentRequest,
+		&status, &failureReason, &createdAt, &info.Label,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	copy(info.PaymentIdentifier[:], hash)
+	info.Value = lnwire.MilliSatoshi(amt)
+	info.CreationTime = createdAt.Local()
+	if info.PaymentRequest == nil {
+		info.PaymentRequest = []byte{}
+	}
+
+	payment := &channeldb.MPPayment{
+		SequenceNum: uint64(sequenceNum),
+		Info:        &info,
+		Status:      channeldb.PaymentStatus(status),
+	}
+	if failureReason.Valid {
+		reason := channeldb.FailureReason(failureReason.Int16)
+		payment.FailureReason = &reason
+	}
+
+	payment.HTLCs, err = fetchAttempts(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The status of legacy duplicate payments was recorded explicitly,
+	// while it's derived from the HTLC attempts for all other payments.
+	if !isDuplicate {
+		payment.Status = channeldb.DecidePaymentStatus(
+			payment.HTLCs, payment.FailureReason,
+		)
+	}
+
+	return payment, nil
+}
+
+// fetchAttempts returns the HTLC attempts of the payment with the given ID,
+// ordered by their attempt ID.
+func fetchAttempts(ctx context.Context, tx *sql.Tx, paymentID int64) (
+	[]channeldb.HTLCAttempt, error) {
+
+	rows, err := tx.QueryContext(
+		ctx, "SELECT "+attemptColumns+" FROM payment_htlc_attempts "+
+			"WHERE payment_id = $1 ORDER BY attempt_id", paymentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attempts []channeldb.HTLCAttempt
+	for rows.Next() {
+		attempt, err := scanAttempt(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		attempts = append(attempts, *attempt)
+	}
+
+	return attempts, rows.Err()
+}
+
+// scanAttempt scans an HTLC attempt from a row that holds the attemptColumns.
+func scanAttempt(rows *sql.Rows) (*channeldb.HTLCAttempt, error) {
+	var (
+		attemptID       int64
+		sessionKey      []byte
+		routeBytes      []byte
+		hash            []byte
+		attemptTime     sql.NullTime
+		preimage        []byte
+		settleTime      sql.NullTime
+		failReason      sql.NullInt16
+		failTime        sql.NullTime
+		failMessage     []byte
+		failSourceIndex sql.NullInt64
+	)
+	err := rows.Scan(
+		&attemptID, &sessionKey, &routeBytes, &hash, &attemptTime,
+		&preimage, &settleTime, &failReason, &failTime, &failMessage,
+		&failSourceIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := channeldb.DeserializeRoute(bytes.NewReader(routeBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	var rawKey [btcec.PrivKeyBytesLen]byte
+	copy(rawKey[:], sessionKey)
+
+	var attemptHash *lntypes.Hash
+	if hash != nil {
+		attemptHash = &lntypes.Hash{}
+		copy(attemptHash[:], hash)
+	}
+
+	attempt := &channeldb.HTLCAttempt{
+		HTLCAttemptInfo: *channeldb.NewHtlcAttemptInfoFromRawKey(
+			uint64(attemptID), rawKey, route,
+			sqldb.FromNullTime(attemptTime), attemptHash,
+		),
+	}
+
+	if preimage != nil {
+		attempt.Settle = &channeldb.HTLCSettleInfo{
+			SettleTime: sqldb.FromNullTime(settleTime),
+		}
+		copy(attempt.Settle.Preimage[:], preimage)
+	}
+
+	if failReason.Valid {
+		attempt.Failure = &channeldb.HTLCFailInfo{
+			FailTime: sqldb.FromNullTime(failTime),
+			Reason: channeldb.HTLCFailReason(
+				failReason.Int16,
+			),
+			FailureSourceIndex: uint32(failSourceIndex.Int64),
+		}
+
+		if len(failMessage) > 0 {
+			attempt.Failure.Message, err =
+				lnwire.DecodeFailureMessage(
+					bytes.NewReader(failMessage), 0,
+				)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return attempt, nil
+}
+
+// updatePaymentStatus derives the status of the payment with the given ID from
+// its HTLC attempts, records it, and returns the payment.
+func updatePaymentStatus(ctx context.Context, tx *sql.Tx, id int64) (
+	*channeldb.MPPayment, error) {
+
+	payment, err := fetchPayment(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(
+		ctx, "UPDATE payments SET status = $1 WHERE id = $2",
+		int16(payment.Status), id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// insertPayment inserts the payment along with its HTLC attempts, and returns
+// its ID.
+func insertPayment(ctx context.Context, tx *sql.Tx, paymentHash lntypes.Hash,
+	payment *channeldb.MPPayment, isDuplicate bool) (int64, error) {
+
+	var failureReason sql.NullInt16
+	if payment.FailureReason != nil {
+		failureReason = sql.NullInt16{
+			Int16: int16(*payment.FailureReason),
+			Valid: true,
+		}
+	}
+
+	var destination interface{}
+	if len(payment.HTLCs) > 0 {
+		finalHop := payment.HTLCs[0].Route.FinalHop()
+		if finalHop != nil {
+			destination = finalHop.PubKeyBytes[:]
+		}
+	}
+
+	var id int64
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO payments (payment_hash, is_duplicate,
+			sequence_num, amount_msat, payment_request, destination,
+			status, failure_reason, created_at, label)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id`, paymentHash[:], isDuplicate,
+		int64(payment.SequenceNum), int64(payment.Info.Value),
+		payReqBytes(payment.Info.PaymentRequest), destination,
+		int16(payment.Status), failureReason,
+		payment.Info.CreationTime.UTC(), payment.Info.Label,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range payment.HTLCs {
+		err := insertAttempt(ctx, tx, id, &payment.HTLCs[i])
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return id, nil
+}
+
+// insertAttempt inserts an HTLC attempt of the payment with the given ID,
+// including its outcome if it's known already.
+func insertAttempt(ctx context.Context, tx *sql.Tx, paymentID int64,
+	attempt *channeldb.HTLCAttempt) error {
+
+	var routeBytes bytes.Buffer
+	err := channeldb.SerializeRoute(&routeBytes, attempt.Route)
+	if err != nil {
+		return err
+	}
+
+	var hash interface{}
+	if attempt.Hash != nil {
+		hash = attempt.Hash[:]
+	}
+
+	var (
+		preimage   interface{}
+		settleTime sql.NullTime
+	)
+	if attempt.Settle != nil {
+		preimage = attempt.Settle.Preimage[:]
+		settleTime = sqldb.NullTime(attempt.Settle.SettleTime)
+	}
+
+	var (
+		failReason      sql.NullInt16
+		failTime        sql.NullTime
+		failMessage     interface{}
+		failSourceIndex sql.NullInt64
+	)
+	if attempt.Failure != nil {
+		failReason = sql.NullInt16{
+			Int16: int16(attempt.Failure.Reason),
+			Valid: true,
+		}
+		failTime = sqldb.NullTime(attempt.Failure.FailTime)
+		failSourceIndex = sql.NullInt64{
+			Int64: int64(attempt.Failure.FailureSourceIndex),
+			Valid: true,
+		}
+
+		failMessage, err = failureMessageBytes(attempt.Failure.Message)
+		if err != nil {
+			return err
+		}
+	}
+
+	sessionKey := attempt.RawSessionKey()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO payment_htlc_attempts (payment_id, attempt_id,
+			session_key, route, amount_msat, fee_msat, hash,
+			attempt_time, settle_preimage, settle_time, fail_reason,
+			fail_time, fail_message, fail_source_index)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12,
+			$13, $14)`, paymentID, int64(attempt.AttemptID),
+		sessionKey[:], routeBytes.Bytes(),
+		int64(attempt.Route.ReceiverAmt()),
+		int64(attempt.Route.TotalFees()), hash,
+		sqldb.NullTime(attempt.AttemptTime), preimage, settleTime,
+		failReason, failTime, failMessage, failSourceIndex,
+	)
+
+	return err
+}
+
+// failureMessageBytes encodes the failure message of a failed HTLC attempt,
+// which is NULL if there is no message.
+func failureMessageBytes(message lnwire.FailureMessage) (interface{}, error) {
+	if message == nil {
+		return nil, nil
+	}
+
+	var b bytes.Buffer
+	if err := lnwire.EncodeFailureMessage(&b, message, 0); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// payReqBytes returns the payment request in its database representation,
+// which is an empty rather than a NULL blob if there is no payment request.
+func payReqBytes(payReq []byte) []byte {
+	if payReq == nil {
+		return []byte{}
+	}
+
+	return payReq
+}
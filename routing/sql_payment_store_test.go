@@ -0,0 +1,444 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/sqldb"
+	"github.com/stretchr/testify/require"
+)
+
+// testPaymentTime is the creation time of the first test payment.
+var testPaymentTime = time.Unix(1650000000, 0)
+
+// newTestPaymentDBs creates a kvdb and an SQL payment store, which are
+// expected to behave identically.
+func newTestPaymentDBs(t *testing.T) []PaymentDB {
+	cdb, err := initDB()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, cdb.Close())
+	})
+
+	db, cleanUp, err := sqldb.NewTestSqliteStore()
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	return []PaymentDB{
+		channeldb.NewPaymentControl(cdb), NewSQLPaymentStore(db),
+	}
+}
+
+// normalizePayment normalizes the representation of the fields of a payment
+// that may differ between the payment stores without changing its meaning,
+// such as the location of timestamps.
+func normalizePayment(payment *channeldb.MPPayment) channeldb.MPPayment {
+	p := *payment
+	info := *p.Info
+	info.CreationTime = info.CreationTime.UTC()
+	p.Info = &info
+
+	var htlcs []channeldb.HTLCAttempt
+	for _, htlc := range p.HTLCs {
+		htlc.AttemptTime = htlc.AttemptTime.UTC()
+		if htlc.Settle != nil {
+			settle := *htlc.Settle
+			settle.SettleTime = settle.SettleTime.UTC()
+			htlc.Settle = &settle
+		}
+		if htlc.Failure != nil {
+			failure := *htlc.Failure
+			failure.FailTime = failure.FailTime.UTC()
+			htlc.Failure = &failure
+		}
+
+		htlcs = append(htlcs, htlc)
+	}
+	p.HTLCs = htlcs
+
+	return p
+}
+
+// paymentQuery is a query that returns a list of payments.
+type paymentQuery func(PaymentDB) ([]*channeldb.MPPayment, error)
+
+// requireSamePayments calls the passed query on all payment stores, and
+// asserts that they return the same payments and error.
+func requireSamePayments(t *testing.T, dbs []PaymentDB, query paymentQuery) {
+	t.Helper()
+
+	expected, expectedErr := query(dbs[0])
+	for _, db := range dbs[1:] {
+		actual, err := query(db)
+		require.Equal(t, expectedErr, err)
+
+		require.Len(t, actual, len(expected))
+		for i := range expected {
+			require.Equal(
+				t, normalizePayment(expected[i]),
+				normalizePayment(actual[i]),
+			)
+		}
+	}
+}
+
+// fetchPaymentsQuery returns all payments.
+func fetchPaymentsQuery(db PaymentDB) ([]*channeldb.MPPayment, error) {
+	return db.FetchPayments()
+}
+
+// fetchInFlightQuery returns the in-flight payments.
+func fetchInFlightQuery(db PaymentDB) ([]*channeldb.MPPayment, error) {
+	return db.FetchInFlightPayments()
+}
+
+// queryPaymentsQuery returns the payments that match the query, along with
+// fake payments that carry the first and last index offsets of the response.
+func queryPaymentsQuery(q channeldb.PaymentsQuery) paymentQuery {
+	return func(db PaymentDB) ([]*channeldb.MPPayment, error) {
+		resp, err := db.QueryPayments(q)
+		if err != nil {
+			return nil, err
+		}
+
+		offsets := []*channeldb.MPPayment{
+			{
+				SequenceNum: resp.FirstIndexOffset,
+				Info:        &channeldb.PaymentCreationInfo{},
+			},
+			{
+				SequenceNum: resp.LastIndexOffset,
+				Info:        &channeldb.PaymentCreationInfo{},
+			},
+		}
+
+		return append(resp.Payments, offsets...), nil
+	}
+}
+
+// paymentUpdate returns a query that applies an update to a single payment,
+// and returns the updated payment.
+func paymentUpdate(update func(PaymentDB) (*channeldb.MPPayment,
+	error)) paymentQuery {
+
+	return func(db PaymentDB) ([]*channeldb.MPPayment, error) {
+		payment, err := update(db)
+		if err != nil {
+			return nil, err
+		}
+
+		// The kvdb store consumes a sequence number for rejected
+		// initializations as well, so the sequence numbers of the
+		// stores aren't compared for single payment updates.
+		p := *payment
+		p.SequenceNum = 0
+
+		return []*channeldb.MPPayment{&p}, nil
+	}
+}
+
+// newTestAttempt returns an attempt to pay along the test route.
+func newTestAttempt(attemptID uint64) *channeldb.HTLCAttemptInfo {
+	return channeldb.NewHtlcAttemptInfo(
+		attemptID, priv, testRoute,
+		testPaymentTime.Add(time.Duration(attemptID)*time.Second), nil,
+	)
+}
+
+// testFailInfo is the failure that test attempts fail with.
+var testFailInfo = &channeldb.HTLCFailInfo{
+	FailTime:           testPaymentTime.Add(time.Minute),
+	Message:            lnwire.NewFailIncorrectDetails(1000, 100),
+	Reason:             channeldb.HTLCFailMessage,
+	FailureSourceIndex: 2,
+}
+
+// populatePayments creates a set of payments with different creation times
+// and outcomes in all of the passed payment stores, and returns their hashes.
+// Every third payment succeeds, fails or remains in flight respectively.
+func populatePayments(t *testing.T, dbs []PaymentDB,
+	numPayments int) []lntypes.Hash {
+
+	var hashes []lntypes.Hash
+	for i := 0; i < numPayments; i++ {
+		info, _, preimage, err := genInfo()
+		require.NoError(t, err)
+		info.CreationTime = testPaymentTime.Add(
+			time.Duration(i) * time.Hour,
+		)
+		hash := info.PaymentIdentifier
+		hashes = append(hashes, hash)
+
+		for _, db := range dbs {
+			require.NoError(t, db.InitPayment(hash, info))
+
+			_, err := db.RegisterAttempt(hash, newTestAttempt(1))
+			require.NoError(t, err)
+
+			switch i % 3 {
+			case 0:
+				_, err = db.FailAttempt(hash, 1, testFailInfo)
+				require.NoError(t, err)
+
+				_, err = db.RegisterAttempt(
+					hash, newTestAttempt(2),
+				)
+				require.NoError(t, err)
+
+				_, err = db.SettleAttempt(
+					hash, 2, &channeldb.HTLCSettleInfo{
+						Preimage:   preimage,
+						SettleTime: info.CreationTime,
+					},
+				)
+
+			case 1:
+				_, err = db.FailAttempt(hash, 1, testFailInfo)
+				require.NoError(t, err)
+
+				_, err = db.Fail(
+					hash, channeldb.FailureReasonNoRoute,
+				)
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	return hashes
+}
+
+// TestSQLPaymentStoreLifecycle asserts that the SQL payment store follows the
+// same state transitions as the kvdb payment store through the life of a
+// payment, including its retry after a failure.
+func TestSQLPaymentStoreLifecycle(t *testing.T) {
+	t.Parallel()
+
+	dbs := newTestPaymentDBs(t)
+
+	info, _, preimage, err := genInfo()
+	require.NoError(t, err)
+	info.Label = "rebalance"
+	hash := info.PaymentIdentifier
+
+	fetch := paymentUpdate(func(db PaymentDB) (*channeldb.MPPayment,
+		error) {
+
+		return db.FetchPayment(hash)
+	})
+	initPayment := paymentUpdate(func(db PaymentDB) (*channeldb.MPPayment,
+		error) {
+
+		if err := db.InitPayment(hash, info); err != nil {
+			return nil, err
+		}
+
+		return db.FetchPayment(hash)
+	})
+	register := func(attemptID uint64) paymentQuery {
+		return paymentUpdate(func(db PaymentDB) (*channeldb.MPPayment,
+			error) {
+
+			return db.RegisterAttempt(
+				hash, newTestAttempt(attemptID),
+			)
+		})
+	}
+	failAttempt := func(attemptID uint64) paymentQuery {
+		return paymentUpdate(func(db PaymentDB) (*channeldb.MPPayment,
+			error) {
+
+			return db.FailAttempt(hash, attemptID, testFailInfo)
+		})
+	}
+	settleAttempt := func(attemptID uint64) paymentQuery {
+		return paymentUpdate(func(db PaymentDB) (*channeldb.MPPayment,
+			error) {
+
+			return db.SettleAttempt(
+				hash, attemptID, &channeldb.HTLCSettleInfo{
+					Preimage:   preimage,
+					SettleTime: testPaymentTime,
+				},
+			)
+		})
+	}
+	fail := paymentUpdate(func(db PaymentDB) (*channeldb.MPPayment,
+		error) {
+
+		return db.Fail(hash, channeldb.FailureReasonTimeout)
+	})
+
+	// Unknown payments can't be fetched or updated.
+	requireSamePayments(t, dbs, fetch)
+	requireSamePayments(t, dbs, register(1))
+	requireSamePayments(t, dbs, fail)
+
+	// A payment can only be initialized once while it's in flight.
+	requireSamePayments(t, dbs, initPayment)
+	requireSamePayments(t, dbs, initPayment)
+	requireSamePayments(t, dbs, fetchInFlightQuery)
+
+	// Attempts can only be resolved once, and only if they're known.
+	requireSamePayments(t, dbs, register(1))
+	requireSamePayments(t, dbs, register(2))
+	requireSamePayments(t, dbs, failAttempt(1))
+	requireSamePayments(t, dbs, failAttempt(1))
+	requireSamePayments(t, dbs, settleAttempt(1))
+	requireSamePayments(t, dbs, settleAttempt(3))
+
+	// The payment remains in flight until its last attempt failed.
+	requireSamePayments(t, dbs, fail)
+	requireSamePayments(t, dbs, register(3))
+	requireSamePayments(t, dbs, failAttempt(2))
+	requireSamePayments(t, dbs, fetchInFlightQuery)
+
+	// A failed payment can be retried, and can't be paid twice.
+	requireSamePayments(t, dbs, initPayment)
+	requireSamePayments(t, dbs, register(4))
+	requireSamePayments(t, dbs, settleAttempt(4))
+	requireSamePayments(t, dbs, register(5))
+	requireSamePayments(t, dbs, initPayment)
+	requireSamePayments(t, dbs, fetch)
+	requireSamePayments(t, dbs, fetchInFlightQuery)
+}
+
+// TestSQLPaymentStoreQueryPayments asserts that the SQL payment store returns
+// the same pages of payments as the kvdb payment store.
+func TestSQLPaymentStoreQueryPayments(t *testing.T) {
+	t.Parallel()
+
+	dbs := newTestPaymentDBs(t)
+	populatePayments(t, dbs, 9)
+
+	requireSamePayments(t, dbs, fetchPaymentsQuery)
+	requireSamePayments(t, dbs, fetchInFlightQuery)
+
+	start := testPaymentTime.Unix()
+	queries := []channeldb.PaymentsQuery{
+		{
+			MaxPayments: 100,
+		},
+		{
+			MaxPayments:       100,
+			IncludeIncomplete: true,
+		},
+		{
+			IndexOffset:       3,
+			MaxPayments:       2,
+			IncludeIncomplete: true,
+		},
+		{
+			MaxPayments: 2,
+			Reversed:    true,
+		},
+		{
+			IndexOffset:       7,
+			MaxPayments:       3,
+			Reversed:          true,
+			IncludeIncomplete: true,
+		},
+		{
+			IndexOffset: 20,
+			MaxPayments: 3,
+			Reversed:    true,
+		},
+		{
+			IndexOffset: 20,
+			MaxPayments: 3,
+		},
+		{
+			MaxPayments:       100,
+			IncludeIncomplete: true,
+			CreationDateStart: start + 3600,
+			CreationDateEnd:   start + 5*3600,
+		},
+		{
+			MaxPayments:       2,
+			IncludeIncomplete: true,
+			Reversed:          true,
+			CreationDateStart: start + 3601,
+		},
+		{
+			MaxPayments:     100,
+			CreationDateEnd: start + 3*3600 - 1,
+		},
+	}
+	for _, q := range queries {
+		requireSamePayments(t, dbs, queryPaymentsQuery(q))
+	}
+}
+
+// TestSQLPaymentStoreDelete asserts that the SQL payment store deletes the
+// same payments and HTLC attempts as the kvdb payment store.
+func TestSQLPaymentStoreDelete(t *testing.T) {
+	t.Parallel()
+
+	dbs := newTestPaymentDBs(t)
+	hashes := populatePayments(t, dbs, 9)
+
+	deletePayment := func(hash lntypes.Hash,
+		failedHtlcsOnly bool) paymentQuery {
+
+		return func(db PaymentDB) ([]*channeldb.MPPayment, error) {
+			err := db.DeletePayment(hash, failedHtlcsOnly)
+			if err != nil {
+				return nil, err
+			}
+
+			return db.FetchPayments()
+		}
+	}
+	deletePayments := func(failedOnly,
+		failedHtlcsOnly bool) paymentQuery {
+
+		return func(db PaymentDB) ([]*channeldb.MPPayment, error) {
+			err := db.DeletePayments(failedOnly, failedHtlcsOnly)
+			if err != nil {
+				return nil, err
+			}
+
+			return db.FetchPayments()
+		}
+	}
+
+	// In-flight payments can't be deleted.
+	requireSamePayments(t, dbs, deletePayment(hashes[2], false))
+
+	requireSamePayments(t, dbs, deletePayment(hashes[0], true))
+	requireSamePayments(t, dbs, deletePayment(hashes[3], false))
+	requireSamePayments(t, dbs, deletePayments(false, true))
+	requireSamePayments(t, dbs, deletePayments(true, false))
+	requireSamePayments(t, dbs, deletePayments(false, false))
+}
+
+// TestMigratePaymentsToSQL asserts that the payments of the kvdb payment store
+// are migrated to the SQL payment store, and that new payments continue their
+// sequence numbers.
+func TestMigratePaymentsToSQL(t *testing.T) {
+	t.Parallel()
+
+	dbs := newTestPaymentDBs(t)
+	populatePayments(t, dbs[:1], 5)
+
+	sqlStore := dbs[1].(*SQLPaymentStore)
+	require.NoError(t, MigratePaymentsToSQL(dbs[0], sqlStore))
+
+	requireSamePayments(t, dbs, fetchPaymentsQuery)
+	requireSamePayments(t, dbs, fetchInFlightQuery)
+
+	// A second migration is a no-op.
+	require.NoError(t, MigratePaymentsToSQL(dbs[0], sqlStore))
+	requireSamePayments(t, dbs, fetchPaymentsQuery)
+
+	// New payments are sequenced after the migrated ones.
+	populatePayments(t, dbs, 1)
+	requireSamePayments(t, dbs, fetchPaymentsQuery)
+	requireSamePayments(t, dbs, queryPaymentsQuery(channeldb.PaymentsQuery{
+		IndexOffset:       5,
+		MaxPayments:       10,
+		IncludeIncomplete: true,
+	}))
+}
@@ -22,6 +22,10 @@ type unifiedPolicies struct {
 	// outChanRestr is an optional outgoing channel restriction for the
 	// local channel to use.
 	outChanRestr map[uint64]struct{}
+
+	// inChanRestr is an optional incoming channel restriction for the
+	// channels into toNode.
+	inChanRestr map[uint64]struct{}
 }
 
 // newUnifiedPolicies instantiates a new unifiedPolicies object. Channel
@@ -51,6 +55,13 @@ func (u *unifiedPolicies) addPolicy(fromNode route.Vertex,
 		}
 	}
 
+	// Skip channels if there is an incoming channel restriction.
+	if u.inChanRestr != nil {
+		if _, ok := u.inChanRestr[edge.ChannelID]; !ok {
+			return
+		}
+	}
+
 	// Update the policies map.
 	policy, ok := u.policies[fromNode]
 	if !ok {
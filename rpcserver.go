@@ -49,16 +49,20 @@ import (
 	"github.com/ltcsuite/lnd/lnwallet/chainfee"
 	"github.com/ltcsuite/lnd/lnwallet/chancloser"
 	"github.com/ltcsuite/lnd/lnwallet/chanfunding"
+	"github.com/ltcsuite/lnd/lnwallet/chansplicer"
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/macaroons"
+	"github.com/ltcsuite/lnd/onionmessage"
 	"github.com/ltcsuite/lnd/peer"
 	"github.com/ltcsuite/lnd/peernotifier"
 	"github.com/ltcsuite/lnd/record"
 	"github.com/ltcsuite/lnd/routing"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
 	"github.com/ltcsuite/lnd/routing/route"
 	"github.com/ltcsuite/lnd/rpcperms"
 	"github.com/ltcsuite/lnd/signal"
 	"github.com/ltcsuite/lnd/sweep"
+	"github.com/ltcsuite/lnd/tlv"
 	"github.com/ltcsuite/lnd/watchtower"
 	"github.com/ltcsuite/lnd/zpay32"
 	"github.com/ltcsuite/ltcd/blockchain"
@@ -208,6 +212,11 @@ var (
 	// macaroon related services are used.
 	errMacaroonDisabled = fmt.Errorf("macaroon authentication disabled, " +
 		"remove --no-macaroons flag to enable")
+
+	// errOnionMessagesDisabled is returned when the onion message RPCs
+	// are used while lnd was started with --protocol.no-onion-messages.
+	errOnionMessagesDisabled = errors.New("onion messages disabled, " +
+		"remove --protocol.no-onion-messages flag to enable")
 )
 
 // stringInSlice returns true if a string is contained in the given slice.
@@ -363,6 +372,13 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SpliceChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/GetInfo": {{
 			Entity: "info",
 			Action: "read",
@@ -391,6 +407,14 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ListInboundReservations": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/CancelInboundReservation": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ListChannels": {{
 			Entity: "offchain",
 			Action: "read",
@@ -451,6 +475,26 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ExportGraph": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ImportGraph": {{
+			Entity: "info",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/GetRapidSyncSnapshot": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ApplyRapidSyncSnapshot": {{
+			Entity: "info",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/GossipStats": {{
+			Entity: "info",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/GetChanInfo": {{
 			Entity: "info",
 			Action: "read",
@@ -577,6 +621,14 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SendOnionMessage": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeOnionMessages": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 	}
 }
 
@@ -747,7 +799,8 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 		routerBackend, s.nodeSigner, s.graphDB, s.chanStateDB,
 		s.sweeper, tower, s.towerClient, s.anchorTowerClient,
 		r.cfg.net.ResolveTCPAddr, genInvoiceFeatures,
-		genAmpInvoiceFeatures, rpcsLog,
+		genAmpInvoiceFeatures, s.aliasMgr.GetPeerAlias, s.offerManager,
+		s.rebalancer, rpcsLog,
 	)
 	if err != nil {
 		return err
@@ -2021,6 +2074,37 @@ func (r *rpcServer) parseOpenChannelReq(in *lnrpc.OpenChannelRequest,
 			in.CommitmentType)
 	}
 
+	// The zero-conf and scid-alias bits are added on top of an explicit
+	// commitment type, so we require one to be set.
+	if in.ZeroConf || in.ScidAlias {
+		if channelType == nil {
+			return nil, fmt.Errorf("zero-conf and scid-alias " +
+				"channels require an explicit commitment type")
+		}
+
+		channelFeatures := lnwire.RawFeatureVector(*channelType)
+		if in.ZeroConf {
+			if !r.cfg.ProtocolOptions.ZeroConf() {
+				return nil, fmt.Errorf("cannot open zero-conf " +
+					"channel without --protocol.zero-conf")
+			}
+			channelFeatures.Set(lnwire.ZeroConfRequired)
+		}
+		if in.ScidAlias {
+			if !r.cfg.ProtocolOptions.ScidAlias() {
+				return nil, fmt.Errorf("cannot open " +
+					"scid-alias channel without " +
+					"--protocol.option-scid-alias")
+			}
+			if !in.Private {
+				return nil, fmt.Errorf("scid-alias channels " +
+					"must be private")
+			}
+			channelFeatures.Set(lnwire.ScidAliasRequired)
+		}
+		*channelType = lnwire.ChannelType(channelFeatures)
+	}
+
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
@@ -2406,10 +2490,19 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 			}
 		}
 
+		// If a closing transaction was broadcast already, the link was
+		// removed from the switch. With the simple close protocol, the
+		// request is then sent to the peer directly to bump the fee of
+		// the closing transaction.
+		coopBroadcasted := channel.HasChanStatus(
+			channeldb.ChanStatusCoopBroadcasted,
+		)
+
 		// If the link is not known by the switch, we cannot gracefully close
 		// the channel.
 		channelID := lnwire.NewChanIDFromOutPoint(chanPoint)
-		if _, err := r.server.htlcSwitch.GetLink(channelID); err != nil {
+		_, err := r.server.htlcSwitch.GetLink(channelID)
+		if err != nil && !coopBroadcasted {
 			rpcsLog.Debugf("Trying to non-force close offline channel with "+
 				"chan_point=%v", chanPoint)
 			return fmt.Errorf("unable to gracefully close channel while peer "+
@@ -2462,10 +2555,29 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 			}
 		}
 
-		updateChan, errChan = r.server.htlcSwitch.CloseLink(
-			chanPoint, contractcourt.CloseRegular, feeRate,
-			deliveryScript,
-		)
+		if !coopBroadcasted {
+			updateChan, errChan = r.server.htlcSwitch.CloseLink(
+				chanPoint, contractcourt.CloseRegular, feeRate,
+				deliveryScript,
+			)
+		} else {
+			chanPeer, err := r.server.FindPeer(channel.IdentityPub)
+			if err != nil {
+				return fmt.Errorf("unable to bump closing fee "+
+					"while peer is offline: %v", err)
+			}
+
+			updateChan = make(chan interface{}, 2)
+			errChan = make(chan error, 1)
+			chanPeer.HandleLocalCloseChanReqs(&htlcswitch.ChanClose{
+				CloseType:      contractcourt.CloseRegular,
+				ChanPoint:      chanPoint,
+				Updates:        updateChan,
+				TargetFeePerKw: feeRate,
+				DeliveryScript: deliveryScript,
+				Err:            errChan,
+			})
+		}
 	}
 out:
 	for {
@@ -2679,6 +2791,81 @@ func (r *rpcServer) AbandonChannel(_ context.Context,
 	return &lnrpc.AbandonChannelResponse{}, nil
 }
 
+// SpliceChannel adds funds to or removes funds from an existing channel by
+// negotiating a splice transaction with the channel peer. The call returns once
+// the splice transaction has been broadcast.
+func (r *rpcServer) SpliceChannel(ctx context.Context,
+	in *lnrpc.SpliceChannelRequest) (*lnrpc.SpliceChannelResponse, error) {
+
+	if in.Amount == 0 {
+		return nil, fmt.Errorf("splice amount must be non-zero")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.GetChannelPoint())
+	if err != nil {
+		return nil, err
+	}
+	index := in.ChannelPoint.OutputIndex
+	chanPoint := wire.NewOutPoint(txid, index)
+
+	dbChan, err := r.server.chanStateDB.FetchChannel(nil, *chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Splicing requires the cooperation of the channel peer, so it needs
+	// to be online.
+	peer, err := r.server.FindPeer(dbChan.IdentityPub)
+	if err != nil {
+		return nil, fmt.Errorf("unable to splice channel while peer "+
+			"is offline: %v", err)
+	}
+
+	// Based on the passed fee related parameters, we'll determine an
+	// appropriate fee rate for the splice transaction.
+	feeRate, err := calculateFeeRate(
+		0, in.SatPerVbyte, uint32(in.TargetConf),
+		r.server.cc.FeeEstimator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[splicechannel] splicing ChannelPoint(%v) with "+
+		"amount=%v, fee_rate=%v", chanPoint, in.Amount, feeRate)
+
+	req := &chansplicer.ChanSplice{
+		ChanPoint:    chanPoint,
+		Contribution: ltcutil.Amount(in.Amount),
+		FeeRate:      feeRate,
+		Done:         make(chan *wire.MsgTx, 1),
+		Err:          make(chan error, 1),
+	}
+	peer.HandleLocalSpliceChanReqs(req)
+
+	select {
+	case spliceTx := <-req.Done:
+		spliceTxid := spliceTx.TxHash()
+		rpcsLog.Infof("[splicechannel] splice of ChannelPoint(%v) "+
+			"broadcast: txid(%v)", chanPoint, spliceTxid)
+
+		return &lnrpc.SpliceChannelResponse{
+			Txid: spliceTxid.String(),
+		}, nil
+
+	case err := <-req.Err:
+		rpcsLog.Errorf("[splicechannel] unable to splice "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return nil, err
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-r.quit:
+		return nil, fmt.Errorf("server shutting down")
+	}
+}
+
 // GetInfo returns general information concerning the lightning node including
 // its identity pubkey, alias, the chains it is connected to, and information
 // concerning the number of open+pending channels.
@@ -3572,6 +3759,69 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 	return resp, nil
 }
 
+// ListInboundReservations lists the channels that remote peers are currently
+// negotiating to open with us.
+func (r *rpcServer) ListInboundReservations(ctx context.Context,
+	_ *lnrpc.ListInboundReservationsRequest) (
+	*lnrpc.ListInboundReservationsResponse, error) {
+
+	reservations := r.server.fundingMgr.InboundReservations()
+
+	resp := &lnrpc.ListInboundReservationsResponse{
+		Reservations: make(
+			[]*lnrpc.InboundReservation, 0, len(reservations),
+		),
+	}
+	for _, res := range reservations {
+		var lastUpdated int64
+		if !res.LastUpdated.IsZero() {
+			lastUpdated = res.LastUpdated.Unix()
+		}
+
+		pendingChanID := res.PendingChanID
+		resp.Reservations = append(
+			resp.Reservations, &lnrpc.InboundReservation{
+				RemoteNodePub: hex.EncodeToString(
+					res.Peer.SerializeCompressed(),
+				),
+				PendingChanId: pendingChanID[:],
+				Capacity:      int64(res.Capacity),
+				LastUpdated:   lastUpdated,
+				Reputation:    res.Reputation,
+			},
+		)
+	}
+
+	// Sort the reservations by their age to get a stable output.
+	sort.Slice(resp.Reservations, func(i, j int) bool {
+		return resp.Reservations[i].LastUpdated <
+			resp.Reservations[j].LastUpdated
+	})
+
+	return resp, nil
+}
+
+// CancelInboundReservation cancels the reservation of a channel a remote peer
+// is negotiating to open with us, and fails the funding flow with the peer.
+func (r *rpcServer) CancelInboundReservation(ctx context.Context,
+	in *lnrpc.CancelInboundReservationRequest) (
+	*lnrpc.CancelInboundReservationResponse, error) {
+
+	if len(in.PendingChanId) != 32 {
+		return nil, fmt.Errorf("pending chan ID must be 32 bytes")
+	}
+
+	var pendingChanID [32]byte
+	copy(pendingChanID[:], in.PendingChanId)
+
+	err := r.server.fundingMgr.CancelInboundReservation(pendingChanID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.CancelInboundReservationResponse{}, nil
+}
+
 // arbitratorPopulateForceCloseResp populates the pending channels response
 // message with channel resolution information from the contract resolvers.
 func (r *rpcServer) arbitratorPopulateForceCloseResp(chanPoint *wire.OutPoint,
@@ -5177,26 +5427,7 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 func (r *rpcServer) AddInvoice(ctx context.Context,
 	invoice *lnrpc.Invoice) (*lnrpc.AddInvoiceResponse, error) {
 
-	defaultDelta := r.cfg.Bitcoin.TimeLockDelta
-	if r.cfg.registeredChains.PrimaryChain() == chainreg.LitecoinChain {
-		defaultDelta = r.cfg.Litecoin.TimeLockDelta
-	}
-
-	addInvoiceCfg := &invoicesrpc.AddInvoiceConfig{
-		AddInvoice:        r.server.invoices.AddInvoice,
-		IsChannelActive:   r.server.htlcSwitch.HasActiveLink,
-		ChainParams:       r.cfg.ActiveNetParams.Params,
-		NodeSigner:        r.server.nodeSigner,
-		DefaultCLTVExpiry: defaultDelta,
-		ChanDB:            r.server.chanStateDB,
-		Graph:             r.server.graphDB,
-		GenInvoiceFeatures: func() *lnwire.FeatureVector {
-			return r.server.featureMgr.Get(feature.SetInvoice)
-		},
-		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
-			return r.server.featureMgr.Get(feature.SetInvoiceAmp)
-		},
-	}
+	addInvoiceCfg := r.server.addInvoiceConfig()
 
 	value, err := lnrpc.UnmarshallAmt(invoice.Value, invoice.ValueMsat)
 	if err != nil {
@@ -5218,6 +5449,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		Private:         invoice.Private,
 		RouteHints:      routeHints,
 		Amp:             invoice.IsAmp,
+		Blind:           invoice.IsBlinded,
 	}
 
 	if invoice.RPreimage != nil {
@@ -5317,7 +5549,7 @@ func (r *rpcServer) ListInvoices(ctx context.Context,
 		PendingOnly:    req.PendingOnly,
 		Reversed:       req.Reversed,
 	}
-	invoiceSlice, err := r.server.miscDB.QueryInvoices(q)
+	invoiceSlice, err := r.server.invoiceDB.QueryInvoices(q)
 	if err != nil {
 		return nil, fmt.Errorf("unable to query invoices: %v", err)
 	}
@@ -5671,6 +5903,125 @@ func (r *rpcServer) GetNodeMetrics(ctx context.Context,
 	return resp, nil
 }
 
+// ExportGraph returns a snapshot of all announced channels, their policies and
+// the announcements of their nodes, that can be imported by another node.
+func (r *rpcServer) ExportGraph(ctx context.Context,
+	_ *lnrpc.ExportGraphRequest) (*lnrpc.ExportGraphResponse, error) {
+
+	var snapshot bytes.Buffer
+	stats, err := r.server.graphDB.ExportSnapshot(&snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("unable to export graph: %v", err)
+	}
+
+	return &lnrpc.ExportGraphResponse{
+		Snapshot:    snapshot.Bytes(),
+		NumNodes:    uint32(stats.NumNodes),
+		NumChannels: uint32(stats.NumChannels),
+		NumPolicies: uint32(stats.NumPolicies),
+	}, nil
+}
+
+// ImportGraph verifies the records of a graph snapshot and adds the ones that
+// are new to us to the graph.
+func (r *rpcServer) ImportGraph(ctx context.Context,
+	req *lnrpc.ImportGraphRequest) (*lnrpc.ImportGraphResponse, error) {
+
+	if len(req.Snapshot) == 0 {
+		return nil, fmt.Errorf("snapshot must be set")
+	}
+
+	stats, err := r.server.chanRouter.ImportGraphSnapshot(
+		bytes.NewReader(req.Snapshot),
+		*r.cfg.ActiveNetParams.GenesisHash,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import graph: %v", err)
+	}
+
+	return &lnrpc.ImportGraphResponse{
+		NumNodesAdded:    uint32(stats.NumNodes),
+		NumChannelsAdded: uint32(stats.NumChannels),
+		NumPoliciesAdded: uint32(stats.NumPolicies),
+	}, nil
+}
+
+// GetRapidSyncSnapshot returns a signed, compact snapshot of the channels and
+// policies of our graph that were updated since the given time.
+func (r *rpcServer) GetRapidSyncSnapshot(ctx context.Context,
+	req *lnrpc.RapidSyncSnapshotRequest) (*lnrpc.RapidSyncSnapshotResponse,
+	error) {
+
+	var since time.Time
+	if req.Since != 0 {
+		since = time.Unix(int64(req.Since), 0)
+	}
+
+	snapshot, timestamp, err := r.server.rapidSync.CreateSnapshot(since)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create rapid sync snapshot: "+
+			"%v", err)
+	}
+
+	return &lnrpc.RapidSyncSnapshotResponse{
+		Snapshot:  snapshot,
+		Timestamp: uint64(timestamp.Unix()),
+	}, nil
+}
+
+// ApplyRapidSyncSnapshot adds the channels and policies of a snapshot created
+// by one of our trusted sources to the graph.
+func (r *rpcServer) ApplyRapidSyncSnapshot(ctx context.Context,
+	req *lnrpc.ApplyRapidSyncSnapshotRequest) (
+	*lnrpc.ApplyRapidSyncSnapshotResponse, error) {
+
+	if len(req.Snapshot) == 0 {
+		return nil, fmt.Errorf("snapshot must be set")
+	}
+
+	stats, err := r.server.rapidSync.ApplySnapshot(req.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("unable to apply rapid sync snapshot: "+
+			"%v", err)
+	}
+
+	return &lnrpc.ApplyRapidSyncSnapshotResponse{
+		NumChannelsAdded:   uint32(stats.NumChannels),
+		NumPoliciesUpdated: uint32(stats.NumPolicies),
+		NumNodesUpdated:    uint32(stats.NumNodes),
+		Timestamp:          uint64(stats.Timestamp.Unix()),
+	}, nil
+}
+
+// GossipStats returns the gossip traffic we've exchanged with each connected
+// peer.
+func (r *rpcServer) GossipStats(ctx context.Context,
+	req *lnrpc.GossipStatsRequest) (*lnrpc.GossipStatsResponse, error) {
+
+	stats := r.server.authGossiper.SyncManager().GossipStats()
+
+	resp := &lnrpc.GossipStatsResponse{
+		Peers: make([]*lnrpc.PeerGossipStats, 0, len(stats)),
+	}
+	for peer, peerStats := range stats {
+		resp.Peers = append(resp.Peers, &lnrpc.PeerGossipStats{
+			PubKey:          peer.String(),
+			MsgsReceived:    peerStats.MsgsReceived,
+			BytesReceived:   peerStats.BytesReceived,
+			MsgsRateLimited: peerStats.MsgsRateLimited,
+			MsgsSent:        peerStats.MsgsSent,
+			BytesSent:       peerStats.BytesSent,
+		})
+	}
+
+	// Sort the peers by their pubkey to get a stable output.
+	sort.Slice(resp.Peers, func(i, j int) bool {
+		return resp.Peers[i].PubKey < resp.Peers[j].PubKey
+	})
+
+	return resp, nil
+}
+
 // GetChanInfo returns the latest authenticated network announcement for the
 // given channel identified by its channel ID: an 8-byte integer which uniquely
 // identifies the location of transaction's funding output within the block
@@ -6105,6 +6456,8 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 		MaxPayments:       req.MaxPayments,
 		Reversed:          req.Reversed,
 		IncludeIncomplete: req.IncludeIncomplete,
+		CreationDateStart: int64(req.CreationDateStart),
+		CreationDateEnd:   int64(req.CreationDateEnd),
 	}
 
 	// If the maximum number of payments wasn't specified, then we'll
@@ -6113,7 +6466,7 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 		query.MaxPayments = math.MaxUint64
 	}
 
-	paymentsQuerySlice, err := r.server.miscDB.QueryPayments(query)
+	paymentsQuerySlice, err := r.server.paymentDB.QueryPayments(query)
 	if err != nil {
 		return nil, err
 	}
@@ -6154,7 +6507,7 @@ func (r *rpcServer) DeletePayment(ctx context.Context,
 	rpcsLog.Infof("[DeletePayment] payment_identifier=%v, "+
 		"failed_htlcs_only=%v", hash, req.FailedHtlcsOnly)
 
-	err = r.server.miscDB.DeletePayment(hash, req.FailedHtlcsOnly)
+	err = r.server.paymentDB.DeletePayment(hash, req.FailedHtlcsOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -6171,7 +6524,7 @@ func (r *rpcServer) DeleteAllPayments(ctx context.Context,
 		"failed_htlcs_only=%v", req.FailedPaymentsOnly,
 		req.FailedHtlcsOnly)
 
-	err := r.server.miscDB.DeletePayments(
+	err := r.server.paymentDB.DeletePayments(
 		req.FailedPaymentsOnly, req.FailedHtlcsOnly,
 	)
 	if err != nil {
@@ -7541,6 +7894,125 @@ func (r *rpcServer) SubscribeCustomMessages(req *lnrpc.SubscribeCustomMessagesRe
 	}
 }
 
+// SendOnionMessage sends an onion message over a path of nodes, a blinded path
+// or a combination of both.
+func (r *rpcServer) SendOnionMessage(ctx context.Context,
+	req *lnrpc.SendOnionMessageRequest) (*lnrpc.SendOnionMessageResponse,
+	error) {
+
+	if r.server.onionMessenger == nil {
+		return nil, errOnionMessagesDisabled
+	}
+
+	msg := &onionmessage.OutgoingMessage{
+		FinalHopRecords: make(map[tlv.Type][]byte, len(req.Records)),
+	}
+
+	for _, nodeBytes := range req.Path {
+		node, err := btcec.ParsePubKey(nodeBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node in path: %v", err)
+		}
+
+		msg.Path = append(msg.Path, node)
+	}
+
+	if len(req.BlindedDestination) > 0 {
+		var err error
+		msg.BlindedDestination, err = blindedpath.DecodeBlindedPath(
+			bytes.NewReader(req.BlindedDestination),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blinded "+
+				"destination: %v", err)
+		}
+	}
+
+	if req.IncludeReplyPath {
+		var err error
+		msg.ReplyPath, err = r.server.onionMessenger.NewReplyPath(
+			req.ReplyPathId,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for recordType, value := range req.Records {
+		if tlv.Type(recordType) < onionmessage.MinFinalHopType {
+			return nil, fmt.Errorf("record type %d is reserved, "+
+				"types must be at least %d", recordType,
+				onionmessage.MinFinalHopType)
+		}
+
+		msg.FinalHopRecords[tlv.Type(recordType)] = value
+	}
+
+	err := r.server.onionMessenger.SendMessage(msg)
+	switch {
+	case err == ErrPeerNotConnected:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	return &lnrpc.SendOnionMessageResponse{}, nil
+}
+
+// SubscribeOnionMessages subscribes to a stream of onion messages that are
+// addressed to this node.
+func (r *rpcServer) SubscribeOnionMessages(
+	req *lnrpc.SubscribeOnionMessagesRequest,
+	server lnrpc.Lightning_SubscribeOnionMessagesServer) error {
+
+	if r.server.onionMessenger == nil {
+		return errOnionMessagesDisabled
+	}
+
+	client, err := r.server.onionMessenger.SubscribeMessages()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case <-client.Quit():
+			return errors.New("shutdown")
+
+		case <-server.Context().Done():
+			return server.Context().Err()
+
+		case update := <-client.Updates():
+			onionMsg := update.(*onionmessage.ReceivedMessage)
+
+			rpcMsg := &lnrpc.OnionMessageUpdate{
+				Peer:    onionMsg.Peer[:],
+				PathId:  onionMsg.PathID,
+				Records: make(map[uint64][]byte),
+			}
+
+			if onionMsg.ReplyPath != nil {
+				var b bytes.Buffer
+				err := onionMsg.ReplyPath.Encode(&b)
+				if err != nil {
+					return err
+				}
+				rpcMsg.ReplyPath = b.Bytes()
+			}
+
+			records := onionMsg.FinalHopRecords
+			for recordType, value := range records {
+				rpcMsg.Records[uint64(recordType)] = value
+			}
+
+			if err := server.Send(rpcMsg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // rpcInitiator returns the correct lnrpc initiator for channels where we have
 // a record of the opening channel.
 func rpcInitiator(isInitiator bool) lnrpc.Initiator {
@@ -19,6 +19,7 @@ import (
 
 	"github.com/go-errors/errors"
 	sphinx "github.com/ltcsuite/lightning-onion"
+	"github.com/ltcsuite/lnd/aliasmgr"
 	"github.com/ltcsuite/lnd/autopilot"
 	"github.com/ltcsuite/lnd/brontide"
 	"github.com/ltcsuite/lnd/cert"
@@ -31,7 +32,9 @@ import (
 	"github.com/ltcsuite/lnd/clock"
 	"github.com/ltcsuite/lnd/contractcourt"
 	"github.com/ltcsuite/lnd/discovery"
+	"github.com/ltcsuite/lnd/discovery/rapidsync"
 	"github.com/ltcsuite/lnd/feature"
+	"github.com/ltcsuite/lnd/feepolicy"
 	"github.com/ltcsuite/lnd/funding"
 	"github.com/ltcsuite/lnd/healthcheck"
 	"github.com/ltcsuite/lnd/htlcswitch"
@@ -43,17 +46,22 @@ import (
 	"github.com/ltcsuite/lnd/lncfg"
 	"github.com/ltcsuite/lnd/lnpeer"
 	"github.com/ltcsuite/lnd/lnrpc"
+	"github.com/ltcsuite/lnd/lnrpc/invoicesrpc"
 	"github.com/ltcsuite/lnd/lnrpc/routerrpc"
+	"github.com/ltcsuite/lnd/lntypes"
 	"github.com/ltcsuite/lnd/lnwallet"
 	"github.com/ltcsuite/lnd/lnwallet/chainfee"
 	"github.com/ltcsuite/lnd/lnwallet/rpcwallet"
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/nat"
 	"github.com/ltcsuite/lnd/netann"
+	"github.com/ltcsuite/lnd/offers"
+	"github.com/ltcsuite/lnd/onionmessage"
 	"github.com/ltcsuite/lnd/peer"
 	"github.com/ltcsuite/lnd/peernotifier"
 	"github.com/ltcsuite/lnd/pool"
 	"github.com/ltcsuite/lnd/queue"
+	"github.com/ltcsuite/lnd/rebalancer"
 	"github.com/ltcsuite/lnd/routing"
 	"github.com/ltcsuite/lnd/routing/localchans"
 	"github.com/ltcsuite/lnd/routing/route"
@@ -66,7 +74,9 @@ import (
 	"github.com/ltcsuite/lnd/watchtower/wtclient"
 	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
 	"github.com/ltcsuite/lnd/watchtower/wtserver"
+	"github.com/ltcsuite/lnd/zpay32"
 	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/btcec/v2/schnorr"
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 	"github.com/ltcsuite/ltcd/connmgr"
 	"github.com/ltcsuite/ltcd/ltcutil"
@@ -243,12 +253,25 @@ type server struct {
 
 	chanStateDB *channeldb.ChannelStateDB
 
+	// aliasMgr manages the alias short channel IDs of zero-conf and
+	// option-scid-alias channels.
+	aliasMgr *aliasmgr.Manager
+
 	addrSource chanbackup.AddressSource
 
 	// miscDB is the DB that contains all "other" databases within the main
 	// channel DB that haven't been separated out yet.
 	miscDB *channeldb.DB
 
+	// invoiceDB is the DB that stores our invoices, which is either the
+	// miscDB or the native SQL invoice store.
+	invoiceDB invoices.InvoiceDB
+
+	// paymentDB is the DB that stores our outgoing payments, which is
+	// either a payment control on top of the miscDB or the native SQL
+	// payment store.
+	paymentDB routing.PaymentDB
+
 	htlcSwitch *htlcswitch.Switch
 
 	interceptableSwitch *htlcswitch.InterceptableSwitch
@@ -273,8 +296,14 @@ type server struct {
 
 	authGossiper *discovery.AuthenticatedGossiper
 
+	rapidSync *rapidsync.Manager
+
 	localChanMgr *localchans.Manager
 
+	// feePolicyEngine updates the fees of our channels based on their
+	// balance and forwarding volume. It is nil if it isn't active.
+	feePolicyEngine *feepolicy.Engine
+
 	utxoNursery *contractcourt.UtxoNursery
 
 	sweeper *sweep.UtxoSweeper
@@ -317,6 +346,10 @@ type server struct {
 	// provide insights into their health and performance.
 	chanEventStore *chanfitness.ChannelEventStore
 
+	// rebalancer moves liquidity between our channels by paying to
+	// ourselves in a circle.
+	rebalancer *rebalancer.Rebalancer
+
 	hostAnn *netann.HostAnnouncer
 
 	// livelinessMonitor monitors that lnd has access to critical resources.
@@ -324,6 +357,15 @@ type server struct {
 
 	customMessageServer *subscribe.Server
 
+	// onionMessenger relays onion messages for our peers and delivers the
+	// ones addressed to us. It is nil if onion messages are disabled.
+	onionMessenger *onionmessage.Messenger
+
+	// offerManager creates BOLT 12 offers, answers invoice requests for
+	// them and fetches invoices for the offers of others. It is nil if
+	// onion messages are disabled.
+	offerManager *offers.Manager
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -536,6 +578,13 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		NoAnchors:                cfg.ProtocolOptions.NoAnchorCommitments(),
 		NoWumbo:                  !cfg.ProtocolOptions.Wumbo(),
 		NoScriptEnforcementLease: cfg.ProtocolOptions.NoScriptEnforcementLease(),
+		NoOptionScidAlias:        !cfg.ProtocolOptions.ScidAlias(),
+		NoZeroConf:               !cfg.ProtocolOptions.ZeroConf(),
+		NoRouteBlinding:          cfg.ProtocolOptions.NoRouteBlinding(),
+		NoOnionMessages:          cfg.ProtocolOptions.NoOnionMessages(),
+		NoDualFund:               !cfg.ProtocolOptions.DualFund(),
+		NoSplice:                 !cfg.ProtocolOptions.Splice(),
+		NoSimpleClose:            !cfg.ProtocolOptions.SimpleClose(),
 	})
 	if err != nil {
 		return nil, err
@@ -552,12 +601,20 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		KeysendHoldTime:             cfg.KeysendHoldTime,
 	}
 
+	aliasMgr, err := aliasmgr.NewManager(dbs.ChanStateDB)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &server{
 		cfg:            cfg,
+		aliasMgr:       aliasMgr,
 		graphDB:        dbs.GraphDB.ChannelGraph(),
 		chanStateDB:    dbs.ChanStateDB.ChannelStateDB(),
 		addrSource:     dbs.ChanStateDB,
 		miscDB:         dbs.ChanStateDB,
+		invoiceDB:      dbs.InvoiceDB,
+		paymentDB:      dbs.PaymentDB,
 		cc:             cc,
 		sigPool:        lnwallet.NewSigPool(cfg.Workers.Sig, cc.Signer),
 		writePool:      writePool,
@@ -576,7 +633,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 
 		// TODO(roasbeef): derive proper onion key based on rotation
 		// schedule
-		sphinx: hop.NewOnionProcessor(sphinxRouter),
+		sphinx: hop.NewOnionProcessor(sphinxRouter, nodeKeyECDH),
 
 		torController: torController,
 
@@ -602,6 +659,27 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		quit:       make(chan struct{}),
 	}
 
+	if !cfg.ProtocolOptions.NoOnionMessages() {
+		s.onionMessenger = onionmessage.New(&onionmessage.Config{
+			NodeKey:          nodeKeyECDH,
+			OnionRouter:      sphinxRouter,
+			SendToPeer:       s.sendOnionMessage,
+			FetchChannelPeer: s.fetchChannelPeer,
+		})
+
+		s.offerManager = offers.NewManager(&offers.Config{
+			NodeKey:           nodeKeyDesc.PubKey,
+			ChainParams:       cfg.ActiveNetParams.Params,
+			DB:                dbs.ChanStateDB,
+			AddInvoice:        s.addOfferInvoice,
+			SignInvoice:       s.signOfferInvoice(nodeKeyDesc),
+			SendMessage:       s.onionMessenger.SendMessage,
+			SubscribeMessages: s.onionMessenger.SubscribeMessages,
+			NewReplyPath:      s.onionMessenger.NewReplyPath,
+			Clock:             clock.NewDefaultClock(),
+		})
+	}
+
 	s.witnessBeacon = &preimageBeacon{
 		wCache:      dbs.ChanStateDB.NewWitnessCache(),
 		subscribers: make(map[uint64]*preimageSubscriber),
@@ -617,7 +695,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		uint32(currentHeight), currentHash, cc.ChainNotifier,
 	)
 	s.invoices = invoices.NewRegistry(
-		dbs.ChanStateDB, expiryWatcher, &registryConfig,
+		dbs.InvoiceDB, expiryWatcher, &registryConfig,
 	)
 
 	s.htlcNotifier = htlcswitch.NewHtlcNotifier(time.Now)
@@ -657,11 +735,19 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		Clock:                  clock.NewDefaultClock(),
 		HTLCExpiry:             htlcswitch.DefaultHTLCExpiry,
 		DustThreshold:          thresholdMSats,
+		GetAliases:             s.aliasMgr.GetAliases,
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
 	}
-	s.interceptableSwitch = htlcswitch.NewInterceptableSwitch(s.htlcSwitch)
+	s.interceptableSwitch = htlcswitch.NewInterceptableSwitch(
+		&htlcswitch.InterceptableSwitchConfig{
+			Switch:             s.htlcSwitch,
+			Notifier:           s.cc.ChainNotifier,
+			RequireInterceptor: cfg.RequireInterceptor,
+			HeldFailDelta:      cfg.HeldForwardFailDelta,
+		},
+	)
 
 	chanStatusMgrCfg := &netann.ChanStatusConfig{
 		ChanStatusSampleInterval: cfg.ChanStatusSampleInterval,
@@ -888,9 +974,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		PathFindingConfig: pathFindingConfig,
 	}
 
-	paymentControl := channeldb.NewPaymentControl(dbs.ChanStateDB)
-
-	s.controlTower = routing.NewControlTower(paymentControl)
+	s.controlTower = routing.NewControlTower(dbs.PaymentDB)
 
 	strictPruning := (cfg.Bitcoin.Node == "neutrino" ||
 		cfg.Routing.StrictZombiePruning)
@@ -917,6 +1001,36 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		return nil, fmt.Errorf("can't create router: %v", err)
 	}
 
+	s.rapidSync = rapidsync.NewManager(&rapidsync.Config{
+		Graph:          chanGraph,
+		ChainHash:      *s.cfg.ActiveNetParams.GenesisHash,
+		SelfKey:        serializedPubKey,
+		Serve:          cfg.RapidSync.Serve,
+		KeyLocator:     nodeKeyDesc.KeyLocator,
+		Signer:         s.nodeSigner,
+		TrustedSources: cfg.RapidSync.TrustedSources,
+		WatchChannels:  s.chanRouter.WatchChannels,
+	})
+
+	defaultDelta := cfg.Bitcoin.TimeLockDelta
+	if cfg.registeredChains.PrimaryChain() == chainreg.LitecoinChain {
+		defaultDelta = cfg.Litecoin.TimeLockDelta
+	}
+	s.rebalancer = rebalancer.New(&rebalancer.Config{
+		Self:              route.Vertex(serializedPubKey),
+		FetchChannels:     s.rebalancerChannels,
+		FindRoute:         s.chanRouter.FindRoute,
+		ProbabilitySource: s.missionControl.GetProbability,
+		SendToRoute:       s.chanRouter.SendToRouteWithLabel,
+		AddInvoice:        s.invoices.AddInvoice,
+		CancelInvoice:     s.invoices.CancelInvoice,
+		InvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoice)
+		},
+		FinalCltvDelta: uint16(defaultDelta),
+		CltvLimit:      cfg.MaxOutgoingCltvExpiry,
+	})
+
 	chanSeries := discovery.NewChanSeries(s.graphDB)
 	gossipMessageStore, err := discovery.NewMessageStore(dbs.ChanStateDB)
 	if err != nil {
@@ -954,6 +1068,9 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		PinnedSyncers:           cfg.Gossip.PinnedSyncers,
 		MaxChannelUpdateBurst:   cfg.Gossip.MaxChannelUpdateBurst,
 		ChannelUpdateInterval:   cfg.Gossip.ChannelUpdateInterval,
+		PeerMsgRateLimit:        cfg.Gossip.PeerMsgRateLimit,
+		PeerMsgBurst:            cfg.Gossip.PeerMsgBurst,
+		FindBaseByAlias:         s.findBaseByAlias,
 	}, nodeKeyDesc)
 
 	s.localChanMgr = &localchans.Manager{
@@ -963,6 +1080,19 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		FetchChannel:              s.chanStateDB.FetchChannel,
 	}
 
+	if cfg.FeePolicy.Policy != nil {
+		feeCfg := cfg.FeePolicy
+		s.feePolicyEngine = feepolicy.New(&feepolicy.Config{
+			Policy:                 feeCfg.Policy,
+			ForAllOutgoingChannels: s.chanRouter.ForAllOutgoingChannels,
+			FetchChannel:           s.chanStateDB.FetchChannel,
+			QueryForwardingLog:     s.miscDB.ForwardingLog().Query,
+			UpdatePolicy:           s.localChanMgr.UpdatePolicy,
+			Clock:                  clock.NewDefaultClock(),
+			UpdateTicker:           ticker.New(feeCfg.Interval),
+		})
+	}
+
 	utxnStore, err := contractcourt.NewNurseryStore(
 		s.cfg.ActiveNetParams.GenesisHash, dbs.ChanStateDB,
 	)
@@ -1160,6 +1290,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		UpdateLabel: func(hash chainhash.Hash, label string) error {
 			return cc.Wallet.LabelTransaction(hash, label, true)
 		},
+		FetchTx:      s.fetchWalletTx,
 		Notifier:     cc.ChainNotifier,
 		FeeEstimator: cc.FeeEstimator,
 		SignMessage:  cc.MsgSigner.SignMessage,
@@ -1323,6 +1454,8 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		MinChanSize:                   ltcutil.Amount(cfg.MinChanSize),
 		MaxChanSize:                   ltcutil.Amount(cfg.MaxChanSize),
 		MaxPendingChannels:            cfg.MaxPendingChannels,
+		MaxPendingInbound:             cfg.MaxPendingInbound,
+		PendingFlapPenalty:            cfg.PendingFlapPenalty,
 		RejectPush:                    cfg.RejectPush,
 		MaxLocalCSVDelay:              chainCfg.MaxLocalDelay,
 		NotifyOpenChannelEvent:        s.channelNotifier.NotifyOpenChannelEvent,
@@ -1332,6 +1465,18 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		RegisteredChains:              cfg.registeredChains,
 		MaxAnchorsCommitFeeRate: chainfee.SatPerKVByte(
 			s.cfg.MaxCommitFeeRateAnchors * 1000).FeePerKWeight(),
+		AliasManager:    s.aliasMgr,
+		AddAliasForLink: s.htlcSwitch.AddAliasForLink,
+		DeleteAliasEdge: func(scid lnwire.ShortChannelID) error {
+			return s.graphDB.DeleteChannelEdges(
+				false, scid.ToUint64(),
+			)
+		},
+		FlapCount: func(peer route.Vertex) (int, *time.Time,
+			error) {
+
+			return s.chanEventStore.FlapCount(peer)
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -1388,6 +1533,23 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			policy.SweepFeeRate = sweepRateSatPerVByte.FeePerKWeight()
 		}
 
+		// If reward sessions are enabled, the configured maximum reward
+		// is used as the cap of the terms negotiated with towers.
+		if cfg.WtClient.Reward {
+			policy.BlobType = blob.TypeRewardCommit
+			policy.RewardBase = cfg.WtClient.MaxRewardBase
+			policy.RewardRate = cfg.WtClient.MaxRewardRate
+			if policy.RewardRate == 0 {
+				policy.RewardRate = wtpolicy.DefaultRewardRate
+			}
+		}
+
+		// If enabled, the justice kits sent to towers also contain the
+		// signatures needed to sweep revoked HTLC outputs.
+		if cfg.WtClient.BackupHtlcs {
+			policy.BlobType |= blob.Type(blob.FlagHtlcOutputs)
+		}
+
 		if err := policy.Validate(); err != nil {
 			return nil, err
 		}
@@ -1403,6 +1565,75 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			)
 		}
 
+		// The clients learn about closed channels through the channel
+		// notifier, so that they can delete sessions once all of their
+		// channels are closed.
+		subscribeChanEvents := s.channelNotifier.SubscribeChannelEvents
+
+		// They also catch up with the channels closed while they
+		// weren't running.
+		fetchClosedChannels := func() ([]*channeldb.ChannelCloseSummary,
+			error) {
+
+			return s.chanStateDB.FetchClosedChannels(false)
+		}
+
+		// The breach hints of updates acked before the clients indexed
+		// them by channel are recovered from the revoked states of the
+		// channels that are still open. The channels are only loaded
+		// once, as the hints of all such updates are recovered when
+		// the clients are started.
+		var (
+			chanCacheMtx sync.Mutex
+			chanCache    map[lnwire.ChannelID]*channeldb.OpenChannel
+		)
+		fetchBreachTxID := func(chanID lnwire.ChannelID,
+			commitHeight uint64) (*chainhash.Hash, error) {
+
+			chanCacheMtx.Lock()
+			defer chanCacheMtx.Unlock()
+
+			if chanCache == nil {
+				channels, err := s.chanStateDB.FetchAllChannels()
+				if err != nil {
+					return nil, err
+				}
+
+				chanCache = make(
+					map[lnwire.ChannelID]*channeldb.OpenChannel,
+				)
+				for _, channel := range channels {
+					id := lnwire.NewChanIDFromOutPoint(
+						&channel.FundingOutpoint,
+					)
+					chanCache[id] = channel
+				}
+			}
+
+			channel, ok := chanCache[chanID]
+			if !ok {
+				return nil, channeldb.ErrChannelNotFound
+			}
+
+			revokedState, err := channel.FindPreviousState(
+				commitHeight,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			breachTxID := revokedState.CommitTx.TxHash()
+			return &breachTxID, nil
+		}
+
+		// If enabled, the clients identify themselves to towers with
+		// the node key, so that hosted towers can apply their per
+		// client limits.
+		var clientKeySigner keychain.SingleKeyMessageSigner
+		if cfg.WtClient.Identify {
+			clientKeySigner = nodeKeySigner
+		}
+
 		s.towerClient, err = wtclient.New(&wtclient.Config{
 			Signer:         cc.Wallet.Cfg.Signer,
 			NewAddress:     newSweepPkScriptGen(cc.Wallet),
@@ -1415,6 +1646,15 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			MinBackoff:     10 * time.Second,
 			MaxBackoff:     5 * time.Minute,
 			ForceQuitDelay: wtclient.DefaultForceQuitDelay,
+
+			SubscribeChannelEvents: subscribeChanEvents,
+			FetchClosedChannels:    fetchClosedChannels,
+			FetchBreachTxID:        fetchBreachTxID,
+			ChainNotifier:          cc.ChainNotifier,
+			SessionCloseDelay:      cfg.WtClient.SessionCloseDelay,
+			ReplicationFactor:      cfg.WtClient.ReplicationFactor,
+			TowerFailureThreshold:  cfg.WtClient.TowerFailureThreshold,
+			ClientKeySigner:        clientKeySigner,
 		})
 		if err != nil {
 			return nil, err
@@ -1438,6 +1678,15 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			MinBackoff:     10 * time.Second,
 			MaxBackoff:     5 * time.Minute,
 			ForceQuitDelay: wtclient.DefaultForceQuitDelay,
+
+			SubscribeChannelEvents: subscribeChanEvents,
+			FetchClosedChannels:    fetchClosedChannels,
+			FetchBreachTxID:        fetchBreachTxID,
+			ChainNotifier:          cc.ChainNotifier,
+			SessionCloseDelay:      cfg.WtClient.SessionCloseDelay,
+			ReplicationFactor:      cfg.WtClient.ReplicationFactor,
+			TowerFailureThreshold:  cfg.WtClient.TowerFailureThreshold,
+			ClientKeySigner:        clientKeySigner,
 		})
 		if err != nil {
 			return nil, err
@@ -1663,6 +1912,22 @@ func (s *server) Start() error {
 		}
 		cleanup = cleanup.add(s.customMessageServer.Stop)
 
+		if s.onionMessenger != nil {
+			if err := s.onionMessenger.Start(); err != nil {
+				startErr = err
+				return
+			}
+			cleanup = cleanup.add(s.onionMessenger.Stop)
+		}
+
+		if s.offerManager != nil {
+			if err := s.offerManager.Start(); err != nil {
+				startErr = err
+				return
+			}
+			cleanup = cleanup.add(s.offerManager.Stop)
+		}
+
 		if s.hostAnn != nil {
 			if err := s.hostAnn.Start(); err != nil {
 				startErr = err
@@ -1802,6 +2067,12 @@ func (s *server) Start() error {
 		}
 		cleanup = cleanup.add(s.htlcSwitch.Stop)
 
+		if err := s.interceptableSwitch.Start(); err != nil {
+			startErr = err
+			return
+		}
+		cleanup = cleanup.add(s.interceptableSwitch.Stop)
+
 		if err := s.chanStatusMgr.Start(); err != nil {
 			startErr = err
 			return
@@ -1861,6 +2132,14 @@ func (s *server) Start() error {
 		}
 		cleanup = cleanup.add(s.chanSubSwapper.Stop)
 
+		if s.feePolicyEngine != nil {
+			if err := s.feePolicyEngine.Start(); err != nil {
+				startErr = err
+				return
+			}
+			cleanup = cleanup.add(s.feePolicyEngine.Stop)
+		}
+
 		if s.torController != nil {
 			if err := s.createNewHiddenService(); err != nil {
 				startErr = err
@@ -1992,6 +2271,10 @@ func (s *server) Stop() error {
 
 		// Shutdown the wallet, funding manager, and the rpc server.
 		s.chanStatusMgr.Stop()
+		if err := s.interceptableSwitch.Stop(); err != nil {
+			srvrLog.Warnf("failed to stop interceptable "+
+				"switch: %v", err)
+		}
 		if err := s.htlcSwitch.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop htlcSwitch: %v", err)
 		}
@@ -2001,6 +2284,7 @@ func (s *server) Stop() error {
 		if err := s.invoices.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop invoices: %v", err)
 		}
+		s.rebalancer.Stop()
 		if err := s.chanRouter.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop chanRouter: %v", err)
 		}
@@ -2034,6 +2318,24 @@ func (s *server) Stop() error {
 		if err := s.chanSubSwapper.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop chanSubSwapper: %v", err)
 		}
+		if s.feePolicyEngine != nil {
+			if err := s.feePolicyEngine.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop fee policy "+
+					"engine: %v", err)
+			}
+		}
+		if s.offerManager != nil {
+			if err := s.offerManager.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop offerManager: %v",
+					err)
+			}
+		}
+		if s.onionMessenger != nil {
+			if err := s.onionMessenger.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop onionMessenger: %v",
+					err)
+			}
+		}
 		if err := s.cc.ChainNotifier.Stop(); err != nil {
 			srvrLog.Warnf("Unable to stop ChainNotifier: %v", err)
 		}
@@ -3484,6 +3786,7 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		FetchLastChanUpdate: s.fetchLastChanUpdate(),
 
 		FundingManager: s.fundingMgr,
+		FetchTx:        s.fetchWalletTx,
 
 		Hodl:                    s.cfg.Hodl,
 		UnsafeReplay:            s.cfg.UnsafeReplay,
@@ -3498,6 +3801,10 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		Quit:                   s.quit,
 	}
 
+	if s.onionMessenger != nil {
+		pCfg.HandleOnionMessage = s.onionMessenger.HandleOnionMessage
+	}
+
 	copy(pCfg.PubKeyBytes[:], peerAddr.IdentityKey.SerializeCompressed())
 	copy(pCfg.ServerPubKey[:], s.identityECDH.PubKey().SerializeCompressed())
 
@@ -4246,6 +4553,30 @@ func (s *server) fetchNodeAdvertisedAddrs(pub *btcec.PublicKey) ([]net.Addr, err
 	return node.Addresses, nil
 }
 
+// fetchWalletTx returns the wallet transaction with the given hash.
+func (s *server) fetchWalletTx(hash chainhash.Hash) (*wire.MsgTx, error) {
+	txs, err := s.cc.Wallet.ListTransactionDetails(0, -1, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		if tx.Hash != hash {
+			continue
+		}
+
+		msgTx := &wire.MsgTx{}
+		err := msgTx.Deserialize(bytes.NewReader(tx.RawTx))
+		if err != nil {
+			return nil, err
+		}
+
+		return msgTx, nil
+	}
+
+	return nil, fmt.Errorf("transaction %v not found", hash)
+}
+
 // fetchLastChanUpdate returns a function which is able to retrieve our latest
 // channel update for a target channel.
 func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
@@ -4254,6 +4585,22 @@ func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
 	ourPubKey := s.identityECDH.PubKey().SerializeCompressed()
 	return func(cid lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error) {
 		info, edge1, edge2, err := s.chanRouter.GetChannelByID(cid)
+
+		// A confirmed public zero-conf channel has its alias edge
+		// replaced by the confirmed one in the graph, so we'll retry
+		// the lookup with any confirmed SCID stored for the alias.
+		if err != nil && aliasmgr.IsAlias(cid) {
+			for _, scid := range s.aliasMgr.GetAliases(cid) {
+				if aliasmgr.IsAlias(scid) {
+					continue
+				}
+
+				info, edge1, edge2, err = s.chanRouter.GetChannelByID(
+					scid,
+				)
+				break
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -4264,6 +4611,39 @@ func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
 	}
 }
 
+// findBaseByAlias maps an alias SCID to the SCID our graph knows the channel
+// by. The alias is first looked up in our own set of local aliases. If that
+// fails, it is treated as an alias assigned by our peer, in which case the
+// channel it belongs to is located via the stored peer aliases.
+func (s *server) findBaseByAlias(alias lnwire.ShortChannelID) (
+	lnwire.ShortChannelID, error) {
+
+	base, err := s.aliasMgr.FindBaseSCID(alias)
+	if err == nil {
+		return base, nil
+	}
+
+	chanID, err := s.aliasMgr.FindChanIDByPeerAlias(alias)
+	if err != nil {
+		return lnwire.ShortChannelID{}, err
+	}
+
+	channels, err := s.chanStateDB.FetchAllOpenChannels()
+	if err != nil {
+		return lnwire.ShortChannelID{}, err
+	}
+
+	for _, channel := range channels {
+		cid := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+		if cid == chanID {
+			return channel.ShortChanID(), nil
+		}
+	}
+
+	return lnwire.ShortChannelID{}, fmt.Errorf("no channel found for "+
+		"alias %v", alias)
+}
+
 // applyChannelUpdate applies the channel update to the different sub-systems of
 // the server.
 func (s *server) applyChannelUpdate(update *lnwire.ChannelUpdate) error {
@@ -4305,6 +4685,150 @@ func (s *server) SendCustomMessage(peerPub [33]byte, msgType lnwire.MessageType,
 	return peer.SendMessageLazy(true, msg)
 }
 
+// sendOnionMessage sends an onion message to the peer with the specified
+// pubkey. The message is sent as low-priority without waiting for it to be
+// written, so that relaying messages never blocks the peer we received them
+// from.
+func (s *server) sendOnionMessage(peerPub [33]byte, msg lnwire.Message) error {
+	peer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	return peer.SendMessageLazy(false, msg)
+}
+
+// fetchChannelPeer returns the public key of our peer in the channel with the
+// given short channel ID.
+func (s *server) fetchChannelPeer(
+	scid lnwire.ShortChannelID) (*btcec.PublicKey, error) {
+
+	info, _, _, err := s.graphDB.FetchChannelEdgesByID(scid.ToUint64())
+	if err != nil {
+		return nil, err
+	}
+
+	var ourKey [33]byte
+	copy(ourKey[:], s.identityECDH.PubKey().SerializeCompressed())
+
+	switch ourKey {
+	case info.NodeKey1Bytes:
+		return info.NodeKey2()
+
+	case info.NodeKey2Bytes:
+		return info.NodeKey1()
+
+	default:
+		return nil, fmt.Errorf("channel %v is not one of ours", scid)
+	}
+}
+
+// rebalancerChannels returns the balances of all our channels that are
+// active, as they're the only ones the rebalancer can move liquidity through.
+func (s *server) rebalancerChannels() ([]rebalancer.Channel, error) {
+	openChannels, err := s.chanStateDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []rebalancer.Channel
+	for _, dbChan := range openChannels {
+		if dbChan.IsPending {
+			continue
+		}
+
+		chanID := lnwire.NewChanIDFromOutPoint(&dbChan.FundingOutpoint)
+		if !s.htlcSwitch.HasActiveLink(chanID) {
+			continue
+		}
+
+		localBalance := dbChan.LocalCommitment.LocalBalance.ToSatoshis()
+		channels = append(channels, rebalancer.Channel{
+			ChanID:       dbChan.ShortChannelID,
+			Peer:         route.NewVertex(dbChan.IdentityPub),
+			Capacity:     dbChan.Capacity,
+			LocalBalance: localBalance,
+		})
+	}
+
+	return channels, nil
+}
+
+// addInvoiceConfig returns the configuration used to add invoices to the
+// invoice registry.
+func (s *server) addInvoiceConfig() *invoicesrpc.AddInvoiceConfig {
+	defaultDelta := s.cfg.Bitcoin.TimeLockDelta
+	if s.cfg.registeredChains.PrimaryChain() == chainreg.LitecoinChain {
+		defaultDelta = s.cfg.Litecoin.TimeLockDelta
+	}
+
+	return &invoicesrpc.AddInvoiceConfig{
+		AddInvoice:        s.invoices.AddInvoice,
+		IsChannelActive:   s.htlcSwitch.HasActiveLink,
+		ChainParams:       s.cfg.ActiveNetParams.Params,
+		NodeSigner:        s.nodeSigner,
+		DefaultCLTVExpiry: defaultDelta,
+		ChanDB:            s.chanStateDB,
+		Graph:             s.graphDB,
+		GenInvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoice)
+		},
+		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoiceAmp)
+		},
+		GetAlias: s.aliasMgr.GetPeerAlias,
+		BestHeight: func() (uint32, error) {
+			_, height, err := s.cc.ChainIO.GetBestBlock()
+			return uint32(height), err
+		},
+	}
+}
+
+// addOfferInvoice adds an invoice for an invoice request that we received for
+// one of our offers. The invoice can only be paid over blinded paths, which
+// are returned along with its payment hash.
+func (s *server) addOfferInvoice(amt lnwire.MilliSatoshi, memo string,
+	expiry time.Duration) (lntypes.Hash, []*zpay32.BlindedPaymentPath,
+	error) {
+
+	hash, dbInvoice, err := invoicesrpc.AddInvoice(
+		context.Background(), s.addInvoiceConfig(),
+		&invoicesrpc.AddInvoiceData{
+			Memo:   memo,
+			Value:  amt,
+			Expiry: int64(expiry.Seconds()),
+			Blind:  true,
+		},
+	)
+	if err != nil {
+		return lntypes.Hash{}, nil, err
+	}
+
+	payReq, err := zpay32.Decode(
+		string(dbInvoice.PaymentRequest), s.cfg.ActiveNetParams.Params,
+	)
+	if err != nil {
+		return lntypes.Hash{}, nil, err
+	}
+
+	return *hash, payReq.BlindedPaymentPaths, nil
+}
+
+// signOfferInvoice returns a function that signs the invoices for our offers
+// with our node key.
+func (s *server) signOfferInvoice(
+	nodeKeyDesc *keychain.KeyDescriptor) offers.SignFunc {
+
+	return func(digest [32]byte) (*schnorr.Signature, error) {
+		nodeKey, err := s.cc.KeyRing.DerivePrivKey(*nodeKeyDesc)
+		if err != nil {
+			return nil, err
+		}
+
+		return schnorr.Sign(nodeKey, digest[:])
+	}
+}
+
 // newSweepPkScriptGen creates closure that generates a new public key script
 // which should be used to sweep any funds into the on-chain wallet.
 // Specifically, the script generated is a version 0, pay-to-witness-pubkey-hash
@@ -0,0 +1,142 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	// Register the postgres and sqlite drivers.
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/ltcsuite/lnd/kvdb/postgres"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// BackendType denotes the database engine that is behind a DB.
+type BackendType uint8
+
+const (
+	// BackendPostgres denotes a postgres database.
+	BackendPostgres BackendType = iota
+
+	// BackendSqlite denotes an sqlite database.
+	BackendSqlite
+)
+
+// String returns a human readable name of the backend type.
+func (b BackendType) String() string {
+	switch b {
+	case BackendPostgres:
+		return "postgres"
+	case BackendSqlite:
+		return "sqlite"
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(b))
+	}
+}
+
+// DB is a native SQL database that holds relational tables, as opposed to the
+// key-value buckets that the kvdb backends emulate on top of a single table.
+// The schema of the database is migrated to the latest version when it's
+// opened.
+//
+// NOTE: Queries use numbered placeholders ($1, $2, ...), which are understood
+// by both backends as long as they first appear in ascending order.
+type DB struct {
+	*sql.DB
+
+	backend BackendType
+
+	// timeout is the maximum duration of a transaction. A zero value
+	// disables the timeout.
+	timeout time.Duration
+}
+
+// NewPostgresStore opens the postgres database described by the passed config
+// and applies all pending schema migrations.
+func NewPostgresStore(cfg *postgres.Config) (*DB, error) {
+	db, err := sql.Open("pgx", cfg.Dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Limit the maximum number of open connections, to prevent the server
+	// from running out of connections.
+	if cfg.MaxConnections != 0 {
+		db.SetMaxOpenConns(cfg.MaxConnections)
+	}
+
+	return newDB(db, BackendPostgres, cfg.Timeout)
+}
+
+// NewSqliteStore opens the sqlite database at the given path, creating it if
+// it doesn't exist yet, and applies all pending schema migrations.
+func NewSqliteStore(dbPath string) (*DB, error) {
+	// Foreign keys are disabled by default in sqlite, so we'll enable
+	// them to have deletions cascade. Write transactions acquire the
+	// database lock right away, as sqlite only supports a single writer.
+	dsn := fmt.Sprintf("file:%s?_foreign_keys=on&_busy_timeout=5000&"+
+		"_txlock=immediate", dbPath)
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// A single connection avoids lock contention between the connections
+	// of the pool, which would otherwise fail with SQLITE_BUSY.
+	db.SetMaxOpenConns(1)
+
+	return newDB(db, BackendSqlite, 0)
+}
+
+// newDB wraps the passed database connection and migrates its schema.
+func newDB(db *sql.DB, backend BackendType, timeout time.Duration) (*DB,
+	error) {
+
+	sqlDB := &DB{
+		DB:      db,
+		backend: backend,
+		timeout: timeout,
+	}
+
+	if err := sqlDB.applyMigrations(); err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("unable to migrate %v database: %v",
+			backend, err)
+	}
+
+	return sqlDB, nil
+}
+
+// Backend returns the type of the database engine.
+func (d *DB) Backend() BackendType {
+	return d.backend
+}
+
+// ExecTx runs the passed closure within a database transaction, which is
+// committed if the closure succeeds, and rolled back otherwise.
+func (d *DB) ExecTx(ctx context.Context, txBody func(*sql.Tx) error) error {
+	if d.timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := txBody(tx); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			log.Errorf("Unable to roll back transaction: %v",
+				rollbackErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
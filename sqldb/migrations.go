@@ -0,0 +1,169 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// migrationFiles holds the schema migrations of the database. The
+	// file name of each migration starts with its version, and the
+	// statements are written in the dialect of sqlite.
+	//
+	//go:embed migrations/*.up.sql
+	migrationFiles embed.FS
+
+	// postgresSchemaReplacements are the replacements that translate the
+	// sqlite schema to the postgres dialect.
+	postgresSchemaReplacements = []struct {
+		sqlite   string
+		postgres string
+	}{
+		{"BLOB", "BYTEA"},
+		{"INTEGER PRIMARY KEY", "BIGSERIAL PRIMARY KEY"},
+	}
+)
+
+// migration is a single schema migration of the database.
+type migration struct {
+	version uint32
+	name    string
+	schema  string
+}
+
+// readMigrations returns all schema migrations ordered by their version,
+// translated to the dialect of the database.
+func (d *DB) readMigrations() ([]migration, error) {
+	files, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]migration, 0, len(files))
+	for _, file := range files {
+		name := file.Name()
+		version, err := strconv.ParseUint(
+			strings.SplitN(name, "_", 2)[0], 10, 32,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name "+
+				"%v: %v", name, err)
+		}
+
+		schema, err := migrationFiles.ReadFile(
+			path.Join("migrations", name),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		m := migration{
+			version: uint32(version),
+			name:    name,
+			schema:  string(schema),
+		}
+		if d.backend == BackendPostgres {
+			for _, r := range postgresSchemaReplacements {
+				m.schema = strings.ReplaceAll(
+					m.schema, r.sqlite, r.postgres,
+				)
+			}
+		}
+
+		migrations = append(migrations, m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version < migrations[j].version
+	})
+
+	return migrations, nil
+}
+
+// applyMigrations applies all schema migrations that are newer than the
+// current version of the database, each within its own transaction.
+func (d *DB) applyMigrations() error {
+	ctx := context.Background()
+
+	_, err := d.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS
+		migration_versions (
+			version BIGINT PRIMARY KEY,
+			migrated_at TIMESTAMP NOT NULL
+		)`)
+	if err != nil {
+		return err
+	}
+
+	var currentVersion uint32
+	err = d.QueryRowContext(
+		ctx, "SELECT COALESCE(MAX(version), 0) FROM migration_versions",
+	).Scan(&currentVersion)
+	if err != nil {
+		return err
+	}
+
+	migrations, err := d.readMigrations()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.version <= currentVersion {
+			continue
+		}
+
+		log.Infof("Applying %v schema migration %v", d.backend, m.name)
+
+		err := d.ExecTx(ctx, func(tx *sql.Tx) error {
+			if _, err := tx.ExecContext(ctx, m.schema); err != nil {
+				return err
+			}
+
+			_, err := tx.ExecContext(ctx, `INSERT INTO
+				migration_versions (version, migrated_at)
+				VALUES ($1, $2)`, m.version, time.Now().UTC(),
+			)
+
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("unable to apply migration %v: %v",
+				m.name, err)
+		}
+	}
+
+	return nil
+}
+
+// KVMigrationDone returns true if the migration of the data with the given
+// name from the kvdb backend was completed already.
+func (d *DB) KVMigrationDone(ctx context.Context, name string) (bool, error) {
+	var count int
+	err := d.QueryRowContext(
+		ctx, "SELECT COUNT(*) FROM kv_migrations WHERE name = $1", name,
+	).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count != 0, nil
+}
+
+// MarkKVMigrationDone records within the passed transaction that the
+// migration of the data with the given name from the kvdb backend was
+// completed.
+func MarkKVMigrationDone(ctx context.Context, tx *sql.Tx, name string) error {
+	_, err := tx.ExecContext(
+		ctx, "INSERT INTO kv_migrations (name, migrated_at) "+
+			"VALUES ($1, $2)", name, time.Now().UTC(),
+	)
+
+	return err
+}
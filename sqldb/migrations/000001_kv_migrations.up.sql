@@ -0,0 +1,6 @@
+-- kv_migrations records the data that was migrated from the kvdb backend to
+-- the native SQL tables already.
+CREATE TABLE IF NOT EXISTS kv_migrations (
+    name TEXT PRIMARY KEY,
+    migrated_at TIMESTAMP NOT NULL
+);
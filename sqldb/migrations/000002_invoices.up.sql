@@ -0,0 +1,103 @@
+-- invoice_sequences holds the counters that the add and settle indexes of the
+-- invoices are assigned from.
+CREATE TABLE IF NOT EXISTS invoice_sequences (
+    name TEXT PRIMARY KEY,
+    current_value BIGINT NOT NULL
+);
+
+INSERT INTO invoice_sequences (name, current_value) VALUES ('add_index', 0);
+INSERT INTO invoice_sequences (name, current_value) VALUES ('settle_index', 0);
+
+-- invoices holds all invoices of the node.
+CREATE TABLE IF NOT EXISTS invoices (
+    id INTEGER PRIMARY KEY,
+
+    -- hash is the payment hash of the invoice.
+    hash BLOB NOT NULL UNIQUE,
+
+    -- preimage is the payment preimage, which is unknown for hodl
+    -- invoices until they are settled.
+    preimage BLOB,
+
+    -- payment_addr is NULL for legacy keysend invoices, which don't have a
+    -- payment address.
+    payment_addr BLOB UNIQUE,
+
+    memo BLOB,
+    payment_request TEXT,
+    amount_msat BIGINT NOT NULL,
+    cltv_delta INTEGER NOT NULL,
+
+    -- expiry is the expiry of the invoice in seconds after its creation.
+    expiry BIGINT NOT NULL,
+
+    features BLOB NOT NULL,
+    state SMALLINT NOT NULL,
+    amount_paid_msat BIGINT NOT NULL,
+    is_hodl BOOLEAN NOT NULL,
+    is_amp BOOLEAN NOT NULL,
+    add_index BIGINT NOT NULL UNIQUE,
+
+    -- settle_index is NULL for invoices that aren't settled, and for AMP
+    -- invoices, whose sub-invoices are settled individually.
+    settle_index BIGINT UNIQUE,
+
+    created_at TIMESTAMP NOT NULL,
+    settled_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS invoices_state_idx ON invoices (state);
+CREATE INDEX IF NOT EXISTS invoices_created_at_idx ON invoices (created_at);
+CREATE INDEX IF NOT EXISTS invoices_settled_at_idx ON invoices (settled_at);
+
+-- invoice_htlcs holds the HTLCs that paid to the invoices.
+CREATE TABLE IF NOT EXISTS invoice_htlcs (
+    id INTEGER PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoices (id) ON DELETE CASCADE,
+    chan_id BIGINT NOT NULL,
+    htlc_id BIGINT NOT NULL,
+    amount_msat BIGINT NOT NULL,
+    total_mpp_msat BIGINT NOT NULL,
+    accept_height INTEGER NOT NULL,
+    accept_time TIMESTAMP NOT NULL,
+    resolve_time TIMESTAMP,
+    expiry_height INTEGER NOT NULL,
+    state SMALLINT NOT NULL,
+
+    -- The remaining fields are only set for AMP HTLCs.
+    set_id BLOB,
+    root_share BLOB,
+    child_index BIGINT,
+    hash BLOB,
+    preimage BLOB,
+
+    UNIQUE (invoice_id, chan_id, htlc_id)
+);
+
+CREATE INDEX IF NOT EXISTS invoice_htlcs_set_id_idx ON invoice_htlcs (set_id);
+
+-- invoice_htlc_custom_records holds the custom records of the onion payloads
+-- of the HTLCs.
+CREATE TABLE IF NOT EXISTS invoice_htlc_custom_records (
+    htlc_id BIGINT NOT NULL REFERENCES invoice_htlcs (id) ON DELETE CASCADE,
+    record_key BIGINT NOT NULL,
+    record_value BLOB NOT NULL,
+
+    PRIMARY KEY (htlc_id, record_key)
+);
+
+-- amp_sub_invoices holds the state of the HTLC sets that paid to AMP
+-- invoices, identified by their set ID.
+CREATE TABLE IF NOT EXISTS amp_sub_invoices (
+    set_id BLOB PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoices (id) ON DELETE CASCADE,
+    state SMALLINT NOT NULL,
+    amount_paid_msat BIGINT NOT NULL,
+    settle_index BIGINT UNIQUE,
+    settled_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS amp_sub_invoices_invoice_id_idx
+    ON amp_sub_invoices (invoice_id);
+CREATE INDEX IF NOT EXISTS amp_sub_invoices_settled_at_idx
+    ON amp_sub_invoices (settled_at);
@@ -0,0 +1,71 @@
+-- payment_sequences holds the counter that the sequence numbers of the
+-- payments are assigned from.
+CREATE TABLE IF NOT EXISTS payment_sequences (
+    name TEXT PRIMARY KEY,
+    current_value BIGINT NOT NULL
+);
+
+INSERT INTO payment_sequences (name, current_value) VALUES ('sequence_num', 0);
+
+-- payments holds all outgoing payments of the node.
+CREATE TABLE IF NOT EXISTS payments (
+    id INTEGER PRIMARY KEY,
+
+    -- payment_hash is the payment identifier, which is the payment hash for
+    -- regular payments and the set ID for AMP payments.
+    payment_hash BLOB NOT NULL,
+
+    -- is_duplicate marks legacy payments to a payment hash that was paid
+    -- more than once by earlier versions of lnd.
+    is_duplicate BOOLEAN NOT NULL,
+
+    sequence_num BIGINT NOT NULL UNIQUE,
+    amount_msat BIGINT NOT NULL,
+    payment_request BLOB NOT NULL,
+
+    -- destination is the public key of the final hop of the first HTLC
+    -- attempt, which is NULL until an attempt was registered.
+    destination BLOB,
+
+    status SMALLINT NOT NULL,
+    failure_reason SMALLINT,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS payments_payment_hash_idx
+    ON payments (payment_hash) WHERE NOT is_duplicate;
+CREATE INDEX IF NOT EXISTS payments_status_idx ON payments (status);
+CREATE INDEX IF NOT EXISTS payments_created_at_idx ON payments (created_at);
+CREATE INDEX IF NOT EXISTS payments_destination_idx
+    ON payments (destination);
+
+-- payment_htlc_attempts holds the HTLCs that were sent to complete the
+-- payments, along with their outcome.
+CREATE TABLE IF NOT EXISTS payment_htlc_attempts (
+    id INTEGER PRIMARY KEY,
+    payment_id BIGINT NOT NULL REFERENCES payments (id) ON DELETE CASCADE,
+    attempt_id BIGINT NOT NULL,
+    session_key BLOB NOT NULL,
+
+    -- route is the serialized route of the attempt, of which the amount
+    -- delivered to the receiver and the total fees are denormalized.
+    route BLOB NOT NULL,
+    amount_msat BIGINT NOT NULL,
+    fee_msat BIGINT NOT NULL,
+
+    -- hash is NULL for attempts that use the hash of their payment.
+    hash BLOB,
+    attempt_time TIMESTAMP,
+
+    -- The settle fields are set once the attempt succeeded.
+    settle_preimage BLOB,
+    settle_time TIMESTAMP,
+
+    -- The fail fields are set once the attempt failed.
+    fail_reason SMALLINT,
+    fail_time TIMESTAMP,
+    fail_message BLOB,
+    fail_source_index BIGINT,
+
+    UNIQUE (payment_id, attempt_id)
+);
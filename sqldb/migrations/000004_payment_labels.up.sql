@@ -0,0 +1,3 @@
+-- label is the optional label of a payment, which allows the payments made by
+-- subsystems such as the rebalancer to be told apart.
+ALTER TABLE payments ADD COLUMN label TEXT NOT NULL DEFAULT '';
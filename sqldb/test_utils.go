@@ -0,0 +1,30 @@
+package sqldb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// NewTestSqliteStore creates an sqlite database within a temporary directory
+// to be used in tests. The returned function closes the database and removes
+// the directory.
+func NewTestSqliteStore() (*DB, func(), error) {
+	tempDir, err := ioutil.TempDir("", "sqldb")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := NewSqliteStore(filepath.Join(tempDir, "test.db"))
+	if err != nil {
+		os.RemoveAll(tempDir)
+		return nil, nil, err
+	}
+
+	cleanUp := func() {
+		db.Close()
+		os.RemoveAll(tempDir)
+	}
+
+	return db, cleanUp, nil
+}
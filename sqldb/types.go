@@ -0,0 +1,29 @@
+package sqldb
+
+import (
+	"database/sql"
+	"time"
+)
+
+// NullTime converts a timestamp to its database representation, which is NULL
+// for the zero time. Timestamps are stored in UTC.
+func NullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{
+		Time:  t.UTC(),
+		Valid: true,
+	}
+}
+
+// FromNullTime converts a nullable timestamp of the database to the local
+// time, or the zero time if it's NULL.
+func FromNullTime(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Time.Local()
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/macaroons"
 	"github.com/ltcsuite/lnd/netann"
+	"github.com/ltcsuite/lnd/offers"
+	"github.com/ltcsuite/lnd/rebalancer"
 	"github.com/ltcsuite/lnd/routing"
 	"github.com/ltcsuite/lnd/sweep"
 	"github.com/ltcsuite/lnd/watchtower"
@@ -101,6 +103,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 	tcpResolver lncfg.TCPResolver,
 	genInvoiceFeatures func() *lnwire.FeatureVector,
 	genAmpInvoiceFeatures func() *lnwire.FeatureVector,
+	getAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error),
+	offerManager *offers.Manager,
+	chanRebalancer *rebalancer.Rebalancer,
 	rpcLogger btclog.Logger) error {
 
 	// First, we'll use reflect to obtain a version of the config struct
@@ -234,6 +239,12 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 			subCfgValue.FieldByName("GenAmpInvoiceFeatures").Set(
 				reflect.ValueOf(genAmpInvoiceFeatures),
 			)
+			subCfgValue.FieldByName("GetAlias").Set(
+				reflect.ValueOf(getAlias),
+			)
+			subCfgValue.FieldByName("OfferManager").Set(
+				reflect.ValueOf(offerManager),
+			)
 
 		// RouterRPC isn't conditionally compiled and doesn't need to be
 		// populated using reflection.
@@ -281,6 +292,8 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 	s.RouterRPC.MacService = macService
 	s.RouterRPC.Router = chanRouter
 	s.RouterRPC.RouterBackend = routerBackend
+	s.RouterRPC.OfferManager = offerManager
+	s.RouterRPC.Rebalancer = chanRebalancer
 
 	return nil
 }
@@ -38,6 +38,26 @@ const (
 	//    commit to-remote sig:           64 bytes, maybe blank
 	V0PlaintextSize = 274
 
+	// MaxHtlcOutputs is the maximum number of HTLC outputs that can be
+	// encoded in a blob.
+	MaxHtlcOutputs = 16
+
+	// HtlcOutputSize is the encoded size of a single HTLC output.
+	//    output index:     2 bytes
+	//    incoming:         1 byte
+	//    payment hash:    32 bytes
+	//    cltv expiry:      4 bytes
+	//    revocation sig:  64 bytes
+	HtlcOutputSize = 103
+
+	// HtlcPlaintextSize is the size of the HTLC section, which follows the
+	// version 0 plaintext in blobs with FlagHtlcOutputs.
+	//    local htlc pubkey:       33 bytes
+	//    remote htlc pubkey:      33 bytes
+	//    number of htlc outputs:   1 byte
+	//    htlc outputs:            16 * 103 bytes, maybe blank
+	HtlcPlaintextSize = 67 + MaxHtlcOutputs*HtlcOutputSize
+
 	// MaxSweepAddrSize defines the maximum sweep address size that can be
 	// encoded in a blob.
 	MaxSweepAddrSize = 42
@@ -54,6 +74,8 @@ func Size(blobType Type) int {
 // PlaintextSize returns the size of the encoded-but-unencrypted blob in bytes.
 func PlaintextSize(blobType Type) int {
 	switch {
+	case blobType.Has(FlagCommitOutputs | FlagHtlcOutputs):
+		return V0PlaintextSize + HtlcPlaintextSize
 	case blobType.Has(FlagCommitOutputs):
 		return V0PlaintextSize
 	default:
@@ -88,11 +110,44 @@ var (
 		"sweep address must be less than or equal to %d bytes long",
 		MaxSweepAddrSize,
 	)
+
+	// ErrTooManyHtlcOutputs is returned when trying to encode or decode
+	// more than MaxHtlcOutputs HTLC outputs.
+	ErrTooManyHtlcOutputs = fmt.Errorf(
+		"blob can contain at most %d htlc outputs", MaxHtlcOutputs,
+	)
 )
 
 // PubKey is a 33-byte, serialized compressed public key.
 type PubKey [33]byte
 
+// HtlcOutput contains the information required to sweep a revoked HTLC output
+// of the remote party's commitment transaction.
+type HtlcOutput struct {
+	// OutputIndex is the index of the HTLC output in the commitment
+	// transaction. HTLCs can have identical scripts, so they are located
+	// by index rather than by script.
+	OutputIndex uint16
+
+	// Incoming is true if the HTLC was offered to the client by the
+	// breaching party, and false if it was offered by the client.
+	Incoming bool
+
+	// PaymentHash is the payment hash of the HTLC.
+	PaymentHash [32]byte
+
+	// CltvExpiry is the absolute timeout of the HTLC, which is part of the
+	// script of outgoing HTLCs.
+	CltvExpiry uint32
+
+	// RevocationSig is a signature under RevocationPubKey using
+	// SIGHASH_ALL, spending the HTLC output in a justice transaction that
+	// only sweeps this output. HTLC outputs are swept separately so that
+	// the others can still be swept if some were already spent by
+	// second-level HTLC transactions.
+	RevocationSig lnwire.Sig
+}
+
 // JusticeKit is lé Blob of Justice. The JusticeKit contains information
 // required to construct a justice transaction, that sweeps a remote party's
 // revoked commitment transaction. It supports encryption and decryption using
@@ -148,6 +203,22 @@ type JusticeKit struct {
 	// NOTE: This value is only used if CommitToRemotePubKey contains a valid
 	// compressed public key.
 	CommitToRemoteSig lnwire.Sig
+
+	// LocalHtlcPubKey is the compressed HTLC pubkey of the breaching party
+	// in the HTLC scripts of the revoked commitment transaction.
+	//
+	// NOTE: The HTLC fields are only encoded for blob types with
+	// FlagHtlcOutputs.
+	LocalHtlcPubKey PubKey
+
+	// RemoteHtlcPubKey is the compressed HTLC pubkey of the client in the
+	// HTLC scripts of the revoked commitment transaction.
+	RemoteHtlcPubKey PubKey
+
+	// HtlcOutputs are the revoked HTLC outputs of the commitment
+	// transaction, each swept by its own justice transaction, in the order
+	// of their output index.
+	HtlcOutputs []HtlcOutput
 }
 
 // CommitToLocalWitnessScript returns the serialized witness script for the
@@ -237,6 +308,84 @@ func (b *JusticeKit) CommitToRemoteWitnessStack() ([][]byte, error) {
 	return witnessStack, nil
 }
 
+// HasHtlcOutputs returns true if the blob contains HTLC outputs to sweep.
+func (b *JusticeKit) HasHtlcOutputs() bool {
+	return b.BlobType.HasHtlcOutputs() && len(b.HtlcOutputs) > 0
+}
+
+// HtlcWitnessScript returns the witness script of the given HTLC output.
+// Incoming HTLCs were offered by the owner of the revoked commitment and use
+// the sender's script, while outgoing HTLCs use the receiver's script.
+func (b *JusticeKit) HtlcWitnessScript(htlc *HtlcOutput) ([]byte, error) {
+	revocationPubKey, err := btcec.ParsePubKey(b.RevocationPubKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	localHtlcPubKey, err := btcec.ParsePubKey(b.LocalHtlcPubKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	remoteHtlcPubKey, err := btcec.ParsePubKey(b.RemoteHtlcPubKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Anchor channels require a confirmation before the non-revocation
+	// clauses can be spent.
+	confirmedSpend := b.BlobType.IsAnchorChannel()
+
+	if htlc.Incoming {
+		return input.SenderHTLCScript(
+			localHtlcPubKey, remoteHtlcPubKey, revocationPubKey,
+			htlc.PaymentHash[:], confirmedSpend,
+		)
+	}
+
+	return input.ReceiverHTLCScript(
+		htlc.CltvExpiry, remoteHtlcPubKey, localHtlcPubKey,
+		revocationPubKey, htlc.PaymentHash[:], confirmedSpend,
+	)
+}
+
+// HtlcPenaltyWitnessSize returns the size of the witness spending the
+// revocation clause of an HTLC output for the given blob type.
+func HtlcPenaltyWitnessSize(blobType Type, incoming bool) int {
+	switch {
+	case incoming && blobType.IsAnchorChannel():
+		return input.OfferedHtlcPenaltyWitnessSizeConfirmed
+
+	case incoming:
+		return input.OfferedHtlcPenaltyWitnessSize
+
+	case blobType.IsAnchorChannel():
+		return input.AcceptedHtlcPenaltyWitnessSizeConfirmed
+
+	default:
+		return input.AcceptedHtlcPenaltyWitnessSize
+	}
+}
+
+// HtlcRevokeWitnessStack constructs a witness stack spending the revocation
+// clause of the given HTLC output.
+//   <revocation-sig> <revocation-pubkey>
+func (b *JusticeKit) HtlcRevokeWitnessStack(
+	htlc *HtlcOutput) ([][]byte, error) {
+
+	revocationSig, err := htlc.RevocationSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	witnessStack := make([][]byte, 2)
+	witnessStack[0] = append(revocationSig.Serialize(),
+		byte(txscript.SigHashAll))
+	witnessStack[1] = b.RevocationPubKey[:]
+
+	return witnessStack, nil
+}
+
 // Encrypt encodes the blob of justice using encoding version, and then
 // creates a ciphertext using chacha20poly1305 under the chosen (nonce, key)
 // pair.
@@ -324,6 +473,13 @@ func Decrypt(key BreachKey, ciphertext []byte,
 // error if the version is unknown.
 func (b *JusticeKit) encode(w io.Writer, blobType Type) error {
 	switch {
+	case blobType.Has(FlagCommitOutputs | FlagHtlcOutputs):
+		if err := b.encodeV0(w); err != nil {
+			return err
+		}
+
+		return b.encodeHtlcs(w)
+
 	case blobType.Has(FlagCommitOutputs):
 		return b.encodeV0(w)
 	default:
@@ -335,6 +491,13 @@ func (b *JusticeKit) encode(w io.Writer, blobType Type) error {
 // error if the version is unknown.
 func (b *JusticeKit) decode(r io.Reader, blobType Type) error {
 	switch {
+	case blobType.Has(FlagCommitOutputs | FlagHtlcOutputs):
+		if err := b.decodeV0(r); err != nil {
+			return err
+		}
+
+		return b.decodeHtlcs(r)
+
 	case blobType.Has(FlagCommitOutputs):
 		return b.decodeV0(r)
 	default:
@@ -501,3 +664,157 @@ func (b *JusticeKit) decodeV0(r io.Reader) error {
 
 	return nil
 }
+
+// encodeHtlcs encodes the HTLC section of the JusticeKit, which follows the
+// version 0 encoding for blob types with FlagHtlcOutputs. The HTLC outputs are
+// padded to MaxHtlcOutputs, producing a constant-size plaintext.
+//
+// blob htlc section plaintext encoding:
+//    local htlc pubkey:       33 bytes
+//    remote htlc pubkey:      33 bytes
+//    number of htlc outputs:   1 byte
+//    htlc outputs:            16 * 103 bytes, maybe blank
+func (b *JusticeKit) encodeHtlcs(w io.Writer) error {
+	// Assert the number of HTLC outputs is sane.
+	if len(b.HtlcOutputs) > MaxHtlcOutputs {
+		return ErrTooManyHtlcOutputs
+	}
+
+	// Write 33-byte local and remote htlc public keys.
+	_, err := w.Write(b.LocalHtlcPubKey[:])
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(b.RemoteHtlcPubKey[:])
+	if err != nil {
+		return err
+	}
+
+	// Write the number of HTLC outputs as a single byte.
+	err = binary.Write(w, byteOrder, uint8(len(b.HtlcOutputs)))
+	if err != nil {
+		return err
+	}
+
+	// Write the HTLC outputs, followed by blank outputs up to the maximum.
+	var blank HtlcOutput
+	for i := 0; i < MaxHtlcOutputs; i++ {
+		htlc := &blank
+		if i < len(b.HtlcOutputs) {
+			htlc = &b.HtlcOutputs[i]
+		}
+
+		if err := htlc.encode(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeHtlcs decodes the HTLC section of the JusticeKit, which follows the
+// version 0 encoding for blob types with FlagHtlcOutputs.
+//
+// blob htlc section plaintext encoding:
+//    local htlc pubkey:       33 bytes
+//    remote htlc pubkey:      33 bytes
+//    number of htlc outputs:   1 byte
+//    htlc outputs:            16 * 103 bytes, maybe blank
+func (b *JusticeKit) decodeHtlcs(r io.Reader) error {
+	// Read 33-byte local and remote htlc public keys.
+	_, err := io.ReadFull(r, b.LocalHtlcPubKey[:])
+	if err != nil {
+		return err
+	}
+
+	_, err = io.ReadFull(r, b.RemoteHtlcPubKey[:])
+	if err != nil {
+		return err
+	}
+
+	// Read the number of HTLC outputs as a single byte.
+	var numHtlcs uint8
+	err = binary.Read(r, byteOrder, &numHtlcs)
+	if err != nil {
+		return err
+	}
+
+	// Assert the number of HTLC outputs is sane.
+	if numHtlcs > MaxHtlcOutputs {
+		return ErrTooManyHtlcOutputs
+	}
+
+	// Read all HTLC outputs, discarding the blank padding.
+	b.HtlcOutputs = nil
+	for i := 0; i < MaxHtlcOutputs; i++ {
+		var htlc HtlcOutput
+		if err := htlc.decode(r); err != nil {
+			return err
+		}
+
+		if i < int(numHtlcs) {
+			b.HtlcOutputs = append(b.HtlcOutputs, htlc)
+		}
+	}
+
+	return nil
+}
+
+// encode serializes the HTLC output using 103 bytes.
+func (h *HtlcOutput) encode(w io.Writer) error {
+	err := binary.Write(w, byteOrder, h.OutputIndex)
+	if err != nil {
+		return err
+	}
+
+	var incoming uint8
+	if h.Incoming {
+		incoming = 1
+	}
+	err = binary.Write(w, byteOrder, incoming)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(h.PaymentHash[:])
+	if err != nil {
+		return err
+	}
+
+	err = binary.Write(w, byteOrder, h.CltvExpiry)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(h.RevocationSig[:])
+	return err
+}
+
+// decode deserializes an HTLC output from 103 bytes.
+func (h *HtlcOutput) decode(r io.Reader) error {
+	err := binary.Read(r, byteOrder, &h.OutputIndex)
+	if err != nil {
+		return err
+	}
+
+	var incoming uint8
+	err = binary.Read(r, byteOrder, &incoming)
+	if err != nil {
+		return err
+	}
+	h.Incoming = incoming == 1
+
+	_, err = io.ReadFull(r, h.PaymentHash[:])
+	if err != nil {
+		return err
+	}
+
+	err = binary.Read(r, byteOrder, &h.CltvExpiry)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.ReadFull(r, h.RevocationSig[:])
+	return err
+}
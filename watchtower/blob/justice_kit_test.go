@@ -33,6 +33,21 @@ func makeSig(i int) lnwire.Sig {
 	return sig
 }
 
+func makeHtlcs(n int) []blob.HtlcOutput {
+	htlcs := make([]blob.HtlcOutput, n)
+	for i := range htlcs {
+		htlcs[i] = blob.HtlcOutput{
+			OutputIndex:   uint16(i + 2),
+			Incoming:      i%2 == 0,
+			CltvExpiry:    uint32(500 + i),
+			RevocationSig: makeSig(i + 10),
+		}
+		htlcs[i].PaymentHash[0] = byte(i)
+	}
+
+	return htlcs
+}
+
 func makeAddr(size int) []byte {
 	addr := make([]byte, size)
 	if _, err := io.ReadFull(rand.Reader, addr); err != nil {
@@ -54,6 +69,8 @@ type descriptorTest struct {
 	hasCommitToRemote    bool
 	commitToRemotePubKey blob.PubKey
 	commitToRemoteSig    lnwire.Sig
+	hasHtlcs             bool
+	htlcOutputs          []blob.HtlcOutput
 	encErr               error
 	decErr               error
 }
@@ -135,6 +152,55 @@ var descriptorTests = []descriptorTest{
 		commitToLocalSig: makeSig(1),
 		encErr:           blob.ErrSweepAddressToLong,
 	},
+	{
+		name:                 "commit htlc",
+		encVersion:           blob.TypeAltruistCommitHtlc,
+		decVersion:           blob.TypeAltruistCommitHtlc,
+		sweepAddr:            makeAddr(22),
+		revPubKey:            makePubKey(0),
+		delayPubKey:          makePubKey(1),
+		csvDelay:             144,
+		commitToLocalSig:     makeSig(1),
+		hasCommitToRemote:    true,
+		commitToRemotePubKey: makePubKey(2),
+		commitToRemoteSig:    makeSig(2),
+		hasHtlcs:             true,
+		htlcOutputs:          makeHtlcs(2),
+	},
+	{
+		name:             "commit htlc without htlcs",
+		encVersion:       blob.TypeRewardAnchorCommitHtlc,
+		decVersion:       blob.TypeRewardAnchorCommitHtlc,
+		sweepAddr:        makeAddr(34),
+		revPubKey:        makePubKey(0),
+		delayPubKey:      makePubKey(1),
+		csvDelay:         144,
+		commitToLocalSig: makeSig(1),
+	},
+	{
+		name:             "max htlcs",
+		encVersion:       blob.TypeAltruistAnchorCommitHtlc,
+		decVersion:       blob.TypeAltruistAnchorCommitHtlc,
+		sweepAddr:        makeAddr(34),
+		revPubKey:        makePubKey(0),
+		delayPubKey:      makePubKey(1),
+		csvDelay:         144,
+		commitToLocalSig: makeSig(1),
+		hasHtlcs:         true,
+		htlcOutputs:      makeHtlcs(blob.MaxHtlcOutputs),
+	},
+	{
+		name:             "too many htlcs",
+		encVersion:       blob.TypeAltruistCommitHtlc,
+		decVersion:       blob.TypeAltruistCommitHtlc,
+		sweepAddr:        makeAddr(34),
+		revPubKey:        makePubKey(0),
+		delayPubKey:      makePubKey(1),
+		csvDelay:         144,
+		commitToLocalSig: makeSig(1),
+		htlcOutputs:      makeHtlcs(blob.MaxHtlcOutputs + 1),
+		encErr:           blob.ErrTooManyHtlcOutputs,
+	},
 }
 
 // TestBlobJusticeKitEncryptDecrypt asserts that encrypting and decrypting a
@@ -159,6 +225,13 @@ func testBlobJusticeKitEncryptDecrypt(t *testing.T, test descriptorTest) {
 		CommitToLocalSig:     test.commitToLocalSig,
 		CommitToRemotePubKey: test.commitToRemotePubKey,
 		CommitToRemoteSig:    test.commitToRemoteSig,
+		HtlcOutputs:          test.htlcOutputs,
+	}
+
+	// The HTLC section is only encoded for blob types that support it.
+	if test.encVersion.HasHtlcOutputs() {
+		boj.LocalHtlcPubKey = makePubKey(3)
+		boj.RemoteHtlcPubKey = makePubKey(4)
 	}
 
 	// Generate a random encryption key for the blob. The key is
@@ -208,6 +281,10 @@ func testBlobJusticeKitEncryptDecrypt(t *testing.T, test descriptorTest) {
 			test.hasCommitToRemote, boj2.HasCommitToRemoteOutput())
 	}
 
+	// Check that the decrypted blob properly reports whether it has htlc
+	// outputs or not.
+	require.Equal(t, test.hasHtlcs, boj2.HasHtlcOutputs())
+
 	// Check that the original blob plaintext matches the
 	// one reconstructed from the encrypted blob.
 	if !reflect.DeepEqual(boj, boj2) {
@@ -385,3 +462,118 @@ func TestJusticeKitToLocalWitnessConstruction(t *testing.T) {
 	}
 	require.Equal(t, expWitnessStack, toLocalWitnessStack)
 }
+
+// TestJusticeKitHtlcWitnessConstruction tests that a JusticeKit returns the
+// proper HTLC witness scripts and witness stacks for spending the revocation
+// path of incoming and outgoing HTLCs.
+func TestJusticeKitHtlcWitnessConstruction(t *testing.T) {
+	tests := []struct {
+		name     string
+		blobType blob.Type
+		incoming bool
+	}{
+		{
+			name:     "legacy incoming",
+			blobType: blob.TypeAltruistCommitHtlc,
+			incoming: true,
+		},
+		{
+			name:     "legacy outgoing",
+			blobType: blob.TypeAltruistCommitHtlc,
+		},
+		{
+			name:     "anchor incoming",
+			blobType: blob.TypeAltruistAnchorCommitHtlc,
+			incoming: true,
+		},
+		{
+			name:     "anchor outgoing",
+			blobType: blob.TypeAltruistAnchorCommitHtlc,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testJusticeKitHtlcWitnessConstruction(
+				t, test.blobType, test.incoming,
+			)
+		})
+	}
+}
+
+func testJusticeKitHtlcWitnessConstruction(t *testing.T, blobType blob.Type,
+	incoming bool) {
+
+	// Generate the revocation key, and the HTLC keys of the breaching
+	// party and the client.
+	revPrivKey, err := btcec.NewPrivateKey()
+	require.Nil(t, err)
+
+	localHtlcKey, err := btcec.NewPrivateKey()
+	require.Nil(t, err)
+
+	remoteHtlcKey, err := btcec.NewPrivateKey()
+	require.Nil(t, err)
+
+	var revPubKey, localHtlcPubKey, remoteHtlcPubKey blob.PubKey
+	copy(revPubKey[:], revPrivKey.PubKey().SerializeCompressed())
+	copy(localHtlcPubKey[:], localHtlcKey.PubKey().SerializeCompressed())
+	copy(remoteHtlcPubKey[:], remoteHtlcKey.PubKey().SerializeCompressed())
+
+	// Sign a message using the revocation private key. The exact message
+	// doesn't matter as we won't be validating the signature's validity.
+	digest := bytes.Repeat([]byte("a"), 32)
+	rawRevSig := ecdsa.Sign(revPrivKey, digest)
+
+	revSig, err := lnwire.NewSigFromSignature(rawRevSig)
+	require.Nil(t, err)
+
+	htlc := &blob.HtlcOutput{
+		OutputIndex:   3,
+		Incoming:      incoming,
+		PaymentHash:   [32]byte{1, 2, 3},
+		CltvExpiry:    500,
+		RevocationSig: revSig,
+	}
+
+	justiceKit := &blob.JusticeKit{
+		BlobType:         blobType,
+		RevocationPubKey: revPubKey,
+		LocalHtlcPubKey:  localHtlcPubKey,
+		RemoteHtlcPubKey: remoteHtlcPubKey,
+		HtlcOutputs:      []blob.HtlcOutput{*htlc},
+	}
+
+	// Incoming HTLCs were offered by the breaching party, so they use the
+	// sender's script, while outgoing HTLCs use the receiver's script.
+	var expScript []byte
+	if incoming {
+		expScript, err = input.SenderHTLCScript(
+			localHtlcKey.PubKey(), remoteHtlcKey.PubKey(),
+			revPrivKey.PubKey(), htlc.PaymentHash[:],
+			blobType.IsAnchorChannel(),
+		)
+	} else {
+		expScript, err = input.ReceiverHTLCScript(
+			htlc.CltvExpiry, remoteHtlcKey.PubKey(),
+			localHtlcKey.PubKey(), revPrivKey.PubKey(),
+			htlc.PaymentHash[:], blobType.IsAnchorChannel(),
+		)
+	}
+	require.Nil(t, err)
+
+	htlcScript, err := justiceKit.HtlcWitnessScript(htlc)
+	require.Nil(t, err)
+	require.Equal(t, expScript, htlcScript)
+
+	// The witness stack consists of the revocation signature and the
+	// revocation pubkey.
+	witnessStack, err := justiceKit.HtlcRevokeWitnessStack(htlc)
+	require.Nil(t, err)
+
+	expWitnessStack := [][]byte{
+		append(rawRevSig.Serialize(), byte(txscript.SigHashAll)),
+		revPubKey[:],
+	}
+	require.Equal(t, expWitnessStack, witnessStack)
+}
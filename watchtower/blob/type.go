@@ -24,6 +24,12 @@ const (
 	// channel, and therefore must expect a P2WSH-style to-remote output if
 	// one exists.
 	FlagAnchorChannel Flag = 1 << 2
+
+	// FlagHtlcOutputs signals that the blob additionally contains the
+	// information required to sweep the revoked HTLC outputs of the
+	// commitment transaction. It is only valid along with
+	// FlagCommitOutputs.
+	FlagHtlcOutputs Flag = 1 << 3
 )
 
 // Type returns a Type consisting solely of this flag enabled.
@@ -40,6 +46,8 @@ func (f Flag) String() string {
 		return "FlagCommitOutputs"
 	case FlagAnchorChannel:
 		return "FlagAnchorChannel"
+	case FlagHtlcOutputs:
+		return "FlagHtlcOutputs"
 	default:
 		return "FlagUnknown"
 	}
@@ -65,6 +73,40 @@ const (
 	// TypeRewardCommit sweeps only commitment outputs to a sweep address
 	// controlled by the user, and pays a negotiated reward to the tower.
 	TypeRewardCommit = Type(FlagCommitOutputs | FlagReward)
+
+	// TypeRewardAnchorCommit sweeps only commitment outputs from an anchor
+	// commitment to a sweep address controlled by the user, and pays a
+	// negotiated reward to the tower.
+	TypeRewardAnchorCommit = Type(
+		FlagCommitOutputs | FlagReward | FlagAnchorChannel,
+	)
+
+	// TypeAltruistCommitHtlc sweeps commitment and HTLC outputs to a sweep
+	// address controlled by the user, and does not give the tower a
+	// reward.
+	TypeAltruistCommitHtlc = Type(FlagCommitOutputs | FlagHtlcOutputs)
+
+	// TypeAltruistAnchorCommitHtlc sweeps commitment and HTLC outputs from
+	// an anchor commitment to a sweep address controlled by the user, and
+	// does not give the tower a reward.
+	TypeAltruistAnchorCommitHtlc = Type(
+		FlagCommitOutputs | FlagHtlcOutputs | FlagAnchorChannel,
+	)
+
+	// TypeRewardCommitHtlc sweeps commitment and HTLC outputs to a sweep
+	// address controlled by the user, and pays a negotiated reward to the
+	// tower.
+	TypeRewardCommitHtlc = Type(
+		FlagCommitOutputs | FlagHtlcOutputs | FlagReward,
+	)
+
+	// TypeRewardAnchorCommitHtlc sweeps commitment and HTLC outputs from an
+	// anchor commitment to a sweep address controlled by the user, and
+	// pays a negotiated reward to the tower.
+	TypeRewardAnchorCommitHtlc = Type(
+		FlagCommitOutputs | FlagHtlcOutputs | FlagReward |
+			FlagAnchorChannel,
+	)
 )
 
 // Has returns true if the Type has the passed flag enabled.
@@ -87,11 +129,17 @@ func (t Type) IsAnchorChannel() bool {
 	return t.Has(FlagAnchorChannel)
 }
 
+// HasHtlcOutputs returns true if the blob type backs up HTLC outputs.
+func (t Type) HasHtlcOutputs() bool {
+	return t.Has(FlagHtlcOutputs)
+}
+
 // knownFlags maps the supported flags to their name.
 var knownFlags = map[Flag]struct{}{
 	FlagReward:        {},
 	FlagCommitOutputs: {},
 	FlagAnchorChannel: {},
+	FlagHtlcOutputs:   {},
 }
 
 // String returns a human readable description of a Type.
@@ -141,6 +189,12 @@ var supportedTypes = map[Type]struct{}{
 	TypeAltruistCommit:       {},
 	TypeRewardCommit:         {},
 	TypeAltruistAnchorCommit: {},
+	TypeRewardAnchorCommit:   {},
+
+	TypeAltruistCommitHtlc:       {},
+	TypeAltruistAnchorCommitHtlc: {},
+	TypeRewardCommitHtlc:         {},
+	TypeRewardAnchorCommitHtlc:   {},
 }
 
 // IsSupportedType returns true if the given type is supported by the package.
@@ -16,19 +16,29 @@ type typeStringTest struct {
 
 var typeStringTests = []typeStringTest{
 	{
-		name:   "commit no-reward",
-		typ:    blob.TypeAltruistCommit,
-		expStr: "[No-FlagAnchorChannel|FlagCommitOutputs|No-FlagReward]",
+		name: "commit no-reward",
+		typ:  blob.TypeAltruistCommit,
+		expStr: "[No-FlagHtlcOutputs|No-FlagAnchorChannel|" +
+			"FlagCommitOutputs|No-FlagReward]",
 	},
 	{
-		name:   "commit reward",
-		typ:    blob.TypeRewardCommit,
-		expStr: "[No-FlagAnchorChannel|FlagCommitOutputs|FlagReward]",
+		name: "commit reward",
+		typ:  blob.TypeRewardCommit,
+		expStr: "[No-FlagHtlcOutputs|No-FlagAnchorChannel|" +
+			"FlagCommitOutputs|FlagReward]",
 	},
 	{
-		name:   "unknown flag",
-		typ:    unknownFlag.Type(),
-		expStr: "0000000000010000[No-FlagAnchorChannel|No-FlagCommitOutputs|No-FlagReward]",
+		name: "commit htlc reward",
+		typ:  blob.TypeRewardCommitHtlc,
+		expStr: "[FlagHtlcOutputs|No-FlagAnchorChannel|" +
+			"FlagCommitOutputs|FlagReward]",
+	},
+	{
+		name: "unknown flag",
+		typ:  unknownFlag.Type(),
+		expStr: "0000000000010000[No-FlagHtlcOutputs|" +
+			"No-FlagAnchorChannel|No-FlagCommitOutputs|" +
+			"No-FlagReward]",
 	},
 }
 
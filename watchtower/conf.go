@@ -1,8 +1,16 @@
 package watchtower
 
 import (
+	"encoding/hex"
+	"fmt"
 	"strconv"
 	"time"
+
+	"github.com/ltcsuite/lnd/input"
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/ltcutil"
 )
 
 // Conf specifies the watchtower options that can be configured from the command
@@ -21,6 +29,38 @@ type Conf struct {
 	// WriteTimeout specifies the duration the tower will wait when trying
 	// to write a message from a client before hanging up.
 	WriteTimeout time.Duration `long:"writetimeout" description:"Duration the watchtower server will wait for messages to be written before hanging up on client connections"`
+
+	// Reward specifies whether the tower accepts reward sessions.
+	Reward bool `long:"reward" description:"Accept sessions that pay the watchtower a reward from the funds swept by its justice transactions"`
+
+	// RewardBase is the minimum fixed reward the tower requires for reward
+	// sessions.
+	RewardBase uint32 `long:"rewardbase" description:"The minimum fixed reward in litoshis the watchtower requires for reward sessions, must not be below the dust limit"`
+
+	// RewardRate is the minimum proportional reward the tower requires for
+	// reward sessions.
+	RewardRate uint32 `long:"rewardrate" description:"The minimum proportional reward the watchtower requires for reward sessions, in millionths of the swept funds"`
+
+	// RequireClientKey specifies whether the tower only accepts sessions
+	// from clients that identify themselves.
+	RequireClientKey bool `long:"requireclientkey" description:"Only accept sessions from clients that identify themselves with a client key, implied by any client list or limit"`
+
+	// AllowedClients are the hex-encoded keys of the only clients the
+	// tower serves.
+	AllowedClients []string `long:"allowclient" description:"Add the hex-encoded key of a client allowed to use the watchtower. If any are set, only allowed clients are served"`
+
+	// DeniedClients are the hex-encoded keys of clients the tower doesn't
+	// serve.
+	DeniedClients []string `long:"denyclient" description:"Add the hex-encoded key of a client denied from using the watchtower"`
+
+	// MaxClientSessions is the maximum number of sessions per client.
+	MaxClientSessions uint32 `long:"maxclientsessions" description:"The maximum number of sessions each identified client can create, 0 for unlimited"`
+
+	// MaxClientUpdates is the maximum number of updates per client.
+	MaxClientUpdates uint32 `long:"maxclientupdates" description:"The maximum number of state updates each identified client can negotiate over all of its sessions, 0 for unlimited"`
+
+	// MaxClientStorage is the maximum storage per client in bytes.
+	MaxClientStorage uint64 `long:"maxclientstorage" description:"The maximum number of bytes the backups of each identified client can occupy, 0 for unlimited"`
 }
 
 // Apply completes the passed Config struct by applying any parsed Conf options.
@@ -86,5 +126,92 @@ func (c *Conf) Apply(cfg *Config,
 		cfg.WriteTimeout = c.WriteTimeout
 	}
 
+	// If the Config doesn't accept reward sessions, we will use the parsed
+	// Conf values.
+	if !cfg.Reward && c.Reward {
+		if c.RewardRate >= wtpolicy.RewardScale {
+			return nil, ErrInvalidRewardRate
+		}
+
+		// Our reward addresses are p2wpkh, a base reward below that
+		// script's dust limit would let clients negotiate sessions
+		// that never pay out.
+		if ltcutil.Amount(c.RewardBase) <
+			lnwallet.DustLimitForSize(input.P2WPKHSize) {
+
+			return nil, ErrRewardBaseDust
+		}
+
+		cfg.Reward = c.Reward
+		cfg.RewardBase = c.RewardBase
+		cfg.RewardRate = c.RewardRate
+	}
+
+	// Parse the client keys of the allow and deny lists if the Config
+	// doesn't have any.
+	if cfg.AllowedClients == nil {
+		var err error
+		cfg.AllowedClients, err = parseClientKeys(c.AllowedClients)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.DeniedClients == nil {
+		var err error
+		cfg.DeniedClients, err = parseClientKeys(c.DeniedClients)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// If the Config has no client limits, we will use the parsed Conf
+	// values.
+	if cfg.ClientQuota.MaxSessions == 0 {
+		cfg.ClientQuota.MaxSessions = c.MaxClientSessions
+	}
+	if cfg.ClientQuota.MaxUpdates == 0 {
+		cfg.ClientQuota.MaxUpdates = c.MaxClientUpdates
+	}
+	if cfg.ClientQuota.MaxStorageBytes == 0 {
+		cfg.ClientQuota.MaxStorageBytes = c.MaxClientStorage
+	}
+
+	// Client permissions and quotas can only be enforced for clients that
+	// identify themselves, so any of them requires a client key.
+	quota := cfg.ClientQuota
+	if len(cfg.AllowedClients) != 0 || len(cfg.DeniedClients) != 0 ||
+		quota.MaxSessions != 0 || quota.MaxUpdates != 0 ||
+		quota.MaxStorageBytes != 0 {
+
+		cfg.RequireClientKey = true
+	}
+
+	if !cfg.RequireClientKey {
+		cfg.RequireClientKey = c.RequireClientKey
+	}
+
 	return cfg, nil
 }
+
+// parseClientKeys parses a list of hex-encoded client keys.
+func parseClientKeys(rawKeys []string) ([]*btcec.PublicKey, error) {
+	var keys []*btcec.PublicKey
+	for _, rawKey := range rawKeys {
+		keyBytes, err := hex.DecodeString(rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w %v: %v", ErrInvalidClientKey,
+				rawKey, err)
+		}
+
+		key, err := btcec.ParsePubKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w %v: %v", ErrInvalidClientKey,
+				rawKey, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,123 @@
+package watchtower_test
+
+import (
+	"encoding/hex"
+	"net"
+	"testing"
+
+	"github.com/ltcsuite/lnd/input"
+	"github.com/ltcsuite/lnd/lnwallet"
+	"github.com/ltcsuite/lnd/watchtower"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// applyConf applies the given Conf to a Config with its addresses already set,
+// so that no network is needed.
+func applyConf(c *watchtower.Conf) (*watchtower.Config, error) {
+	cfg := &watchtower.Config{
+		ListenAddrs: []net.Addr{},
+		ExternalIPs: []net.Addr{},
+	}
+
+	return c.Apply(cfg, nil)
+}
+
+// TestConfApplyRewardBase asserts that reward sessions are only accepted with
+// a base reward of at least the dust limit of the tower's reward output.
+func TestConfApplyRewardBase(t *testing.T) {
+	dustLimit := uint32(lnwallet.DustLimitForSize(input.P2WPKHSize))
+
+	_, err := applyConf(&watchtower.Conf{
+		Reward: true,
+	})
+	require.ErrorIs(t, err, watchtower.ErrRewardBaseDust)
+
+	_, err = applyConf(&watchtower.Conf{
+		Reward:     true,
+		RewardBase: dustLimit - 1,
+	})
+	require.ErrorIs(t, err, watchtower.ErrRewardBaseDust)
+
+	cfg, err := applyConf(&watchtower.Conf{
+		Reward:     true,
+		RewardBase: dustLimit,
+	})
+	require.NoError(t, err)
+	require.True(t, cfg.Reward)
+	require.Equal(t, dustLimit, cfg.RewardBase)
+
+	// The base reward doesn't matter for altruist towers.
+	cfg, err = applyConf(&watchtower.Conf{})
+	require.NoError(t, err)
+	require.False(t, cfg.Reward)
+}
+
+// TestConfApplyRequireClientKey asserts that any client list or quota requires
+// clients to identify themselves, as they couldn't be enforced otherwise.
+func TestConfApplyRequireClientKey(t *testing.T) {
+	clientKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	rawKey := hex.EncodeToString(clientKey.PubKey().SerializeCompressed())
+
+	tests := []struct {
+		name       string
+		conf       watchtower.Conf
+		requireKey bool
+	}{
+		{
+			name: "no limits",
+		},
+		{
+			name: "explicitly required",
+			conf: watchtower.Conf{
+				RequireClientKey: true,
+			},
+			requireKey: true,
+		},
+		{
+			name: "allowed clients",
+			conf: watchtower.Conf{
+				AllowedClients: []string{rawKey},
+			},
+			requireKey: true,
+		},
+		{
+			name: "denied clients",
+			conf: watchtower.Conf{
+				DeniedClients: []string{rawKey},
+			},
+			requireKey: true,
+		},
+		{
+			name: "max sessions",
+			conf: watchtower.Conf{
+				MaxClientSessions: 1,
+			},
+			requireKey: true,
+		},
+		{
+			name: "max updates",
+			conf: watchtower.Conf{
+				MaxClientUpdates: 1,
+			},
+			requireKey: true,
+		},
+		{
+			name: "max storage",
+			conf: watchtower.Conf{
+				MaxClientStorage: 1,
+			},
+			requireKey: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := applyConf(&test.conf)
+			require.NoError(t, err)
+			require.Equal(t, test.requireKey, cfg.RequireClientKey)
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/ltcsuite/lnd/keychain"
 	"github.com/ltcsuite/lnd/tor"
 	"github.com/ltcsuite/lnd/watchtower/lookout"
+	"github.com/ltcsuite/lnd/watchtower/wtserver"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 	"github.com/ltcsuite/ltcd/ltcutil"
 	"github.com/ltcsuite/ltcd/wire"
@@ -101,4 +103,32 @@ type Config struct {
 	// Type specifies the hidden service type (V2 or V3) that the watchtower
 	// will create.
 	Type tor.OnionType
+
+	// Reward specifies whether the tower accepts sessions that pay it a
+	// reward from the justice transactions it publishes.
+	Reward bool
+
+	// RewardBase is the minimum fixed reward the tower requires for reward
+	// sessions.
+	RewardBase uint32
+
+	// RewardRate is the minimum proportional reward the tower requires for
+	// reward sessions, expressed in millionths of the swept funds.
+	RewardRate uint32
+
+	// RequireClientKey specifies whether the tower rejects sessions from
+	// clients that don't identify themselves with a client key. It is
+	// implied by any allowed or denied clients and client quota.
+	RequireClientKey bool
+
+	// AllowedClients are the client keys of the only clients the tower
+	// serves. If empty, all clients that aren't denied are served.
+	AllowedClients []*btcec.PublicKey
+
+	// DeniedClients are the client keys of clients the tower doesn't serve.
+	DeniedClients []*btcec.PublicKey
+
+	// ClientQuota limits the resources each identified client can use on
+	// the tower.
+	ClientQuota wtserver.ClientQuota
 }
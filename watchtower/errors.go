@@ -10,4 +10,18 @@ var (
 	// ErrNoNetwork signals that no tor.Net is provided in the Config, which
 	// prevents resolution of listening addresses.
 	ErrNoNetwork = errors.New("no network specified, must be tor or clearnet")
+
+	// ErrInvalidRewardRate signals that the configured reward rate is at
+	// least the whole swept amount.
+	ErrInvalidRewardRate = errors.New("reward rate must be below the " +
+		"reward scale")
+
+	// ErrRewardBaseDust signals that the configured base reward is below
+	// the dust limit of the tower's reward output.
+	ErrRewardBaseDust = errors.New("reward base must not be below the " +
+		"dust limit")
+
+	// ErrInvalidClientKey signals that a configured client key isn't a
+	// valid hex-encoded public key.
+	ErrInvalidClientKey = errors.New("invalid client key")
 )
@@ -4,6 +4,7 @@ import (
 	"net"
 
 	"github.com/ltcsuite/lnd/watchtower/lookout"
+	"github.com/ltcsuite/lnd/watchtower/wtdb"
 	"github.com/ltcsuite/lnd/watchtower/wtserver"
 )
 
@@ -13,6 +14,16 @@ import (
 type DB interface {
 	lookout.DB
 	wtserver.DB
+
+	// RecordReward adds the reward claimed by a justice transaction to
+	// the tower's reward ledger.
+	RecordReward(*wtdb.RewardRecord) error
+
+	// ListRewards returns all records of the tower's reward ledger.
+	ListRewards() ([]*wtdb.RewardRecord, error)
+
+	// ListSessions returns all sessions negotiated with the tower.
+	ListSessions() ([]*wtdb.SessionInfo, error)
 }
 
 // AddressNormalizer is a function signature that allows the tower to resolve
@@ -67,3 +67,10 @@ type Punisher interface {
 	// be canceled on shutdown.
 	Punish(*JusticeDescriptor, <-chan struct{}) error
 }
+
+// Metrics records statistics about the breaches found by the Service.
+type Metrics interface {
+	// BreachFound records that a breach of a client's channel was found,
+	// and that the state update for it could be decrypted.
+	BreachFound()
+}
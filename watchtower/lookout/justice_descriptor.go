@@ -1,6 +1,7 @@
 package lookout
 
 import (
+	"bytes"
 	"errors"
 
 	"github.com/ltcsuite/lnd/input"
@@ -22,6 +23,10 @@ var (
 	// ErrUnknownSweepAddrType signals that client provided an output that
 	// was not p2wkh or p2wsh.
 	ErrUnknownSweepAddrType = errors.New("sweep addr is not p2wkh or p2wsh")
+
+	// ErrHtlcScriptMismatch signals that the output at the index of an
+	// HTLC output doesn't pay to the HTLC's script.
+	ErrHtlcScriptMismatch = errors.New("htlc output script mismatch")
 )
 
 // JusticeDescriptor contains the information required to sweep a breached
@@ -166,6 +171,51 @@ func (p *JusticeDescriptor) commitToRemoteInput() (*breachedInput, error) {
 	}, nil
 }
 
+// htlcInput extracts the information required to spend the revoked HTLC
+// output.
+func (p *JusticeDescriptor) htlcInput(
+	htlc *blob.HtlcOutput) (*breachedInput, error) {
+
+	// Retrieve the HTLC's witness script from the justice kit.
+	htlcScript, err := p.JusticeKit.HtlcWitnessScript(htlc)
+	if err != nil {
+		return nil, err
+	}
+
+	htlcScriptHash, err := input.WitnessScriptHash(htlcScript)
+	if err != nil {
+		return nil, err
+	}
+
+	// Multiple HTLCs can share the same script, so the output is located
+	// by its index, and we only verify that it pays to the script.
+	index := uint32(htlc.OutputIndex)
+	if index >= uint32(len(p.BreachedCommitTx.TxOut)) {
+		return nil, ErrOutputNotFound
+	}
+
+	htlcTxOut := p.BreachedCommitTx.TxOut[index]
+	if !bytes.Equal(htlcTxOut.PkScript, htlcScriptHash) {
+		return nil, ErrHtlcScriptMismatch
+	}
+
+	// Retrieve the HTLC's witness stack, which primarily includes a
+	// signature under the revocation pubkey.
+	witnessStack, err := p.JusticeKit.HtlcRevokeWitnessStack(htlc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &breachedInput{
+		txOut: htlcTxOut,
+		outPoint: wire.OutPoint{
+			Hash:  p.BreachedCommitTx.TxHash(),
+			Index: index,
+		},
+		witness: buildWitness(witnessStack, htlcScript),
+	}, nil
+}
+
 // assembleJusticeTxn accepts the breached inputs recovered from state update
 // and attempts to construct the justice transaction that sweeps the victims
 // funds to their wallet and claims the watchtower's reward.
@@ -318,6 +368,50 @@ func (p *JusticeDescriptor) CreateJusticeTxn() (*wire.MsgTx, error) {
 	return p.assembleJusticeTxn(txWeight, sweepInputs...)
 }
 
+// CreateHtlcJusticeTxn computes the justice transaction that sweeps the given
+// revoked HTLC output of the breaching commitment transaction. Each HTLC output
+// is swept by its own justice transaction, so that the remaining ones can still
+// be swept if the breaching party spent some of them using second-level HTLC
+// transactions.
+func (p *JusticeDescriptor) CreateHtlcJusticeTxn(
+	htlc *blob.HtlcOutput) (*wire.MsgTx, error) {
+
+	var weightEstimate input.TxWeightEstimator
+
+	// Add the sweep address's contribution, depending on whether it is a
+	// p2wkh or p2wsh output.
+	switch len(p.JusticeKit.SweepAddress) {
+	case input.P2WPKHSize:
+		weightEstimate.AddP2WKHOutput()
+
+	case input.P2WSHSize:
+		weightEstimate.AddP2WSHOutput()
+
+	default:
+		return nil, ErrUnknownSweepAddrType
+	}
+
+	// Add our reward address to the weight estimate if the policy's blob
+	// type specifies a reward output.
+	if p.SessionInfo.Policy.BlobType.Has(blob.FlagReward) {
+		weightEstimate.AddP2WKHOutput()
+	}
+
+	// Assemble the revoked HTLC output, using the witness size of its
+	// revocation clause.
+	htlcInput, err := p.htlcInput(htlc)
+	if err != nil {
+		return nil, err
+	}
+	weightEstimate.AddWitnessInput(
+		blob.HtlcPenaltyWitnessSize(p.JusticeKit.BlobType, htlc.Incoming),
+	)
+
+	txWeight := int64(weightEstimate.Weight())
+
+	return p.assembleJusticeTxn(txWeight, htlcInput)
+}
+
 // findTxOutByPkScript searches the given transaction for an output whose
 // pkscript matches the query. If one is found, the TxOut is returned along with
 // the index.
@@ -1,6 +1,8 @@
 package lookout_test
 
 import (
+	"bytes"
+	"errors"
 	"testing"
 	"time"
 
@@ -52,6 +54,8 @@ var (
 	altruistCommitType = blob.FlagCommitOutputs.Type()
 
 	altruistAnchorCommitType = blob.TypeAltruistAnchorCommit
+
+	rewardAnchorCommitType = blob.TypeRewardAnchorCommit
 )
 
 // TestJusticeDescriptor asserts that a JusticeDescriptor is able to produce the
@@ -73,6 +77,10 @@ func TestJusticeDescriptor(t *testing.T) {
 			name:     "altruist anchor commit type",
 			blobType: altruistAnchorCommitType,
 		},
+		{
+			name:     "reward anchor commit type",
+			blobType: rewardAnchorCommitType,
+		},
 	}
 
 	for _, test := range tests {
@@ -331,13 +339,18 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 	}
 
 	// Construct a breach punisher that will feed published transactions
-	// over the buffered channel.
+	// and recorded rewards over the buffered channels.
 	publications := make(chan *wire.MsgTx, 1)
+	rewards := make(chan *wtdb.RewardRecord, 1)
 	punisher := lookout.NewBreachPunisher(&lookout.PunisherConfig{
 		PublishTx: func(tx *wire.MsgTx, _ string) error {
 			publications <- tx
 			return nil
 		},
+		RecordReward: func(record *wtdb.RewardRecord) error {
+			rewards <- record
+			return nil
+		},
 	})
 
 	// Exact retribution on the offender. If no error is returned, we expect
@@ -368,4 +381,408 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 
 	// Assert that the watchtower derives the same justice txn.
 	require.Equal(t, justiceTxn, wtJusticeTxn)
+
+	// Finally, assert that the reward paid by the justice txn is recorded
+	// only for reward sessions.
+	if !blobType.Has(blob.FlagReward) {
+		require.Empty(t, rewards)
+		return
+	}
+
+	var rewardOutput *wire.TxOut
+	for _, txOut := range justiceTxn.TxOut {
+		if bytes.Equal(txOut.PkScript, sessionInfo.RewardAddress) {
+			rewardOutput = txOut
+		}
+	}
+	require.NotNil(t, rewardOutput)
+
+	select {
+	case record := <-rewards:
+		require.Equal(t, sessionInfo.ID, record.SessionID)
+		require.Equal(t, breachTxn.TxHash(), record.BreachTxID)
+		require.Equal(t, justiceTxn.TxHash(), record.JusticeTxID)
+		require.Equal(
+			t, ltcutil.Amount(rewardOutput.Value), record.Amount,
+		)
+
+	default:
+		t.Fatalf("punisher did not record reward")
+	}
+}
+
+// htlcTestInput is an input of a justice transaction in
+// TestJusticeDescriptorHtlcs, along with the descriptor used to sign it.
+type htlcTestInput struct {
+	outPoint wire.OutPoint
+	sequence uint32
+	signDesc *input.SignDescriptor
+}
+
+// signTestJusticeTxn assembles a justice transaction spending the given inputs
+// to the outputs computed by the policy, and returns the signature of each
+// input by its outpoint.
+func signTestJusticeTxn(t *testing.T, signer input.Signer,
+	policy wtpolicy.Policy, sweepAddr, rewardAddr []byte, txWeight int64,
+	inputs ...*htlcTestInput) map[wire.OutPoint]lnwire.Sig {
+
+	justiceTxn := wire.NewMsgTx(2)
+
+	var totalAmt ltcutil.Amount
+	for _, inp := range inputs {
+		totalAmt += ltcutil.Amount(inp.signDesc.Output.Value)
+		justiceTxn.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: inp.outPoint,
+			Sequence:         inp.sequence,
+		})
+	}
+
+	outputs, err := policy.ComputeJusticeTxOuts(
+		totalAmt, txWeight, sweepAddr, rewardAddr,
+	)
+	require.Nil(t, err)
+
+	justiceTxn.TxOut = outputs
+	txsort.InPlaceSort(justiceTxn)
+
+	hashCache := txscript.NewTxSigHashes(justiceTxn)
+
+	sigs := make(map[wire.OutPoint]lnwire.Sig)
+	for i, txIn := range justiceTxn.TxIn {
+		for _, inp := range inputs {
+			if inp.outPoint != txIn.PreviousOutPoint {
+				continue
+			}
+
+			signDesc := *inp.signDesc
+			signDesc.SigHashes = hashCache
+			signDesc.InputIndex = i
+			signDesc.HashType = txscript.SigHashAll
+
+			rawSig, err := signer.SignOutputRaw(
+				justiceTxn, &signDesc,
+			)
+			require.Nil(t, err)
+
+			sig, err := lnwire.NewSigFromSignature(rawSig)
+			require.Nil(t, err)
+
+			sigs[inp.outPoint] = sig
+		}
+	}
+
+	return sigs
+}
+
+// TestJusticeDescriptorHtlcs asserts that the punisher sweeps each revoked HTLC
+// output in its own justice transaction if the justice kit contains them, so
+// that HTLC outputs already spent by second-level HTLC transactions don't
+// prevent sweeping the remaining ones.
+func TestJusticeDescriptorHtlcs(t *testing.T) {
+	tests := []struct {
+		name     string
+		blobType blob.Type
+	}{
+		{
+			name:     "altruist commit htlc type",
+			blobType: blob.TypeAltruistCommitHtlc,
+		},
+		{
+			name:     "reward anchor commit htlc type",
+			blobType: blob.TypeRewardAnchorCommitHtlc,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testJusticeDescriptorHtlcs(t, test.blobType)
+		})
+	}
+}
+
+func testJusticeDescriptorHtlcs(t *testing.T, blobType blob.Type) {
+	isAnchorChannel := blobType.IsAnchorChannel()
+
+	// Parse the key pairs for all keys used in the test. The to-local and
+	// to-remote keys double as the HTLC keys of the breaching party and
+	// the client.
+	revSK, revPK := btcec.PrivKeyFromBytes(revPrivBytes)
+	_, toLocalPK := btcec.PrivKeyFromBytes(toLocalPrivBytes)
+	toRemoteSK, toRemotePK := btcec.PrivKeyFromBytes(toRemotePrivBytes)
+
+	signer := wtmock.NewMockSigner()
+	var (
+		revKeyLoc      = signer.AddPrivKey(revSK)
+		toRemoteKeyLoc = signer.AddPrivKey(toRemoteSK)
+	)
+
+	toLocalScript, err := input.CommitScriptToSelf(
+		csvDelay, toLocalPK, revPK,
+	)
+	require.Nil(t, err)
+
+	toLocalScriptHash, err := input.WitnessScriptHash(toLocalScript)
+	require.Nil(t, err)
+
+	var (
+		toRemoteSequence      uint32
+		toRemoteScriptHash    []byte
+		toRemoteSigningScript []byte
+	)
+	if isAnchorChannel {
+		toRemoteSequence = 1
+		toRemoteScript, err := input.CommitScriptToRemoteConfirmed(
+			toRemotePK,
+		)
+		require.Nil(t, err)
+
+		toRemoteScriptHash, err = input.WitnessScriptHash(
+			toRemoteScript,
+		)
+		require.Nil(t, err)
+
+		toRemoteSigningScript = toRemoteScript
+	} else {
+		toRemoteScriptHash, err = input.CommitScriptUnencumbered(
+			toRemotePK,
+		)
+		require.Nil(t, err)
+
+		toRemoteSigningScript = toRemoteScriptHash
+	}
+
+	// Construct an incoming and an outgoing HTLC. Both HTLCs are stored in
+	// the justice kit.
+	htlcs := []blob.HtlcOutput{
+		{
+			OutputIndex: 2,
+			Incoming:    true,
+			PaymentHash: [32]byte{1},
+		},
+		{
+			OutputIndex: 3,
+			PaymentHash: [32]byte{2},
+			CltvExpiry:  500,
+		},
+	}
+
+	justiceKit := &blob.JusticeKit{
+		BlobType:     blobType,
+		SweepAddress: makeAddrSlice(22),
+		CSVDelay:     csvDelay,
+		HtlcOutputs:  htlcs,
+	}
+	copy(justiceKit.RevocationPubKey[:], revPK.SerializeCompressed())
+	copy(justiceKit.LocalDelayPubKey[:], toLocalPK.SerializeCompressed())
+	copy(
+		justiceKit.CommitToRemotePubKey[:],
+		toRemotePK.SerializeCompressed(),
+	)
+	copy(justiceKit.LocalHtlcPubKey[:], toLocalPK.SerializeCompressed())
+	copy(justiceKit.RemoteHtlcPubKey[:], toRemotePK.SerializeCompressed())
+
+	var htlcScripts [][]byte
+	for i := range htlcs {
+		script, err := justiceKit.HtlcWitnessScript(&htlcs[i])
+		require.Nil(t, err)
+
+		htlcScripts = append(htlcScripts, script)
+	}
+
+	// Construct the breaching commitment txn, containing the commitment
+	// outputs followed by the HTLC outputs.
+	breachTxn := &wire.MsgTx{
+		Version: 2,
+		TxOut: []*wire.TxOut{
+			{
+				Value:    100000,
+				PkScript: toLocalScriptHash,
+			},
+			{
+				Value:    200000,
+				PkScript: toRemoteScriptHash,
+			},
+		},
+	}
+	for i, script := range htlcScripts {
+		scriptHash, err := input.WitnessScriptHash(script)
+		require.Nil(t, err)
+
+		breachTxn.AddTxOut(&wire.TxOut{
+			Value:    int64(50000 * (i + 1)),
+			PkScript: scriptHash,
+		})
+	}
+	breachTxID := breachTxn.TxHash()
+
+	// Create the inputs spending the breaching commitment txn.
+	toLocalInput := &htlcTestInput{
+		outPoint: wire.OutPoint{Hash: breachTxID, Index: 0},
+		signDesc: &input.SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				KeyLocator: revKeyLoc,
+			},
+			WitnessScript: toLocalScript,
+			Output:        breachTxn.TxOut[0],
+		},
+	}
+	toRemoteInput := &htlcTestInput{
+		outPoint: wire.OutPoint{Hash: breachTxID, Index: 1},
+		sequence: toRemoteSequence,
+		signDesc: &input.SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				KeyLocator: toRemoteKeyLoc,
+				PubKey:     toRemotePK,
+			},
+			WitnessScript: toRemoteSigningScript,
+			Output:        breachTxn.TxOut[1],
+		},
+	}
+	var htlcInputs []*htlcTestInput
+	for i, script := range htlcScripts {
+		index := uint32(htlcs[i].OutputIndex)
+		htlcInputs = append(htlcInputs, &htlcTestInput{
+			outPoint: wire.OutPoint{Hash: breachTxID, Index: index},
+			signDesc: &input.SignDescriptor{
+				KeyDesc: keychain.KeyDescriptor{
+					KeyLocator: revKeyLoc,
+				},
+				WitnessScript: script,
+				Output:        breachTxn.TxOut[index],
+			},
+		})
+	}
+
+	policy := wtpolicy.Policy{
+		TxPolicy: wtpolicy.TxPolicy{
+			BlobType:     blobType,
+			SweepFeeRate: 2000,
+			RewardRate:   900000,
+		},
+	}
+	sessionInfo := &wtdb.SessionInfo{
+		Policy:        policy,
+		RewardAddress: makeAddrSlice(22),
+	}
+
+	// Compute the weight of the justice transaction sweeping the
+	// commitment outputs, and sign it.
+	var (
+		weightEstimate input.TxWeightEstimator
+		toLocalSize    = input.ToLocalPenaltyWitnessSize
+		toRemoteSize   = input.ToRemoteConfirmedWitnessSize
+	)
+	if !isAnchorChannel {
+		toLocalSize--
+		toRemoteSize = input.P2WKHWitnessSize
+	}
+	weightEstimate.AddWitnessInput(toLocalSize)
+	weightEstimate.AddWitnessInput(toRemoteSize)
+	weightEstimate.AddP2WKHOutput()
+	if blobType.Has(blob.FlagReward) {
+		weightEstimate.AddP2WKHOutput()
+	}
+
+	sigs := signTestJusticeTxn(
+		t, signer, policy, justiceKit.SweepAddress,
+		sessionInfo.RewardAddress, int64(weightEstimate.Weight()),
+		toLocalInput, toRemoteInput,
+	)
+	justiceKit.CommitToLocalSig = sigs[toLocalInput.outPoint]
+	justiceKit.CommitToRemoteSig = sigs[toRemoteInput.outPoint]
+
+	// Sign the justice transaction sweeping each HTLC output on its own.
+	for i, htlcInput := range htlcInputs {
+		var weightEstimate input.TxWeightEstimator
+		weightEstimate.AddWitnessInput(
+			blob.HtlcPenaltyWitnessSize(blobType, htlcs[i].Incoming),
+		)
+		weightEstimate.AddP2WKHOutput()
+		if blobType.Has(blob.FlagReward) {
+			weightEstimate.AddP2WKHOutput()
+		}
+
+		sigs := signTestJusticeTxn(
+			t, signer, policy, justiceKit.SweepAddress,
+			sessionInfo.RewardAddress,
+			int64(weightEstimate.Weight()), htlcInput,
+		)
+		htlcs[i].RevocationSig = sigs[htlcInput.outPoint]
+	}
+
+	justiceDesc := &lookout.JusticeDescriptor{
+		BreachedCommitTx: breachTxn,
+		SessionInfo:      sessionInfo,
+		JusticeKit:       justiceKit,
+	}
+
+	// All justice transactions are validated against the breaching
+	// commitment txn while being assembled.
+	justiceTxn, err := justiceDesc.CreateJusticeTxn()
+	require.Nil(t, err)
+	require.Len(t, justiceTxn.TxIn, 2)
+
+	var htlcJusticeTxns []*wire.MsgTx
+	for i := range htlcs {
+		htlcJusticeTxn, err := justiceDesc.CreateHtlcJusticeTxn(&htlcs[i])
+		require.Nil(t, err)
+		require.Len(t, htlcJusticeTxn.TxIn, 1)
+
+		htlcJusticeTxns = append(htlcJusticeTxns, htlcJusticeTxn)
+	}
+
+	// The punisher publishes the justice transaction sweeping the
+	// commitment outputs, along with the one sweeping each HTLC output.
+	var (
+		published []*wire.MsgTx
+		rewards   []*wtdb.RewardRecord
+		rejectTx  *wire.MsgTx
+	)
+	punisher := lookout.NewBreachPunisher(&lookout.PunisherConfig{
+		PublishTx: func(tx *wire.MsgTx, _ string) error {
+			if rejectTx != nil && tx.TxHash() == rejectTx.TxHash() {
+				return errors.New("htlc output already spent")
+			}
+
+			published = append(published, tx)
+			return nil
+		},
+		RecordReward: func(record *wtdb.RewardRecord) error {
+			rewards = append(rewards, record)
+			return nil
+		},
+	})
+
+	require.Nil(t, punisher.Punish(justiceDesc, nil))
+	require.Equal(
+		t, append([]*wire.MsgTx{justiceTxn}, htlcJusticeTxns...),
+		published,
+	)
+
+	// If an HTLC output was already spent by a second-level HTLC
+	// transaction, the remaining outputs are still swept.
+	rejectTx = htlcJusticeTxns[0]
+	published = nil
+	rewards = nil
+	require.Nil(t, punisher.Punish(justiceDesc, nil))
+	require.Equal(
+		t, []*wire.MsgTx{justiceTxn, htlcJusticeTxns[1]}, published,
+	)
+
+	// Rewards are recorded for the published transactions only.
+	if blobType.Has(blob.FlagReward) {
+		require.Len(t, rewards, 2)
+		require.Equal(t, justiceTxn.TxHash(), rewards[0].JusticeTxID)
+		require.Equal(
+			t, htlcJusticeTxns[1].TxHash(), rewards[1].JusticeTxID,
+		)
+	} else {
+		require.Empty(t, rewards)
+	}
+
+	// The justice transaction can't be created if an HTLC output doesn't
+	// pay to the HTLC's script.
+	htlcs[0].OutputIndex = 3
+	_, err = justiceDesc.CreateHtlcJusticeTxn(&htlcs[0])
+	require.ErrorIs(t, err, lookout.ErrHtlcScriptMismatch)
 }
@@ -29,6 +29,9 @@ type Config struct {
 	// Punisher handles the responsibility of crafting and broadcasting
 	// justice transaction for any breached transactions.
 	Punisher Punisher
+
+	// Metrics records the breaches found by the lookout.
+	Metrics Metrics
 }
 
 // Lookout will check any incoming blocks against the transactions found in the
@@ -225,6 +228,8 @@ func (l *Lookout) processEpoch(epoch *chainntnfs.BlockEpoch,
 			continue
 		}
 
+		l.cfg.Metrics.BreachFound()
+
 		justiceDesc := &JusticeDescriptor{
 			BreachedCommitTx: commitTx,
 			SessionInfo:      match.SessionInfo,
@@ -13,6 +13,7 @@ import (
 	"github.com/ltcsuite/lnd/watchtower/blob"
 	"github.com/ltcsuite/lnd/watchtower/lookout"
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/lnd/watchtower/wtmetrics"
 	"github.com/ltcsuite/lnd/watchtower/wtmock"
 	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
 )
@@ -81,6 +82,7 @@ func TestLookoutBreachMatching(t *testing.T) {
 		DB:             db,
 		EpochRegistrar: backend,
 		Punisher:       punisher,
+		Metrics:        wtmetrics.Metrics{},
 	})
 	if err := watcher.Start(); err != nil {
 		t.Fatalf("unable to start watcher: %v", err)
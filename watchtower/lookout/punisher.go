@@ -1,16 +1,27 @@
 package lookout
 
 import (
+	"bytes"
+	"time"
+
 	"github.com/ltcsuite/lnd/labels"
+	"github.com/ltcsuite/lnd/watchtower/blob"
+	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/ltcd/ltcutil"
 	"github.com/ltcsuite/ltcd/wire"
 )
 
 // PunisherConfig houses the resources required by the Punisher.
 type PunisherConfig struct {
 	// PublishTx provides the ability to send a signed transaction to the
-	// network.
+	// network. Publishing a transaction that is already in the mempool or
+	// confirmed must succeed, so that a breach can be punished again.
 	PublishTx func(*wire.MsgTx, string) error
 
+	// RecordReward adds the reward claimed by a published justice
+	// transaction to the tower's reward ledger.
+	RecordReward func(*wtdb.RewardRecord) error
+
 	// TODO(conner) add DB tracking and spend ntfn registration to see if
 	// ours confirmed or not
 }
@@ -30,7 +41,11 @@ func NewBreachPunisher(cfg *PunisherConfig) *BreachPunisher {
 }
 
 // Punish constructs a justice transaction given a JusticeDescriptor and
-// publishes is it to the network.
+// publishes is it to the network. If the justice kit contains revoked HTLC
+// outputs, a justice transaction sweeping each of them is published as well.
+// The breaching party may already have spent some HTLC outputs using
+// second-level HTLC transactions, in which case only their justice
+// transactions fail to publish.
 func (p *BreachPunisher) Punish(desc *JusticeDescriptor, quit <-chan struct{}) error {
 	justiceTxn, err := desc.CreateJusticeTxn()
 	if err != nil {
@@ -40,11 +55,51 @@ func (p *BreachPunisher) Punish(desc *JusticeDescriptor, quit <-chan struct{}) e
 		return err
 	}
 
+	// The HTLC outputs are swept even if the commitment outputs can't be,
+	// in which case the error is returned once they have been.
+	publishErr := p.publish(desc, justiceTxn)
+	if publishErr == nil {
+		if err := p.recordReward(desc, justiceTxn); err != nil {
+			return err
+		}
+	}
+
+	for i := range desc.JusticeKit.HtlcOutputs {
+		htlc := &desc.JusticeKit.HtlcOutputs[i]
+
+		justiceTxn, err := desc.CreateHtlcJusticeTxn(htlc)
+		if err != nil {
+			log.Warnf("Unable to create justice txn for htlc "+
+				"output %d of client=%s with breach-txid=%s: %v",
+				htlc.OutputIndex, desc.SessionInfo.ID,
+				desc.BreachedCommitTx.TxHash(), err)
+			continue
+		}
+
+		// A failure to publish most likely means the HTLC output was
+		// already spent by a second-level HTLC transaction, which
+		// doesn't prevent sweeping the remaining ones.
+		if err := p.publish(desc, justiceTxn); err != nil {
+			continue
+		}
+
+		if err := p.recordReward(desc, justiceTxn); err != nil {
+			return err
+		}
+	}
+
+	return publishErr
+}
+
+// publish broadcasts the justice transaction to the network.
+func (p *BreachPunisher) publish(desc *JusticeDescriptor,
+	justiceTxn *wire.MsgTx) error {
+
 	log.Infof("Publishing justice transaction for client=%s with txid=%s",
 		desc.SessionInfo.ID, justiceTxn.TxHash())
 
 	label := labels.MakeLabel(labels.LabelTypeJusticeTransaction, nil)
-	err = p.cfg.PublishTx(justiceTxn, label)
+	err := p.cfg.PublishTx(justiceTxn, label)
 	if err != nil {
 		log.Errorf("Unable to publish justice txn for client=%s"+
 			"with breach-txid=%s: %v",
@@ -57,3 +112,43 @@ func (p *BreachPunisher) Punish(desc *JusticeDescriptor, quit <-chan struct{}) e
 
 	return nil
 }
+
+// recordReward records the reward output of the published justice
+// transaction in our ledger, if the session pays us a reward.
+func (p *BreachPunisher) recordReward(desc *JusticeDescriptor,
+	justiceTxn *wire.MsgTx) error {
+
+	if !desc.SessionInfo.Policy.BlobType.Has(blob.FlagReward) {
+		return nil
+	}
+
+	rewardScript := desc.SessionInfo.RewardAddress
+	for _, txOut := range justiceTxn.TxOut {
+		if !bytes.Equal(txOut.PkScript, rewardScript) {
+			continue
+		}
+
+		record := &wtdb.RewardRecord{
+			SessionID:   desc.SessionInfo.ID,
+			BreachTxID:  desc.BreachedCommitTx.TxHash(),
+			JusticeTxID: justiceTxn.TxHash(),
+			Amount:      ltcutil.Amount(txOut.Value),
+			Timestamp:   time.Now(),
+		}
+
+		log.Infof("Claimed reward of %v for client=%s with "+
+			"justice-txid=%s", record.Amount, record.SessionID,
+			record.JusticeTxID)
+
+		if err := p.cfg.RecordReward(record); err != nil {
+			log.Errorf("Unable to record reward for client=%s "+
+				"with justice-txid=%s: %v", record.SessionID,
+				record.JusticeTxID, err)
+			return err
+		}
+
+		break
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/ltcsuite/lnd/brontide"
 	"github.com/ltcsuite/lnd/tor"
 	"github.com/ltcsuite/lnd/watchtower/lookout"
+	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/lnd/watchtower/wtmetrics"
 	"github.com/ltcsuite/lnd/watchtower/wtserver"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 )
@@ -33,6 +35,9 @@ type Standalone struct {
 	// transactions found in new blocks against the state updates received
 	// by the server.
 	lookout lookout.Service
+
+	// metrics records the statistics of the server and lookout.
+	metrics wtmetrics.Metrics
 }
 
 // New validates the passed Config and returns a fresh Standalone instance if
@@ -55,15 +60,19 @@ func New(cfg *Config) (*Standalone, error) {
 	}
 
 	punisher := lookout.NewBreachPunisher(&lookout.PunisherConfig{
-		PublishTx: cfg.PublishTx,
+		PublishTx:    cfg.PublishTx,
+		RecordReward: cfg.DB.RecordReward,
 	})
 
+	var metrics wtmetrics.Metrics
+
 	// Initialize the lookout service with its required resources.
 	lookout := lookout.New(&lookout.Config{
 		BlockFetcher:   cfg.BlockFetcher,
 		DB:             cfg.DB,
 		EpochRegistrar: cfg.EpochRegistrar,
 		Punisher:       punisher,
+		Metrics:        metrics,
 	})
 
 	// Create a brontide listener on each of the provided listening
@@ -90,7 +99,15 @@ func New(cfg *Config) (*Standalone, error) {
 		ReadTimeout:   cfg.ReadTimeout,
 		WriteTimeout:  cfg.WriteTimeout,
 		NewAddress:    cfg.NewAddress,
-		DisableReward: true,
+		DisableReward: !cfg.Reward,
+		RewardBase:    cfg.RewardBase,
+		RewardRate:    cfg.RewardRate,
+
+		RequireClientKey: cfg.RequireClientKey,
+		AllowedClients:   cfg.AllowedClients,
+		DeniedClients:    cfg.DeniedClients,
+		ClientQuota:      cfg.ClientQuota,
+		Metrics:          metrics,
 	})
 	if err != nil {
 		return nil, err
@@ -101,6 +118,7 @@ func New(cfg *Config) (*Standalone, error) {
 		listeners: listeners,
 		server:    server,
 		lookout:   lookout,
+		metrics:   metrics,
 	}, nil
 }
 
@@ -122,6 +140,10 @@ func (w *Standalone) Start() error {
 		}
 	}
 
+	if err := w.metrics.LoadSessions(w.cfg.DB.ListSessions); err != nil {
+		return err
+	}
+
 	if err := w.lookout.Start(); err != nil {
 		return err
 	}
@@ -216,3 +238,51 @@ func (w *Standalone) ExternalIPs() []net.Addr {
 
 	return addrs
 }
+
+// ListRewards returns the records of the watchtower's reward ledger.
+//
+// NOTE: Part of the watchtowerrpc.WatchtowerBackend interface.
+func (w *Standalone) ListRewards() ([]*wtdb.RewardRecord, error) {
+	return w.cfg.DB.ListRewards()
+}
+
+// ListSessions returns the sessions negotiated with the watchtower. If a client
+// key is given, only the sessions of that client are returned.
+//
+// NOTE: Part of the watchtowerrpc.WatchtowerBackend interface.
+func (w *Standalone) ListSessions(
+	clientKey *btcec.PublicKey) ([]*wtdb.SessionInfo, error) {
+
+	if clientKey == nil {
+		return w.cfg.DB.ListSessions()
+	}
+
+	return w.cfg.DB.ListClientSessions(clientKey)
+}
+
+// RevokeSessions deletes the given sessions and all of their state updates
+// from the watchtower, after which it no longer watches for their breaches.
+// The number of revoked sessions is returned, which excludes sessions that
+// don't exist.
+//
+// NOTE: Part of the watchtowerrpc.WatchtowerBackend interface.
+func (w *Standalone) RevokeSessions(ids ...wtdb.SessionID) (int, error) {
+	var numRevoked int
+	for i := range ids {
+		err := w.cfg.DB.DeleteSession(ids[i])
+		switch {
+		case err == wtdb.ErrSessionNotFound:
+			continue
+
+		case err != nil:
+			return numRevoked, err
+		}
+
+		log.Infof("Revoked session %s", ids[i])
+
+		w.metrics.SessionDeleted()
+		numRevoked++
+	}
+
+	return numRevoked, nil
+}
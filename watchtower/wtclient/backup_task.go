@@ -1,7 +1,9 @@
 package wtclient
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/ltcsuite/lnd/channeldb"
 	"github.com/ltcsuite/lnd/input"
@@ -45,6 +47,7 @@ type backupTask struct {
 
 	toLocalInput  input.Input
 	toRemoteInput input.Input
+	htlcInputs    []*htlcInput
 	totalAmt      ltcutil.Amount
 	sweepPkScript []byte
 
@@ -52,6 +55,23 @@ type backupTask struct {
 
 	blobType blob.Type
 	outputs  []*wire.TxOut
+
+	// htlcs are the HTLC inputs that are each swept by their own justice
+	// transaction, whose outputs are found in htlcOutputs by the input's
+	// outpoint. These are only set if the session's blob type supports
+	// HTLC outputs.
+	htlcs       []*htlcInput
+	htlcOutputs map[wire.OutPoint][]*wire.TxOut
+}
+
+// htlcInput is a revoked HTLC output of the breach transaction, along with
+// the information the tower needs to reconstruct its script.
+type htlcInput struct {
+	input.Input
+
+	// htlc contains the HTLC's script parameters. Its revocation signature
+	// is only set once the justice transaction is signed.
+	htlc blob.HtlcOutput
 }
 
 // newBackupTask initializes a new backupTask and populates all state-dependent
@@ -123,6 +143,7 @@ func newBackupTask(chanID *lnwire.ChannelID,
 	}
 
 	return &backupTask{
+		htlcInputs: newHtlcInputs(breachInfo, chanType),
 		id: wtdb.BackupID{
 			ChanID:       *chanID,
 			CommitHeight: breachInfo.RevokedStateNum,
@@ -136,6 +157,98 @@ func newBackupTask(chanID *lnwire.ChannelID,
 	}
 }
 
+// newHtlcInputs returns the revoked HTLC outputs of the breach transaction
+// that can be backed up. HTLCs whose script can't be reconstructed from the
+// information in a justice kit are skipped.
+func newHtlcInputs(breachInfo *lnwallet.BreachRetribution,
+	chanType channeldb.ChannelType) []*htlcInput {
+
+	if len(breachInfo.HtlcRetributions) == 0 {
+		return nil
+	}
+
+	// The HTLC scripts are reconstructed using the same keys the tower
+	// would find in the justice kit. The breaching party's HTLC key is the
+	// key ring's remote one.
+	blobType := blob.TypeAltruistCommitHtlc
+	if chanType.HasAnchors() {
+		blobType = blob.TypeAltruistAnchorCommitHtlc
+	}
+
+	keyRing := breachInfo.KeyRing
+	kit := &blob.JusticeKit{
+		BlobType:         blobType,
+		RevocationPubKey: toBlobPubKey(keyRing.RevocationKey),
+		LocalHtlcPubKey:  toBlobPubKey(keyRing.RemoteHtlcKey),
+		RemoteHtlcPubKey: toBlobPubKey(keyRing.LocalHtlcKey),
+	}
+
+	var htlcInputs []*htlcInput
+	for i := range breachInfo.HtlcRetributions {
+		retribution := &breachInfo.HtlcRetributions[i]
+		outPoint := retribution.OutPoint
+
+		var htlc *channeldb.HTLC
+		for j := range breachInfo.PendingHTLCs {
+			pending := &breachInfo.PendingHTLCs[j]
+			if pending.OutputIndex == int32(outPoint.Index) {
+				htlc = pending
+				break
+			}
+		}
+		if htlc == nil || outPoint.Index > uint32(^uint16(0)) {
+			log.Warnf("Unable to back up htlc output %v", outPoint)
+			continue
+		}
+
+		blobHtlc := blob.HtlcOutput{
+			OutputIndex: uint16(outPoint.Index),
+			Incoming:    retribution.IsIncoming,
+			PaymentHash: htlc.RHash,
+			CltvExpiry:  htlc.RefundTimeout,
+		}
+
+		script, err := kit.HtlcWitnessScript(&blobHtlc)
+		if err != nil || !bytes.Equal(
+			script, retribution.SignDesc.WitnessScript,
+		) {
+
+			log.Warnf("Unable to reconstruct script of htlc "+
+				"output %v", outPoint)
+			continue
+		}
+
+		// The witness type depends on whether the HTLC was offered
+		// by us, or accepted from the breaching party.
+		witnessType := input.HtlcOfferedRevoke
+		if retribution.IsIncoming {
+			witnessType = input.HtlcAcceptedRevoke
+		}
+
+		htlcInputs = append(htlcInputs, &htlcInput{
+			Input: input.NewBaseInput(
+				&retribution.OutPoint, witnessType,
+				&retribution.SignDesc, 0,
+			),
+			htlc: blobHtlc,
+		})
+	}
+
+	return htlcInputs
+}
+
+// clone returns a copy of the task without any session-dependent variables,
+// allowing the same revoked state to be bound to sessions with several towers.
+func (t *backupTask) clone() *backupTask {
+	task := *t
+	task.blobType = 0
+	task.outputs = nil
+	task.htlcs = nil
+	task.htlcOutputs = nil
+
+	return &task
+}
+
 // inputs returns all non-dust inputs that we will attempt to spend from.
 //
 // NOTE: Ordering of the inputs is not critical as we sort the transaction with
@@ -220,9 +333,72 @@ func (t *backupTask) bindSession(session *wtdb.ClientSessionBody) error {
 	t.blobType = session.Policy.BlobType
 	t.outputs = outputs
 
+	if !session.Policy.BlobType.HasHtlcOutputs() {
+		return nil
+	}
+
+	// If the session supports HTLC outputs, the tower is also given a
+	// justice transaction for each economical HTLC output, sweeping only
+	// that output. Since they are signed independently, the tower can
+	// still sweep the remaining HTLC outputs if some were already spent
+	// by second-level HTLC transactions.
+	t.htlcs, t.htlcOutputs = t.selectHtlcs(session)
+
 	return nil
 }
 
+// selectHtlcs returns the HTLC inputs whose value exceeds the fee required to
+// sweep them in their own justice transaction at the session's fee rate, along
+// with the outputs of those justice transactions by the input's outpoint. If
+// there are more than can be encoded in a justice kit, the largest ones are
+// selected. The returned inputs are ordered by their output index.
+func (t *backupTask) selectHtlcs(session *wtdb.ClientSessionBody) (
+	[]*htlcInput, map[wire.OutPoint][]*wire.TxOut) {
+
+	var (
+		htlcs       []*htlcInput
+		htlcOutputs = make(map[wire.OutPoint][]*wire.TxOut)
+	)
+	for _, h := range t.htlcInputs {
+		var weightEstimate input.TxWeightEstimator
+		weightEstimate.AddWitnessInput(blob.HtlcPenaltyWitnessSize(
+			session.Policy.BlobType, h.htlc.Incoming,
+		))
+		weightEstimate.AddP2WKHOutput()
+		if session.Policy.BlobType.Has(blob.FlagReward) {
+			weightEstimate.AddP2WKHOutput()
+		}
+
+		outputs, err := session.Policy.ComputeJusticeTxOuts(
+			ltcutil.Amount(h.SignDesc().Output.Value),
+			int64(weightEstimate.Weight()), t.sweepPkScript,
+			session.RewardPkScript,
+		)
+		if err != nil {
+			log.Debugf("Not backing up htlc output %v of %v: %v",
+				h.OutPoint(), t.id, err)
+			continue
+		}
+
+		htlcs = append(htlcs, h)
+		htlcOutputs[*h.OutPoint()] = outputs
+	}
+
+	if len(htlcs) > blob.MaxHtlcOutputs {
+		sort.Slice(htlcs, func(i, j int) bool {
+			return htlcs[i].SignDesc().Output.Value >
+				htlcs[j].SignDesc().Output.Value
+		})
+		htlcs = htlcs[:blob.MaxHtlcOutputs]
+	}
+
+	sort.Slice(htlcs, func(i, j int) bool {
+		return htlcs[i].htlc.OutputIndex < htlcs[j].htlc.OutputIndex
+	})
+
+	return htlcs, htlcOutputs
+}
+
 // craftSessionPayload is the final stage for a backupTask, and generates the
 // encrypted payload and breach hint that should be sent to the tower. This
 // method computes the final justice transaction using the bound
@@ -255,14 +431,88 @@ func (t *backupTask) craftSessionPayload(
 		)
 	}
 
-	// Now, begin construction of the justice transaction. We'll start with
-	// a version 2 transaction.
+	// Sign the justice transaction sweeping the commitment outputs, and
+	// copy the signatures into the justice kit.
+	inputs := t.inputs()
+	sigs, err := signJusticeTxn(signer, inputs, t.outputs)
+	if err != nil {
+		return hint, nil, err
+	}
+
+	for prevOutPoint, inp := range inputs {
+		// Copy the serialized signature into the justice kit, using the
+		// input's witness type to select the appropriate field.
+		switch inp.WitnessType() {
+		case input.CommitmentRevoke:
+			justiceKit.CommitToLocalSig = sigs[prevOutPoint]
+
+		case input.CommitSpendNoDelayTweakless:
+			fallthrough
+		case input.CommitmentNoDelay:
+			fallthrough
+		case input.CommitmentToRemoteConfirmed:
+			justiceKit.CommitToRemoteSig = sigs[prevOutPoint]
+		default:
+			return hint, nil, fmt.Errorf("invalid witness type: %v",
+				inp.WitnessType())
+		}
+	}
+
+	// If HTLC outputs were selected when binding the task, also sign the
+	// justice transaction sweeping each of them. Unlike its to-local key,
+	// the key ring's HTLC keys aren't relative to the owner of the
+	// commitment, so the breaching party's HTLC key is the remote one.
+	if len(t.htlcs) > 0 {
+		justiceKit.LocalHtlcPubKey = toBlobPubKey(keyRing.RemoteHtlcKey)
+		justiceKit.RemoteHtlcPubKey = toBlobPubKey(keyRing.LocalHtlcKey)
+	}
+
+	for _, h := range t.htlcs {
+		outPoint := *h.OutPoint()
+		htlcInputs := map[wire.OutPoint]input.Input{
+			outPoint: h,
+		}
+
+		sigs, err := signJusticeTxn(
+			signer, htlcInputs, t.htlcOutputs[outPoint],
+		)
+		if err != nil {
+			return hint, nil, err
+		}
+
+		htlc := h.htlc
+		htlc.RevocationSig = sigs[outPoint]
+		justiceKit.HtlcOutputs = append(justiceKit.HtlcOutputs, htlc)
+	}
+
+	breachTxID := t.breachInfo.BreachTransaction.TxHash()
+
+	// Compute the breach key as SHA256(txid).
+	hint, key := blob.NewBreachHintAndKeyFromHash(&breachTxID)
+
+	// Then, we'll encrypt the computed justice kit using the full breach
+	// transaction id, which will allow the tower to recover the contents
+	// after the transaction is seen in the chain or mempool.
+	encBlob, err := justiceKit.Encrypt(key)
+	if err != nil {
+		return hint, nil, err
+	}
+
+	return hint, encBlob, nil
+}
+
+// signJusticeTxn assembles the justice transaction spending the given inputs
+// to the given outputs, and returns the signature of each input by its
+// outpoint.
+func signJusticeTxn(signer input.Signer, inputs map[wire.OutPoint]input.Input,
+	outputs []*wire.TxOut) (map[wire.OutPoint]lnwire.Sig, error) {
+
+	// Begin construction of the justice transaction. We'll start with a
+	// version 2 transaction.
 	justiceTxn := wire.NewMsgTx(2)
 
 	// Next, add the non-dust inputs that were derived from the breach
-	// information. This will either be contain both the to-local and
-	// to-remote outputs, or only be the to-local output.
-	inputs := t.inputs()
+	// information.
 	for prevOutPoint, input := range inputs {
 		justiceTxn.AddTxIn(&wire.TxIn{
 			PreviousOutPoint: prevOutPoint,
@@ -272,7 +522,7 @@ func (t *backupTask) craftSessionPayload(
 
 	// Add the sweep output paying directly to the user and possibly a
 	// reward output, using the outputs computed when the task was bound.
-	justiceTxn.TxOut = t.outputs
+	justiceTxn.TxOut = outputs
 
 	// Sort the justice transaction according to BIP69.
 	txsort.InPlaceSort(justiceTxn)
@@ -281,7 +531,7 @@ func (t *backupTask) craftSessionPayload(
 	// before attempting to attach the witnesses.
 	btx := ltcutil.NewTx(justiceTxn)
 	if err := blockchain.CheckTransactionSanity(btx); err != nil {
-		return hint, nil, err
+		return nil, err
 	}
 
 	// Construct a sighash cache to improve signing performance.
@@ -296,18 +546,18 @@ func (t *backupTask) craftSessionPayload(
 	}
 
 	// Now, iterate through the list of inputs that were initially added to
-	// the transaction and store the computed witness within the justice
-	// kit.
-	for _, inp := range inputs {
+	// the transaction and compute the signature of each input.
+	sigs := make(map[wire.OutPoint]lnwire.Sig, len(inputs))
+	for prevOutPoint, inp := range inputs {
 		// Lookup the input's new post-sort position.
-		i := inputIndex[*inp.OutPoint()]
+		i := inputIndex[prevOutPoint]
 
 		// Construct the full witness required to spend this input.
 		inputScript, err := inp.CraftInputScript(
 			signer, justiceTxn, hashCache, i,
 		)
 		if err != nil {
-			return hint, nil, err
+			return nil, err
 		}
 
 		// Parse the DER-encoded signature from the first position of
@@ -320,42 +570,13 @@ func (t *backupTask) craftSessionPayload(
 		// signature.
 		signature, err := lnwire.NewSigFromRawSignature(rawSignature)
 		if err != nil {
-			return hint, nil, err
-		}
-
-		// Finally, copy the serialized signature into the justice kit,
-		// using the input's witness type to select the appropriate
-		// field.
-		switch inp.WitnessType() {
-		case input.CommitmentRevoke:
-			copy(justiceKit.CommitToLocalSig[:], signature[:])
-
-		case input.CommitSpendNoDelayTweakless:
-			fallthrough
-		case input.CommitmentNoDelay:
-			fallthrough
-		case input.CommitmentToRemoteConfirmed:
-			copy(justiceKit.CommitToRemoteSig[:], signature[:])
-		default:
-			return hint, nil, fmt.Errorf("invalid witness type: %v",
-				inp.WitnessType())
+			return nil, err
 		}
-	}
-
-	breachTxID := t.breachInfo.BreachTransaction.TxHash()
-
-	// Compute the breach key as SHA256(txid).
-	hint, key := blob.NewBreachHintAndKeyFromHash(&breachTxID)
 
-	// Then, we'll encrypt the computed justice kit using the full breach
-	// transaction id, which will allow the tower to recover the contents
-	// after the transaction is seen in the chain or mempool.
-	encBlob, err := justiceKit.Encrypt(key)
-	if err != nil {
-		return hint, nil, err
+		sigs[prevOutPoint] = signature
 	}
 
-	return hint, encBlob, nil
+	return sigs, nil
 }
 
 // toBlobPubKey serializes the given pubkey into a blob.PubKey that can be set
@@ -15,6 +15,7 @@ import (
 	"github.com/ltcsuite/lnd/lnwallet/chainfee"
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/watchtower/blob"
+	"github.com/ltcsuite/lnd/watchtower/lookout"
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
 	"github.com/ltcsuite/lnd/watchtower/wtmock"
 	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
@@ -23,6 +24,7 @@ import (
 	"github.com/ltcsuite/ltcd/ltcutil"
 	"github.com/ltcsuite/ltcd/txscript"
 	"github.com/ltcsuite/ltcd/wire"
+	"github.com/stretchr/testify/require"
 )
 
 const csvDelay uint32 = 144
@@ -694,3 +696,211 @@ func testBackupTask(t *testing.T, test backupTaskTest) {
 		t.Fatalf("to-remote signature should be empty")
 	}
 }
+
+// TestBackupTaskHtlcs asserts that a backup task bound to a session supporting
+// HTLC outputs backs up the economical revoked HTLC outputs, and that the
+// tower is able to reconstruct valid justice transactions from the payload.
+func TestBackupTaskHtlcs(t *testing.T) {
+	t.Parallel()
+
+	const (
+		toLocalAmt  = 100000
+		toRemoteAmt = 200000
+	)
+
+	// The revocation key is derived from the revocation base point and the
+	// commitment secret, which are part of the HTLC sign descriptors.
+	revBaseSK, revBasePK := btcec.PrivKeyFromBytes(revPrivBytes)
+	commitSecret, _ := btcec.PrivKeyFromBytes(bytes.Repeat([]byte{1}, 32))
+	revSK := input.DeriveRevocationPrivKey(revBaseSK, commitSecret)
+	revPK := revSK.PubKey()
+
+	_, toLocalPK := btcec.PrivKeyFromBytes(toLocalPrivBytes)
+	toRemoteSK, toRemotePK := btcec.PrivKeyFromBytes(toRemotePrivBytes)
+	_, localHtlcPK := btcec.PrivKeyFromBytes(bytes.Repeat([]byte{2}, 32))
+	_, remoteHtlcPK := btcec.PrivKeyFromBytes(bytes.Repeat([]byte{3}, 32))
+
+	signer := wtmock.NewMockSigner()
+	var (
+		revKeyLoc      = signer.AddPrivKey(revSK)
+		toRemoteKeyLoc = signer.AddPrivKey(toRemoteSK)
+	)
+
+	keyRing := &lnwallet.CommitmentKeyRing{
+		RevocationKey: revPK,
+		ToLocalKey:    toLocalPK,
+		ToRemoteKey:   toRemotePK,
+		LocalHtlcKey:  localHtlcPK,
+		RemoteHtlcKey: remoteHtlcPK,
+	}
+
+	toLocalScript, err := input.CommitScriptToSelf(
+		csvDelay, toLocalPK, revPK,
+	)
+	require.NoError(t, err)
+	toLocalPkScript, err := input.WitnessScriptHash(toLocalScript)
+	require.NoError(t, err)
+
+	toRemotePkScript, err := input.CommitScriptUnencumbered(toRemotePK)
+	require.NoError(t, err)
+
+	// Create an incoming and an outgoing HTLC, using the same scripts as
+	// the remote party's commitment transaction, and an HTLC that isn't
+	// worth sweeping.
+	htlcs := []channeldb.HTLC{
+		{
+			RHash:       [32]byte{1},
+			Amt:         lnwire.NewMSatFromSatoshis(50000),
+			OutputIndex: 2,
+			Incoming:    true,
+		},
+		{
+			RHash:         [32]byte{2},
+			Amt:           lnwire.NewMSatFromSatoshis(60000),
+			RefundTimeout: 500,
+			OutputIndex:   3,
+		},
+		{
+			RHash:       [32]byte{3},
+			Amt:         lnwire.NewMSatFromSatoshis(1000),
+			OutputIndex: 4,
+			Incoming:    true,
+		},
+	}
+
+	breachTxn := wire.NewMsgTx(2)
+	breachTxn.AddTxOut(wire.NewTxOut(toLocalAmt, toLocalPkScript))
+	breachTxn.AddTxOut(wire.NewTxOut(toRemoteAmt, toRemotePkScript))
+
+	var htlcScripts [][]byte
+	for _, htlc := range htlcs {
+		var script []byte
+		if htlc.Incoming {
+			script, err = input.SenderHTLCScript(
+				keyRing.RemoteHtlcKey, keyRing.LocalHtlcKey,
+				keyRing.RevocationKey, htlc.RHash[:], false,
+			)
+		} else {
+			script, err = input.ReceiverHTLCScript(
+				htlc.RefundTimeout, keyRing.LocalHtlcKey,
+				keyRing.RemoteHtlcKey, keyRing.RevocationKey,
+				htlc.RHash[:], false,
+			)
+		}
+		require.NoError(t, err)
+		htlcScripts = append(htlcScripts, script)
+
+		pkScript, err := input.WitnessScriptHash(script)
+		require.NoError(t, err)
+
+		breachTxn.AddTxOut(
+			wire.NewTxOut(int64(htlc.Amt.ToSatoshis()), pkScript),
+		)
+	}
+	txid := breachTxn.TxHash()
+
+	breachInfo := &lnwallet.BreachRetribution{
+		BreachTransaction: breachTxn,
+		PendingHTLCs:      htlcs,
+		RemoteOutputSignDesc: &input.SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				KeyLocator: revKeyLoc,
+			},
+			WitnessScript: toLocalScript,
+			Output:        breachTxn.TxOut[0],
+			HashType:      txscript.SigHashAll,
+		},
+		RemoteOutpoint: wire.OutPoint{Hash: txid, Index: 0},
+		LocalOutputSignDesc: &input.SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				KeyLocator: toRemoteKeyLoc,
+				PubKey:     toRemotePK,
+			},
+			WitnessScript: toRemotePkScript,
+			Output:        breachTxn.TxOut[1],
+			HashType:      txscript.SigHashAll,
+		},
+		LocalOutpoint: wire.OutPoint{Hash: txid, Index: 1},
+		RemoteDelay:   csvDelay,
+		KeyRing:       keyRing,
+	}
+	for i, htlc := range htlcs {
+		index := uint32(htlc.OutputIndex)
+		breachInfo.HtlcRetributions = append(
+			breachInfo.HtlcRetributions, lnwallet.HtlcRetribution{
+				SignDesc: input.SignDescriptor{
+					KeyDesc: keychain.KeyDescriptor{
+						KeyLocator: revKeyLoc,
+						PubKey:     revBasePK,
+					},
+					DoubleTweak:   commitSecret,
+					WitnessScript: htlcScripts[i],
+					Output:        breachTxn.TxOut[index],
+					HashType:      txscript.SigHashAll,
+				},
+				OutPoint: wire.OutPoint{
+					Hash:  txid,
+					Index: index,
+				},
+				IsIncoming: htlc.Incoming,
+			},
+		)
+	}
+
+	var chanID lnwire.ChannelID
+	task := newBackupTask(
+		&chanID, breachInfo, makeAddrSlice(22),
+		channeldb.SingleFunderTweaklessBit,
+	)
+	require.Len(t, task.htlcInputs, 3)
+
+	// Bind the task to a session supporting HTLC outputs. The small HTLC
+	// isn't worth sweeping at the session's fee rate.
+	session := &wtdb.ClientSessionBody{
+		Policy: wtpolicy.Policy{
+			TxPolicy: wtpolicy.TxPolicy{
+				BlobType:     blob.TypeAltruistCommitHtlc,
+				SweepFeeRate: 2500,
+			},
+		},
+	}
+	require.NoError(t, task.bindSession(session))
+	require.Len(t, task.htlcs, 2)
+	require.Len(t, task.clone().htlcs, 0)
+
+	_, encBlob, err := task.craftSessionPayload(signer)
+	require.NoError(t, err)
+
+	key := blob.NewBreachKeyFromHash(&txid)
+	jKit, err := blob.Decrypt(key, encBlob, session.Policy.BlobType)
+	require.NoError(t, err)
+	require.Len(t, jKit.HtlcOutputs, 2)
+
+	// The tower validates the witnesses of all justice transactions it
+	// reconstructs from the justice kit.
+	justiceDesc := &lookout.JusticeDescriptor{
+		BreachedCommitTx: breachTxn,
+		SessionInfo: &wtdb.SessionInfo{
+			Policy: session.Policy,
+		},
+		JusticeKit: jKit,
+	}
+
+	justiceTxn, err := justiceDesc.CreateJusticeTxn()
+	require.NoError(t, err)
+	require.Len(t, justiceTxn.TxIn, 2)
+
+	for i := range jKit.HtlcOutputs {
+		htlcJusticeTxn, err := justiceDesc.CreateHtlcJusticeTxn(
+			&jKit.HtlcOutputs[i],
+		)
+		require.NoError(t, err)
+		require.Len(t, htlcJusticeTxn.TxIn, 1)
+	}
+
+	// Sessions that don't support HTLC outputs don't back them up.
+	task = task.clone()
+	session.Policy.BlobType = blob.TypeAltruistCommit
+	require.NoError(t, task.bindSession(session))
+	require.Empty(t, task.htlcs)
+}
@@ -25,14 +25,20 @@ type TowerCandidateIterator interface {
 	// iterator.
 	IsActive(wtdb.TowerID) bool
 
+	// NumCandidates returns the number of candidate towers within the
+	// iterator.
+	NumCandidates() int
+
 	// Reset clears any internal iterator state, making previously taken
 	// candidates available as long as they remain in the set.
 	Reset() error
 
-	// Next returns the next candidate tower. The iterator is not required
-	// to return results in any particular order.  If no more candidates are
-	// available, ErrTowerCandidatesExhausted is returned.
-	Next() (*wtdb.Tower, error)
+	// Next returns the next candidate tower that isn't in the exclude set.
+	// Excluded candidates are skipped, but remain available after a Reset.
+	// The iterator is not required to return results in any particular
+	// order.  If no more candidates are available,
+	// ErrTowerCandidatesExhausted is returned.
+	Next(exclude map[wtdb.TowerID]struct{}) (*wtdb.Tower, error)
 }
 
 // towerListIterator is a linked-list backed TowerCandidateIterator.
@@ -76,10 +82,13 @@ func (t *towerListIterator) Reset() error {
 	return nil
 }
 
-// Next returns the next candidate tower. This iterator will always return
-// candidates in the order given when the iterator was instantiated.  If no more
-// candidates are available, ErrTowerCandidatesExhausted is returned.
-func (t *towerListIterator) Next() (*wtdb.Tower, error) {
+// Next returns the next candidate tower that isn't in the exclude set. This
+// iterator will always return candidates in the order given when the iterator
+// was instantiated.  If no more candidates are available,
+// ErrTowerCandidatesExhausted is returned.
+func (t *towerListIterator) Next(
+	exclude map[wtdb.TowerID]struct{}) (*wtdb.Tower, error) {
+
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
@@ -99,6 +108,12 @@ func (t *towerListIterator) Next() (*wtdb.Tower, error) {
 
 		// Set the next candidate to the subsequent element.
 		t.nextCandidate = t.nextCandidate.Next()
+
+		// Skip excluded towers, while keeping them as candidates.
+		if _, ok := exclude[towerID]; ok {
+			continue
+		}
+
 		return tower, nil
 	}
 
@@ -162,4 +177,12 @@ func (t *towerListIterator) IsActive(tower wtdb.TowerID) bool {
 	return ok
 }
 
+// NumCandidates returns the number of candidate towers within the iterator.
+func (t *towerListIterator) NumCandidates() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.candidates)
+}
+
 // TODO(conner): implement graph-backed candidate iterator for public towers.
@@ -72,7 +72,7 @@ func assertActiveCandidate(t *testing.T, i TowerCandidateIterator,
 func assertNextCandidate(t *testing.T, i TowerCandidateIterator, c *wtdb.Tower) {
 	t.Helper()
 
-	tower, err := i.Next()
+	tower, err := i.Next(nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -104,7 +104,7 @@ func TestTowerCandidateIterator(t *testing.T) {
 	// We should expect to see all of our candidates in the order that they
 	// were added.
 	for _, expTower := range towers {
-		tower, err := towerIterator.Next()
+		tower, err := towerIterator.Next(nil)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -114,7 +114,7 @@ func TestTowerCandidateIterator(t *testing.T) {
 		}
 	}
 
-	if _, err := towerIterator.Next(); err != ErrTowerCandidatesExhausted {
+	if _, err := towerIterator.Next(nil); err != ErrTowerCandidatesExhausted {
 		t.Fatalf("expected ErrTowerCandidatesExhausted, got %v", err)
 	}
 	towerIterator.Reset()
@@ -137,6 +137,9 @@ func TestTowerCandidateIterator(t *testing.T) {
 	towerIterator.RemoveCandidate(secondTower.ID, nil)
 	assertActiveCandidate(t, towerIterator, secondTower, false)
 	assertNextCandidate(t, towerIterator, thirdTower)
+	if n := towerIterator.NumCandidates(); n != numTowers-1 {
+		t.Fatalf("expected %d candidates, got %d", numTowers-1, n)
+	}
 
 	// We'll then update the fourth candidate with a new address. A
 	// duplicate shouldn't be added since it already exists within the
@@ -153,4 +156,49 @@ func TestTowerCandidateIterator(t *testing.T) {
 	towerIterator.AddCandidate(secondTower)
 	assertActiveCandidate(t, towerIterator, secondTower, true)
 	assertNextCandidate(t, towerIterator, secondTower)
+	if n := towerIterator.NumCandidates(); n != numTowers {
+		t.Fatalf("expected %d candidates, got %d", numTowers, n)
+	}
+}
+
+// TestTowerCandidateIteratorExclude asserts that excluded towers are skipped
+// by the TowerCandidateIterator, while remaining active candidates that are
+// returned again after a reset.
+func TestTowerCandidateIteratorExclude(t *testing.T) {
+	t.Parallel()
+
+	const numTowers = 3
+	towers := make([]*wtdb.Tower, 0, numTowers)
+	for i := 0; i < numTowers; i++ {
+		towers = append(towers, randTower(t))
+	}
+	towerIterator := newTowerListIterator(towers...)
+
+	// Excluding the first and last towers should only yield the second
+	// one, while all three remain active candidates.
+	exclude := map[wtdb.TowerID]struct{}{
+		towers[0].ID: {},
+		towers[2].ID: {},
+	}
+	tower, err := towerIterator.Next(exclude)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tower, towers[1]) {
+		t.Fatalf("expected tower: %v\ngot: %v",
+			spew.Sdump(towers[1]), spew.Sdump(tower))
+	}
+	if _, err := towerIterator.Next(exclude); err != ErrTowerCandidatesExhausted {
+		t.Fatalf("expected ErrTowerCandidatesExhausted, got %v", err)
+	}
+	for _, tower := range towers {
+		assertActiveCandidate(t, towerIterator, tower, true)
+	}
+
+	// After a reset, the excluded towers should be returned again once
+	// they're no longer excluded.
+	towerIterator.Reset()
+	for _, tower := range towers {
+		assertNextCandidate(t, towerIterator, tower)
+	}
 }
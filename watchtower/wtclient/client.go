@@ -10,11 +10,13 @@ import (
 
 	"github.com/btcsuite/btclog"
 	"github.com/ltcsuite/lnd/build"
+	"github.com/ltcsuite/lnd/chainntnfs"
 	"github.com/ltcsuite/lnd/channeldb"
 	"github.com/ltcsuite/lnd/input"
 	"github.com/ltcsuite/lnd/keychain"
 	"github.com/ltcsuite/lnd/lnwallet"
 	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/subscribe"
 	"github.com/ltcsuite/lnd/tor"
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
 	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
@@ -41,6 +43,10 @@ const (
 	// client should abandon any pending updates or session negotiations
 	// before terminating.
 	DefaultForceQuitDelay = 10 * time.Second
+
+	// DefaultReplicationFactor specifies the default number of distinct
+	// towers each revoked state is backed up to.
+	DefaultReplicationFactor = 1
 )
 
 // genActiveSessionFilter generates a filter that selects active sessions that
@@ -63,6 +69,10 @@ type RegisteredTower struct {
 	// ActiveSessionCandidate determines whether the watchtower is currently
 	// being considered for new sessions.
 	ActiveSessionCandidate bool
+
+	// Health is the health of the watchtower, as observed by the client
+	// since it started.
+	Health TowerHealth
 }
 
 // Client is the primary interface used by the daemon to control a client's
@@ -152,6 +162,32 @@ type Config struct {
 	// DB provides access to the client's stable storage medium.
 	DB DB
 
+	// SubscribeChannelEvents subscribes to the events of our channels, so
+	// that the client learns when the channels it backs up are closed.
+	SubscribeChannelEvents func() (*subscribe.Client, error)
+
+	// FetchClosedChannels returns the close summaries of our channels, so
+	// that the client learns about channels that were closed while it
+	// wasn't running.
+	FetchClosedChannels func() ([]*channeldb.ChannelCloseSummary, error)
+
+	// FetchBreachTxID returns the txid of the channel's revoked commitment
+	// transaction at the given commit height. It is used to recover the
+	// breach hints of updates that were acked before the client indexed
+	// them by channel.
+	FetchBreachTxID func(lnwire.ChannelID, uint64) (*chainhash.Hash, error)
+
+	// ChainNotifier is used to track the best block height, which
+	// determines when sessions whose channels are all closed are deleted.
+	ChainNotifier chainntnfs.ChainNotifier
+
+	// SessionCloseDelay is the number of blocks the client waits after the
+	// last channel of an exhausted session was closed, before deleting the
+	// session from the tower and its database. This ensures that the
+	// close is deeply confirmed first. If the value is zero, the default
+	// will be used instead.
+	SessionCloseDelay uint32
+
 	// Policy is the session policy the client will propose when creating
 	// new sessions with the tower. If the policy differs from any active
 	// sessions recorded in the database, those sessions will be ignored and
@@ -162,6 +198,11 @@ type Config struct {
 	// the tower must be watching to monitor for breaches.
 	ChainHash chainhash.Hash
 
+	// ClientKeySigner, if set, is used to identify the client to towers
+	// when negotiating sessions, by signing each session key with the
+	// signer's key. This allows hosted towers to apply per-client limits.
+	ClientKeySigner keychain.SingleKeyMessageSigner
+
 	// ForceQuitDelay is the duration after attempting to shutdown that the
 	// client will automatically abort any pending backups if an unclean
 	// shutdown is detected. If the value is less than or equal to zero, a
@@ -179,6 +220,20 @@ type Config struct {
 	// to zero, the default will be used instead.
 	WriteTimeout time.Duration
 
+	// ReplicationFactor is the number of distinct towers each revoked
+	// state is backed up to. If fewer candidate towers are available, the
+	// states are backed up to all of them. If the value is zero, the
+	// default will be used instead.
+	ReplicationFactor uint32
+
+	// TowerFailureThreshold is the number of consecutive failures to
+	// negotiate a session with or deliver a backup to a tower, after which
+	// the tower is considered unhealthy. Unless it is the last candidate,
+	// an unhealthy tower isn't considered for new sessions and backups
+	// until it recovers. If the value is zero, the default will be used
+	// instead.
+	TowerFailureThreshold uint32
+
 	// MinBackoff defines the initial backoff applied to connections with
 	// watchtowers. Subsequent backoff durations will grow exponentially up
 	// until MaxBackoff.
@@ -242,8 +297,30 @@ type TowerClient struct {
 	candidateSessions map[wtdb.SessionID]*wtdb.ClientSession
 	activeSessions    sessionQueueSet
 
-	sessionQueue *sessionQueue
-	prevTask     *backupTask
+	// sessionQueues holds the active session queue of each tower that new
+	// backups are assigned to.
+	sessionQueues map[wtdb.TowerID]*sessionQueue
+
+	// sessionRequested is true while a session requested from the
+	// negotiator wasn't delivered yet, such that no further session is
+	// requested in the meantime. It is only accessed by the backup
+	// dispatcher.
+	sessionRequested bool
+
+	// prevTask is a task that wasn't accepted by enough towers yet, and
+	// prevTaskTowers holds the towers that did accept it.
+	prevTask       *backupTask
+	prevTaskTowers map[wtdb.TowerID]struct{}
+
+	// towerHealth tracks the health of our towers. Unhealthy towers are
+	// removed as candidates and held in demotedTowers until they recover.
+	towerHealth   *towerHealthSet
+	demotedTowers map[wtdb.TowerID]*wtdb.Tower
+
+	// probeTimers holds the timers that probe the demoted towers again. It
+	// is only accessed by the backup dispatcher, which stops all timers
+	// when it exits.
+	probeTimers map[wtdb.TowerID]*time.Timer
 
 	backupMu          sync.Mutex
 	summaries         wtdb.ChannelSummaries
@@ -255,7 +332,17 @@ type TowerClient struct {
 	newTowers   chan *newTowerMsg
 	staleTowers chan *staleTowerMsg
 
+	// closableSessions holds the sessions that will be deleted once their
+	// delete height is reached. It is only accessed by the session closer.
+	closableSessions map[wtdb.SessionID]*closableSession
+
+	// closedChannels holds the closed channels whose updates will be
+	// deleted from the towers once their delete height is reached. It is
+	// only accessed by the session closer.
+	closedChannels map[lnwire.ChannelID]*closedChannel
+
 	wg        sync.WaitGroup
+	quit      chan struct{}
 	forceQuit chan struct{}
 }
 
@@ -281,6 +368,21 @@ func New(config *Config) (*TowerClient, error) {
 		cfg.WriteTimeout = DefaultWriteTimeout
 	}
 
+	// Set the session close delay to the default if none was provided.
+	if cfg.SessionCloseDelay == 0 {
+		cfg.SessionCloseDelay = DefaultSessionCloseDelay
+	}
+
+	// Set the replication factor to the default if none was provided.
+	if cfg.ReplicationFactor == 0 {
+		cfg.ReplicationFactor = DefaultReplicationFactor
+	}
+
+	// Set the tower failure threshold to the default if none was provided.
+	if cfg.TowerFailureThreshold == 0 {
+		cfg.TowerFailureThreshold = DefaultTowerFailureThreshold
+	}
+
 	prefix := "(legacy)"
 	if cfg.Policy.IsAnchorChannel() {
 		prefix = "(anchor)"
@@ -321,11 +423,18 @@ func New(config *Config) (*TowerClient, error) {
 		candidateTowers:   newTowerListIterator(candidateTowers...),
 		candidateSessions: candidateSessions,
 		activeSessions:    make(sessionQueueSet),
+		sessionQueues:     make(map[wtdb.TowerID]*sessionQueue),
+		towerHealth:       newTowerHealthSet(cfg.TowerFailureThreshold),
+		demotedTowers:     make(map[wtdb.TowerID]*wtdb.Tower),
+		probeTimers:       make(map[wtdb.TowerID]*time.Timer),
 		summaries:         chanSummaries,
 		statTicker:        time.NewTicker(DefaultStatInterval),
 		stats:             new(ClientStats),
 		newTowers:         make(chan *newTowerMsg),
 		staleTowers:       make(chan *staleTowerMsg),
+		closableSessions:  make(map[wtdb.SessionID]*closableSession),
+		closedChannels:    make(map[lnwire.ChannelID]*closedChannel),
+		quit:              make(chan struct{}),
 		forceQuit:         make(chan struct{}),
 	}
 	c.negotiator = newSessionNegotiator(&NegotiatorConfig{
@@ -337,9 +446,12 @@ func New(config *Config) (*TowerClient, error) {
 		ReadMessage:   c.readMessage,
 		Dial:          c.dial,
 		Candidates:    c.candidateTowers,
+		Health:        c.towerHealth,
 		MinBackoff:    cfg.MinBackoff,
 		MaxBackoff:    cfg.MaxBackoff,
 		Log:           plog,
+
+		ClientKeySigner: cfg.ClientKeySigner,
 	})
 
 	// Reconstruct the highest commit height processed for each channel
@@ -405,8 +517,11 @@ func (c *TowerClient) buildHighestCommitHeights() {
 	for _, s := range c.candidateSessions {
 		// We only want to consider accepted updates that have been
 		// accepted under an identical policy to the client's current
-		// policy.
-		if s.Policy != c.cfg.Policy {
+		// policy, except for reward sessions charging less than our
+		// reward caps.
+		if s.Policy.MaxUpdates != c.cfg.Policy.MaxUpdates ||
+			!c.cfg.Policy.AcceptsTerms(s.Policy.TxPolicy) {
+
 			continue
 		}
 
@@ -469,6 +584,54 @@ func (c *TowerClient) Start() error {
 		c.wg.Add(1)
 		go c.backupDispatcher()
 
+		// Finally, resume tracking the closed channels and closable
+		// sessions from before the restart, and start processing
+		// channel closes and new blocks to delete their updates and
+		// sessions. We subscribe to channel events before catching up
+		// with the channels closed while the client wasn't running,
+		// such that no close is missed in between.
+		var chanSub *subscribe.Client
+		chanSub, err = c.cfg.SubscribeChannelEvents()
+		if err != nil {
+			return
+		}
+
+		err = c.indexAckedUpdates()
+		if err != nil {
+			chanSub.Cancel()
+			return
+		}
+
+		err = c.reconcileClosedChannels()
+		if err != nil {
+			chanSub.Cancel()
+			return
+		}
+
+		err = c.loadClosedChannels()
+		if err != nil {
+			chanSub.Cancel()
+			return
+		}
+
+		err = c.loadClosableSessions()
+		if err != nil {
+			chanSub.Cancel()
+			return
+		}
+
+		var blockEpochs *chainntnfs.BlockEpochEvent
+		blockEpochs, err = c.cfg.ChainNotifier.RegisterBlockEpochNtfn(
+			nil,
+		)
+		if err != nil {
+			chanSub.Cancel()
+			return
+		}
+
+		c.wg.Add(1)
+		go c.sessionCloser(chanSub, blockEpochs)
+
 		c.log.Infof("Watchtower client started successfully")
 	})
 	return err
@@ -501,9 +664,11 @@ func (c *TowerClient) Stop() error {
 		c.pipeline.Stop()
 
 		// 3. Once the backup queue has shutdown, wait for the main
-		// dispatcher to exit. The backup queue will signal it's
-		// completion to the dispatcher, which releases the wait group
-		// after all tasks have been assigned to session queues.
+		// dispatcher and the session closer to exit. The backup queue
+		// will signal it's completion to the dispatcher, which releases
+		// the wait group after all tasks have been assigned to session
+		// queues.
+		close(c.quit)
 		c.wg.Wait()
 
 		// 4. Since all valid tasks have been assigned to session
@@ -645,20 +810,28 @@ func (c *TowerClient) BackupState(chanID *lnwire.ChannelID,
 // nextSessionQueue attempts to fetch an active session from our set of
 // candidate sessions. Candidate sessions with a differing policy from the
 // active client's advertised policy will be ignored, but may be resumed if the
-// client is restarted with a matching policy. If no candidates were found, nil
-// is returned to signal that we need to request a new policy.
+// client is restarted with a matching policy. Candidate sessions with towers
+// that can't be used at the moment are skipped. If no candidates were found,
+// nil is returned to signal that we need to request a new policy.
 func (c *TowerClient) nextSessionQueue() *sessionQueue {
 	// Select any candidate session at random, and remove it from the set of
 	// candidate sessions.
 	var candidateSession *wtdb.ClientSession
 	for id, sessionInfo := range c.candidateSessions {
+		// Sessions with towers that already have an active session
+		// queue, or that were demoted, remain candidates for later.
+		if !c.isUsableTower(sessionInfo.TowerID) {
+			continue
+		}
+
 		delete(c.candidateSessions, id)
 
 		// Skip any sessions with policies that don't match the current
 		// TxPolicy, as they would result in different justice
 		// transactions from what is requested. These can be used again
 		// if the client changes their configuration and restarting.
-		if sessionInfo.Policy.TxPolicy != c.cfg.Policy.TxPolicy {
+		// Reward sessions only need to charge at most our reward caps.
+		if !c.cfg.Policy.AcceptsTerms(sessionInfo.Policy.TxPolicy) {
 			continue
 		}
 
@@ -678,6 +851,136 @@ func (c *TowerClient) nextSessionQueue() *sessionQueue {
 	return c.getOrInitActiveQueue(candidateSession)
 }
 
+// isUsableTower returns true if new backups can be assigned to a session with
+// the given tower, which is the case if the tower doesn't have an active
+// session queue yet and wasn't demoted for being unhealthy.
+func (c *TowerClient) isUsableTower(id wtdb.TowerID) bool {
+	_, isActive := c.sessionQueues[id]
+	_, isDemoted := c.demotedTowers[id]
+
+	return !isActive && !isDemoted
+}
+
+// hasCandidateSession returns true if any of the candidate sessions can be
+// used for new backups.
+func (c *TowerClient) hasCandidateSession() bool {
+	for _, session := range c.candidateSessions {
+		if c.isUsableTower(session.TowerID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// activeTowers returns the set of towers the client has an active session
+// queue with.
+func (c *TowerClient) activeTowers() map[wtdb.TowerID]struct{} {
+	towers := make(map[wtdb.TowerID]struct{}, len(c.sessionQueues))
+	for id := range c.sessionQueues {
+		towers[id] = struct{}{}
+	}
+
+	return towers
+}
+
+// numReplicas returns the number of distinct towers each backup needs to be
+// accepted by, which is the replication factor limited to the number of
+// candidate towers.
+func (c *TowerClient) numReplicas() int {
+	numReplicas := int(c.cfg.ReplicationFactor)
+	numCandidates := c.candidateTowers.NumCandidates()
+	if numCandidates < numReplicas {
+		numReplicas = numCandidates
+	}
+
+	// We always need at least one session queue, even if there are no
+	// candidates yet.
+	if numReplicas < 1 {
+		numReplicas = 1
+	}
+
+	return numReplicas
+}
+
+// applyTowerHealth fails over from towers that became unhealthy, and
+// reinstates demoted towers that recovered. Unhealthy towers are demoted by
+// removing them as candidates for new sessions and no longer assigning new
+// backups to them, while backups that were already assigned to them are
+// delivered once they recover. Demoted towers are probed again after the max
+// backoff, in case they became reachable. The last candidate is never demoted,
+// as there would be no tower left to fail over to.
+func (c *TowerClient) applyTowerHealth() {
+	for id, tower := range c.demotedTowers {
+		if !c.towerHealth.isHealthy(id) {
+			continue
+		}
+
+		c.log.Infof("Watchtower %x recovered, considering it for new "+
+			"backups", tower.IdentityKey.SerializeCompressed())
+
+		c.candidateTowers.AddCandidate(tower)
+		c.clearDemotion(id)
+	}
+
+	for _, id := range c.towerHealth.unhealthyTowers() {
+		if !c.candidateTowers.IsActive(id) ||
+			c.candidateTowers.NumCandidates() <= 1 {
+
+			continue
+		}
+
+		tower, err := c.cfg.DB.LoadTowerByID(id)
+		if err != nil {
+			c.log.Errorf("Unable to load unhealthy tower %v: %v",
+				id, err)
+			continue
+		}
+
+		c.log.Warnf("Watchtower %x is unhealthy, failing over to "+
+			"other towers", tower.IdentityKey.SerializeCompressed())
+
+		err = c.candidateTowers.RemoveCandidate(id, nil)
+		if err != nil {
+			c.log.Errorf("Unable to remove unhealthy tower %v: %v",
+				id, err)
+			continue
+		}
+		c.demotedTowers[id] = tower
+
+		c.probeTimers[id] = time.AfterFunc(c.cfg.MaxBackoff, func() {
+			c.towerHealth.probe(id)
+		})
+
+		// The session of the tower's active session queue can be used
+		// again once the tower recovers.
+		if sq, ok := c.sessionQueues[id]; ok {
+			c.candidateSessions[*sq.ID()] = sq.cfg.ClientSession
+			delete(c.sessionQueues, id)
+		}
+	}
+}
+
+// clearDemotion stops holding the tower as demoted, and stops the timer that
+// would probe it again.
+func (c *TowerClient) clearDemotion(id wtdb.TowerID) {
+	if timer, ok := c.probeTimers[id]; ok {
+		timer.Stop()
+		delete(c.probeTimers, id)
+	}
+
+	delete(c.demotedTowers, id)
+}
+
+// stopProbeTimers stops the timers of all demoted towers, such that they don't
+// fire after the client has stopped.
+func (c *TowerClient) stopProbeTimers() {
+	for id, timer := range c.probeTimers {
+		timer.Stop()
+		delete(c.probeTimers, id)
+	}
+}
+
 // backupDispatcher processes events coming from the taskPipeline and is
 // responsible for detecting when the client needs to renegotiate a session to
 // fulfill continuing demand. The event loop exits after all tasks have been
@@ -686,19 +989,32 @@ func (c *TowerClient) nextSessionQueue() *sessionQueue {
 // NOTE: This method MUST be run as a goroutine.
 func (c *TowerClient) backupDispatcher() {
 	defer c.wg.Done()
+	defer c.stopProbeTimers()
 
 	c.log.Tracef("Starting backup dispatcher")
 	defer c.log.Tracef("Stopping backup dispatcher")
 
 	for {
+		// Fail over from any towers that became unhealthy, and
+		// reinstate those that recovered.
+		c.applyTowerHealth()
+
 		switch {
 
-		// No active session queue and no additional sessions.
-		case c.sessionQueue == nil && len(c.candidateSessions) == 0:
-			c.log.Infof("Requesting new session.")
+		// Not enough active session queues and no additional sessions.
+		case len(c.sessionQueues) < c.numReplicas() &&
+			!c.hasCandidateSession():
 
-			// Immediately request a new session.
-			c.negotiator.RequestSession()
+			// Immediately request a new session, unless one is
+			// still being negotiated. Towers we already have an
+			// active session queue with can't provide another
+			// replica.
+			if !c.sessionRequested {
+				c.log.Infof("Requesting new session.")
+
+				c.negotiator.RequestSession(c.activeTowers())
+				c.sessionRequested = true
+			}
 
 			// Wait until we receive the newly negotiated session.
 			// All backups sent in the meantime are queued in the
@@ -709,6 +1025,7 @@ func (c *TowerClient) backupDispatcher() {
 				c.log.Infof("Acquired new session with id=%s",
 					session.ID)
 				c.candidateSessions[session.ID] = session
+				c.sessionRequested = false
 				c.stats.sessionAcquired()
 
 				// We'll continue to choose the newly negotiated
@@ -734,6 +1051,18 @@ func (c *TowerClient) backupDispatcher() {
 					"is disallowed while a new session " +
 					"negotiation is in progress")
 
+			// A tower became healthy or unhealthy. If we no longer
+			// need another session as a result, we'll continue
+			// without waiting for the negotiation.
+			case <-c.towerHealth.Updates():
+				c.applyTowerHealth()
+
+				if len(c.sessionQueues) >= c.numReplicas() ||
+					c.hasCandidateSession() {
+
+					continue
+				}
+
 			case <-c.forceQuit:
 				return
 			}
@@ -743,23 +1072,27 @@ func (c *TowerClient) backupDispatcher() {
 			// us from re-requesting additional sessions.
 			goto awaitSession
 
-		// No active session queue but have additional sessions.
-		case c.sessionQueue == nil && len(c.candidateSessions) > 0:
-			// We've exhausted the prior session, we'll pop another
-			// from the remaining sessions and continue processing
-			// backup tasks.
-			c.sessionQueue = c.nextSessionQueue()
-			if c.sessionQueue != nil {
+		// Not enough active session queues but have additional
+		// sessions.
+		case len(c.sessionQueues) < c.numReplicas():
+			// We've exhausted a prior session or need another
+			// replica, we'll pop another from the remaining
+			// sessions and continue processing backup tasks.
+			sessionQueue := c.nextSessionQueue()
+			if sessionQueue != nil {
 				c.log.Debugf("Loaded next candidate session "+
-					"queue id=%s", c.sessionQueue.ID())
+					"queue id=%s", sessionQueue.ID())
+
+				session := sessionQueue.cfg.ClientSession
+				c.sessionQueues[session.TowerID] = sessionQueue
 			}
 
-		// Have active session queue, process backups.
-		case c.sessionQueue != nil:
+		// Have enough active session queues, process backups.
+		default:
 			if c.prevTask != nil {
 				c.processTask(c.prevTask)
 
-				// Continue to ensure the sessionQueue is
+				// Continue to ensure the session queues are
 				// properly initialized before attempting to
 				// process more tasks from the pipeline.
 				continue
@@ -769,17 +1102,16 @@ func (c *TowerClient) backupDispatcher() {
 			// pipeline.
 			select {
 
-			// If any sessions are negotiated while we have an
-			// active session queue, queue them for future use.
-			// This shouldn't happen with the current design, so
-			// it doesn't hurt to select here just in case. In the
-			// future, we will likely allow more asynchrony so that
-			// we can request new sessions before the session is
-			// fully empty, which this case would handle.
+			// If any sessions are negotiated while we have enough
+			// active session queues, queue them for future use.
+			// This happens if a session was requested for another
+			// replica, but was negotiated with a tower that
+			// already has an active session queue.
 			case session := <-c.negotiator.NewSessions():
 				c.log.Warnf("Acquired new session with id=%s "+
 					"while processing tasks", session.ID)
 				c.candidateSessions[session.ID] = session
+				c.sessionRequested = false
 				c.stats.sessionAcquired()
 
 			case <-c.statTicker.C:
@@ -812,41 +1144,70 @@ func (c *TowerClient) backupDispatcher() {
 			// of its corresponding candidate sessions as inactive.
 			case msg := <-c.staleTowers:
 				msg.errChan <- c.handleStaleTower(msg)
+
+			// A tower became healthy or unhealthy, which is
+			// applied before processing the next task.
+			case <-c.towerHealth.Updates():
 			}
 		}
 	}
 }
 
 // processTask attempts to schedule the given backupTask on the active
-// sessionQueue. The task will either be accepted or rejected, afterwhich the
-// appropriate modifications to the client's state machine will be made. After
-// every invocation of processTask, the caller should ensure that the
-// sessionQueue hasn't been exhausted before proceeding to the next task. Tasks
-// that are rejected because the active sessionQueue is full will be cached as
-// the prevTask, and should be reprocessed after obtaining a new sessionQueue.
+// session queues of all towers that didn't accept it yet. The task will either
+// be accepted or rejected by each of them, afterwhich the appropriate
+// modifications to the client's state machine will be made. After every
+// invocation of processTask, the caller should ensure that enough session
+// queues are active before proceeding to the next task. Tasks that weren't
+// accepted by enough towers because a session queue is full will be cached as
+// the prevTask, and should be reprocessed after obtaining a new session queue.
 func (c *TowerClient) processTask(task *backupTask) {
-	status, accepted := c.sessionQueue.AcceptTask(task)
-	if accepted {
-		c.taskAccepted(task, status)
-	} else {
-		c.taskRejected(task, status)
+	if task != c.prevTask {
+		c.prevTaskTowers = make(map[wtdb.TowerID]struct{})
+	}
+
+	for towerID, sessionQueue := range c.sessionQueues {
+		if _, ok := c.prevTaskTowers[towerID]; ok {
+			continue
+		}
+
+		// Each session queue binds its own copy of the task, as the
+		// justice transaction depends on the session's policy.
+		status, accepted := sessionQueue.AcceptTask(task.clone())
+		if accepted {
+			c.taskAccepted(task, sessionQueue, status)
+			continue
+		}
+
+		if !c.taskRejected(task, sessionQueue, status) {
+			c.taskIneligible(task)
+			return
+		}
 	}
+
+	// If the task wasn't accepted by enough towers yet, we'll cache it to
+	// process it again once a new session queue is available.
+	if len(c.prevTaskTowers) < c.numReplicas() {
+		c.prevTask = task
+		return
+	}
+
+	c.stats.taskAccepted()
+	c.prevTask = nil
 }
 
 // taskAccepted processes the acceptance of a task by a sessionQueue depending
-// on the state the sessionQueue is in *after* the task is added. The client's
-// prevTask is always removed as a result of this call. The client's
-// sessionQueue will be removed if accepting the task left the sessionQueue in
-// an exhausted state.
-func (c *TowerClient) taskAccepted(task *backupTask, newStatus reserveStatus) {
-	c.log.Infof("Queued %v successfully for session %v",
-		task.id, c.sessionQueue.ID())
+// on the state the sessionQueue is in *after* the task is added. The
+// sessionQueue will be removed from the active session queues if accepting the
+// task left the sessionQueue in an exhausted state.
+func (c *TowerClient) taskAccepted(task *backupTask,
+	sessionQueue *sessionQueue, newStatus reserveStatus) {
 
-	c.stats.taskAccepted()
+	c.log.Infof("Queued %v successfully for session %v",
+		task.id, sessionQueue.ID())
 
-	// If this task was accepted, we discard anything held in the prevTask.
-	// Either it was nil before, or is the task which was just accepted.
-	c.prevTask = nil
+	towerID := sessionQueue.cfg.ClientSession.TowerID
+	c.prevTaskTowers[towerID] = struct{}{}
 
 	switch newStatus {
 
@@ -858,62 +1219,84 @@ func (c *TowerClient) taskAccepted(task *backupTask, newStatus reserveStatus) {
 	case reserveExhausted:
 		c.stats.sessionExhausted()
 
-		c.log.Debugf("Session %s exhausted", c.sessionQueue.ID())
+		c.log.Debugf("Session %s exhausted", sessionQueue.ID())
 
-		// This task left the session exhausted, set it to nil and
-		// proceed to the next loop so we can consume another
-		// pre-negotiated session or request another.
-		c.sessionQueue = nil
+		// This task left the session exhausted, remove it and proceed
+		// to the next loop so we can consume another pre-negotiated
+		// session or request another.
+		delete(c.sessionQueues, towerID)
 	}
 }
 
 // taskRejected process the rejection of a task by a sessionQueue depending on
-// the state the was in *before* the task was rejected. The client's prevTask
-// will cache the task if the sessionQueue was exhausted before hand, and nil
-// the sessionQueue to find a new session. If the sessionQueue was not
-// exhausted, the client marks the task as ineligible, as this implies we
-// couldn't construct a valid justice transaction given the session's policy.
-func (c *TowerClient) taskRejected(task *backupTask, curStatus reserveStatus) {
+// the state the was in *before* the task was rejected. If the sessionQueue was
+// exhausted before hand, it is removed from the active session queues so that
+// a new session is found, and true is returned to signal that the task should
+// be processed again. If the sessionQueue was not exhausted, false is returned,
+// as this implies we couldn't construct a valid justice transaction given the
+// session's policy.
+func (c *TowerClient) taskRejected(task *backupTask,
+	sessionQueue *sessionQueue, curStatus reserveStatus) bool {
+
 	switch curStatus {
 
 	// The sessionQueue has available capacity but the task was rejected,
 	// this indicates that the task was ineligible for backup.
 	case reserveAvailable:
-		c.stats.taskIneligible()
+		return false
 
-		c.log.Infof("Ignoring ineligible %v", task.id)
+	// The sessionQueue rejected the task because it is full, we will try
+	// to add the task to the next available sessionQueue.
+	default:
+		c.stats.sessionExhausted()
 
-		err := c.cfg.DB.MarkBackupIneligible(
-			task.id.ChanID, task.id.CommitHeight,
-		)
-		if err != nil {
-			c.log.Errorf("Unable to mark %v ineligible: %v",
-				task.id, err)
-
-			// It is safe to not handle this error, even if we could
-			// not persist the result. At worst, this task may be
-			// reprocessed on a subsequent start up, and will either
-			// succeed do a change in session parameters or fail in
-			// the same manner.
-		}
+		c.log.Debugf("Session %v exhausted, %v queued for next session",
+			sessionQueue.ID(), task.id)
 
-		// If this task was rejected *and* the session had available
-		// capacity, we discard anything held in the prevTask. Either it
-		// was nil before, or is the task which was just rejected.
-		c.prevTask = nil
+		towerID := sessionQueue.cfg.ClientSession.TowerID
+		delete(c.sessionQueues, towerID)
 
-	// The sessionQueue rejected the task because it is full, we will stash
-	// this task and try to add it to the next available sessionQueue.
-	case reserveExhausted:
-		c.stats.sessionExhausted()
+		return true
+	}
+}
 
-		c.log.Debugf("Session %v exhausted, %v queued for next session",
-			c.sessionQueue.ID(), task.id)
+// taskIneligible processes a task that was rejected by a session queue with
+// available capacity, which implies the task is ineligible for backup. The
+// task is no longer offered to other towers, and the client's prevTask is
+// always removed as a result of this call.
+func (c *TowerClient) taskIneligible(task *backupTask) {
+	// If this task was rejected *and* the session had available capacity,
+	// we discard anything held in the prevTask. Either it was nil before,
+	// or is the task which was just rejected.
+	c.prevTask = nil
 
-		// Cache the task that we pulled off, so that we can process it
-		// once a new session queue is available.
-		c.sessionQueue = nil
-		c.prevTask = task
+	// If some towers accepted the task before, it remains backed up to
+	// them.
+	if len(c.prevTaskTowers) > 0 {
+		c.stats.taskAccepted()
+
+		c.log.Warnf("Ignoring %v for remaining towers, it is only "+
+			"backed up to %d towers", task.id,
+			len(c.prevTaskTowers))
+
+		return
+	}
+
+	c.stats.taskIneligible()
+
+	c.log.Infof("Ignoring ineligible %v", task.id)
+
+	err := c.cfg.DB.MarkBackupIneligible(
+		task.id.ChanID, task.id.CommitHeight,
+	)
+	if err != nil {
+		c.log.Errorf("Unable to mark %v ineligible: %v",
+			task.id, err)
+
+		// It is safe to not handle this error, even if we could not
+		// persist the result. At worst, this task may be reprocessed
+		// on a subsequent start up, and will either succeed do a
+		// change in session parameters or fail in the same manner.
 	}
 }
 
@@ -1005,6 +1388,7 @@ func (c *TowerClient) newSessionQueue(s *wtdb.ClientSession) *sessionQueue {
 		SendMessage:   c.sendMessage,
 		Signer:        c.cfg.Signer,
 		DB:            c.cfg.DB,
+		Health:        c.towerHealth,
 		MinBackoff:    c.cfg.MinBackoff,
 		MaxBackoff:    c.cfg.MaxBackoff,
 		Log:           c.log,
@@ -1083,6 +1467,11 @@ func (c *TowerClient) handleNewTower(msg *newTowerMsg) error {
 	}
 	c.candidateTowers.AddCandidate(tower)
 
+	// Give a demoted tower another chance, as it may be reachable through
+	// the new address.
+	c.clearDemotion(tower.ID)
+	c.towerHealth.reset(tower.ID)
+
 	// Include all of its corresponding sessions to our set of candidates.
 	isAnchorClient := c.cfg.Policy.IsAnchorChannel()
 	activeSessionFilter := genActiveSessionFilter(isAnchorClient)
@@ -1152,8 +1541,13 @@ func (c *TowerClient) handleStaleTower(msg *staleTowerMsg) error {
 	}
 
 	// If an address was provided, then we're only meant to remove the
-	// address from the tower, so there's nothing left for us to do.
+	// address from the tower, so there's nothing left for us to do, other
+	// than removing it from the tower if it's demoted.
 	if msg.addr != nil {
+		if demotedTower, ok := c.demotedTowers[tower.ID]; ok {
+			demotedTower.RemoveAddress(msg.addr)
+		}
+
 		return nil
 	}
 
@@ -1169,14 +1563,11 @@ func (c *TowerClient) handleStaleTower(msg *staleTowerMsg) error {
 		delete(c.candidateSessions, sessionID)
 	}
 
-	// If our active session queue corresponds to the stale tower, we'll
-	// proceed to negotiate a new one.
-	if c.sessionQueue != nil {
-		activeTower := c.sessionQueue.towerAddr.IdentityKey.SerializeCompressed()
-		if bytes.Equal(pubKey, activeTower) {
-			c.sessionQueue = nil
-		}
-	}
+	// If we have an active session queue with the stale tower, we'll
+	// proceed to negotiate a new one. The tower also shouldn't be
+	// reinstated if it was demoted.
+	delete(c.sessionQueues, tower.ID)
+	c.clearDemotion(tower.ID)
 
 	return nil
 }
@@ -1215,6 +1606,7 @@ func (c *TowerClient) RegisteredTowers() ([]*RegisteredTower, error) {
 			Tower:                  tower,
 			Sessions:               towerSessions[tower.ID],
 			ActiveSessionCandidate: isActive,
+			Health:                 c.towerHealth.health(tower.ID),
 		})
 	}
 
@@ -1237,6 +1629,7 @@ func (c *TowerClient) LookupTower(pubKey *btcec.PublicKey) (*RegisteredTower, er
 		Tower:                  tower,
 		Sessions:               towerSessions,
 		ActiveSessionCandidate: c.candidateTowers.IsActive(tower.ID),
+		Health:                 c.towerHealth.health(tower.ID),
 	}, nil
 }
 
@@ -7,20 +7,27 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ltcsuite/lnd/chainntnfs"
 	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/channelnotifier"
 	"github.com/ltcsuite/lnd/input"
 	"github.com/ltcsuite/lnd/keychain"
+	"github.com/ltcsuite/lnd/lntest/mock"
+	"github.com/ltcsuite/lnd/lntest/wait"
 	"github.com/ltcsuite/lnd/lnwallet"
 	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/subscribe"
 	"github.com/ltcsuite/lnd/tor"
 	"github.com/ltcsuite/lnd/watchtower/blob"
 	"github.com/ltcsuite/lnd/watchtower/wtclient"
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/lnd/watchtower/wtmetrics"
 	"github.com/ltcsuite/lnd/watchtower/wtmock"
 	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
 	"github.com/ltcsuite/lnd/watchtower/wtserver"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/chaincfg"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 	"github.com/ltcsuite/ltcd/ltcutil"
 	"github.com/ltcsuite/ltcd/txscript"
 	"github.com/ltcsuite/ltcd/wire"
@@ -31,6 +38,8 @@ const (
 	csvDelay uint32 = 144
 
 	towerAddrStr = "18.28.243.2:9911"
+
+	secondTowerAddrStr = "18.28.243.3:9911"
 )
 
 var (
@@ -55,12 +64,18 @@ var (
 		0xe2, 0x2e, 0x68, 0x08, 0x4c, 0xb4, 0x0f, 0x4f,
 	}
 
-	// addr is the server's reward address given to watchtower clients.
+	// addr is the client's sweep address.
 	addr, _ = ltcutil.DecodeAddress(
 		"mrX9vMRYLfVy1BnZbc5gZjuyaqH3ZW2ZHz", &chaincfg.TestNet4Params,
 	)
 
 	addrScript, _ = txscript.PayToAddrScript(addr)
+
+	// rewardAddr is the server's reward address given to watchtower
+	// clients.
+	rewardAddr, _ = ltcutil.NewAddressWitnessPubKeyHash(
+		make([]byte, 20), &chaincfg.TestNet4Params,
+	)
 )
 
 // randPrivKey generates a new secp keypair, and returns the public key.
@@ -383,8 +398,12 @@ type testHarness struct {
 	server     *wtserver.Server
 	net        *mockNet
 
-	mu       sync.Mutex
-	channels map[lnwire.ChannelID]*mockChannel
+	chanEvents  *subscribe.Server
+	blockEpochs chan *chainntnfs.BlockEpoch
+
+	mu          sync.Mutex
+	channels    map[lnwire.ChannelID]*mockChannel
+	closedChans []*channeldb.ChannelCloseSummary
 }
 
 type harnessCfg struct {
@@ -393,6 +412,10 @@ type harnessCfg struct {
 	policy             wtpolicy.Policy
 	noRegisterChan0    bool
 	noAckCreateSession bool
+	rewardRate         uint32
+	replicationFactor  uint32
+	identify           bool
+	clientQuota        wtserver.ClientQuota
 }
 
 func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
@@ -423,9 +446,13 @@ func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
 		WriteTimeout: timeout,
 		NodeKeyECDH:  privKeyECDH,
 		NewAddress: func() (ltcutil.Address, error) {
-			return addr, nil
+			return rewardAddr, nil
 		},
 		NoAckCreateSession: cfg.noAckCreateSession,
+		RewardRate:         cfg.rewardRate,
+		RequireClientKey:   cfg.identify,
+		ClientQuota:        cfg.clientQuota,
+		Metrics:            wtmetrics.Metrics{},
 	}
 
 	server, err := wtserver.New(serverCfg)
@@ -437,7 +464,29 @@ func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
 	mockNet := newMockNet(server.InboundPeerConnected)
 	clientDB := wtmock.NewClientDB()
 
-	clientCfg := &wtclient.Config{
+	chanEvents := subscribe.NewServer()
+	if err := chanEvents.Start(); err != nil {
+		t.Fatalf("Unable to start channel events server: %v", err)
+	}
+	blockEpochs := make(chan *chainntnfs.BlockEpoch)
+
+	h := &testHarness{
+		t:           t,
+		cfg:         cfg,
+		signer:      signer,
+		capacity:    cfg.localBalance + cfg.remoteBalance,
+		clientDB:    clientDB,
+		serverAddr:  towerAddr,
+		serverDB:    serverDB,
+		serverCfg:   serverCfg,
+		server:      server,
+		net:         mockNet,
+		chanEvents:  chanEvents,
+		blockEpochs: blockEpochs,
+		channels:    make(map[lnwire.ChannelID]*mockChannel),
+	}
+
+	h.clientCfg = &wtclient.Config{
 		Signer:        signer,
 		Dial:          mockNet.Dial,
 		DB:            clientDB,
@@ -447,13 +496,30 @@ func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
 		NewAddress: func() ([]byte, error) {
 			return addrScript, nil
 		},
-		ReadTimeout:    timeout,
-		WriteTimeout:   timeout,
-		MinBackoff:     time.Millisecond,
-		MaxBackoff:     time.Second,
-		ForceQuitDelay: 10 * time.Second,
+		SubscribeChannelEvents: chanEvents.Subscribe,
+		FetchClosedChannels:    h.fetchClosedChannels,
+		FetchBreachTxID:        h.fetchBreachTxID,
+		ChainNotifier: &mock.ChainNotifier{
+			EpochChan: blockEpochs,
+		},
+		ReadTimeout:       timeout,
+		WriteTimeout:      timeout,
+		MinBackoff:        time.Millisecond,
+		MaxBackoff:        time.Second,
+		ForceQuitDelay:    10 * time.Second,
+		ReplicationFactor: cfg.replicationFactor,
 	}
-	client, err := wtclient.New(clientCfg)
+	if cfg.identify {
+		clientKey, err := btcec.NewPrivateKey()
+		if err != nil {
+			t.Fatalf("Unable to generate client key: %v", err)
+		}
+
+		h.clientCfg.ClientKeySigner = keychain.NewPrivKeyMessageSigner(
+			clientKey, keychain.KeyLocator{},
+		)
+	}
+	h.client, err = wtclient.New(h.clientCfg)
 	if err != nil {
 		t.Fatalf("Unable to create wtclient: %v", err)
 	}
@@ -462,31 +528,15 @@ func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
 		t.Fatalf("Unable to start wtserver: %v", err)
 	}
 
-	if err = client.Start(); err != nil {
+	if err = h.client.Start(); err != nil {
 		server.Stop()
 		t.Fatalf("Unable to start wtclient: %v", err)
 	}
-	if err := client.AddTower(towerAddr); err != nil {
+	if err := h.client.AddTower(towerAddr); err != nil {
 		server.Stop()
 		t.Fatalf("Unable to add tower to wtclient: %v", err)
 	}
 
-	h := &testHarness{
-		t:          t,
-		cfg:        cfg,
-		signer:     signer,
-		capacity:   cfg.localBalance + cfg.remoteBalance,
-		clientDB:   clientDB,
-		clientCfg:  clientCfg,
-		client:     client,
-		serverAddr: towerAddr,
-		serverDB:   serverDB,
-		serverCfg:  serverCfg,
-		server:     server,
-		net:        mockNet,
-		channels:   make(map[lnwire.ChannelID]*mockChannel),
-	}
-
 	h.makeChannel(0, h.cfg.localBalance, h.cfg.remoteBalance)
 	if !cfg.noRegisterChan0 {
 		h.registerChannel(0)
@@ -788,6 +838,199 @@ func (h *testHarness) assertUpdatesForPolicy(hints []blob.BreachHint,
 	}
 }
 
+// closeChannel notifies the client that the channel identified by id was
+// closed at the given height.
+func (h *testHarness) closeChannel(id uint64, closeHeight uint32) {
+	h.t.Helper()
+
+	summary := h.markChannelClosed(id, closeHeight)
+	err := h.chanEvents.SendUpdate(channelnotifier.ClosedChannelEvent{
+		CloseSummary: summary,
+	})
+	require.NoError(h.t, err)
+}
+
+// markChannelClosed records that the channel identified by id was closed at
+// the given height, without notifying the client, as if the channel was closed
+// while the client was offline.
+func (h *testHarness) markChannelClosed(id uint64,
+	closeHeight uint32) *channeldb.ChannelCloseSummary {
+
+	// The channel id of an outpoint with index zero is its txid.
+	summary := &channeldb.ChannelCloseSummary{
+		ChanPoint:   wire.OutPoint{Hash: chainhash.Hash(chanIDFromInt(id))},
+		CloseHeight: closeHeight,
+	}
+
+	h.mu.Lock()
+	h.closedChans = append(h.closedChans, summary)
+	h.mu.Unlock()
+
+	return summary
+}
+
+// fetchClosedChannels returns the summaries of all channels that were closed.
+func (h *testHarness) fetchClosedChannels() ([]*channeldb.ChannelCloseSummary,
+	error) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]*channeldb.ChannelCloseSummary(nil),
+		h.closedChans...), nil
+}
+
+// fetchBreachTxID returns the txid of the channel's revoked commitment at the
+// given height.
+func (h *testHarness) fetchBreachTxID(chanID lnwire.ChannelID,
+	commitHeight uint64) (*chainhash.Hash, error) {
+
+	h.mu.Lock()
+	c, ok := h.channels[chanID]
+	h.mu.Unlock()
+	if !ok {
+		return nil, channeldb.ErrChannelNotFound
+	}
+
+	commitTx, _ := c.getState(commitHeight)
+	txid := commitTx.TxHash()
+
+	return &txid, nil
+}
+
+// mineBlock notifies the client of a new block at the given height.
+func (h *testHarness) mineBlock(height uint32) {
+	h.t.Helper()
+
+	select {
+	case h.blockEpochs <- &chainntnfs.BlockEpoch{Height: int32(height)}:
+	case <-time.After(time.Second):
+		h.t.Fatalf("client did not receive block %d", height)
+	}
+}
+
+// waitSessionAcked blocks until the client has a session with the given number
+// of acked updates, and returns its id.
+func (h *testHarness) waitSessionAcked(numUpdates int) wtdb.SessionID {
+	h.t.Helper()
+
+	var id wtdb.SessionID
+	err := wait.Predicate(func() bool {
+		sessions, err := h.clientDB.ListClientSessions(nil)
+		require.NoError(h.t, err)
+
+		for _, session := range sessions {
+			if len(session.AckedUpdates) == numUpdates {
+				id = session.ID
+				return true
+			}
+		}
+
+		return false
+	}, 5*time.Second)
+	require.NoError(h.t, err)
+
+	return id
+}
+
+// secondTower is an additional tower the client can back up states to, which
+// can be taken offline independently of the harness's tower.
+type secondTower struct {
+	addr     *lnwire.NetAddress
+	serverDB *wtmock.TowerDB
+	server   *wtserver.Server
+
+	mu      sync.Mutex
+	offline bool
+}
+
+// setOffline determines whether connections to the tower fail.
+func (s *secondTower) setOffline(offline bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.offline = offline
+}
+
+// startSecondTower starts a second tower, which is reachable through the
+// harness's mock network, and adds it to the client.
+func (h *testHarness) startSecondTower() *secondTower {
+	h.t.Helper()
+
+	towerTCPAddr, err := net.ResolveTCPAddr("tcp", secondTowerAddrStr)
+	require.NoError(h.t, err)
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(h.t, err)
+
+	serverCfg := *h.serverCfg
+	serverCfg.DB = wtmock.NewTowerDB()
+	serverCfg.NodeKeyECDH = &keychain.PrivKeyECDH{PrivKey: privKey}
+
+	server, err := wtserver.New(&serverCfg)
+	require.NoError(h.t, err)
+	require.NoError(h.t, server.Start())
+
+	tower := &secondTower{
+		addr: &lnwire.NetAddress{
+			IdentityKey: privKey.PubKey(),
+			Address:     towerTCPAddr,
+		},
+		serverDB: serverCfg.DB.(*wtmock.TowerDB),
+		server:   server,
+	}
+
+	// Route connections to the second tower by its address. Connections
+	// to an offline tower are never served, so the client times out.
+	h.net.setConnCallback(func(peer wtserver.Peer) {
+		localAddr := peer.(*wtmock.MockPeer).LocalAddr()
+		if localAddr.String() != secondTowerAddrStr {
+			h.server.InboundPeerConnected(peer)
+			return
+		}
+
+		tower.mu.Lock()
+		defer tower.mu.Unlock()
+
+		if !tower.offline {
+			server.InboundPeerConnected(peer)
+		}
+	})
+
+	h.addTower(tower.addr)
+
+	return tower
+}
+
+// waitTowerUpdates blocks until all breach hints appear in the given tower
+// database.
+func (h *testHarness) waitTowerUpdates(db *wtmock.TowerDB,
+	hints []blob.BreachHint) {
+
+	h.t.Helper()
+
+	err := wait.Predicate(func() bool {
+		matches, err := db.QueryMatches(hints)
+		require.NoError(h.t, err)
+
+		return len(matches) == len(hints)
+	}, 10*time.Second)
+	require.NoError(h.t, err)
+}
+
+// lookupTower returns the tower with the given public key, as registered with
+// the client.
+func (h *testHarness) lookupTower(
+	pubKey *btcec.PublicKey) *wtclient.RegisteredTower {
+
+	h.t.Helper()
+
+	tower, err := h.client.LookupTower(pubKey)
+	require.NoError(h.t, err)
+
+	return tower
+}
+
 // addTower adds a tower found at `addr` to the client.
 func (h *testHarness) addTower(addr *lnwire.NetAddress) {
 	h.t.Helper()
@@ -1532,6 +1775,394 @@ var clientTests = []clientTest{
 			require.Nil(h.t, err)
 		},
 	},
+	{
+		// Asserts that the client negotiates reward sessions paying
+		// the reward required by the tower, rather than its reward
+		// caps.
+		name: "reward session within caps",
+		cfg: harnessCfg{
+			localBalance:  localBalance,
+			remoteBalance: remoteBalance,
+			policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blob.TypeRewardCommit,
+					RewardRate:   2 * wtpolicy.DefaultRewardRate,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 5,
+			},
+			rewardRate: wtpolicy.DefaultRewardRate,
+		},
+		fn: func(h *testHarness) {
+			const (
+				chanID     = 0
+				numUpdates = 3
+			)
+
+			// Generate the retributions that will be backed up.
+			hints := h.advanceChannelN(chanID, numUpdates)
+
+			// Now, queue the retributions for backup.
+			h.backupStates(chanID, 0, numUpdates, nil)
+
+			// Wait for all of the updates to be populated in the
+			// server's database.
+			h.waitServerUpdates(hints, 5*time.Second)
+
+			// Assert that the session pays the reward rate
+			// required by the tower.
+			expPolicy := h.clientCfg.Policy
+			expPolicy.RewardRate = wtpolicy.DefaultRewardRate
+			h.assertUpdatesForPolicy(hints, expPolicy)
+		},
+	},
+	{
+		// Asserts that the client doesn't negotiate reward sessions
+		// with towers requiring more than its reward caps, until the
+		// caps are raised.
+		name: "reward session exceeds caps",
+		cfg: harnessCfg{
+			localBalance:  localBalance,
+			remoteBalance: remoteBalance,
+			policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blob.TypeRewardCommit,
+					RewardRate:   wtpolicy.DefaultRewardRate / 2,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 5,
+			},
+			rewardRate: wtpolicy.DefaultRewardRate,
+		},
+		fn: func(h *testHarness) {
+			const (
+				chanID     = 0
+				numUpdates = 3
+			)
+
+			// Generate the retributions that will be backed up.
+			hints := h.advanceChannelN(chanID, numUpdates)
+
+			// Now, queue the retributions for backup.
+			h.backupStates(chanID, 0, numUpdates, nil)
+
+			// Since the tower requires more than our caps, the
+			// client is unable to create a session, and the server
+			// should have no updates.
+			h.waitServerUpdates(nil, time.Second)
+
+			// Force quit the client since it has queued backups.
+			h.client.ForceQuit()
+
+			// Restart the client with caps matching the tower's
+			// terms.
+			rewardRate := uint32(wtpolicy.DefaultRewardRate)
+			h.clientCfg.Policy.RewardRate = rewardRate
+			h.startClient()
+			defer h.client.ForceQuit()
+
+			// Now, queue the retributions for backup.
+			h.backupStates(chanID, 0, numUpdates, nil)
+
+			// Wait for all of the updates to be populated in the
+			// server's database.
+			h.waitServerUpdates(hints, 5*time.Second)
+
+			// Assert that the server has updates for the client's
+			// new policy.
+			h.assertUpdatesForPolicy(hints, h.clientCfg.Policy)
+		},
+	},
+	{
+		// Asserts that an identified client negotiates sessions with a
+		// tower requiring client keys, until it exhausts its quota.
+		name: "identified client quota",
+		cfg: harnessCfg{
+			localBalance:  localBalance,
+			remoteBalance: remoteBalance,
+			policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blob.TypeAltruistCommit,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 5,
+			},
+			identify: true,
+			clientQuota: wtserver.ClientQuota{
+				MaxSessions: 1,
+			},
+		},
+		fn: func(h *testHarness) {
+			const (
+				chanID     = 0
+				numUpdates = 10
+			)
+
+			// Generate the retributions that will be backed up.
+			hints := h.advanceChannelN(chanID, numUpdates)
+
+			// Now, queue the retributions for backup.
+			h.backupStates(chanID, 0, numUpdates, nil)
+
+			// Only the updates of the first session are accepted,
+			// since the tower rejects a second session.
+			h.waitServerUpdates(hints[:5], time.Second)
+
+			// The session is recorded for the client's key.
+			clientKey := h.clientCfg.ClientKeySigner.PubKey()
+			sessions, err := h.serverDB.ListClientSessions(
+				clientKey,
+			)
+			require.NoError(h.t, err)
+			require.Len(h.t, sessions, 1)
+
+			// Force quit the client since it has queued backups.
+			h.client.ForceQuit()
+		},
+	},
+	{
+		// Asserts that exhausted sessions are deleted from the tower
+		// and the client once all of their channels are closed, and
+		// the session close delay has passed.
+		name: "delete closed sessions",
+		cfg: harnessCfg{
+			localBalance:  localBalance,
+			remoteBalance: remoteBalance,
+			policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blob.TypeAltruistCommit,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 5,
+			},
+		},
+		fn: func(h *testHarness) {
+			const (
+				numUpdates  = 5
+				closeHeight = 100
+				closeDelay  = wtclient.DefaultSessionCloseDelay
+			)
+
+			// Back up states of two channels, which will exhaust
+			// the first session.
+			h.makeChannel(
+				1, h.cfg.localBalance, h.cfg.remoteBalance,
+			)
+			h.registerChannel(1)
+
+			hints := h.advanceChannelN(0, 3)
+			hints = append(hints, h.advanceChannelN(1, 2)...)
+			h.backupStates(0, 0, 3, nil)
+			h.backupStates(1, 0, 2, nil)
+			h.waitServerUpdates(hints, 5*time.Second)
+
+			// Wait for the tower to ack all updates of the
+			// session, which is required for it to be closable.
+			sessionID := h.waitSessionAcked(numUpdates)
+
+			// Closing only the first channel doesn't make the
+			// session closable. Its updates are kept by the tower
+			// until the delay passed.
+			h.closeChannel(0, closeHeight)
+			h.mineBlock(closeHeight + closeDelay - 1)
+			h.mineBlock(closeHeight + closeDelay - 1)
+			h.waitServerUpdates(hints, time.Second)
+
+			// Once it did, the client tells the tower to delete
+			// the updates of the closed channel, while those of
+			// the second channel and the session itself are kept.
+			h.mineBlock(closeHeight + closeDelay)
+
+			err := wait.Predicate(func() bool {
+				matches, err := h.serverDB.QueryMatches(
+					hints[:3],
+				)
+				require.NoError(h.t, err)
+
+				return len(matches) == 0
+			}, 5*time.Second)
+			require.NoError(h.t, err)
+
+			h.waitServerUpdates(hints[3:], time.Second)
+
+			sessions, err := h.clientDB.ListClientSessions(nil)
+			require.NoError(h.t, err)
+			require.Contains(h.t, sessions, sessionID)
+
+			// Once the second channel is closed as well, the
+			// session is closable, but it is only deleted after
+			// the delay passed.
+			h.closeChannel(1, closeHeight+10)
+			h.mineBlock(closeHeight + 10 + closeDelay - 1)
+			h.mineBlock(closeHeight + 10 + closeDelay - 1)
+			h.waitServerUpdates(hints[3:], time.Second)
+
+			h.mineBlock(closeHeight + 10 + closeDelay)
+
+			// The tower should delete all updates of the session,
+			// and the client should delete the session.
+			err = wait.Predicate(func() bool {
+				matches, err := h.serverDB.QueryMatches(hints)
+				require.NoError(h.t, err)
+
+				return len(matches) == 0
+			}, 5*time.Second)
+			require.NoError(h.t, err)
+
+			err = wait.Predicate(func() bool {
+				sessions, err := h.clientDB.ListClientSessions(
+					nil,
+				)
+				require.NoError(h.t, err)
+
+				_, ok := sessions[sessionID]
+				return !ok
+			}, 5*time.Second)
+			require.NoError(h.t, err)
+		},
+	},
+	{
+		// Asserts that the updates of a channel that was closed while
+		// the client was offline are deleted from the tower, including
+		// those acked before the client indexed them by channel.
+		name: "delete updates of channel closed while offline",
+		cfg: harnessCfg{
+			localBalance:  localBalance,
+			remoteBalance: remoteBalance,
+			policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blob.TypeAltruistCommit,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 5,
+			},
+		},
+		fn: func(h *testHarness) {
+			const (
+				numUpdates  = 3
+				closeHeight = 100
+				closeDelay  = wtclient.DefaultSessionCloseDelay
+			)
+
+			hints := h.advanceChannelN(0, numUpdates)
+			h.backupStates(0, 0, numUpdates, nil)
+			h.waitServerUpdates(hints, 5*time.Second)
+
+			sessionID := h.waitSessionAcked(numUpdates)
+			h.client.Stop()
+
+			// Drop the channel's hints and record its updates as
+			// unindexed, as if they were acked before the upgrade.
+			sessions, err := h.clientDB.ListClientSessions(nil)
+			require.NoError(h.t, err)
+
+			chanID := chanIDFromInt(0)
+			err = h.clientDB.DeleteChannelHints(chanID, sessionID)
+			require.NoError(h.t, err)
+
+			acked := sessions[sessionID].AckedUpdates
+			for seqNum, backupID := range acked {
+				h.clientDB.AddUnindexedAck(
+					sessionID, seqNum, backupID,
+				)
+			}
+
+			// Close the channel while the client is offline, and
+			// restart it, which should notice the close.
+			h.markChannelClosed(0, closeHeight)
+			h.startClient()
+			defer h.client.ForceQuit()
+
+			unindexed, err := h.clientDB.ListUnindexedAcks()
+			require.NoError(h.t, err)
+			require.Empty(h.t, unindexed)
+
+			h.mineBlock(closeHeight + closeDelay - 1)
+			h.mineBlock(closeHeight + closeDelay - 1)
+			h.waitServerUpdates(hints, time.Second)
+
+			h.mineBlock(closeHeight + closeDelay)
+
+			err = wait.Predicate(func() bool {
+				matches, err := h.serverDB.QueryMatches(hints)
+				require.NoError(h.t, err)
+
+				return len(matches) == 0
+			}, 5*time.Second)
+			require.NoError(h.t, err)
+		},
+	},
+	{
+		name: "replicate backups to multiple towers",
+		cfg: harnessCfg{
+			localBalance:  localBalance,
+			remoteBalance: remoteBalance,
+			policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blob.TypeAltruistCommit,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 5,
+			},
+			replicationFactor: 2,
+		},
+		fn: func(h *testHarness) {
+			const numUpdates = 15
+
+			hints := h.advanceChannelN(0, numUpdates)
+
+			// With a second tower, each state is backed up to both
+			// towers.
+			tower := h.startSecondTower()
+			defer tower.server.Stop()
+
+			h.backupStates(0, 0, 5, nil)
+			h.waitTowerUpdates(h.serverDB, hints[:5])
+			h.waitTowerUpdates(tower.serverDB, hints[:5])
+
+			err := wait.Predicate(func() bool {
+				pubKey := tower.addr.IdentityKey
+				health := h.lookupTower(pubKey).Health
+				return health.NumPendingBackups == 0 &&
+					!health.LastAck.IsZero()
+			}, 5*time.Second)
+			require.NoError(h.t, err)
+
+			// Once the second tower is offline, the client fails
+			// over to only backing up to the first tower, since
+			// there is no other candidate.
+			tower.setOffline(true)
+			h.backupStates(0, 5, 10, nil)
+			h.waitTowerUpdates(h.serverDB, hints[:10])
+
+			err = wait.Predicate(func() bool {
+				pubKey := tower.addr.IdentityKey
+				registered := h.lookupTower(pubKey)
+				return !registered.Health.Healthy &&
+					!registered.ActiveSessionCandidate
+			}, 5*time.Second)
+			require.NoError(h.t, err)
+
+			health := h.lookupTower(h.serverAddr.IdentityKey).Health
+			require.True(h.t, health.Healthy)
+
+			// After the second tower is back online, the client
+			// considers it for new backups again, and backs up new
+			// states to both towers.
+			tower.setOffline(false)
+			err = wait.Predicate(func() bool {
+				pubKey := tower.addr.IdentityKey
+				registered := h.lookupTower(pubKey)
+				return registered.Health.Healthy &&
+					registered.ActiveSessionCandidate
+			}, 5*time.Second)
+			require.NoError(h.t, err)
+
+			h.backupStates(0, 10, numUpdates, nil)
+			h.waitTowerUpdates(h.serverDB, hints)
+			h.waitTowerUpdates(tower.serverDB, hints[10:])
+		},
+	},
 }
 
 // TestClient executes the client test suite, asserting the ability to backup
@@ -1543,6 +2174,7 @@ func TestClient(t *testing.T) {
 			t.Parallel()
 
 			h := newHarness(t, tc.cfg)
+			defer h.chanEvents.Stop()
 			defer h.server.Stop()
 			defer h.client.ForceQuit()
 
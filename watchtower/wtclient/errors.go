@@ -32,4 +32,24 @@ var (
 	// revoked state because the channel had not been previously registered
 	// with the client.
 	ErrUnregisteredChannel = errors.New("channel is not registered")
+
+	// ErrRewardSessionsUnsupported signals that the tower does not accept
+	// the reward sessions the client wants to negotiate.
+	ErrRewardSessionsUnsupported = errors.New("tower does not accept " +
+		"reward sessions")
+
+	// ErrChannelClosedUnsupported signals that the tower does not accept
+	// requests to delete the updates of closed channels.
+	ErrChannelClosedUnsupported = errors.New("tower does not accept " +
+		"channel closed messages")
+
+	// ErrRewardTermsExceedCaps signals that the reward required by the
+	// tower exceeds the maximum reward the client is willing to pay.
+	ErrRewardTermsExceedCaps = errors.New("tower reward terms exceed " +
+		"reward caps")
+
+	// errRewardTermsUpdated signals that the tower rejected the reward
+	// proposed by the client, but returned terms that are acceptable to
+	// the client, which should retry with them.
+	errRewardTermsUpdated = errors.New("tower reward terms updated")
 )
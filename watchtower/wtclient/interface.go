@@ -92,8 +92,57 @@ type DB interface {
 
 	// AckUpdate records an acknowledgment from the watchtower that the
 	// update identified by seqNum was received and saved. The returned
-	// lastApplied will be recorded.
+	// lastApplied will be recorded. The session is marked closable if the
+	// ack makes it so.
 	AckUpdate(id *wtdb.SessionID, seqNum, lastApplied uint16) error
+
+	// MarkChannelClosed records that the channel was closed at the given
+	// block height, and returns the sessions that are closable as a
+	// result. A session is closable once it is exhausted, all of its
+	// updates have been acked, and all channels it has updates for are
+	// closed.
+	MarkChannelClosed(chanID lnwire.ChannelID,
+		blockHeight uint32) ([]wtdb.SessionID, error)
+
+	// ListClosableSessions returns all closable sessions, mapped to the
+	// height at which the last channel with updates in the session was
+	// closed.
+	ListClosableSessions() (map[wtdb.SessionID]uint32, error)
+
+	// ListClosedChannels returns the closed channels with acked updates
+	// that the towers weren't told to delete yet, mapped to the height at
+	// which each channel was closed.
+	ListClosedChannels() (map[lnwire.ChannelID]uint32, error)
+
+	// FetchCloseHeights returns all channels that were marked closed,
+	// mapped to the height at which each channel was closed.
+	FetchCloseHeights() (map[lnwire.ChannelID]uint32, error)
+
+	// ListUnindexedAcks returns the acked updates whose hints weren't
+	// indexed by their channel yet, grouped by session and keyed by their
+	// sequence number.
+	ListUnindexedAcks() (map[wtdb.SessionID]map[uint16]wtdb.BackupID,
+		error)
+
+	// IndexAckedUpdate indexes the hint of an unindexed acked update by
+	// its channel. If the hint is nil, the update is only removed from the
+	// unindexed updates.
+	IndexAckedUpdate(id *wtdb.SessionID, seqNum uint16,
+		hint *blob.BreachHint) error
+
+	// FetchChannelHints returns the breach hints of the channel's acked
+	// updates that the towers weren't told to delete yet, grouped by
+	// session.
+	FetchChannelHints(
+		lnwire.ChannelID) (map[wtdb.SessionID][]blob.BreachHint, error)
+
+	// DeleteChannelHints removes the breach hints of the channel's updates
+	// in the session, once the session's tower deleted the updates.
+	DeleteChannelHints(lnwire.ChannelID, wtdb.SessionID) error
+
+	// DeleteSession removes a closable session, along with all of its
+	// updates, from the database.
+	DeleteSession(wtdb.SessionID) error
 }
 
 // AuthDialer connects to a remote node using an authenticated transport, such as
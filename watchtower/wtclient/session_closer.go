@@ -0,0 +1,634 @@
+package wtclient
+
+import (
+	"fmt"
+
+	"github.com/ltcsuite/lnd/chainntnfs"
+	"github.com/ltcsuite/lnd/channeldb"
+	"github.com/ltcsuite/lnd/channelnotifier"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/subscribe"
+	"github.com/ltcsuite/lnd/watchtower/blob"
+	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/lnd/watchtower/wtserver"
+	"github.com/ltcsuite/lnd/watchtower/wtwire"
+)
+
+// DefaultSessionCloseDelay is the default number of blocks the client waits
+// after a channel was closed, before deleting its updates from the towers, and
+// after the last channel of a closable session was closed, before deleting the
+// session from the tower and its database.
+const DefaultSessionCloseDelay = 288
+
+const (
+	// maxChannelClosedAttempts is the number of times the client tries to
+	// delete the updates of a closed channel from its towers. Afterwards,
+	// the remaining updates are left to be deleted along with their
+	// sessions.
+	maxChannelClosedAttempts = 10
+
+	// maxChannelClosedBackoff is the maximum number of blocks the client
+	// waits before retrying to delete the updates of a closed channel.
+	maxChannelClosedBackoff = 144
+)
+
+// closedChannel is a closed channel whose updates will be deleted from the
+// towers once deleteHeight is reached.
+type closedChannel struct {
+	deleteHeight uint32
+
+	// attempts is the number of failed attempts to delete the channel's
+	// updates from the towers.
+	attempts uint32
+}
+
+// closableSession is a session whose channels are all closed, which will be
+// deleted from the tower and the client's database once deleteHeight is
+// reached.
+type closableSession struct {
+	session      *wtdb.ClientSession
+	deleteHeight uint32
+}
+
+// indexAckedUpdates indexes the breach hints of the updates that were acked
+// before the client indexed them by channel, such that the towers can be told
+// to delete them once their channel is closed. The hints of updates whose
+// revoked state is no longer available, as their channel is closed, can't be
+// recovered, leaving those updates to be deleted along with their session.
+func (c *TowerClient) indexAckedUpdates() error {
+	unindexed, err := c.cfg.DB.ListUnindexedAcks()
+	if err != nil {
+		return err
+	}
+
+	if len(unindexed) == 0 {
+		return nil
+	}
+
+	// Sessions of the other channel type are handled by the other client.
+	sessions, err := c.cfg.DB.ListClientSessions(nil)
+	if err != nil {
+		return err
+	}
+
+	isAnchorClient := c.cfg.Policy.IsAnchorChannel()
+	for id, acks := range unindexed {
+		id := id
+
+		session, ok := sessions[id]
+		if ok && session.Policy.IsAnchorChannel() != isAnchorClient {
+			continue
+		}
+
+		for seqNum, backupID := range acks {
+			var hint *blob.BreachHint
+
+			breachTxID, err := c.cfg.FetchBreachTxID(
+				backupID.ChanID, backupID.CommitHeight,
+			)
+			if err != nil {
+				c.log.Debugf("Unable to recover hint of %v, "+
+					"deleting it along with session %s: %v",
+					backupID, id, err)
+			} else {
+				breachHint := blob.NewBreachHintFromHash(
+					breachTxID,
+				)
+				hint = &breachHint
+			}
+
+			err = c.cfg.DB.IndexAckedUpdate(&id, seqNum, hint)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// reconcileClosedChannels marks the registered channels that were closed while
+// the client wasn't running, or before it tracked channel closes, as closed.
+func (c *TowerClient) reconcileClosedChannels() error {
+	closeSummaries, err := c.cfg.FetchClosedChannels()
+	if err != nil {
+		return err
+	}
+
+	closeHeights, err := c.cfg.DB.FetchCloseHeights()
+	if err != nil {
+		return err
+	}
+
+	for _, summary := range closeSummaries {
+		// Channels are only considered closed once the close is
+		// confirmed.
+		if summary.IsPending {
+			continue
+		}
+
+		chanID := lnwire.NewChanIDFromOutPoint(&summary.ChanPoint)
+		if _, ok := closeHeights[chanID]; ok {
+			continue
+		}
+
+		// Ignore channels that were never registered with the client,
+		// as they can't have any updates.
+		c.backupMu.Lock()
+		_, ok := c.summaries[chanID]
+		c.backupMu.Unlock()
+		if !ok {
+			continue
+		}
+
+		c.log.Infof("Channel %v was closed at height %d before the "+
+			"client was started", chanID, summary.CloseHeight)
+
+		_, err := c.cfg.DB.MarkChannelClosed(chanID, summary.CloseHeight)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadClosableSessions starts tracking any closable sessions of the client's
+// channel type that aren't tracked yet.
+func (c *TowerClient) loadClosableSessions() error {
+	closeHeights, err := c.cfg.DB.ListClosableSessions()
+	if err != nil {
+		return err
+	}
+
+	for id := range closeHeights {
+		if _, ok := c.closableSessions[id]; ok {
+			delete(closeHeights, id)
+		}
+	}
+
+	if len(closeHeights) == 0 {
+		return nil
+	}
+
+	isAnchorClient := c.cfg.Policy.IsAnchorChannel()
+	sessions, err := getClientSessions(
+		c.cfg.DB, c.cfg.SecretKeyRing, nil,
+		func(s *wtdb.ClientSession) bool {
+			_, ok := closeHeights[s.ID]
+			isAnchor := s.Policy.IsAnchorChannel()
+
+			return ok && isAnchor == isAnchorClient
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for id, session := range sessions {
+		deleteHeight := closeHeights[id] + c.cfg.SessionCloseDelay
+		c.log.Infof("Session %s is closable, deleting it at height %d",
+			id, deleteHeight)
+
+		c.closableSessions[id] = &closableSession{
+			session:      session,
+			deleteHeight: deleteHeight,
+		}
+	}
+
+	return nil
+}
+
+// loadClosedChannels starts tracking any closed channels with updates that the
+// towers weren't told to delete yet, and that aren't tracked yet.
+func (c *TowerClient) loadClosedChannels() error {
+	closeHeights, err := c.cfg.DB.ListClosedChannels()
+	if err != nil {
+		return err
+	}
+
+	for chanID, closeHeight := range closeHeights {
+		if _, ok := c.closedChannels[chanID]; ok {
+			continue
+		}
+
+		deleteHeight := closeHeight + c.cfg.SessionCloseDelay
+		c.log.Infof("Channel %v is closed, deleting its updates from "+
+			"towers at height %d", chanID, deleteHeight)
+
+		c.closedChannels[chanID] = &closedChannel{
+			deleteHeight: deleteHeight,
+		}
+	}
+
+	return nil
+}
+
+// sessionCloser processes the closes of our channels, and deletes the updates
+// of closed channels and sessions that became closable once their delete
+// height is reached.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (c *TowerClient) sessionCloser(chanSub *subscribe.Client,
+	blockEpochs *chainntnfs.BlockEpochEvent) {
+
+	defer c.wg.Done()
+	defer chanSub.Cancel()
+	defer blockEpochs.Cancel()
+
+	c.log.Tracef("Starting session closer")
+	defer c.log.Tracef("Stopping session closer")
+
+	for {
+		select {
+		case update, ok := <-chanSub.Updates():
+			if !ok {
+				return
+			}
+
+			event, ok := update.(channelnotifier.ClosedChannelEvent)
+			if !ok {
+				continue
+			}
+
+			c.handleClosedChannel(event.CloseSummary)
+
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			// Sessions also become closable once their last
+			// update is acked after their channels were closed,
+			// so we pick those up before deleting any sessions.
+			err := c.loadClosableSessions()
+			if err != nil {
+				c.log.Errorf("Unable to load closable "+
+					"sessions: %v", err)
+			}
+
+			c.deleteClosedChannelUpdates(uint32(epoch.Height))
+			c.deleteClosableSessions(uint32(epoch.Height))
+
+		case <-chanSub.Quit():
+			return
+
+		case <-c.quit:
+			return
+
+		case <-c.forceQuit:
+			return
+		}
+	}
+}
+
+// handleClosedChannel records the close of one of our channels, and starts
+// tracking the channel and any sessions that became closable as a result.
+func (c *TowerClient) handleClosedChannel(
+	summary *channeldb.ChannelCloseSummary) {
+
+	if summary == nil {
+		return
+	}
+
+	// Ignore channels that were never registered with the client, as they
+	// can't have any updates.
+	chanID := lnwire.NewChanIDFromOutPoint(&summary.ChanPoint)
+	c.backupMu.Lock()
+	_, ok := c.summaries[chanID]
+	c.backupMu.Unlock()
+	if !ok {
+		return
+	}
+
+	closableSessions, err := c.cfg.DB.MarkChannelClosed(
+		chanID, summary.CloseHeight,
+	)
+	if err != nil {
+		c.log.Errorf("Unable to mark channel %v closed: %v", chanID,
+			err)
+		return
+	}
+
+	if err := c.loadClosedChannels(); err != nil {
+		c.log.Errorf("Unable to load closed channels: %v", err)
+	}
+
+	if len(closableSessions) == 0 {
+		return
+	}
+
+	if err := c.loadClosableSessions(); err != nil {
+		c.log.Errorf("Unable to load closable sessions: %v", err)
+	}
+}
+
+// deleteClosedChannelUpdates requests the towers to delete the updates of all
+// closed channels whose delete height is reached. Updates in closable sessions,
+// or in sessions whose tower doesn't accept ChannelClosed messages, are deleted
+// along with their session instead. Channels whose updates can't be deleted
+// from all towers are retried with an exponential backoff, until
+// maxChannelClosedAttempts is reached.
+func (c *TowerClient) deleteClosedChannelUpdates(height uint32) {
+	isAnchorClient := c.cfg.Policy.IsAnchorChannel()
+	for chanID, closed := range c.closedChannels {
+		if height < closed.deleteHeight {
+			continue
+		}
+
+		sessionHints, err := c.cfg.DB.FetchChannelHints(chanID)
+		if err != nil {
+			c.log.Errorf("Unable to fetch hints of closed channel "+
+				"%v: %v", chanID, err)
+			continue
+		}
+
+		// Sessions of the other channel type are handled by the other
+		// client.
+		sessions, err := getClientSessions(
+			c.cfg.DB, c.cfg.SecretKeyRing, nil,
+			func(s *wtdb.ClientSession) bool {
+				_, ok := sessionHints[s.ID]
+				isAnchor := s.Policy.IsAnchorChannel()
+
+				return ok && isAnchor == isAnchorClient
+			},
+		)
+		if err != nil {
+			c.log.Errorf("Unable to load sessions of closed "+
+				"channel %v: %v", chanID, err)
+			continue
+		}
+
+		var failed []wtdb.SessionID
+		for id, session := range sessions {
+			if _, ok := c.closableSessions[id]; ok {
+				continue
+			}
+
+			err := c.sendChannelClosed(session, sessionHints[id])
+			switch {
+			// The tower keeps the updates until the session is
+			// deleted, so there's no point in asking it again.
+			case err == ErrChannelClosedUnsupported:
+				c.log.Infof("Tower of session %s doesn't "+
+					"delete updates of closed channels, "+
+					"keeping those of channel %v until "+
+					"the session is deleted", id, chanID)
+
+			case err != nil:
+				c.log.Errorf("Unable to delete updates of "+
+					"closed channel %v from session %s: %v",
+					chanID, id, err)
+				failed = append(failed, id)
+				continue
+			}
+
+			err = c.cfg.DB.DeleteChannelHints(chanID, id)
+			if err != nil {
+				c.log.Errorf("Unable to delete hints of "+
+					"closed channel %v for session %s: %v",
+					chanID, id, err)
+				failed = append(failed, id)
+				continue
+			}
+		}
+
+		if len(failed) > 0 {
+			c.retryClosedChannel(chanID, closed, failed, height)
+			continue
+		}
+
+		c.log.Infof("Deleted updates of closed channel %v from towers",
+			chanID)
+
+		delete(c.closedChannels, chanID)
+	}
+}
+
+// retryClosedChannel schedules another attempt to delete the updates of the
+// closed channel from the given sessions' towers, backing off exponentially.
+// Once the maximum number of attempts is reached, the channel's hints for the
+// sessions are dropped instead, leaving the updates to be deleted along with
+// their sessions.
+func (c *TowerClient) retryClosedChannel(chanID lnwire.ChannelID,
+	closed *closedChannel, failed []wtdb.SessionID, height uint32) {
+
+	closed.attempts++
+	if closed.attempts < maxChannelClosedAttempts {
+		backoff := uint32(1) << closed.attempts
+		if backoff > maxChannelClosedBackoff {
+			backoff = maxChannelClosedBackoff
+		}
+		closed.deleteHeight = height + backoff
+
+		return
+	}
+
+	c.log.Warnf("Giving up deleting updates of closed channel %v from "+
+		"towers after %d attempts", chanID, closed.attempts)
+
+	for _, id := range failed {
+		err := c.cfg.DB.DeleteChannelHints(chanID, id)
+		if err != nil {
+			c.log.Errorf("Unable to delete hints of closed "+
+				"channel %v for session %s: %v", chanID, id,
+				err)
+		}
+	}
+
+	delete(c.closedChannels, chanID)
+}
+
+// deleteClosableSessions deletes all closable sessions whose delete height is
+// reached from their tower and the database. Sessions that can't be deleted
+// from their tower are retried on the next block.
+func (c *TowerClient) deleteClosableSessions(height uint32) {
+	for id, closable := range c.closableSessions {
+		if height < closable.deleteHeight {
+			continue
+		}
+
+		err := c.deleteSessionFromTower(closable.session)
+		if err != nil {
+			c.log.Errorf("Unable to delete session %s from tower: "+
+				"%v", id, err)
+			continue
+		}
+
+		err = c.cfg.DB.DeleteSession(id)
+		if err != nil {
+			c.log.Errorf("Unable to delete session %s: %v", id, err)
+			continue
+		}
+
+		c.log.Infof("Deleted closed session %s", id)
+
+		delete(c.closableSessions, id)
+	}
+}
+
+// dialSession connects to the session's tower using the session key, and
+// exchanges Init messages with it. The tower's Init message is returned along
+// with the connection, which the caller is responsible for closing.
+func (c *TowerClient) dialSession(s *wtdb.ClientSession) (wtserver.Peer,
+	*wtwire.Init, error) {
+
+	var (
+		conn wtserver.Peer
+		err  error
+	)
+	for _, addr := range s.Tower.Addresses {
+		conn, err = c.dial(s.SessionKeyECDH, &lnwire.NetAddress{
+			IdentityKey: s.Tower.IdentityKey,
+			Address:     addr,
+		})
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to dial tower: %v", err)
+	}
+
+	localInit := wtwire.NewInitMessage(
+		lnwire.NewRawFeatureVector(wtwire.AltruistSessionsRequired),
+		c.cfg.ChainHash,
+	)
+
+	// Send Init to tower.
+	err = c.sendMessage(conn, localInit)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	// Receive Init from tower.
+	remoteMsg, err := c.readMessage(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	remoteInit, ok := remoteMsg.(*wtwire.Init)
+	if !ok {
+		conn.Close()
+		return nil, nil, fmt.Errorf("watchtower responded with %T to "+
+			"Init", remoteMsg)
+	}
+
+	// Validate Init.
+	err = localInit.CheckRemoteInit(remoteInit, wtwire.FeatureNames)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	return conn, remoteInit, nil
+}
+
+// sendChannelClosed requests the session's tower to delete the session's
+// updates with the given breach hints, as their channel is closed. The hints
+// are split over as many ChannelClosed messages as needed. Sessions the tower
+// doesn't know of are considered deleted. ErrChannelClosedUnsupported is
+// returned if the tower doesn't advertise support for ChannelClosed messages,
+// as it would drop the connection upon receiving one.
+func (c *TowerClient) sendChannelClosed(s *wtdb.ClientSession,
+	hints []blob.BreachHint) error {
+
+	conn, remoteInit, err := c.dialSession(s)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if !remoteInit.ConnFeatures.IsSet(wtwire.ChannelClosedOptional) {
+		return ErrChannelClosedUnsupported
+	}
+
+	for len(hints) > 0 {
+		numHints := len(hints)
+		if numHints > wtwire.MaxChannelClosedHints {
+			numHints = wtwire.MaxChannelClosedHints
+		}
+
+		msg := &wtwire.ChannelClosed{
+			BreachHints: hints[:numHints],
+		}
+		hints = hints[numHints:]
+
+		// Signal the tower to close the connection after the last
+		// message.
+		if len(hints) == 0 {
+			msg.IsComplete = 1
+		}
+
+		// Send ChannelClosed to tower.
+		err = c.sendMessage(conn, msg)
+		if err != nil {
+			return err
+		}
+
+		// Receive ChannelClosedReply from tower.
+		remoteMsg, err := c.readMessage(conn)
+		if err != nil {
+			return err
+		}
+
+		reply, ok := remoteMsg.(*wtwire.ChannelClosedReply)
+		if !ok {
+			return fmt.Errorf("watchtower responded with %T to "+
+				"ChannelClosed", remoteMsg)
+		}
+
+		switch reply.Code {
+		case wtwire.CodeOK:
+
+		case wtwire.ChannelClosedCodeNotFound:
+			return nil
+
+		default:
+			return fmt.Errorf("received error code %v in "+
+				"ChannelClosedReply", reply.Code)
+		}
+	}
+
+	return nil
+}
+
+// deleteSessionFromTower requests the session's tower to delete all state of
+// the session. Sessions the tower doesn't know of are considered deleted.
+func (c *TowerClient) deleteSessionFromTower(s *wtdb.ClientSession) error {
+	conn, _, err := c.dialSession(s)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Send DeleteSession to tower.
+	err = c.sendMessage(conn, &wtwire.DeleteSession{})
+	if err != nil {
+		return err
+	}
+
+	// Receive DeleteSessionReply from tower.
+	remoteMsg, err := c.readMessage(conn)
+	if err != nil {
+		return err
+	}
+
+	reply, ok := remoteMsg.(*wtwire.DeleteSessionReply)
+	if !ok {
+		return fmt.Errorf("watchtower responded with %T to "+
+			"DeleteSession", remoteMsg)
+	}
+
+	switch reply.Code {
+	case wtwire.CodeOK, wtwire.DeleteSessionCodeNotFound:
+		return nil
+
+	default:
+		return fmt.Errorf("received error code %v in "+
+			"DeleteSessionReply", reply.Code)
+	}
+}
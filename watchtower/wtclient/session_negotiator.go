@@ -13,15 +13,19 @@ import (
 	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
 	"github.com/ltcsuite/lnd/watchtower/wtserver"
 	"github.com/ltcsuite/lnd/watchtower/wtwire"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/txscript"
 )
 
 // SessionNegotiator is an interface for asynchronously requesting new sessions.
 type SessionNegotiator interface {
 	// RequestSession signals to the session negotiator that the client
 	// needs another session. Once the session is negotiated, it should be
-	// returned via NewSessions.
-	RequestSession()
+	// returned via NewSessions. The session isn't negotiated with any of
+	// the excluded towers, such as the towers the client already has an
+	// active session queue with.
+	RequestSession(exclude map[wtdb.TowerID]struct{})
 
 	// NewSessions is a read-only channel where newly negotiated sessions
 	// will be delivered.
@@ -54,6 +58,9 @@ type NegotiatorConfig struct {
 	// Policy defines the session policy that will be proposed to towers
 	// when attempting to negotiate a new session. This policy will be used
 	// across all negotiation proposals for the lifetime of the negotiator.
+	// For reward policies, the reward base and rate are the maximum reward
+	// the client is willing to pay, while the reward proposed to a tower
+	// is the one it requires.
 	Policy wtpolicy.Policy
 
 	// Dial initiates an outbound brontide connection to the given address
@@ -69,11 +76,19 @@ type NegotiatorConfig struct {
 	// decoded wtwire message.
 	ReadMessage func(wtserver.Peer) (wtwire.Message, error)
 
+	// Health tracks the health of the candidate towers, which is updated
+	// after each negotiation attempt.
+	Health *towerHealthSet
+
 	// ChainHash the genesis hash identifying the chain for any negotiated
 	// sessions. Any state updates sent to that session should also
 	// originate from this chain.
 	ChainHash chainhash.Hash
 
+	// ClientKeySigner, if set, signs the session keys of new sessions to
+	// identify the client to towers.
+	ClientKeySigner keychain.SingleKeyMessageSigner
+
 	// MinBackoff defines the initial backoff applied by the session
 	// negotiator after all tower candidates have been exhausted and
 	// reattempting negotiation with the same set of candidates. Subsequent
@@ -101,6 +116,16 @@ type sessionNegotiator struct {
 
 	localInit *wtwire.Init
 
+	// rewardTerms holds the reward terms required by each tower that
+	// rejected a proposed reward, which are proposed to the tower
+	// afterwards.
+	rewardTerms    map[wtdb.TowerID]wtwire.RewardTerms
+	rewardTermsMtx sync.Mutex
+
+	// exclude holds the towers excluded by the last session request.
+	exclude    map[wtdb.TowerID]struct{}
+	excludeMtx sync.Mutex
+
 	cfg *NegotiatorConfig
 	log btclog.Logger
 
@@ -128,16 +153,22 @@ func newSessionNegotiator(cfg *NegotiatorConfig) *sessionNegotiator {
 	if cfg.Policy.IsAnchorChannel() {
 		features = append(features, wtwire.AnchorCommitRequired)
 	}
+	if cfg.Policy.BlobType.Has(blob.FlagReward) {
+		features = append(features, wtwire.RewardSessionsRequired)
+	}
 
 	localInit := wtwire.NewInitMessage(
 		lnwire.NewRawFeatureVector(features...),
 		cfg.ChainHash,
 	)
 
+	rewardTerms := make(map[wtdb.TowerID]wtwire.RewardTerms)
+
 	return &sessionNegotiator{
 		cfg:                    cfg,
 		log:                    cfg.Log,
 		localInit:              localInit,
+		rewardTerms:            rewardTerms,
 		dispatcher:             make(chan struct{}, 1),
 		newSessions:            make(chan *wtdb.ClientSession),
 		successfulNegotiations: make(chan *wtdb.ClientSession),
@@ -176,15 +207,33 @@ func (n *sessionNegotiator) NewSessions() <-chan *wtdb.ClientSession {
 }
 
 // RequestSession sends a request to the sessionNegotiator to begin requesting a
-// new session. If one is already in the process of being negotiated, the
-// request will be ignored.
-func (n *sessionNegotiator) RequestSession() {
+// new session from any tower that isn't excluded. If one is already in the
+// process of being negotiated, the request will be ignored, apart from
+// updating the excluded towers.
+func (n *sessionNegotiator) RequestSession(exclude map[wtdb.TowerID]struct{}) {
+	excludeCopy := make(map[wtdb.TowerID]struct{}, len(exclude))
+	for id := range exclude {
+		excludeCopy[id] = struct{}{}
+	}
+
+	n.excludeMtx.Lock()
+	n.exclude = excludeCopy
+	n.excludeMtx.Unlock()
+
 	select {
 	case n.dispatcher <- struct{}{}:
 	default:
 	}
 }
 
+// excludedTowers returns the towers excluded by the last session request.
+func (n *sessionNegotiator) excludedTowers() map[wtdb.TowerID]struct{} {
+	n.excludeMtx.Lock()
+	defer n.excludeMtx.Unlock()
+
+	return n.exclude
+}
+
 // negotiationDispatcher acts as the primary event loop for the
 // sessionNegotiator, coordinating requests for more sessions and dispatching
 // attempts to negotiate them from a list of candidates.
@@ -280,7 +329,7 @@ retryWithBackoff:
 		}
 
 		// Pull the next candidate from our list of addresses.
-		tower, err := n.cfg.Candidates.Next()
+		tower, err := n.cfg.Candidates.Next(n.excludedTowers())
 		if err != nil {
 			// We've run out of addresses, update our backoff.
 			updateBackoff()
@@ -320,6 +369,7 @@ retryWithBackoff:
 		if err != nil {
 			// An unexpected error occurred, updpate our backoff.
 			updateBackoff()
+			n.cfg.Health.failed(tower.ID)
 
 			n.log.Debugf("Session negotiation with tower=%x "+
 				"failed, trying again -- reason: %v",
@@ -329,6 +379,7 @@ retryWithBackoff:
 		}
 
 		// Success.
+		n.cfg.Health.sessionNegotiated(tower.ID)
 		return
 	}
 }
@@ -360,6 +411,13 @@ func (n *sessionNegotiator) createSession(tower *wtdb.Tower,
 
 	for _, lnAddr := range tower.LNAddrs() {
 		err := n.tryAddress(sessionKey, keyIndex, tower, lnAddr)
+
+		// If the tower rejected our reward but its own terms are
+		// acceptable, immediately retry with them.
+		if err == errRewardTermsUpdated {
+			err = n.tryAddress(sessionKey, keyIndex, tower, lnAddr)
+		}
+
 		switch {
 		case err == ErrPermanentTowerFailure:
 			// TODO(conner): report to iterator? can then be reset
@@ -380,6 +438,26 @@ func (n *sessionNegotiator) createSession(tower *wtdb.Tower,
 	return ErrFailedNegotiation
 }
 
+// signClientKey adds the client key and its signature of the session key to
+// the CreateSession message.
+func (n *sessionNegotiator) signClientKey(createSession *wtwire.CreateSession,
+	sessionKey *btcec.PublicKey) error {
+
+	msg := wtwire.ClientSigMsg(sessionKey, n.cfg.ChainHash)
+	sig, err := n.cfg.ClientKeySigner.SignMessage(msg, false)
+	if err != nil {
+		return fmt.Errorf("unable to sign client key: %v", err)
+	}
+
+	createSession.ClientSig, err = lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		return err
+	}
+	createSession.ClientKey = n.cfg.ClientKeySigner.PubKey()
+
+	return nil
+}
+
 // tryAddress executes a single create session dance using the given address.
 // The address should belong to the tower's set of addresses. This method only
 // returns true if all steps succeed and the new session has been persisted, and
@@ -417,7 +495,25 @@ func (n *sessionNegotiator) tryAddress(sessionKey keychain.SingleKeyECDH,
 		return err
 	}
 
+	// Reward sessions require the tower to accept them, which is signaled
+	// in its Init message.
+	isRewardPolicy := n.cfg.Policy.BlobType.Has(blob.FlagReward)
+	if isRewardPolicy &&
+		!remoteInit.ConnFeatures.IsSet(wtwire.RewardSessionsOptional) {
+
+		return ErrRewardSessionsUnsupported
+	}
+
+	// For reward sessions, we propose the reward the tower required the
+	// last time we negotiated with it. Initially we propose no reward, and
+	// learn the tower's terms from its rejection.
 	policy := n.cfg.Policy
+	if isRewardPolicy {
+		terms := n.getRewardTerms(tower.ID)
+		policy.RewardBase = terms.RewardBase
+		policy.RewardRate = terms.RewardRate
+	}
+
 	createSession := &wtwire.CreateSession{
 		BlobType:     policy.BlobType,
 		MaxUpdates:   policy.MaxUpdates,
@@ -426,6 +522,15 @@ func (n *sessionNegotiator) tryAddress(sessionKey keychain.SingleKeyECDH,
 		SweepFeeRate: policy.SweepFeeRate,
 	}
 
+	// If we identify ourselves to towers, prove that we own the client key
+	// by signing the session key with it.
+	if n.cfg.ClientKeySigner != nil {
+		err := n.signClientKey(createSession, sessionKey.PubKey())
+		if err != nil {
+			return err
+		}
+	}
+
 	// Send CreateSession message.
 	err = n.cfg.SendMessage(conn, createSession)
 	if err != nil {
@@ -452,15 +557,23 @@ func (n *sessionNegotiator) tryAddress(sessionKey keychain.SingleKeyECDH,
 		// handle case where we lose state, session already exists, and
 		// we want to possibly resume using the session
 
-		// TODO(conner): validate reward address
+		// The justice transactions of reward sessions pay the tower's
+		// reward to a P2WKH output, which is what our weight estimates
+		// assume.
 		rewardPkScript := createSessionReply.Data
+		if isRewardPolicy &&
+			!txscript.IsPayToWitnessPubKeyHash(rewardPkScript) {
+
+			return fmt.Errorf("tower returned invalid reward "+
+				"script: %x", rewardPkScript)
+		}
 
 		sessionID := wtdb.NewSessionIDFromPubKey(sessionKey.PubKey())
 		clientSession := &wtdb.ClientSession{
 			ClientSessionBody: wtdb.ClientSessionBody{
 				TowerID:        tower.ID,
 				KeyIndex:       keyIndex,
-				Policy:         n.cfg.Policy,
+				Policy:         policy,
 				RewardPkScript: rewardPkScript,
 			},
 			Tower:          tower,
@@ -500,19 +613,83 @@ func (n *sessionNegotiator) tryAddress(sessionKey keychain.SingleKeyECDH,
 		// The tower rejected the session because of the reward rate. If
 		// we didn't request a reward session, we'll treat this as a
 		// permanent tower failure.
-		if !policy.BlobType.Has(blob.FlagReward) {
+		if !isRewardPolicy {
 			return ErrPermanentTowerFailure
 		}
 
-		return fmt.Errorf("tower rejected reward rate: %v",
-			policy.RewardRate)
+		// Otherwise, the tower returned the reward terms it requires.
+		// If they're within our caps, we'll remember them so that we
+		// can propose them instead.
+		terms, err := wtwire.ParseRewardTerms(createSessionReply.Data)
+		if err != nil {
+			return fmt.Errorf("tower rejected reward rate %v with "+
+				"invalid terms: %v", policy.RewardRate, err)
+		}
+
+		requested := policy.TxPolicy
+		requested.RewardBase = terms.RewardBase
+		requested.RewardRate = terms.RewardRate
+		if !n.cfg.Policy.AcceptsTerms(requested) {
+			n.log.Debugf("Tower %s requires reward base %d and "+
+				"rate %d, exceeding our caps of base %d and "+
+				"rate %d",
+				lnAddr, terms.RewardBase, terms.RewardRate,
+				n.cfg.Policy.RewardBase, n.cfg.Policy.RewardRate)
+
+			return ErrRewardTermsExceedCaps
+		}
+
+		// If we already proposed these terms, the tower is
+		// misbehaving and we won't retry.
+		if terms.RewardBase == policy.RewardBase &&
+			terms.RewardRate == policy.RewardRate {
+
+			return fmt.Errorf("tower rejected its own reward "+
+				"terms: base %d, rate %d", terms.RewardBase,
+				terms.RewardRate)
+		}
+
+		n.setRewardTerms(tower.ID, *terms)
+
+		return errRewardTermsUpdated
 
 	case wtwire.CreateSessionCodeRejectSweepFeeRate:
 		return fmt.Errorf("tower rejected sweep fee rate: %v",
 			policy.SweepFeeRate)
 
+	// The tower doesn't serve us, or we exhausted our quota with it,
+	// neither of which depends on the address we dialed.
+	case wtwire.CreateSessionCodeRejectClient,
+		wtwire.CreateSessionCodeRejectQuota:
+
+		n.log.Warnf("Tower %s rejected session: %v", lnAddr,
+			createSessionReply.Code)
+
+		return ErrPermanentTowerFailure
+
 	default:
 		return fmt.Errorf("received unhandled error code: %v",
 			createSessionReply.Code)
 	}
 }
+
+// getRewardTerms returns the last known reward terms of the given tower, or
+// no reward if the tower's terms are unknown.
+func (n *sessionNegotiator) getRewardTerms(
+	towerID wtdb.TowerID) wtwire.RewardTerms {
+
+	n.rewardTermsMtx.Lock()
+	defer n.rewardTermsMtx.Unlock()
+
+	return n.rewardTerms[towerID]
+}
+
+// setRewardTerms stores the reward terms required by the given tower.
+func (n *sessionNegotiator) setRewardTerms(towerID wtdb.TowerID,
+	terms wtwire.RewardTerms) {
+
+	n.rewardTermsMtx.Lock()
+	defer n.rewardTermsMtx.Unlock()
+
+	n.rewardTerms[towerID] = terms
+}
@@ -59,6 +59,10 @@ type sessionQueueConfig struct {
 	// DB provides access to the client's stable storage.
 	DB DB
 
+	// Health tracks the health of the session's tower, which is updated
+	// as backups are accepted, acknowledged, or fail to be delivered.
+	Health *towerHealthSet
+
 	// MinBackoff defines the initial backoff applied by the session
 	// queue before reconnecting to the tower after a failed or partially
 	// successful batch is sent. Subsequent backoff durations will grow
@@ -140,6 +144,10 @@ func newSessionQueue(cfg *sessionQueueConfig) *sessionQueue {
 	for _, update := range sq.cfg.ClientSession.CommittedUpdates {
 		sq.commitQueue.PushBack(update)
 	}
+	cfg.Health.backupsAdded(
+		cfg.ClientSession.TowerID,
+		uint32(len(cfg.ClientSession.CommittedUpdates)),
+	)
 
 	return sq
 }
@@ -234,6 +242,7 @@ func (q *sessionQueue) AcceptTask(task *backupTask) (reserveStatus, bool) {
 	// The sweep and reward outputs satisfy the session's policy, queue the
 	// task for final signing and delivery.
 	q.pendingQueue.PushBack(task)
+	q.cfg.Health.backupsAdded(q.cfg.ClientSession.TowerID, 1)
 
 	// Finally, compute the session's *new* reserve status. This will be
 	// used by the client to determine if it can continue using this session
@@ -296,6 +305,7 @@ func (q *sessionQueue) drainBackups() {
 	if err != nil {
 		q.log.Errorf("SessionQueue(%s) unable to dial tower at %v: %v",
 			q.ID(), q.towerAddr, err)
+		q.cfg.Health.failed(q.cfg.ClientSession.TowerID)
 
 		q.increaseBackoff()
 		select {
@@ -328,6 +338,7 @@ func (q *sessionQueue) drainBackups() {
 		if err != nil {
 			q.log.Errorf("SessionQueue(%s) unable to send state "+
 				"update: %v", q.ID(), err)
+			q.cfg.Health.failed(q.cfg.ClientSession.TowerID)
 
 			q.increaseBackoff()
 			select {
@@ -593,6 +604,8 @@ func (q *sessionQueue) sendStateUpdate(conn wtserver.Peer,
 	}
 	q.queueCond.L.Unlock()
 
+	q.cfg.Health.backupAcked(q.cfg.ClientSession.TowerID)
+
 	return nil
 }
 
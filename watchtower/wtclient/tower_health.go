@@ -0,0 +1,204 @@
+package wtclient
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ltcsuite/lnd/watchtower/wtdb"
+)
+
+// DefaultTowerFailureThreshold is the default number of consecutive failures
+// to negotiate sessions with or deliver backups to a tower, after which the
+// tower is considered unhealthy.
+const DefaultTowerFailureThreshold = 3
+
+// TowerHealth describes the health of a watchtower, as observed by the client
+// since it started.
+type TowerHealth struct {
+	// NumPendingBackups is the number of backups that were assigned to
+	// sessions with the tower, but haven't been acknowledged yet.
+	NumPendingBackups uint32
+
+	// LastAck is the time the tower last acknowledged a backup. It is the
+	// zero time if the tower didn't acknowledge any backup yet.
+	LastAck time.Time
+
+	// ConsecutiveFailures is the number of consecutive failed attempts to
+	// negotiate a session with or deliver a backup to the tower.
+	ConsecutiveFailures uint32
+
+	// Healthy is true if the number of consecutive failures is below the
+	// client's failure threshold.
+	Healthy bool
+}
+
+// towerHealthSet tracks the health of all towers the client negotiates
+// sessions with and delivers backups to. It is safe for concurrent use, as it
+// is updated by the session negotiator and all session queues.
+type towerHealthSet struct {
+	mu     sync.Mutex
+	towers map[wtdb.TowerID]*TowerHealth
+
+	// failureThreshold is the number of consecutive failures after which a
+	// tower is considered unhealthy.
+	failureThreshold uint32
+
+	// updates is signaled whenever a tower becomes healthy or unhealthy.
+	updates chan struct{}
+}
+
+// newTowerHealthSet creates a towerHealthSet considering towers unhealthy
+// after the given number of consecutive failures.
+func newTowerHealthSet(failureThreshold uint32) *towerHealthSet {
+	return &towerHealthSet{
+		towers:           make(map[wtdb.TowerID]*TowerHealth),
+		failureThreshold: failureThreshold,
+		updates:          make(chan struct{}, 1),
+	}
+}
+
+// Updates returns a channel that is signaled whenever a tower becomes healthy
+// or unhealthy. Multiple changes may be coalesced into a single signal.
+func (s *towerHealthSet) Updates() <-chan struct{} {
+	return s.updates
+}
+
+// get returns the health of the given tower, creating it if unknown.
+//
+// NOTE: This method MUST be called with the mutex held.
+func (s *towerHealthSet) get(id wtdb.TowerID) *TowerHealth {
+	health, ok := s.towers[id]
+	if !ok {
+		health = &TowerHealth{Healthy: true}
+		s.towers[id] = health
+	}
+
+	return health
+}
+
+// setHealthy updates whether the tower is healthy, and signals the change if
+// it differs from the previous state.
+//
+// NOTE: This method MUST be called with the mutex held.
+func (s *towerHealthSet) setHealthy(health *TowerHealth, healthy bool) {
+	if health.Healthy == healthy {
+		return
+	}
+	health.Healthy = healthy
+
+	select {
+	case s.updates <- struct{}{}:
+	default:
+	}
+}
+
+// backupsAdded records that the given number of backups were assigned to a
+// session with the tower.
+func (s *towerHealthSet) backupsAdded(id wtdb.TowerID, num uint32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.get(id).NumPendingBackups += num
+}
+
+// backupAcked records that the tower acknowledged a backup, which resets its
+// consecutive failures.
+func (s *towerHealthSet) backupAcked(id wtdb.TowerID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health := s.get(id)
+	if health.NumPendingBackups > 0 {
+		health.NumPendingBackups--
+	}
+	health.LastAck = time.Now()
+	health.ConsecutiveFailures = 0
+	s.setHealthy(health, true)
+}
+
+// sessionNegotiated records that a session was negotiated with the tower,
+// which resets its consecutive failures.
+func (s *towerHealthSet) sessionNegotiated(id wtdb.TowerID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health := s.get(id)
+	health.ConsecutiveFailures = 0
+	s.setHealthy(health, true)
+}
+
+// failed records a failed attempt to negotiate a session with or deliver a
+// backup to the tower.
+func (s *towerHealthSet) failed(id wtdb.TowerID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health := s.get(id)
+	health.ConsecutiveFailures++
+	if health.ConsecutiveFailures >= s.failureThreshold {
+		s.setHealthy(health, false)
+	}
+}
+
+// reset clears the consecutive failures of the tower, giving an unhealthy
+// tower another chance. Unlike other updates, this isn't signaled.
+func (s *towerHealthSet) reset(id wtdb.TowerID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health := s.get(id)
+	health.ConsecutiveFailures = 0
+	health.Healthy = true
+}
+
+// probe gives an unhealthy tower another chance by considering it healthy
+// again, although a single further failure makes it unhealthy again.
+func (s *towerHealthSet) probe(id wtdb.TowerID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health := s.get(id)
+	if health.Healthy {
+		return
+	}
+
+	health.ConsecutiveFailures = s.failureThreshold - 1
+	s.setHealthy(health, true)
+}
+
+// isHealthy returns true if the tower is healthy. Unknown towers are healthy.
+func (s *towerHealthSet) isHealthy(id wtdb.TowerID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health, ok := s.towers[id]
+	return !ok || health.Healthy
+}
+
+// unhealthyTowers returns the IDs of all unhealthy towers.
+func (s *towerHealthSet) unhealthyTowers() []wtdb.TowerID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ids []wtdb.TowerID
+	for id, health := range s.towers {
+		if !health.Healthy {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// health returns a copy of the health of the given tower.
+func (s *towerHealthSet) health(id wtdb.TowerID) TowerHealth {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health, ok := s.towers[id]
+	if !ok {
+		return TowerHealth{Healthy: true}
+	}
+
+	return *health
+}
@@ -48,6 +48,29 @@ var (
 	//    tower-pubkey -> tower-id.
 	cTowerIndexBkt = []byte("client-tower-index-bucket")
 
+	// cClosedChanBkt is a top-level bucket storing:
+	//    channel-id -> close-height (uint32).
+	cClosedChanBkt = []byte("client-closed-channel-bucket")
+
+	// cClosableSessionsBkt is a top-level bucket storing:
+	//    session-id -> close-height (uint32).
+	//
+	// The close height is the height at which the last channel with
+	// updates in the session was closed.
+	cClosableSessionsBkt = []byte("client-closable-sessions-bucket")
+
+	// cChanHintsBkt is a top-level bucket storing the breach hints of the
+	// acked updates of each channel, until the towers deleted the updates
+	// after the channel was closed:
+	//    channel-id => session-id => hint -> []byte{}
+	cChanHintsBkt = []byte("client-channel-hints-bucket")
+
+	// cUnindexedAcksBkt is a top-level bucket storing the acked updates
+	// whose hints weren't indexed in cChanHintsBkt when they were acked,
+	// as they were acked before the index was introduced:
+	//    session-id => seqnum -> encoded BackupID
+	cUnindexedAcksBkt = []byte("client-unindexed-acks-bucket")
+
 	// ErrTowerNotFound signals that the target tower was not found in the
 	// database.
 	ErrTowerNotFound = errors.New("tower not found")
@@ -108,6 +131,11 @@ var (
 	// ErrLastTowerAddr is an error returned when the last address of a
 	// watchtower is attempted to be removed.
 	ErrLastTowerAddr = errors.New("cannot remove last tower address")
+
+	// ErrSessionNotClosable signals that a client session could not be
+	// deleted because it can still be used for backups, or not all of the
+	// channels it has updates for are closed.
+	ErrSessionNotClosable = errors.New("session is not closable")
 )
 
 // NewBoltBackendCreator returns a function that creates a new bbolt backend for
@@ -195,6 +223,10 @@ func initClientDBBuckets(tx kvdb.RwTx) error {
 		cSessionBkt,
 		cTowerBkt,
 		cTowerIndexBkt,
+		cClosedChanBkt,
+		cClosableSessionsBkt,
+		cChanHintsBkt,
+		cUnindexedAcksBkt,
 	}
 
 	for _, bucket := range buckets {
@@ -207,6 +239,42 @@ func initClientDBBuckets(tx kvdb.RwTx) error {
 	return nil
 }
 
+// migrateUnindexedAcks records the acked updates of all sessions as unindexed.
+// Their hints can't be recovered from the database alone, so the client
+// indexes them in cChanHintsBkt once it rebuilt them from the channel state.
+func migrateUnindexedAcks(tx kvdb.RwTx) error {
+	sessions := tx.ReadBucket(cSessionBkt)
+	if sessions == nil {
+		return nil
+	}
+
+	unindexedAcks, err := tx.CreateTopLevelBucket(cUnindexedAcksBkt)
+	if err != nil {
+		return err
+	}
+
+	return sessions.ForEach(func(k, _ []byte) error {
+		sessionBkt := sessions.NestedReadBucket(k)
+		if sessionBkt == nil {
+			return nil
+		}
+
+		sessionAcks := sessionBkt.NestedReadBucket(cSessionAcks)
+		if sessionAcks == nil {
+			return nil
+		}
+
+		sessionUnindexed, err := unindexedAcks.CreateBucketIfNotExists(k)
+		if err != nil {
+			return err
+		}
+
+		return sessionAcks.ForEach(func(k, v []byte) error {
+			return sessionUnindexed.Put(k, v)
+		})
+	})
+}
+
 // bdb returns the backing bbolt.DB instance.
 //
 // NOTE: Part of the versionedDB interface.
@@ -904,7 +972,9 @@ func (c *ClientDB) CommitUpdate(id *SessionID,
 
 // AckUpdate persists an acknowledgment for a given (session, seqnum) pair. This
 // removes the update from the set of committed updates, and validates the
-// lastApplied value returned from the tower.
+// lastApplied value returned from the tower. If the ack makes the session
+// closable, as all channels it has updates for were closed already, the session
+// is marked closable.
 func (c *ClientDB) AckUpdate(id *SessionID, seqNum uint16,
 	lastApplied uint16) error {
 
@@ -914,6 +984,21 @@ func (c *ClientDB) AckUpdate(id *SessionID, seqNum uint16,
 			return ErrUninitializedDB
 		}
 
+		chanHints := tx.ReadWriteBucket(cChanHintsBkt)
+		if chanHints == nil {
+			return ErrUninitializedDB
+		}
+
+		closedChans := tx.ReadBucket(cClosedChanBkt)
+		if closedChans == nil {
+			return ErrUninitializedDB
+		}
+
+		closableIndex := tx.ReadWriteBucket(cClosableSessionsBkt)
+		if closableIndex == nil {
+			return ErrUninitializedDB
+		}
+
 		// We'll only load the ClientSession body for performance, since
 		// we primarily need to inspect its SeqNum and TowerLastApplied
 		// fields. The CommittedUpdates and AckedUpdates will be
@@ -997,8 +1082,526 @@ func (c *ClientDB) AckUpdate(id *SessionID, seqNum uint16,
 			return err
 		}
 
-		// Finally, insert the ack into the sessionAcks sub-bucket.
-		return sessionAcks.Put(seqNumBuf[:], b.Bytes())
+		// Insert the ack into the sessionAcks sub-bucket.
+		err = sessionAcks.Put(seqNumBuf[:], b.Bytes())
+		if err != nil {
+			return err
+		}
+
+		// Index the update's hint under its channel, such that the
+		// tower can be told to delete the update once the channel is
+		// closed.
+		err = putChanHint(
+			chanHints, committedUpdate.BackupID.ChanID, id,
+			committedUpdate.Hint,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Finally, the session might have become closable if this was
+		// its last unacked update, and its channels were closed while
+		// it was pending.
+		closeHeight, err := sessionCloseHeight(
+			sessions, closedChans, id[:], nil,
+		)
+		switch {
+		case err == ErrSessionNotClosable:
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		var heightBuf [4]byte
+		byteOrder.PutUint32(heightBuf[:], closeHeight)
+
+		return closableIndex.Put(id[:], heightBuf[:])
+	}, func() {})
+}
+
+// MarkChannelClosed records that the channel was closed at the given block
+// height, and returns the sessions that are closable as a result. A session is
+// closable once it is exhausted, all of its updates have been acked, and all
+// channels it has updates for are closed. Closable sessions can be deleted
+// from the tower and the database. Marking a channel closed more than once
+// keeps the original close height.
+func (c *ClientDB) MarkChannelClosed(chanID lnwire.ChannelID,
+	blockHeight uint32) ([]SessionID, error) {
+
+	var closableSessions []SessionID
+	err := kvdb.Update(c.db, func(tx kvdb.RwTx) error {
+		chanSummaries := tx.ReadBucket(cChanSummaryBkt)
+		if chanSummaries == nil {
+			return ErrUninitializedDB
+		}
+
+		closedChans := tx.ReadWriteBucket(cClosedChanBkt)
+		if closedChans == nil {
+			return ErrUninitializedDB
+		}
+
+		closableIndex := tx.ReadWriteBucket(cClosableSessionsBkt)
+		if closableIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		sessions := tx.ReadBucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		_, err := getChanSummary(chanSummaries, chanID)
+		if err != nil {
+			return err
+		}
+
+		if closedChans.Get(chanID[:]) == nil {
+			var heightBuf [4]byte
+			byteOrder.PutUint32(heightBuf[:], blockHeight)
+
+			err := closedChans.Put(chanID[:], heightBuf[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		// Now that the channel is closed, find all sessions with
+		// updates for it that became closable.
+		return sessions.ForEach(func(k, _ []byte) error {
+			closeHeight, err := sessionCloseHeight(
+				sessions, closedChans, k, &chanID,
+			)
+			switch {
+			case err == ErrSessionNotClosable:
+				return nil
+
+			case err != nil:
+				return err
+			}
+
+			var heightBuf [4]byte
+			byteOrder.PutUint32(heightBuf[:], closeHeight)
+
+			err = closableIndex.Put(k, heightBuf[:])
+			if err != nil {
+				return err
+			}
+
+			var id SessionID
+			copy(id[:], k)
+			closableSessions = append(closableSessions, id)
+
+			return nil
+		})
+	}, func() {
+		closableSessions = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closableSessions, nil
+}
+
+// sessionCloseHeight returns the height at which the last channel with updates
+// in the session was closed. ErrSessionNotClosable is returned if the session
+// isn't closable yet, or, if a channel is given, has no updates for it.
+func sessionCloseHeight(sessions, closedChans kvdb.RBucket, idBytes []byte,
+	chanID *lnwire.ChannelID) (uint32, error) {
+
+	session, err := getClientSessionBody(sessions, idBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	// Sessions that can still be used for backups aren't closable.
+	if session.SeqNum < session.Policy.MaxUpdates {
+		return 0, ErrSessionNotClosable
+	}
+
+	// Neither are sessions with updates the tower hasn't acked yet.
+	sessionBkt := sessions.NestedReadBucket(idBytes)
+	sessionCommits := sessionBkt.NestedReadBucket(cSessionCommits)
+	if sessionCommits != nil {
+		err := isBucketEmpty(sessionCommits)
+		switch {
+		case err == errBucketNotEmpty:
+			return 0, ErrSessionNotClosable
+
+		case err != nil:
+			return 0, err
+		}
+	}
+
+	ackedUpdates, err := getClientSessionAcks(sessions, idBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		hasChannel  = chanID == nil
+		closeHeight uint32
+	)
+	for _, backupID := range ackedUpdates {
+		if chanID != nil && backupID.ChanID == *chanID {
+			hasChannel = true
+		}
+
+		heightBytes := closedChans.Get(backupID.ChanID[:])
+		if heightBytes == nil {
+			return 0, ErrSessionNotClosable
+		}
+
+		height := byteOrder.Uint32(heightBytes)
+		if height > closeHeight {
+			closeHeight = height
+		}
+	}
+
+	if !hasChannel {
+		return 0, ErrSessionNotClosable
+	}
+
+	return closeHeight, nil
+}
+
+// ListClosableSessions returns all closable sessions, mapped to the height at
+// which the last channel with updates in the session was closed.
+func (c *ClientDB) ListClosableSessions() (map[SessionID]uint32, error) {
+	var closableSessions map[SessionID]uint32
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		closableIndex := tx.ReadBucket(cClosableSessionsBkt)
+		if closableIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		return closableIndex.ForEach(func(k, v []byte) error {
+			var id SessionID
+			copy(id[:], k)
+			closableSessions[id] = byteOrder.Uint32(v)
+
+			return nil
+		})
+	}, func() {
+		closableSessions = make(map[SessionID]uint32)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closableSessions, nil
+}
+
+// ListClosedChannels returns the closed channels with acked updates that the
+// towers weren't told to delete yet, mapped to the height at which each
+// channel was closed.
+func (c *ClientDB) ListClosedChannels() (map[lnwire.ChannelID]uint32, error) {
+	var closedChannels map[lnwire.ChannelID]uint32
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		closedChans := tx.ReadBucket(cClosedChanBkt)
+		if closedChans == nil {
+			return ErrUninitializedDB
+		}
+
+		chanHints := tx.ReadBucket(cChanHintsBkt)
+		if chanHints == nil {
+			return ErrUninitializedDB
+		}
+
+		return chanHints.ForEach(func(k, _ []byte) error {
+			heightBytes := closedChans.Get(k)
+			if heightBytes == nil {
+				return nil
+			}
+
+			var chanID lnwire.ChannelID
+			copy(chanID[:], k)
+			closedChannels[chanID] = byteOrder.Uint32(heightBytes)
+
+			return nil
+		})
+	}, func() {
+		closedChannels = make(map[lnwire.ChannelID]uint32)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closedChannels, nil
+}
+
+// FetchCloseHeights returns all channels that were marked closed, mapped to the
+// height at which each channel was closed.
+func (c *ClientDB) FetchCloseHeights() (map[lnwire.ChannelID]uint32, error) {
+	var closeHeights map[lnwire.ChannelID]uint32
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		closedChans := tx.ReadBucket(cClosedChanBkt)
+		if closedChans == nil {
+			return ErrUninitializedDB
+		}
+
+		return closedChans.ForEach(func(k, v []byte) error {
+			var chanID lnwire.ChannelID
+			copy(chanID[:], k)
+			closeHeights[chanID] = byteOrder.Uint32(v)
+
+			return nil
+		})
+	}, func() {
+		closeHeights = make(map[lnwire.ChannelID]uint32)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closeHeights, nil
+}
+
+// ListUnindexedAcks returns the acked updates whose hints weren't indexed by
+// their channel yet, as they were acked before the index was introduced,
+// grouped by session and keyed by their sequence number.
+func (c *ClientDB) ListUnindexedAcks() (map[SessionID]map[uint16]BackupID,
+	error) {
+
+	var unindexed map[SessionID]map[uint16]BackupID
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		unindexedAcks := tx.ReadBucket(cUnindexedAcksBkt)
+		if unindexedAcks == nil {
+			return ErrUninitializedDB
+		}
+
+		return unindexedAcks.ForEach(func(k, _ []byte) error {
+			sessionBkt := unindexedAcks.NestedReadBucket(k)
+			if sessionBkt == nil {
+				return nil
+			}
+
+			var id SessionID
+			copy(id[:], k)
+
+			acks := make(map[uint16]BackupID)
+			err := sessionBkt.ForEach(func(k, v []byte) error {
+				var backupID BackupID
+				err := backupID.Decode(bytes.NewReader(v))
+				if err != nil {
+					return err
+				}
+
+				acks[byteOrder.Uint16(k)] = backupID
+
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			unindexed[id] = acks
+
+			return nil
+		})
+	}, func() {
+		unindexed = make(map[SessionID]map[uint16]BackupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return unindexed, nil
+}
+
+// IndexAckedUpdate indexes the hint of an unindexed acked update by its
+// channel, such that the tower can be told to delete the update once the
+// channel is closed. If the hint is nil, as it can't be recovered, the update
+// is only removed from the unindexed updates, leaving it to be deleted along
+// with its session.
+func (c *ClientDB) IndexAckedUpdate(id *SessionID, seqNum uint16,
+	hint *blob.BreachHint) error {
+
+	return kvdb.Update(c.db, func(tx kvdb.RwTx) error {
+		sessions := tx.ReadBucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		chanHints := tx.ReadWriteBucket(cChanHintsBkt)
+		if chanHints == nil {
+			return ErrUninitializedDB
+		}
+
+		unindexedAcks := tx.ReadWriteBucket(cUnindexedAcksBkt)
+		if unindexedAcks == nil {
+			return ErrUninitializedDB
+		}
+
+		sessionBkt := unindexedAcks.NestedReadWriteBucket(id[:])
+		if sessionBkt == nil {
+			return nil
+		}
+
+		var seqNumBuf [2]byte
+		byteOrder.PutUint16(seqNumBuf[:], seqNum)
+
+		backupIDBytes := sessionBkt.Get(seqNumBuf[:])
+		if backupIDBytes == nil {
+			return nil
+		}
+
+		var backupID BackupID
+		err := backupID.Decode(bytes.NewReader(backupIDBytes))
+		if err != nil {
+			return err
+		}
+
+		// Sessions that were deleted in the meantime took their
+		// updates with them.
+		if hint != nil && sessions.NestedReadBucket(id[:]) != nil {
+			err := putChanHint(chanHints, backupID.ChanID, id, *hint)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = sessionBkt.Delete(seqNumBuf[:])
+		if err != nil {
+			return err
+		}
+
+		err = isBucketEmpty(sessionBkt)
+		switch {
+		case err == errBucketNotEmpty:
+			return nil
+
+		case err != nil:
+			return err
+
+		default:
+			return unindexedAcks.DeleteNestedBucket(id[:])
+		}
+	}, func() {})
+}
+
+// FetchChannelHints returns the breach hints of the channel's acked updates
+// that the towers weren't told to delete yet, grouped by session.
+func (c *ClientDB) FetchChannelHints(
+	chanID lnwire.ChannelID) (map[SessionID][]blob.BreachHint, error) {
+
+	var sessionHints map[SessionID][]blob.BreachHint
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		chanHints := tx.ReadBucket(cChanHintsBkt)
+		if chanHints == nil {
+			return ErrUninitializedDB
+		}
+
+		chanBkt := chanHints.NestedReadBucket(chanID[:])
+		if chanBkt == nil {
+			return nil
+		}
+
+		return chanBkt.ForEach(func(k, _ []byte) error {
+			sessionBkt := chanBkt.NestedReadBucket(k)
+			if sessionBkt == nil {
+				return nil
+			}
+
+			var id SessionID
+			copy(id[:], k)
+
+			return sessionBkt.ForEach(func(k, _ []byte) error {
+				var hint blob.BreachHint
+				copy(hint[:], k)
+				sessionHints[id] = append(
+					sessionHints[id], hint,
+				)
+
+				return nil
+			})
+		})
+	}, func() {
+		sessionHints = make(map[SessionID][]blob.BreachHint)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionHints, nil
+}
+
+// DeleteChannelHints removes the breach hints of the channel's updates in the
+// session, once the session's tower deleted the updates.
+func (c *ClientDB) DeleteChannelHints(chanID lnwire.ChannelID,
+	id SessionID) error {
+
+	return kvdb.Update(c.db, func(tx kvdb.RwTx) error {
+		chanHints := tx.ReadWriteBucket(cChanHintsBkt)
+		if chanHints == nil {
+			return ErrUninitializedDB
+		}
+
+		return removeChanHints(chanHints, chanID, &id)
+	}, func() {})
+}
+
+// DeleteSession removes a closable session, along with all of its updates,
+// from the database. ErrSessionNotClosable is returned if the session isn't
+// closable.
+func (c *ClientDB) DeleteSession(id SessionID) error {
+	return kvdb.Update(c.db, func(tx kvdb.RwTx) error {
+		sessions := tx.ReadWriteBucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		closableIndex := tx.ReadWriteBucket(cClosableSessionsBkt)
+		if closableIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		chanHints := tx.ReadWriteBucket(cChanHintsBkt)
+		if chanHints == nil {
+			return ErrUninitializedDB
+		}
+
+		unindexedAcks := tx.ReadWriteBucket(cUnindexedAcksBkt)
+		if unindexedAcks == nil {
+			return ErrUninitializedDB
+		}
+
+		if closableIndex.Get(id[:]) == nil {
+			return ErrSessionNotClosable
+		}
+
+		if sessions.NestedReadBucket(id[:]) == nil {
+			return ErrClientSessionNotFound
+		}
+
+		// The tower deletes all updates of the session along with it,
+		// so the hints of the session's channels are no longer needed.
+		ackedUpdates, err := getClientSessionAcks(sessions, id[:])
+		if err != nil {
+			return err
+		}
+
+		for _, backupID := range ackedUpdates {
+			err := removeChanHints(chanHints, backupID.ChanID, &id)
+			if err != nil {
+				return err
+			}
+		}
+
+		if unindexedAcks.NestedReadBucket(id[:]) != nil {
+			err := unindexedAcks.DeleteNestedBucket(id[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		err = sessions.DeleteNestedBucket(id[:])
+		if err != nil {
+			return err
+		}
+
+		return closableIndex.Delete(id[:])
 	}, func() {})
 }
 
@@ -1161,6 +1764,57 @@ func markSessionStatus(sessions kvdb.RwBucket, session *ClientSession,
 	return putClientSessionBody(sessions, session)
 }
 
+// putChanHint indexes the breach hint of an acked update of the session under
+// the update's channel.
+func putChanHint(chanHints kvdb.RwBucket, chanID lnwire.ChannelID,
+	id *SessionID, hint blob.BreachHint) error {
+
+	chanBkt, err := chanHints.CreateBucketIfNotExists(chanID[:])
+	if err != nil {
+		return err
+	}
+
+	sessionBkt, err := chanBkt.CreateBucketIfNotExists(id[:])
+	if err != nil {
+		return err
+	}
+
+	return sessionBkt.Put(hint[:], []byte{})
+}
+
+// removeChanHints removes the breach hints of the channel's updates in the
+// session. If no other session has updates for the channel, the channel's
+// bucket is pruned as well.
+func removeChanHints(chanHints kvdb.RwBucket, chanID lnwire.ChannelID,
+	id *SessionID) error {
+
+	chanBkt := chanHints.NestedReadWriteBucket(chanID[:])
+	if chanBkt == nil {
+		return nil
+	}
+
+	if chanBkt.NestedReadBucket(id[:]) != nil {
+		err := chanBkt.DeleteNestedBucket(id[:])
+		if err != nil {
+			return err
+		}
+	}
+
+	err := isBucketEmpty(chanBkt)
+	switch {
+
+	// Other sessions have updates for the channel, keep the bucket.
+	case err == errBucketNotEmpty:
+		return nil
+
+	case err != nil:
+		return err
+
+	default:
+		return chanHints.DeleteNestedBucket(chanID[:])
+	}
+}
+
 // getChanSummary loads a ClientChanSummary for the passed chanID.
 func getChanSummary(chanSummaries kvdb.RBucket,
 	chanID lnwire.ChannelID) (*ClientChanSummary, error) {
@@ -18,6 +18,7 @@ import (
 	"github.com/ltcsuite/lnd/watchtower/wtmock"
 	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
 	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/stretchr/testify/require"
 )
 
 // clientDBInit is a closure used to initialize a wtclient.DB instance its
@@ -223,6 +224,41 @@ func (h *clientDBHarness) ackUpdate(id *wtdb.SessionID, seqNum uint16,
 	}
 }
 
+func (h *clientDBHarness) markChannelClosed(chanID lnwire.ChannelID,
+	blockHeight uint32, expErr error) []wtdb.SessionID {
+
+	h.t.Helper()
+
+	closableSessions, err := h.db.MarkChannelClosed(chanID, blockHeight)
+	if err != expErr {
+		h.t.Fatalf("expected mark channel closed error: %v, got: %v",
+			expErr, err)
+	}
+
+	return closableSessions
+}
+
+func (h *clientDBHarness) listClosableSessions() map[wtdb.SessionID]uint32 {
+	h.t.Helper()
+
+	closableSessions, err := h.db.ListClosableSessions()
+	if err != nil {
+		h.t.Fatalf("unable to list closable sessions: %v", err)
+	}
+
+	return closableSessions
+}
+
+func (h *clientDBHarness) deleteSession(id wtdb.SessionID, expErr error) {
+	h.t.Helper()
+
+	err := h.db.DeleteSession(id)
+	if err != expErr {
+		h.t.Fatalf("expected delete session error: %v, got: %v",
+			expErr, err)
+	}
+}
+
 // testCreateClientSession asserts various conditions regarding the creation of
 // a new ClientSession. The test asserts:
 //   - client sessions can only be created if a session key index is reserved.
@@ -729,6 +765,269 @@ func testAckUpdate(h *clientDBHarness) {
 	h.ackUpdate(&session.ID, 4, 3, wtdb.ErrUnallocatedLastApplied)
 }
 
+// testMarkChannelClosed asserts that sessions only become closable once they
+// are exhausted, all of their updates are acked, and all channels they have
+// updates for are closed, also if the last update is acked after the close,
+// and that only closable sessions can be deleted.
+func testMarkChannelClosed(h *clientDBHarness) {
+	const blobType = blob.TypeAltruistCommit
+
+	// Create a new session that can hold two updates.
+	session := &wtdb.ClientSession{
+		ClientSessionBody: wtdb.ClientSessionBody{
+			TowerID: wtdb.TowerID(3),
+			Policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType: blobType,
+				},
+				MaxUpdates: 2,
+			},
+			RewardPkScript: []byte{0x01, 0x02, 0x03},
+		},
+		ID: wtdb.SessionID([33]byte{0x04}),
+	}
+	session.KeyIndex = h.nextKeyIndex(session.TowerID, blobType)
+	h.insertSession(session, nil)
+
+	// Create an update for each of two channels.
+	update1 := randCommittedUpdate(h.t, 1)
+	update2 := randCommittedUpdate(h.t, 2)
+	chanID1 := update1.BackupID.ChanID
+	chanID2 := update2.BackupID.ChanID
+
+	// Marking a channel closed before it's registered should fail.
+	h.markChannelClosed(chanID1, 100, wtdb.ErrChannelNotRegistered)
+
+	h.registerChan(chanID1, nil, nil)
+	h.registerChan(chanID2, nil, nil)
+
+	// Commit and ack the first update, and close its channel. The session
+	// isn't exhausted yet, so it isn't closable.
+	h.commitUpdate(&session.ID, update1, nil)
+	h.ackUpdate(&session.ID, 1, 1, nil)
+	closable := h.markChannelClosed(chanID1, 100, nil)
+	if len(closable) != 0 {
+		h.t.Fatalf("expected no closable sessions, got: %v", closable)
+	}
+
+	// Commit the second update, exhausting the session, and close its
+	// channel. The update isn't acked yet, so the session still isn't
+	// closable.
+	h.commitUpdate(&session.ID, update2, nil)
+	closable = h.markChannelClosed(chanID2, 110, nil)
+	if len(closable) != 0 {
+		h.t.Fatalf("expected no closable sessions, got: %v", closable)
+	}
+
+	// The session can't be deleted while it isn't closable.
+	h.deleteSession(session.ID, wtdb.ErrSessionNotClosable)
+
+	// Acking the second update after its channel was closed makes the
+	// session closable, without the channel being marked closed again.
+	h.ackUpdate(&session.ID, 2, 2, nil)
+
+	closeHeights := h.listClosableSessions()
+	expCloseHeights := map[wtdb.SessionID]uint32{session.ID: 110}
+	if !reflect.DeepEqual(closeHeights, expCloseHeights) {
+		h.t.Fatalf("closable sessions mismatch, want: %v, got: %v",
+			expCloseHeights, closeHeights)
+	}
+
+	// Marking the channel closed again returns the closable session. The
+	// original close height is kept.
+	closable = h.markChannelClosed(chanID2, 120, nil)
+	expClosable := []wtdb.SessionID{session.ID}
+	if !reflect.DeepEqual(closable, expClosable) {
+		h.t.Fatalf("closable sessions mismatch, want: %v, got: %v",
+			expClosable, closable)
+	}
+
+	closeHeights = h.listClosableSessions()
+	if !reflect.DeepEqual(closeHeights, expCloseHeights) {
+		h.t.Fatalf("closable sessions mismatch, want: %v, got: %v",
+			expCloseHeights, closeHeights)
+	}
+
+	// Finally, delete the session, which should remove it along with its
+	// closable entry.
+	h.deleteSession(session.ID, nil)
+	if _, ok := h.listSessions(nil)[session.ID]; ok {
+		h.t.Fatalf("session %x should be deleted", session.ID)
+	}
+	if len(h.listClosableSessions()) != 0 {
+		h.t.Fatalf("expected no closable sessions after deletion")
+	}
+
+	h.deleteSession(session.ID, wtdb.ErrSessionNotClosable)
+}
+
+// testChannelHints asserts that the breach hints of acked updates are tracked
+// per channel and session, are listed once their channel is closed, and are
+// removed once deleted from the tower or along with their session.
+func testChannelHints(h *clientDBHarness) {
+	const blobType = blob.TypeAltruistCommit
+
+	newSession := func(id byte, maxUpdates uint16) *wtdb.ClientSession {
+		session := &wtdb.ClientSession{
+			ClientSessionBody: wtdb.ClientSessionBody{
+				TowerID: wtdb.TowerID(id),
+				Policy: wtpolicy.Policy{
+					TxPolicy: wtpolicy.TxPolicy{
+						BlobType: blobType,
+					},
+					MaxUpdates: maxUpdates,
+				},
+				RewardPkScript: []byte{0x01, 0x02, 0x03},
+			},
+			ID: wtdb.SessionID([33]byte{id}),
+		}
+		session.KeyIndex = h.nextKeyIndex(session.TowerID, blobType)
+		h.insertSession(session, nil)
+
+		return session
+	}
+
+	// The first session has updates for two channels, the second session
+	// has an update for the first channel only.
+	session1 := newSession(1, 2)
+	session2 := newSession(2, 5)
+
+	update1 := randCommittedUpdate(h.t, 1)
+	update2 := randCommittedUpdate(h.t, 2)
+	update3 := randCommittedUpdate(h.t, 1)
+	update3.BackupID.ChanID = update1.BackupID.ChanID
+	chanID1 := update1.BackupID.ChanID
+	chanID2 := update2.BackupID.ChanID
+
+	h.registerChan(chanID1, nil, nil)
+	h.registerChan(chanID2, nil, nil)
+
+	h.commitUpdate(&session1.ID, update1, nil)
+	h.commitUpdate(&session1.ID, update2, nil)
+	h.commitUpdate(&session2.ID, update3, nil)
+
+	// Only acked updates are tracked.
+	h.ackUpdate(&session1.ID, 1, 1, nil)
+	h.ackUpdate(&session2.ID, 1, 1, nil)
+
+	// No channel is closed yet.
+	closedChans, err := h.db.ListClosedChannels()
+	require.NoError(h.t, err)
+	require.Empty(h.t, closedChans)
+
+	// Once the first channel is closed, it is listed along with the hints
+	// of its updates in both sessions.
+	h.markChannelClosed(chanID1, 100, nil)
+
+	closedChans, err = h.db.ListClosedChannels()
+	require.NoError(h.t, err)
+	require.Equal(
+		h.t, map[lnwire.ChannelID]uint32{chanID1: 100}, closedChans,
+	)
+
+	hints, err := h.db.FetchChannelHints(chanID1)
+	require.NoError(h.t, err)
+	require.Equal(h.t, map[wtdb.SessionID][]blob.BreachHint{
+		session1.ID: {update1.Hint},
+		session2.ID: {update3.Hint},
+	}, hints)
+
+	// After the second session's tower deleted its update, only the hint
+	// of the first session remains.
+	err = h.db.DeleteChannelHints(chanID1, session2.ID)
+	require.NoError(h.t, err)
+
+	hints, err = h.db.FetchChannelHints(chanID1)
+	require.NoError(h.t, err)
+	require.Equal(h.t, map[wtdb.SessionID][]blob.BreachHint{
+		session1.ID: {update1.Hint},
+	}, hints)
+
+	// Acking the first session's second update and closing its channel
+	// makes the session closable. Deleting it removes the hints of both
+	// of its channels.
+	h.ackUpdate(&session1.ID, 2, 2, nil)
+	h.markChannelClosed(chanID2, 110, nil)
+
+	closedChans, err = h.db.ListClosedChannels()
+	require.NoError(h.t, err)
+	require.Len(h.t, closedChans, 2)
+
+	h.deleteSession(session1.ID, nil)
+
+	closedChans, err = h.db.ListClosedChannels()
+	require.NoError(h.t, err)
+	require.Empty(h.t, closedChans)
+
+	hints, err = h.db.FetchChannelHints(chanID1)
+	require.NoError(h.t, err)
+	require.Empty(h.t, hints)
+}
+
+// testCloseHeights asserts that the close heights of all channels marked closed
+// are returned, and that updates acked by the client are indexed right away.
+func testCloseHeights(h *clientDBHarness) {
+	const blobType = blob.TypeAltruistCommit
+
+	session := &wtdb.ClientSession{
+		ClientSessionBody: wtdb.ClientSessionBody{
+			TowerID: wtdb.TowerID(3),
+			Policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType: blobType,
+				},
+				MaxUpdates: 2,
+			},
+			RewardPkScript: []byte{0x01, 0x02, 0x03},
+		},
+		ID: wtdb.SessionID([33]byte{0x03}),
+	}
+	session.KeyIndex = h.nextKeyIndex(session.TowerID, blobType)
+	h.insertSession(session, nil)
+
+	update1 := randCommittedUpdate(h.t, 1)
+	update2 := randCommittedUpdate(h.t, 2)
+	chanID1 := update1.BackupID.ChanID
+	chanID2 := update2.BackupID.ChanID
+
+	h.registerChan(chanID1, nil, nil)
+	h.registerChan(chanID2, nil, nil)
+
+	h.commitUpdate(&session.ID, update1, nil)
+	h.ackUpdate(&session.ID, 1, 1, nil)
+
+	// Updates acked by the client don't need to be indexed later on.
+	unindexed, err := h.db.ListUnindexedAcks()
+	require.NoError(h.t, err)
+	require.Empty(h.t, unindexed)
+
+	// Indexing an update that isn't unindexed has no effect.
+	err = h.db.IndexAckedUpdate(&session.ID, 1, nil)
+	require.NoError(h.t, err)
+
+	closeHeights, err := h.db.FetchCloseHeights()
+	require.NoError(h.t, err)
+	require.Empty(h.t, closeHeights)
+
+	// Both closed channels are returned, including the one without acked
+	// updates, which isn't listed as closed channel with hints.
+	h.markChannelClosed(chanID1, 100, nil)
+	h.markChannelClosed(chanID2, 110, nil)
+
+	closeHeights, err = h.db.FetchCloseHeights()
+	require.NoError(h.t, err)
+	require.Equal(h.t, map[lnwire.ChannelID]uint32{
+		chanID1: 100,
+		chanID2: 110,
+	}, closeHeights)
+
+	closedChans, err := h.db.ListClosedChannels()
+	require.NoError(h.t, err)
+	require.Equal(
+		h.t, map[lnwire.ChannelID]uint32{chanID1: 100}, closedChans,
+	)
+}
+
 // checkCommittedUpdates asserts that the CommittedUpdates on session match the
 // expUpdates provided.
 func checkCommittedUpdates(t *testing.T, session *wtdb.ClientSession,
@@ -893,6 +1192,18 @@ func TestClientDB(t *testing.T) {
 			name: "ack update",
 			run:  testAckUpdate,
 		},
+		{
+			name: "mark channel closed",
+			run:  testMarkChannelClosed,
+		},
+		{
+			name: "channel hints",
+			run:  testChannelHints,
+		},
+		{
+			name: "close heights",
+			run:  testCloseHeights,
+		},
 	}
 
 	for _, database := range dbs {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/ltcsuite/lnd/tor"
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 )
 
@@ -178,6 +179,38 @@ func TestCodec(tt *testing.T) {
 	}
 
 	customTypeGen := map[string]func([]reflect.Value, *rand.Rand){
+		"SessionInfo": func(v []reflect.Value, r *rand.Rand) {
+			value, ok := quick.Value(
+				reflect.TypeOf(wtpolicy.Policy{}), r,
+			)
+			if !ok {
+				t.Fatalf("unable to generate policy")
+				return
+			}
+			policy := value.Interface().(wtpolicy.Policy)
+
+			pk, err := randPubKey()
+			if err != nil {
+				t.Fatalf("unable to generate pubkey: %v", err)
+				return
+			}
+
+			obj := wtdb.SessionInfo{
+				Policy:            policy,
+				LastApplied:       uint16(r.Intn(1 << 16)),
+				ClientLastApplied: uint16(r.Intn(1 << 16)),
+				RewardAddress:     make([]byte, 1+r.Intn(34)),
+			}
+			r.Read(obj.ID[:])
+			r.Read(obj.RewardAddress)
+
+			// Sessions of anonymous clients have no client key.
+			if r.Intn(2) == 0 {
+				obj.ClientKey = pk
+			}
+
+			v[0] = reflect.ValueOf(obj)
+		},
 		"Tower": func(v []reflect.Value, r *rand.Rand) {
 			pk, err := randPubKey()
 			if err != nil {
@@ -0,0 +1,62 @@
+package wtdb
+
+import (
+	"io"
+	"time"
+
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/ltcsuite/ltcd/ltcutil"
+)
+
+// RewardRecord is an entry of the tower's reward ledger, recording the reward
+// the tower claimed with a justice transaction it published on behalf of one
+// of its reward sessions.
+type RewardRecord struct {
+	// SessionID is the id of the session the justice transaction was
+	// created for.
+	SessionID SessionID
+
+	// BreachTxID is the txid of the breaching commitment transaction.
+	BreachTxID chainhash.Hash
+
+	// JusticeTxID is the txid of the justice transaction paying the
+	// reward.
+	JusticeTxID chainhash.Hash
+
+	// Amount is the value of the reward output of the justice
+	// transaction.
+	Amount ltcutil.Amount
+
+	// Timestamp is the time the justice transaction was published.
+	Timestamp time.Time
+}
+
+// Encode serializes the reward record to the given io.Writer.
+func (r *RewardRecord) Encode(w io.Writer) error {
+	return WriteElements(w,
+		r.SessionID,
+		r.BreachTxID,
+		r.JusticeTxID,
+		r.Amount,
+		uint64(r.Timestamp.Unix()),
+	)
+}
+
+// Decode deserializes the reward record from the given io.Reader.
+func (r *RewardRecord) Decode(rd io.Reader) error {
+	var timestamp uint64
+	err := ReadElements(rd,
+		&r.SessionID,
+		&r.BreachTxID,
+		&r.JusticeTxID,
+		&r.Amount,
+		&timestamp,
+	)
+	if err != nil {
+		return err
+	}
+
+	r.Timestamp = time.Unix(int64(timestamp), 0)
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ltcsuite/lnd/watchtower/blob"
 	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 )
 
 var (
@@ -58,29 +59,66 @@ type SessionInfo struct {
 	// to if a sweep transaction confirms.
 	RewardAddress []byte
 
+	// ClientKey is the long-term key the client identified itself with
+	// when negotiating the session. It is nil for anonymous clients.
+	ClientKey *btcec.PublicKey
+
+	// StoredUpdates is the number of the session's state updates the
+	// tower currently stores, which excludes updates deleted after their
+	// channel was closed. It isn't serialized, but derived from the stored
+	// updates when the session is retrieved.
+	StoredUpdates uint16
+
 	// TODO(conner): store client metrics, DOS score, etc
 }
 
+// StorageBytes returns the number of bytes used by the encrypted blobs of the
+// state updates the tower currently stores for the session.
+func (s *SessionInfo) StorageBytes() uint64 {
+	return uint64(s.StoredUpdates) * uint64(blob.Size(s.Policy.BlobType))
+}
+
 // Encode serializes the session info to the given io.Writer.
 func (s *SessionInfo) Encode(w io.Writer) error {
-	return WriteElements(w,
+	err := WriteElements(w,
 		s.ID,
 		s.Policy,
 		s.LastApplied,
 		s.ClientLastApplied,
 		s.RewardAddress,
 	)
+	if err != nil {
+		return err
+	}
+
+	// The client key is omitted for anonymous clients, which keeps their
+	// encoding identical to sessions stored before client keys existed.
+	if s.ClientKey == nil {
+		return nil
+	}
+
+	return WriteElement(w, s.ClientKey)
 }
 
 // Decode deserializes the session infor from the given io.Reader.
 func (s *SessionInfo) Decode(r io.Reader) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		&s.ID,
 		&s.Policy,
 		&s.LastApplied,
 		&s.ClientLastApplied,
 		&s.RewardAddress,
 	)
+	if err != nil {
+		return err
+	}
+
+	err = ReadElement(r, &s.ClientKey)
+	if err == io.EOF {
+		return nil
+	}
+
+	return err
 }
 
 // AcceptUpdateSequence validates that a state update's sequence number and last
@@ -7,6 +7,7 @@ import (
 	"github.com/ltcsuite/lnd/chainntnfs"
 	"github.com/ltcsuite/lnd/kvdb"
 	"github.com/ltcsuite/lnd/watchtower/blob"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 )
 
@@ -38,6 +39,30 @@ var (
 	// epoch from the lookoutTipBkt.
 	lookoutTipKey = []byte("lookout-tip")
 
+	// rewardLedgerBkt is a bucket containing the rewards claimed by the
+	// tower's justice transactions, keyed by the justice txid.
+	//   justice txid -> reward record
+	rewardLedgerBkt = []byte("reward-ledger-bucket")
+
+	// clientIndexBkt is a bucket that indexes the sessions of identified
+	// clients by their client key, allowing the tower to efficiently
+	// account for the resources used by each client.
+	//  client key => session id1 -> []byte{}
+	//             => session id2 -> []byte{}
+	clientIndexBkt = []byte("client-index-bucket")
+
+	// sessionUsageBkt is a bucket containing the number of state updates
+	// the tower stores for each session, which excludes updates deleted
+	// after their channel was closed.
+	//   session id -> number of stored updates
+	sessionUsageBkt = []byte("session-usage-bucket")
+
+	// clientUsageBkt is a bucket containing the number of bytes used by the
+	// encrypted blobs of the state updates the tower stores for each
+	// identified client, summed over all of its sessions.
+	//   client key -> storage bytes
+	clientUsageBkt = []byte("client-usage-bucket")
+
 	// ErrNoSessionHintIndex signals that an active session does not have an
 	// initialized index for tracking its own state updates.
 	ErrNoSessionHintIndex = errors.New("session hint index missing")
@@ -98,6 +123,10 @@ func initTowerDBBuckets(tx kvdb.RwTx) error {
 		updateIndexBkt,
 		updatesBkt,
 		lookoutTipBkt,
+		rewardLedgerBkt,
+		clientIndexBkt,
+		sessionUsageBkt,
+		clientUsageBkt,
 	}
 
 	for _, bucket := range buckets {
@@ -110,6 +139,51 @@ func initTowerDBBuckets(tx kvdb.RwTx) error {
 	return nil
 }
 
+// migrateStorageUsage initializes the number of state updates stored for each
+// session, and the storage used by each identified client, from the updates
+// the tower stores.
+func migrateStorageUsage(tx kvdb.RwTx) error {
+	sessions := tx.ReadBucket(sessionsBkt)
+	if sessions == nil {
+		return nil
+	}
+
+	updateIndex := tx.ReadBucket(updateIndexBkt)
+	if updateIndex == nil {
+		return nil
+	}
+
+	sessionUsage, err := tx.CreateTopLevelBucket(sessionUsageBkt)
+	if err != nil {
+		return err
+	}
+
+	clientUsage, err := tx.CreateTopLevelBucket(clientUsageBkt)
+	if err != nil {
+		return err
+	}
+
+	return sessions.ForEach(func(k, _ []byte) error {
+		session, err := getSession(sessions, k)
+		if err != nil {
+			return err
+		}
+
+		hints, err := getHintsForSession(updateIndex, &session.ID)
+		switch {
+		case err == ErrNoSessionHintIndex:
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		return updateUsage(
+			sessionUsage, clientUsage, session, len(hints),
+		)
+	})
+}
+
 // bdb returns the backing bbolt.DB instance.
 //
 // NOTE: Part of the versionedDB interface.
@@ -151,8 +225,13 @@ func (t *TowerDB) GetSessionInfo(id *SessionID) (*SessionInfo, error) {
 			return ErrUninitializedDB
 		}
 
+		sessionUsage := tx.ReadBucket(sessionUsageBkt)
+		if sessionUsage == nil {
+			return ErrUninitializedDB
+		}
+
 		var err error
-		session, err = getSession(sessions, id[:])
+		session, err = getStoredSession(sessions, sessionUsage, id[:])
 		return err
 	}, func() {
 		session = nil
@@ -178,6 +257,11 @@ func (t *TowerDB) InsertSessionInfo(session *SessionInfo) error {
 			return ErrUninitializedDB
 		}
 
+		clientIndex := tx.ReadWriteBucket(clientIndexBkt)
+		if clientIndex == nil {
+			return ErrUninitializedDB
+		}
+
 		dbSession, err := getSession(sessions, session.ID[:])
 		switch {
 		case err == ErrSessionNotFound:
@@ -196,11 +280,25 @@ func (t *TowerDB) InsertSessionInfo(session *SessionInfo) error {
 			return err
 		}
 
+		// If an unused session is recommitted, it may have been
+		// negotiated by a different client key before.
+		if dbSession != nil {
+			err := removeClientSession(clientIndex, dbSession)
+			if err != nil {
+				return err
+			}
+		}
+
 		err = putSession(sessions, session)
 		if err != nil {
 			return err
 		}
 
+		err = putClientSession(clientIndex, session)
+		if err != nil {
+			return err
+		}
+
 		// Initialize the session-hint index which will be used to track
 		// all updates added for this session. Upon deletion, we will
 		// consult the index to determine exactly which updates should
@@ -232,6 +330,16 @@ func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error)
 			return ErrUninitializedDB
 		}
 
+		sessionUsage := tx.ReadWriteBucket(sessionUsageBkt)
+		if sessionUsage == nil {
+			return ErrUninitializedDB
+		}
+
+		clientUsage := tx.ReadWriteBucket(clientUsageBkt)
+		if clientUsage == nil {
+			return ErrUninitializedDB
+		}
+
 		// Fetch the session corresponding to the update's session id.
 		// This will be used to validate that the update's sequence
 		// number and last applied values are sane.
@@ -285,6 +393,20 @@ func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error)
 			return err
 		}
 
+		// Account for the storage used by the update, unless it
+		// replaced an update the session stored under the same hint.
+		sessionHints := updateIndex.NestedReadBucket(update.ID[:])
+		if sessionHints == nil {
+			return ErrNoSessionHintIndex
+		}
+
+		if sessionHints.Get(update.Hint[:]) == nil {
+			err := updateUsage(sessionUsage, clientUsage, session, 1)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Finally, create an entry in the update index to track this
 		// hint under its session id. This will allow us to delete the
 		// entries efficiently if the session is ever removed.
@@ -318,18 +440,38 @@ func (t *TowerDB) DeleteSession(target SessionID) error {
 			return ErrUninitializedDB
 		}
 
+		clientIndex := tx.ReadWriteBucket(clientIndexBkt)
+		if clientIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		sessionUsage := tx.ReadWriteBucket(sessionUsageBkt)
+		if sessionUsage == nil {
+			return ErrUninitializedDB
+		}
+
+		clientUsage := tx.ReadWriteBucket(clientUsageBkt)
+		if clientUsage == nil {
+			return ErrUninitializedDB
+		}
+
 		// Fail if the session doesn't exit.
-		_, err := getSession(sessions, target[:])
+		session, err := getSession(sessions, target[:])
 		if err != nil {
 			return err
 		}
 
-		// Remove the target session.
+		// Remove the target session, and its entry in the client index.
 		err = sessions.Delete(target[:])
 		if err != nil {
 			return err
 		}
 
+		err = removeClientSession(clientIndex, session)
+		if err != nil {
+			return err
+		}
+
 		// Next, check the update index for any hints that were added
 		// under this session.
 		hints, err := getHintsForSession(updateIndex, &target)
@@ -337,50 +479,212 @@ func (t *TowerDB) DeleteSession(target SessionID) error {
 			return err
 		}
 
+		// Remove the state updates for any blobs stored under the
+		// target session identifier.
 		for _, hint := range hints {
-			// Remove the state updates for any blobs stored under
-			// the target session identifier.
-			updatesForHint := updates.NestedReadWriteBucket(hint[:])
-			if updatesForHint == nil {
-				continue
+			err := removeSessionUpdate(updates, hint, &target)
+			if err != nil {
+				return err
 			}
+		}
+
+		// Release the storage accounted to the session's client.
+		storedUpdates := getStoredUpdates(sessionUsage, target[:])
+		err = updateUsage(
+			sessionUsage, clientUsage, session, -int(storedUpdates),
+		)
+		if err != nil {
+			return err
+		}
+
+		// Finally, remove this session from the update index, which
+		// also removes any of the indexed hints beneath it.
+		return removeSessionHintBkt(updateIndex, &target)
+	}, func() {})
+}
+
+// DeleteChannelUpdates removes the session's state updates with the given
+// breach hints, after the client signaled that the channel they belong to was
+// closed. Only hints found in the session's update index are removed, such
+// that a session can't delete the updates of other sessions. An error is
+// returned if the session could not be found.
+func (t *TowerDB) DeleteChannelUpdates(id SessionID,
+	hints []blob.BreachHint) error {
+
+	return kvdb.Update(t.db, func(tx kvdb.RwTx) error {
+		sessions := tx.ReadBucket(sessionsBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		updates := tx.ReadWriteBucket(updatesBkt)
+		if updates == nil {
+			return ErrUninitializedDB
+		}
+
+		updateIndex := tx.ReadWriteBucket(updateIndexBkt)
+		if updateIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		sessionUsage := tx.ReadWriteBucket(sessionUsageBkt)
+		if sessionUsage == nil {
+			return ErrUninitializedDB
+		}
+
+		clientUsage := tx.ReadWriteBucket(clientUsageBkt)
+		if clientUsage == nil {
+			return ErrUninitializedDB
+		}
+
+		// Fail if the session doesn't exist.
+		session, err := getSession(sessions, id[:])
+		if err != nil {
+			return err
+		}
+
+		sessionHints := updateIndex.NestedReadWriteBucket(id[:])
+		if sessionHints == nil {
+			return ErrNoSessionHintIndex
+		}
 
-			update := updatesForHint.Get(target[:])
-			if update == nil {
+		var numDeleted int
+		for _, hint := range hints {
+			// Skip any hints the session has no updates for.
+			if sessionHints.Get(hint[:]) == nil {
 				continue
 			}
 
-			err := updatesForHint.Delete(target[:])
+			err := removeSessionUpdate(updates, hint, &id)
 			if err != nil {
 				return err
 			}
 
-			// If this was the last state update, we can also remove
-			// the hint that would map to an empty set.
-			err = isBucketEmpty(updatesForHint)
-			switch {
+			err = sessionHints.Delete(hint[:])
+			if err != nil {
+				return err
+			}
 
-			// Other updates exist for this hint, keep the bucket.
-			case err == errBucketNotEmpty:
-				continue
+			numDeleted++
+		}
+
+		return updateUsage(
+			sessionUsage, clientUsage, session, -numDeleted,
+		)
+	}, func() {})
+}
 
-			// Unexpected error.
-			case err != nil:
+// ListSessions returns all sessions negotiated with the tower.
+func (t *TowerDB) ListSessions() ([]*SessionInfo, error) {
+	var infos []*SessionInfo
+	err := kvdb.View(t.db, func(tx kvdb.RTx) error {
+		sessions := tx.ReadBucket(sessionsBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		sessionUsage := tx.ReadBucket(sessionUsageBkt)
+		if sessionUsage == nil {
+			return ErrUninitializedDB
+		}
+
+		return sessions.ForEach(func(k, _ []byte) error {
+			session, err := getStoredSession(
+				sessions, sessionUsage, k,
+			)
+			if err != nil {
 				return err
+			}
 
-			// No more updates for this hint, prune hint bucket.
-			default:
-				err = updates.DeleteNestedBucket(hint[:])
-				if err != nil {
-					return err
-				}
+			infos = append(infos, session)
+
+			return nil
+		})
+	}, func() {
+		infos = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return infos, nil
+}
+
+// ListClientSessions returns all sessions negotiated with the tower by the
+// client identified by the given client key.
+func (t *TowerDB) ListClientSessions(
+	clientKey *btcec.PublicKey) ([]*SessionInfo, error) {
+
+	var infos []*SessionInfo
+	err := kvdb.View(t.db, func(tx kvdb.RTx) error {
+		sessions := tx.ReadBucket(sessionsBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		sessionUsage := tx.ReadBucket(sessionUsageBkt)
+		if sessionUsage == nil {
+			return ErrUninitializedDB
+		}
+
+		clientIndex := tx.ReadBucket(clientIndexBkt)
+		if clientIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		clientSessions := clientIndex.NestedReadBucket(
+			clientKey.SerializeCompressed(),
+		)
+		if clientSessions == nil {
+			return nil
+		}
+
+		return clientSessions.ForEach(func(k, _ []byte) error {
+			session, err := getStoredSession(
+				sessions, sessionUsage, k,
+			)
+			if err != nil {
+				return err
 			}
+
+			infos = append(infos, session)
+
+			return nil
+		})
+	}, func() {
+		infos = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return infos, nil
+}
+
+// GetClientStorage returns the number of bytes used by the encrypted blobs of
+// the state updates the tower stores for the client identified by the given
+// client key, summed over all of its sessions.
+func (t *TowerDB) GetClientStorage(clientKey *btcec.PublicKey) (uint64, error) {
+	var storage uint64
+	err := kvdb.View(t.db, func(tx kvdb.RTx) error {
+		clientUsage := tx.ReadBucket(clientUsageBkt)
+		if clientUsage == nil {
+			return ErrUninitializedDB
 		}
 
-		// Finally, remove this session from the update index, which
-		// also removes any of the indexed hints beneath it.
-		return removeSessionHintBkt(updateIndex, &target)
-	}, func() {})
+		storage = getClientStorage(
+			clientUsage, clientKey.SerializeCompressed(),
+		)
+
+		return nil
+	}, func() {
+		storage = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return storage, nil
 }
 
 // QueryMatches searches against all known state updates for any that match the
@@ -399,6 +703,11 @@ func (t *TowerDB) QueryMatches(breachHints []blob.BreachHint) ([]Match, error) {
 			return ErrUninitializedDB
 		}
 
+		sessionUsage := tx.ReadBucket(sessionUsageBkt)
+		if sessionUsage == nil {
+			return ErrUninitializedDB
+		}
+
 		// Iterate through the target breach hints, appending any
 		// matching updates to the set of matches.
 		for _, hint := range breachHints {
@@ -416,7 +725,9 @@ func (t *TowerDB) QueryMatches(breachHints []blob.BreachHint) ([]Match, error) {
 				// update. The session info contains further
 				// instructions for how to process the state
 				// update.
-				session, err := getSession(sessions, k)
+				session, err := getStoredSession(
+					sessions, sessionUsage, k,
+				)
 				switch {
 				case err == ErrSessionNotFound:
 					log.Warnf("Missing session=%x for "+
@@ -505,6 +816,55 @@ func (t *TowerDB) GetLookoutTip() (*chainntnfs.BlockEpoch, error) {
 	return epoch, nil
 }
 
+// RecordReward adds the reward claimed by a justice transaction to the tower's
+// reward ledger. Recording the same justice transaction again replaces the
+// existing record.
+func (t *TowerDB) RecordReward(record *RewardRecord) error {
+	return kvdb.Update(t.db, func(tx kvdb.RwTx) error {
+		ledger := tx.ReadWriteBucket(rewardLedgerBkt)
+		if ledger == nil {
+			return ErrUninitializedDB
+		}
+
+		var b bytes.Buffer
+		if err := record.Encode(&b); err != nil {
+			return err
+		}
+
+		return ledger.Put(record.JusticeTxID[:], b.Bytes())
+	}, func() {})
+}
+
+// ListRewards returns all records of the tower's reward ledger.
+func (t *TowerDB) ListRewards() ([]*RewardRecord, error) {
+	var records []*RewardRecord
+	err := kvdb.View(t.db, func(tx kvdb.RTx) error {
+		ledger := tx.ReadBucket(rewardLedgerBkt)
+		if ledger == nil {
+			return ErrUninitializedDB
+		}
+
+		return ledger.ForEach(func(_, v []byte) error {
+			record := &RewardRecord{}
+			err := record.Decode(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			records = append(records, record)
+
+			return nil
+		})
+	}, func() {
+		records = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
 // getSession retrieves the session info from the sessions bucket identified by
 // its session id. An error is returned if the session is not found or a
 // deserialization error occurs.
@@ -523,6 +883,21 @@ func getSession(sessions kvdb.RBucket, id []byte) (*SessionInfo, error) {
 	return &session, nil
 }
 
+// getStoredSession retrieves the session like getSession, and populates its
+// number of stored updates from the session usage bucket.
+func getStoredSession(sessions, sessionUsage kvdb.RBucket,
+	id []byte) (*SessionInfo, error) {
+
+	session, err := getSession(sessions, id)
+	if err != nil {
+		return nil, err
+	}
+
+	session.StoredUpdates = getStoredUpdates(sessionUsage, id)
+
+	return session, nil
+}
+
 // putSession stores the session info in the sessions bucket identified by its
 // session id. An error is returned if a serialization error occurs.
 func putSession(sessions kvdb.RwBucket, session *SessionInfo) error {
@@ -551,6 +926,46 @@ func removeSessionHintBkt(updateIndex kvdb.RwBucket, id *SessionID) error {
 	return updateIndex.DeleteNestedBucket(id[:])
 }
 
+// removeSessionUpdate removes the state update of the given session stored
+// under the hint. If this was the last update for the hint, the hint's bucket
+// is pruned as well.
+func removeSessionUpdate(updates kvdb.RwBucket, hint blob.BreachHint,
+	id *SessionID) error {
+
+	updatesForHint := updates.NestedReadWriteBucket(hint[:])
+	if updatesForHint == nil {
+		return nil
+	}
+
+	update := updatesForHint.Get(id[:])
+	if update == nil {
+		return nil
+	}
+
+	err := updatesForHint.Delete(id[:])
+	if err != nil {
+		return err
+	}
+
+	// If this was the last state update, we can also remove the hint that
+	// would map to an empty set.
+	err = isBucketEmpty(updatesForHint)
+	switch {
+
+	// Other updates exist for this hint, keep the bucket.
+	case err == errBucketNotEmpty:
+		return nil
+
+	// Unexpected error.
+	case err != nil:
+		return err
+
+	// No more updates for this hint, prune hint bucket.
+	default:
+		return updates.DeleteNestedBucket(hint[:])
+	}
+}
+
 // getHintsForSession returns all known hints belonging to the given session id.
 // If the index for the session has not been initialized, this method returns
 // ErrNoSessionHintIndex.
@@ -596,6 +1011,122 @@ func putHintForSession(updateIndex kvdb.RwBucket, id *SessionID,
 	return sessionHints.Put(hint[:], []byte{})
 }
 
+// putClientSession adds the session to the client index, if it was negotiated
+// by an identified client.
+func putClientSession(clientIndex kvdb.RwBucket, session *SessionInfo) error {
+	if session.ClientKey == nil {
+		return nil
+	}
+
+	clientSessions, err := clientIndex.CreateBucketIfNotExists(
+		session.ClientKey.SerializeCompressed(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return clientSessions.Put(session.ID[:], []byte{})
+}
+
+// removeClientSession removes the session from the client index, pruning the
+// client's bucket once it has no sessions left.
+func removeClientSession(clientIndex kvdb.RwBucket,
+	session *SessionInfo) error {
+
+	if session.ClientKey == nil {
+		return nil
+	}
+
+	clientKey := session.ClientKey.SerializeCompressed()
+	clientSessions := clientIndex.NestedReadWriteBucket(clientKey)
+	if clientSessions == nil {
+		return nil
+	}
+
+	err := clientSessions.Delete(session.ID[:])
+	if err != nil {
+		return err
+	}
+
+	err = isBucketEmpty(clientSessions)
+	switch {
+	case err == errBucketNotEmpty:
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	return clientIndex.DeleteNestedBucket(clientKey)
+}
+
+// getStoredUpdates returns the number of state updates the tower stores for the
+// session with the given id.
+func getStoredUpdates(sessionUsage kvdb.RBucket, id []byte) uint16 {
+	storedUpdates := sessionUsage.Get(id)
+	if len(storedUpdates) != 2 {
+		return 0
+	}
+
+	return byteOrder.Uint16(storedUpdates)
+}
+
+// getClientStorage returns the number of bytes used by the state updates the
+// tower stores for the client with the given serialized client key.
+func getClientStorage(clientUsage kvdb.RBucket, clientKey []byte) uint64 {
+	storage := clientUsage.Get(clientKey)
+	if len(storage) != 8 {
+		return 0
+	}
+
+	return byteOrder.Uint64(storage)
+}
+
+// updateUsage adjusts the number of state updates stored for the session, and
+// the storage used by its client if it is identified, by the given number of
+// added or removed updates. Entries that drop to zero are removed.
+func updateUsage(sessionUsage, clientUsage kvdb.RwBucket,
+	session *SessionInfo, delta int) error {
+
+	if delta == 0 {
+		return nil
+	}
+
+	storedUpdates := int(getStoredUpdates(sessionUsage, session.ID[:]))
+	storedUpdates += delta
+
+	var err error
+	if storedUpdates > 0 {
+		var b [2]byte
+		byteOrder.PutUint16(b[:], uint16(storedUpdates))
+		err = sessionUsage.Put(session.ID[:], b[:])
+	} else {
+		err = sessionUsage.Delete(session.ID[:])
+	}
+	if err != nil {
+		return err
+	}
+
+	if session.ClientKey == nil {
+		return nil
+	}
+
+	clientKey := session.ClientKey.SerializeCompressed()
+	blobSize := int64(blob.Size(session.Policy.BlobType))
+
+	storage := int64(getClientStorage(clientUsage, clientKey))
+	storage += int64(delta) * blobSize
+
+	if storage <= 0 {
+		return clientUsage.Delete(clientKey)
+	}
+
+	var b [8]byte
+	byteOrder.PutUint64(b[:], uint64(storage))
+
+	return clientUsage.Put(clientKey, b[:])
+}
+
 // putLookoutEpoch stores the given lookout tip block epoch in provided bucket.
 func putLookoutEpoch(bkt kvdb.RwBucket, epoch *chainntnfs.BlockEpoch) error {
 	epochBytes := make([]byte, 36)
@@ -6,7 +6,9 @@ import (
 	"io/ioutil"
 	"os"
 	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/ltcsuite/lnd/chainntnfs"
 	"github.com/ltcsuite/lnd/kvdb"
@@ -15,7 +17,9 @@ import (
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
 	"github.com/ltcsuite/lnd/watchtower/wtmock"
 	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -102,6 +106,41 @@ func (h *towerDBHarness) deleteSession(id wtdb.SessionID, expErr error) {
 	}
 }
 
+// assertClientSessions asserts that the sessions of the given client are
+// exactly the sessions with the expected ids.
+func (h *towerDBHarness) assertClientSessions(clientKey *btcec.PublicKey,
+	expIDs ...*wtdb.SessionID) {
+
+	h.t.Helper()
+
+	sessions, err := h.db.ListClientSessions(clientKey)
+	require.NoError(h.t, err)
+
+	ids := make([]wtdb.SessionID, 0, len(sessions))
+	for _, session := range sessions {
+		ids = append(ids, session.ID)
+	}
+
+	exp := make([]wtdb.SessionID, 0, len(expIDs))
+	for _, id := range expIDs {
+		exp = append(exp, *id)
+	}
+
+	require.ElementsMatch(h.t, exp, ids)
+}
+
+// assertClientStorage asserts that the client's updates use the expected number
+// of bytes.
+func (h *towerDBHarness) assertClientStorage(clientKey *btcec.PublicKey,
+	expStorage uint64) {
+
+	h.t.Helper()
+
+	storage, err := h.db.GetClientStorage(clientKey)
+	require.NoError(h.t, err)
+	require.Equal(h.t, expStorage, storage)
+}
+
 // queryMatches queries that database for the passed breach hint, returning all
 // matches found.
 func (h *towerDBHarness) queryMatches(hint blob.BreachHint) []wtdb.Match {
@@ -280,6 +319,148 @@ func testLookoutTip(h *towerDBHarness) {
 	}
 }
 
+// testRewardLedger asserts that the database properly stores and returns the
+// records of the reward ledger, and that recording the same justice
+// transaction twice replaces the existing record.
+func testRewardLedger(h *towerDBHarness) {
+	// The ledger of a fresh db is empty.
+	records, err := h.db.ListRewards()
+	if err != nil {
+		h.t.Fatalf("unable to list rewards: %v", err)
+	}
+	if len(records) != 0 {
+		h.t.Fatalf("expected no rewards, found %d", len(records))
+	}
+
+	record0 := &wtdb.RewardRecord{
+		SessionID:   *id(0),
+		BreachTxID:  chainhash.Hash{0x01},
+		JusticeTxID: chainhash.Hash{0x02},
+		Amount:      1000,
+		Timestamp:   time.Unix(1_600_000_000, 0),
+	}
+	record1 := &wtdb.RewardRecord{
+		SessionID:   *id(1),
+		BreachTxID:  chainhash.Hash{0x03},
+		JusticeTxID: chainhash.Hash{0x04},
+		Amount:      2000,
+		Timestamp:   time.Unix(1_600_000_100, 0),
+	}
+
+	for _, record := range []*wtdb.RewardRecord{record0, record1} {
+		if err := h.db.RecordReward(record); err != nil {
+			h.t.Fatalf("unable to record reward: %v", err)
+		}
+	}
+
+	// Record the first justice transaction again with a different amount,
+	// which should replace the existing record.
+	record0.Amount = 1500
+	if err := h.db.RecordReward(record0); err != nil {
+		h.t.Fatalf("unable to record reward: %v", err)
+	}
+
+	records, err = h.db.ListRewards()
+	if err != nil {
+		h.t.Fatalf("unable to list rewards: %v", err)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return bytes.Compare(
+			records[i].JusticeTxID[:], records[j].JusticeTxID[:],
+		) < 0
+	})
+
+	expRecords := []*wtdb.RewardRecord{record0, record1}
+	if !reflect.DeepEqual(records, expRecords) {
+		h.t.Fatalf("reward mismatch, want: %v, got: %v",
+			expRecords, records)
+	}
+}
+
+// testClientSessions asserts that the database indexes the sessions of
+// identified clients by their client key, and keeps the index consistent when
+// sessions are recommitted or deleted.
+func testClientSessions(h *towerDBHarness) {
+	client0 := pubKey(h.t)
+	client1 := pubKey(h.t)
+
+	policy := wtpolicy.Policy{
+		TxPolicy: wtpolicy.TxPolicy{
+			BlobType:     blob.TypeAltruistCommit,
+			SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+		},
+		MaxUpdates: 100,
+	}
+	newSession := func(i int,
+		clientKey *btcec.PublicKey) *wtdb.SessionInfo {
+
+		return &wtdb.SessionInfo{
+			ID:            *id(i),
+			Policy:        policy,
+			RewardAddress: []byte{},
+			ClientKey:     clientKey,
+		}
+	}
+
+	// Create two sessions for the first client, and one session each for
+	// the second client and an anonymous client.
+	h.insertSession(newSession(0, client0), nil)
+	h.insertSession(newSession(1, client0), nil)
+	h.insertSession(newSession(2, client1), nil)
+	h.insertSession(newSession(3, nil), nil)
+
+	sessions, err := h.db.ListSessions()
+	require.NoError(h.t, err)
+	require.Len(h.t, sessions, 4)
+
+	h.assertClientSessions(client0, id(0), id(1))
+	h.assertClientSessions(client1, id(2))
+
+	// The client key is persisted along with the session, and the storage
+	// used by the session grows with each update.
+	session := h.getSession(id(0), nil)
+	require.True(h.t, session.ClientKey.IsEqual(client0))
+	require.Zero(h.t, session.StorageBytes())
+
+	h.insertUpdate(updateFromInt(id(0), 1, 0), nil)
+	session = h.getSession(id(0), nil)
+	blobSize := uint64(blob.Size(blob.TypeAltruistCommit))
+	require.Equal(h.t, blobSize, session.StorageBytes())
+
+	// The storage of each client is summed over its sessions.
+	update := updateFromInt(id(2), 1, 0)
+	h.insertUpdate(update, nil)
+	h.insertUpdate(updateFromInt(id(3), 1, 0), nil)
+	h.assertClientStorage(client0, blobSize)
+	h.assertClientStorage(client1, blobSize)
+
+	// Deleting the update of the second client releases its storage.
+	err = h.db.DeleteChannelUpdates(
+		*id(2), []blob.BreachHint{update.Hint},
+	)
+	require.NoError(h.t, err)
+	h.assertClientStorage(client1, 0)
+	h.assertClientStorage(client0, blobSize)
+
+	// Recommitting the unused session of the first client with the key of
+	// the second client moves it to the second client.
+	h.insertSession(newSession(1, client1), nil)
+	h.assertClientSessions(client0, id(0))
+	h.assertClientSessions(client1, id(1), id(2))
+
+	// Deleting sessions removes them from the index, and releases the
+	// storage of their updates.
+	h.deleteSession(*id(0), nil)
+	h.deleteSession(*id(2), nil)
+	h.assertClientSessions(client0)
+	h.assertClientSessions(client1, id(1))
+	h.assertClientStorage(client0, 0)
+
+	sessions, err = h.db.ListSessions()
+	require.NoError(h.t, err)
+	require.Len(h.t, sessions, 2)
+}
+
 // testDeleteSession asserts the behavior of a tower database when deleting
 // session data. The test asserts that the only proper the target session is
 // remmoved, and that only updates for a particular session are pruned.
@@ -374,6 +555,99 @@ func testDeleteSession(h *towerDBHarness) {
 	}
 }
 
+// testDeleteChannelUpdates asserts that deleting the updates of a closed
+// channel only removes the session's updates with the given hints, leaving its
+// other updates and the updates of other sessions in place.
+func testDeleteChannelUpdates(h *towerDBHarness) {
+	newSession := func(id *wtdb.SessionID) *wtdb.SessionInfo {
+		return &wtdb.SessionInfo{
+			ID: *id,
+			Policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blob.TypeAltruistCommit,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 3,
+			},
+			RewardAddress: []byte{},
+		}
+	}
+
+	// Deleting updates of an unknown session should fail.
+	id0 := id(0)
+	id1 := id(1)
+	err := h.db.DeleteChannelUpdates(*id0, nil)
+	require.ErrorIs(h.t, err, wtdb.ErrSessionNotFound)
+
+	h.insertSession(newSession(id0), nil)
+	h.insertSession(newSession(id1), nil)
+
+	// The first session has updates for a closed and an open channel,
+	// while the second session has an update with the same hint as the
+	// closed channel's update.
+	closedHint := blob.BreachHint{0x01}
+	openHint := blob.BreachHint{0x02}
+	h.insertUpdate(&wtdb.SessionStateUpdate{
+		ID:            *id0,
+		Hint:          closedHint,
+		SeqNum:        1,
+		EncryptedBlob: testBlob,
+	}, nil)
+	h.insertUpdate(&wtdb.SessionStateUpdate{
+		ID:            *id0,
+		Hint:          openHint,
+		SeqNum:        2,
+		LastApplied:   1,
+		EncryptedBlob: testBlob,
+	}, nil)
+	h.insertUpdate(&wtdb.SessionStateUpdate{
+		ID:            *id1,
+		Hint:          closedHint,
+		SeqNum:        1,
+		EncryptedBlob: testBlob,
+	}, nil)
+
+	// Delete the closed channel's update from the first session. Hints
+	// the session has no updates for are ignored.
+	unknownHint := blob.BreachHint{0x03}
+	err = h.db.DeleteChannelUpdates(
+		*id0, []blob.BreachHint{closedHint, unknownHint},
+	)
+	require.NoError(h.t, err)
+
+	// Only the second session's update remains for the closed channel's
+	// hint, and the first session's other update is untouched.
+	matches := h.queryMatches(closedHint)
+	require.Len(h.t, matches, 1)
+	require.Equal(h.t, *id1, matches[0].ID)
+
+	match := h.hasUpdate(openHint)
+	require.Equal(h.t, *id0, match.ID)
+
+	// The deleted update no longer counts towards the session's storage.
+	blobSize := uint64(blob.Size(blob.TypeAltruistCommit))
+	require.Equal(h.t, blobSize, h.getSession(id0, nil).StorageBytes())
+
+	// Deleting the same hints again is a no-op.
+	err = h.db.DeleteChannelUpdates(*id0, []blob.BreachHint{closedHint})
+	require.NoError(h.t, err)
+	require.Len(h.t, h.queryMatches(closedHint), 1)
+	require.Equal(h.t, blobSize, h.getSession(id0, nil).StorageBytes())
+
+	// Once the second session's update is deleted, no matches remain for
+	// the hint. The sessions themselves aren't affected.
+	err = h.db.DeleteChannelUpdates(*id1, []blob.BreachHint{closedHint})
+	require.NoError(h.t, err)
+	require.Empty(h.t, h.queryMatches(closedHint))
+
+	h.getSession(id0, nil)
+	h.getSession(id1, nil)
+
+	// Deleting the first session still removes its remaining update.
+	h.deleteSession(*id0, nil)
+	require.Empty(h.t, h.queryMatches(openHint))
+}
+
 type stateUpdateTest struct {
 	session    *wtdb.SessionInfo
 	sessionErr error
@@ -428,9 +702,10 @@ func runStateUpdateTest(test stateUpdateTest) func(*towerDBHarness) {
 			}
 
 			// Update the session's last applied and client last
-			// applied.
+			// applied. Each update is stored under a unique hint.
 			expSession.LastApplied = update.SeqNum
 			expSession.ClientLastApplied = update.LastApplied
+			expSession.StoredUpdates++
 
 			match := h.hasUpdate(update.Hint)
 			if !reflect.DeepEqual(match.SessionInfo, expSession) {
@@ -735,6 +1010,10 @@ func TestTowerDB(t *testing.T) {
 			name: "delete session",
 			run:  testDeleteSession,
 		},
+		{
+			name: "delete channel updates",
+			run:  testDeleteChannelUpdates,
+		},
 		{
 			name: "state update no session",
 			run:  runStateUpdateTest(stateUpdateNoSession),
@@ -783,6 +1062,14 @@ func TestTowerDB(t *testing.T) {
 			name: "lookout tip",
 			run:  testLookoutTip,
 		},
+		{
+			name: "reward ledger",
+			run:  testRewardLedger,
+		},
+		{
+			name: "client sessions",
+			run:  testClientSessions,
+		},
 	}
 
 	for _, database := range dbs {
@@ -811,6 +1098,16 @@ func id(i int) *wtdb.SessionID {
 	return &id
 }
 
+// pubKey generates a fresh public key.
+func pubKey(t *testing.T) *btcec.PublicKey {
+	t.Helper()
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return privKey.PubKey()
+}
+
 // updateFromInt creates a unique update for a given (session, seqnum) pair. The
 // lastApplied argument can be used to construct updates simulating different
 // levels of synchronicity between client and db.
@@ -19,12 +19,26 @@ type version struct {
 // towerDBVersions stores all versions and migrations of the tower database.
 // This list will be used when opening the database to determine if any
 // migrations must be applied.
-var towerDBVersions = []version{}
+var towerDBVersions = []version{
+	{
+		// The storage used by each session and client is tracked
+		// since version 1, so that client quotas can be checked
+		// without walking all of a client's updates.
+		migration: migrateStorageUsage,
+	},
+}
 
 // clientDBVersions stores all versions and migrations of the client database.
 // This list will be used when opening the database to determine if any
 // migrations must be applied.
-var clientDBVersions = []version{}
+var clientDBVersions = []version{
+	{
+		// The acked updates are indexed by their channel since version
+		// 1, so that their towers can be told to delete them once the
+		// channel is closed.
+		migration: migrateUnindexedAcks,
+	},
+}
 
 // getLatestDBVersion returns the last known database version.
 func getLatestDBVersion(versions []version) uint32 {
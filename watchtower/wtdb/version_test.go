@@ -0,0 +1,257 @@
+package wtdb
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ltcsuite/lnd/kvdb"
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/watchtower/blob"
+	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMigrateUnindexedAcks asserts that the updates acked before the client
+// indexed their hints by channel are recorded as unindexed when the client
+// database is upgraded, and can be indexed afterwards.
+func TestMigrateUnindexedAcks(t *testing.T) {
+	path, err := ioutil.TempDir("", "clientdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(path)
+
+	openDB := func() *ClientDB {
+		dbCfg := &kvdb.BoltConfig{DBTimeout: kvdb.DefaultDBTimeout}
+		bdb, err := NewBoltBackendCreator(
+			true, path, "wtclient.db",
+		)(dbCfg)
+		require.NoError(t, err)
+
+		db, err := OpenClientDB(bdb)
+		require.NoError(t, err)
+
+		return db
+	}
+
+	db := openDB()
+
+	const blobType = blob.TypeAltruistCommit
+	session := &ClientSession{
+		ClientSessionBody: ClientSessionBody{
+			TowerID: TowerID(1),
+			Policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType: blobType,
+				},
+				MaxUpdates: 2,
+			},
+			RewardPkScript: []byte{0x01, 0x02, 0x03},
+		},
+		ID: SessionID([33]byte{0x01}),
+	}
+	session.KeyIndex, err = db.NextSessionKeyIndex(session.TowerID, blobType)
+	require.NoError(t, err)
+	require.NoError(t, db.CreateClientSession(session))
+
+	// Ack an update for each of two channels.
+	updates := []*CommittedUpdate{
+		{
+			SeqNum: 1,
+			CommittedUpdateBody: CommittedUpdateBody{
+				BackupID: BackupID{
+					ChanID:       lnwire.ChannelID{0x01},
+					CommitHeight: 1,
+				},
+				Hint:          blob.BreachHint{0x01},
+				EncryptedBlob: []byte{0x01},
+			},
+		},
+		{
+			SeqNum: 2,
+			CommittedUpdateBody: CommittedUpdateBody{
+				BackupID: BackupID{
+					ChanID:       lnwire.ChannelID{0x02},
+					CommitHeight: 2,
+				},
+				Hint:          blob.BreachHint{0x02},
+				EncryptedBlob: []byte{0x02},
+			},
+		},
+	}
+	for _, update := range updates {
+		chanID := update.BackupID.ChanID
+		require.NoError(t, db.RegisterChannel(chanID, nil))
+
+		_, err := db.CommitUpdate(&session.ID, update)
+		require.NoError(t, err)
+
+		err = db.AckUpdate(&session.ID, update.SeqNum, update.SeqNum)
+		require.NoError(t, err)
+	}
+
+	// Revert the database to the state before the hints were indexed.
+	err = kvdb.Update(db.db, func(tx kvdb.RwTx) error {
+		err := tx.DeleteTopLevelBucket(cUnindexedAcksBkt)
+		if err != nil {
+			return err
+		}
+
+		err = tx.DeleteTopLevelBucket(cChanHintsBkt)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(cChanHintsBkt)
+		if err != nil {
+			return err
+		}
+
+		return putDBVersion(tx, 0)
+	}, func() {})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// Reopening the database migrates it, which records both acked
+	// updates as unindexed.
+	db = openDB()
+	defer db.Close()
+
+	version, err := db.Version()
+	require.NoError(t, err)
+	require.Equal(t, getLatestDBVersion(clientDBVersions), version)
+
+	unindexed, err := db.ListUnindexedAcks()
+	require.NoError(t, err)
+	require.Equal(t, map[SessionID]map[uint16]BackupID{
+		session.ID: {
+			1: updates[0].BackupID,
+			2: updates[1].BackupID,
+		},
+	}, unindexed)
+
+	// Index the hint of the first update only, as if the second one
+	// couldn't be recovered.
+	err = db.IndexAckedUpdate(&session.ID, 1, &updates[0].Hint)
+	require.NoError(t, err)
+	err = db.IndexAckedUpdate(&session.ID, 2, nil)
+	require.NoError(t, err)
+
+	unindexed, err = db.ListUnindexedAcks()
+	require.NoError(t, err)
+	require.Empty(t, unindexed)
+
+	hints, err := db.FetchChannelHints(updates[0].BackupID.ChanID)
+	require.NoError(t, err)
+	require.Equal(t, map[SessionID][]blob.BreachHint{
+		session.ID: {updates[0].Hint},
+	}, hints)
+
+	hints, err = db.FetchChannelHints(updates[1].BackupID.ChanID)
+	require.NoError(t, err)
+	require.Empty(t, hints)
+}
+
+// TestMigrateStorageUsage asserts that the number of updates stored for each
+// session, and the storage used by each identified client, are initialized
+// from the stored updates when the tower database is upgraded.
+func TestMigrateStorageUsage(t *testing.T) {
+	path, err := ioutil.TempDir("", "towerdb")
+	require.NoError(t, err)
+	defer os.RemoveAll(path)
+
+	openDB := func() *TowerDB {
+		dbCfg := &kvdb.BoltConfig{DBTimeout: kvdb.DefaultDBTimeout}
+		bdb, err := NewBoltBackendCreator(
+			true, path, "watchtower.db",
+		)(dbCfg)
+		require.NoError(t, err)
+
+		db, err := OpenTowerDB(bdb)
+		require.NoError(t, err)
+
+		return db
+	}
+
+	db := openDB()
+
+	clientPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	clientKey := clientPriv.PubKey()
+
+	// Store two updates for a session of an identified client, and one
+	// update for a session of an anonymous client.
+	const blobType = blob.TypeAltruistCommit
+	newSession := func(id byte, clientKey *btcec.PublicKey) *SessionInfo {
+		session := &SessionInfo{
+			ID: SessionID([33]byte{id}),
+			Policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blobType,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 10,
+			},
+			RewardAddress: []byte{},
+			ClientKey:     clientKey,
+		}
+		require.NoError(t, db.InsertSessionInfo(session))
+
+		return session
+	}
+	insertUpdate := func(session *SessionInfo, seqNum uint16) {
+		hint := blob.BreachHint{session.ID[0], byte(seqNum)}
+		_, err := db.InsertStateUpdate(&SessionStateUpdate{
+			ID:            session.ID,
+			SeqNum:        seqNum,
+			LastApplied:   seqNum - 1,
+			Hint:          hint,
+			EncryptedBlob: make([]byte, blob.Size(blobType)),
+		})
+		require.NoError(t, err)
+	}
+
+	session0 := newSession(0x01, clientKey)
+	session1 := newSession(0x02, nil)
+	insertUpdate(session0, 1)
+	insertUpdate(session0, 2)
+	insertUpdate(session1, 1)
+
+	// Revert the database to the state before the storage was tracked.
+	err = kvdb.Update(db.db, func(tx kvdb.RwTx) error {
+		err := tx.DeleteTopLevelBucket(sessionUsageBkt)
+		if err != nil {
+			return err
+		}
+
+		err = tx.DeleteTopLevelBucket(clientUsageBkt)
+		if err != nil {
+			return err
+		}
+
+		return putDBVersion(tx, 0)
+	}, func() {})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// Reopening the database migrates it, which recovers the storage of
+	// both sessions and the identified client.
+	db = openDB()
+	defer db.Close()
+
+	version, err := db.Version()
+	require.NoError(t, err)
+	require.Equal(t, getLatestDBVersion(towerDBVersions), version)
+
+	session, err := db.GetSessionInfo(&session0.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, session.StoredUpdates)
+
+	session, err = db.GetSessionInfo(&session1.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, session.StoredUpdates)
+
+	storage, err := db.GetClientStorage(clientKey)
+	require.NoError(t, err)
+	require.EqualValues(t, 2*blob.Size(blobType), storage)
+}
@@ -0,0 +1,48 @@
+//go:build !monitoring
+// +build !monitoring
+
+package wtmetrics
+
+import (
+	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/lnd/watchtower/wtwire"
+)
+
+// Metrics is a no-op implementation of the tower's metrics, used if lnd isn't
+// built with monitoring support.
+type Metrics struct{}
+
+// LoadSessions is a no-op.
+func (Metrics) LoadSessions(func() ([]*wtdb.SessionInfo, error)) error {
+	return nil
+}
+
+// SessionCreated is a no-op.
+//
+// NOTE: Part of the wtserver.Metrics interface.
+func (Metrics) SessionCreated() {}
+
+// SessionRejected is a no-op.
+//
+// NOTE: Part of the wtserver.Metrics interface.
+func (Metrics) SessionRejected(wtwire.CreateSessionCode) {}
+
+// SessionDeleted is a no-op.
+//
+// NOTE: Part of the wtserver.Metrics interface.
+func (Metrics) SessionDeleted() {}
+
+// UpdateAccepted is a no-op.
+//
+// NOTE: Part of the wtserver.Metrics interface.
+func (Metrics) UpdateAccepted() {}
+
+// UpdateRejected is a no-op.
+//
+// NOTE: Part of the wtserver.Metrics interface.
+func (Metrics) UpdateRejected(wtwire.StateUpdateCode) {}
+
+// BreachFound is a no-op.
+//
+// NOTE: Part of the lookout.Metrics interface.
+func (Metrics) BreachFound() {}
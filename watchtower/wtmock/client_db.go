@@ -29,6 +29,11 @@ type ClientDB struct {
 	towerIndex     map[towerPK]wtdb.TowerID
 	towers         map[wtdb.TowerID]*wtdb.Tower
 
+	closedChans      map[lnwire.ChannelID]uint32
+	closableSessions map[wtdb.SessionID]uint32
+	chanHints        map[lnwire.ChannelID]map[wtdb.SessionID][]blob.BreachHint
+	unindexedAcks    map[wtdb.SessionID]map[uint16]wtdb.BackupID
+
 	nextIndex     uint32
 	indexes       map[keyIndexKey]uint32
 	legacyIndexes map[wtdb.TowerID]uint32
@@ -43,6 +48,15 @@ func NewClientDB() *ClientDB {
 		towers:         make(map[wtdb.TowerID]*wtdb.Tower),
 		indexes:        make(map[keyIndexKey]uint32),
 		legacyIndexes:  make(map[wtdb.TowerID]uint32),
+
+		closedChans:      make(map[lnwire.ChannelID]uint32),
+		closableSessions: make(map[wtdb.SessionID]uint32),
+		chanHints: make(
+			map[lnwire.ChannelID]map[wtdb.SessionID][]blob.BreachHint,
+		),
+		unindexedAcks: make(
+			map[wtdb.SessionID]map[uint16]wtdb.BackupID,
+		),
 	}
 }
 
@@ -395,7 +409,7 @@ func (m *ClientDB) AckUpdate(id *wtdb.SessionID, seqNum, lastApplied uint16) err
 		// Remove the committed update from disk and mark the update as
 		// acked. The tower last applied value is also recorded to send
 		// along with the next update.
-		copy(updates[:i], updates[i+1:])
+		copy(updates[i:], updates[i+1:])
 		updates[len(updates)-1] = wtdb.CommittedUpdate{}
 		session.CommittedUpdates = updates[:len(updates)-1]
 
@@ -403,6 +417,17 @@ func (m *ClientDB) AckUpdate(id *wtdb.SessionID, seqNum, lastApplied uint16) err
 		session.TowerLastApplied = lastApplied
 
 		m.activeSessions[*id] = session
+
+		// Index the update's hint under its channel.
+		m.putChanHint(update.BackupID.ChanID, *id, update.Hint)
+
+		// The session might have become closable if this was its last
+		// unacked update.
+		closeHeight, ok := m.sessionCloseHeight(&session, nil)
+		if ok {
+			m.closableSessions[*id] = closeHeight
+		}
+
 		return nil
 	}
 
@@ -447,6 +472,249 @@ func (m *ClientDB) RegisterChannel(chanID lnwire.ChannelID,
 	return nil
 }
 
+// MarkChannelClosed records that the channel was closed at the given block
+// height, and returns the sessions that are closable as a result. A session is
+// closable once it is exhausted, all of its updates have been acked, and all
+// channels it has updates for are closed.
+func (m *ClientDB) MarkChannelClosed(chanID lnwire.ChannelID,
+	blockHeight uint32) ([]wtdb.SessionID, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.summaries[chanID]; !ok {
+		return nil, wtdb.ErrChannelNotRegistered
+	}
+
+	if _, ok := m.closedChans[chanID]; !ok {
+		m.closedChans[chanID] = blockHeight
+	}
+
+	var closableSessions []wtdb.SessionID
+	for id, session := range m.activeSessions {
+		closeHeight, ok := m.sessionCloseHeight(&session, &chanID)
+		if !ok {
+			continue
+		}
+
+		m.closableSessions[id] = closeHeight
+		closableSessions = append(closableSessions, id)
+	}
+
+	return closableSessions, nil
+}
+
+// sessionCloseHeight returns the height at which the last channel with updates
+// in the session was closed, and whether the session is closable and, if a
+// channel is given, has updates for it.
+func (m *ClientDB) sessionCloseHeight(session *wtdb.ClientSession,
+	chanID *lnwire.ChannelID) (uint32, bool) {
+
+	if session.SeqNum < session.Policy.MaxUpdates ||
+		len(session.CommittedUpdates) > 0 {
+
+		return 0, false
+	}
+
+	var (
+		hasChannel  = chanID == nil
+		closeHeight uint32
+	)
+	for _, backupID := range session.AckedUpdates {
+		if chanID != nil && backupID.ChanID == *chanID {
+			hasChannel = true
+		}
+
+		height, ok := m.closedChans[backupID.ChanID]
+		if !ok {
+			return 0, false
+		}
+
+		if height > closeHeight {
+			closeHeight = height
+		}
+	}
+
+	return closeHeight, hasChannel
+}
+
+// ListClosableSessions returns all closable sessions, mapped to the height at
+// which the last channel with updates in the session was closed.
+func (m *ClientDB) ListClosableSessions() (map[wtdb.SessionID]uint32, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	closableSessions := make(map[wtdb.SessionID]uint32)
+	for id, closeHeight := range m.closableSessions {
+		closableSessions[id] = closeHeight
+	}
+
+	return closableSessions, nil
+}
+
+// ListClosedChannels returns the closed channels with acked updates that the
+// towers weren't told to delete yet, mapped to the height at which each
+// channel was closed.
+func (m *ClientDB) ListClosedChannels() (map[lnwire.ChannelID]uint32, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	closedChannels := make(map[lnwire.ChannelID]uint32)
+	for chanID := range m.chanHints {
+		closeHeight, ok := m.closedChans[chanID]
+		if !ok {
+			continue
+		}
+
+		closedChannels[chanID] = closeHeight
+	}
+
+	return closedChannels, nil
+}
+
+// FetchCloseHeights returns all channels that were marked closed, mapped to the
+// height at which each channel was closed.
+func (m *ClientDB) FetchCloseHeights() (map[lnwire.ChannelID]uint32, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	closeHeights := make(map[lnwire.ChannelID]uint32)
+	for chanID, closeHeight := range m.closedChans {
+		closeHeights[chanID] = closeHeight
+	}
+
+	return closeHeights, nil
+}
+
+// ListUnindexedAcks returns the acked updates whose hints weren't indexed by
+// their channel yet, grouped by session and keyed by their sequence number.
+func (m *ClientDB) ListUnindexedAcks() (
+	map[wtdb.SessionID]map[uint16]wtdb.BackupID, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	unindexed := make(map[wtdb.SessionID]map[uint16]wtdb.BackupID)
+	for id, acks := range m.unindexedAcks {
+		unindexed[id] = make(map[uint16]wtdb.BackupID)
+		for seqNum, backupID := range acks {
+			unindexed[id][seqNum] = backupID
+		}
+	}
+
+	return unindexed, nil
+}
+
+// IndexAckedUpdate indexes the hint of an unindexed acked update by its
+// channel. If the hint is nil, the update is only removed from the unindexed
+// updates.
+func (m *ClientDB) IndexAckedUpdate(id *wtdb.SessionID, seqNum uint16,
+	hint *blob.BreachHint) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	backupID, ok := m.unindexedAcks[*id][seqNum]
+	if !ok {
+		return nil
+	}
+
+	if _, ok := m.activeSessions[*id]; ok && hint != nil {
+		m.putChanHint(backupID.ChanID, *id, *hint)
+	}
+
+	delete(m.unindexedAcks[*id], seqNum)
+	if len(m.unindexedAcks[*id]) == 0 {
+		delete(m.unindexedAcks, *id)
+	}
+
+	return nil
+}
+
+// AddUnindexedAck records an acked update of the session as unindexed, as if
+// it was acked before the hints of acked updates were indexed.
+func (m *ClientDB) AddUnindexedAck(id wtdb.SessionID, seqNum uint16,
+	backupID wtdb.BackupID) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.unindexedAcks[id]; !ok {
+		m.unindexedAcks[id] = make(map[uint16]wtdb.BackupID)
+	}
+	m.unindexedAcks[id][seqNum] = backupID
+}
+
+// FetchChannelHints returns the breach hints of the channel's acked updates
+// that the towers weren't told to delete yet, grouped by session.
+func (m *ClientDB) FetchChannelHints(
+	chanID lnwire.ChannelID) (map[wtdb.SessionID][]blob.BreachHint, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sessionHints := make(map[wtdb.SessionID][]blob.BreachHint)
+	for id, hints := range m.chanHints[chanID] {
+		sessionHints[id] = append([]blob.BreachHint(nil), hints...)
+	}
+
+	return sessionHints, nil
+}
+
+// DeleteChannelHints removes the breach hints of the channel's updates in the
+// session, once the session's tower deleted the updates.
+func (m *ClientDB) DeleteChannelHints(chanID lnwire.ChannelID,
+	id wtdb.SessionID) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.removeChanHints(chanID, id)
+
+	return nil
+}
+
+// putChanHint indexes the breach hint of an acked update of the session under
+// the update's channel.
+func (m *ClientDB) putChanHint(chanID lnwire.ChannelID, id wtdb.SessionID,
+	hint blob.BreachHint) {
+
+	if _, ok := m.chanHints[chanID]; !ok {
+		m.chanHints[chanID] = make(map[wtdb.SessionID][]blob.BreachHint)
+	}
+	m.chanHints[chanID][id] = append(m.chanHints[chanID][id], hint)
+}
+
+// removeChanHints removes the breach hints of the channel's updates in the
+// session, pruning the channel if no other session has updates for it.
+func (m *ClientDB) removeChanHints(chanID lnwire.ChannelID, id wtdb.SessionID) {
+	delete(m.chanHints[chanID], id)
+	if len(m.chanHints[chanID]) == 0 {
+		delete(m.chanHints, chanID)
+	}
+}
+
+// DeleteSession removes a closable session, along with all of its updates,
+// from the database.
+func (m *ClientDB) DeleteSession(id wtdb.SessionID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.closableSessions[id]; !ok {
+		return wtdb.ErrSessionNotClosable
+	}
+
+	for _, backupID := range m.activeSessions[id].AckedUpdates {
+		m.removeChanHints(backupID.ChanID, id)
+	}
+
+	delete(m.activeSessions, id)
+	delete(m.closableSessions, id)
+	delete(m.unindexedAcks, id)
+
+	return nil
+}
+
 func cloneBytes(b []byte) []byte {
 	if b == nil {
 		return nil
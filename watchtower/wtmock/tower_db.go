@@ -6,6 +6,8 @@ import (
 	"github.com/ltcsuite/lnd/chainntnfs"
 	"github.com/ltcsuite/lnd/watchtower/blob"
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 )
 
 // TowerDB is a mock, in-memory implementation of a watchtower.DB.
@@ -14,6 +16,7 @@ type TowerDB struct {
 	lastEpoch *chainntnfs.BlockEpoch
 	sessions  map[wtdb.SessionID]*wtdb.SessionInfo
 	blobs     map[blob.BreachHint]map[wtdb.SessionID]*wtdb.SessionStateUpdate
+	rewards   map[chainhash.Hash]*wtdb.RewardRecord
 }
 
 // NewTowerDB initializes a fresh mock TowerDB.
@@ -21,6 +24,7 @@ func NewTowerDB() *TowerDB {
 	return &TowerDB{
 		sessions: make(map[wtdb.SessionID]*wtdb.SessionInfo),
 		blobs:    make(map[blob.BreachHint]map[wtdb.SessionID]*wtdb.SessionStateUpdate),
+		rewards:  make(map[chainhash.Hash]*wtdb.RewardRecord),
 	}
 }
 
@@ -52,6 +56,9 @@ func (db *TowerDB) InsertStateUpdate(update *wtdb.SessionStateUpdate) (uint16, e
 		sessionsToUpdates = make(map[wtdb.SessionID]*wtdb.SessionStateUpdate)
 		db.blobs[update.Hint] = sessionsToUpdates
 	}
+	if _, ok := sessionsToUpdates[update.ID]; !ok {
+		info.StoredUpdates++
+	}
 	sessionsToUpdates[update.ID] = update
 
 	return info.LastApplied, nil
@@ -120,6 +127,100 @@ func (db *TowerDB) DeleteSession(target wtdb.SessionID) error {
 	return nil
 }
 
+// DeleteChannelUpdates removes the session's state updates with the given
+// breach hints, after the client signaled that the channel they belong to was
+// closed. An error is returned if the session could not be found.
+func (db *TowerDB) DeleteChannelUpdates(id wtdb.SessionID,
+	hints []blob.BreachHint) error {
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	// Fail if the session doesn't exist.
+	info, ok := db.sessions[id]
+	if !ok {
+		return wtdb.ErrSessionNotFound
+	}
+
+	for _, hint := range hints {
+		sessionUpdates, ok := db.blobs[hint]
+		if !ok {
+			continue
+		}
+
+		if _, ok := sessionUpdates[id]; ok {
+			info.StoredUpdates--
+		}
+		delete(sessionUpdates, id)
+
+		// If this was the last state update, we can also remove the
+		// hint that would map to an empty set.
+		if len(sessionUpdates) == 0 {
+			delete(db.blobs, hint)
+		}
+	}
+
+	return nil
+}
+
+// ListSessions returns all sessions negotiated with the tower.
+func (db *TowerDB) ListSessions() ([]*wtdb.SessionInfo, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	sessions := make([]*wtdb.SessionInfo, 0, len(db.sessions))
+	for _, session := range db.sessions {
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// ListClientSessions returns all sessions negotiated with the tower by the
+// client identified by the given client key.
+func (db *TowerDB) ListClientSessions(
+	clientKey *btcec.PublicKey) ([]*wtdb.SessionInfo, error) {
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var sessions []*wtdb.SessionInfo
+	for _, session := range db.sessions {
+		if session.ClientKey == nil ||
+			!session.ClientKey.IsEqual(clientKey) {
+
+			continue
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// GetClientStorage returns the number of bytes used by the encrypted blobs of
+// the state updates the tower stores for the client identified by the given
+// client key, summed over all of its sessions.
+func (db *TowerDB) GetClientStorage(clientKey *btcec.PublicKey) (uint64,
+	error) {
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	var storage uint64
+	for _, session := range db.sessions {
+		if session.ClientKey == nil ||
+			!session.ClientKey.IsEqual(clientKey) {
+
+			continue
+		}
+
+		storage += session.StorageBytes()
+	}
+
+	return storage, nil
+}
+
 // QueryMatches searches against all known state updates for any that match the
 // passed breachHints. More than one Match will be returned for a given hint if
 // they exist in the database.
@@ -171,3 +272,27 @@ func (db *TowerDB) GetLookoutTip() (*chainntnfs.BlockEpoch, error) {
 
 	return db.lastEpoch, nil
 }
+
+// RecordReward adds the reward claimed by a justice transaction to the tower's
+// reward ledger.
+func (db *TowerDB) RecordReward(record *wtdb.RewardRecord) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.rewards[record.JusticeTxID] = record
+
+	return nil
+}
+
+// ListRewards returns all records of the tower's reward ledger.
+func (db *TowerDB) ListRewards() ([]*wtdb.RewardRecord, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	records := make([]*wtdb.RewardRecord, 0, len(db.rewards))
+	for _, record := range db.rewards {
+		records = append(records, record)
+	}
+
+	return records, nil
+}
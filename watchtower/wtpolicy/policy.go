@@ -9,6 +9,7 @@ import (
 	"github.com/ltcsuite/lnd/lnwallet/chainfee"
 	"github.com/ltcsuite/lnd/watchtower/blob"
 	"github.com/ltcsuite/ltcd/ltcutil"
+	"github.com/ltcsuite/ltcd/mempool"
 	"github.com/ltcsuite/ltcd/wire"
 )
 
@@ -102,6 +103,22 @@ type TxPolicy struct {
 	SweepFeeRate chainfee.SatPerKWeight
 }
 
+// AcceptsTerms returns true if a session negotiated under the given policy
+// produces justice transactions that are acceptable under this policy. Both
+// must agree on the blob type and sweep fee rate. The reward base and rate of
+// this policy are treated as caps, such that any session charging at most
+// those is accepted.
+func (p TxPolicy) AcceptsTerms(session TxPolicy) bool {
+	if p.BlobType != session.BlobType ||
+		p.SweepFeeRate != session.SweepFeeRate {
+
+		return false
+	}
+
+	return session.RewardBase <= p.RewardBase &&
+		session.RewardRate <= p.RewardRate
+}
+
 // Policy defines the negotiated parameters for a session between a client and
 // server. In addition to the TxPolicy that governs the shape of the justice
 // transaction, the Policy also includes features which only affect the
@@ -233,10 +250,11 @@ func ComputeRewardAmount(total ltcutil.Amount, base, rate uint32) ltcutil.Amount
 
 // ComputeJusticeTxOuts constructs the justice transaction outputs for the given
 // policy. If the policy specifies a reward for the tower, there will be two
-// outputs paying to the victim and the tower. Otherwise there will be a single
-// output sweeping funds back to the victim. The totalAmt should be the sum of
-// any inputs used in the transaction. The passed txWeight should include the
-// weight of the outputs for the justice transaction, which is dependent on
+// outputs paying to the victim and the tower, unless the reward would be dust,
+// in which case it is swept to the victim as well. Otherwise there will be a
+// single output sweeping funds back to the victim. The totalAmt should be the
+// sum of any inputs used in the transaction. The passed txWeight should include
+// the weight of the outputs for the justice transaction, which is dependent on
 // whether the justice transaction has a reward. The sweepPkScript should be the
 // pkScript of the victim to which funds will be recovered. The rewardPkScript
 // is the pkScript of the tower where its reward will be deposited, and will be
@@ -265,15 +283,28 @@ func (p *Policy) ComputeJusticeTxOuts(totalAmt ltcutil.Amount, txWeight int64,
 			return nil, err
 		}
 
+		// A reward below the dust limit of the tower's reward script
+		// can't be paid out, e.g. if the tower accepted a zero reward
+		// or the breach is small. In that case the reward output is
+		// dropped and its value is returned to the victim instead.
+		rewardOut := &wire.TxOut{
+			PkScript: rewardPkScript,
+			Value:    int64(rewardAmt),
+		}
+		rewardDust := mempool.GetDustThreshold(rewardOut)
+		if rewardOut.Value < rewardDust {
+			sweepAmt += rewardAmt
+			rewardOut = nil
+		}
+
 		// Add the sweep and reward outputs to the list of txouts.
 		outputs = append(outputs, &wire.TxOut{
 			PkScript: sweepPkScript,
 			Value:    int64(sweepAmt),
 		})
-		outputs = append(outputs, &wire.TxOut{
-			PkScript: rewardPkScript,
-			Value:    int64(rewardAmt),
-		})
+		if rewardOut != nil {
+			outputs = append(outputs, rewardOut)
+		}
 	} else {
 		// Using the total input amount and the transaction's weight,
 		// compute the sweep amount, which corresponds to the amount
@@ -3,8 +3,11 @@ package wtpolicy_test
 import (
 	"testing"
 
+	"github.com/ltcsuite/lnd/input"
+	"github.com/ltcsuite/lnd/lnwallet"
 	"github.com/ltcsuite/lnd/watchtower/blob"
 	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
+	"github.com/ltcsuite/ltcd/ltcutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -110,3 +113,132 @@ func TestPolicyIsAnchorChannel(t *testing.T) {
 	}
 	require.Equal(t, true, policyAnchor.IsAnchorChannel())
 }
+
+// TestPolicyAcceptsTerms asserts that a policy only accepts sessions with the
+// same blob type and sweep fee rate, and a reward within its reward caps.
+func TestPolicyAcceptsTerms(t *testing.T) {
+	caps := wtpolicy.TxPolicy{
+		BlobType:     blob.TypeRewardCommit,
+		RewardBase:   1000,
+		RewardRate:   wtpolicy.DefaultRewardRate,
+		SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+	}
+
+	// Sessions charging at most the caps are accepted.
+	require.True(t, caps.AcceptsTerms(caps))
+
+	session := caps
+	session.RewardBase = 0
+	session.RewardRate = wtpolicy.DefaultRewardRate / 2
+	require.True(t, caps.AcceptsTerms(session))
+
+	// Sessions charging more than either cap aren't.
+	session = caps
+	session.RewardBase++
+	require.False(t, caps.AcceptsTerms(session))
+
+	session = caps
+	session.RewardRate++
+	require.False(t, caps.AcceptsTerms(session))
+
+	// Neither are sessions that differ in their blob type or sweep fee
+	// rate.
+	session = caps
+	session.BlobType = blob.TypeRewardAnchorCommit
+	require.False(t, caps.AcceptsTerms(session))
+
+	session = caps
+	session.SweepFeeRate++
+	require.False(t, caps.AcceptsTerms(session))
+
+	// Altruist policies only accept identical sessions.
+	altruist := wtpolicy.DefaultPolicy().TxPolicy
+	require.True(t, altruist.AcceptsTerms(altruist))
+	require.False(t, altruist.AcceptsTerms(caps))
+}
+
+// TestComputeJusticeTxOutsDustReward asserts that a reward below the dust
+// limit of the reward script is swept back to the victim rather than creating
+// a dust output paying the tower.
+func TestComputeJusticeTxOutsDustReward(t *testing.T) {
+	const (
+		totalAmt = ltcutil.Amount(20000)
+		txWeight = 1000
+	)
+
+	sweepPkScript, err := input.WitnessPubKeyHash(make([]byte, 20))
+	require.NoError(t, err)
+	rewardPkScript, err := input.WitnessPubKeyHash(make([]byte, 20))
+	require.NoError(t, err)
+	rewardPkScript[2] = 0x01
+
+	policy := wtpolicy.Policy{
+		TxPolicy: wtpolicy.TxPolicy{
+			BlobType:     blob.TypeRewardCommit,
+			SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+		},
+		MaxUpdates: 1,
+	}
+	txFee := policy.SweepFeeRate.FeeForWeight(txWeight)
+	dustLimit := lnwallet.DustLimitForSize(input.P2WPKHSize)
+
+	tests := []struct {
+		name       string
+		rewardBase uint32
+		rewardRate uint32
+		expReward  ltcutil.Amount
+	}{
+		{
+			name: "zero reward",
+		},
+		{
+			name:       "dust reward",
+			rewardBase: uint32(dustLimit) - 1,
+		},
+		{
+			name:       "small proportional reward",
+			rewardRate: wtpolicy.DefaultRewardRate,
+		},
+		{
+			name:       "reward at dust limit",
+			rewardBase: uint32(dustLimit),
+			expReward:  dustLimit,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			policy := policy
+			policy.RewardBase = test.rewardBase
+			policy.RewardRate = test.rewardRate
+
+			outputs, err := policy.ComputeJusticeTxOuts(
+				totalAmt, txWeight, sweepPkScript,
+				rewardPkScript,
+			)
+			require.NoError(t, err)
+
+			// Without a reward output, the victim receives
+			// everything but the fee.
+			if test.expReward == 0 {
+				require.Len(t, outputs, 1)
+				require.Equal(
+					t, sweepPkScript, outputs[0].PkScript,
+				)
+				require.EqualValues(
+					t, totalAmt-txFee, outputs[0].Value,
+				)
+				return
+			}
+
+			require.Len(t, outputs, 2)
+			require.EqualValues(
+				t, totalAmt-txFee-test.expReward,
+				outputs[0].Value,
+			)
+			require.Equal(t, rewardPkScript, outputs[1].PkScript)
+			require.EqualValues(t, test.expReward, outputs[1].Value)
+		})
+	}
+}
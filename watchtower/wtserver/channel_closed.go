@@ -0,0 +1,111 @@
+package wtserver
+
+import (
+	"fmt"
+
+	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/lnd/watchtower/wtwire"
+)
+
+// handleChannelClosedMsgs processes a stream of ChannelClosed messages from the
+// client. The provided message should be the first such message read,
+// subsequent messages will be consumed if the peer does not signal IsComplete
+// on a particular message.
+func (s *Server) handleChannelClosedMsgs(peer Peer, id *wtdb.SessionID,
+	msg *wtwire.ChannelClosed) error {
+
+	var curMsg = msg
+	for {
+		// If this is not the first message, read the next one from the
+		// peer.
+		if curMsg == nil {
+			nextMsg, err := s.readMessage(peer)
+			if err != nil {
+				return err
+			}
+
+			var ok bool
+			curMsg, ok = nextMsg.(*wtwire.ChannelClosed)
+			if !ok {
+				return fmt.Errorf("client sent %T after "+
+					"ChannelClosed", nextMsg)
+			}
+		}
+
+		err := s.handleChannelClosed(peer, id, curMsg)
+		if err != nil {
+			return err
+		}
+
+		// If the client signals that this is the last ChannelClosed
+		// message, we can disconnect the client.
+		if curMsg.IsComplete == 1 {
+			return nil
+		}
+
+		curMsg = nil
+
+		select {
+		case <-s.quit:
+			return ErrServerExiting
+		default:
+		}
+	}
+}
+
+// handleChannelClosed processes a ChannelClosed message for a client with the
+// given SessionID, deleting the session's state updates for the closed
+// channel. The id is assumed to have been previously authenticated by the
+// brontide connection.
+func (s *Server) handleChannelClosed(peer Peer, id *wtdb.SessionID,
+	msg *wtwire.ChannelClosed) error {
+
+	var failCode wtwire.ChannelClosedCode
+
+	err := s.cfg.DB.DeleteChannelUpdates(*id, msg.BreachHints)
+	switch {
+	case err == nil:
+		failCode = wtwire.CodeOK
+
+		log.Debugf("Deleted %d updates of closed channel for "+
+			"session %s", len(msg.BreachHints), id)
+
+	case err == wtdb.ErrSessionNotFound:
+		failCode = wtwire.ChannelClosedCodeNotFound
+
+	default:
+		log.Errorf("Unable to delete updates of closed channel for "+
+			"session %s: %v", id, err)
+
+		failCode = wtwire.CodeTemporaryFailure
+	}
+
+	return s.replyChannelClosed(peer, id, failCode)
+}
+
+// replyChannelClosed sends a ChannelClosedReply back to the peer containing
+// the error code resulting from processing a ChannelClosed message.
+func (s *Server) replyChannelClosed(peer Peer, id *wtdb.SessionID,
+	code wtwire.ChannelClosedCode) error {
+
+	msg := &wtwire.ChannelClosedReply{
+		Code: code,
+	}
+
+	err := s.sendMessage(peer, msg)
+	if err != nil {
+		log.Errorf("Unable to send ChannelClosedReply to %s", id)
+	}
+
+	// Return the write error if the request succeeded.
+	if code == wtwire.CodeOK {
+		return err
+	}
+
+	// Otherwise the request failed, return a connection failure to
+	// disconnect the client.
+	return &connFailure{
+		ID:   *id,
+		Code: code,
+	}
+}
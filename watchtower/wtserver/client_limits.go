@@ -0,0 +1,219 @@
+package wtserver
+
+import (
+	"crypto/sha256"
+
+	"github.com/ltcsuite/lnd/lntypes"
+	"github.com/ltcsuite/lnd/watchtower/blob"
+	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/lnd/watchtower/wtwire"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+)
+
+// ClientQuota limits the resources each identified client can use on the
+// tower, summed over all of its sessions. A zero limit is unlimited.
+type ClientQuota struct {
+	// MaxSessions is the maximum number of sessions of a client.
+	MaxSessions uint32
+
+	// MaxUpdates is the maximum number of state updates a client can
+	// negotiate, summed over the MaxUpdates of its sessions.
+	MaxUpdates uint32
+
+	// MaxStorageBytes is the maximum number of bytes the encrypted blobs of
+	// a client's state updates can occupy.
+	MaxStorageBytes uint64
+}
+
+// clientKeySet is a set of serialized client keys.
+type clientKeySet map[[33]byte]struct{}
+
+// newClientKeySet creates a clientKeySet containing the given keys.
+func newClientKeySet(keys []*btcec.PublicKey) clientKeySet {
+	set := make(clientKeySet, len(keys))
+	for _, key := range keys {
+		set[serializeClientKey(key)] = struct{}{}
+	}
+
+	return set
+}
+
+// has returns true if the set contains the given key.
+func (c clientKeySet) has(key *btcec.PublicKey) bool {
+	_, ok := c[serializeClientKey(key)]
+	return ok
+}
+
+// serializeClientKey returns the compressed serialization of the key.
+func serializeClientKey(key *btcec.PublicKey) [33]byte {
+	var b [33]byte
+	copy(b[:], key.SerializeCompressed())
+
+	return b
+}
+
+// clientLockID returns the id of the client's quota lock, which is the hash of
+// its serialized client key.
+func clientLockID(key *btcec.PublicKey) lntypes.Hash {
+	return sha256.Sum256(key.SerializeCompressed())
+}
+
+// requiresClientKey returns true if clients must identify themselves with a
+// client key. This is the case if it is required explicitly, or if any client
+// permissions or quotas are configured, as those couldn't be enforced for
+// anonymous clients.
+func (s *Server) requiresClientKey() bool {
+	quota := s.cfg.ClientQuota
+
+	return s.cfg.RequireClientKey || len(s.allowedClients) != 0 ||
+		len(s.deniedClients) != 0 || quota.MaxSessions != 0 ||
+		quota.MaxUpdates != 0 || quota.MaxStorageBytes != 0
+}
+
+// isClientAllowed returns true if the server accepts sessions from the client
+// with the given client key, which is nil for anonymous clients.
+func (s *Server) isClientAllowed(clientKey *btcec.PublicKey) bool {
+	if clientKey == nil {
+		return !s.requiresClientKey()
+	}
+
+	if s.deniedClients.has(clientKey) {
+		return false
+	}
+
+	return len(s.allowedClients) == 0 || s.allowedClients.has(clientKey)
+}
+
+// limitsUpdates returns true if the state updates of identified clients need
+// to be checked against the client's permissions and quota.
+func (s *Server) limitsUpdates() bool {
+	return len(s.allowedClients) != 0 || len(s.deniedClients) != 0 ||
+		s.cfg.ClientQuota.MaxStorageBytes != 0
+}
+
+// checkSessionQuota returns CodeOK if the client can create the requested
+// session within its quota, and the reason for the rejection otherwise. An
+// unused session with the same id that is recommitted doesn't count towards
+// the client's usage.
+//
+// NOTE: This method MUST be called with the client's quota lock held.
+func (s *Server) checkSessionQuota(id *wtdb.SessionID,
+	clientKey *btcec.PublicKey,
+	req *wtwire.CreateSession) (wtwire.CreateSessionCode, error) {
+
+	quota := s.cfg.ClientQuota
+	if quota.MaxSessions == 0 && quota.MaxUpdates == 0 {
+		return wtwire.CodeOK, nil
+	}
+
+	sessions, err := s.cfg.DB.ListClientSessions(clientKey)
+	if err != nil {
+		return wtwire.CodeTemporaryFailure, err
+	}
+
+	numSessions := uint32(1)
+	numUpdates := uint32(req.MaxUpdates)
+	for _, session := range sessions {
+		if session.ID == *id {
+			continue
+		}
+
+		numSessions++
+		numUpdates += uint32(session.Policy.MaxUpdates)
+	}
+
+	switch {
+	case quota.MaxSessions != 0 && numSessions > quota.MaxSessions:
+		log.Debugf("Rejecting CreateSession from %s, client %x "+
+			"exceeds max sessions %d", id,
+			clientKey.SerializeCompressed(), quota.MaxSessions)
+
+		return wtwire.CreateSessionCodeRejectQuota, nil
+
+	case quota.MaxUpdates != 0 && numUpdates > quota.MaxUpdates:
+		log.Debugf("Rejecting CreateSession from %s, client %x "+
+			"exceeds max updates %d", id,
+			clientKey.SerializeCompressed(), quota.MaxUpdates)
+
+		return wtwire.CreateSessionCodeRejectQuota, nil
+	}
+
+	return wtwire.CodeOK, nil
+}
+
+// insertLimitedUpdate stores the state update if the session's client is still
+// allowed to use the tower, and the update fits within the client's storage
+// quota. Otherwise, the reason for the rejection is returned. Updates for
+// unknown sessions are left to be rejected by the database.
+func (s *Server) insertLimitedUpdate(
+	update *wtdb.SessionStateUpdate) (uint16, wtwire.StateUpdateCode, error) {
+
+	session, err := s.cfg.DB.GetSessionInfo(&update.ID)
+	switch {
+	case err == wtdb.ErrSessionNotFound:
+		lastApplied, err := s.cfg.DB.InsertStateUpdate(update)
+		return lastApplied, wtwire.CodeOK, err
+
+	case err != nil:
+		return 0, wtwire.CodeTemporaryFailure, err
+	}
+
+	// Sessions of anonymous clients were accepted under the tower's
+	// policy at the time, and aren't subject to client limits.
+	if session.ClientKey == nil {
+		lastApplied, err := s.cfg.DB.InsertStateUpdate(update)
+		return lastApplied, wtwire.CodeOK, err
+	}
+
+	if !s.isClientAllowed(session.ClientKey) {
+		log.Debugf("Rejecting StateUpdate for %s, client %x not "+
+			"allowed", update.ID,
+			session.ClientKey.SerializeCompressed())
+
+		return 0, wtwire.CodePermanentFailure, nil
+	}
+
+	// Hold the client's quota lock until the update is stored, such that
+	// concurrent updates of the same client can't exceed its quota.
+	lockID := clientLockID(session.ClientKey)
+	s.quotaMtx.Lock(lockID)
+	defer s.quotaMtx.Unlock(lockID)
+
+	code, err := s.checkStorageQuota(session, update)
+	if err != nil || code != wtwire.CodeOK {
+		return 0, code, err
+	}
+
+	lastApplied, err := s.cfg.DB.InsertStateUpdate(update)
+	return lastApplied, wtwire.CodeOK, err
+}
+
+// checkStorageQuota returns CodeOK if the session's client can store the update
+// within its storage quota, and the reason for the rejection otherwise.
+//
+// NOTE: This method MUST be called with the client's quota lock held.
+func (s *Server) checkStorageQuota(session *wtdb.SessionInfo,
+	update *wtdb.SessionStateUpdate) (wtwire.StateUpdateCode, error) {
+
+	// Retransmissions of the last update don't use additional storage.
+	maxStorage := s.cfg.ClientQuota.MaxStorageBytes
+	if maxStorage == 0 || update.SeqNum <= session.LastApplied {
+		return wtwire.CodeOK, nil
+	}
+
+	storage, err := s.cfg.DB.GetClientStorage(session.ClientKey)
+	if err != nil {
+		return wtwire.CodeTemporaryFailure, err
+	}
+
+	storage += uint64(blob.Size(session.Policy.BlobType))
+	if storage > maxStorage {
+		log.Debugf("Rejecting StateUpdate for %s, client %x exceeds "+
+			"max storage %d bytes", update.ID,
+			session.ClientKey.SerializeCompressed(), maxStorage)
+
+		return wtwire.StateUpdateCodeQuotaExceeded, nil
+	}
+
+	return wtwire.CodeOK, nil
+}
@@ -1,6 +1,9 @@
 package wtserver
 
 import (
+	"bytes"
+
+	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/txscript"
 	"github.com/ltcsuite/lnd/watchtower/blob"
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
@@ -15,8 +18,6 @@ import (
 func (s *Server) handleCreateSession(peer Peer, id *wtdb.SessionID,
 	req *wtwire.CreateSession) error {
 
-	// TODO(conner): validate accept against policy
-
 	// Query the db for session info belonging to the client's session id.
 	existingInfo, err := s.cfg.DB.GetSessionInfo(id)
 	switch {
@@ -65,6 +66,63 @@ func (s *Server) handleCreateSession(peer Peer, id *wtdb.SessionID,
 		)
 	}
 
+	// Ensure that the client offers at least the reward we require for
+	// reward sessions. Otherwise we return our terms, such that the client
+	// can retry with them if they are acceptable.
+	if req.BlobType.Has(blob.FlagReward) &&
+		(req.RewardBase < s.cfg.RewardBase ||
+			req.RewardRate < s.cfg.RewardRate) {
+
+		log.Debugf("Rejecting CreateSession from %s, reward base %d "+
+			"and rate %d below required base %d and rate %d", id,
+			req.RewardBase, req.RewardRate, s.cfg.RewardBase,
+			s.cfg.RewardRate)
+
+		terms := &wtwire.RewardTerms{
+			RewardBase: s.cfg.RewardBase,
+			RewardRate: s.cfg.RewardRate,
+		}
+
+		var b bytes.Buffer
+		if err := terms.Encode(&b); err != nil {
+			log.Errorf("Unable to encode reward terms for %s: %v",
+				id, err)
+			return s.replyCreateSession(
+				peer, id, wtwire.CodeTemporaryFailure, 0, nil,
+			)
+		}
+
+		return s.replyCreateSession(
+			peer, id, wtwire.CreateSessionCodeRejectRewardRate, 0,
+			b.Bytes(),
+		)
+	}
+
+	// Identify the client by its client key, if it provided one, and
+	// ensure that we serve the client.
+	clientKey, code := s.identifyClient(peer, id, req)
+	if code != wtwire.CodeOK {
+		return s.replyCreateSession(peer, id, code, 0, nil)
+	}
+
+	// Hold the client's quota lock until the session is stored, such that
+	// concurrent requests of the same client can't exceed its quota.
+	if clientKey != nil {
+		lockID := clientLockID(clientKey)
+		s.quotaMtx.Lock(lockID)
+		defer s.quotaMtx.Unlock(lockID)
+
+		code, err := s.checkSessionQuota(id, clientKey, req)
+		if err != nil {
+			log.Errorf("Unable to check quota of client %x for "+
+				"%s: %v", clientKey.SerializeCompressed(), id,
+				err)
+		}
+		if code != wtwire.CodeOK {
+			return s.replyCreateSession(peer, id, code, 0, nil)
+		}
+	}
+
 	// Now that we've established that this session does not exist in the
 	// database, retrieve the sweep address that will be given to the
 	// client. This address is to be included by the client when signing
@@ -109,6 +167,7 @@ func (s *Server) handleCreateSession(peer Peer, id *wtdb.SessionID,
 			MaxUpdates: req.MaxUpdates,
 		},
 		RewardAddress: rewardScript,
+		ClientKey:     clientKey,
 	}
 
 	// Insert the session info into the watchtower's database. If
@@ -123,11 +182,51 @@ func (s *Server) handleCreateSession(peer Peer, id *wtdb.SessionID,
 
 	log.Infof("Accepted session for %s", id)
 
+	// Recommitting an unused session doesn't create a new one.
+	if existingInfo == nil {
+		s.cfg.Metrics.SessionCreated()
+	}
+
 	return s.replyCreateSession(
 		peer, id, wtwire.CodeOK, 0, rewardScript,
 	)
 }
 
+// identifyClient returns the verified client key of the CreateSession request,
+// or nil if the client is anonymous. A non-OK code is returned if the client's
+// signature is invalid, or if the server doesn't serve the client.
+func (s *Server) identifyClient(peer Peer, id *wtdb.SessionID,
+	req *wtwire.CreateSession) (*btcec.PublicKey,
+	wtwire.CreateSessionCode) {
+
+	clientKey := req.ClientKey
+	if clientKey != nil {
+		err := req.VerifyClientSig(peer.RemotePub(), s.cfg.ChainHash)
+		if err != nil {
+			log.Debugf("Rejecting CreateSession from %s, unable "+
+				"to verify client key %x: %v", id,
+				clientKey.SerializeCompressed(), err)
+
+			return nil, wtwire.CreateSessionCodeRejectClient
+		}
+	}
+
+	if !s.isClientAllowed(clientKey) {
+		if clientKey == nil {
+			log.Debugf("Rejecting CreateSession from %s, client "+
+				"key required", id)
+		} else {
+			log.Debugf("Rejecting CreateSession from %s, client "+
+				"%x not allowed", id,
+				clientKey.SerializeCompressed())
+		}
+
+		return nil, wtwire.CreateSessionCodeRejectClient
+	}
+
+	return clientKey, wtwire.CodeOK
+}
+
 // replyCreateSession sends a response to a CreateSession from a client. If the
 // status code in the reply is OK, the error from the write will be bubbled up.
 // Otherwise, this method returns a connection error to ensure we don't continue
@@ -135,6 +234,12 @@ func (s *Server) handleCreateSession(peer Peer, id *wtdb.SessionID,
 func (s *Server) replyCreateSession(peer Peer, id *wtdb.SessionID,
 	code wtwire.ErrorCode, lastApplied uint16, data []byte) error {
 
+	if code != wtwire.CodeOK &&
+		code != wtwire.CreateSessionCodeAlreadyExists {
+
+		s.cfg.Metrics.SessionRejected(code)
+	}
+
 	if s.cfg.NoAckCreateSession {
 		return &connFailure{
 			ID:   *id,
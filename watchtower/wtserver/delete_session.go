@@ -19,6 +19,8 @@ func (s *Server) handleDeleteSession(peer Peer, id *wtdb.SessionID) error {
 
 		log.Debugf("Session %s deleted", id)
 
+		s.cfg.Metrics.SessionDeleted()
+
 	case err == wtdb.ErrSessionNotFound:
 		failCode = wtwire.DeleteSessionCodeNotFound
 
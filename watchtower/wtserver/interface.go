@@ -5,7 +5,9 @@ import (
 	"net"
 	"time"
 
+	"github.com/ltcsuite/lnd/watchtower/blob"
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/lnd/watchtower/wtwire"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 )
 
@@ -67,4 +69,39 @@ type DB interface {
 	// DeleteSession removes all data associated with a particular session
 	// id from the tower's database.
 	DeleteSession(wtdb.SessionID) error
+
+	// DeleteChannelUpdates removes the session's state updates with the
+	// given breach hints, after the client signaled that the channel they
+	// belong to was closed.
+	DeleteChannelUpdates(wtdb.SessionID, []blob.BreachHint) error
+
+	// ListClientSessions returns all sessions negotiated by the client
+	// identified by the given client key.
+	ListClientSessions(*btcec.PublicKey) ([]*wtdb.SessionInfo, error)
+
+	// GetClientStorage returns the number of bytes used by the encrypted
+	// blobs of the state updates stored for the client identified by the
+	// given client key, summed over all of its sessions.
+	GetClientStorage(*btcec.PublicKey) (uint64, error)
+}
+
+// Metrics records statistics about the sessions and updates handled by the
+// server.
+type Metrics interface {
+	// SessionCreated records that a new session was created.
+	SessionCreated()
+
+	// SessionRejected records that a request to create a session was
+	// rejected with the given code.
+	SessionRejected(code wtwire.CreateSessionCode)
+
+	// SessionDeleted records that a client deleted one of its sessions.
+	SessionDeleted()
+
+	// UpdateAccepted records that a state update was accepted.
+	UpdateAccepted()
+
+	// UpdateRejected records that a state update was rejected with the
+	// given code.
+	UpdateRejected(code wtwire.StateUpdateCode)
 }
@@ -10,8 +10,10 @@ import (
 
 	"github.com/ltcsuite/lnd/keychain"
 	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/multimutex"
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
 	"github.com/ltcsuite/lnd/watchtower/wtwire"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 	"github.com/ltcsuite/ltcd/connmgr"
 	"github.com/ltcsuite/ltcd/ltcutil"
@@ -67,6 +69,34 @@ type Config struct {
 	// DisableReward causes the server to reject any session creation
 	// attempts that request rewards.
 	DisableReward bool
+
+	// RewardBase is the minimum fixed reward the server requires for
+	// reward sessions.
+	RewardBase uint32
+
+	// RewardRate is the minimum proportional reward the server requires
+	// for reward sessions, expressed in millionths of the swept funds.
+	RewardRate uint32
+
+	// RequireClientKey causes the server to reject sessions from clients
+	// that don't identify themselves with a client key.
+	RequireClientKey bool
+
+	// AllowedClients restricts the server to the clients with the given
+	// client keys, if it isn't empty. This implies RequireClientKey.
+	AllowedClients []*btcec.PublicKey
+
+	// DeniedClients are the client keys of clients the server doesn't
+	// serve, even if they are allowed. This implies RequireClientKey.
+	DeniedClients []*btcec.PublicKey
+
+	// ClientQuota limits the resources each identified client can use.
+	// Setting any limit implies RequireClientKey.
+	ClientQuota ClientQuota
+
+	// Metrics records statistics about the sessions and updates handled by
+	// the server.
+	Metrics Metrics
 }
 
 // Server houses the state required to handle watchtower peers. It's primary job
@@ -83,6 +113,14 @@ type Server struct {
 	clientMtx sync.RWMutex
 	clients   map[wtdb.SessionID]Peer
 
+	allowedClients clientKeySet
+	deniedClients  clientKeySet
+
+	// quotaMtx serializes checking the quota of a client with storing its
+	// request, such that concurrent requests of the same client can't
+	// exceed its quota. Requests of different clients don't contend.
+	quotaMtx *multimutex.HashMutex
+
 	newPeers chan Peer
 
 	localInit *wtwire.Init
@@ -95,20 +133,31 @@ type Server struct {
 // clients connecting to the listener addresses, and allows them to open
 // sessions and send state updates.
 func New(cfg *Config) (*Server, error) {
+	// Signal support for reward sessions only if we accept them, allowing
+	// clients looking for reward sessions to skip us otherwise.
+	features := []lnwire.FeatureBit{
+		wtwire.AltruistSessionsOptional,
+		wtwire.AnchorCommitOptional,
+		wtwire.ChannelClosedOptional,
+	}
+	if !cfg.DisableReward {
+		features = append(features, wtwire.RewardSessionsOptional)
+	}
+
 	localInit := wtwire.NewInitMessage(
-		lnwire.NewRawFeatureVector(
-			wtwire.AltruistSessionsOptional,
-			wtwire.AnchorCommitOptional,
-		),
+		lnwire.NewRawFeatureVector(features...),
 		cfg.ChainHash,
 	)
 
 	s := &Server{
-		cfg:       cfg,
-		clients:   make(map[wtdb.SessionID]Peer),
-		newPeers:  make(chan Peer),
-		localInit: localInit,
-		quit:      make(chan struct{}),
+		cfg:            cfg,
+		clients:        make(map[wtdb.SessionID]Peer),
+		allowedClients: newClientKeySet(cfg.AllowedClients),
+		deniedClients:  newClientKeySet(cfg.DeniedClients),
+		quotaMtx:       multimutex.NewHashMutex(),
+		newPeers:       make(chan Peer),
+		localInit:      localInit,
+		quit:           make(chan struct{}),
 	}
 
 	connMgr, err := connmgr.New(&connmgr.Config{
@@ -205,6 +254,8 @@ func (s *Server) peerHandler() {
 // client may either send:
 //  * a single CreateSession message.
 //  * a series of StateUpdate messages.
+//  * a single DeleteSession message.
+//  * a series of ChannelClosed messages.
 //
 // This method uses the server's peer map to ensure at most one peer using the
 // same session id can enter the main event loop. The connection will be
@@ -277,6 +328,13 @@ func (s *Server) handleClient(peer Peer) {
 				"from %s: %v", id, err)
 		}
 
+	case *wtwire.ChannelClosed:
+		err = s.handleChannelClosedMsgs(peer, &id, msg)
+		if err != nil {
+			log.Errorf("Unable to handle ChannelClosed "+
+				"from %s: %v", id, err)
+		}
+
 	case *wtwire.StateUpdate:
 		err = s.handleStateUpdates(peer, &id, msg)
 		if err != nil {
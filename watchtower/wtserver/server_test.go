@@ -3,19 +3,24 @@ package wtserver_test
 import (
 	"bytes"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/ltcsuite/lnd/keychain"
 	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/watchtower/blob"
 	"github.com/ltcsuite/lnd/watchtower/wtdb"
+	"github.com/ltcsuite/lnd/watchtower/wtmetrics"
 	"github.com/ltcsuite/lnd/watchtower/wtmock"
+	"github.com/ltcsuite/lnd/watchtower/wtpolicy"
 	"github.com/ltcsuite/lnd/watchtower/wtserver"
 	"github.com/ltcsuite/lnd/watchtower/wtwire"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/chaincfg"
 	"github.com/ltcsuite/ltcd/ltcutil"
 	"github.com/ltcsuite/ltcd/txscript"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -29,6 +34,20 @@ var (
 	testnetChainHash = *chaincfg.TestNet4Params.GenesisHash
 
 	testBlob = make([]byte, blob.Size(blob.TypeAltruistCommit))
+
+	// testRewardTerms are the serialized reward terms the server requires
+	// for reward sessions.
+	testRewardTerms = []byte{
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x27, 0x10,
+	}
+)
+
+const (
+	// testRewardBase is the minimum reward base the server requires.
+	testRewardBase = 0
+
+	// testRewardRate is the minimum reward rate the server requires.
+	testRewardRate = wtpolicy.DefaultRewardRate
 )
 
 // randPubKey generates a new secp keypair, and returns the public key.
@@ -61,7 +80,10 @@ func initServer(t *testing.T, db wtserver.DB,
 		NewAddress: func() (ltcutil.Address, error) {
 			return addr, nil
 		},
-		ChainHash: testnetChainHash,
+		ChainHash:  testnetChainHash,
+		RewardBase: testRewardBase,
+		RewardRate: testRewardRate,
+		Metrics:    wtmetrics.Metrics{},
 	})
 	if err != nil {
 		t.Fatalf("unable to create server: %v", err)
@@ -239,7 +261,7 @@ var createSessionTests = []createSessionTestCase{
 			BlobType:     blob.TypeRewardCommit,
 			MaxUpdates:   1000,
 			RewardBase:   0,
-			RewardRate:   0,
+			RewardRate:   testRewardRate,
 			SweepFeeRate: 10000,
 		},
 		expReply: &wtwire.CreateSessionReply{
@@ -269,6 +291,42 @@ var createSessionTests = []createSessionTestCase{
 			Data: []byte{},
 		},
 	},
+	{
+		name: "reject reward below required terms",
+		initMsg: wtwire.NewInitMessage(
+			lnwire.NewRawFeatureVector(),
+			testnetChainHash,
+		),
+		createMsg: &wtwire.CreateSession{
+			BlobType:     blob.TypeRewardCommit,
+			MaxUpdates:   1000,
+			RewardBase:   testRewardBase,
+			RewardRate:   testRewardRate - 1,
+			SweepFeeRate: 10000,
+		},
+		expReply: &wtwire.CreateSessionReply{
+			Code: wtwire.CreateSessionCodeRejectRewardRate,
+			Data: testRewardTerms,
+		},
+	},
+	{
+		name: "accept reward above required terms",
+		initMsg: wtwire.NewInitMessage(
+			lnwire.NewRawFeatureVector(),
+			testnetChainHash,
+		),
+		createMsg: &wtwire.CreateSession{
+			BlobType:     blob.TypeRewardAnchorCommit,
+			MaxUpdates:   1000,
+			RewardBase:   testRewardBase + 1,
+			RewardRate:   testRewardRate,
+			SweepFeeRate: 10000,
+		},
+		expReply: &wtwire.CreateSessionReply{
+			Code: wtwire.CodeOK,
+			Data: addrScript,
+		},
+	},
 	// TODO(conner): add policy rejection tests
 }
 
@@ -834,6 +892,413 @@ func TestServerDeleteSession(t *testing.T) {
 	}
 }
 
+// TestServerChannelClosed asserts that ChannelClosed messages delete only the
+// session's updates for the closed channel, and that they are rejected for
+// unknown sessions.
+func TestServerChannelClosed(t *testing.T) {
+	t.Parallel()
+
+	const timeoutDuration = 100 * time.Millisecond
+
+	db := wtmock.NewTowerDB()
+	s := initServer(t, db, timeoutDuration)
+	defer s.Stop()
+
+	localPub := randPubKey(t)
+	peerPub := randPubKey(t)
+	id := wtdb.NewSessionIDFromPubKey(peerPub)
+
+	initMsg := wtwire.NewInitMessage(
+		lnwire.NewRawFeatureVector(), testnetChainHash,
+	)
+
+	closedHint1 := blob.BreachHint{0x01}
+	closedHint2 := blob.BreachHint{0x02}
+	openHint := blob.BreachHint{0x03}
+
+	// Signaling a closed channel for an unknown session should fail.
+	peer := wtmock.NewMockPeer(localPub, peerPub, nil, 0)
+	connect(t, s, peer, initMsg, timeoutDuration)
+	sendMsg(t, &wtwire.ChannelClosed{
+		IsComplete:  1,
+		BreachHints: []blob.BreachHint{closedHint1},
+	}, peer, timeoutDuration)
+	reply := recvReply(
+		t, "MsgChannelClosedReply", peer, timeoutDuration,
+	).(*wtwire.ChannelClosedReply)
+	require.Equal(t, wtwire.ChannelClosedCodeNotFound, reply.Code)
+	assertConnClosed(t, peer, 2*timeoutDuration)
+
+	// Create the session, and store updates for two closed channels and an
+	// open one.
+	peer = wtmock.NewMockPeer(localPub, peerPub, nil, 0)
+	connect(t, s, peer, initMsg, timeoutDuration)
+	sendMsg(t, &wtwire.CreateSession{
+		BlobType:     blob.TypeAltruistCommit,
+		MaxUpdates:   3,
+		SweepFeeRate: 10000,
+	}, peer, timeoutDuration)
+	recvReply(t, "MsgCreateSessionReply", peer, timeoutDuration)
+	assertConnClosed(t, peer, 2*timeoutDuration)
+
+	hints := []blob.BreachHint{closedHint1, closedHint2, openHint}
+	for i, hint := range hints {
+		_, err := db.InsertStateUpdate(&wtdb.SessionStateUpdate{
+			ID:            id,
+			SeqNum:        uint16(i + 1),
+			LastApplied:   uint16(i),
+			Hint:          hint,
+			EncryptedBlob: testBlob,
+		})
+		require.NoError(t, err)
+	}
+
+	// Signal both closed channels on the same connection, which is closed
+	// by the tower after the last message.
+	peer = wtmock.NewMockPeer(localPub, peerPub, nil, 0)
+	connect(t, s, peer, initMsg, timeoutDuration)
+	for i, hint := range []blob.BreachHint{closedHint1, closedHint2} {
+		var isComplete uint8
+		if i == 1 {
+			isComplete = 1
+		}
+
+		sendMsg(t, &wtwire.ChannelClosed{
+			IsComplete:  isComplete,
+			BreachHints: []blob.BreachHint{hint},
+		}, peer, timeoutDuration)
+		reply := recvReply(
+			t, "MsgChannelClosedReply", peer, timeoutDuration,
+		).(*wtwire.ChannelClosedReply)
+		require.Equal(t, wtwire.CodeOK, reply.Code)
+	}
+	assertConnClosed(t, peer, 2*timeoutDuration)
+
+	// Only the update of the open channel remains.
+	matches, err := db.QueryMatches(hints)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, openHint, matches[0].Hint)
+	require.Equal(t, id, matches[0].ID)
+}
+
+// mockMetrics is a wtserver.Metrics implementation counting the recorded
+// events.
+type mockMetrics struct {
+	mu               sync.Mutex
+	sessionsCreated  int
+	sessionsRejected int
+	sessionsDeleted  int
+	updatesAccepted  int
+	updatesRejected  int
+}
+
+func (m *mockMetrics) SessionCreated() {
+	m.mu.Lock()
+	m.sessionsCreated++
+	m.mu.Unlock()
+}
+
+func (m *mockMetrics) SessionRejected(wtwire.CreateSessionCode) {
+	m.mu.Lock()
+	m.sessionsRejected++
+	m.mu.Unlock()
+}
+
+func (m *mockMetrics) SessionDeleted() {
+	m.mu.Lock()
+	m.sessionsDeleted++
+	m.mu.Unlock()
+}
+
+func (m *mockMetrics) UpdateAccepted() {
+	m.mu.Lock()
+	m.updatesAccepted++
+	m.mu.Unlock()
+}
+
+func (m *mockMetrics) UpdateRejected(wtwire.StateUpdateCode) {
+	m.mu.Lock()
+	m.updatesRejected++
+	m.mu.Unlock()
+}
+
+// TestServerClientLimits asserts that the server only accepts sessions and
+// updates from allowed clients, within their quota, and that updates deleted
+// for closed channels no longer count towards the storage quota.
+func TestServerClientLimits(t *testing.T) {
+	t.Parallel()
+
+	const timeoutDuration = 100 * time.Millisecond
+
+	newKey := func() *btcec.PrivateKey {
+		privKey, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		return privKey
+	}
+
+	client0 := newKey()
+	client1 := newKey()
+	client2 := newKey()
+
+	metrics := &mockMetrics{}
+	s, err := wtserver.New(&wtserver.Config{
+		DB:           wtmock.NewTowerDB(),
+		ReadTimeout:  timeoutDuration,
+		WriteTimeout: timeoutDuration,
+		NewAddress: func() (ltcutil.Address, error) {
+			return addr, nil
+		},
+		ChainHash: testnetChainHash,
+		AllowedClients: []*btcec.PublicKey{
+			client0.PubKey(), client1.PubKey(),
+		},
+		DeniedClients: []*btcec.PublicKey{client1.PubKey()},
+		ClientQuota: wtserver.ClientQuota{
+			MaxSessions:     2,
+			MaxUpdates:      3,
+			MaxStorageBytes: uint64(2 * len(testBlob)),
+		},
+		Metrics: metrics,
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	localPub := randPubKey(t)
+	initMsg := wtwire.NewInitMessage(
+		lnwire.NewRawFeatureVector(), testnetChainHash,
+	)
+
+	// request sends the message with the given session key, and returns
+	// the server's reply.
+	request := func(sessionKey *btcec.PublicKey,
+		msg wtwire.Message) wtwire.Message {
+
+		t.Helper()
+
+		peer := wtmock.NewMockPeer(localPub, sessionKey, nil, 0)
+		connect(t, s, peer, initMsg, timeoutDuration)
+		sendMsg(t, msg, peer, timeoutDuration)
+		reply := recvReply(t, "", peer, timeoutDuration)
+		assertConnClosed(t, peer, 2*timeoutDuration)
+
+		return reply
+	}
+
+	// createSession requests a session with the given session key,
+	// identified by the client key if it isn't nil, and asserts the code
+	// of the reply.
+	createSession := func(sessionKey *btcec.PublicKey,
+		clientKey *btcec.PrivateKey, maxUpdates uint16,
+		expCode wtwire.CreateSessionCode) {
+
+		t.Helper()
+
+		req := &wtwire.CreateSession{
+			BlobType:     blob.TypeAltruistCommit,
+			MaxUpdates:   maxUpdates,
+			SweepFeeRate: 10000,
+		}
+		if clientKey != nil {
+			signer := keychain.NewPrivKeyMessageSigner(
+				clientKey, keychain.KeyLocator{},
+			)
+			msg := wtwire.ClientSigMsg(
+				sessionKey, testnetChainHash,
+			)
+			ecdsaSig, err := signer.SignMessage(msg, false)
+			require.NoError(t, err)
+
+			sig, err := lnwire.NewSigFromSignature(ecdsaSig)
+			require.NoError(t, err)
+
+			req.ClientKey = clientKey.PubKey()
+			req.ClientSig = sig
+		}
+
+		reply := request(sessionKey, req).(*wtwire.CreateSessionReply)
+		require.Equal(t, expCode, reply.Code)
+	}
+
+	// sendUpdate sends the state update with the given sequence number for
+	// the session, and asserts the code of the reply. The update's hint is
+	// derived from its sequence number.
+	sendUpdate := func(sessionKey *btcec.PublicKey, seqNum uint16,
+		expCode wtwire.StateUpdateCode) {
+
+		t.Helper()
+
+		update := &wtwire.StateUpdate{
+			SeqNum:        seqNum,
+			LastApplied:   seqNum - 1,
+			IsComplete:    1,
+			Hint:          blob.BreachHint{byte(seqNum)},
+			EncryptedBlob: testBlob,
+		}
+
+		reply := request(sessionKey, update).(*wtwire.StateUpdateReply)
+		require.Equal(t, expCode, reply.Code)
+	}
+
+	// Anonymous clients, clients that can't prove ownership of their
+	// client key, denied and unknown clients are rejected.
+	createSession(
+		randPubKey(t), nil, 1, wtwire.CreateSessionCodeRejectClient,
+	)
+	createSession(
+		randPubKey(t), client1, 1, wtwire.CreateSessionCodeRejectClient,
+	)
+	createSession(
+		randPubKey(t), client2, 1, wtwire.CreateSessionCodeRejectClient,
+	)
+
+	invalidSig := &wtwire.CreateSession{
+		BlobType:     blob.TypeAltruistCommit,
+		MaxUpdates:   1,
+		SweepFeeRate: 10000,
+		ClientKey:    client0.PubKey(),
+	}
+	reply := request(randPubKey(t), invalidSig).(*wtwire.CreateSessionReply)
+	require.Equal(t, wtwire.CreateSessionCodeRejectClient, reply.Code)
+
+	// The allowed client can create sessions until it reaches its quota
+	// of updates or sessions.
+	session0 := randPubKey(t)
+	session1 := randPubKey(t)
+	createSession(session0, client0, 2, wtwire.CodeOK)
+	createSession(
+		session1, client0, 2, wtwire.CreateSessionCodeRejectQuota,
+	)
+	createSession(session1, client0, 1, wtwire.CodeOK)
+	createSession(
+		randPubKey(t), client0, 1, wtwire.CreateSessionCodeRejectQuota,
+	)
+
+	// The client can store updates until it reaches its storage quota.
+	// Retransmitting the last update doesn't use additional storage.
+	sendUpdate(session0, 1, wtwire.CodeOK)
+	sendUpdate(session0, 2, wtwire.CodeOK)
+	sendUpdate(session0, 2, wtwire.CodeOK)
+	sendUpdate(session1, 1, wtwire.StateUpdateCodeQuotaExceeded)
+
+	// Once the update of a closed channel is deleted, the storage it used
+	// is available to the client again.
+	closed := &wtwire.ChannelClosed{
+		IsComplete:  1,
+		BreachHints: []blob.BreachHint{{1}},
+	}
+	closedReply := request(session0, closed).(*wtwire.ChannelClosedReply)
+	require.Equal(t, wtwire.CodeOK, closedReply.Code)
+
+	sendUpdate(session1, 1, wtwire.CodeOK)
+
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+
+	require.Equal(t, 2, metrics.sessionsCreated)
+	require.Equal(t, 6, metrics.sessionsRejected)
+	require.Equal(t, 4, metrics.updatesAccepted)
+	require.Equal(t, 1, metrics.updatesRejected)
+}
+
+// TestServerRequireClientKey asserts that anonymous clients are refused as
+// soon as any client permissions or quotas are configured, since those can't
+// be enforced for clients without a client key.
+func TestServerRequireClientKey(t *testing.T) {
+	t.Parallel()
+
+	const timeoutDuration = 100 * time.Millisecond
+
+	deniedKey := randPubKey(t)
+
+	tests := []struct {
+		name    string
+		denied  []*btcec.PublicKey
+		quota   wtserver.ClientQuota
+		expCode wtwire.CreateSessionCode
+	}{
+		{
+			name:    "no limits",
+			expCode: wtwire.CodeOK,
+		},
+		{
+			name:    "denied clients",
+			denied:  []*btcec.PublicKey{deniedKey},
+			expCode: wtwire.CreateSessionCodeRejectClient,
+		},
+		{
+			name: "max sessions",
+			quota: wtserver.ClientQuota{
+				MaxSessions: 1,
+			},
+			expCode: wtwire.CreateSessionCodeRejectClient,
+		},
+		{
+			name: "max updates",
+			quota: wtserver.ClientQuota{
+				MaxUpdates: 1,
+			},
+			expCode: wtwire.CreateSessionCodeRejectClient,
+		},
+		{
+			name: "max storage",
+			quota: wtserver.ClientQuota{
+				MaxStorageBytes: 1,
+			},
+			expCode: wtwire.CreateSessionCodeRejectClient,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := wtserver.New(&wtserver.Config{
+				DB:           wtmock.NewTowerDB(),
+				ReadTimeout:  timeoutDuration,
+				WriteTimeout: timeoutDuration,
+				NewAddress: func() (ltcutil.Address, error) {
+					return addr, nil
+				},
+				ChainHash:     testnetChainHash,
+				DeniedClients: test.denied,
+				ClientQuota:   test.quota,
+				Metrics:       wtmetrics.Metrics{},
+			})
+			require.NoError(t, err)
+			require.NoError(t, s.Start())
+			defer s.Stop()
+
+			initMsg := wtwire.NewInitMessage(
+				lnwire.NewRawFeatureVector(), testnetChainHash,
+			)
+			peer := wtmock.NewMockPeer(
+				randPubKey(t), randPubKey(t), nil, 0,
+			)
+			connect(t, s, peer, initMsg, timeoutDuration)
+
+			// The anonymous client doesn't set a client key.
+			sendMsg(t, &wtwire.CreateSession{
+				BlobType:     blob.TypeAltruistCommit,
+				MaxUpdates:   1,
+				SweepFeeRate: 10000,
+			}, peer, timeoutDuration)
+
+			reply := recvReply(
+				t, "MsgCreateSessionReply", peer,
+				timeoutDuration,
+			)
+			require.Equal(
+				t, test.expCode,
+				reply.(*wtwire.CreateSessionReply).Code,
+			)
+		})
+	}
+}
+
 func connect(t *testing.T, s wtserver.Interface, peer *wtmock.MockPeer,
 	initMsg *wtwire.Init, timeout time.Duration) {
 
@@ -865,8 +1330,8 @@ func sendMsg(t *testing.T, msg wtwire.Message,
 }
 
 // recvReply receives a message from the server, and parses it according to
-// expected reply type. The supported replies are CreateSessionReply and
-// StateUpdateReply.
+// expected reply type. The supported replies are CreateSessionReply,
+// StateUpdateReply, DeleteSessionReply and ChannelClosedReply.
 func recvReply(t *testing.T, name string, peer *wtmock.MockPeer,
 	timeout time.Duration) wtwire.Message {
 
@@ -910,6 +1375,11 @@ func recvReply(t *testing.T, name string, peer *wtmock.MockPeer,
 			t.Fatalf("expected %s reply message, "+
 				"got %T", name, msg)
 		}
+	case "MsgChannelClosedReply":
+		if _, ok := msg.(*wtwire.ChannelClosedReply); !ok {
+			t.Fatalf("expected %s reply message, "+
+				"got %T", name, msg)
+		}
 	}
 
 	return msg
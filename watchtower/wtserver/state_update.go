@@ -81,8 +81,20 @@ func (s *Server) handleStateUpdate(peer Peer, id *wtdb.SessionID,
 		EncryptedBlob: update.EncryptedBlob,
 	}
 
-	lastApplied, err = s.cfg.DB.InsertStateUpdate(&sessionUpdate)
+	// If the tower limits its clients, ensure that the session's client
+	// may store the update.
+	if s.limitsUpdates() {
+		lastApplied, failCode, err = s.insertLimitedUpdate(
+			&sessionUpdate,
+		)
+	} else {
+		lastApplied, err = s.cfg.DB.InsertStateUpdate(&sessionUpdate)
+	}
+
 	switch {
+	// The update was rejected by the client limits.
+	case err == nil && failCode != wtwire.CodeOK:
+
 	case err == nil:
 		log.Debugf("State update %d accepted for %s",
 			update.SeqNum, id)
@@ -114,6 +126,12 @@ func (s *Server) handleStateUpdate(peer Peer, id *wtdb.SessionID,
 		failCode = wtwire.CodeTemporaryFailure
 	}
 
+	if failCode == wtwire.CodeOK {
+		s.cfg.Metrics.UpdateAccepted()
+	} else {
+		s.cfg.Metrics.UpdateRejected(failCode)
+	}
+
 	if s.cfg.NoAckUpdates {
 		return &connFailure{
 			ID:   *id,
@@ -0,0 +1,70 @@
+package wtwire
+
+import (
+	"io"
+
+	"github.com/ltcsuite/lnd/watchtower/blob"
+)
+
+// MaxChannelClosedHints is the maximum number of breach hints a single
+// ChannelClosed message can carry. Clients with more updates for a closed
+// channel in a session send multiple ChannelClosed messages.
+const MaxChannelClosedHints = 4000
+
+// ChannelClosed is sent from the client to the tower to signal that a channel
+// with state updates in the session has been closed, and its close is deeply
+// confirmed. The tower can then delete the session's state updates with the
+// given breach hints, as they can no longer be used to exact justice. The
+// session is identified by the session key used to authenticate the brontide
+// connection.
+type ChannelClosed struct {
+	// IsComplete is 1 if the watchtower should close the connection after
+	// responding, and 0 otherwise.
+	IsComplete uint8
+
+	// BreachHints are the breach hints of the session's state updates for
+	// the closed channel.
+	BreachHints []blob.BreachHint
+}
+
+// A compile time check to ensure ChannelClosed implements the wtwire.Message
+// interface.
+var _ Message = (*ChannelClosed)(nil)
+
+// Decode deserializes a serialized ChannelClosed message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the wtwire.Message interface.
+func (m *ChannelClosed) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r,
+		&m.IsComplete,
+		&m.BreachHints,
+	)
+}
+
+// Encode serializes the target ChannelClosed into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the wtwire.Message interface.
+func (m *ChannelClosed) Encode(w io.Writer, pver uint32) error {
+	return WriteElements(w,
+		m.IsComplete,
+		m.BreachHints,
+	)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the wtwire.Message interface.
+func (m *ChannelClosed) MsgType() MessageType {
+	return MsgChannelClosed
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for a
+// ChannelClosed message observing the specified protocol version.
+//
+// This is part of the wtwire.Message interface.
+func (m *ChannelClosed) MaxPayloadLength(uint32) uint32 {
+	return 3 + MaxChannelClosedHints*blob.BreachHintSize
+}
@@ -0,0 +1,64 @@
+package wtwire
+
+import "io"
+
+// ChannelClosedCode is an error code returned by a watchtower in response to a
+// ChannelClosed message.
+type ChannelClosedCode = ErrorCode
+
+const (
+	// ChannelClosedCodeNotFound is returned when the watchtower does not
+	// know of the session the ChannelClosed message was sent for. This
+	// may indicate that the tower had already deleted the session.
+	ChannelClosedCodeNotFound ChannelClosedCode = 90
+)
+
+// ChannelClosedReply is a message sent in response to a client's
+// ChannelClosed message, and signals whether the tower deleted the state
+// updates of the closed channel.
+type ChannelClosedReply struct {
+	// Code will be non-zero if the watchtower was not able to delete the
+	// state updates of the closed channel.
+	Code ChannelClosedCode
+}
+
+// A compile time check to ensure ChannelClosedReply implements the
+// wtwire.Message interface.
+var _ Message = (*ChannelClosedReply)(nil)
+
+// Decode deserializes a serialized ChannelClosedReply message stored in the
+// passed io.Reader observing the specified protocol version.
+//
+// This is part of the wtwire.Message interface.
+func (m *ChannelClosedReply) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r,
+		&m.Code,
+	)
+}
+
+// Encode serializes the target ChannelClosedReply into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the wtwire.Message interface.
+func (m *ChannelClosedReply) Encode(w io.Writer, pver uint32) error {
+	return WriteElements(w,
+		m.Code,
+	)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the wtwire.Message interface.
+func (m *ChannelClosedReply) MsgType() MessageType {
+	return MsgChannelClosedReply
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for a
+// ChannelClosedReply complete message observing the specified protocol
+// version.
+//
+// This is part of the wtwire.Message interface.
+func (m *ChannelClosedReply) MaxPayloadLength(uint32) uint32 {
+	return 2
+}
@@ -1,12 +1,25 @@
 package wtwire
 
 import (
+	"bytes"
+	"errors"
 	"io"
 
 	"github.com/ltcsuite/lnd/lnwallet/chainfee"
+	"github.com/ltcsuite/lnd/lnwire"
 	"github.com/ltcsuite/lnd/watchtower/blob"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 )
 
+// clientSigTag is the tag prefixed to the message signed by a client's client
+// key, to prevent the signature from being valid in any other context.
+var clientSigTag = []byte("watchtower-client-key")
+
+// ErrInvalidClientSig signals that the client signature of a CreateSession
+// message doesn't commit to the session key using the client key.
+var ErrInvalidClientSig = errors.New("invalid client signature")
+
 // CreateSession is sent from a client to tower when to negotiate a session, which
 // specifies the total number of updates that can be made, as well as fee rates.
 // An update is consumed by uploading an encrypted blob that contains
@@ -35,6 +48,49 @@ type CreateSession struct {
 	// for this session must use this value during construction, and the
 	// signatures must implicitly commit to the resulting output values.
 	SweepFeeRate chainfee.SatPerKWeight
+
+	// ClientKey is an optional long-term key identifying the client across
+	// all of its sessions, allowing the tower to restrict and meter the
+	// sessions of each client. If nil, the client is anonymous.
+	ClientKey *btcec.PublicKey
+
+	// ClientSig is a signature of ClientSigMsg by the ClientKey, proving
+	// that the owner of the client key requested the session. It is only
+	// present if ClientKey is set.
+	ClientSig lnwire.Sig
+}
+
+// ClientSigMsg returns the message a client signs with its client key when
+// requesting a session with the given session key on the given chain. The
+// signature is over the single SHA256 hash of the message.
+func ClientSigMsg(sessionKey *btcec.PublicKey,
+	chainHash chainhash.Hash) []byte {
+
+	var b bytes.Buffer
+	b.Write(clientSigTag)
+	b.Write(chainHash[:])
+	b.Write(sessionKey.SerializeCompressed())
+
+	return b.Bytes()
+}
+
+// VerifyClientSig verifies that the client signature commits to the given
+// session key and chain using the client key. It must only be called if the
+// message contains a client key.
+func (m *CreateSession) VerifyClientSig(sessionKey *btcec.PublicKey,
+	chainHash chainhash.Hash) error {
+
+	sig, err := m.ClientSig.ToSignature()
+	if err != nil {
+		return err
+	}
+
+	digest := chainhash.HashB(ClientSigMsg(sessionKey, chainHash))
+	if !sig.Verify(digest, m.ClientKey) {
+		return ErrInvalidClientSig
+	}
+
+	return nil
 }
 
 // A compile time check to ensure CreateSession implements the wtwire.Message
@@ -46,13 +102,29 @@ var _ Message = (*CreateSession)(nil)
 //
 // This is part of the wtwire.Message interface.
 func (m *CreateSession) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		&m.BlobType,
 		&m.MaxUpdates,
 		&m.RewardBase,
 		&m.RewardRate,
 		&m.SweepFeeRate,
 	)
+	if err != nil {
+		return err
+	}
+
+	// The client key and signature are optional, and omitted entirely by
+	// anonymous clients.
+	err = ReadElement(r, &m.ClientKey)
+	switch {
+	case err == io.EOF:
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	return ReadElement(r, &m.ClientSig)
 }
 
 // Encode serializes the target CreateSession into the passed io.Writer
@@ -60,13 +132,24 @@ func (m *CreateSession) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the wtwire.Message interface.
 func (m *CreateSession) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	err := WriteElements(w,
 		m.BlobType,
 		m.MaxUpdates,
 		m.RewardBase,
 		m.RewardRate,
 		m.SweepFeeRate,
 	)
+	if err != nil {
+		return err
+	}
+
+	// Towers that don't know of client keys ignore the trailing bytes, so
+	// identified clients remain compatible with them.
+	if m.ClientKey == nil {
+		return nil
+	}
+
+	return WriteElements(w, m.ClientKey, m.ClientSig)
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -82,5 +165,5 @@ func (m *CreateSession) MsgType() MessageType {
 //
 // This is part of the wtwire.Message interface.
 func (m *CreateSession) MaxPayloadLength(uint32) uint32 {
-	return 2 + 2 + 4 + 4 + 8 // 20
+	return 2 + 2 + 4 + 4 + 8 + 33 + 64 // 117
 }
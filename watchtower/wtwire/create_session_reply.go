@@ -19,7 +19,8 @@ const (
 	CreateSessionCodeRejectMaxUpdates CreateSessionCode = 61
 
 	// CreateSessionCodeRejectRewardRate the tower rejected the reward rate
-	// proposed by the client.
+	// proposed by the client. The response includes the serialized
+	// RewardTerms the tower requires, which the client can use to retry.
 	CreateSessionCodeRejectRewardRate CreateSessionCode = 62
 
 	// CreateSessionCodeRejectSweepFeeRate the tower rejected the sweep fee
@@ -29,6 +30,16 @@ const (
 	// CreateSessionCodeRejectBlobType is returned when the tower does not
 	// support the proposed blob type.
 	CreateSessionCodeRejectBlobType CreateSessionCode = 64
+
+	// CreateSessionCodeRejectClient is returned when the tower doesn't
+	// accept sessions from the client, either because the client didn't
+	// identify itself with a client key, or because its client key isn't
+	// allowed.
+	CreateSessionCodeRejectClient CreateSessionCode = 65
+
+	// CreateSessionCodeRejectQuota is returned when the requested session
+	// would exceed the tower's quota for the client.
+	CreateSessionCodeRejectQuota CreateSessionCode = 66
 )
 
 // MaxCreateSessionReplyDataLength is the maximum size of the Data payload
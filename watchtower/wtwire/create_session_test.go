@@ -0,0 +1,53 @@
+package wtwire_test
+
+import (
+	"testing"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/watchtower/wtwire"
+	"github.com/ltcsuite/ltcd/btcec/v2"
+	"github.com/ltcsuite/ltcd/btcec/v2/ecdsa"
+	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateSessionClientSig asserts that the client signature of a
+// CreateSession message only verifies for the session key and chain it was
+// created for.
+func TestCreateSessionClientSig(t *testing.T) {
+	t.Parallel()
+
+	clientPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	sessionPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	sessionKey := sessionPriv.PubKey()
+
+	otherPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	digest := chainhash.HashB(
+		wtwire.ClientSigMsg(sessionKey, testnetChainHash),
+	)
+	sig, err := lnwire.NewSigFromSignature(ecdsa.Sign(clientPriv, digest))
+	require.NoError(t, err)
+
+	msg := &wtwire.CreateSession{
+		ClientKey: clientPriv.PubKey(),
+		ClientSig: sig,
+	}
+	require.NoError(t, msg.VerifyClientSig(sessionKey, testnetChainHash))
+
+	// The signature can't be used for other sessions or chains.
+	err = msg.VerifyClientSig(otherPriv.PubKey(), testnetChainHash)
+	require.ErrorIs(t, err, wtwire.ErrInvalidClientSig)
+
+	err = msg.VerifyClientSig(sessionKey, mainnetChainHash)
+	require.ErrorIs(t, err, wtwire.ErrInvalidClientSig)
+
+	// Nor can it be claimed by another client key.
+	msg.ClientKey = otherPriv.PubKey()
+	err = msg.VerifyClientSig(sessionKey, testnetChainHash)
+	require.ErrorIs(t, err, wtwire.ErrInvalidClientSig)
+}
@@ -40,14 +40,22 @@ func (c ErrorCode) String() string {
 		return "CreateSessionCodeRejectSweepFeeRate"
 	case CreateSessionCodeRejectBlobType:
 		return "CreateSessionCodeRejectBlobType"
+	case CreateSessionCodeRejectClient:
+		return "CreateSessionCodeRejectClient"
+	case CreateSessionCodeRejectQuota:
+		return "CreateSessionCodeRejectQuota"
 	case StateUpdateCodeClientBehind:
 		return "StateUpdateCodeClientBehind"
 	case StateUpdateCodeMaxUpdatesExceeded:
 		return "StateUpdateCodeMaxUpdatesExceeded"
 	case StateUpdateCodeSeqNumOutOfOrder:
 		return "StateUpdateCodeSeqNumOutOfOrder"
+	case StateUpdateCodeQuotaExceeded:
+		return "StateUpdateCodeQuotaExceeded"
 	case DeleteSessionCodeNotFound:
 		return "DeleteSessionCodeNotFound"
+	case ChannelClosedCodeNotFound:
+		return "ChannelClosedCodeNotFound"
 	default:
 		return fmt.Sprintf("UnknownErrorCode: %d", c)
 	}
@@ -9,6 +9,10 @@ var FeatureNames = map[lnwire.FeatureBit]string{
 	AltruistSessionsOptional: "altruist-sessions",
 	AnchorCommitRequired:     "anchor-commit",
 	AnchorCommitOptional:     "anchor-commit",
+	RewardSessionsRequired:   "reward-sessions",
+	RewardSessionsOptional:   "reward-sessions",
+	ChannelClosedRequired:    "channel-closed",
+	ChannelClosedOptional:    "channel-closed",
 }
 
 const (
@@ -30,4 +34,23 @@ const (
 	// AnchorCommitOptional specifies that the advertising tower allows the
 	// remote party to negotiate sessions for protecting anchor channels.
 	AnchorCommitOptional lnwire.FeatureBit = 3
+
+	// RewardSessionsRequired specifies that the advertising node requires
+	// the remote party to support sessions that pay the tower a reward
+	// from the justice transaction.
+	RewardSessionsRequired lnwire.FeatureBit = 4
+
+	// RewardSessionsOptional specifies that the advertising tower accepts
+	// sessions that pay the tower a reward from the justice transaction.
+	RewardSessionsOptional lnwire.FeatureBit = 5
+
+	// ChannelClosedRequired specifies that the advertising node requires
+	// the remote party to understand ChannelClosed messages, which ask
+	// the tower to delete the updates of a closed channel.
+	ChannelClosedRequired lnwire.FeatureBit = 6
+
+	// ChannelClosedOptional specifies that the advertising tower accepts
+	// ChannelClosed messages, which ask it to delete the updates of a
+	// closed channel.
+	ChannelClosedOptional lnwire.FeatureBit = 7
 )
@@ -45,6 +45,13 @@ const (
 	// MsgDeleteSessionReply identifies an encoded DeleteSessionReply
 	// message.
 	MsgDeleteSessionReply MessageType = 607
+
+	// MsgChannelClosed identifies an encoded ChannelClosed message.
+	MsgChannelClosed MessageType = 608
+
+	// MsgChannelClosedReply identifies an encoded ChannelClosedReply
+	// message.
+	MsgChannelClosedReply MessageType = 609
 )
 
 // String returns a human readable description of the message type.
@@ -64,6 +71,10 @@ func (m MessageType) String() string {
 		return "MsgDeleteSession"
 	case MsgDeleteSessionReply:
 		return "MsgDeleteSessionReply"
+	case MsgChannelClosed:
+		return "MsgChannelClosed"
+	case MsgChannelClosedReply:
+		return "MsgChannelClosedReply"
 	case MsgError:
 		return "Error"
 	default:
@@ -117,6 +128,10 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 		msg = &DeleteSession{}
 	case MsgDeleteSessionReply:
 		msg = &DeleteSessionReply{}
+	case MsgChannelClosed:
+		msg = &ChannelClosed{}
+	case MsgChannelClosedReply:
+		msg = &ChannelClosedReply{}
 	case MsgError:
 		msg = &Error{}
 	default:
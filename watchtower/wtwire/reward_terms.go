@@ -0,0 +1,46 @@
+package wtwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// RewardTerms are the reward parameters a tower requires for reward sessions.
+// They are returned in the Data of a CreateSessionReply when the tower rejects
+// the reward proposed by the client.
+type RewardTerms struct {
+	// RewardBase is the minimum fixed amount the tower takes from the
+	// funds swept by a justice transaction.
+	RewardBase uint32
+
+	// RewardRate is the minimum fraction of the funds swept by a justice
+	// transaction the tower takes, expressed in millionths.
+	RewardRate uint32
+}
+
+// Encode serializes the RewardTerms into the passed io.Writer.
+func (t *RewardTerms) Encode(w io.Writer) error {
+	return WriteElements(w,
+		t.RewardBase,
+		t.RewardRate,
+	)
+}
+
+// Decode deserializes the RewardTerms from the passed io.Reader.
+func (t *RewardTerms) Decode(r io.Reader) error {
+	return ReadElements(r,
+		&t.RewardBase,
+		&t.RewardRate,
+	)
+}
+
+// ParseRewardTerms parses the RewardTerms from the Data of a
+// CreateSessionReply.
+func ParseRewardTerms(data []byte) (*RewardTerms, error) {
+	terms := &RewardTerms{}
+	if err := terms.Decode(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	return terms, nil
+}
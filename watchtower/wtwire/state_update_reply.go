@@ -28,6 +28,10 @@ const (
 	// that does not follow the required incremental monotonicity required
 	// by the tower.
 	StateUpdateCodeSeqNumOutOfOrder StateUpdateCode = 72
+
+	// StateUpdateCodeQuotaExceeded signals that storing the update would
+	// exceed the tower's storage quota for the client.
+	StateUpdateCodeQuotaExceeded StateUpdateCode = 73
 )
 
 // StateUpdateReply is a message sent from watchtower to client in response to a
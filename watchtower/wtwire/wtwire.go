@@ -74,6 +74,24 @@ func WriteElement(w io.Writer, element interface{}) error {
 			return err
 		}
 
+	case []blob.BreachHint:
+		if len(e) > MaxChannelClosedHints {
+			return fmt.Errorf("cannot write %d breach hints, "+
+				"maximum is %d", len(e), MaxChannelClosedHints)
+		}
+
+		var b [2]byte
+		binary.BigEndian.PutUint16(b[:], uint16(len(e)))
+		if _, err := w.Write(b[:]); err != nil {
+			return err
+		}
+
+		for _, hint := range e {
+			if _, err := w.Write(hint[:]); err != nil {
+				return err
+			}
+		}
+
 	case chainfee.SatPerKWeight:
 		var b [8]byte
 		binary.BigEndian.PutUint64(b[:], uint64(e))
@@ -102,6 +120,11 @@ func WriteElement(w io.Writer, element interface{}) error {
 			return err
 		}
 
+	case lnwire.Sig:
+		if _, err := w.Write(e[:]); err != nil {
+			return err
+		}
+
 	case *btcec.PublicKey:
 		if e == nil {
 			return fmt.Errorf("cannot write nil pubkey")
@@ -194,6 +217,29 @@ func ReadElement(r io.Reader, element interface{}) error {
 		}
 		*e = bytes
 
+	case *[]blob.BreachHint:
+		var b [2]byte
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return err
+		}
+
+		numHints := binary.BigEndian.Uint16(b[:])
+		if numHints > MaxChannelClosedHints {
+			return fmt.Errorf("cannot read %d breach hints, "+
+				"maximum is %d", numHints,
+				MaxChannelClosedHints)
+		}
+
+		var hints []blob.BreachHint
+		for i := uint16(0); i < numHints; i++ {
+			var hint blob.BreachHint
+			if _, err := io.ReadFull(r, hint[:]); err != nil {
+				return err
+			}
+			hints = append(hints, hint)
+		}
+		*e = hints
+
 	case *chainfee.SatPerKWeight:
 		var b [8]byte
 		if _, err := io.ReadFull(r, b[:]); err != nil {
@@ -222,6 +268,11 @@ func ReadElement(r io.Reader, element interface{}) error {
 
 		*e = f
 
+	case *lnwire.Sig:
+		if _, err := io.ReadFull(r, e[:]); err != nil {
+			return err
+		}
+
 	case **btcec.PublicKey:
 		var b [btcec.PubKeyBytesLenCompressed]byte
 		if _, err := io.ReadFull(r, b[:]); err != nil {
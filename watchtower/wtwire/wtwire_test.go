@@ -10,8 +10,11 @@ import (
 
 	"github.com/ltcsuite/ltcd/chaincfg/chainhash"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/ltcsuite/lnd/lnwallet/chainfee"
 	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/watchtower/blob"
 	"github.com/ltcsuite/lnd/watchtower/wtwire"
+	"github.com/ltcsuite/ltcd/btcec/v2"
 )
 
 func randRawFeatureVector(r *rand.Rand) *lnwire.RawFeatureVector {
@@ -85,6 +88,47 @@ func TestWatchtowerWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(*req)
 		},
+		wtwire.MsgCreateSession: func(v []reflect.Value, r *rand.Rand) {
+			feeRate := chainfee.SatPerKWeight(r.Uint64())
+			req := wtwire.CreateSession{
+				BlobType:     blob.Type(r.Intn(1 << 16)),
+				MaxUpdates:   uint16(r.Intn(1 << 16)),
+				RewardBase:   r.Uint32(),
+				RewardRate:   r.Uint32(),
+				SweepFeeRate: feeRate,
+			}
+
+			// Only identified clients include a client key and
+			// signature.
+			if r.Intn(2) == 0 {
+				privKey, err := btcec.NewPrivateKey()
+				if err != nil {
+					t.Fatalf("unable to generate key: %v",
+						err)
+				}
+
+				req.ClientKey = privKey.PubKey()
+				r.Read(req.ClientSig[:])
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		wtwire.MsgChannelClosed: func(v []reflect.Value, r *rand.Rand) {
+			req := wtwire.ChannelClosed{
+				IsComplete: uint8(r.Intn(2)),
+			}
+
+			// Messages carry at least one hint, as an empty list
+			// is decoded as nil.
+			numHints := 1 + r.Intn(wtwire.MaxChannelClosedHints)
+			for i := 0; i < numHints; i++ {
+				var hint blob.BreachHint
+				r.Read(hint[:])
+				req.BreachHints = append(req.BreachHints, hint)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
 	}
 
 	// With the above types defined, we'll now generate a slice of
@@ -138,6 +182,18 @@ func TestWatchtowerWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: wtwire.MsgChannelClosed,
+			scenario: func(m wtwire.ChannelClosed) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: wtwire.MsgChannelClosedReply,
+			scenario: func(m wtwire.ChannelClosedReply) bool {
+				return mainScenario(&m)
+			},
+		},
 		{
 			msgType: wtwire.MsgError,
 			scenario: func(m wtwire.Error) bool {
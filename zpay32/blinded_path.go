@@ -0,0 +1,117 @@
+package zpay32
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
+	"github.com/ltcsuite/ltcd/ltcutil/bech32"
+)
+
+// BlindedPaymentPath holds a blinded path to the recipient of an invoice,
+// along with the aggregate relay parameters of the path. The sender treats
+// the blinded portion of the route as a single hop that charges the
+// aggregate fees and expiry delta.
+type BlindedPaymentPath struct {
+	// FeeBaseMsat is the total base fee charged by the hops in the path.
+	FeeBaseMsat uint32
+
+	// FeeRate is the total proportional fee, in millionths, charged by
+	// the hops in the path.
+	FeeRate uint32
+
+	// CltvExpiryDelta is the total expiry delta of the path, including
+	// the final CLTV delta of the recipient.
+	CltvExpiryDelta uint16
+
+	// HTLCMinMsat is the minimum amount that the path can carry.
+	HTLCMinMsat uint64
+
+	// HTLCMaxMsat is the maximum amount that the path can carry.
+	HTLCMaxMsat uint64
+
+	// Features is the set of features required to use the path.
+	Features *lnwire.FeatureVector
+
+	// BlindedPath is the blinded route to the recipient.
+	*blindedpath.BlindedPath
+}
+
+// encode serializes the blinded payment path in its 8-bit representation.
+func (p *BlindedPaymentPath) encode(w io.Writer) error {
+	var relayInfo [26]byte
+	binary.BigEndian.PutUint32(relayInfo[:4], p.FeeBaseMsat)
+	binary.BigEndian.PutUint32(relayInfo[4:8], p.FeeRate)
+	binary.BigEndian.PutUint16(relayInfo[8:10], p.CltvExpiryDelta)
+	binary.BigEndian.PutUint64(relayInfo[10:18], p.HTLCMinMsat)
+	binary.BigEndian.PutUint64(relayInfo[18:26], p.HTLCMaxMsat)
+	if _, err := w.Write(relayInfo[:]); err != nil {
+		return err
+	}
+
+	features := lnwire.NewRawFeatureVector()
+	if p.Features != nil {
+		features = p.Features.RawFeatureVector
+	}
+	if err := features.Encode(w); err != nil {
+		return err
+	}
+
+	return p.BlindedPath.Encode(w)
+}
+
+// decodeBlindedPaymentPath parses a blinded payment path from its 8-bit
+// representation.
+func decodeBlindedPaymentPath(r io.Reader) (*BlindedPaymentPath, error) {
+	var relayInfo [26]byte
+	if _, err := io.ReadFull(r, relayInfo[:]); err != nil {
+		return nil, err
+	}
+
+	path := &BlindedPaymentPath{
+		FeeBaseMsat:     binary.BigEndian.Uint32(relayInfo[:4]),
+		FeeRate:         binary.BigEndian.Uint32(relayInfo[4:8]),
+		CltvExpiryDelta: binary.BigEndian.Uint16(relayInfo[8:10]),
+		HTLCMinMsat:     binary.BigEndian.Uint64(relayInfo[10:18]),
+		HTLCMaxMsat:     binary.BigEndian.Uint64(relayInfo[18:26]),
+	}
+
+	features := lnwire.NewRawFeatureVector()
+	if err := features.Decode(r); err != nil {
+		return nil, err
+	}
+	path.Features = lnwire.NewFeatureVector(features, lnwire.Features)
+
+	blindedPath, err := blindedpath.DecodeBlindedPath(r)
+	if err != nil {
+		return nil, err
+	}
+	path.BlindedPath = blindedPath
+
+	return path, nil
+}
+
+// parseBlindedPaymentPath converts the data (encoded in base32) into a blinded
+// payment path.
+func parseBlindedPaymentPath(data []byte) (*BlindedPaymentPath, error) {
+	base256Data, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bytes.NewReader(base256Data)
+	path, err := decodeBlindedPaymentPath(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after blinded path",
+			r.Len())
+	}
+
+	return path, nil
+}
@@ -1,6 +1,7 @@
 package zpay32
 
 import (
+	"bytes"
 	"encoding/binary"
 	"math"
 	"reflect"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/ltcsuite/lnd/lnwire"
+	"github.com/ltcsuite/lnd/routing/blindedpath"
 	"github.com/ltcsuite/ltcd/btcec/v2"
 	"github.com/ltcsuite/ltcd/chaincfg"
 	"github.com/ltcsuite/ltcd/ltcutil"
@@ -728,6 +730,88 @@ func TestParseRouteHint(t *testing.T) {
 	}
 }
 
+// TestParseBlindedPaymentPath checks that blinded payment paths are properly
+// encoded and parsed.
+func TestParseBlindedPaymentPath(t *testing.T) {
+	t.Parallel()
+
+	testPath := &BlindedPaymentPath{
+		FeeBaseMsat:     1000,
+		FeeRate:         20,
+		CltvExpiryDelta: 144,
+		HTLCMinMsat:     1,
+		HTLCMaxMsat:     100000000,
+		Features: lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(lnwire.RouteBlindingRequired),
+			lnwire.Features,
+		),
+		BlindedPath: &blindedpath.BlindedPath{
+			IntroductionPoint: testHopHintPubkey1,
+			BlindingPoint:     testHopHintPubkey2,
+			BlindedHops: []*blindedpath.BlindedHop{
+				{
+					BlindedNodePub: testHopHintPubkey1,
+					CipherText:     []byte{1, 2, 3, 4},
+				},
+				{
+					BlindedNodePub: testHopHintPubkey2,
+					CipherText:     []byte{5, 6, 7},
+				},
+			},
+		},
+	}
+
+	var b bytes.Buffer
+	if err := testPath.encode(&b); err != nil {
+		t.Fatalf("unable to encode blinded path: %v", err)
+	}
+	testPathData, _ := bech32.ConvertBits(b.Bytes(), 8, 5, true)
+
+	tests := []struct {
+		data   []byte
+		valid  bool
+		result *BlindedPaymentPath
+	}{
+		{
+			data:  []byte{0x0, 0x0, 0x0, 0x0},
+			valid: false, // data too short
+		},
+		{
+			data:   testPathData,
+			valid:  true,
+			result: testPath,
+		},
+		{
+			data:  append(testPathData, 0x0, 0x0),
+			valid: false, // trailing data
+		},
+	}
+
+	for i, test := range tests {
+		path, err := parseBlindedPaymentPath(test.data)
+		if (err == nil) != test.valid {
+			t.Errorf("blinded path decoding test %d failed: %v", i,
+				err)
+			return
+		}
+		if test.valid && !reflect.DeepEqual(test.result, path) {
+			t.Fatalf("test %d failed decoding blinded path: "+
+				"expected %v, got %v", i, test.result, path)
+		}
+	}
+
+	// A path without any hops cannot be encoded.
+	emptyPath := &BlindedPaymentPath{
+		BlindedPath: &blindedpath.BlindedPath{
+			IntroductionPoint: testHopHintPubkey1,
+			BlindingPoint:     testHopHintPubkey2,
+		},
+	}
+	if err := emptyPath.encode(&b); err == nil {
+		t.Fatalf("expected error encoding path without hops")
+	}
+}
+
 // TestParseTaggedFields checks that tagged field data is correctly parsed or
 // errors as expected.
 func TestParseTaggedFields(t *testing.T) {